@@ -66,6 +66,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&APIKey{},
 		&APIKeyList{},
 		&APIKeyReq{},
+		&APIKeyRotateReq{},
 		&APIKeyReqPassword{},
 		&APISigningKey{},
 		&APISigningKeyList{},
@@ -101,6 +102,21 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&CustomPolicyBinding{},
 		&CustomPolicyBindingList{},
 
+		&AccessRequest{},
+		&AccessRequestList{},
+		&AccessRequestReview{},
+		&AccessReview{},
+		&AccessReviewList{},
+		&AccessReviewExportOptions{},
+		&AccessReviewDiffOptions{},
+		&AccessReviewDiff{},
+		&Session{},
+		&SessionList{},
+		&SessionRevocation{},
+		&SessionRevocationList{},
+		&Tenant{},
+		&TenantList{},
+
 		&ConfigMap{},
 		&ConfigMapList{})
 
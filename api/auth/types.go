@@ -32,6 +32,9 @@ const (
 	// PolicyQueryTag is a field tag to query localidentities with policies in extra.
 	PolicyQueryTag string = "policy"
 
+	// UnusedDaysQueryTag is a field tag to query api keys not used for the given number of days.
+	UnusedDaysQueryTag string = "unusedDays"
+
 	// IssuerName is the name of issuer location.
 	IssuerName = "oidc"
 )
@@ -82,6 +85,13 @@ const (
 
 	// CustomPolicyBindingFinalize is an internal finalizer values to CustomPolicyBinding.
 	CustomPolicyBindingFinalize FinalizerName = "custompolicybinding"
+
+	// AccessRequestFinalize is the metadata finalizer of AccessRequest, the
+	// granted permissions are revoked before it is removed.
+	AccessRequestFinalize FinalizerName = "auth.tke.com/accessrequest"
+
+	// TenantFinalize is an internal finalizer values to Tenant.
+	TenantFinalize FinalizerName = "tenant"
 )
 
 // LocalIdentitySpec is a description of an identity.
@@ -114,6 +124,23 @@ type LocalIdentityStatus struct {
 	// The last time the local identity was updated.
 	// +optional
 	LastUpdateTime metav1.Time
+
+	// FailedLoginAttempts is the number of consecutive failed logins.
+	// +optional
+	FailedLoginAttempts int32
+	// The last time a login of the local identity failed.
+	// +optional
+	LastFailedLoginTime metav1.Time
+	// LockedUntil is the time until which the local identity is temporarily
+	// locked because of too many failed logins.
+	// +optional
+	LockedUntil metav1.Time
+	// The last time the password of the local identity was changed.
+	// +optional
+	PasswordUpdateTime metav1.Time
+	// PasswordHistory holds the previous hashed passwords, newest first.
+	// +optional
+	PasswordHistory []string
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -308,6 +335,27 @@ type APIKeySpec struct {
 
 	// ExpireAt is the expire time for api key
 	ExpireAt metav1.Time `json:"expire_at,omitempty"`
+
+	// Scope restricts the api key to a subset of the permissions of the user.
+	// +optional
+	Scope *APIKeyScope `json:"scope,omitempty"`
+}
+
+// APIKeyScope restricts what an api key is allowed to do on behalf of its user.
+// The effective permissions are the intersection of the scope and the
+// permissions of the user.
+type APIKeyScope struct {
+	// Statements is the subset of actions and resources granted to the api key.
+	// +optional
+	Statements []Statement `json:"statements,omitempty"`
+
+	// Projects limits the api key to the given projects.
+	// +optional
+	Projects []string `json:"projects,omitempty"`
+
+	// SourceCIDRs limits the source addresses the api key can be used from.
+	// +optional
+	SourceCIDRs []string `json:"sourceCIDRs,omitempty"`
 }
 
 // APIKeyStatus is a description of an api key status.
@@ -316,6 +364,21 @@ type APIKeyStatus struct {
 	Disabled bool `json:"disabled"`
 	// Expired represents whether the apikey has been expired.
 	Expired bool `json:"expired"`
+	// LastUsedTime is the last time the api key was used to authenticate.
+	// +optional
+	LastUsedTime metav1.Time `json:"lastUsedTime,omitempty"`
+	// LastSourceIP is the source address of the last request using the api key.
+	// +optional
+	LastSourceIP string `json:"lastSourceIP,omitempty"`
+	// RequestCount is the number of requests authenticated by the api key.
+	// +optional
+	RequestCount int64 `json:"requestCount,omitempty"`
+	// RotatedTo is the name of the api key replacing this one.
+	// +optional
+	RotatedTo string `json:"rotatedTo,omitempty"`
+	// RetireTime is the time after which a rotated api key is no longer accepted.
+	// +optional
+	RetireTime metav1.Time `json:"retireTime,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -329,6 +392,25 @@ type APIKeyReq struct {
 
 	// Description describes api keys usage.
 	Description string `json:"description"`
+
+	// Scope restricts the api key to a subset of the permissions of the user.
+	// +optional
+	Scope *APIKeyScope `json:"scope,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// APIKeyRotateReq contains the parameters used to rotate an api key.
+type APIKeyRotateReq struct {
+	metav1.TypeMeta
+
+	// Expire holds the duration of the new api key become invalid. By default, the same as the rotated one.
+	// +optional
+	Expire metav1.Duration `json:"expire,omitempty"`
+
+	// Overlap holds the duration the rotated api key stays valid. By default, 24h.
+	// +optional
+	Overlap metav1.Duration `json:"overlap,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -351,6 +433,10 @@ type APIKeyReqPassword struct {
 
 	// Expire holds the duration of the api key become invalid. By default, 168h(= seven days)
 	Expire metav1.Duration `json:"expire,omitempty"`
+
+	// Scope restricts the api key to a subset of the permissions of the user.
+	// +optional
+	Scope *APIKeyScope `json:"scope,omitempty"`
 }
 
 // +genclient
@@ -364,6 +450,26 @@ type APISigningKey struct {
 
 	SigningKey    []byte
 	SigningKeyPub []byte
+	// KeyID is the ID of the signing key, set as the kid header of the api
+	// keys signed with it.
+	// +optional
+	KeyID string
+	// RotateTime is the time the signing key is generated.
+	// +optional
+	RotateTime metav1.Time
+	// VerificationKeys are the public keys of the retired signing keys, kept
+	// until every api key signed with them has expired.
+	// +optional
+	VerificationKeys []VerificationKey
+}
+
+// VerificationKey is the public key of a retired signing key.
+type VerificationKey struct {
+	KeyID     string
+	PublicKey []byte
+	// Expiry is the time the last api key signed with the key expires, the
+	// key is removed after it.
+	Expiry metav1.Time
 }
 
 // +genclient:nonNamespaced
@@ -629,6 +735,247 @@ type CustomPolicyBindingList struct {
 	Items []CustomPolicyBinding
 }
 
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequest represents a request of a user for temporary permissions,
+// which are granted once approved and revoked automatically at expiry.
+type AccessRequest struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   AccessRequestSpec
+	Status AccessRequestStatus
+}
+
+// AccessRequestSpec describes the permissions requested.
+type AccessRequestSpec struct {
+	TenantID string
+	// Username is the user the permissions are granted to, set to the requester.
+	Username string
+	// ProjectID is the project the permissions are requested in, the
+	// permissions are platform wide if empty.
+	// +optional
+	ProjectID string
+	// Policies are the ids of the policies requested.
+	// +optional
+	Policies []string
+	// RoleID is the id of a role whose policies are requested.
+	// +optional
+	RoleID string
+	// Duration is how long the permissions are granted after approval.
+	Duration metav1.Duration
+	// Reason explains why the permissions are needed.
+	Reason string
+}
+
+// AccessRequestPhase defines the phase of access request.
+type AccessRequestPhase string
+
+const (
+	// AccessRequestPending means the request is waiting for approval.
+	AccessRequestPending AccessRequestPhase = "Pending"
+	// AccessRequestApproved means the permissions are granted.
+	AccessRequestApproved AccessRequestPhase = "Approved"
+	// AccessRequestRejected means the request is rejected by an approver.
+	AccessRequestRejected AccessRequestPhase = "Rejected"
+	// AccessRequestExpired means the granted permissions have been revoked.
+	AccessRequestExpired AccessRequestPhase = "Expired"
+)
+
+// AccessRequestStatus represents information about the status of an access request.
+type AccessRequestStatus struct {
+	// +optional
+	Phase AccessRequestPhase
+	// Approvers are the users allowed to approve or reject the request.
+	// +optional
+	Approvers []string
+	// Reviewer is the user who approved or rejected the request.
+	// +optional
+	Reviewer string
+	// ReviewMessage is the comment of the reviewer.
+	// +optional
+	ReviewMessage string
+	// ReviewTime is the time the request was approved or rejected.
+	// +optional
+	ReviewTime metav1.Time
+	// ExpireTime is the time the granted permissions are revoked.
+	// +optional
+	ExpireTime metav1.Time
+	// NotifyTime is the time the approvers were alerted.
+	// +optional
+	NotifyTime metav1.Time
+	// Bindings are the bindings the user was added to for the request.
+	// +optional
+	Bindings []AccessRequestBinding
+	// Message is a human readable message indicating details about the
+	// last failure of granting or revoking the permissions.
+	// +optional
+	Message string
+}
+
+// AccessRequestBinding references a binding granting the requested permissions.
+type AccessRequestBinding struct {
+	// Kind is ProjectPolicyBinding or CustomPolicyBinding.
+	Kind string
+	// +optional
+	Namespace string
+	Name      string
+	// Created is true if the binding was created for the request rather
+	// than extended.
+	// +optional
+	Created bool
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequestList is the whole list of all access requests.
+type AccessRequestList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	// List of access requests.
+	Items []AccessRequest
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequestReview contains the comment used to approve or reject an access request.
+type AccessRequestReview struct {
+	metav1.TypeMeta
+
+	// Message is the comment of the reviewer.
+	// +optional
+	Message string
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=update,patch,watch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessReview is a snapshot of the effective permissions of every user and
+// group in a tenant or a project, taken when it is created.
+type AccessReview struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   AccessReviewSpec
+	Status AccessReviewStatus
+}
+
+// AccessReviewSpec describes the scope of an access review.
+type AccessReviewSpec struct {
+	TenantID string
+	// ProjectID limits the review to the permissions in the project, the
+	// whole tenant is reviewed if empty.
+	// +optional
+	ProjectID string
+}
+
+// AccessReviewStatus holds the permissions resolved by an access review.
+type AccessReviewStatus struct {
+	// Subjects are the users and groups with their effective permissions.
+	// +optional
+	Subjects []AccessReviewSubject
+}
+
+// AccessReviewSubject is a user or a group with its effective permissions.
+type AccessReviewSubject struct {
+	// Kind is User or Group.
+	Kind string
+	ID   string
+	Name string
+	// Groups are the groups the user belongs to.
+	// +optional
+	Groups []string
+	// Members are the users of the group.
+	// +optional
+	Members []string
+	// +optional
+	Permissions []AccessReviewPermission
+}
+
+// AccessReviewPermission is a policy granted to a subject, together with the
+// actions it allows or denies.
+type AccessReviewPermission struct {
+	// Project is the project the policy is granted in, platform wide if empty.
+	// +optional
+	Project string
+	Policy  string
+	// +optional
+	PolicyName string
+	// Role is the role the policy is granted through.
+	// +optional
+	Role string
+	// Group is the group the policy is inherited from.
+	// +optional
+	Group     string
+	Effect    Effect
+	Resources []string
+	Actions   []string
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessReviewList is the whole list of all access reviews.
+type AccessReviewList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	// List of access reviews.
+	Items []AccessReview
+}
+
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessReviewExportOptions is the query options to export an access review.
+type AccessReviewExportOptions struct {
+	metav1.TypeMeta
+
+	// Format is json or csv, json by default.
+	// +optional
+	Format string
+}
+
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessReviewDiffOptions is the query options to compare two access reviews.
+type AccessReviewDiffOptions struct {
+	metav1.TypeMeta
+
+	// Base is the name of the access review compared with.
+	Base string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessReviewDiff lists the permissions granted or revoked between two
+// access reviews.
+type AccessReviewDiff struct {
+	metav1.TypeMeta
+
+	Base   string
+	Target string
+	// Added are the permissions in the target but not in the base.
+	// +optional
+	Added []AccessReviewEntry
+	// Removed are the permissions in the base but not in the target.
+	// +optional
+	Removed []AccessReviewEntry
+}
+
+// AccessReviewEntry is a permission of a subject, as a row of the exported
+// access review.
+type AccessReviewEntry struct {
+	SubjectKind string
+	Subject     string
+	Permission  AccessReviewPermission
+}
+
 const (
 	DefaultRuleModel = `
 [request_definition]
@@ -853,6 +1200,11 @@ type SubjectAccessReviewSpec struct {
 	Extra map[string]ExtraValue
 	// UID information about the requesting user.
 	UID string
+
+	// SimulatedGroups are the ids of local groups the user is considered a
+	// member of while explaining the request, so that membership changes can
+	// be tested before they are applied.
+	SimulatedGroups []string
 }
 
 // ExtraValue masks the value so protobuf can generate
@@ -906,6 +1258,57 @@ type SubjectAccessReviewStatus struct {
 
 	// AllowedList is the allowed response for batch authorization request.
 	AllowedList []*AllowedStatus
+
+	// Explanation describes how the decision is made, only set if explain is
+	// requested.
+	Explanation *AuthorizationExplanation
+}
+
+// AuthorizationExplanation describes how the decision of a subject access
+// review is made.
+type AuthorizationExplanation struct {
+	// Subject is the casbin subject of the user.
+	Subject string
+	// Tenant is the tenant of the user.
+	Tenant string
+	// Project is the project the request is made in.
+	Project string
+	// Action is the action being requested.
+	Action string
+	// Resource is the resource being requested.
+	Resource string
+	// Stage is the step of the authorization that made the decision.
+	Stage string
+	// Decision is the final decision, one of Allow, Deny or NoOpinion.
+	Decision string
+	// MatchedRules are all the rules matched by the request.
+	MatchedRules []MatchedRule
+}
+
+// MatchedRule describes a casbin rule matched by a subject access review.
+type MatchedRule struct {
+	// Rule is the name of the rule object.
+	Rule string
+	// Kind is the kind of the object the rule belongs to, Policy or Role.
+	Kind string
+	// Name is the name of the policy or role the rule belongs to.
+	Name string
+	// Binding is the name of the ProjectPolicyBinding granting the rule.
+	Binding string
+	// Project is the project the rule is granted in, empty if it is granted
+	// for the whole platform.
+	Project string
+	// Via is the user or group the rule is granted to.
+	Via string
+	// Resource is the resource pattern of the rule.
+	Resource string
+	// Action is the action pattern of the rule.
+	Action string
+	// Effect is the effect of the rule.
+	Effect Effect
+	// FailedCondition describes the condition of the policy not met by the
+	// request, the rule takes no effect if it is set.
+	FailedCondition string
 }
 
 // AllowedStatus includes the resource access request and response.
@@ -1048,3 +1451,222 @@ type ConfigMapList struct {
 	// Items is the list of ConfigMaps.
 	Items []ConfigMap
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=create,update,patch,watch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Session is a login session of a user, backed by the refresh token issued to
+// the client. Deleting a session revokes it.
+type Session struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   SessionSpec
+	Status SessionStatus
+}
+
+// SessionSpec describes the attributes of a session.
+type SessionSpec struct {
+	TenantID string
+	Username string
+	UserID   string
+	ClientID string
+	// SessionID identifies the session in the ID tokens issued for it, it is
+	// empty if the session is not logged in through the gateway.
+	// +optional
+	SessionID string
+	// ClientIP is the address the user logged in from.
+	// +optional
+	ClientIP string
+}
+
+// SessionStatus represents information about the usage of a session.
+type SessionStatus struct {
+	IssuedTime metav1.Time
+	// LastUsedTime is the last time the session is refreshed.
+	// +optional
+	LastUsedTime metav1.Time
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SessionList is the whole list of all sessions.
+type SessionList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	// List of sessions.
+	Items []Session
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=update,patch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SessionRevocation records the ID tokens revoked before they expire.
+type SessionRevocation struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec SessionRevocationSpec
+}
+
+// SessionRevocationSpec describes the ID tokens revoked.
+type SessionRevocationSpec struct {
+	TenantID string
+	Username string
+	// SessionID is the revoked session, all sessions of the user issued
+	// before the revoke time are revoked if empty.
+	// +optional
+	SessionID  string
+	RevokeTime metav1.Time
+	// ExpireTime is the time the last revoked ID token expires, the
+	// revocation is removed after it.
+	ExpireTime metav1.Time
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SessionRevocationList is the whole list of all session revocations.
+type SessionRevocationList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	// List of session revocations.
+	Items []SessionRevocation
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Tenant is a tenant of TKE, its name is the tenant id the objects of every
+// API group belong to.
+type Tenant struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec TenantSpec
+	// +optional
+	Status TenantStatus
+}
+
+// TenantSpec is a description of a tenant.
+type TenantSpec struct {
+	Finalizers []FinalizerName
+
+	DisplayName string
+	// IdentityProviderType is the type of the default identity provider
+	// created with the tenant, tke if empty.
+	// +optional
+	IdentityProviderType string
+	// IdentityProviderConfig is the json configuration of the default
+	// identity provider.
+	// +optional
+	IdentityProviderConfig string
+	// Administrators are the administrators of the default identity provider.
+	// +optional
+	Administrators []string
+	// Quota limits the number of objects the tenant may own.
+	// +optional
+	Quota TenantQuota
+	// Suspended denies every request of the users of the tenant.
+	// +optional
+	Suspended bool
+}
+
+// TenantQuota limits the number of objects a tenant may own, nil means
+// unlimited.
+type TenantQuota struct {
+	// +optional
+	Clusters *int32
+	// +optional
+	Projects *int32
+	// +optional
+	Registries *int32
+	// +optional
+	Apps *int32
+}
+
+// TenantUsage is the number of objects a tenant owns.
+type TenantUsage struct {
+	Clusters   int32
+	Projects   int32
+	Registries int32
+	Apps       int32
+}
+
+// TenantPhase defines the phase of tenant.
+type TenantPhase string
+
+const (
+	// TenantInitializing means the default identity provider of the tenant
+	// is being created.
+	TenantInitializing TenantPhase = "Initializing"
+	// TenantActive is the normal phase.
+	TenantActive TenantPhase = "Active"
+	// TenantSuspended means the requests of the tenant are denied.
+	TenantSuspended TenantPhase = "Suspended"
+	// TenantTerminating means the tenant is undergoing graceful termination.
+	TenantTerminating TenantPhase = "Terminating"
+)
+
+// TenantStatus represents information about the status of a tenant.
+type TenantStatus struct {
+	// +optional
+	Phase TenantPhase
+	// Used is the number of objects the tenant owns, refreshed periodically.
+	// +optional
+	Used TenantUsage
+	// LastUsageTime is the last time the usage is refreshed.
+	// +optional
+	LastUsageTime metav1.Time
+	// Deletion is the progress of the deletion per API group.
+	// +optional
+	Deletion []TenantDeletionStatus
+	// +optional
+	Message string
+}
+
+// TenantDeletionPhase defines the phase of the deletion of the objects of a
+// tenant in an API group.
+type TenantDeletionPhase string
+
+const (
+	// TenantDeletionDeleting means the objects are being deleted.
+	TenantDeletionDeleting TenantDeletionPhase = "Deleting"
+	// TenantDeletionCompleted means all objects are deleted.
+	TenantDeletionCompleted TenantDeletionPhase = "Completed"
+	// TenantDeletionFailed means the objects failed to be deleted, it is
+	// retried.
+	TenantDeletionFailed TenantDeletionPhase = "Failed"
+	// TenantDeletionSkipped means the API group is not available.
+	TenantDeletionSkipped TenantDeletionPhase = "Skipped"
+)
+
+// TenantDeletionStatus is the progress of the deletion of the objects of a
+// tenant in an API group.
+type TenantDeletionStatus struct {
+	Group string
+	Phase TenantDeletionPhase
+	// Remaining is the number of objects not deleted yet.
+	// +optional
+	Remaining int32
+	// +optional
+	Message string
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TenantList is the whole list of all tenants.
+type TenantList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+	// List of tenants.
+	Items []Tenant
+}
@@ -38,6 +38,11 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 		AddFieldLabelConversionsForUser,
 		AddFieldLabelConversionsForGroup,
 		AddFieldLabelConversionsForIdentityProvider,
+		AddFieldLabelConversionsForAccessRequest,
+		AddFieldLabelConversionsForAccessReview,
+		AddFieldLabelConversionsForSession,
+		AddFieldLabelConversionsForSessionRevocation,
+		AddFieldLabelConversionsForTenant,
 	}
 	for _, f := range funcs {
 		if err := f(scheme); err != nil {
@@ -290,3 +295,90 @@ func AddFieldLabelConversionsForCustomPolicyBinding(scheme *runtime.Scheme) erro
 			}
 		})
 }
+
+// AddFieldLabelConversionsForAccessRequest adds a conversion function to convert
+// field selectors of AccessRequest from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForAccessRequest(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("AccessRequest"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.username",
+				"spec.projectID",
+				"status.phase",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForAccessReview adds a conversion function to convert
+// field selectors of AccessReview from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForAccessReview(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("AccessReview"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.projectID",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForSession adds a conversion function to convert
+// field selectors of Session from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForSession(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("Session"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.username",
+				"spec.clientID",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForSessionRevocation adds a conversion function to
+// convert field selectors of SessionRevocation from the given version to
+// internal version representation.
+func AddFieldLabelConversionsForSessionRevocation(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("SessionRevocation"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.username",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForTenant adds a conversion function to convert
+// field selectors of Tenant from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForTenant(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("Tenant"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "status.phase",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
@@ -54,6 +54,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&APIKey{},
 		&APIKeyList{},
 		&APIKeyReq{},
+		&APIKeyRotateReq{},
 		&APIKeyReqPassword{},
 		&APISigningKey{},
 		&APISigningKeyList{},
@@ -90,6 +91,21 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&CustomPolicyBinding{},
 		&CustomPolicyBindingList{},
 
+		&AccessRequest{},
+		&AccessRequestList{},
+		&AccessRequestReview{},
+		&AccessReview{},
+		&AccessReviewList{},
+		&AccessReviewExportOptions{},
+		&AccessReviewDiffOptions{},
+		&AccessReviewDiff{},
+		&Session{},
+		&SessionList{},
+		&SessionRevocation{},
+		&SessionRevocationList{},
+		&Tenant{},
+		&TenantList{},
+
 		&ConfigMap{},
 		&ConfigMapList{},
 	)
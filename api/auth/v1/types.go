@@ -75,6 +75,13 @@ const (
 
 	// CustomPolicyBindingFinalize is an internal finalizer values to CustomPolicyBinding.
 	CustomPolicyBindingFinalize FinalizerName = "custompolicybinding"
+
+	// AccessRequestFinalize is the metadata finalizer of AccessRequest, the
+	// granted permissions are revoked before it is removed.
+	AccessRequestFinalize FinalizerName = "auth.tke.com/accessrequest"
+
+	// TenantFinalize is an internal finalizer values to Tenant.
+	TenantFinalize FinalizerName = "tenant"
 )
 
 // LocalIdentitySpec is a description of an identity.
@@ -112,6 +119,23 @@ type LocalIdentityStatus struct {
 	// The last time the local identity was updated.
 	// +optional
 	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,2,opt,name=lastUpdateTime"`
+
+	// FailedLoginAttempts is the number of consecutive failed logins.
+	// +optional
+	FailedLoginAttempts int32 `json:"failedLoginAttempts,omitempty" protobuf:"varint,4,opt,name=failedLoginAttempts"`
+	// The last time a login of the local identity failed.
+	// +optional
+	LastFailedLoginTime metav1.Time `json:"lastFailedLoginTime,omitempty" protobuf:"bytes,5,opt,name=lastFailedLoginTime"`
+	// LockedUntil is the time until which the local identity is temporarily
+	// locked because of too many failed logins.
+	// +optional
+	LockedUntil metav1.Time `json:"lockedUntil,omitempty" protobuf:"bytes,6,opt,name=lockedUntil"`
+	// The last time the password of the local identity was changed.
+	// +optional
+	PasswordUpdateTime metav1.Time `json:"passwordUpdateTime,omitempty" protobuf:"bytes,7,opt,name=passwordUpdateTime"`
+	// PasswordHistory holds the previous hashed passwords, newest first.
+	// +optional
+	PasswordHistory []string `json:"passwordHistory,omitempty" protobuf:"bytes,8,rep,name=passwordHistory"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -307,6 +331,27 @@ type APIKeySpec struct {
 
 	// ExpireAt is the expire time for api key
 	ExpireAt metav1.Time `json:"expire_at,omitempty" protobuf:"bytes,4,opt,name=expire_at,json=expireAt"`
+
+	// Scope restricts the api key to a subset of the permissions of the user.
+	// +optional
+	Scope *APIKeyScope `json:"scope,omitempty" protobuf:"bytes,7,opt,name=scope"`
+}
+
+// APIKeyScope restricts what an api key is allowed to do on behalf of its user.
+// The effective permissions are the intersection of the scope and the
+// permissions of the user.
+type APIKeyScope struct {
+	// Statements is the subset of actions and resources granted to the api key.
+	// +optional
+	Statements []Statement `json:"statements,omitempty" protobuf:"bytes,1,rep,name=statements"`
+
+	// Projects limits the api key to the given projects.
+	// +optional
+	Projects []string `json:"projects,omitempty" protobuf:"bytes,2,rep,name=projects"`
+
+	// SourceCIDRs limits the source addresses the api key can be used from.
+	// +optional
+	SourceCIDRs []string `json:"sourceCIDRs,omitempty" protobuf:"bytes,3,rep,name=sourceCIDRs"`
 }
 
 // APIKeyStatus is a description of an api key status.
@@ -316,6 +361,21 @@ type APIKeyStatus struct {
 	Disabled bool `json:"disabled" protobuf:"varint,1,opt,name=disabled"`
 	// Expired represents whether the apikey has been expired.
 	Expired bool `json:"expired" protobuf:"varint,2,opt,name=expired"`
+	// LastUsedTime is the last time the api key was used to authenticate.
+	// +optional
+	LastUsedTime metav1.Time `json:"lastUsedTime,omitempty" protobuf:"bytes,3,opt,name=lastUsedTime"`
+	// LastSourceIP is the source address of the last request using the api key.
+	// +optional
+	LastSourceIP string `json:"lastSourceIP,omitempty" protobuf:"bytes,4,opt,name=lastSourceIP"`
+	// RequestCount is the number of requests authenticated by the api key.
+	// +optional
+	RequestCount int64 `json:"requestCount,omitempty" protobuf:"varint,5,opt,name=requestCount"`
+	// RotatedTo is the name of the api key replacing this one.
+	// +optional
+	RotatedTo string `json:"rotatedTo,omitempty" protobuf:"bytes,6,opt,name=rotatedTo"`
+	// RetireTime is the time after which a rotated api key is no longer accepted.
+	// +optional
+	RetireTime metav1.Time `json:"retireTime,omitempty" protobuf:"bytes,7,opt,name=retireTime"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -330,6 +390,25 @@ type APIKeyReq struct {
 
 	// Description describes api keys usage.
 	Description string `json:"description" protobuf:"bytes,3,opt,name=description"`
+
+	// Scope restricts the api key to a subset of the permissions of the user.
+	// +optional
+	Scope *APIKeyScope `json:"scope,omitempty" protobuf:"bytes,4,opt,name=scope"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// APIKeyRotateReq contains the parameters used to rotate an api key.
+type APIKeyRotateReq struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Expire holds the duration of the new api key become invalid. By default, the same as the rotated one.
+	// +optional
+	Expire metav1.Duration `json:"expire,omitempty" protobuf:"bytes,2,opt,name=expire"`
+
+	// Overlap holds the duration the rotated api key stays valid. By default, 24h.
+	// +optional
+	Overlap metav1.Duration `json:"overlap,omitempty" protobuf:"bytes,3,opt,name=overlap"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -354,6 +433,10 @@ type APIKeyReqPassword struct {
 	// Expire holds the duration of the api key become invalid. By default, 168h(= seven days)
 	// +optional
 	Expire metav1.Duration `json:"expire,omitempty" protobuf:"bytes,6,opt,name=expire"`
+
+	// Scope restricts the api key to a subset of the permissions of the user.
+	// +optional
+	Scope *APIKeyScope `json:"scope,omitempty" protobuf:"bytes,7,opt,name=scope"`
 }
 
 // +genclient
@@ -370,6 +453,26 @@ type APISigningKey struct {
 	SigningKey []byte `json:"signingKey,omitempty" protobuf:"bytes,2,opt,name=signingKey"`
 	// +optional
 	SigningKeyPub []byte `json:"signingKeyPub,omitempty" protobuf:"bytes,3,opt,name=signingKeyPub"`
+	// KeyID is the ID of the signing key, set as the kid header of the api
+	// keys signed with it.
+	// +optional
+	KeyID string `json:"keyID,omitempty" protobuf:"bytes,4,opt,name=keyID"`
+	// RotateTime is the time the signing key is generated.
+	// +optional
+	RotateTime metav1.Time `json:"rotateTime,omitempty" protobuf:"bytes,5,opt,name=rotateTime"`
+	// VerificationKeys are the public keys of the retired signing keys, kept
+	// until every api key signed with them has expired.
+	// +optional
+	VerificationKeys []VerificationKey `json:"verificationKeys,omitempty" protobuf:"bytes,6,rep,name=verificationKeys"`
+}
+
+// VerificationKey is the public key of a retired signing key.
+type VerificationKey struct {
+	KeyID     string `json:"keyID" protobuf:"bytes,1,opt,name=keyID"`
+	PublicKey []byte `json:"publicKey" protobuf:"bytes,2,opt,name=publicKey"`
+	// Expiry is the time the last api key signed with the key expires, the
+	// key is removed after it.
+	Expiry metav1.Time `json:"expiry" protobuf:"bytes,3,opt,name=expiry"`
 }
 
 // +genclient:nonNamespaced
@@ -623,6 +726,247 @@ type CustomPolicyBindingList struct {
 	Items []CustomPolicyBinding `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequest represents a request of a user for temporary permissions,
+// which are granted once approved and revoked automatically at expiry.
+type AccessRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   AccessRequestSpec   `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	Status AccessRequestStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// AccessRequestSpec describes the permissions requested.
+type AccessRequestSpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	// Username is the user the permissions are granted to, set to the requester.
+	Username string `json:"username" protobuf:"bytes,2,opt,name=username"`
+	// ProjectID is the project the permissions are requested in, the
+	// permissions are platform wide if empty.
+	// +optional
+	ProjectID string `json:"projectID,omitempty" protobuf:"bytes,3,opt,name=projectID"`
+	// Policies are the ids of the policies requested.
+	// +optional
+	Policies []string `json:"policies,omitempty" protobuf:"bytes,4,rep,name=policies"`
+	// RoleID is the id of a role whose policies are requested.
+	// +optional
+	RoleID string `json:"roleID,omitempty" protobuf:"bytes,5,opt,name=roleID"`
+	// Duration is how long the permissions are granted after approval.
+	Duration metav1.Duration `json:"duration" protobuf:"bytes,6,opt,name=duration"`
+	// Reason explains why the permissions are needed.
+	Reason string `json:"reason" protobuf:"bytes,7,opt,name=reason"`
+}
+
+// AccessRequestPhase defines the phase of access request.
+type AccessRequestPhase string
+
+const (
+	// AccessRequestPending means the request is waiting for approval.
+	AccessRequestPending AccessRequestPhase = "Pending"
+	// AccessRequestApproved means the permissions are granted.
+	AccessRequestApproved AccessRequestPhase = "Approved"
+	// AccessRequestRejected means the request is rejected by an approver.
+	AccessRequestRejected AccessRequestPhase = "Rejected"
+	// AccessRequestExpired means the granted permissions have been revoked.
+	AccessRequestExpired AccessRequestPhase = "Expired"
+)
+
+// AccessRequestStatus represents information about the status of an access request.
+type AccessRequestStatus struct {
+	// +optional
+	Phase AccessRequestPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=AccessRequestPhase"`
+	// Approvers are the users allowed to approve or reject the request.
+	// +optional
+	Approvers []string `json:"approvers,omitempty" protobuf:"bytes,2,rep,name=approvers"`
+	// Reviewer is the user who approved or rejected the request.
+	// +optional
+	Reviewer string `json:"reviewer,omitempty" protobuf:"bytes,3,opt,name=reviewer"`
+	// ReviewMessage is the comment of the reviewer.
+	// +optional
+	ReviewMessage string `json:"reviewMessage,omitempty" protobuf:"bytes,4,opt,name=reviewMessage"`
+	// ReviewTime is the time the request was approved or rejected.
+	// +optional
+	ReviewTime metav1.Time `json:"reviewTime,omitempty" protobuf:"bytes,5,opt,name=reviewTime"`
+	// ExpireTime is the time the granted permissions are revoked.
+	// +optional
+	ExpireTime metav1.Time `json:"expireTime,omitempty" protobuf:"bytes,6,opt,name=expireTime"`
+	// NotifyTime is the time the approvers were alerted.
+	// +optional
+	NotifyTime metav1.Time `json:"notifyTime,omitempty" protobuf:"bytes,7,opt,name=notifyTime"`
+	// Bindings are the bindings the user was added to for the request.
+	// +optional
+	Bindings []AccessRequestBinding `json:"bindings,omitempty" protobuf:"bytes,8,rep,name=bindings"`
+	// Message is a human readable message indicating details about the
+	// last failure of granting or revoking the permissions.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,9,opt,name=message"`
+}
+
+// AccessRequestBinding references a binding granting the requested permissions.
+type AccessRequestBinding struct {
+	// Kind is ProjectPolicyBinding or CustomPolicyBinding.
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	Name      string `json:"name" protobuf:"bytes,3,opt,name=name"`
+	// Created is true if the binding was created for the request rather
+	// than extended.
+	// +optional
+	Created bool `json:"created,omitempty" protobuf:"varint,4,opt,name=created"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequestList is the whole list of all access requests.
+type AccessRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// List of access requests.
+	Items []AccessRequest `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessRequestReview contains the comment used to approve or reject an access request.
+type AccessRequestReview struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Message is the comment of the reviewer.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,1,opt,name=message"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=update,patch,watch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessReview is a snapshot of the effective permissions of every user and
+// group in a tenant or a project, taken when it is created.
+type AccessReview struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   AccessReviewSpec   `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	Status AccessReviewStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// AccessReviewSpec describes the scope of an access review.
+type AccessReviewSpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	// ProjectID limits the review to the permissions in the project, the
+	// whole tenant is reviewed if empty.
+	// +optional
+	ProjectID string `json:"projectID,omitempty" protobuf:"bytes,2,opt,name=projectID"`
+}
+
+// AccessReviewStatus holds the permissions resolved by an access review.
+type AccessReviewStatus struct {
+	// Subjects are the users and groups with their effective permissions.
+	// +optional
+	Subjects []AccessReviewSubject `json:"subjects,omitempty" protobuf:"bytes,1,rep,name=subjects"`
+}
+
+// AccessReviewSubject is a user or a group with its effective permissions.
+type AccessReviewSubject struct {
+	// Kind is User or Group.
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	ID   string `json:"id" protobuf:"bytes,2,opt,name=id"`
+	Name string `json:"name" protobuf:"bytes,3,opt,name=name"`
+	// Groups are the groups the user belongs to.
+	// +optional
+	Groups []string `json:"groups,omitempty" protobuf:"bytes,4,rep,name=groups"`
+	// Members are the users of the group.
+	// +optional
+	Members []string `json:"members,omitempty" protobuf:"bytes,5,rep,name=members"`
+	// +optional
+	Permissions []AccessReviewPermission `json:"permissions,omitempty" protobuf:"bytes,6,rep,name=permissions"`
+}
+
+// AccessReviewPermission is a policy granted to a subject, together with the
+// actions it allows or denies.
+type AccessReviewPermission struct {
+	// Project is the project the policy is granted in, platform wide if empty.
+	// +optional
+	Project string `json:"project,omitempty" protobuf:"bytes,1,opt,name=project"`
+	Policy  string `json:"policy" protobuf:"bytes,2,opt,name=policy"`
+	// +optional
+	PolicyName string `json:"policyName,omitempty" protobuf:"bytes,3,opt,name=policyName"`
+	// Role is the role the policy is granted through.
+	// +optional
+	Role string `json:"role,omitempty" protobuf:"bytes,4,opt,name=role"`
+	// Group is the group the policy is inherited from.
+	// +optional
+	Group     string   `json:"group,omitempty" protobuf:"bytes,5,opt,name=group"`
+	Effect    Effect   `json:"effect" protobuf:"bytes,6,opt,name=effect,casttype=Effect"`
+	Resources []string `json:"resources" protobuf:"bytes,7,rep,name=resources"`
+	Actions   []string `json:"actions" protobuf:"bytes,8,rep,name=actions"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessReviewList is the whole list of all access reviews.
+type AccessReviewList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// List of access reviews.
+	Items []AccessReview `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessReviewExportOptions is the query options to export an access review.
+type AccessReviewExportOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Format is json or csv, json by default.
+	// +optional
+	Format string `json:"format,omitempty" protobuf:"bytes,1,opt,name=format"`
+}
+
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessReviewDiffOptions is the query options to compare two access reviews.
+type AccessReviewDiffOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Base is the name of the access review compared with.
+	Base string `json:"base" protobuf:"bytes,1,opt,name=base"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AccessReviewDiff lists the permissions granted or revoked between two
+// access reviews.
+type AccessReviewDiff struct {
+	metav1.TypeMeta `json:",inline"`
+
+	Base   string `json:"base" protobuf:"bytes,1,opt,name=base"`
+	Target string `json:"target" protobuf:"bytes,2,opt,name=target"`
+	// Added are the permissions in the target but not in the base.
+	// +optional
+	Added []AccessReviewEntry `json:"added,omitempty" protobuf:"bytes,3,rep,name=added"`
+	// Removed are the permissions in the base but not in the target.
+	// +optional
+	Removed []AccessReviewEntry `json:"removed,omitempty" protobuf:"bytes,4,rep,name=removed"`
+}
+
+// AccessReviewEntry is a permission of a subject, as a row of the exported
+// access review.
+type AccessReviewEntry struct {
+	SubjectKind string                 `json:"subjectKind" protobuf:"bytes,1,opt,name=subjectKind"`
+	Subject     string                 `json:"subject" protobuf:"bytes,2,opt,name=subject"`
+	Permission  AccessReviewPermission `json:"permission" protobuf:"bytes,3,opt,name=permission"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ProjectPolicyBindingRequest references the request to bind or unbind policies to the role.
@@ -848,6 +1192,12 @@ type SubjectAccessReviewSpec struct {
 	// UID information about the requesting user.
 	// +optional
 	UID string `json:"uid,omitempty" protobuf:"bytes,6,opt,name=uid"`
+
+	// SimulatedGroups are the ids of local groups the user is considered a
+	// member of while explaining the request, so that membership changes can
+	// be tested before they are applied.
+	// +optional
+	SimulatedGroups []string `json:"simulatedGroups,omitempty" protobuf:"bytes,11,rep,name=simulatedGroups"`
 }
 
 // ExtraValue masks the value so protobuf can generate
@@ -918,6 +1268,66 @@ type SubjectAccessReviewStatus struct {
 
 	// AllowedList is the allowed response for batch authorization request.
 	AllowedList []*AllowedStatus `json:"allowedList,omitempty" protobuf:"bytes,5,rep,name=allowedList"`
+
+	// Explanation describes how the decision is made, only set if explain is
+	// requested.
+	// +optional
+	Explanation *AuthorizationExplanation `json:"explanation,omitempty" protobuf:"bytes,6,opt,name=explanation"`
+}
+
+// AuthorizationExplanation describes how the decision of a subject access
+// review is made.
+type AuthorizationExplanation struct {
+	// Subject is the casbin subject of the user.
+	Subject string `json:"subject" protobuf:"bytes,1,opt,name=subject"`
+	// Tenant is the tenant of the user.
+	// +optional
+	Tenant string `json:"tenant,omitempty" protobuf:"bytes,2,opt,name=tenant"`
+	// Project is the project the request is made in.
+	// +optional
+	Project string `json:"project,omitempty" protobuf:"bytes,3,opt,name=project"`
+	// Action is the action being requested.
+	Action string `json:"action" protobuf:"bytes,4,opt,name=action"`
+	// Resource is the resource being requested.
+	Resource string `json:"resource" protobuf:"bytes,5,opt,name=resource"`
+	// Stage is the step of the authorization that made the decision.
+	Stage string `json:"stage" protobuf:"bytes,6,opt,name=stage"`
+	// Decision is the final decision, one of Allow, Deny or NoOpinion.
+	Decision string `json:"decision" protobuf:"bytes,7,opt,name=decision"`
+	// MatchedRules are all the rules matched by the request.
+	// +optional
+	MatchedRules []MatchedRule `json:"matchedRules,omitempty" protobuf:"bytes,8,rep,name=matchedRules"`
+}
+
+// MatchedRule describes a casbin rule matched by a subject access review.
+type MatchedRule struct {
+	// Rule is the name of the rule object.
+	// +optional
+	Rule string `json:"rule,omitempty" protobuf:"bytes,1,opt,name=rule"`
+	// Kind is the kind of the object the rule belongs to, Policy or Role.
+	// +optional
+	Kind string `json:"kind,omitempty" protobuf:"bytes,2,opt,name=kind"`
+	// Name is the name of the policy or role the rule belongs to.
+	Name string `json:"name" protobuf:"bytes,3,opt,name=name"`
+	// Binding is the name of the ProjectPolicyBinding granting the rule.
+	// +optional
+	Binding string `json:"binding,omitempty" protobuf:"bytes,4,opt,name=binding"`
+	// Project is the project the rule is granted in, empty if it is granted
+	// for the whole platform.
+	// +optional
+	Project string `json:"project,omitempty" protobuf:"bytes,5,opt,name=project"`
+	// Via is the user or group the rule is granted to.
+	Via string `json:"via" protobuf:"bytes,6,opt,name=via"`
+	// Resource is the resource pattern of the rule.
+	Resource string `json:"resource" protobuf:"bytes,7,opt,name=resource"`
+	// Action is the action pattern of the rule.
+	Action string `json:"action" protobuf:"bytes,8,opt,name=action"`
+	// Effect is the effect of the rule.
+	Effect Effect `json:"effect" protobuf:"bytes,9,opt,name=effect,casttype=Effect"`
+	// FailedCondition describes the condition of the policy not met by the
+	// request, the rule takes no effect if it is set.
+	// +optional
+	FailedCondition string `json:"failedCondition,omitempty" protobuf:"bytes,10,opt,name=failedCondition"`
 }
 
 // AllowedStatus includes the resource access request and response.
@@ -1064,3 +1474,222 @@ type ConfigMapList struct {
 	// Items is the list of ConfigMaps.
 	Items []ConfigMap `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=create,update,patch,watch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Session is a login session of a user, backed by the refresh token issued to
+// the client. Deleting a session revokes it.
+type Session struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   SessionSpec   `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	Status SessionStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// SessionSpec describes the attributes of a session.
+type SessionSpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	Username string `json:"username" protobuf:"bytes,2,opt,name=username"`
+	UserID   string `json:"userID" protobuf:"bytes,3,opt,name=userID"`
+	ClientID string `json:"clientID" protobuf:"bytes,4,opt,name=clientID"`
+	// SessionID identifies the session in the ID tokens issued for it, it is
+	// empty if the session is not logged in through the gateway.
+	// +optional
+	SessionID string `json:"sessionID,omitempty" protobuf:"bytes,5,opt,name=sessionID"`
+	// ClientIP is the address the user logged in from.
+	// +optional
+	ClientIP string `json:"clientIP,omitempty" protobuf:"bytes,6,opt,name=clientIP"`
+}
+
+// SessionStatus represents information about the usage of a session.
+type SessionStatus struct {
+	IssuedTime metav1.Time `json:"issuedTime" protobuf:"bytes,1,opt,name=issuedTime"`
+	// LastUsedTime is the last time the session is refreshed.
+	// +optional
+	LastUsedTime metav1.Time `json:"lastUsedTime,omitempty" protobuf:"bytes,2,opt,name=lastUsedTime"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SessionList is the whole list of all sessions.
+type SessionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// List of sessions.
+	Items []Session `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=update,patch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SessionRevocation records the ID tokens revoked before they expire.
+type SessionRevocation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec SessionRevocationSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// SessionRevocationSpec describes the ID tokens revoked.
+type SessionRevocationSpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	Username string `json:"username" protobuf:"bytes,2,opt,name=username"`
+	// SessionID is the revoked session, all sessions of the user issued
+	// before the revoke time are revoked if empty.
+	// +optional
+	SessionID  string      `json:"sessionID,omitempty" protobuf:"bytes,3,opt,name=sessionID"`
+	RevokeTime metav1.Time `json:"revokeTime" protobuf:"bytes,4,opt,name=revokeTime"`
+	// ExpireTime is the time the last revoked ID token expires, the
+	// revocation is removed after it.
+	ExpireTime metav1.Time `json:"expireTime" protobuf:"bytes,5,opt,name=expireTime"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SessionRevocationList is the whole list of all session revocations.
+type SessionRevocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// List of session revocations.
+	Items []SessionRevocation `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Tenant is a tenant of TKE, its name is the tenant id the objects of every
+// API group belong to.
+type Tenant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec TenantSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status TenantStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// TenantSpec is a description of a tenant.
+type TenantSpec struct {
+	Finalizers []FinalizerName `json:"finalizers,omitempty" protobuf:"bytes,1,rep,name=finalizers,casttype=FinalizerName"`
+
+	DisplayName string `json:"displayName" protobuf:"bytes,2,opt,name=displayName"`
+	// IdentityProviderType is the type of the default identity provider
+	// created with the tenant, tke if empty.
+	// +optional
+	IdentityProviderType string `json:"identityProviderType,omitempty" protobuf:"bytes,3,opt,name=identityProviderType"`
+	// IdentityProviderConfig is the json configuration of the default
+	// identity provider.
+	// +optional
+	IdentityProviderConfig string `json:"identityProviderConfig,omitempty" protobuf:"bytes,4,opt,name=identityProviderConfig"`
+	// Administrators are the administrators of the default identity provider.
+	// +optional
+	Administrators []string `json:"administrators,omitempty" protobuf:"bytes,5,rep,name=administrators"`
+	// Quota limits the number of objects the tenant may own.
+	// +optional
+	Quota TenantQuota `json:"quota,omitempty" protobuf:"bytes,6,opt,name=quota"`
+	// Suspended denies every request of the users of the tenant.
+	// +optional
+	Suspended bool `json:"suspended,omitempty" protobuf:"varint,7,opt,name=suspended"`
+}
+
+// TenantQuota limits the number of objects a tenant may own, nil means
+// unlimited.
+type TenantQuota struct {
+	// +optional
+	Clusters *int32 `json:"clusters,omitempty" protobuf:"varint,1,opt,name=clusters"`
+	// +optional
+	Projects *int32 `json:"projects,omitempty" protobuf:"varint,2,opt,name=projects"`
+	// +optional
+	Registries *int32 `json:"registries,omitempty" protobuf:"varint,3,opt,name=registries"`
+	// +optional
+	Apps *int32 `json:"apps,omitempty" protobuf:"varint,4,opt,name=apps"`
+}
+
+// TenantUsage is the number of objects a tenant owns.
+type TenantUsage struct {
+	Clusters   int32 `json:"clusters" protobuf:"varint,1,opt,name=clusters"`
+	Projects   int32 `json:"projects" protobuf:"varint,2,opt,name=projects"`
+	Registries int32 `json:"registries" protobuf:"varint,3,opt,name=registries"`
+	Apps       int32 `json:"apps" protobuf:"varint,4,opt,name=apps"`
+}
+
+// TenantPhase defines the phase of tenant.
+type TenantPhase string
+
+const (
+	// TenantInitializing means the default identity provider of the tenant
+	// is being created.
+	TenantInitializing TenantPhase = "Initializing"
+	// TenantActive is the normal phase.
+	TenantActive TenantPhase = "Active"
+	// TenantSuspended means the requests of the tenant are denied.
+	TenantSuspended TenantPhase = "Suspended"
+	// TenantTerminating means the tenant is undergoing graceful termination.
+	TenantTerminating TenantPhase = "Terminating"
+)
+
+// TenantStatus represents information about the status of a tenant.
+type TenantStatus struct {
+	// +optional
+	Phase TenantPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=TenantPhase"`
+	// Used is the number of objects the tenant owns, refreshed periodically.
+	// +optional
+	Used TenantUsage `json:"used,omitempty" protobuf:"bytes,2,opt,name=used"`
+	// LastUsageTime is the last time the usage is refreshed.
+	// +optional
+	LastUsageTime metav1.Time `json:"lastUsageTime,omitempty" protobuf:"bytes,3,opt,name=lastUsageTime"`
+	// Deletion is the progress of the deletion per API group.
+	// +optional
+	Deletion []TenantDeletionStatus `json:"deletion,omitempty" protobuf:"bytes,4,rep,name=deletion"`
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+}
+
+// TenantDeletionPhase defines the phase of the deletion of the objects of a
+// tenant in an API group.
+type TenantDeletionPhase string
+
+const (
+	// TenantDeletionDeleting means the objects are being deleted.
+	TenantDeletionDeleting TenantDeletionPhase = "Deleting"
+	// TenantDeletionCompleted means all objects are deleted.
+	TenantDeletionCompleted TenantDeletionPhase = "Completed"
+	// TenantDeletionFailed means the objects failed to be deleted, it is
+	// retried.
+	TenantDeletionFailed TenantDeletionPhase = "Failed"
+	// TenantDeletionSkipped means the API group is not available.
+	TenantDeletionSkipped TenantDeletionPhase = "Skipped"
+)
+
+// TenantDeletionStatus is the progress of the deletion of the objects of a
+// tenant in an API group.
+type TenantDeletionStatus struct {
+	Group string              `json:"group" protobuf:"bytes,1,opt,name=group"`
+	Phase TenantDeletionPhase `json:"phase" protobuf:"bytes,2,opt,name=phase,casttype=TenantDeletionPhase"`
+	// Remaining is the number of objects not deleted yet.
+	// +optional
+	Remaining int32 `json:"remaining,omitempty" protobuf:"varint,3,opt,name=remaining"`
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TenantList is the whole list of all tenants.
+type TenantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// List of tenants.
+	Items []Tenant `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
@@ -51,6 +51,7 @@ var map_APIKeyReq = map[string]string{
 	"":            "APIKeyReq contains expiration time used to apply the api key.",
 	"expire":      "Expire is required, holds the duration of the api key become invalid. By default, 168h(= seven days)",
 	"description": "Description describes api keys usage.",
+	"scope":       "Scope restricts the api key to a subset of the permissions of the user.",
 }
 
 func (APIKeyReq) SwaggerDoc() map[string]string {
@@ -64,12 +65,34 @@ var map_APIKeyReqPassword = map[string]string{
 	"password":    "Password (encoded by base64)",
 	"description": "Description describes api keys usage.",
 	"expire":      "Expire holds the duration of the api key become invalid. By default, 168h(= seven days)",
+	"scope":       "Scope restricts the api key to a subset of the permissions of the user.",
 }
 
 func (APIKeyReqPassword) SwaggerDoc() map[string]string {
 	return map_APIKeyReqPassword
 }
 
+var map_APIKeyRotateReq = map[string]string{
+	"":        "APIKeyRotateReq contains the parameters used to rotate an api key.",
+	"expire":  "Expire holds the duration of the new api key become invalid. By default, the same as the rotated one.",
+	"overlap": "Overlap holds the duration the rotated api key stays valid. By default, 24h.",
+}
+
+func (APIKeyRotateReq) SwaggerDoc() map[string]string {
+	return map_APIKeyRotateReq
+}
+
+var map_APIKeyScope = map[string]string{
+	"":            "APIKeyScope restricts what an api key is allowed to do on behalf of its user. The effective permissions are the intersection of the scope and the permissions of the user.",
+	"statements":  "Statements is the subset of actions and resources granted to the api key.",
+	"projects":    "Projects limits the api key to the given projects.",
+	"sourceCIDRs": "SourceCIDRs limits the source addresses the api key can be used from.",
+}
+
+func (APIKeyScope) SwaggerDoc() map[string]string {
+	return map_APIKeyScope
+}
+
 var map_APIKeySpec = map[string]string{
 	"":            "APIKeySpec is a description of an apiKey.",
 	"apiKey":      "APIkey is the jwt token used to authenticate user, and contains user info and sign.",
@@ -77,6 +100,7 @@ var map_APIKeySpec = map[string]string{
 	"description": "Description describes api keys usage.",
 	"issue_at":    "IssueAt is the created time for api key",
 	"expire_at":   "ExpireAt is the expire time for api key",
+	"scope":       "Scope restricts the api key to a subset of the permissions of the user.",
 }
 
 func (APIKeySpec) SwaggerDoc() map[string]string {
@@ -84,9 +108,14 @@ func (APIKeySpec) SwaggerDoc() map[string]string {
 }
 
 var map_APIKeyStatus = map[string]string{
-	"":         "APIKeyStatus is a description of an api key status.",
-	"disabled": "Disabled represents whether the apikey has been disabled.",
-	"expired":  "Expired represents whether the apikey has been expired.",
+	"":             "APIKeyStatus is a description of an api key status.",
+	"disabled":     "Disabled represents whether the apikey has been disabled.",
+	"expired":      "Expired represents whether the apikey has been expired.",
+	"lastUsedTime": "LastUsedTime is the last time the api key was used to authenticate.",
+	"lastSourceIP": "LastSourceIP is the source address of the last request using the api key.",
+	"requestCount": "RequestCount is the number of requests authenticated by the api key.",
+	"rotatedTo":    "RotatedTo is the name of the api key replacing this one.",
+	"retireTime":   "RetireTime is the time after which a rotated api key is no longer accepted.",
 }
 
 func (APIKeyStatus) SwaggerDoc() map[string]string {
@@ -94,7 +123,10 @@ func (APIKeyStatus) SwaggerDoc() map[string]string {
 }
 
 var map_APISigningKey = map[string]string{
-	"": "APISigningKey hold encryption and signing key.",
+	"":                 "APISigningKey hold encryption and signing key.",
+	"keyID":            "KeyID is the ID of the signing key, set as the kid header of the api keys signed with it.",
+	"rotateTime":       "RotateTime is the time the signing key is generated.",
+	"verificationKeys": "VerificationKeys are the public keys of the retired signing keys, kept until every api key signed with them has expired.",
 }
 
 func (APISigningKey) SwaggerDoc() map[string]string {
@@ -110,6 +142,165 @@ func (APISigningKeyList) SwaggerDoc() map[string]string {
 	return map_APISigningKeyList
 }
 
+var map_AccessRequest = map[string]string{
+	"": "AccessRequest represents a request of a user for temporary permissions, which are granted once approved and revoked automatically at expiry.",
+}
+
+func (AccessRequest) SwaggerDoc() map[string]string {
+	return map_AccessRequest
+}
+
+var map_AccessRequestBinding = map[string]string{
+	"":        "AccessRequestBinding references a binding granting the requested permissions.",
+	"kind":    "Kind is ProjectPolicyBinding or CustomPolicyBinding.",
+	"created": "Created is true if the binding was created for the request rather than extended.",
+}
+
+func (AccessRequestBinding) SwaggerDoc() map[string]string {
+	return map_AccessRequestBinding
+}
+
+var map_AccessRequestList = map[string]string{
+	"":      "AccessRequestList is the whole list of all access requests.",
+	"items": "List of access requests.",
+}
+
+func (AccessRequestList) SwaggerDoc() map[string]string {
+	return map_AccessRequestList
+}
+
+var map_AccessRequestReview = map[string]string{
+	"":        "AccessRequestReview contains the comment used to approve or reject an access request.",
+	"message": "Message is the comment of the reviewer.",
+}
+
+func (AccessRequestReview) SwaggerDoc() map[string]string {
+	return map_AccessRequestReview
+}
+
+var map_AccessRequestSpec = map[string]string{
+	"":          "AccessRequestSpec describes the permissions requested.",
+	"username":  "Username is the user the permissions are granted to, set to the requester.",
+	"projectID": "ProjectID is the project the permissions are requested in, the permissions are platform wide if empty.",
+	"policies":  "Policies are the ids of the policies requested.",
+	"roleID":    "RoleID is the id of a role whose policies are requested.",
+	"duration":  "Duration is how long the permissions are granted after approval.",
+	"reason":    "Reason explains why the permissions are needed.",
+}
+
+func (AccessRequestSpec) SwaggerDoc() map[string]string {
+	return map_AccessRequestSpec
+}
+
+var map_AccessRequestStatus = map[string]string{
+	"":              "AccessRequestStatus represents information about the status of an access request.",
+	"approvers":     "Approvers are the users allowed to approve or reject the request.",
+	"reviewer":      "Reviewer is the user who approved or rejected the request.",
+	"reviewMessage": "ReviewMessage is the comment of the reviewer.",
+	"reviewTime":    "ReviewTime is the time the request was approved or rejected.",
+	"expireTime":    "ExpireTime is the time the granted permissions are revoked.",
+	"notifyTime":    "NotifyTime is the time the approvers were alerted.",
+	"bindings":      "Bindings are the bindings the user was added to for the request.",
+	"message":       "Message is a human readable message indicating details about the last failure of granting or revoking the permissions.",
+}
+
+func (AccessRequestStatus) SwaggerDoc() map[string]string {
+	return map_AccessRequestStatus
+}
+
+var map_AccessReview = map[string]string{
+	"": "AccessReview is a snapshot of the effective permissions of every user and group in a tenant or a project, taken when it is created.",
+}
+
+func (AccessReview) SwaggerDoc() map[string]string {
+	return map_AccessReview
+}
+
+var map_AccessReviewDiff = map[string]string{
+	"":        "AccessReviewDiff lists the permissions granted or revoked between two access reviews.",
+	"added":   "Added are the permissions in the target but not in the base.",
+	"removed": "Removed are the permissions in the base but not in the target.",
+}
+
+func (AccessReviewDiff) SwaggerDoc() map[string]string {
+	return map_AccessReviewDiff
+}
+
+var map_AccessReviewDiffOptions = map[string]string{
+	"":     "AccessReviewDiffOptions is the query options to compare two access reviews.",
+	"base": "Base is the name of the access review compared with.",
+}
+
+func (AccessReviewDiffOptions) SwaggerDoc() map[string]string {
+	return map_AccessReviewDiffOptions
+}
+
+var map_AccessReviewEntry = map[string]string{
+	"": "AccessReviewEntry is a permission of a subject, as a row of the exported access review.",
+}
+
+func (AccessReviewEntry) SwaggerDoc() map[string]string {
+	return map_AccessReviewEntry
+}
+
+var map_AccessReviewExportOptions = map[string]string{
+	"":       "AccessReviewExportOptions is the query options to export an access review.",
+	"format": "Format is json or csv, json by default.",
+}
+
+func (AccessReviewExportOptions) SwaggerDoc() map[string]string {
+	return map_AccessReviewExportOptions
+}
+
+var map_AccessReviewList = map[string]string{
+	"":      "AccessReviewList is the whole list of all access reviews.",
+	"items": "List of access reviews.",
+}
+
+func (AccessReviewList) SwaggerDoc() map[string]string {
+	return map_AccessReviewList
+}
+
+var map_AccessReviewPermission = map[string]string{
+	"":        "AccessReviewPermission is a policy granted to a subject, together with the actions it allows or denies.",
+	"project": "Project is the project the policy is granted in, platform wide if empty.",
+	"role":    "Role is the role the policy is granted through.",
+	"group":   "Group is the group the policy is inherited from.",
+}
+
+func (AccessReviewPermission) SwaggerDoc() map[string]string {
+	return map_AccessReviewPermission
+}
+
+var map_AccessReviewSpec = map[string]string{
+	"":          "AccessReviewSpec describes the scope of an access review.",
+	"projectID": "ProjectID limits the review to the permissions in the project, the whole tenant is reviewed if empty.",
+}
+
+func (AccessReviewSpec) SwaggerDoc() map[string]string {
+	return map_AccessReviewSpec
+}
+
+var map_AccessReviewStatus = map[string]string{
+	"":         "AccessReviewStatus holds the permissions resolved by an access review.",
+	"subjects": "Subjects are the users and groups with their effective permissions.",
+}
+
+func (AccessReviewStatus) SwaggerDoc() map[string]string {
+	return map_AccessReviewStatus
+}
+
+var map_AccessReviewSubject = map[string]string{
+	"":        "AccessReviewSubject is a user or a group with its effective permissions.",
+	"kind":    "Kind is User or Group.",
+	"groups":  "Groups are the groups the user belongs to.",
+	"members": "Members are the users of the group.",
+}
+
+func (AccessReviewSubject) SwaggerDoc() map[string]string {
+	return map_AccessReviewSubject
+}
+
 var map_Action = map[string]string{
 	"":            "Action defines a action verb for authorization.",
 	"name":        "Name represents user access review request verb.",
@@ -134,6 +325,22 @@ func (AllowedStatus) SwaggerDoc() map[string]string {
 	return map_AllowedStatus
 }
 
+var map_AuthorizationExplanation = map[string]string{
+	"":             "AuthorizationExplanation describes how the decision of a subject access review is made.",
+	"subject":      "Subject is the casbin subject of the user.",
+	"tenant":       "Tenant is the tenant of the user.",
+	"project":      "Project is the project the request is made in.",
+	"action":       "Action is the action being requested.",
+	"resource":     "Resource is the resource being requested.",
+	"stage":        "Stage is the step of the authorization that made the decision.",
+	"decision":     "Decision is the final decision, one of Allow, Deny or NoOpinion.",
+	"matchedRules": "MatchedRules are all the rules matched by the request.",
+}
+
+func (AuthorizationExplanation) SwaggerDoc() map[string]string {
+	return map_AuthorizationExplanation
+}
+
 var map_Binding = map[string]string{
 	"":       "Binding is used to bind or unbind the subjects to or from the policy,role or group.",
 	"users":  "Users holds references to the objects the policy applies to.",
@@ -378,14 +585,37 @@ func (LocalIdentitySpec) SwaggerDoc() map[string]string {
 }
 
 var map_LocalIdentityStatus = map[string]string{
-	"":               "LocalIdentityStatus is a description of an identity status.",
-	"lastUpdateTime": "The last time the local identity was updated.",
+	"":                    "LocalIdentityStatus is a description of an identity status.",
+	"lastUpdateTime":      "The last time the local identity was updated.",
+	"failedLoginAttempts": "FailedLoginAttempts is the number of consecutive failed logins.",
+	"lastFailedLoginTime": "The last time a login of the local identity failed.",
+	"lockedUntil":         "LockedUntil is the time until which the local identity is temporarily locked because of too many failed logins.",
+	"passwordUpdateTime":  "The last time the password of the local identity was changed.",
+	"passwordHistory":     "PasswordHistory holds the previous hashed passwords, newest first.",
 }
 
 func (LocalIdentityStatus) SwaggerDoc() map[string]string {
 	return map_LocalIdentityStatus
 }
 
+var map_MatchedRule = map[string]string{
+	"":                "MatchedRule describes a casbin rule matched by a subject access review.",
+	"rule":            "Rule is the name of the rule object.",
+	"kind":            "Kind is the kind of the object the rule belongs to, Policy or Role.",
+	"name":            "Name is the name of the policy or role the rule belongs to.",
+	"binding":         "Binding is the name of the ProjectPolicyBinding granting the rule.",
+	"project":         "Project is the project the rule is granted in, empty if it is granted for the whole platform.",
+	"via":             "Via is the user or group the rule is granted to.",
+	"resource":        "Resource is the resource pattern of the rule.",
+	"action":          "Action is the action pattern of the rule.",
+	"effect":          "Effect is the effect of the rule.",
+	"failedCondition": "FailedCondition describes the condition of the policy not met by the request, the rule takes no effect if it is set.",
+}
+
+func (MatchedRule) SwaggerDoc() map[string]string {
+	return map_MatchedRule
+}
+
 var map_NonResourceAttributes = map[string]string{
 	"":     "NonResourceAttributes includes the authorization attributes available for non-resource requests to the Authorizer interface",
 	"path": "Path is the URL path of the request",
@@ -595,6 +825,69 @@ func (RuleSpec) SwaggerDoc() map[string]string {
 	return map_RuleSpec
 }
 
+var map_Session = map[string]string{
+	"": "Session is a login session of a user, backed by the refresh token issued to the client. Deleting a session revokes it.",
+}
+
+func (Session) SwaggerDoc() map[string]string {
+	return map_Session
+}
+
+var map_SessionList = map[string]string{
+	"":      "SessionList is the whole list of all sessions.",
+	"items": "List of sessions.",
+}
+
+func (SessionList) SwaggerDoc() map[string]string {
+	return map_SessionList
+}
+
+var map_SessionRevocation = map[string]string{
+	"": "SessionRevocation records the ID tokens revoked before they expire.",
+}
+
+func (SessionRevocation) SwaggerDoc() map[string]string {
+	return map_SessionRevocation
+}
+
+var map_SessionRevocationList = map[string]string{
+	"":      "SessionRevocationList is the whole list of all session revocations.",
+	"items": "List of session revocations.",
+}
+
+func (SessionRevocationList) SwaggerDoc() map[string]string {
+	return map_SessionRevocationList
+}
+
+var map_SessionRevocationSpec = map[string]string{
+	"":           "SessionRevocationSpec describes the ID tokens revoked.",
+	"sessionID":  "SessionID is the revoked session, all sessions of the user issued before the revoke time are revoked if empty.",
+	"expireTime": "ExpireTime is the time the last revoked ID token expires, the revocation is removed after it.",
+}
+
+func (SessionRevocationSpec) SwaggerDoc() map[string]string {
+	return map_SessionRevocationSpec
+}
+
+var map_SessionSpec = map[string]string{
+	"":          "SessionSpec describes the attributes of a session.",
+	"sessionID": "SessionID identifies the session in the ID tokens issued for it, it is empty if the session is not logged in through the gateway.",
+	"clientIP":  "ClientIP is the address the user logged in from.",
+}
+
+func (SessionSpec) SwaggerDoc() map[string]string {
+	return map_SessionSpec
+}
+
+var map_SessionStatus = map[string]string{
+	"":             "SessionStatus represents information about the usage of a session.",
+	"lastUsedTime": "LastUsedTime is the last time the session is refreshed.",
+}
+
+func (SessionStatus) SwaggerDoc() map[string]string {
+	return map_SessionStatus
+}
+
 var map_Statement = map[string]string{
 	"":       "Statement defines a series of action on resource can be done or not.",
 	"effect": "Effect indicates action on the resource is allowed or not, can be \"allow\" or \"deny\"",
@@ -631,6 +924,7 @@ var map_SubjectAccessReviewSpec = map[string]string{
 	"group":                  "Groups is the groups you're testing for.",
 	"extra":                  "Extra corresponds to the user.Info.GetExtra() method from the authenticator.  Since that is input to the authorizer it needs a reflection here.",
 	"uid":                    "UID information about the requesting user.",
+	"simulatedGroups":        "SimulatedGroups are the ids of local groups the user is considered a member of while explaining the request, so that membership changes can be tested before they are applied.",
 }
 
 func (SubjectAccessReviewSpec) SwaggerDoc() map[string]string {
@@ -644,12 +938,79 @@ var map_SubjectAccessReviewStatus = map[string]string{
 	"reason":          "Reason is optional.  It indicates why a request was allowed or denied.",
 	"evaluationError": "EvaluationError is an indication that some error occurred during the authorization check. It is entirely possible to get an error and be able to continue determine authorization status in spite of it. For instance, RBAC can be missing a role, but enough roles are still present and bound to reason about the request.",
 	"allowedList":     "AllowedList is the allowed response for batch authorization request.",
+	"explanation":     "Explanation describes how the decision is made, only set if explain is requested.",
 }
 
 func (SubjectAccessReviewStatus) SwaggerDoc() map[string]string {
 	return map_SubjectAccessReviewStatus
 }
 
+var map_Tenant = map[string]string{
+	"": "Tenant is a tenant of TKE, its name is the tenant id the objects of every API group belong to.",
+}
+
+func (Tenant) SwaggerDoc() map[string]string {
+	return map_Tenant
+}
+
+var map_TenantDeletionStatus = map[string]string{
+	"":          "TenantDeletionStatus is the progress of the deletion of the objects of a tenant in an API group.",
+	"remaining": "Remaining is the number of objects not deleted yet.",
+}
+
+func (TenantDeletionStatus) SwaggerDoc() map[string]string {
+	return map_TenantDeletionStatus
+}
+
+var map_TenantList = map[string]string{
+	"":      "TenantList is the whole list of all tenants.",
+	"items": "List of tenants.",
+}
+
+func (TenantList) SwaggerDoc() map[string]string {
+	return map_TenantList
+}
+
+var map_TenantQuota = map[string]string{
+	"": "TenantQuota limits the number of objects a tenant may own, nil means unlimited.",
+}
+
+func (TenantQuota) SwaggerDoc() map[string]string {
+	return map_TenantQuota
+}
+
+var map_TenantSpec = map[string]string{
+	"":                       "TenantSpec is a description of a tenant.",
+	"identityProviderType":   "IdentityProviderType is the type of the default identity provider created with the tenant, tke if empty.",
+	"identityProviderConfig": "IdentityProviderConfig is the json configuration of the default identity provider.",
+	"administrators":         "Administrators are the administrators of the default identity provider.",
+	"quota":                  "Quota limits the number of objects the tenant may own.",
+	"suspended":              "Suspended denies every request of the users of the tenant.",
+}
+
+func (TenantSpec) SwaggerDoc() map[string]string {
+	return map_TenantSpec
+}
+
+var map_TenantStatus = map[string]string{
+	"":              "TenantStatus represents information about the status of a tenant.",
+	"used":          "Used is the number of objects the tenant owns, refreshed periodically.",
+	"lastUsageTime": "LastUsageTime is the last time the usage is refreshed.",
+	"deletion":      "Deletion is the progress of the deletion per API group.",
+}
+
+func (TenantStatus) SwaggerDoc() map[string]string {
+	return map_TenantStatus
+}
+
+var map_TenantUsage = map[string]string{
+	"": "TenantUsage is the number of objects a tenant owns.",
+}
+
+func (TenantUsage) SwaggerDoc() map[string]string {
+	return map_TenantUsage
+}
+
 var map_User = map[string]string{
 	"":     "User is an object that contains the metadata about identify about tke local idp or third-party idp.",
 	"spec": "Spec defines the desired identities of identity in this set.",
@@ -677,4 +1038,13 @@ func (UserSpec) SwaggerDoc() map[string]string {
 	return map_UserSpec
 }
 
+var map_VerificationKey = map[string]string{
+	"":       "VerificationKey is the public key of a retired signing key.",
+	"expiry": "Expiry is the time the last api key signed with the key expires, the key is removed after it.",
+}
+
+func (VerificationKey) SwaggerDoc() map[string]string {
+	return map_VerificationKey
+}
+
 // AUTO-GENERATED FUNCTIONS END HERE
@@ -23,6 +23,7 @@
 package v1
 
 import (
+	url "net/url"
 	unsafe "unsafe"
 
 	conversion "k8s.io/apimachinery/pkg/conversion"
@@ -77,6 +78,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*APIKeyRotateReq)(nil), (*auth.APIKeyRotateReq)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_APIKeyRotateReq_To_auth_APIKeyRotateReq(a.(*APIKeyRotateReq), b.(*auth.APIKeyRotateReq), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.APIKeyRotateReq)(nil), (*APIKeyRotateReq)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_APIKeyRotateReq_To_v1_APIKeyRotateReq(a.(*auth.APIKeyRotateReq), b.(*APIKeyRotateReq), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*APIKeyScope)(nil), (*auth.APIKeyScope)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_APIKeyScope_To_auth_APIKeyScope(a.(*APIKeyScope), b.(*auth.APIKeyScope), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.APIKeyScope)(nil), (*APIKeyScope)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_APIKeyScope_To_v1_APIKeyScope(a.(*auth.APIKeyScope), b.(*APIKeyScope), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*APIKeySpec)(nil), (*auth.APIKeySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_APIKeySpec_To_auth_APIKeySpec(a.(*APIKeySpec), b.(*auth.APIKeySpec), scope)
 	}); err != nil {
@@ -117,6 +138,166 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequest)(nil), (*auth.AccessRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessRequest_To_auth_AccessRequest(a.(*AccessRequest), b.(*auth.AccessRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessRequest)(nil), (*AccessRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessRequest_To_v1_AccessRequest(a.(*auth.AccessRequest), b.(*AccessRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestBinding)(nil), (*auth.AccessRequestBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessRequestBinding_To_auth_AccessRequestBinding(a.(*AccessRequestBinding), b.(*auth.AccessRequestBinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessRequestBinding)(nil), (*AccessRequestBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessRequestBinding_To_v1_AccessRequestBinding(a.(*auth.AccessRequestBinding), b.(*AccessRequestBinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestList)(nil), (*auth.AccessRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessRequestList_To_auth_AccessRequestList(a.(*AccessRequestList), b.(*auth.AccessRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessRequestList)(nil), (*AccessRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessRequestList_To_v1_AccessRequestList(a.(*auth.AccessRequestList), b.(*AccessRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestReview)(nil), (*auth.AccessRequestReview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessRequestReview_To_auth_AccessRequestReview(a.(*AccessRequestReview), b.(*auth.AccessRequestReview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessRequestReview)(nil), (*AccessRequestReview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessRequestReview_To_v1_AccessRequestReview(a.(*auth.AccessRequestReview), b.(*AccessRequestReview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestSpec)(nil), (*auth.AccessRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessRequestSpec_To_auth_AccessRequestSpec(a.(*AccessRequestSpec), b.(*auth.AccessRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessRequestSpec)(nil), (*AccessRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessRequestSpec_To_v1_AccessRequestSpec(a.(*auth.AccessRequestSpec), b.(*AccessRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessRequestStatus)(nil), (*auth.AccessRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessRequestStatus_To_auth_AccessRequestStatus(a.(*AccessRequestStatus), b.(*auth.AccessRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessRequestStatus)(nil), (*AccessRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessRequestStatus_To_v1_AccessRequestStatus(a.(*auth.AccessRequestStatus), b.(*AccessRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessReview)(nil), (*auth.AccessReview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessReview_To_auth_AccessReview(a.(*AccessReview), b.(*auth.AccessReview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessReview)(nil), (*AccessReview)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessReview_To_v1_AccessReview(a.(*auth.AccessReview), b.(*AccessReview), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessReviewDiff)(nil), (*auth.AccessReviewDiff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessReviewDiff_To_auth_AccessReviewDiff(a.(*AccessReviewDiff), b.(*auth.AccessReviewDiff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessReviewDiff)(nil), (*AccessReviewDiff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessReviewDiff_To_v1_AccessReviewDiff(a.(*auth.AccessReviewDiff), b.(*AccessReviewDiff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessReviewDiffOptions)(nil), (*auth.AccessReviewDiffOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessReviewDiffOptions_To_auth_AccessReviewDiffOptions(a.(*AccessReviewDiffOptions), b.(*auth.AccessReviewDiffOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessReviewDiffOptions)(nil), (*AccessReviewDiffOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessReviewDiffOptions_To_v1_AccessReviewDiffOptions(a.(*auth.AccessReviewDiffOptions), b.(*AccessReviewDiffOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessReviewEntry)(nil), (*auth.AccessReviewEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessReviewEntry_To_auth_AccessReviewEntry(a.(*AccessReviewEntry), b.(*auth.AccessReviewEntry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessReviewEntry)(nil), (*AccessReviewEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessReviewEntry_To_v1_AccessReviewEntry(a.(*auth.AccessReviewEntry), b.(*AccessReviewEntry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessReviewExportOptions)(nil), (*auth.AccessReviewExportOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessReviewExportOptions_To_auth_AccessReviewExportOptions(a.(*AccessReviewExportOptions), b.(*auth.AccessReviewExportOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessReviewExportOptions)(nil), (*AccessReviewExportOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessReviewExportOptions_To_v1_AccessReviewExportOptions(a.(*auth.AccessReviewExportOptions), b.(*AccessReviewExportOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessReviewList)(nil), (*auth.AccessReviewList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessReviewList_To_auth_AccessReviewList(a.(*AccessReviewList), b.(*auth.AccessReviewList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessReviewList)(nil), (*AccessReviewList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessReviewList_To_v1_AccessReviewList(a.(*auth.AccessReviewList), b.(*AccessReviewList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessReviewPermission)(nil), (*auth.AccessReviewPermission)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessReviewPermission_To_auth_AccessReviewPermission(a.(*AccessReviewPermission), b.(*auth.AccessReviewPermission), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessReviewPermission)(nil), (*AccessReviewPermission)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessReviewPermission_To_v1_AccessReviewPermission(a.(*auth.AccessReviewPermission), b.(*AccessReviewPermission), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessReviewSpec)(nil), (*auth.AccessReviewSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessReviewSpec_To_auth_AccessReviewSpec(a.(*AccessReviewSpec), b.(*auth.AccessReviewSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessReviewSpec)(nil), (*AccessReviewSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessReviewSpec_To_v1_AccessReviewSpec(a.(*auth.AccessReviewSpec), b.(*AccessReviewSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessReviewStatus)(nil), (*auth.AccessReviewStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessReviewStatus_To_auth_AccessReviewStatus(a.(*AccessReviewStatus), b.(*auth.AccessReviewStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessReviewStatus)(nil), (*AccessReviewStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessReviewStatus_To_v1_AccessReviewStatus(a.(*auth.AccessReviewStatus), b.(*AccessReviewStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AccessReviewSubject)(nil), (*auth.AccessReviewSubject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AccessReviewSubject_To_auth_AccessReviewSubject(a.(*AccessReviewSubject), b.(*auth.AccessReviewSubject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AccessReviewSubject)(nil), (*AccessReviewSubject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AccessReviewSubject_To_v1_AccessReviewSubject(a.(*auth.AccessReviewSubject), b.(*AccessReviewSubject), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Action)(nil), (*auth.Action)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Action_To_auth_Action(a.(*Action), b.(*auth.Action), scope)
 	}); err != nil {
@@ -137,6 +318,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuthorizationExplanation)(nil), (*auth.AuthorizationExplanation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuthorizationExplanation_To_auth_AuthorizationExplanation(a.(*AuthorizationExplanation), b.(*auth.AuthorizationExplanation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.AuthorizationExplanation)(nil), (*AuthorizationExplanation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_AuthorizationExplanation_To_v1_AuthorizationExplanation(a.(*auth.AuthorizationExplanation), b.(*AuthorizationExplanation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Binding)(nil), (*auth.Binding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Binding_To_auth_Binding(a.(*Binding), b.(*auth.Binding), scope)
 	}); err != nil {
@@ -417,6 +608,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MatchedRule)(nil), (*auth.MatchedRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MatchedRule_To_auth_MatchedRule(a.(*MatchedRule), b.(*auth.MatchedRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.MatchedRule)(nil), (*MatchedRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_MatchedRule_To_v1_MatchedRule(a.(*auth.MatchedRule), b.(*MatchedRule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NonResourceAttributes)(nil), (*auth.NonResourceAttributes)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_NonResourceAttributes_To_auth_NonResourceAttributes(a.(*NonResourceAttributes), b.(*auth.NonResourceAttributes), scope)
 	}); err != nil {
@@ -647,6 +848,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Session)(nil), (*auth.Session)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Session_To_auth_Session(a.(*Session), b.(*auth.Session), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.Session)(nil), (*Session)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_Session_To_v1_Session(a.(*auth.Session), b.(*Session), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SessionList)(nil), (*auth.SessionList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SessionList_To_auth_SessionList(a.(*SessionList), b.(*auth.SessionList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.SessionList)(nil), (*SessionList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_SessionList_To_v1_SessionList(a.(*auth.SessionList), b.(*SessionList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SessionRevocation)(nil), (*auth.SessionRevocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SessionRevocation_To_auth_SessionRevocation(a.(*SessionRevocation), b.(*auth.SessionRevocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.SessionRevocation)(nil), (*SessionRevocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_SessionRevocation_To_v1_SessionRevocation(a.(*auth.SessionRevocation), b.(*SessionRevocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SessionRevocationList)(nil), (*auth.SessionRevocationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SessionRevocationList_To_auth_SessionRevocationList(a.(*SessionRevocationList), b.(*auth.SessionRevocationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.SessionRevocationList)(nil), (*SessionRevocationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_SessionRevocationList_To_v1_SessionRevocationList(a.(*auth.SessionRevocationList), b.(*SessionRevocationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SessionRevocationSpec)(nil), (*auth.SessionRevocationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SessionRevocationSpec_To_auth_SessionRevocationSpec(a.(*SessionRevocationSpec), b.(*auth.SessionRevocationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.SessionRevocationSpec)(nil), (*SessionRevocationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_SessionRevocationSpec_To_v1_SessionRevocationSpec(a.(*auth.SessionRevocationSpec), b.(*SessionRevocationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SessionSpec)(nil), (*auth.SessionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SessionSpec_To_auth_SessionSpec(a.(*SessionSpec), b.(*auth.SessionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.SessionSpec)(nil), (*SessionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_SessionSpec_To_v1_SessionSpec(a.(*auth.SessionSpec), b.(*SessionSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SessionStatus)(nil), (*auth.SessionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SessionStatus_To_auth_SessionStatus(a.(*SessionStatus), b.(*auth.SessionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.SessionStatus)(nil), (*SessionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_SessionStatus_To_v1_SessionStatus(a.(*auth.SessionStatus), b.(*SessionStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Statement)(nil), (*auth.Statement)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Statement_To_auth_Statement(a.(*Statement), b.(*auth.Statement), scope)
 	}); err != nil {
@@ -697,6 +968,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Tenant)(nil), (*auth.Tenant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Tenant_To_auth_Tenant(a.(*Tenant), b.(*auth.Tenant), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.Tenant)(nil), (*Tenant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_Tenant_To_v1_Tenant(a.(*auth.Tenant), b.(*Tenant), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TenantDeletionStatus)(nil), (*auth.TenantDeletionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TenantDeletionStatus_To_auth_TenantDeletionStatus(a.(*TenantDeletionStatus), b.(*auth.TenantDeletionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.TenantDeletionStatus)(nil), (*TenantDeletionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_TenantDeletionStatus_To_v1_TenantDeletionStatus(a.(*auth.TenantDeletionStatus), b.(*TenantDeletionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TenantList)(nil), (*auth.TenantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TenantList_To_auth_TenantList(a.(*TenantList), b.(*auth.TenantList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.TenantList)(nil), (*TenantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_TenantList_To_v1_TenantList(a.(*auth.TenantList), b.(*TenantList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TenantQuota)(nil), (*auth.TenantQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TenantQuota_To_auth_TenantQuota(a.(*TenantQuota), b.(*auth.TenantQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.TenantQuota)(nil), (*TenantQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_TenantQuota_To_v1_TenantQuota(a.(*auth.TenantQuota), b.(*TenantQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TenantSpec)(nil), (*auth.TenantSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TenantSpec_To_auth_TenantSpec(a.(*TenantSpec), b.(*auth.TenantSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.TenantSpec)(nil), (*TenantSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_TenantSpec_To_v1_TenantSpec(a.(*auth.TenantSpec), b.(*TenantSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TenantStatus)(nil), (*auth.TenantStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TenantStatus_To_auth_TenantStatus(a.(*TenantStatus), b.(*auth.TenantStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.TenantStatus)(nil), (*TenantStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_TenantStatus_To_v1_TenantStatus(a.(*auth.TenantStatus), b.(*TenantStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TenantUsage)(nil), (*auth.TenantUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TenantUsage_To_auth_TenantUsage(a.(*TenantUsage), b.(*auth.TenantUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.TenantUsage)(nil), (*TenantUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_TenantUsage_To_v1_TenantUsage(a.(*auth.TenantUsage), b.(*TenantUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*User)(nil), (*auth.User)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_User_To_auth_User(a.(*User), b.(*auth.User), scope)
 	}); err != nil {
@@ -727,6 +1068,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VerificationKey)(nil), (*auth.VerificationKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VerificationKey_To_auth_VerificationKey(a.(*VerificationKey), b.(*auth.VerificationKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.VerificationKey)(nil), (*VerificationKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_VerificationKey_To_v1_VerificationKey(a.(*auth.VerificationKey), b.(*VerificationKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*AccessReviewDiffOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_AccessReviewDiffOptions(a.(*url.Values), b.(*AccessReviewDiffOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*AccessReviewExportOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_AccessReviewExportOptions(a.(*url.Values), b.(*AccessReviewExportOptions), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -787,6 +1148,7 @@ func Convert_auth_APIKeyList_To_v1_APIKeyList(in *auth.APIKeyList, out *APIKeyLi
 func autoConvert_v1_APIKeyReq_To_auth_APIKeyReq(in *APIKeyReq, out *auth.APIKeyReq, s conversion.Scope) error {
 	out.Expire = in.Expire
 	out.Description = in.Description
+	out.Scope = (*auth.APIKeyScope)(unsafe.Pointer(in.Scope))
 	return nil
 }
 
@@ -798,6 +1160,7 @@ func Convert_v1_APIKeyReq_To_auth_APIKeyReq(in *APIKeyReq, out *auth.APIKeyReq,
 func autoConvert_auth_APIKeyReq_To_v1_APIKeyReq(in *auth.APIKeyReq, out *APIKeyReq, s conversion.Scope) error {
 	out.Expire = in.Expire
 	out.Description = in.Description
+	out.Scope = (*APIKeyScope)(unsafe.Pointer(in.Scope))
 	return nil
 }
 
@@ -812,6 +1175,7 @@ func autoConvert_v1_APIKeyReqPassword_To_auth_APIKeyReqPassword(in *APIKeyReqPas
 	out.Password = in.Password
 	out.Description = in.Description
 	out.Expire = in.Expire
+	out.Scope = (*auth.APIKeyScope)(unsafe.Pointer(in.Scope))
 	return nil
 }
 
@@ -826,6 +1190,7 @@ func autoConvert_auth_APIKeyReqPassword_To_v1_APIKeyReqPassword(in *auth.APIKeyR
 	out.Password = in.Password
 	out.Description = in.Description
 	out.Expire = in.Expire
+	out.Scope = (*APIKeyScope)(unsafe.Pointer(in.Scope))
 	return nil
 }
 
@@ -834,6 +1199,52 @@ func Convert_auth_APIKeyReqPassword_To_v1_APIKeyReqPassword(in *auth.APIKeyReqPa
 	return autoConvert_auth_APIKeyReqPassword_To_v1_APIKeyReqPassword(in, out, s)
 }
 
+func autoConvert_v1_APIKeyRotateReq_To_auth_APIKeyRotateReq(in *APIKeyRotateReq, out *auth.APIKeyRotateReq, s conversion.Scope) error {
+	out.Expire = in.Expire
+	out.Overlap = in.Overlap
+	return nil
+}
+
+// Convert_v1_APIKeyRotateReq_To_auth_APIKeyRotateReq is an autogenerated conversion function.
+func Convert_v1_APIKeyRotateReq_To_auth_APIKeyRotateReq(in *APIKeyRotateReq, out *auth.APIKeyRotateReq, s conversion.Scope) error {
+	return autoConvert_v1_APIKeyRotateReq_To_auth_APIKeyRotateReq(in, out, s)
+}
+
+func autoConvert_auth_APIKeyRotateReq_To_v1_APIKeyRotateReq(in *auth.APIKeyRotateReq, out *APIKeyRotateReq, s conversion.Scope) error {
+	out.Expire = in.Expire
+	out.Overlap = in.Overlap
+	return nil
+}
+
+// Convert_auth_APIKeyRotateReq_To_v1_APIKeyRotateReq is an autogenerated conversion function.
+func Convert_auth_APIKeyRotateReq_To_v1_APIKeyRotateReq(in *auth.APIKeyRotateReq, out *APIKeyRotateReq, s conversion.Scope) error {
+	return autoConvert_auth_APIKeyRotateReq_To_v1_APIKeyRotateReq(in, out, s)
+}
+
+func autoConvert_v1_APIKeyScope_To_auth_APIKeyScope(in *APIKeyScope, out *auth.APIKeyScope, s conversion.Scope) error {
+	out.Statements = *(*[]auth.Statement)(unsafe.Pointer(&in.Statements))
+	out.Projects = *(*[]string)(unsafe.Pointer(&in.Projects))
+	out.SourceCIDRs = *(*[]string)(unsafe.Pointer(&in.SourceCIDRs))
+	return nil
+}
+
+// Convert_v1_APIKeyScope_To_auth_APIKeyScope is an autogenerated conversion function.
+func Convert_v1_APIKeyScope_To_auth_APIKeyScope(in *APIKeyScope, out *auth.APIKeyScope, s conversion.Scope) error {
+	return autoConvert_v1_APIKeyScope_To_auth_APIKeyScope(in, out, s)
+}
+
+func autoConvert_auth_APIKeyScope_To_v1_APIKeyScope(in *auth.APIKeyScope, out *APIKeyScope, s conversion.Scope) error {
+	out.Statements = *(*[]Statement)(unsafe.Pointer(&in.Statements))
+	out.Projects = *(*[]string)(unsafe.Pointer(&in.Projects))
+	out.SourceCIDRs = *(*[]string)(unsafe.Pointer(&in.SourceCIDRs))
+	return nil
+}
+
+// Convert_auth_APIKeyScope_To_v1_APIKeyScope is an autogenerated conversion function.
+func Convert_auth_APIKeyScope_To_v1_APIKeyScope(in *auth.APIKeyScope, out *APIKeyScope, s conversion.Scope) error {
+	return autoConvert_auth_APIKeyScope_To_v1_APIKeyScope(in, out, s)
+}
+
 func autoConvert_v1_APIKeySpec_To_auth_APIKeySpec(in *APIKeySpec, out *auth.APIKeySpec, s conversion.Scope) error {
 	out.APIkey = in.APIkey
 	out.TenantID = in.TenantID
@@ -841,6 +1252,7 @@ func autoConvert_v1_APIKeySpec_To_auth_APIKeySpec(in *APIKeySpec, out *auth.APIK
 	out.Description = in.Description
 	out.IssueAt = in.IssueAt
 	out.ExpireAt = in.ExpireAt
+	out.Scope = (*auth.APIKeyScope)(unsafe.Pointer(in.Scope))
 	return nil
 }
 
@@ -856,6 +1268,7 @@ func autoConvert_auth_APIKeySpec_To_v1_APIKeySpec(in *auth.APIKeySpec, out *APIK
 	out.Description = in.Description
 	out.IssueAt = in.IssueAt
 	out.ExpireAt = in.ExpireAt
+	out.Scope = (*APIKeyScope)(unsafe.Pointer(in.Scope))
 	return nil
 }
 
@@ -867,6 +1280,11 @@ func Convert_auth_APIKeySpec_To_v1_APIKeySpec(in *auth.APIKeySpec, out *APIKeySp
 func autoConvert_v1_APIKeyStatus_To_auth_APIKeyStatus(in *APIKeyStatus, out *auth.APIKeyStatus, s conversion.Scope) error {
 	out.Disabled = in.Disabled
 	out.Expired = in.Expired
+	out.LastUsedTime = in.LastUsedTime
+	out.LastSourceIP = in.LastSourceIP
+	out.RequestCount = in.RequestCount
+	out.RotatedTo = in.RotatedTo
+	out.RetireTime = in.RetireTime
 	return nil
 }
 
@@ -878,6 +1296,11 @@ func Convert_v1_APIKeyStatus_To_auth_APIKeyStatus(in *APIKeyStatus, out *auth.AP
 func autoConvert_auth_APIKeyStatus_To_v1_APIKeyStatus(in *auth.APIKeyStatus, out *APIKeyStatus, s conversion.Scope) error {
 	out.Disabled = in.Disabled
 	out.Expired = in.Expired
+	out.LastUsedTime = in.LastUsedTime
+	out.LastSourceIP = in.LastSourceIP
+	out.RequestCount = in.RequestCount
+	out.RotatedTo = in.RotatedTo
+	out.RetireTime = in.RetireTime
 	return nil
 }
 
@@ -890,6 +1313,9 @@ func autoConvert_v1_APISigningKey_To_auth_APISigningKey(in *APISigningKey, out *
 	out.ObjectMeta = in.ObjectMeta
 	out.SigningKey = *(*[]byte)(unsafe.Pointer(&in.SigningKey))
 	out.SigningKeyPub = *(*[]byte)(unsafe.Pointer(&in.SigningKeyPub))
+	out.KeyID = in.KeyID
+	out.RotateTime = in.RotateTime
+	out.VerificationKeys = *(*[]auth.VerificationKey)(unsafe.Pointer(&in.VerificationKeys))
 	return nil
 }
 
@@ -902,34 +1328,495 @@ func autoConvert_auth_APISigningKey_To_v1_APISigningKey(in *auth.APISigningKey,
 	out.ObjectMeta = in.ObjectMeta
 	out.SigningKey = *(*[]byte)(unsafe.Pointer(&in.SigningKey))
 	out.SigningKeyPub = *(*[]byte)(unsafe.Pointer(&in.SigningKeyPub))
+	out.KeyID = in.KeyID
+	out.RotateTime = in.RotateTime
+	out.VerificationKeys = *(*[]VerificationKey)(unsafe.Pointer(&in.VerificationKeys))
+	return nil
+}
+
+// Convert_auth_APISigningKey_To_v1_APISigningKey is an autogenerated conversion function.
+func Convert_auth_APISigningKey_To_v1_APISigningKey(in *auth.APISigningKey, out *APISigningKey, s conversion.Scope) error {
+	return autoConvert_auth_APISigningKey_To_v1_APISigningKey(in, out, s)
+}
+
+func autoConvert_v1_APISigningKeyList_To_auth_APISigningKeyList(in *APISigningKeyList, out *auth.APISigningKeyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]auth.APISigningKey)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_APISigningKeyList_To_auth_APISigningKeyList is an autogenerated conversion function.
+func Convert_v1_APISigningKeyList_To_auth_APISigningKeyList(in *APISigningKeyList, out *auth.APISigningKeyList, s conversion.Scope) error {
+	return autoConvert_v1_APISigningKeyList_To_auth_APISigningKeyList(in, out, s)
+}
+
+func autoConvert_auth_APISigningKeyList_To_v1_APISigningKeyList(in *auth.APISigningKeyList, out *APISigningKeyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]APISigningKey)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_auth_APISigningKeyList_To_v1_APISigningKeyList is an autogenerated conversion function.
+func Convert_auth_APISigningKeyList_To_v1_APISigningKeyList(in *auth.APISigningKeyList, out *APISigningKeyList, s conversion.Scope) error {
+	return autoConvert_auth_APISigningKeyList_To_v1_APISigningKeyList(in, out, s)
+}
+
+func autoConvert_v1_AccessRequest_To_auth_AccessRequest(in *AccessRequest, out *auth.AccessRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_AccessRequestSpec_To_auth_AccessRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_AccessRequestStatus_To_auth_AccessRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_AccessRequest_To_auth_AccessRequest is an autogenerated conversion function.
+func Convert_v1_AccessRequest_To_auth_AccessRequest(in *AccessRequest, out *auth.AccessRequest, s conversion.Scope) error {
+	return autoConvert_v1_AccessRequest_To_auth_AccessRequest(in, out, s)
+}
+
+func autoConvert_auth_AccessRequest_To_v1_AccessRequest(in *auth.AccessRequest, out *AccessRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_auth_AccessRequestSpec_To_v1_AccessRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_auth_AccessRequestStatus_To_v1_AccessRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_auth_AccessRequest_To_v1_AccessRequest is an autogenerated conversion function.
+func Convert_auth_AccessRequest_To_v1_AccessRequest(in *auth.AccessRequest, out *AccessRequest, s conversion.Scope) error {
+	return autoConvert_auth_AccessRequest_To_v1_AccessRequest(in, out, s)
+}
+
+func autoConvert_v1_AccessRequestBinding_To_auth_AccessRequestBinding(in *AccessRequestBinding, out *auth.AccessRequestBinding, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Created = in.Created
+	return nil
+}
+
+// Convert_v1_AccessRequestBinding_To_auth_AccessRequestBinding is an autogenerated conversion function.
+func Convert_v1_AccessRequestBinding_To_auth_AccessRequestBinding(in *AccessRequestBinding, out *auth.AccessRequestBinding, s conversion.Scope) error {
+	return autoConvert_v1_AccessRequestBinding_To_auth_AccessRequestBinding(in, out, s)
+}
+
+func autoConvert_auth_AccessRequestBinding_To_v1_AccessRequestBinding(in *auth.AccessRequestBinding, out *AccessRequestBinding, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Created = in.Created
+	return nil
+}
+
+// Convert_auth_AccessRequestBinding_To_v1_AccessRequestBinding is an autogenerated conversion function.
+func Convert_auth_AccessRequestBinding_To_v1_AccessRequestBinding(in *auth.AccessRequestBinding, out *AccessRequestBinding, s conversion.Scope) error {
+	return autoConvert_auth_AccessRequestBinding_To_v1_AccessRequestBinding(in, out, s)
+}
+
+func autoConvert_v1_AccessRequestList_To_auth_AccessRequestList(in *AccessRequestList, out *auth.AccessRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]auth.AccessRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_AccessRequestList_To_auth_AccessRequestList is an autogenerated conversion function.
+func Convert_v1_AccessRequestList_To_auth_AccessRequestList(in *AccessRequestList, out *auth.AccessRequestList, s conversion.Scope) error {
+	return autoConvert_v1_AccessRequestList_To_auth_AccessRequestList(in, out, s)
+}
+
+func autoConvert_auth_AccessRequestList_To_v1_AccessRequestList(in *auth.AccessRequestList, out *AccessRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]AccessRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_auth_AccessRequestList_To_v1_AccessRequestList is an autogenerated conversion function.
+func Convert_auth_AccessRequestList_To_v1_AccessRequestList(in *auth.AccessRequestList, out *AccessRequestList, s conversion.Scope) error {
+	return autoConvert_auth_AccessRequestList_To_v1_AccessRequestList(in, out, s)
+}
+
+func autoConvert_v1_AccessRequestReview_To_auth_AccessRequestReview(in *AccessRequestReview, out *auth.AccessRequestReview, s conversion.Scope) error {
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_AccessRequestReview_To_auth_AccessRequestReview is an autogenerated conversion function.
+func Convert_v1_AccessRequestReview_To_auth_AccessRequestReview(in *AccessRequestReview, out *auth.AccessRequestReview, s conversion.Scope) error {
+	return autoConvert_v1_AccessRequestReview_To_auth_AccessRequestReview(in, out, s)
+}
+
+func autoConvert_auth_AccessRequestReview_To_v1_AccessRequestReview(in *auth.AccessRequestReview, out *AccessRequestReview, s conversion.Scope) error {
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_auth_AccessRequestReview_To_v1_AccessRequestReview is an autogenerated conversion function.
+func Convert_auth_AccessRequestReview_To_v1_AccessRequestReview(in *auth.AccessRequestReview, out *AccessRequestReview, s conversion.Scope) error {
+	return autoConvert_auth_AccessRequestReview_To_v1_AccessRequestReview(in, out, s)
+}
+
+func autoConvert_v1_AccessRequestSpec_To_auth_AccessRequestSpec(in *AccessRequestSpec, out *auth.AccessRequestSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Username = in.Username
+	out.ProjectID = in.ProjectID
+	out.Policies = *(*[]string)(unsafe.Pointer(&in.Policies))
+	out.RoleID = in.RoleID
+	out.Duration = in.Duration
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_v1_AccessRequestSpec_To_auth_AccessRequestSpec is an autogenerated conversion function.
+func Convert_v1_AccessRequestSpec_To_auth_AccessRequestSpec(in *AccessRequestSpec, out *auth.AccessRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1_AccessRequestSpec_To_auth_AccessRequestSpec(in, out, s)
+}
+
+func autoConvert_auth_AccessRequestSpec_To_v1_AccessRequestSpec(in *auth.AccessRequestSpec, out *AccessRequestSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Username = in.Username
+	out.ProjectID = in.ProjectID
+	out.Policies = *(*[]string)(unsafe.Pointer(&in.Policies))
+	out.RoleID = in.RoleID
+	out.Duration = in.Duration
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_auth_AccessRequestSpec_To_v1_AccessRequestSpec is an autogenerated conversion function.
+func Convert_auth_AccessRequestSpec_To_v1_AccessRequestSpec(in *auth.AccessRequestSpec, out *AccessRequestSpec, s conversion.Scope) error {
+	return autoConvert_auth_AccessRequestSpec_To_v1_AccessRequestSpec(in, out, s)
+}
+
+func autoConvert_v1_AccessRequestStatus_To_auth_AccessRequestStatus(in *AccessRequestStatus, out *auth.AccessRequestStatus, s conversion.Scope) error {
+	out.Phase = auth.AccessRequestPhase(in.Phase)
+	out.Approvers = *(*[]string)(unsafe.Pointer(&in.Approvers))
+	out.Reviewer = in.Reviewer
+	out.ReviewMessage = in.ReviewMessage
+	out.ReviewTime = in.ReviewTime
+	out.ExpireTime = in.ExpireTime
+	out.NotifyTime = in.NotifyTime
+	out.Bindings = *(*[]auth.AccessRequestBinding)(unsafe.Pointer(&in.Bindings))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_AccessRequestStatus_To_auth_AccessRequestStatus is an autogenerated conversion function.
+func Convert_v1_AccessRequestStatus_To_auth_AccessRequestStatus(in *AccessRequestStatus, out *auth.AccessRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1_AccessRequestStatus_To_auth_AccessRequestStatus(in, out, s)
+}
+
+func autoConvert_auth_AccessRequestStatus_To_v1_AccessRequestStatus(in *auth.AccessRequestStatus, out *AccessRequestStatus, s conversion.Scope) error {
+	out.Phase = AccessRequestPhase(in.Phase)
+	out.Approvers = *(*[]string)(unsafe.Pointer(&in.Approvers))
+	out.Reviewer = in.Reviewer
+	out.ReviewMessage = in.ReviewMessage
+	out.ReviewTime = in.ReviewTime
+	out.ExpireTime = in.ExpireTime
+	out.NotifyTime = in.NotifyTime
+	out.Bindings = *(*[]AccessRequestBinding)(unsafe.Pointer(&in.Bindings))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_auth_AccessRequestStatus_To_v1_AccessRequestStatus is an autogenerated conversion function.
+func Convert_auth_AccessRequestStatus_To_v1_AccessRequestStatus(in *auth.AccessRequestStatus, out *AccessRequestStatus, s conversion.Scope) error {
+	return autoConvert_auth_AccessRequestStatus_To_v1_AccessRequestStatus(in, out, s)
+}
+
+func autoConvert_v1_AccessReview_To_auth_AccessReview(in *AccessReview, out *auth.AccessReview, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_AccessReviewSpec_To_auth_AccessReviewSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_AccessReviewStatus_To_auth_AccessReviewStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_AccessReview_To_auth_AccessReview is an autogenerated conversion function.
+func Convert_v1_AccessReview_To_auth_AccessReview(in *AccessReview, out *auth.AccessReview, s conversion.Scope) error {
+	return autoConvert_v1_AccessReview_To_auth_AccessReview(in, out, s)
+}
+
+func autoConvert_auth_AccessReview_To_v1_AccessReview(in *auth.AccessReview, out *AccessReview, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_auth_AccessReviewSpec_To_v1_AccessReviewSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_auth_AccessReviewStatus_To_v1_AccessReviewStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_auth_AccessReview_To_v1_AccessReview is an autogenerated conversion function.
+func Convert_auth_AccessReview_To_v1_AccessReview(in *auth.AccessReview, out *AccessReview, s conversion.Scope) error {
+	return autoConvert_auth_AccessReview_To_v1_AccessReview(in, out, s)
+}
+
+func autoConvert_v1_AccessReviewDiff_To_auth_AccessReviewDiff(in *AccessReviewDiff, out *auth.AccessReviewDiff, s conversion.Scope) error {
+	out.Base = in.Base
+	out.Target = in.Target
+	out.Added = *(*[]auth.AccessReviewEntry)(unsafe.Pointer(&in.Added))
+	out.Removed = *(*[]auth.AccessReviewEntry)(unsafe.Pointer(&in.Removed))
+	return nil
+}
+
+// Convert_v1_AccessReviewDiff_To_auth_AccessReviewDiff is an autogenerated conversion function.
+func Convert_v1_AccessReviewDiff_To_auth_AccessReviewDiff(in *AccessReviewDiff, out *auth.AccessReviewDiff, s conversion.Scope) error {
+	return autoConvert_v1_AccessReviewDiff_To_auth_AccessReviewDiff(in, out, s)
+}
+
+func autoConvert_auth_AccessReviewDiff_To_v1_AccessReviewDiff(in *auth.AccessReviewDiff, out *AccessReviewDiff, s conversion.Scope) error {
+	out.Base = in.Base
+	out.Target = in.Target
+	out.Added = *(*[]AccessReviewEntry)(unsafe.Pointer(&in.Added))
+	out.Removed = *(*[]AccessReviewEntry)(unsafe.Pointer(&in.Removed))
+	return nil
+}
+
+// Convert_auth_AccessReviewDiff_To_v1_AccessReviewDiff is an autogenerated conversion function.
+func Convert_auth_AccessReviewDiff_To_v1_AccessReviewDiff(in *auth.AccessReviewDiff, out *AccessReviewDiff, s conversion.Scope) error {
+	return autoConvert_auth_AccessReviewDiff_To_v1_AccessReviewDiff(in, out, s)
+}
+
+func autoConvert_v1_AccessReviewDiffOptions_To_auth_AccessReviewDiffOptions(in *AccessReviewDiffOptions, out *auth.AccessReviewDiffOptions, s conversion.Scope) error {
+	out.Base = in.Base
+	return nil
+}
+
+// Convert_v1_AccessReviewDiffOptions_To_auth_AccessReviewDiffOptions is an autogenerated conversion function.
+func Convert_v1_AccessReviewDiffOptions_To_auth_AccessReviewDiffOptions(in *AccessReviewDiffOptions, out *auth.AccessReviewDiffOptions, s conversion.Scope) error {
+	return autoConvert_v1_AccessReviewDiffOptions_To_auth_AccessReviewDiffOptions(in, out, s)
+}
+
+func autoConvert_auth_AccessReviewDiffOptions_To_v1_AccessReviewDiffOptions(in *auth.AccessReviewDiffOptions, out *AccessReviewDiffOptions, s conversion.Scope) error {
+	out.Base = in.Base
+	return nil
+}
+
+// Convert_auth_AccessReviewDiffOptions_To_v1_AccessReviewDiffOptions is an autogenerated conversion function.
+func Convert_auth_AccessReviewDiffOptions_To_v1_AccessReviewDiffOptions(in *auth.AccessReviewDiffOptions, out *AccessReviewDiffOptions, s conversion.Scope) error {
+	return autoConvert_auth_AccessReviewDiffOptions_To_v1_AccessReviewDiffOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1_AccessReviewDiffOptions(in *url.Values, out *AccessReviewDiffOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["base"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Base, s); err != nil {
+			return err
+		}
+	} else {
+		out.Base = ""
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1_AccessReviewDiffOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1_AccessReviewDiffOptions(in *url.Values, out *AccessReviewDiffOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1_AccessReviewDiffOptions(in, out, s)
+}
+
+func autoConvert_v1_AccessReviewEntry_To_auth_AccessReviewEntry(in *AccessReviewEntry, out *auth.AccessReviewEntry, s conversion.Scope) error {
+	out.SubjectKind = in.SubjectKind
+	out.Subject = in.Subject
+	if err := Convert_v1_AccessReviewPermission_To_auth_AccessReviewPermission(&in.Permission, &out.Permission, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_AccessReviewEntry_To_auth_AccessReviewEntry is an autogenerated conversion function.
+func Convert_v1_AccessReviewEntry_To_auth_AccessReviewEntry(in *AccessReviewEntry, out *auth.AccessReviewEntry, s conversion.Scope) error {
+	return autoConvert_v1_AccessReviewEntry_To_auth_AccessReviewEntry(in, out, s)
+}
+
+func autoConvert_auth_AccessReviewEntry_To_v1_AccessReviewEntry(in *auth.AccessReviewEntry, out *AccessReviewEntry, s conversion.Scope) error {
+	out.SubjectKind = in.SubjectKind
+	out.Subject = in.Subject
+	if err := Convert_auth_AccessReviewPermission_To_v1_AccessReviewPermission(&in.Permission, &out.Permission, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_auth_AccessReviewEntry_To_v1_AccessReviewEntry is an autogenerated conversion function.
+func Convert_auth_AccessReviewEntry_To_v1_AccessReviewEntry(in *auth.AccessReviewEntry, out *AccessReviewEntry, s conversion.Scope) error {
+	return autoConvert_auth_AccessReviewEntry_To_v1_AccessReviewEntry(in, out, s)
+}
+
+func autoConvert_v1_AccessReviewExportOptions_To_auth_AccessReviewExportOptions(in *AccessReviewExportOptions, out *auth.AccessReviewExportOptions, s conversion.Scope) error {
+	out.Format = in.Format
+	return nil
+}
+
+// Convert_v1_AccessReviewExportOptions_To_auth_AccessReviewExportOptions is an autogenerated conversion function.
+func Convert_v1_AccessReviewExportOptions_To_auth_AccessReviewExportOptions(in *AccessReviewExportOptions, out *auth.AccessReviewExportOptions, s conversion.Scope) error {
+	return autoConvert_v1_AccessReviewExportOptions_To_auth_AccessReviewExportOptions(in, out, s)
+}
+
+func autoConvert_auth_AccessReviewExportOptions_To_v1_AccessReviewExportOptions(in *auth.AccessReviewExportOptions, out *AccessReviewExportOptions, s conversion.Scope) error {
+	out.Format = in.Format
+	return nil
+}
+
+// Convert_auth_AccessReviewExportOptions_To_v1_AccessReviewExportOptions is an autogenerated conversion function.
+func Convert_auth_AccessReviewExportOptions_To_v1_AccessReviewExportOptions(in *auth.AccessReviewExportOptions, out *AccessReviewExportOptions, s conversion.Scope) error {
+	return autoConvert_auth_AccessReviewExportOptions_To_v1_AccessReviewExportOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1_AccessReviewExportOptions(in *url.Values, out *AccessReviewExportOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["format"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Format, s); err != nil {
+			return err
+		}
+	} else {
+		out.Format = ""
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1_AccessReviewExportOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1_AccessReviewExportOptions(in *url.Values, out *AccessReviewExportOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1_AccessReviewExportOptions(in, out, s)
+}
+
+func autoConvert_v1_AccessReviewList_To_auth_AccessReviewList(in *AccessReviewList, out *auth.AccessReviewList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]auth.AccessReview)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_AccessReviewList_To_auth_AccessReviewList is an autogenerated conversion function.
+func Convert_v1_AccessReviewList_To_auth_AccessReviewList(in *AccessReviewList, out *auth.AccessReviewList, s conversion.Scope) error {
+	return autoConvert_v1_AccessReviewList_To_auth_AccessReviewList(in, out, s)
+}
+
+func autoConvert_auth_AccessReviewList_To_v1_AccessReviewList(in *auth.AccessReviewList, out *AccessReviewList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]AccessReview)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_auth_AccessReviewList_To_v1_AccessReviewList is an autogenerated conversion function.
+func Convert_auth_AccessReviewList_To_v1_AccessReviewList(in *auth.AccessReviewList, out *AccessReviewList, s conversion.Scope) error {
+	return autoConvert_auth_AccessReviewList_To_v1_AccessReviewList(in, out, s)
+}
+
+func autoConvert_v1_AccessReviewPermission_To_auth_AccessReviewPermission(in *AccessReviewPermission, out *auth.AccessReviewPermission, s conversion.Scope) error {
+	out.Project = in.Project
+	out.Policy = in.Policy
+	out.PolicyName = in.PolicyName
+	out.Role = in.Role
+	out.Group = in.Group
+	out.Effect = auth.Effect(in.Effect)
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Actions = *(*[]string)(unsafe.Pointer(&in.Actions))
+	return nil
+}
+
+// Convert_v1_AccessReviewPermission_To_auth_AccessReviewPermission is an autogenerated conversion function.
+func Convert_v1_AccessReviewPermission_To_auth_AccessReviewPermission(in *AccessReviewPermission, out *auth.AccessReviewPermission, s conversion.Scope) error {
+	return autoConvert_v1_AccessReviewPermission_To_auth_AccessReviewPermission(in, out, s)
+}
+
+func autoConvert_auth_AccessReviewPermission_To_v1_AccessReviewPermission(in *auth.AccessReviewPermission, out *AccessReviewPermission, s conversion.Scope) error {
+	out.Project = in.Project
+	out.Policy = in.Policy
+	out.PolicyName = in.PolicyName
+	out.Role = in.Role
+	out.Group = in.Group
+	out.Effect = Effect(in.Effect)
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Actions = *(*[]string)(unsafe.Pointer(&in.Actions))
+	return nil
+}
+
+// Convert_auth_AccessReviewPermission_To_v1_AccessReviewPermission is an autogenerated conversion function.
+func Convert_auth_AccessReviewPermission_To_v1_AccessReviewPermission(in *auth.AccessReviewPermission, out *AccessReviewPermission, s conversion.Scope) error {
+	return autoConvert_auth_AccessReviewPermission_To_v1_AccessReviewPermission(in, out, s)
+}
+
+func autoConvert_v1_AccessReviewSpec_To_auth_AccessReviewSpec(in *AccessReviewSpec, out *auth.AccessReviewSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ProjectID = in.ProjectID
+	return nil
+}
+
+// Convert_v1_AccessReviewSpec_To_auth_AccessReviewSpec is an autogenerated conversion function.
+func Convert_v1_AccessReviewSpec_To_auth_AccessReviewSpec(in *AccessReviewSpec, out *auth.AccessReviewSpec, s conversion.Scope) error {
+	return autoConvert_v1_AccessReviewSpec_To_auth_AccessReviewSpec(in, out, s)
+}
+
+func autoConvert_auth_AccessReviewSpec_To_v1_AccessReviewSpec(in *auth.AccessReviewSpec, out *AccessReviewSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ProjectID = in.ProjectID
+	return nil
+}
+
+// Convert_auth_AccessReviewSpec_To_v1_AccessReviewSpec is an autogenerated conversion function.
+func Convert_auth_AccessReviewSpec_To_v1_AccessReviewSpec(in *auth.AccessReviewSpec, out *AccessReviewSpec, s conversion.Scope) error {
+	return autoConvert_auth_AccessReviewSpec_To_v1_AccessReviewSpec(in, out, s)
+}
+
+func autoConvert_v1_AccessReviewStatus_To_auth_AccessReviewStatus(in *AccessReviewStatus, out *auth.AccessReviewStatus, s conversion.Scope) error {
+	out.Subjects = *(*[]auth.AccessReviewSubject)(unsafe.Pointer(&in.Subjects))
+	return nil
+}
+
+// Convert_v1_AccessReviewStatus_To_auth_AccessReviewStatus is an autogenerated conversion function.
+func Convert_v1_AccessReviewStatus_To_auth_AccessReviewStatus(in *AccessReviewStatus, out *auth.AccessReviewStatus, s conversion.Scope) error {
+	return autoConvert_v1_AccessReviewStatus_To_auth_AccessReviewStatus(in, out, s)
+}
+
+func autoConvert_auth_AccessReviewStatus_To_v1_AccessReviewStatus(in *auth.AccessReviewStatus, out *AccessReviewStatus, s conversion.Scope) error {
+	out.Subjects = *(*[]AccessReviewSubject)(unsafe.Pointer(&in.Subjects))
 	return nil
 }
 
-// Convert_auth_APISigningKey_To_v1_APISigningKey is an autogenerated conversion function.
-func Convert_auth_APISigningKey_To_v1_APISigningKey(in *auth.APISigningKey, out *APISigningKey, s conversion.Scope) error {
-	return autoConvert_auth_APISigningKey_To_v1_APISigningKey(in, out, s)
+// Convert_auth_AccessReviewStatus_To_v1_AccessReviewStatus is an autogenerated conversion function.
+func Convert_auth_AccessReviewStatus_To_v1_AccessReviewStatus(in *auth.AccessReviewStatus, out *AccessReviewStatus, s conversion.Scope) error {
+	return autoConvert_auth_AccessReviewStatus_To_v1_AccessReviewStatus(in, out, s)
 }
 
-func autoConvert_v1_APISigningKeyList_To_auth_APISigningKeyList(in *APISigningKeyList, out *auth.APISigningKeyList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]auth.APISigningKey)(unsafe.Pointer(&in.Items))
+func autoConvert_v1_AccessReviewSubject_To_auth_AccessReviewSubject(in *AccessReviewSubject, out *auth.AccessReviewSubject, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.ID = in.ID
+	out.Name = in.Name
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Members = *(*[]string)(unsafe.Pointer(&in.Members))
+	out.Permissions = *(*[]auth.AccessReviewPermission)(unsafe.Pointer(&in.Permissions))
 	return nil
 }
 
-// Convert_v1_APISigningKeyList_To_auth_APISigningKeyList is an autogenerated conversion function.
-func Convert_v1_APISigningKeyList_To_auth_APISigningKeyList(in *APISigningKeyList, out *auth.APISigningKeyList, s conversion.Scope) error {
-	return autoConvert_v1_APISigningKeyList_To_auth_APISigningKeyList(in, out, s)
+// Convert_v1_AccessReviewSubject_To_auth_AccessReviewSubject is an autogenerated conversion function.
+func Convert_v1_AccessReviewSubject_To_auth_AccessReviewSubject(in *AccessReviewSubject, out *auth.AccessReviewSubject, s conversion.Scope) error {
+	return autoConvert_v1_AccessReviewSubject_To_auth_AccessReviewSubject(in, out, s)
 }
 
-func autoConvert_auth_APISigningKeyList_To_v1_APISigningKeyList(in *auth.APISigningKeyList, out *APISigningKeyList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]APISigningKey)(unsafe.Pointer(&in.Items))
+func autoConvert_auth_AccessReviewSubject_To_v1_AccessReviewSubject(in *auth.AccessReviewSubject, out *AccessReviewSubject, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.ID = in.ID
+	out.Name = in.Name
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Members = *(*[]string)(unsafe.Pointer(&in.Members))
+	out.Permissions = *(*[]AccessReviewPermission)(unsafe.Pointer(&in.Permissions))
 	return nil
 }
 
-// Convert_auth_APISigningKeyList_To_v1_APISigningKeyList is an autogenerated conversion function.
-func Convert_auth_APISigningKeyList_To_v1_APISigningKeyList(in *auth.APISigningKeyList, out *APISigningKeyList, s conversion.Scope) error {
-	return autoConvert_auth_APISigningKeyList_To_v1_APISigningKeyList(in, out, s)
+// Convert_auth_AccessReviewSubject_To_v1_AccessReviewSubject is an autogenerated conversion function.
+func Convert_auth_AccessReviewSubject_To_v1_AccessReviewSubject(in *auth.AccessReviewSubject, out *AccessReviewSubject, s conversion.Scope) error {
+	return autoConvert_auth_AccessReviewSubject_To_v1_AccessReviewSubject(in, out, s)
 }
 
 func autoConvert_v1_Action_To_auth_Action(in *Action, out *auth.Action, s conversion.Scope) error {
@@ -984,6 +1871,40 @@ func Convert_auth_AllowedStatus_To_v1_AllowedStatus(in *auth.AllowedStatus, out
 	return autoConvert_auth_AllowedStatus_To_v1_AllowedStatus(in, out, s)
 }
 
+func autoConvert_v1_AuthorizationExplanation_To_auth_AuthorizationExplanation(in *AuthorizationExplanation, out *auth.AuthorizationExplanation, s conversion.Scope) error {
+	out.Subject = in.Subject
+	out.Tenant = in.Tenant
+	out.Project = in.Project
+	out.Action = in.Action
+	out.Resource = in.Resource
+	out.Stage = in.Stage
+	out.Decision = in.Decision
+	out.MatchedRules = *(*[]auth.MatchedRule)(unsafe.Pointer(&in.MatchedRules))
+	return nil
+}
+
+// Convert_v1_AuthorizationExplanation_To_auth_AuthorizationExplanation is an autogenerated conversion function.
+func Convert_v1_AuthorizationExplanation_To_auth_AuthorizationExplanation(in *AuthorizationExplanation, out *auth.AuthorizationExplanation, s conversion.Scope) error {
+	return autoConvert_v1_AuthorizationExplanation_To_auth_AuthorizationExplanation(in, out, s)
+}
+
+func autoConvert_auth_AuthorizationExplanation_To_v1_AuthorizationExplanation(in *auth.AuthorizationExplanation, out *AuthorizationExplanation, s conversion.Scope) error {
+	out.Subject = in.Subject
+	out.Tenant = in.Tenant
+	out.Project = in.Project
+	out.Action = in.Action
+	out.Resource = in.Resource
+	out.Stage = in.Stage
+	out.Decision = in.Decision
+	out.MatchedRules = *(*[]MatchedRule)(unsafe.Pointer(&in.MatchedRules))
+	return nil
+}
+
+// Convert_auth_AuthorizationExplanation_To_v1_AuthorizationExplanation is an autogenerated conversion function.
+func Convert_auth_AuthorizationExplanation_To_v1_AuthorizationExplanation(in *auth.AuthorizationExplanation, out *AuthorizationExplanation, s conversion.Scope) error {
+	return autoConvert_auth_AuthorizationExplanation_To_v1_AuthorizationExplanation(in, out, s)
+}
+
 func autoConvert_v1_Binding_To_auth_Binding(in *Binding, out *auth.Binding, s conversion.Scope) error {
 	out.Users = *(*[]auth.Subject)(unsafe.Pointer(&in.Users))
 	out.Groups = *(*[]auth.Subject)(unsafe.Pointer(&in.Groups))
@@ -1710,6 +2631,11 @@ func autoConvert_v1_LocalIdentityStatus_To_auth_LocalIdentityStatus(in *LocalIde
 	out.Phase = auth.LocalIdentityPhase(in.Phase)
 	out.Locked = in.Locked
 	out.LastUpdateTime = in.LastUpdateTime
+	out.FailedLoginAttempts = in.FailedLoginAttempts
+	out.LastFailedLoginTime = in.LastFailedLoginTime
+	out.LockedUntil = in.LockedUntil
+	out.PasswordUpdateTime = in.PasswordUpdateTime
+	out.PasswordHistory = *(*[]string)(unsafe.Pointer(&in.PasswordHistory))
 	return nil
 }
 
@@ -1722,6 +2648,11 @@ func autoConvert_auth_LocalIdentityStatus_To_v1_LocalIdentityStatus(in *auth.Loc
 	out.Locked = in.Locked
 	out.Phase = LocalIdentityPhase(in.Phase)
 	out.LastUpdateTime = in.LastUpdateTime
+	out.FailedLoginAttempts = in.FailedLoginAttempts
+	out.LastFailedLoginTime = in.LastFailedLoginTime
+	out.LockedUntil = in.LockedUntil
+	out.PasswordUpdateTime = in.PasswordUpdateTime
+	out.PasswordHistory = *(*[]string)(unsafe.Pointer(&in.PasswordHistory))
 	return nil
 }
 
@@ -1730,6 +2661,44 @@ func Convert_auth_LocalIdentityStatus_To_v1_LocalIdentityStatus(in *auth.LocalId
 	return autoConvert_auth_LocalIdentityStatus_To_v1_LocalIdentityStatus(in, out, s)
 }
 
+func autoConvert_v1_MatchedRule_To_auth_MatchedRule(in *MatchedRule, out *auth.MatchedRule, s conversion.Scope) error {
+	out.Rule = in.Rule
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Binding = in.Binding
+	out.Project = in.Project
+	out.Via = in.Via
+	out.Resource = in.Resource
+	out.Action = in.Action
+	out.Effect = auth.Effect(in.Effect)
+	out.FailedCondition = in.FailedCondition
+	return nil
+}
+
+// Convert_v1_MatchedRule_To_auth_MatchedRule is an autogenerated conversion function.
+func Convert_v1_MatchedRule_To_auth_MatchedRule(in *MatchedRule, out *auth.MatchedRule, s conversion.Scope) error {
+	return autoConvert_v1_MatchedRule_To_auth_MatchedRule(in, out, s)
+}
+
+func autoConvert_auth_MatchedRule_To_v1_MatchedRule(in *auth.MatchedRule, out *MatchedRule, s conversion.Scope) error {
+	out.Rule = in.Rule
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Binding = in.Binding
+	out.Project = in.Project
+	out.Via = in.Via
+	out.Resource = in.Resource
+	out.Action = in.Action
+	out.Effect = Effect(in.Effect)
+	out.FailedCondition = in.FailedCondition
+	return nil
+}
+
+// Convert_auth_MatchedRule_To_v1_MatchedRule is an autogenerated conversion function.
+func Convert_auth_MatchedRule_To_v1_MatchedRule(in *auth.MatchedRule, out *MatchedRule, s conversion.Scope) error {
+	return autoConvert_auth_MatchedRule_To_v1_MatchedRule(in, out, s)
+}
+
 func autoConvert_v1_NonResourceAttributes_To_auth_NonResourceAttributes(in *NonResourceAttributes, out *auth.NonResourceAttributes, s conversion.Scope) error {
 	out.Path = in.Path
 	out.Verb = in.Verb
@@ -2340,6 +3309,188 @@ func Convert_auth_RuleSpec_To_v1_RuleSpec(in *auth.RuleSpec, out *RuleSpec, s co
 	return autoConvert_auth_RuleSpec_To_v1_RuleSpec(in, out, s)
 }
 
+func autoConvert_v1_Session_To_auth_Session(in *Session, out *auth.Session, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_SessionSpec_To_auth_SessionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_SessionStatus_To_auth_SessionStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_Session_To_auth_Session is an autogenerated conversion function.
+func Convert_v1_Session_To_auth_Session(in *Session, out *auth.Session, s conversion.Scope) error {
+	return autoConvert_v1_Session_To_auth_Session(in, out, s)
+}
+
+func autoConvert_auth_Session_To_v1_Session(in *auth.Session, out *Session, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_auth_SessionSpec_To_v1_SessionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_auth_SessionStatus_To_v1_SessionStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_auth_Session_To_v1_Session is an autogenerated conversion function.
+func Convert_auth_Session_To_v1_Session(in *auth.Session, out *Session, s conversion.Scope) error {
+	return autoConvert_auth_Session_To_v1_Session(in, out, s)
+}
+
+func autoConvert_v1_SessionList_To_auth_SessionList(in *SessionList, out *auth.SessionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]auth.Session)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_SessionList_To_auth_SessionList is an autogenerated conversion function.
+func Convert_v1_SessionList_To_auth_SessionList(in *SessionList, out *auth.SessionList, s conversion.Scope) error {
+	return autoConvert_v1_SessionList_To_auth_SessionList(in, out, s)
+}
+
+func autoConvert_auth_SessionList_To_v1_SessionList(in *auth.SessionList, out *SessionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Session)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_auth_SessionList_To_v1_SessionList is an autogenerated conversion function.
+func Convert_auth_SessionList_To_v1_SessionList(in *auth.SessionList, out *SessionList, s conversion.Scope) error {
+	return autoConvert_auth_SessionList_To_v1_SessionList(in, out, s)
+}
+
+func autoConvert_v1_SessionRevocation_To_auth_SessionRevocation(in *SessionRevocation, out *auth.SessionRevocation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_SessionRevocationSpec_To_auth_SessionRevocationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_SessionRevocation_To_auth_SessionRevocation is an autogenerated conversion function.
+func Convert_v1_SessionRevocation_To_auth_SessionRevocation(in *SessionRevocation, out *auth.SessionRevocation, s conversion.Scope) error {
+	return autoConvert_v1_SessionRevocation_To_auth_SessionRevocation(in, out, s)
+}
+
+func autoConvert_auth_SessionRevocation_To_v1_SessionRevocation(in *auth.SessionRevocation, out *SessionRevocation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_auth_SessionRevocationSpec_To_v1_SessionRevocationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_auth_SessionRevocation_To_v1_SessionRevocation is an autogenerated conversion function.
+func Convert_auth_SessionRevocation_To_v1_SessionRevocation(in *auth.SessionRevocation, out *SessionRevocation, s conversion.Scope) error {
+	return autoConvert_auth_SessionRevocation_To_v1_SessionRevocation(in, out, s)
+}
+
+func autoConvert_v1_SessionRevocationList_To_auth_SessionRevocationList(in *SessionRevocationList, out *auth.SessionRevocationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]auth.SessionRevocation)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_SessionRevocationList_To_auth_SessionRevocationList is an autogenerated conversion function.
+func Convert_v1_SessionRevocationList_To_auth_SessionRevocationList(in *SessionRevocationList, out *auth.SessionRevocationList, s conversion.Scope) error {
+	return autoConvert_v1_SessionRevocationList_To_auth_SessionRevocationList(in, out, s)
+}
+
+func autoConvert_auth_SessionRevocationList_To_v1_SessionRevocationList(in *auth.SessionRevocationList, out *SessionRevocationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]SessionRevocation)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_auth_SessionRevocationList_To_v1_SessionRevocationList is an autogenerated conversion function.
+func Convert_auth_SessionRevocationList_To_v1_SessionRevocationList(in *auth.SessionRevocationList, out *SessionRevocationList, s conversion.Scope) error {
+	return autoConvert_auth_SessionRevocationList_To_v1_SessionRevocationList(in, out, s)
+}
+
+func autoConvert_v1_SessionRevocationSpec_To_auth_SessionRevocationSpec(in *SessionRevocationSpec, out *auth.SessionRevocationSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Username = in.Username
+	out.SessionID = in.SessionID
+	out.RevokeTime = in.RevokeTime
+	out.ExpireTime = in.ExpireTime
+	return nil
+}
+
+// Convert_v1_SessionRevocationSpec_To_auth_SessionRevocationSpec is an autogenerated conversion function.
+func Convert_v1_SessionRevocationSpec_To_auth_SessionRevocationSpec(in *SessionRevocationSpec, out *auth.SessionRevocationSpec, s conversion.Scope) error {
+	return autoConvert_v1_SessionRevocationSpec_To_auth_SessionRevocationSpec(in, out, s)
+}
+
+func autoConvert_auth_SessionRevocationSpec_To_v1_SessionRevocationSpec(in *auth.SessionRevocationSpec, out *SessionRevocationSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Username = in.Username
+	out.SessionID = in.SessionID
+	out.RevokeTime = in.RevokeTime
+	out.ExpireTime = in.ExpireTime
+	return nil
+}
+
+// Convert_auth_SessionRevocationSpec_To_v1_SessionRevocationSpec is an autogenerated conversion function.
+func Convert_auth_SessionRevocationSpec_To_v1_SessionRevocationSpec(in *auth.SessionRevocationSpec, out *SessionRevocationSpec, s conversion.Scope) error {
+	return autoConvert_auth_SessionRevocationSpec_To_v1_SessionRevocationSpec(in, out, s)
+}
+
+func autoConvert_v1_SessionSpec_To_auth_SessionSpec(in *SessionSpec, out *auth.SessionSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Username = in.Username
+	out.UserID = in.UserID
+	out.ClientID = in.ClientID
+	out.SessionID = in.SessionID
+	out.ClientIP = in.ClientIP
+	return nil
+}
+
+// Convert_v1_SessionSpec_To_auth_SessionSpec is an autogenerated conversion function.
+func Convert_v1_SessionSpec_To_auth_SessionSpec(in *SessionSpec, out *auth.SessionSpec, s conversion.Scope) error {
+	return autoConvert_v1_SessionSpec_To_auth_SessionSpec(in, out, s)
+}
+
+func autoConvert_auth_SessionSpec_To_v1_SessionSpec(in *auth.SessionSpec, out *SessionSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Username = in.Username
+	out.UserID = in.UserID
+	out.ClientID = in.ClientID
+	out.SessionID = in.SessionID
+	out.ClientIP = in.ClientIP
+	return nil
+}
+
+// Convert_auth_SessionSpec_To_v1_SessionSpec is an autogenerated conversion function.
+func Convert_auth_SessionSpec_To_v1_SessionSpec(in *auth.SessionSpec, out *SessionSpec, s conversion.Scope) error {
+	return autoConvert_auth_SessionSpec_To_v1_SessionSpec(in, out, s)
+}
+
+func autoConvert_v1_SessionStatus_To_auth_SessionStatus(in *SessionStatus, out *auth.SessionStatus, s conversion.Scope) error {
+	out.IssuedTime = in.IssuedTime
+	out.LastUsedTime = in.LastUsedTime
+	return nil
+}
+
+// Convert_v1_SessionStatus_To_auth_SessionStatus is an autogenerated conversion function.
+func Convert_v1_SessionStatus_To_auth_SessionStatus(in *SessionStatus, out *auth.SessionStatus, s conversion.Scope) error {
+	return autoConvert_v1_SessionStatus_To_auth_SessionStatus(in, out, s)
+}
+
+func autoConvert_auth_SessionStatus_To_v1_SessionStatus(in *auth.SessionStatus, out *SessionStatus, s conversion.Scope) error {
+	out.IssuedTime = in.IssuedTime
+	out.LastUsedTime = in.LastUsedTime
+	return nil
+}
+
+// Convert_auth_SessionStatus_To_v1_SessionStatus is an autogenerated conversion function.
+func Convert_auth_SessionStatus_To_v1_SessionStatus(in *auth.SessionStatus, out *SessionStatus, s conversion.Scope) error {
+	return autoConvert_auth_SessionStatus_To_v1_SessionStatus(in, out, s)
+}
+
 func autoConvert_v1_Statement_To_auth_Statement(in *Statement, out *auth.Statement, s conversion.Scope) error {
 	out.Actions = *(*[]string)(unsafe.Pointer(&in.Actions))
 	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
@@ -2426,6 +3577,7 @@ func autoConvert_v1_SubjectAccessReviewSpec_To_auth_SubjectAccessReviewSpec(in *
 	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
 	out.Extra = *(*map[string]auth.ExtraValue)(unsafe.Pointer(&in.Extra))
 	out.UID = in.UID
+	out.SimulatedGroups = *(*[]string)(unsafe.Pointer(&in.SimulatedGroups))
 	return nil
 }
 
@@ -2442,6 +3594,7 @@ func autoConvert_auth_SubjectAccessReviewSpec_To_v1_SubjectAccessReviewSpec(in *
 	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
 	out.Extra = *(*map[string]ExtraValue)(unsafe.Pointer(&in.Extra))
 	out.UID = in.UID
+	out.SimulatedGroups = *(*[]string)(unsafe.Pointer(&in.SimulatedGroups))
 	return nil
 }
 
@@ -2456,6 +3609,7 @@ func autoConvert_v1_SubjectAccessReviewStatus_To_auth_SubjectAccessReviewStatus(
 	out.Reason = in.Reason
 	out.EvaluationError = in.EvaluationError
 	out.AllowedList = *(*[]*auth.AllowedStatus)(unsafe.Pointer(&in.AllowedList))
+	out.Explanation = (*auth.AuthorizationExplanation)(unsafe.Pointer(in.Explanation))
 	return nil
 }
 
@@ -2470,6 +3624,7 @@ func autoConvert_auth_SubjectAccessReviewStatus_To_v1_SubjectAccessReviewStatus(
 	out.Reason = in.Reason
 	out.EvaluationError = in.EvaluationError
 	out.AllowedList = *(*[]*AllowedStatus)(unsafe.Pointer(&in.AllowedList))
+	out.Explanation = (*AuthorizationExplanation)(unsafe.Pointer(in.Explanation))
 	return nil
 }
 
@@ -2478,6 +3633,206 @@ func Convert_auth_SubjectAccessReviewStatus_To_v1_SubjectAccessReviewStatus(in *
 	return autoConvert_auth_SubjectAccessReviewStatus_To_v1_SubjectAccessReviewStatus(in, out, s)
 }
 
+func autoConvert_v1_Tenant_To_auth_Tenant(in *Tenant, out *auth.Tenant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_TenantSpec_To_auth_TenantSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_TenantStatus_To_auth_TenantStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_Tenant_To_auth_Tenant is an autogenerated conversion function.
+func Convert_v1_Tenant_To_auth_Tenant(in *Tenant, out *auth.Tenant, s conversion.Scope) error {
+	return autoConvert_v1_Tenant_To_auth_Tenant(in, out, s)
+}
+
+func autoConvert_auth_Tenant_To_v1_Tenant(in *auth.Tenant, out *Tenant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_auth_TenantSpec_To_v1_TenantSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_auth_TenantStatus_To_v1_TenantStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_auth_Tenant_To_v1_Tenant is an autogenerated conversion function.
+func Convert_auth_Tenant_To_v1_Tenant(in *auth.Tenant, out *Tenant, s conversion.Scope) error {
+	return autoConvert_auth_Tenant_To_v1_Tenant(in, out, s)
+}
+
+func autoConvert_v1_TenantDeletionStatus_To_auth_TenantDeletionStatus(in *TenantDeletionStatus, out *auth.TenantDeletionStatus, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Phase = auth.TenantDeletionPhase(in.Phase)
+	out.Remaining = in.Remaining
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_TenantDeletionStatus_To_auth_TenantDeletionStatus is an autogenerated conversion function.
+func Convert_v1_TenantDeletionStatus_To_auth_TenantDeletionStatus(in *TenantDeletionStatus, out *auth.TenantDeletionStatus, s conversion.Scope) error {
+	return autoConvert_v1_TenantDeletionStatus_To_auth_TenantDeletionStatus(in, out, s)
+}
+
+func autoConvert_auth_TenantDeletionStatus_To_v1_TenantDeletionStatus(in *auth.TenantDeletionStatus, out *TenantDeletionStatus, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Phase = TenantDeletionPhase(in.Phase)
+	out.Remaining = in.Remaining
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_auth_TenantDeletionStatus_To_v1_TenantDeletionStatus is an autogenerated conversion function.
+func Convert_auth_TenantDeletionStatus_To_v1_TenantDeletionStatus(in *auth.TenantDeletionStatus, out *TenantDeletionStatus, s conversion.Scope) error {
+	return autoConvert_auth_TenantDeletionStatus_To_v1_TenantDeletionStatus(in, out, s)
+}
+
+func autoConvert_v1_TenantList_To_auth_TenantList(in *TenantList, out *auth.TenantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]auth.Tenant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_TenantList_To_auth_TenantList is an autogenerated conversion function.
+func Convert_v1_TenantList_To_auth_TenantList(in *TenantList, out *auth.TenantList, s conversion.Scope) error {
+	return autoConvert_v1_TenantList_To_auth_TenantList(in, out, s)
+}
+
+func autoConvert_auth_TenantList_To_v1_TenantList(in *auth.TenantList, out *TenantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Tenant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_auth_TenantList_To_v1_TenantList is an autogenerated conversion function.
+func Convert_auth_TenantList_To_v1_TenantList(in *auth.TenantList, out *TenantList, s conversion.Scope) error {
+	return autoConvert_auth_TenantList_To_v1_TenantList(in, out, s)
+}
+
+func autoConvert_v1_TenantQuota_To_auth_TenantQuota(in *TenantQuota, out *auth.TenantQuota, s conversion.Scope) error {
+	out.Clusters = (*int32)(unsafe.Pointer(in.Clusters))
+	out.Projects = (*int32)(unsafe.Pointer(in.Projects))
+	out.Registries = (*int32)(unsafe.Pointer(in.Registries))
+	out.Apps = (*int32)(unsafe.Pointer(in.Apps))
+	return nil
+}
+
+// Convert_v1_TenantQuota_To_auth_TenantQuota is an autogenerated conversion function.
+func Convert_v1_TenantQuota_To_auth_TenantQuota(in *TenantQuota, out *auth.TenantQuota, s conversion.Scope) error {
+	return autoConvert_v1_TenantQuota_To_auth_TenantQuota(in, out, s)
+}
+
+func autoConvert_auth_TenantQuota_To_v1_TenantQuota(in *auth.TenantQuota, out *TenantQuota, s conversion.Scope) error {
+	out.Clusters = (*int32)(unsafe.Pointer(in.Clusters))
+	out.Projects = (*int32)(unsafe.Pointer(in.Projects))
+	out.Registries = (*int32)(unsafe.Pointer(in.Registries))
+	out.Apps = (*int32)(unsafe.Pointer(in.Apps))
+	return nil
+}
+
+// Convert_auth_TenantQuota_To_v1_TenantQuota is an autogenerated conversion function.
+func Convert_auth_TenantQuota_To_v1_TenantQuota(in *auth.TenantQuota, out *TenantQuota, s conversion.Scope) error {
+	return autoConvert_auth_TenantQuota_To_v1_TenantQuota(in, out, s)
+}
+
+func autoConvert_v1_TenantSpec_To_auth_TenantSpec(in *TenantSpec, out *auth.TenantSpec, s conversion.Scope) error {
+	out.Finalizers = *(*[]auth.FinalizerName)(unsafe.Pointer(&in.Finalizers))
+	out.DisplayName = in.DisplayName
+	out.IdentityProviderType = in.IdentityProviderType
+	out.IdentityProviderConfig = in.IdentityProviderConfig
+	out.Administrators = *(*[]string)(unsafe.Pointer(&in.Administrators))
+	if err := Convert_v1_TenantQuota_To_auth_TenantQuota(&in.Quota, &out.Quota, s); err != nil {
+		return err
+	}
+	out.Suspended = in.Suspended
+	return nil
+}
+
+// Convert_v1_TenantSpec_To_auth_TenantSpec is an autogenerated conversion function.
+func Convert_v1_TenantSpec_To_auth_TenantSpec(in *TenantSpec, out *auth.TenantSpec, s conversion.Scope) error {
+	return autoConvert_v1_TenantSpec_To_auth_TenantSpec(in, out, s)
+}
+
+func autoConvert_auth_TenantSpec_To_v1_TenantSpec(in *auth.TenantSpec, out *TenantSpec, s conversion.Scope) error {
+	out.Finalizers = *(*[]FinalizerName)(unsafe.Pointer(&in.Finalizers))
+	out.DisplayName = in.DisplayName
+	out.IdentityProviderType = in.IdentityProviderType
+	out.IdentityProviderConfig = in.IdentityProviderConfig
+	out.Administrators = *(*[]string)(unsafe.Pointer(&in.Administrators))
+	if err := Convert_auth_TenantQuota_To_v1_TenantQuota(&in.Quota, &out.Quota, s); err != nil {
+		return err
+	}
+	out.Suspended = in.Suspended
+	return nil
+}
+
+// Convert_auth_TenantSpec_To_v1_TenantSpec is an autogenerated conversion function.
+func Convert_auth_TenantSpec_To_v1_TenantSpec(in *auth.TenantSpec, out *TenantSpec, s conversion.Scope) error {
+	return autoConvert_auth_TenantSpec_To_v1_TenantSpec(in, out, s)
+}
+
+func autoConvert_v1_TenantStatus_To_auth_TenantStatus(in *TenantStatus, out *auth.TenantStatus, s conversion.Scope) error {
+	out.Phase = auth.TenantPhase(in.Phase)
+	if err := Convert_v1_TenantUsage_To_auth_TenantUsage(&in.Used, &out.Used, s); err != nil {
+		return err
+	}
+	out.LastUsageTime = in.LastUsageTime
+	out.Deletion = *(*[]auth.TenantDeletionStatus)(unsafe.Pointer(&in.Deletion))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_TenantStatus_To_auth_TenantStatus is an autogenerated conversion function.
+func Convert_v1_TenantStatus_To_auth_TenantStatus(in *TenantStatus, out *auth.TenantStatus, s conversion.Scope) error {
+	return autoConvert_v1_TenantStatus_To_auth_TenantStatus(in, out, s)
+}
+
+func autoConvert_auth_TenantStatus_To_v1_TenantStatus(in *auth.TenantStatus, out *TenantStatus, s conversion.Scope) error {
+	out.Phase = TenantPhase(in.Phase)
+	if err := Convert_auth_TenantUsage_To_v1_TenantUsage(&in.Used, &out.Used, s); err != nil {
+		return err
+	}
+	out.LastUsageTime = in.LastUsageTime
+	out.Deletion = *(*[]TenantDeletionStatus)(unsafe.Pointer(&in.Deletion))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_auth_TenantStatus_To_v1_TenantStatus is an autogenerated conversion function.
+func Convert_auth_TenantStatus_To_v1_TenantStatus(in *auth.TenantStatus, out *TenantStatus, s conversion.Scope) error {
+	return autoConvert_auth_TenantStatus_To_v1_TenantStatus(in, out, s)
+}
+
+func autoConvert_v1_TenantUsage_To_auth_TenantUsage(in *TenantUsage, out *auth.TenantUsage, s conversion.Scope) error {
+	out.Clusters = in.Clusters
+	out.Projects = in.Projects
+	out.Registries = in.Registries
+	out.Apps = in.Apps
+	return nil
+}
+
+// Convert_v1_TenantUsage_To_auth_TenantUsage is an autogenerated conversion function.
+func Convert_v1_TenantUsage_To_auth_TenantUsage(in *TenantUsage, out *auth.TenantUsage, s conversion.Scope) error {
+	return autoConvert_v1_TenantUsage_To_auth_TenantUsage(in, out, s)
+}
+
+func autoConvert_auth_TenantUsage_To_v1_TenantUsage(in *auth.TenantUsage, out *TenantUsage, s conversion.Scope) error {
+	out.Clusters = in.Clusters
+	out.Projects = in.Projects
+	out.Registries = in.Registries
+	out.Apps = in.Apps
+	return nil
+}
+
+// Convert_auth_TenantUsage_To_v1_TenantUsage is an autogenerated conversion function.
+func Convert_auth_TenantUsage_To_v1_TenantUsage(in *auth.TenantUsage, out *TenantUsage, s conversion.Scope) error {
+	return autoConvert_auth_TenantUsage_To_v1_TenantUsage(in, out, s)
+}
+
 func autoConvert_v1_User_To_auth_User(in *User, out *auth.User, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_UserSpec_To_auth_UserSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2557,3 +3912,27 @@ func autoConvert_auth_UserSpec_To_v1_UserSpec(in *auth.UserSpec, out *UserSpec,
 func Convert_auth_UserSpec_To_v1_UserSpec(in *auth.UserSpec, out *UserSpec, s conversion.Scope) error {
 	return autoConvert_auth_UserSpec_To_v1_UserSpec(in, out, s)
 }
+
+func autoConvert_v1_VerificationKey_To_auth_VerificationKey(in *VerificationKey, out *auth.VerificationKey, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	out.PublicKey = *(*[]byte)(unsafe.Pointer(&in.PublicKey))
+	out.Expiry = in.Expiry
+	return nil
+}
+
+// Convert_v1_VerificationKey_To_auth_VerificationKey is an autogenerated conversion function.
+func Convert_v1_VerificationKey_To_auth_VerificationKey(in *VerificationKey, out *auth.VerificationKey, s conversion.Scope) error {
+	return autoConvert_v1_VerificationKey_To_auth_VerificationKey(in, out, s)
+}
+
+func autoConvert_auth_VerificationKey_To_v1_VerificationKey(in *auth.VerificationKey, out *VerificationKey, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	out.PublicKey = *(*[]byte)(unsafe.Pointer(&in.PublicKey))
+	out.Expiry = in.Expiry
+	return nil
+}
+
+// Convert_auth_VerificationKey_To_v1_VerificationKey is an autogenerated conversion function.
+func Convert_auth_VerificationKey_To_v1_VerificationKey(in *auth.VerificationKey, out *VerificationKey, s conversion.Scope) error {
+	return autoConvert_auth_VerificationKey_To_v1_VerificationKey(in, out, s)
+}
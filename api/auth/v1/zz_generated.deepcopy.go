@@ -32,7 +32,7 @@ func (in *APIKey) DeepCopyInto(out *APIKey) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -92,6 +92,11 @@ func (in *APIKeyReq) DeepCopyInto(out *APIKeyReq) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.Expire = in.Expire
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(APIKeyScope)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -118,6 +123,11 @@ func (in *APIKeyReqPassword) DeepCopyInto(out *APIKeyReqPassword) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.Expire = in.Expire
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(APIKeyScope)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -139,11 +149,76 @@ func (in *APIKeyReqPassword) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyRotateReq) DeepCopyInto(out *APIKeyRotateReq) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.Expire = in.Expire
+	out.Overlap = in.Overlap
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyRotateReq.
+func (in *APIKeyRotateReq) DeepCopy() *APIKeyRotateReq {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyRotateReq)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *APIKeyRotateReq) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyScope) DeepCopyInto(out *APIKeyScope) {
+	*out = *in
+	if in.Statements != nil {
+		in, out := &in.Statements, &out.Statements
+		*out = make([]Statement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceCIDRs != nil {
+		in, out := &in.SourceCIDRs, &out.SourceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyScope.
+func (in *APIKeyScope) DeepCopy() *APIKeyScope {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIKeySpec) DeepCopyInto(out *APIKeySpec) {
 	*out = *in
 	in.IssueAt.DeepCopyInto(&out.IssueAt)
 	in.ExpireAt.DeepCopyInto(&out.ExpireAt)
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(APIKeyScope)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -160,6 +235,8 @@ func (in *APIKeySpec) DeepCopy() *APIKeySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIKeyStatus) DeepCopyInto(out *APIKeyStatus) {
 	*out = *in
+	in.LastUsedTime.DeepCopyInto(&out.LastUsedTime)
+	in.RetireTime.DeepCopyInto(&out.RetireTime)
 	return
 }
 
@@ -188,6 +265,14 @@ func (in *APISigningKey) DeepCopyInto(out *APISigningKey) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	in.RotateTime.DeepCopyInto(&out.RotateTime)
+	if in.VerificationKeys != nil {
+		in, out := &in.VerificationKeys, &out.VerificationKeys
+		*out = make([]VerificationKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -243,66 +328,76 @@ func (in *APISigningKeyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Action) DeepCopyInto(out *Action) {
+func (in *AccessRequest) DeepCopyInto(out *AccessRequest) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Action.
-func (in *Action) DeepCopy() *Action {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRequest.
+func (in *AccessRequest) DeepCopy() *AccessRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(Action)
+	out := new(AccessRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AllowedStatus) DeepCopyInto(out *AllowedStatus) {
+func (in *AccessRequestBinding) DeepCopyInto(out *AccessRequestBinding) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedStatus.
-func (in *AllowedStatus) DeepCopy() *AllowedStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRequestBinding.
+func (in *AccessRequestBinding) DeepCopy() *AccessRequestBinding {
 	if in == nil {
 		return nil
 	}
-	out := new(AllowedStatus)
+	out := new(AccessRequestBinding)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Binding) DeepCopyInto(out *Binding) {
+func (in *AccessRequestList) DeepCopyInto(out *AccessRequestList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	if in.Users != nil {
-		in, out := &in.Users, &out.Users
-		*out = make([]Subject, len(*in))
-		copy(*out, *in)
-	}
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
-		*out = make([]Subject, len(*in))
-		copy(*out, *in)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Binding.
-func (in *Binding) DeepCopy() *Binding {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRequestList.
+func (in *AccessRequestList) DeepCopy() *AccessRequestList {
 	if in == nil {
 		return nil
 	}
-	out := new(Binding)
+	out := new(AccessRequestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Binding) DeepCopyObject() runtime.Object {
+func (in *AccessRequestList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -310,26 +405,24 @@ func (in *Binding) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Category) DeepCopyInto(out *Category) {
+func (in *AccessRequestReview) DeepCopyInto(out *AccessRequestReview) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Category.
-func (in *Category) DeepCopy() *Category {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRequestReview.
+func (in *AccessRequestReview) DeepCopy() *AccessRequestReview {
 	if in == nil {
 		return nil
 	}
-	out := new(Category)
+	out := new(AccessRequestReview)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Category) DeepCopyObject() runtime.Object {
+func (in *AccessRequestReview) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -337,80 +430,78 @@ func (in *Category) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CategoryList) DeepCopyInto(out *CategoryList) {
+func (in *AccessRequestSpec) DeepCopyInto(out *AccessRequestSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Category, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
+	out.Duration = in.Duration
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryList.
-func (in *CategoryList) DeepCopy() *CategoryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRequestSpec.
+func (in *AccessRequestSpec) DeepCopy() *AccessRequestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CategoryList)
+	out := new(AccessRequestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CategoryList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CategorySpec) DeepCopyInto(out *CategorySpec) {
+func (in *AccessRequestStatus) DeepCopyInto(out *AccessRequestStatus) {
 	*out = *in
-	if in.Actions != nil {
-		in, out := &in.Actions, &out.Actions
-		*out = make([]Action, len(*in))
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.ReviewTime.DeepCopyInto(&out.ReviewTime)
+	in.ExpireTime.DeepCopyInto(&out.ExpireTime)
+	in.NotifyTime.DeepCopyInto(&out.NotifyTime)
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]AccessRequestBinding, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategorySpec.
-func (in *CategorySpec) DeepCopy() *CategorySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessRequestStatus.
+func (in *AccessRequestStatus) DeepCopy() *AccessRequestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CategorySpec)
+	out := new(AccessRequestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Client) DeepCopyInto(out *Client) {
+func (in *AccessReview) DeepCopyInto(out *AccessReview) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Client.
-func (in *Client) DeepCopy() *Client {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessReview.
+func (in *AccessReview) DeepCopy() *AccessReview {
 	if in == nil {
 		return nil
 	}
-	out := new(Client)
+	out := new(AccessReview)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Client) DeepCopyObject() runtime.Object {
+func (in *AccessReview) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -418,13 +509,19 @@ func (in *Client) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientList) DeepCopyInto(out *ClientList) {
+func (in *AccessReviewDiff) DeepCopyInto(out *AccessReviewDiff) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Client, len(*in))
+	if in.Added != nil {
+		in, out := &in.Added, &out.Added
+		*out = make([]AccessReviewEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Removed != nil {
+		in, out := &in.Removed, &out.Removed
+		*out = make([]AccessReviewEntry, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -432,18 +529,18 @@ func (in *ClientList) DeepCopyInto(out *ClientList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientList.
-func (in *ClientList) DeepCopy() *ClientList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessReviewDiff.
+func (in *AccessReviewDiff) DeepCopy() *AccessReviewDiff {
 	if in == nil {
 		return nil
 	}
-	out := new(ClientList)
+	out := new(AccessReviewDiff)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClientList) DeepCopyObject() runtime.Object {
+func (in *AccessReviewDiff) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -451,73 +548,24 @@ func (in *ClientList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClientSpec) DeepCopyInto(out *ClientSpec) {
-	*out = *in
-	if in.RedirectUris != nil {
-		in, out := &in.RedirectUris, &out.RedirectUris
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.TrustedPeers != nil {
-		in, out := &in.TrustedPeers, &out.TrustedPeers
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSpec.
-func (in *ClientSpec) DeepCopy() *ClientSpec {
-	if in == nil {
-		return nil
-	}
-	out := new(ClientSpec)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigMap) DeepCopyInto(out *ConfigMap) {
+func (in *AccessReviewDiffOptions) DeepCopyInto(out *AccessReviewDiffOptions) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	if in.Data != nil {
-		in, out := &in.Data, &out.Data
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.BinaryData != nil {
-		in, out := &in.BinaryData, &out.BinaryData
-		*out = make(map[string][]byte, len(*in))
-		for key, val := range *in {
-			var outVal []byte
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = make([]byte, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMap.
-func (in *ConfigMap) DeepCopy() *ConfigMap {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessReviewDiffOptions.
+func (in *AccessReviewDiffOptions) DeepCopy() *AccessReviewDiffOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigMap)
+	out := new(AccessReviewDiffOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ConfigMap) DeepCopyObject() runtime.Object {
+func (in *AccessReviewDiffOptions) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -525,60 +573,41 @@ func (in *ConfigMap) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigMapList) DeepCopyInto(out *ConfigMapList) {
+func (in *AccessReviewEntry) DeepCopyInto(out *AccessReviewEntry) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ConfigMap, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.Permission.DeepCopyInto(&out.Permission)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapList.
-func (in *ConfigMapList) DeepCopy() *ConfigMapList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessReviewEntry.
+func (in *AccessReviewEntry) DeepCopy() *AccessReviewEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigMapList)
+	out := new(AccessReviewEntry)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ConfigMapList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomPolicyBinding) DeepCopyInto(out *CustomPolicyBinding) {
+func (in *AccessReviewExportOptions) DeepCopyInto(out *AccessReviewExportOptions) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPolicyBinding.
-func (in *CustomPolicyBinding) DeepCopy() *CustomPolicyBinding {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessReviewExportOptions.
+func (in *AccessReviewExportOptions) DeepCopy() *AccessReviewExportOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomPolicyBinding)
+	out := new(AccessReviewExportOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CustomPolicyBinding) DeepCopyObject() runtime.Object {
+func (in *AccessReviewExportOptions) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -586,13 +615,13 @@ func (in *CustomPolicyBinding) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomPolicyBindingList) DeepCopyInto(out *CustomPolicyBindingList) {
+func (in *AccessReviewList) DeepCopyInto(out *AccessReviewList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CustomPolicyBinding, len(*in))
+		*out = make([]AccessReview, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -600,18 +629,18 @@ func (in *CustomPolicyBindingList) DeepCopyInto(out *CustomPolicyBindingList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPolicyBindingList.
-func (in *CustomPolicyBindingList) DeepCopy() *CustomPolicyBindingList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessReviewList.
+func (in *AccessReviewList) DeepCopy() *AccessReviewList {
 	if in == nil {
 		return nil
 	}
-	out := new(CustomPolicyBindingList)
+	out := new(AccessReviewList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CustomPolicyBindingList) DeepCopyObject() runtime.Object {
+func (in *AccessReviewList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -619,25 +648,520 @@ func (in *CustomPolicyBindingList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CustomPolicyBindingSpec) DeepCopyInto(out *CustomPolicyBindingSpec) {
+func (in *AccessReviewPermission) DeepCopyInto(out *AccessReviewPermission) {
 	*out = *in
-	if in.Finalizers != nil {
-		in, out := &in.Finalizers, &out.Finalizers
-		*out = make([]FinalizerName, len(*in))
-		copy(*out, *in)
-	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Users != nil {
-		in, out := &in.Users, &out.Users
-		*out = make([]Subject, len(*in))
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessReviewPermission.
+func (in *AccessReviewPermission) DeepCopy() *AccessReviewPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessReviewPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessReviewSpec) DeepCopyInto(out *AccessReviewSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessReviewSpec.
+func (in *AccessReviewSpec) DeepCopy() *AccessReviewSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessReviewSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessReviewStatus) DeepCopyInto(out *AccessReviewStatus) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]AccessReviewSubject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessReviewStatus.
+func (in *AccessReviewStatus) DeepCopy() *AccessReviewStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessReviewStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessReviewSubject) DeepCopyInto(out *AccessReviewSubject) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]AccessReviewPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessReviewSubject.
+func (in *AccessReviewSubject) DeepCopy() *AccessReviewSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessReviewSubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Action) DeepCopyInto(out *Action) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Action.
+func (in *Action) DeepCopy() *Action {
+	if in == nil {
+		return nil
+	}
+	out := new(Action)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllowedStatus) DeepCopyInto(out *AllowedStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllowedStatus.
+func (in *AllowedStatus) DeepCopy() *AllowedStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AllowedStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationExplanation) DeepCopyInto(out *AuthorizationExplanation) {
+	*out = *in
+	if in.MatchedRules != nil {
+		in, out := &in.MatchedRules, &out.MatchedRules
+		*out = make([]MatchedRule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationExplanation.
+func (in *AuthorizationExplanation) DeepCopy() *AuthorizationExplanation {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationExplanation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Binding) DeepCopyInto(out *Binding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]Subject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]Subject, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Binding.
+func (in *Binding) DeepCopy() *Binding {
+	if in == nil {
+		return nil
+	}
+	out := new(Binding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Binding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Category) DeepCopyInto(out *Category) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Category.
+func (in *Category) DeepCopy() *Category {
+	if in == nil {
+		return nil
+	}
+	out := new(Category)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Category) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryList) DeepCopyInto(out *CategoryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Category, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryList.
+func (in *CategoryList) DeepCopy() *CategoryList {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CategoryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategorySpec) DeepCopyInto(out *CategorySpec) {
+	*out = *in
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]Action, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategorySpec.
+func (in *CategorySpec) DeepCopy() *CategorySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CategorySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Client) DeepCopyInto(out *Client) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Client.
+func (in *Client) DeepCopy() *Client {
+	if in == nil {
+		return nil
+	}
+	out := new(Client)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Client) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientList) DeepCopyInto(out *ClientList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Client, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientList.
+func (in *ClientList) DeepCopy() *ClientList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClientList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSpec) DeepCopyInto(out *ClientSpec) {
+	*out = *in
+	if in.RedirectUris != nil {
+		in, out := &in.RedirectUris, &out.RedirectUris
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrustedPeers != nil {
+		in, out := &in.TrustedPeers, &out.TrustedPeers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSpec.
+func (in *ClientSpec) DeepCopy() *ClientSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMap) DeepCopyInto(out *ConfigMap) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BinaryData != nil {
+		in, out := &in.BinaryData, &out.BinaryData
+		*out = make(map[string][]byte, len(*in))
+		for key, val := range *in {
+			var outVal []byte
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]byte, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMap.
+func (in *ConfigMap) DeepCopy() *ConfigMap {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigMap) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapList) DeepCopyInto(out *ConfigMapList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ConfigMap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapList.
+func (in *ConfigMapList) DeepCopy() *ConfigMapList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigMapList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPolicyBinding) DeepCopyInto(out *CustomPolicyBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPolicyBinding.
+func (in *CustomPolicyBinding) DeepCopy() *CustomPolicyBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPolicyBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomPolicyBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPolicyBindingList) DeepCopyInto(out *CustomPolicyBindingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CustomPolicyBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPolicyBindingList.
+func (in *CustomPolicyBindingList) DeepCopy() *CustomPolicyBindingList {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPolicyBindingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomPolicyBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPolicyBindingSpec) DeepCopyInto(out *CustomPolicyBindingSpec) {
+	*out = *in
+	if in.Finalizers != nil {
+		in, out := &in.Finalizers, &out.Finalizers
+		*out = make([]FinalizerName, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]Subject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
 		*out = make([]Subject, len(*in))
 		copy(*out, *in)
 	}
@@ -1084,6 +1608,14 @@ func (in *LocalIdentitySpec) DeepCopy() *LocalIdentitySpec {
 func (in *LocalIdentityStatus) DeepCopyInto(out *LocalIdentityStatus) {
 	*out = *in
 	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	in.LastFailedLoginTime.DeepCopyInto(&out.LastFailedLoginTime)
+	in.LockedUntil.DeepCopyInto(&out.LockedUntil)
+	in.PasswordUpdateTime.DeepCopyInto(&out.PasswordUpdateTime)
+	if in.PasswordHistory != nil {
+		in, out := &in.PasswordHistory, &out.PasswordHistory
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1097,6 +1629,22 @@ func (in *LocalIdentityStatus) DeepCopy() *LocalIdentityStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MatchedRule) DeepCopyInto(out *MatchedRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MatchedRule.
+func (in *MatchedRule) DeepCopy() *MatchedRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MatchedRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NonResourceAttributes) DeepCopyInto(out *NonResourceAttributes) {
 	*out = *in
@@ -1569,33 +2117,222 @@ func (in *ResourceAttributes) DeepCopy() *ResourceAttributes {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceAttributes)
+	out := new(ResourceAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Role) DeepCopyInto(out *Role) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Role.
+func (in *Role) DeepCopy() *Role {
+	if in == nil {
+		return nil
+	}
+	out := new(Role)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Role) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleList) DeepCopyInto(out *RoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Role, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleList.
+func (in *RoleList) DeepCopy() *RoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
+	*out = *in
+	if in.Finalizers != nil {
+		in, out := &in.Finalizers, &out.Finalizers
+		*out = make([]FinalizerName, len(*in))
+		copy(*out, *in)
+	}
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
+func (in *RoleSpec) DeepCopy() *RoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]Subject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]Subject, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStatus.
+func (in *RoleStatus) DeepCopy() *RoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rule) DeepCopyInto(out *Rule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rule.
+func (in *Rule) DeepCopy() *Rule {
+	if in == nil {
+		return nil
+	}
+	out := new(Rule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Rule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleList) DeepCopyInto(out *RuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Rule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleList.
+func (in *RuleList) DeepCopy() *RuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuleSpec) DeepCopyInto(out *RuleSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleSpec.
+func (in *RuleSpec) DeepCopy() *RuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RuleSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Role) DeepCopyInto(out *Role) {
+func (in *Session) DeepCopyInto(out *Session) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Role.
-func (in *Role) DeepCopy() *Role {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Session.
+func (in *Session) DeepCopy() *Session {
 	if in == nil {
 		return nil
 	}
-	out := new(Role)
+	out := new(Session)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Role) DeepCopyObject() runtime.Object {
+func (in *Session) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1603,13 +2340,13 @@ func (in *Role) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleList) DeepCopyInto(out *RoleList) {
+func (in *SessionList) DeepCopyInto(out *SessionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Role, len(*in))
+		*out = make([]Session, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1617,18 +2354,18 @@ func (in *RoleList) DeepCopyInto(out *RoleList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleList.
-func (in *RoleList) DeepCopy() *RoleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionList.
+func (in *SessionList) DeepCopy() *SessionList {
 	if in == nil {
 		return nil
 	}
-	out := new(RoleList)
+	out := new(SessionList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RoleList) DeepCopyObject() runtime.Object {
+func (in *SessionList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1636,78 +2373,59 @@ func (in *RoleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
+func (in *SessionRevocation) DeepCopyInto(out *SessionRevocation) {
 	*out = *in
-	if in.Finalizers != nil {
-		in, out := &in.Finalizers, &out.Finalizers
-		*out = make([]FinalizerName, len(*in))
-		copy(*out, *in)
-	}
-	if in.Policies != nil {
-		in, out := &in.Policies, &out.Policies
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
-func (in *RoleSpec) DeepCopy() *RoleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionRevocation.
+func (in *SessionRevocation) DeepCopy() *SessionRevocation {
 	if in == nil {
 		return nil
 	}
-	out := new(RoleSpec)
+	out := new(SessionRevocation)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
-	*out = *in
-	if in.Users != nil {
-		in, out := &in.Users, &out.Users
-		*out = make([]Subject, len(*in))
-		copy(*out, *in)
-	}
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
-		*out = make([]Subject, len(*in))
-		copy(*out, *in)
-	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStatus.
-func (in *RoleStatus) DeepCopy() *RoleStatus {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SessionRevocation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(RoleStatus)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Rule) DeepCopyInto(out *Rule) {
+func (in *SessionRevocationList) DeepCopyInto(out *SessionRevocationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SessionRevocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rule.
-func (in *Rule) DeepCopy() *Rule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionRevocationList.
+func (in *SessionRevocationList) DeepCopy() *SessionRevocationList {
 	if in == nil {
 		return nil
 	}
-	out := new(Rule)
+	out := new(SessionRevocationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Rule) DeepCopyObject() runtime.Object {
+func (in *SessionRevocationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1715,50 +2433,53 @@ func (in *Rule) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RuleList) DeepCopyInto(out *RuleList) {
+func (in *SessionRevocationSpec) DeepCopyInto(out *SessionRevocationSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Rule, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.RevokeTime.DeepCopyInto(&out.RevokeTime)
+	in.ExpireTime.DeepCopyInto(&out.ExpireTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleList.
-func (in *RuleList) DeepCopy() *RuleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionRevocationSpec.
+func (in *SessionRevocationSpec) DeepCopy() *SessionRevocationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RuleList)
+	out := new(SessionRevocationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RuleList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SessionSpec) DeepCopyInto(out *SessionSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionSpec.
+func (in *SessionSpec) DeepCopy() *SessionSpec {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(SessionSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RuleSpec) DeepCopyInto(out *RuleSpec) {
+func (in *SessionStatus) DeepCopyInto(out *SessionStatus) {
 	*out = *in
+	in.IssuedTime.DeepCopyInto(&out.IssuedTime)
+	in.LastUsedTime.DeepCopyInto(&out.LastUsedTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuleSpec.
-func (in *RuleSpec) DeepCopy() *RuleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionStatus.
+func (in *SessionStatus) DeepCopy() *SessionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RuleSpec)
+	out := new(SessionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1877,6 +2598,11 @@ func (in *SubjectAccessReviewSpec) DeepCopyInto(out *SubjectAccessReviewSpec) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.SimulatedGroups != nil {
+		in, out := &in.SimulatedGroups, &out.SimulatedGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1904,6 +2630,11 @@ func (in *SubjectAccessReviewStatus) DeepCopyInto(out *SubjectAccessReviewStatus
 			}
 		}
 	}
+	if in.Explanation != nil {
+		in, out := &in.Explanation, &out.Explanation
+		*out = new(AuthorizationExplanation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1917,6 +2648,185 @@ func (in *SubjectAccessReviewStatus) DeepCopy() *SubjectAccessReviewStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tenant) DeepCopyInto(out *Tenant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tenant.
+func (in *Tenant) DeepCopy() *Tenant {
+	if in == nil {
+		return nil
+	}
+	out := new(Tenant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Tenant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantDeletionStatus) DeepCopyInto(out *TenantDeletionStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantDeletionStatus.
+func (in *TenantDeletionStatus) DeepCopy() *TenantDeletionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantDeletionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantList) DeepCopyInto(out *TenantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Tenant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantList.
+func (in *TenantList) DeepCopy() *TenantList {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TenantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantQuota) DeepCopyInto(out *TenantQuota) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Apps != nil {
+		in, out := &in.Apps, &out.Apps
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantQuota.
+func (in *TenantQuota) DeepCopy() *TenantQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantSpec) DeepCopyInto(out *TenantSpec) {
+	*out = *in
+	if in.Finalizers != nil {
+		in, out := &in.Finalizers, &out.Finalizers
+		*out = make([]FinalizerName, len(*in))
+		copy(*out, *in)
+	}
+	if in.Administrators != nil {
+		in, out := &in.Administrators, &out.Administrators
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Quota.DeepCopyInto(&out.Quota)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantSpec.
+func (in *TenantSpec) DeepCopy() *TenantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantStatus) DeepCopyInto(out *TenantStatus) {
+	*out = *in
+	out.Used = in.Used
+	in.LastUsageTime.DeepCopyInto(&out.LastUsageTime)
+	if in.Deletion != nil {
+		in, out := &in.Deletion, &out.Deletion
+		*out = make([]TenantDeletionStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantStatus.
+func (in *TenantStatus) DeepCopy() *TenantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TenantUsage) DeepCopyInto(out *TenantUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenantUsage.
+func (in *TenantUsage) DeepCopy() *TenantUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(TenantUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
@@ -1999,3 +2909,25 @@ func (in *UserSpec) DeepCopy() *UserSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationKey) DeepCopyInto(out *VerificationKey) {
+	*out = *in
+	if in.PublicKey != nil {
+		in, out := &in.PublicKey, &out.PublicKey
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	in.Expiry.DeepCopyInto(&out.Expiry)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationKey.
+func (in *VerificationKey) DeepCopy() *VerificationKey {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationKey)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -62,6 +62,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Project{},
 		&ProjectList{},
+		&ProjectQuotaTree{},
 
 		&Namespace{},
 		&NamespaceList{},
@@ -83,6 +84,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 
 		&NsEmigration{},
 		&NsEmigrationList{},
+		&NamespaceTemplate{},
+		&NamespaceTemplateList{},
+		&ProjectRequest{},
+		&ProjectRequestList{},
 	)
 	return nil
 }
@@ -19,6 +19,7 @@
 package business
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -72,6 +73,71 @@ type ProjectSpec struct {
 	// Clusters represents clusters that can be used and the resource limits of each cluster.
 	// +optional
 	Clusters ClusterHard
+	// QuotaBorrowing lets the project allocate the idle quota of the parent
+	// project beyond its own quota, borrowing is disabled if nil.
+	// +optional
+	QuotaBorrowing *QuotaBorrowing
+	// QuotaAlert notifies the members of the project when the quota usage of
+	// the project or its namespaces crosses the thresholds.
+	// The usage of the project in a cluster is the quota allocated to its
+	// child projects and namespaces, not the resources used in them.
+	// +optional
+	QuotaAlert *QuotaAlert
+	// CertificateAlert notifies the members of the project when the
+	// certificates of its namespaces fail to be renewed.
+	// +optional
+	CertificateAlert *CertificateAlert
+	// Frozen scales the workloads of the namespaces of the project down to
+	// zero and stops new pods from running, they are restored once unfrozen.
+	// +optional
+	Frozen bool
+}
+
+// CertificateAlert represents where the alerts of the certificates of the
+// namespaces in a project are sent.
+type CertificateAlert struct {
+	// Channel is the name of the notify channel the alerts are sent through.
+	Channel string
+	// Template is the name of the message template in the channel.
+	Template string
+}
+
+// QuotaBorrowing is the borrowing of quota from the parent project.
+type QuotaBorrowing struct {
+	// Limits caps the quantity borrowed from the parent project per cluster
+	// and resource, the resources absent can not be borrowed.
+	Limits ClusterHard
+}
+
+// QuotaAlert represents the quota usage alert rules of a project.
+type QuotaAlert struct {
+	// Channel is the name of the notify channel the alerts are sent through.
+	Channel string
+	// Template is the name of the message template in the channel.
+	Template string
+	Rules    []QuotaAlertRule
+}
+
+// QuotaAlertRule represents the alert thresholds of a resource.
+type QuotaAlertRule struct {
+	// Resource is the name of the resource, such as requests.cpu, limits.memory or pods.
+	Resource string
+	// Thresholds are the percentages of the quota to alert at, such as 80 and 95.
+	Thresholds []int32
+}
+
+// QuotaAlertState represents the highest threshold alerted of a resource in
+// a cluster of the project or a namespace of the project.
+type QuotaAlertState struct {
+	ClusterName string
+	// Namespace is the name of the namespace, empty for the project.
+	// +optional
+	Namespace string
+	Resource  string
+	Threshold int32
+	// The last time the alert was sent.
+	// +optional
+	LastNotifyTime metav1.Time
 }
 
 // ProjectStatus represents information about the status of a project.
@@ -100,6 +166,69 @@ type ProjectStatus struct {
 	// A human readable message indicating details about the transition.
 	// +optional
 	Message string
+	// QuotaAlerts represents the alerts sent for the quota usage that is
+	// still above the thresholds.
+	// +optional
+	QuotaAlerts []QuotaAlertState
+	// Freeze represents the progress of freezing or unfreezing the project,
+	// nil if the project is not frozen.
+	// +optional
+	Freeze *ProjectFreezeStatus
+}
+
+// ProjectFreezeStatus represents the state of the project saved when it was
+// frozen, which is restored when it is unfrozen.
+type ProjectFreezeStatus struct {
+	// +optional
+	Phase ProjectFreezePhase
+	// Locked is the lock of the project before it was frozen.
+	// +optional
+	Locked *bool
+	// Namespaces represents the namespaces of the project that have been frozen.
+	// +optional
+	Namespaces []FrozenNamespace
+	// The last time the freeze transitioned from one phase to another.
+	// +optional
+	LastTransitionTime metav1.Time
+	// A human readable message indicating details about the last failure.
+	// +optional
+	Message string
+}
+
+// ProjectFreezePhase defines the phase of freezing a project.
+type ProjectFreezePhase string
+
+const (
+	// ProjectFreezing indicates the workloads of the project are being scaled down.
+	ProjectFreezing ProjectFreezePhase = "Freezing"
+	// ProjectFrozen indicates the workloads of the project have been scaled down.
+	ProjectFrozen ProjectFreezePhase = "Frozen"
+	// ProjectUnfreezing indicates the workloads of the project are being restored.
+	ProjectUnfreezing ProjectFreezePhase = "Unfreezing"
+)
+
+// FrozenNamespace represents the workloads of a namespace scaled down when
+// the project was frozen.
+type FrozenNamespace struct {
+	// Name is the name of the namespace object in the project.
+	Name        string
+	ClusterName string
+	Namespace   string
+	// Workloads represents the replicas of the workloads before they were scaled down.
+	// +optional
+	Workloads []FrozenWorkload
+	// Frozen indicates all the workloads of the namespace have been scaled down.
+	// +optional
+	Frozen bool
+}
+
+// FrozenWorkload represents the replicas of a workload before it was scaled down.
+type FrozenWorkload struct {
+	// Kind is the kind of the workload, Deployment, StatefulSet, DaemonSet, Job
+	// or CronJob, the replicas of a Job are its parallelism.
+	Kind     string
+	Name     string
+	Replicas int32
 }
 
 // ProjectPhase defines the phase of project constructor.
@@ -150,7 +279,55 @@ type ClusterHard map[string]HardQuantity
 type ClusterUsed map[string]UsedQuantity
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
-// NamespaceCertOptions is query options of getting namespace with a x509 certificate.
+
+// ProjectQuotaTree shows the quota allocated and used at every level of the
+// tree of a project.
+type ProjectQuotaTree struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Root is the project the tree is requested for.
+	Root ProjectQuotaNode
+}
+
+// ProjectQuotaNode is the quota of a project in the quota tree.
+type ProjectQuotaNode struct {
+	Name string
+	// +optional
+	DisplayName string
+	// Hard is the quota of the project.
+	// +optional
+	Hard ClusterHard
+	// Allocated is the quota allocated to the child projects and namespaces.
+	// +optional
+	Allocated ClusterUsed
+	// Borrowed is the quota allocated beyond the quota of the project, which
+	// is borrowed from the parent project.
+	// +optional
+	Borrowed ClusterUsed
+	// Used is the quota used by the namespaces of the project and of all its
+	// descendants.
+	// +optional
+	Used ClusterUsed
+	// +optional
+	Namespaces []NamespaceQuotaNode
+	// +optional
+	Children []ProjectQuotaNode
+}
+
+// NamespaceQuotaNode is the quota of a namespace in the quota tree.
+type NamespaceQuotaNode struct {
+	Name        string
+	ClusterName string
+	// +optional
+	Hard ResourceList
+	// +optional
+	Used ResourceList
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// NamespaceCertOptions is the options of issuing a x509 certificate of a namespace.
 type NamespaceCertOptions struct {
 	metav1.TypeMeta
 
@@ -199,6 +376,11 @@ type NamespaceSpec struct {
 	// Hard represents the total resources of a namespace.
 	// +optional
 	Hard ResourceList
+	// RevokedCertificates are the serial numbers of the certificates of the
+	// namespace to revoke, the revoked certificates are no longer renewed and
+	// expire within a day.
+	// +optional
+	RevokedCertificates []string
 }
 
 // NamespaceStatus represents information about the status of a namespace in project.
@@ -223,8 +405,87 @@ type NamespaceStatus struct {
 	CachedSpecHard ResourceList
 	// +optional
 	Certificate *NamespaceCert
+	// Templates represents the sync status of the namespace templates of the
+	// project in the namespace.
+	// +optional
+	Templates []NamespaceTemplateSync
+	// Certificates represents the short-lived x509 certificates issued for
+	// the users of the namespace, which are renewed before they expire.
+	// +optional
+	Certificates []NamespaceCertStatus
+}
+
+// NamespaceCertStatus represents a x509 certificate issued for a user of a namespace.
+type NamespaceCertStatus struct {
+	Username     string
+	SerialNumber string
+	// ValidDays is how many days after the key was issued the certificate is
+	// renewed with the same key.
+	ValidDays int32
+	// +optional
+	NotBefore metav1.Time
+	// NotAfter is the time the certificate expires.
+	// +optional
+	NotAfter metav1.Time
+	// +optional
+	Phase NamespaceCertPhase
+	// CertPem is the certificate, the private key is only returned when the
+	// certificate is issued and is never stored.
+	// +optional
+	CertPem []byte
+	// A human readable message indicating why the certificate failed to be renewed.
+	// +optional
+	Message string
+	// The last time the failure to renew the certificate was notified.
+	// +optional
+	LastNotifyTime metav1.Time
+	// RenewUntil is the time the certificate stops being renewed.
+	// +optional
+	RenewUntil metav1.Time
 }
 
+// NamespaceCertPhase indicates the status of a certificate of a namespace.
+type NamespaceCertPhase string
+
+// These are valid status of the certificates of a namespace.
+const (
+	// NamespaceCertActive indicates the certificate is the one in use by the user.
+	NamespaceCertActive NamespaceCertPhase = "Active"
+	// NamespaceCertSuperseded indicates the certificate has been renewed, it
+	// is kept valid until it expires so that the user can switch to the new one.
+	NamespaceCertSuperseded NamespaceCertPhase = "Superseded"
+	// NamespaceCertRevoked indicates the certificate has been revoked.
+	NamespaceCertRevoked NamespaceCertPhase = "Revoked"
+)
+
+// NamespaceTemplateSync represents the sync status of a namespace template in a namespace.
+type NamespaceTemplateSync struct {
+	// Name is the name of the namespace template.
+	Name string
+	// ObservedGeneration is the generation of the namespace template last applied.
+	// +optional
+	ObservedGeneration int64
+	// +optional
+	Phase NamespaceTemplateSyncPhase
+	// The last time the namespace template was applied.
+	// +optional
+	LastSyncTime metav1.Time
+	// A human readable message indicating why the namespace template failed to be applied.
+	// +optional
+	Message string
+}
+
+// NamespaceTemplateSyncPhase indicates the sync status of a namespace template in a namespace.
+type NamespaceTemplateSyncPhase string
+
+// These are valid sync status of namespace templates.
+const (
+	// NamespaceTemplateSynced indicates the namespace template has been applied to the namespace.
+	NamespaceTemplateSynced NamespaceTemplateSyncPhase = "Synced"
+	// NamespaceTemplateSyncFailed indicates the namespace template failed to be applied to the namespace.
+	NamespaceTemplateSyncFailed NamespaceTemplateSyncPhase = "Failed"
+)
+
 // NamespaceCert represents a x509 certificate of a namespace in project.
 type NamespaceCert struct {
 	// +optional
@@ -235,6 +496,11 @@ type NamespaceCert struct {
 	CACertPem []byte
 	// +optional
 	APIServer string
+	// +optional
+	SerialNumber string
+	// NotAfter is the time the certificate expires.
+	// +optional
+	NotAfter metav1.Time
 }
 
 // NamespacePhase indicates the status of namespace in project.
@@ -541,8 +807,35 @@ type NsEmigrationSpec struct {
 	Namespace   string
 	NsShowName  string
 	Destination string
+	// Mode is Project to move the namespace to another project, or Cluster to
+	// move the workloads of the namespace to another cluster in the project.
+	// +optional
+	Mode NsEmigrationMode
+	// DestinationCluster is the cluster the workloads are moved to in Cluster mode.
+	// +optional
+	DestinationCluster string
+	// TransferQuota moves the quota of the namespace in the project from the
+	// source cluster to the destination cluster in Cluster mode, it requires
+	// CutOver.
+	// +optional
+	TransferQuota bool
+	// CutOver scales down the workloads in the source cluster and releases the
+	// source namespace after the workloads are moved in Cluster mode.
+	// +optional
+	CutOver bool
 }
 
+// NsEmigrationMode indicates what a namespace emigration moves.
+type NsEmigrationMode string
+
+const (
+	// NsEmigrationModeProject moves the namespace to another project.
+	NsEmigrationModeProject NsEmigrationMode = "Project"
+	// NsEmigrationModeCluster moves the workloads of the namespace to another
+	// cluster in the same project.
+	NsEmigrationModeCluster NsEmigrationMode = "Cluster"
+)
+
 // NsEmigrationStatus represents information about the status of a namespace emigration.
 type NsEmigrationStatus struct {
 	// +optional
@@ -556,6 +849,15 @@ type NsEmigrationStatus struct {
 	// A human readable message indicating details about the transition.
 	// +optional
 	Message string
+	// SourceCluster is the cluster the workloads are moved from in Cluster mode.
+	// +optional
+	SourceCluster string
+	// TransferredQuota is the quota added to the destination cluster of the
+	// project, recorded before it is added. It is released from the source
+	// cluster once the emigration finishes, or rolled back from the
+	// destination cluster if the emigration fails.
+	// +optional
+	TransferredQuota ResourceList
 }
 
 // NsEmigrationPhase indicates the phase of namespace emigrations.
@@ -573,8 +875,219 @@ const (
 	NsEmigrationNewOneCreated NsEmigrationPhase = "NewOneCreated"
 	// NsEmigrationOldOneTerminating indicates that old namespace is terminating.
 	NsEmigrationOldOneTerminating NsEmigrationPhase = "OldOneTerminating"
+	// NsEmigrationResourcesMigrated indicates that the resources of the namespace
+	// have been applied to the destination cluster.
+	NsEmigrationResourcesMigrated NsEmigrationPhase = "ResourcesMigrated"
+	// NsEmigrationOldOneScaledDown indicates that the workloads of the namespace
+	// in the source cluster have been scaled down.
+	NsEmigrationOldOneScaledDown NsEmigrationPhase = "OldOneScaledDown"
 	// NsEmigrationFinished indicates that the emigration finished.
 	NsEmigrationFinished NsEmigrationPhase = "Finished"
 	// NsEmigrationFailed indicates that the emigration failed.
 	NsEmigrationFailed NsEmigrationPhase = "Failed"
 )
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceTemplate is the default policies rendered into every namespace of a project.
+type NamespaceTemplate struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the policies rendered into the namespaces.
+	// +optional
+	Spec NamespaceTemplateSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceTemplateList is the whole list of all namespace templates which owned by a project.
+type NamespaceTemplateList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of namespace templates
+	Items []NamespaceTemplate
+}
+
+// NamespaceTemplateSpec represents the policies rendered into the namespaces of a project.
+type NamespaceTemplateSpec struct {
+	TenantID string
+	// +optional
+	DisplayName string
+	// Labels are added to the namespaces on the cluster.
+	// +optional
+	Labels map[string]string
+	// Annotations are added to the namespaces on the cluster.
+	// +optional
+	Annotations map[string]string
+	// LimitRanges are created as a LimitRange named after the template.
+	// +optional
+	LimitRanges []corev1.LimitRangeItem
+	// NetworkPolicy creates a default-deny NetworkPolicy named after the template.
+	// +optional
+	NetworkPolicy *NamespaceTemplateNetworkPolicy
+	// RoleBindings are created with names prefixed by the name of the template.
+	// +optional
+	RoleBindings []NamespaceTemplateRoleBinding
+	// PodSecurity sets the Pod Security admission levels of the namespaces.
+	// +optional
+	PodSecurity *NamespaceTemplatePodSecurity
+}
+
+// NamespaceTemplateNetworkPolicy represents the default-deny network policy of the namespaces.
+type NamespaceTemplateNetworkPolicy struct {
+	// DenyIngress denies all ingress traffic of the pods by default.
+	// +optional
+	DenyIngress bool
+	// DenyEgress denies all egress traffic of the pods by default.
+	// +optional
+	DenyEgress bool
+}
+
+// NamespaceTemplateRoleBinding represents a role binding in the namespaces.
+type NamespaceTemplateRoleBinding struct {
+	Name string
+	// ClusterRole is the name of the cluster role bound in the namespaces, one of admin, edit and view.
+	ClusterRole string
+	// +optional
+	Subjects []NamespaceTemplateSubject
+}
+
+// NamespaceTemplateSubject represents a subject of a role binding.
+type NamespaceTemplateSubject struct {
+	// Kind is one of User, Group and ServiceAccount.
+	Kind string
+	Name string
+	// Namespace must be empty, service accounts are always bound in the namespace of the role binding.
+	// +optional
+	Namespace string
+}
+
+// NamespaceTemplatePodSecurity represents the Pod Security admission levels of the namespaces.
+type NamespaceTemplatePodSecurity struct {
+	// Enforce is one of privileged, baseline and restricted, only the platform administrators may set privileged.
+	// +optional
+	Enforce string
+	// +optional
+	Audit string
+	// +optional
+	Warn string
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectRequest is a request of a user for a new project, or for more quota
+// of an existing project, which is approved or rejected by the administrators.
+type ProjectRequest struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// +optional
+	Spec ProjectRequestSpec
+	// +optional
+	Status ProjectRequestStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectRequestList is the whole list of all project requests.
+type ProjectRequestList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of project requests
+	Items []ProjectRequest
+}
+
+// ProjectRequestSpec is a description of a project request.
+type ProjectRequestSpec struct {
+	TenantID string
+	// Requester is the name of the user who made the request, it is set by the server.
+	// +optional
+	Requester string
+	Type      ProjectRequestType
+	// ProjectName is the name of the project to raise the quota of in Quota requests.
+	// +optional
+	ProjectName string
+	// DisplayName is the display name of the project created in Create requests.
+	// +optional
+	DisplayName string
+	// ParentProjectName is the parent of the project created in Create requests.
+	// +optional
+	ParentProjectName string
+	// Clusters is the quota of the project created in Create requests, or the
+	// quota added to the project in Quota requests.
+	// +optional
+	Clusters ClusterHard
+	// Justification tells the administrators why the project or the quota is needed.
+	Justification string
+	// Channel is the name of the notify channel the requester and the
+	// administrators are informed through, nobody is informed if empty.
+	// +optional
+	Channel string
+	// Template is the name of the message template in the channel.
+	// +optional
+	Template string
+}
+
+// ProjectRequestStatus represents information about the status of a project request.
+type ProjectRequestStatus struct {
+	// +optional
+	Phase ProjectRequestPhase
+	// Approver is the name of the administrator who approved or rejected the request.
+	// +optional
+	Approver string
+	// ProjectName is the name of the project created or updated by the request.
+	// +optional
+	ProjectName string
+	// NotifiedPhase is the last phase the requester and the administrators
+	// were informed of.
+	// +optional
+	NotifiedPhase ProjectRequestPhase
+	// The last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time
+	// The reason for the condition's last transition, such as the comment of the approver.
+	// +optional
+	Reason string
+	// A human readable message indicating details about the transition.
+	// +optional
+	Message string
+}
+
+// ProjectRequestType indicates what a project request asks for.
+type ProjectRequestType string
+
+const (
+	// ProjectRequestCreate asks for a new project.
+	ProjectRequestCreate ProjectRequestType = "Create"
+	// ProjectRequestQuota asks for more quota of an existing project.
+	ProjectRequestQuota ProjectRequestType = "Quota"
+)
+
+// ProjectRequestPhase indicates the phase of a project request.
+type ProjectRequestPhase string
+
+// These are valid phases of project requests.
+const (
+	// ProjectRequestPending indicates that the request is waiting for the administrators.
+	ProjectRequestPending ProjectRequestPhase = "Pending"
+	// ProjectRequestApproved indicates that the request is approved and being carried out.
+	ProjectRequestApproved ProjectRequestPhase = "Approved"
+	// ProjectRequestRejected indicates that the request is rejected.
+	ProjectRequestRejected ProjectRequestPhase = "Rejected"
+	// ProjectRequestCompleted indicates that the project has been created or updated.
+	ProjectRequestCompleted ProjectRequestPhase = "Completed"
+	// ProjectRequestFailed indicates that the project failed to be created or updated.
+	ProjectRequestFailed ProjectRequestPhase = "Failed"
+)
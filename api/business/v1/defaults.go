@@ -83,8 +83,20 @@ func SetDefaults_ChartGroupStatus(obj *ChartGroupStatus) {
 	}
 }
 
+func SetDefaults_NsEmigrationSpec(obj *NsEmigrationSpec) {
+	if obj.Mode == "" {
+		obj.Mode = NsEmigrationModeProject
+	}
+}
+
 func SetDefaults_NsEmigrationStatus(obj *NsEmigrationStatus) {
 	if obj.Phase == "" {
 		obj.Phase = NsEmigrationPending
 	}
 }
+
+func SetDefaults_ProjectRequestStatus(obj *ProjectRequestStatus) {
+	if obj.Phase == "" {
+		obj.Phase = ProjectRequestPending
+	}
+}
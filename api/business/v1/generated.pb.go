@@ -27,6 +27,7 @@ import (
 
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	v11 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	math "math"
@@ -46,10 +47,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+func (m *CertificateAlert) Reset()      { *m = CertificateAlert{} }
+func (*CertificateAlert) ProtoMessage() {}
+func (*CertificateAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{0}
+}
+func (m *CertificateAlert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CertificateAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CertificateAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertificateAlert.Merge(m, src)
+}
+func (m *CertificateAlert) XXX_Size() int {
+	return m.Size()
+}
+func (m *CertificateAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertificateAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertificateAlert proto.InternalMessageInfo
+
 func (m *ChartGroup) Reset()      { *m = ChartGroup{} }
 func (*ChartGroup) ProtoMessage() {}
 func (*ChartGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{0}
+	return fileDescriptor_237074a6af309550, []int{1}
 }
 func (m *ChartGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -77,7 +106,7 @@ var xxx_messageInfo_ChartGroup proto.InternalMessageInfo
 func (m *ChartGroupList) Reset()      { *m = ChartGroupList{} }
 func (*ChartGroupList) ProtoMessage() {}
 func (*ChartGroupList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{1}
+	return fileDescriptor_237074a6af309550, []int{2}
 }
 func (m *ChartGroupList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -105,7 +134,7 @@ var xxx_messageInfo_ChartGroupList proto.InternalMessageInfo
 func (m *ChartGroupSpec) Reset()      { *m = ChartGroupSpec{} }
 func (*ChartGroupSpec) ProtoMessage() {}
 func (*ChartGroupSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{2}
+	return fileDescriptor_237074a6af309550, []int{3}
 }
 func (m *ChartGroupSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -133,7 +162,7 @@ var xxx_messageInfo_ChartGroupSpec proto.InternalMessageInfo
 func (m *ChartGroupStatus) Reset()      { *m = ChartGroupStatus{} }
 func (*ChartGroupStatus) ProtoMessage() {}
 func (*ChartGroupStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{3}
+	return fileDescriptor_237074a6af309550, []int{4}
 }
 func (m *ChartGroupStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -161,7 +190,7 @@ var xxx_messageInfo_ChartGroupStatus proto.InternalMessageInfo
 func (m *ConfigMap) Reset()      { *m = ConfigMap{} }
 func (*ConfigMap) ProtoMessage() {}
 func (*ConfigMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{4}
+	return fileDescriptor_237074a6af309550, []int{5}
 }
 func (m *ConfigMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -189,7 +218,7 @@ var xxx_messageInfo_ConfigMap proto.InternalMessageInfo
 func (m *ConfigMapList) Reset()      { *m = ConfigMapList{} }
 func (*ConfigMapList) ProtoMessage() {}
 func (*ConfigMapList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{5}
+	return fileDescriptor_237074a6af309550, []int{6}
 }
 func (m *ConfigMapList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,10 +243,66 @@ func (m *ConfigMapList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConfigMapList proto.InternalMessageInfo
 
+func (m *FrozenNamespace) Reset()      { *m = FrozenNamespace{} }
+func (*FrozenNamespace) ProtoMessage() {}
+func (*FrozenNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{7}
+}
+func (m *FrozenNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FrozenNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenNamespace.Merge(m, src)
+}
+func (m *FrozenNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenNamespace proto.InternalMessageInfo
+
+func (m *FrozenWorkload) Reset()      { *m = FrozenWorkload{} }
+func (*FrozenWorkload) ProtoMessage() {}
+func (*FrozenWorkload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{8}
+}
+func (m *FrozenWorkload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenWorkload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FrozenWorkload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenWorkload.Merge(m, src)
+}
+func (m *FrozenWorkload) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenWorkload) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenWorkload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenWorkload proto.InternalMessageInfo
+
 func (m *HardQuantity) Reset()      { *m = HardQuantity{} }
 func (*HardQuantity) ProtoMessage() {}
 func (*HardQuantity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{6}
+	return fileDescriptor_237074a6af309550, []int{9}
 }
 func (m *HardQuantity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -245,7 +330,7 @@ var xxx_messageInfo_HardQuantity proto.InternalMessageInfo
 func (m *ImageNamespace) Reset()      { *m = ImageNamespace{} }
 func (*ImageNamespace) ProtoMessage() {}
 func (*ImageNamespace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{7}
+	return fileDescriptor_237074a6af309550, []int{10}
 }
 func (m *ImageNamespace) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,7 +358,7 @@ var xxx_messageInfo_ImageNamespace proto.InternalMessageInfo
 func (m *ImageNamespaceList) Reset()      { *m = ImageNamespaceList{} }
 func (*ImageNamespaceList) ProtoMessage() {}
 func (*ImageNamespaceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{8}
+	return fileDescriptor_237074a6af309550, []int{11}
 }
 func (m *ImageNamespaceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,7 +386,7 @@ var xxx_messageInfo_ImageNamespaceList proto.InternalMessageInfo
 func (m *ImageNamespaceSpec) Reset()      { *m = ImageNamespaceSpec{} }
 func (*ImageNamespaceSpec) ProtoMessage() {}
 func (*ImageNamespaceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{9}
+	return fileDescriptor_237074a6af309550, []int{12}
 }
 func (m *ImageNamespaceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -329,7 +414,7 @@ var xxx_messageInfo_ImageNamespaceSpec proto.InternalMessageInfo
 func (m *ImageNamespaceStatus) Reset()      { *m = ImageNamespaceStatus{} }
 func (*ImageNamespaceStatus) ProtoMessage() {}
 func (*ImageNamespaceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{10}
+	return fileDescriptor_237074a6af309550, []int{13}
 }
 func (m *ImageNamespaceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +442,7 @@ var xxx_messageInfo_ImageNamespaceStatus proto.InternalMessageInfo
 func (m *Namespace) Reset()      { *m = Namespace{} }
 func (*Namespace) ProtoMessage() {}
 func (*Namespace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{11}
+	return fileDescriptor_237074a6af309550, []int{14}
 }
 func (m *Namespace) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +470,7 @@ var xxx_messageInfo_Namespace proto.InternalMessageInfo
 func (m *NamespaceCert) Reset()      { *m = NamespaceCert{} }
 func (*NamespaceCert) ProtoMessage() {}
 func (*NamespaceCert) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{12}
+	return fileDescriptor_237074a6af309550, []int{15}
 }
 func (m *NamespaceCert) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,7 +498,7 @@ var xxx_messageInfo_NamespaceCert proto.InternalMessageInfo
 func (m *NamespaceCertOptions) Reset()      { *m = NamespaceCertOptions{} }
 func (*NamespaceCertOptions) ProtoMessage() {}
 func (*NamespaceCertOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{13}
+	return fileDescriptor_237074a6af309550, []int{16}
 }
 func (m *NamespaceCertOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,10 +523,38 @@ func (m *NamespaceCertOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NamespaceCertOptions proto.InternalMessageInfo
 
+func (m *NamespaceCertStatus) Reset()      { *m = NamespaceCertStatus{} }
+func (*NamespaceCertStatus) ProtoMessage() {}
+func (*NamespaceCertStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{17}
+}
+func (m *NamespaceCertStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceCertStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NamespaceCertStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceCertStatus.Merge(m, src)
+}
+func (m *NamespaceCertStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceCertStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceCertStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceCertStatus proto.InternalMessageInfo
+
 func (m *NamespaceList) Reset()      { *m = NamespaceList{} }
 func (*NamespaceList) ProtoMessage() {}
 func (*NamespaceList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{14}
+	return fileDescriptor_237074a6af309550, []int{18}
 }
 func (m *NamespaceList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,10 +579,38 @@ func (m *NamespaceList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NamespaceList proto.InternalMessageInfo
 
+func (m *NamespaceQuotaNode) Reset()      { *m = NamespaceQuotaNode{} }
+func (*NamespaceQuotaNode) ProtoMessage() {}
+func (*NamespaceQuotaNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{19}
+}
+func (m *NamespaceQuotaNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceQuotaNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NamespaceQuotaNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceQuotaNode.Merge(m, src)
+}
+func (m *NamespaceQuotaNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceQuotaNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceQuotaNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceQuotaNode proto.InternalMessageInfo
+
 func (m *NamespaceSpec) Reset()      { *m = NamespaceSpec{} }
 func (*NamespaceSpec) ProtoMessage() {}
 func (*NamespaceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{15}
+	return fileDescriptor_237074a6af309550, []int{20}
 }
 func (m *NamespaceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -497,7 +638,7 @@ var xxx_messageInfo_NamespaceSpec proto.InternalMessageInfo
 func (m *NamespaceStatus) Reset()      { *m = NamespaceStatus{} }
 func (*NamespaceStatus) ProtoMessage() {}
 func (*NamespaceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{16}
+	return fileDescriptor_237074a6af309550, []int{21}
 }
 func (m *NamespaceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -522,15 +663,15 @@ func (m *NamespaceStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NamespaceStatus proto.InternalMessageInfo
 
-func (m *NsEmigration) Reset()      { *m = NsEmigration{} }
-func (*NsEmigration) ProtoMessage() {}
-func (*NsEmigration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{17}
+func (m *NamespaceTemplate) Reset()      { *m = NamespaceTemplate{} }
+func (*NamespaceTemplate) ProtoMessage() {}
+func (*NamespaceTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{22}
 }
-func (m *NsEmigration) XXX_Unmarshal(b []byte) error {
+func (m *NamespaceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NsEmigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NamespaceTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -538,27 +679,27 @@ func (m *NsEmigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *NsEmigration) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NsEmigration.Merge(m, src)
+func (m *NamespaceTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceTemplate.Merge(m, src)
 }
-func (m *NsEmigration) XXX_Size() int {
+func (m *NamespaceTemplate) XXX_Size() int {
 	return m.Size()
 }
-func (m *NsEmigration) XXX_DiscardUnknown() {
-	xxx_messageInfo_NsEmigration.DiscardUnknown(m)
+func (m *NamespaceTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceTemplate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NsEmigration proto.InternalMessageInfo
+var xxx_messageInfo_NamespaceTemplate proto.InternalMessageInfo
 
-func (m *NsEmigrationList) Reset()      { *m = NsEmigrationList{} }
-func (*NsEmigrationList) ProtoMessage() {}
-func (*NsEmigrationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{18}
+func (m *NamespaceTemplateList) Reset()      { *m = NamespaceTemplateList{} }
+func (*NamespaceTemplateList) ProtoMessage() {}
+func (*NamespaceTemplateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{23}
 }
-func (m *NsEmigrationList) XXX_Unmarshal(b []byte) error {
+func (m *NamespaceTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NsEmigrationList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NamespaceTemplateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -566,27 +707,27 @@ func (m *NsEmigrationList) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 	}
 	return b[:n], nil
 }
-func (m *NsEmigrationList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NsEmigrationList.Merge(m, src)
+func (m *NamespaceTemplateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceTemplateList.Merge(m, src)
 }
-func (m *NsEmigrationList) XXX_Size() int {
+func (m *NamespaceTemplateList) XXX_Size() int {
 	return m.Size()
 }
-func (m *NsEmigrationList) XXX_DiscardUnknown() {
-	xxx_messageInfo_NsEmigrationList.DiscardUnknown(m)
+func (m *NamespaceTemplateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceTemplateList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NsEmigrationList proto.InternalMessageInfo
+var xxx_messageInfo_NamespaceTemplateList proto.InternalMessageInfo
 
-func (m *NsEmigrationSpec) Reset()      { *m = NsEmigrationSpec{} }
-func (*NsEmigrationSpec) ProtoMessage() {}
-func (*NsEmigrationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{19}
+func (m *NamespaceTemplateNetworkPolicy) Reset()      { *m = NamespaceTemplateNetworkPolicy{} }
+func (*NamespaceTemplateNetworkPolicy) ProtoMessage() {}
+func (*NamespaceTemplateNetworkPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{24}
 }
-func (m *NsEmigrationSpec) XXX_Unmarshal(b []byte) error {
+func (m *NamespaceTemplateNetworkPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NsEmigrationSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NamespaceTemplateNetworkPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -594,27 +735,27 @@ func (m *NsEmigrationSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 	}
 	return b[:n], nil
 }
-func (m *NsEmigrationSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NsEmigrationSpec.Merge(m, src)
+func (m *NamespaceTemplateNetworkPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceTemplateNetworkPolicy.Merge(m, src)
 }
-func (m *NsEmigrationSpec) XXX_Size() int {
+func (m *NamespaceTemplateNetworkPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *NsEmigrationSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_NsEmigrationSpec.DiscardUnknown(m)
+func (m *NamespaceTemplateNetworkPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceTemplateNetworkPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NsEmigrationSpec proto.InternalMessageInfo
+var xxx_messageInfo_NamespaceTemplateNetworkPolicy proto.InternalMessageInfo
 
-func (m *NsEmigrationStatus) Reset()      { *m = NsEmigrationStatus{} }
-func (*NsEmigrationStatus) ProtoMessage() {}
-func (*NsEmigrationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{20}
+func (m *NamespaceTemplatePodSecurity) Reset()      { *m = NamespaceTemplatePodSecurity{} }
+func (*NamespaceTemplatePodSecurity) ProtoMessage() {}
+func (*NamespaceTemplatePodSecurity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{25}
 }
-func (m *NsEmigrationStatus) XXX_Unmarshal(b []byte) error {
+func (m *NamespaceTemplatePodSecurity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NsEmigrationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NamespaceTemplatePodSecurity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -622,27 +763,27 @@ func (m *NsEmigrationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 	}
 	return b[:n], nil
 }
-func (m *NsEmigrationStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NsEmigrationStatus.Merge(m, src)
+func (m *NamespaceTemplatePodSecurity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceTemplatePodSecurity.Merge(m, src)
 }
-func (m *NsEmigrationStatus) XXX_Size() int {
+func (m *NamespaceTemplatePodSecurity) XXX_Size() int {
 	return m.Size()
 }
-func (m *NsEmigrationStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_NsEmigrationStatus.DiscardUnknown(m)
+func (m *NamespaceTemplatePodSecurity) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceTemplatePodSecurity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NsEmigrationStatus proto.InternalMessageInfo
+var xxx_messageInfo_NamespaceTemplatePodSecurity proto.InternalMessageInfo
 
-func (m *Platform) Reset()      { *m = Platform{} }
-func (*Platform) ProtoMessage() {}
-func (*Platform) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{21}
+func (m *NamespaceTemplateRoleBinding) Reset()      { *m = NamespaceTemplateRoleBinding{} }
+func (*NamespaceTemplateRoleBinding) ProtoMessage() {}
+func (*NamespaceTemplateRoleBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{26}
 }
-func (m *Platform) XXX_Unmarshal(b []byte) error {
+func (m *NamespaceTemplateRoleBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Platform) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NamespaceTemplateRoleBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -650,27 +791,27 @@ func (m *Platform) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *Platform) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Platform.Merge(m, src)
+func (m *NamespaceTemplateRoleBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceTemplateRoleBinding.Merge(m, src)
 }
-func (m *Platform) XXX_Size() int {
+func (m *NamespaceTemplateRoleBinding) XXX_Size() int {
 	return m.Size()
 }
-func (m *Platform) XXX_DiscardUnknown() {
-	xxx_messageInfo_Platform.DiscardUnknown(m)
+func (m *NamespaceTemplateRoleBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceTemplateRoleBinding.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Platform proto.InternalMessageInfo
+var xxx_messageInfo_NamespaceTemplateRoleBinding proto.InternalMessageInfo
 
-func (m *PlatformList) Reset()      { *m = PlatformList{} }
-func (*PlatformList) ProtoMessage() {}
-func (*PlatformList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{22}
+func (m *NamespaceTemplateSpec) Reset()      { *m = NamespaceTemplateSpec{} }
+func (*NamespaceTemplateSpec) ProtoMessage() {}
+func (*NamespaceTemplateSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{27}
 }
-func (m *PlatformList) XXX_Unmarshal(b []byte) error {
+func (m *NamespaceTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PlatformList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NamespaceTemplateSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -678,27 +819,27 @@ func (m *PlatformList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *PlatformList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlatformList.Merge(m, src)
+func (m *NamespaceTemplateSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceTemplateSpec.Merge(m, src)
 }
-func (m *PlatformList) XXX_Size() int {
+func (m *NamespaceTemplateSpec) XXX_Size() int {
 	return m.Size()
 }
-func (m *PlatformList) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlatformList.DiscardUnknown(m)
+func (m *NamespaceTemplateSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceTemplateSpec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PlatformList proto.InternalMessageInfo
+var xxx_messageInfo_NamespaceTemplateSpec proto.InternalMessageInfo
 
-func (m *PlatformSpec) Reset()      { *m = PlatformSpec{} }
-func (*PlatformSpec) ProtoMessage() {}
-func (*PlatformSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{23}
+func (m *NamespaceTemplateSubject) Reset()      { *m = NamespaceTemplateSubject{} }
+func (*NamespaceTemplateSubject) ProtoMessage() {}
+func (*NamespaceTemplateSubject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{28}
 }
-func (m *PlatformSpec) XXX_Unmarshal(b []byte) error {
+func (m *NamespaceTemplateSubject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PlatformSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NamespaceTemplateSubject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -706,27 +847,27 @@ func (m *PlatformSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *PlatformSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlatformSpec.Merge(m, src)
+func (m *NamespaceTemplateSubject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceTemplateSubject.Merge(m, src)
 }
-func (m *PlatformSpec) XXX_Size() int {
+func (m *NamespaceTemplateSubject) XXX_Size() int {
 	return m.Size()
 }
-func (m *PlatformSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlatformSpec.DiscardUnknown(m)
+func (m *NamespaceTemplateSubject) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceTemplateSubject.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PlatformSpec proto.InternalMessageInfo
+var xxx_messageInfo_NamespaceTemplateSubject proto.InternalMessageInfo
 
-func (m *Portal) Reset()      { *m = Portal{} }
-func (*Portal) ProtoMessage() {}
-func (*Portal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{24}
+func (m *NamespaceTemplateSync) Reset()      { *m = NamespaceTemplateSync{} }
+func (*NamespaceTemplateSync) ProtoMessage() {}
+func (*NamespaceTemplateSync) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{29}
 }
-func (m *Portal) XXX_Unmarshal(b []byte) error {
+func (m *NamespaceTemplateSync) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Portal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NamespaceTemplateSync) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -734,27 +875,27 @@ func (m *Portal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *Portal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Portal.Merge(m, src)
+func (m *NamespaceTemplateSync) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceTemplateSync.Merge(m, src)
 }
-func (m *Portal) XXX_Size() int {
+func (m *NamespaceTemplateSync) XXX_Size() int {
 	return m.Size()
 }
-func (m *Portal) XXX_DiscardUnknown() {
-	xxx_messageInfo_Portal.DiscardUnknown(m)
+func (m *NamespaceTemplateSync) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceTemplateSync.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Portal proto.InternalMessageInfo
+var xxx_messageInfo_NamespaceTemplateSync proto.InternalMessageInfo
 
-func (m *PortalProject) Reset()      { *m = PortalProject{} }
-func (*PortalProject) ProtoMessage() {}
-func (*PortalProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{25}
+func (m *NsEmigration) Reset()      { *m = NsEmigration{} }
+func (*NsEmigration) ProtoMessage() {}
+func (*NsEmigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{30}
 }
-func (m *PortalProject) XXX_Unmarshal(b []byte) error {
+func (m *NsEmigration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PortalProject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NsEmigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -762,27 +903,27 @@ func (m *PortalProject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 	}
 	return b[:n], nil
 }
-func (m *PortalProject) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PortalProject.Merge(m, src)
+func (m *NsEmigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NsEmigration.Merge(m, src)
 }
-func (m *PortalProject) XXX_Size() int {
+func (m *NsEmigration) XXX_Size() int {
 	return m.Size()
 }
-func (m *PortalProject) XXX_DiscardUnknown() {
-	xxx_messageInfo_PortalProject.DiscardUnknown(m)
+func (m *NsEmigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_NsEmigration.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PortalProject proto.InternalMessageInfo
+var xxx_messageInfo_NsEmigration proto.InternalMessageInfo
 
-func (m *Project) Reset()      { *m = Project{} }
-func (*Project) ProtoMessage() {}
-func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{26}
+func (m *NsEmigrationList) Reset()      { *m = NsEmigrationList{} }
+func (*NsEmigrationList) ProtoMessage() {}
+func (*NsEmigrationList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{31}
 }
-func (m *Project) XXX_Unmarshal(b []byte) error {
+func (m *NsEmigrationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NsEmigrationList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -790,27 +931,27 @@ func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *Project) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Project.Merge(m, src)
+func (m *NsEmigrationList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NsEmigrationList.Merge(m, src)
 }
-func (m *Project) XXX_Size() int {
+func (m *NsEmigrationList) XXX_Size() int {
 	return m.Size()
 }
-func (m *Project) XXX_DiscardUnknown() {
-	xxx_messageInfo_Project.DiscardUnknown(m)
+func (m *NsEmigrationList) XXX_DiscardUnknown() {
+	xxx_messageInfo_NsEmigrationList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Project proto.InternalMessageInfo
+var xxx_messageInfo_NsEmigrationList proto.InternalMessageInfo
 
-func (m *ProjectList) Reset()      { *m = ProjectList{} }
-func (*ProjectList) ProtoMessage() {}
-func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{27}
+func (m *NsEmigrationSpec) Reset()      { *m = NsEmigrationSpec{} }
+func (*NsEmigrationSpec) ProtoMessage() {}
+func (*NsEmigrationSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{32}
 }
-func (m *ProjectList) XXX_Unmarshal(b []byte) error {
+func (m *NsEmigrationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProjectList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NsEmigrationSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -818,27 +959,27 @@ func (m *ProjectList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *ProjectList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProjectList.Merge(m, src)
+func (m *NsEmigrationSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NsEmigrationSpec.Merge(m, src)
 }
-func (m *ProjectList) XXX_Size() int {
+func (m *NsEmigrationSpec) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProjectList) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProjectList.DiscardUnknown(m)
+func (m *NsEmigrationSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_NsEmigrationSpec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProjectList proto.InternalMessageInfo
+var xxx_messageInfo_NsEmigrationSpec proto.InternalMessageInfo
 
-func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
-func (*ProjectSpec) ProtoMessage() {}
-func (*ProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{28}
+func (m *NsEmigrationStatus) Reset()      { *m = NsEmigrationStatus{} }
+func (*NsEmigrationStatus) ProtoMessage() {}
+func (*NsEmigrationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{33}
 }
-func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
+func (m *NsEmigrationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProjectSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NsEmigrationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -846,27 +987,27 @@ func (m *ProjectSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *ProjectSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProjectSpec.Merge(m, src)
+func (m *NsEmigrationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NsEmigrationStatus.Merge(m, src)
 }
-func (m *ProjectSpec) XXX_Size() int {
+func (m *NsEmigrationStatus) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProjectSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProjectSpec.DiscardUnknown(m)
+func (m *NsEmigrationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NsEmigrationStatus.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
+var xxx_messageInfo_NsEmigrationStatus proto.InternalMessageInfo
 
-func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
-func (*ProjectStatus) ProtoMessage() {}
-func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{29}
+func (m *Platform) Reset()      { *m = Platform{} }
+func (*Platform) ProtoMessage() {}
+func (*Platform) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{34}
 }
-func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
+func (m *Platform) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProjectStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Platform) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -874,27 +1015,27 @@ func (m *ProjectStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 	}
 	return b[:n], nil
 }
-func (m *ProjectStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProjectStatus.Merge(m, src)
+func (m *Platform) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Platform.Merge(m, src)
 }
-func (m *ProjectStatus) XXX_Size() int {
+func (m *Platform) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProjectStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProjectStatus.DiscardUnknown(m)
+func (m *Platform) XXX_DiscardUnknown() {
+	xxx_messageInfo_Platform.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
+var xxx_messageInfo_Platform proto.InternalMessageInfo
 
-func (m *UsedQuantity) Reset()      { *m = UsedQuantity{} }
-func (*UsedQuantity) ProtoMessage() {}
-func (*UsedQuantity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_237074a6af309550, []int{30}
+func (m *PlatformList) Reset()      { *m = PlatformList{} }
+func (*PlatformList) ProtoMessage() {}
+func (*PlatformList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{35}
 }
-func (m *UsedQuantity) XXX_Unmarshal(b []byte) error {
+func (m *PlatformList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UsedQuantity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PlatformList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -902,606 +1043,948 @@ func (m *UsedQuantity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *UsedQuantity) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UsedQuantity.Merge(m, src)
+func (m *PlatformList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlatformList.Merge(m, src)
 }
-func (m *UsedQuantity) XXX_Size() int {
+func (m *PlatformList) XXX_Size() int {
 	return m.Size()
 }
-func (m *UsedQuantity) XXX_DiscardUnknown() {
-	xxx_messageInfo_UsedQuantity.DiscardUnknown(m)
+func (m *PlatformList) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlatformList.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UsedQuantity proto.InternalMessageInfo
-
-func init() {
-	proto.RegisterType((*ChartGroup)(nil), "tkestack.io.tke.api.business.v1.ChartGroup")
-	proto.RegisterType((*ChartGroupList)(nil), "tkestack.io.tke.api.business.v1.ChartGroupList")
-	proto.RegisterType((*ChartGroupSpec)(nil), "tkestack.io.tke.api.business.v1.ChartGroupSpec")
-	proto.RegisterType((*ChartGroupStatus)(nil), "tkestack.io.tke.api.business.v1.ChartGroupStatus")
-	proto.RegisterType((*ConfigMap)(nil), "tkestack.io.tke.api.business.v1.ConfigMap")
-	proto.RegisterMapType((map[string][]byte)(nil), "tkestack.io.tke.api.business.v1.ConfigMap.BinaryDataEntry")
-	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.business.v1.ConfigMap.DataEntry")
-	proto.RegisterType((*ConfigMapList)(nil), "tkestack.io.tke.api.business.v1.ConfigMapList")
-	proto.RegisterType((*HardQuantity)(nil), "tkestack.io.tke.api.business.v1.HardQuantity")
-	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.business.v1.HardQuantity.HardEntry")
-	proto.RegisterType((*ImageNamespace)(nil), "tkestack.io.tke.api.business.v1.ImageNamespace")
-	proto.RegisterType((*ImageNamespaceList)(nil), "tkestack.io.tke.api.business.v1.ImageNamespaceList")
-	proto.RegisterType((*ImageNamespaceSpec)(nil), "tkestack.io.tke.api.business.v1.ImageNamespaceSpec")
-	proto.RegisterType((*ImageNamespaceStatus)(nil), "tkestack.io.tke.api.business.v1.ImageNamespaceStatus")
-	proto.RegisterType((*Namespace)(nil), "tkestack.io.tke.api.business.v1.Namespace")
-	proto.RegisterType((*NamespaceCert)(nil), "tkestack.io.tke.api.business.v1.NamespaceCert")
-	proto.RegisterType((*NamespaceCertOptions)(nil), "tkestack.io.tke.api.business.v1.NamespaceCertOptions")
-	proto.RegisterType((*NamespaceList)(nil), "tkestack.io.tke.api.business.v1.NamespaceList")
-	proto.RegisterType((*NamespaceSpec)(nil), "tkestack.io.tke.api.business.v1.NamespaceSpec")
-	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.business.v1.NamespaceSpec.HardEntry")
-	proto.RegisterType((*NamespaceStatus)(nil), "tkestack.io.tke.api.business.v1.NamespaceStatus")
-	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.business.v1.NamespaceStatus.CachedSpecHardEntry")
-	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.business.v1.NamespaceStatus.UsedEntry")
-	proto.RegisterType((*NsEmigration)(nil), "tkestack.io.tke.api.business.v1.NsEmigration")
-	proto.RegisterType((*NsEmigrationList)(nil), "tkestack.io.tke.api.business.v1.NsEmigrationList")
-	proto.RegisterType((*NsEmigrationSpec)(nil), "tkestack.io.tke.api.business.v1.NsEmigrationSpec")
-	proto.RegisterType((*NsEmigrationStatus)(nil), "tkestack.io.tke.api.business.v1.NsEmigrationStatus")
-	proto.RegisterType((*Platform)(nil), "tkestack.io.tke.api.business.v1.Platform")
-	proto.RegisterType((*PlatformList)(nil), "tkestack.io.tke.api.business.v1.PlatformList")
-	proto.RegisterType((*PlatformSpec)(nil), "tkestack.io.tke.api.business.v1.PlatformSpec")
-	proto.RegisterType((*Portal)(nil), "tkestack.io.tke.api.business.v1.Portal")
-	proto.RegisterMapType((ProjectExtension)(nil), "tkestack.io.tke.api.business.v1.Portal.ExtensionEntry")
-	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.business.v1.Portal.ProjectsEntry")
-	proto.RegisterType((*PortalProject)(nil), "tkestack.io.tke.api.business.v1.PortalProject")
-	proto.RegisterType((*Project)(nil), "tkestack.io.tke.api.business.v1.Project")
-	proto.RegisterType((*ProjectList)(nil), "tkestack.io.tke.api.business.v1.ProjectList")
-	proto.RegisterType((*ProjectSpec)(nil), "tkestack.io.tke.api.business.v1.ProjectSpec")
-	proto.RegisterMapType((ClusterHard)(nil), "tkestack.io.tke.api.business.v1.ProjectSpec.ClustersEntry")
-	proto.RegisterType((*ProjectStatus)(nil), "tkestack.io.tke.api.business.v1.ProjectStatus")
-	proto.RegisterMapType((ClusterHard)(nil), "tkestack.io.tke.api.business.v1.ProjectStatus.CachedSpecClustersEntry")
-	proto.RegisterMapType((ClusterUsed)(nil), "tkestack.io.tke.api.business.v1.ProjectStatus.ClustersEntry")
-	proto.RegisterType((*UsedQuantity)(nil), "tkestack.io.tke.api.business.v1.UsedQuantity")
-	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.business.v1.UsedQuantity.UsedEntry")
-}
+var xxx_messageInfo_PlatformList proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterFile("tkestack.io/tke/api/business/v1/generated.proto", fileDescriptor_237074a6af309550)
+func (m *PlatformSpec) Reset()      { *m = PlatformSpec{} }
+func (*PlatformSpec) ProtoMessage() {}
+func (*PlatformSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{36}
 }
-
-var fileDescriptor_237074a6af309550 = []byte{
-	// 2064 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x19, 0xcd, 0x6f, 0x1b, 0x4b,
-	0x3d, 0xeb, 0x38, 0x89, 0xfd, 0xb3, 0x9d, 0xa6, 0xd3, 0x40, 0x8d, 0x81, 0x38, 0x32, 0xb4, 0x4a,
-	0xdf, 0x7b, 0x5d, 0xbf, 0xa4, 0x1f, 0xaf, 0xea, 0xe3, 0x43, 0xb5, 0xd3, 0x57, 0x0a, 0x6d, 0xea,
-	0x4e, 0xd3, 0x8a, 0x8f, 0x87, 0xc4, 0x64, 0x3d, 0x71, 0xb6, 0xb6, 0x77, 0xcd, 0xee, 0x38, 0xef,
-	0x19, 0x2e, 0x1c, 0xb9, 0x81, 0x04, 0x1c, 0x90, 0xe0, 0x00, 0x27, 0x2e, 0x1c, 0x90, 0x38, 0x20,
-	0x04, 0x88, 0x03, 0x87, 0x1e, 0x7b, 0xec, 0x01, 0x59, 0xd4, 0xfc, 0x17, 0x39, 0x20, 0x34, 0xb3,
-	0xb3, 0x1f, 0xb3, 0xb6, 0x63, 0x1b, 0x11, 0x83, 0x7a, 0xf3, 0xfe, 0xbe, 0xe7, 0xf7, 0x3d, 0x63,
-	0x28, 0xb3, 0x26, 0x75, 0x19, 0x31, 0x9a, 0xba, 0x69, 0xf3, 0xdf, 0x65, 0xd2, 0x31, 0xcb, 0x07,
-	0x5d, 0xd7, 0xb4, 0xa8, 0xeb, 0x96, 0x8f, 0xb7, 0xcb, 0x0d, 0x6a, 0x51, 0x87, 0x30, 0x5a, 0xd7,
-	0x3b, 0x8e, 0xcd, 0x6c, 0x54, 0x8c, 0x30, 0xe8, 0xac, 0x49, 0x75, 0xd2, 0x31, 0x75, 0x9f, 0x41,
-	0x3f, 0xde, 0x2e, 0x5c, 0x6d, 0x98, 0xec, 0xa8, 0x7b, 0xa0, 0x1b, 0x76, 0xbb, 0xdc, 0xb0, 0x1b,
-	0x76, 0x59, 0xf0, 0x1d, 0x74, 0x0f, 0xc5, 0x97, 0xf8, 0x10, 0xbf, 0x3c, 0x79, 0x85, 0xeb, 0xcd,
-	0x5b, 0x2e, 0xd7, 0x4d, 0x3a, 0x66, 0x9b, 0x18, 0x47, 0xa6, 0x45, 0x9d, 0x5e, 0xb9, 0xd3, 0x6c,
-	0x08, 0x43, 0x1c, 0xea, 0xda, 0x5d, 0xc7, 0xa0, 0x71, 0x2b, 0x4e, 0xe5, 0x72, 0xcb, 0x6d, 0xca,
-	0xc8, 0x08, 0xdb, 0x0b, 0xe5, 0x71, 0x5c, 0x4e, 0xd7, 0x62, 0x66, 0x7b, 0x58, 0xcd, 0xcd, 0x49,
-	0x0c, 0xae, 0x71, 0x44, 0xdb, 0x24, 0xce, 0x57, 0xfa, 0x45, 0x02, 0xa0, 0x7a, 0x44, 0x1c, 0x76,
-	0xcf, 0xb1, 0xbb, 0x1d, 0xf4, 0x1d, 0x48, 0x71, 0x93, 0xea, 0x84, 0x91, 0xbc, 0xb6, 0xa9, 0x6d,
-	0x65, 0x76, 0xde, 0xd5, 0x3d, 0xc9, 0x7a, 0x54, 0xb2, 0xde, 0x69, 0x36, 0x38, 0xc0, 0xd5, 0x39,
-	0xb5, 0x7e, 0xbc, 0xad, 0x3f, 0x3a, 0x78, 0x4e, 0x0d, 0xf6, 0x90, 0x32, 0x52, 0x41, 0x2f, 0xfa,
-	0xc5, 0x85, 0x41, 0xbf, 0x08, 0x21, 0x0c, 0x07, 0x52, 0xd1, 0x63, 0x48, 0xba, 0x1d, 0x6a, 0xe4,
-	0x13, 0x42, 0x7a, 0x59, 0x9f, 0x10, 0x24, 0x3d, 0x34, 0xee, 0x49, 0x87, 0x1a, 0x95, 0xac, 0x14,
-	0x9e, 0xe4, 0x5f, 0x58, 0x88, 0x42, 0xdf, 0x80, 0x65, 0x97, 0x11, 0xd6, 0x75, 0xf3, 0x8b, 0x42,
-	0xe8, 0xf6, 0x2c, 0x42, 0x05, 0x63, 0x65, 0x55, 0x8a, 0x5d, 0xf6, 0xbe, 0xb1, 0x14, 0x58, 0xfa,
-	0xab, 0x06, 0xab, 0x21, 0xf1, 0x03, 0xd3, 0x65, 0xe8, 0xc3, 0x21, 0x17, 0xe9, 0xd3, 0xb9, 0x88,
-	0x73, 0x0b, 0x07, 0xad, 0x49, 0x65, 0x29, 0x1f, 0x12, 0x71, 0x4f, 0x0d, 0x96, 0x4c, 0x46, 0xdb,
-	0x6e, 0x3e, 0xb1, 0xb9, 0xb8, 0x95, 0xd9, 0x79, 0x7b, 0x86, 0xa3, 0x54, 0x72, 0x52, 0xee, 0xd2,
-	0x7d, 0x2e, 0x01, 0x7b, 0x82, 0x4a, 0xaf, 0x94, 0x23, 0x70, 0xb7, 0xa1, 0x2f, 0x03, 0x1c, 0x9a,
-	0x16, 0x69, 0x99, 0xdf, 0xa3, 0x8e, 0x9b, 0xd7, 0x36, 0x17, 0xb7, 0xd2, 0x95, 0x22, 0x8f, 0xd8,
-	0x07, 0x01, 0xf4, 0xa4, 0x5f, 0xcc, 0x05, 0x5f, 0x7b, 0xa4, 0x4d, 0x71, 0x84, 0x05, 0x6d, 0x42,
-	0xd2, 0x22, 0x6d, 0x2a, 0x82, 0x98, 0x0e, 0x63, 0x22, 0xe8, 0x04, 0x06, 0xbd, 0x03, 0x29, 0x46,
-	0x2d, 0x62, 0xb1, 0xfb, 0xbb, 0x22, 0x2a, 0xe9, 0xf0, 0xd4, 0xfb, 0x12, 0x8e, 0x03, 0x0a, 0x74,
-	0x03, 0x32, 0x75, 0xd3, 0xed, 0xb4, 0x48, 0x8f, 0x8b, 0xc8, 0x27, 0x05, 0xc3, 0x05, 0xc9, 0x90,
-	0xd9, 0x0d, 0x51, 0x38, 0x4a, 0x57, 0xfa, 0x59, 0x02, 0xd6, 0xe2, 0xa1, 0x44, 0x37, 0x61, 0xa9,
-	0x73, 0x44, 0x5c, 0x2a, 0x82, 0x93, 0xae, 0x6c, 0xfa, 0x4e, 0xa9, 0x71, 0xe0, 0x49, 0xbf, 0x78,
-	0x2e, 0xe4, 0x10, 0x20, 0xec, 0x91, 0xa3, 0x63, 0x40, 0x2d, 0xe2, 0xb2, 0x7d, 0x87, 0x58, 0xae,
-	0xc9, 0x4c, 0xdb, 0xda, 0x37, 0xe5, 0x09, 0x33, 0x3b, 0x6f, 0x4d, 0x17, 0x61, 0xce, 0x51, 0x29,
-	0x48, 0x85, 0xe8, 0xc1, 0x90, 0x34, 0x3c, 0x42, 0x03, 0xba, 0x0c, 0xcb, 0x0e, 0x25, 0xae, 0x6d,
-	0x49, 0x3f, 0x05, 0xa9, 0x88, 0x05, 0x14, 0x4b, 0x2c, 0xba, 0x02, 0x2b, 0x6d, 0xea, 0xba, 0xa4,
-	0xe1, 0xfb, 0xe7, 0x9c, 0x24, 0x5c, 0x79, 0xe8, 0x81, 0xb1, 0x8f, 0x2f, 0xfd, 0x76, 0x11, 0xd2,
-	0x55, 0xdb, 0x3a, 0x34, 0x1b, 0x0f, 0xc9, 0x3c, 0x6a, 0xfa, 0x19, 0x24, 0x85, 0x74, 0x2f, 0x67,
-	0xaf, 0x4f, 0xce, 0x59, 0xdf, 0x36, 0x7d, 0x97, 0x30, 0x72, 0xd7, 0x62, 0x4e, 0x2f, 0x4c, 0x22,
-	0x0e, 0xc2, 0x42, 0x1e, 0xb2, 0x00, 0x0e, 0x4c, 0x8b, 0x38, 0x3d, 0x0e, 0xcb, 0x2f, 0x0a, 0xe9,
-	0xb7, 0x67, 0x90, 0x5e, 0x09, 0x98, 0x3d, 0x1d, 0xc1, 0x29, 0x42, 0x04, 0x8e, 0x68, 0x28, 0xbc,
-	0x07, 0xe9, 0x80, 0x18, 0xad, 0xc1, 0x62, 0x93, 0xf6, 0xbc, 0x2c, 0xc2, 0xfc, 0x27, 0x5a, 0x87,
-	0xa5, 0x63, 0xd2, 0xea, 0xca, 0xb4, 0xc7, 0xde, 0xc7, 0xed, 0xc4, 0x2d, 0xad, 0xf0, 0x45, 0x38,
-	0x17, 0xd3, 0x35, 0x89, 0x3d, 0x1b, 0x61, 0x2f, 0xfd, 0x45, 0x83, 0x5c, 0x60, 0xf5, 0x1c, 0x9a,
-	0xcc, 0x23, 0xb5, 0xc9, 0xbc, 0x35, 0xbd, 0x4b, 0xc7, 0xf4, 0x98, 0x81, 0x06, 0xd9, 0xaf, 0x10,
-	0xa7, 0xfe, 0xb8, 0x4b, 0x2c, 0x66, 0xb2, 0x1e, 0x32, 0x21, 0x79, 0x44, 0x9c, 0xba, 0xe8, 0x2d,
-	0x99, 0x9d, 0xf7, 0x26, 0x2a, 0x88, 0x32, 0x8b, 0x0f, 0x2f, 0x60, 0x9f, 0xf1, 0x93, 0x82, 0x83,
-	0x4e, 0xfa, 0xc5, 0x2c, 0x96, 0x63, 0x96, 0x1f, 0x0a, 0x0b, 0x15, 0x85, 0x06, 0xa4, 0x03, 0x86,
-	0x11, 0x5e, 0xdf, 0x8d, 0x7a, 0x7d, 0x82, 0x1b, 0x75, 0x7f, 0x8a, 0xeb, 0xbe, 0x2d, 0xd1, 0x28,
-	0xfd, 0x26, 0x01, 0xab, 0xf7, 0xdb, 0xa4, 0x41, 0x79, 0xef, 0x71, 0x3b, 0xc4, 0xa0, 0x73, 0x28,
-	0xad, 0xa7, 0xca, 0xb8, 0xbc, 0x36, 0xd1, 0x91, 0xaa, 0x81, 0x63, 0x47, 0xe6, 0xb7, 0x63, 0x23,
-	0xf3, 0xc6, 0xac, 0x82, 0x4f, 0x1f, 0x9b, 0x2f, 0x34, 0x40, 0x2a, 0xc3, 0x1c, 0xb2, 0x7a, 0x5f,
-	0xcd, 0xea, 0xf2, 0x8c, 0x47, 0x1a, 0x93, 0xda, 0x7f, 0x1f, 0x3a, 0xca, 0x1b, 0x35, 0x42, 0x7f,
-	0x99, 0x80, 0xf5, 0x51, 0xa1, 0x45, 0xb7, 0xd5, 0x31, 0xfa, 0xf9, 0xf8, 0x18, 0xbd, 0xa0, 0x72,
-	0xbd, 0xa9, 0xa3, 0xf4, 0xe7, 0x09, 0x48, 0xcf, 0xb3, 0xde, 0x6b, 0x4a, 0xbd, 0xeb, 0x13, 0x73,
-	0x78, 0x72, 0xa9, 0x7f, 0x3d, 0x56, 0xea, 0xef, 0xce, 0x20, 0xf3, 0xf4, 0x2a, 0xff, 0x83, 0x06,
-	0xb9, 0x80, 0xb6, 0x4a, 0x1d, 0x86, 0x2e, 0xc1, 0x8a, 0x41, 0x1d, 0x56, 0xa3, 0x6d, 0xe1, 0x9e,
-	0x6c, 0x25, 0xc3, 0x9d, 0x5a, 0xf5, 0x40, 0xd8, 0xc7, 0xa1, 0x12, 0x2c, 0x37, 0x69, 0x8f, 0x53,
-	0x89, 0x51, 0x58, 0x01, 0x2e, 0xfc, 0x6b, 0x02, 0x82, 0x25, 0x06, 0xbd, 0x0d, 0x69, 0x83, 0x48,
-	0x4e, 0x61, 0x79, 0xb6, 0x92, 0x1b, 0xf4, 0x8b, 0xe9, 0xea, 0x1d, 0x5f, 0x5c, 0x88, 0x47, 0x65,
-	0x48, 0x93, 0x8e, 0xf9, 0x84, 0x3a, 0xc7, 0xd4, 0x91, 0x21, 0x3d, 0x2f, 0x8d, 0x4e, 0xdf, 0xa9,
-	0xdd, 0xf7, 0x10, 0x38, 0xa4, 0x29, 0xdd, 0x83, 0x75, 0xc5, 0xf2, 0x47, 0x1d, 0x9e, 0x44, 0x2e,
-	0x17, 0x74, 0x4c, 0x5a, 0x66, 0x7d, 0x97, 0xf4, 0x5c, 0x99, 0xf9, 0x81, 0xa0, 0x67, 0x3e, 0x02,
-	0x87, 0x34, 0x62, 0x74, 0xcf, 0xb3, 0xc9, 0xcd, 0x3c, 0xba, 0x27, 0xf5, 0xb7, 0x7f, 0x25, 0x23,
-	0x07, 0xf8, 0xef, 0xb4, 0xb6, 0x68, 0xe3, 0x4a, 0x4c, 0xd3, 0xb8, 0x8c, 0x56, 0xd7, 0x65, 0x9e,
-	0x20, 0x59, 0xb9, 0x41, 0xe3, 0xaa, 0x86, 0x28, 0x1c, 0xa5, 0x8b, 0xb0, 0xed, 0xf7, 0x3a, 0x34,
-	0x9f, 0x1a, 0xc9, 0xc6, 0x51, 0x38, 0x4a, 0x87, 0xbe, 0x04, 0xab, 0xf2, 0xf3, 0x19, 0x75, 0x5c,
-	0xd3, 0xb6, 0xf2, 0xcb, 0x82, 0xf3, 0x93, 0x92, 0x73, 0xb5, 0xaa, 0x60, 0x71, 0x8c, 0x1a, 0x7d,
-	0x15, 0x90, 0x84, 0x44, 0x5a, 0x6a, 0x7e, 0x45, 0xc8, 0x08, 0xda, 0x55, 0x75, 0x88, 0x02, 0x8f,
-	0xe0, 0xe2, 0xc9, 0x66, 0xf9, 0x9e, 0x8f, 0x67, 0x6d, 0x10, 0x12, 0x1c, 0xd2, 0xa0, 0xe7, 0x72,
-	0xab, 0x5a, 0x12, 0xb1, 0xbf, 0x35, 0x5b, 0x73, 0xf8, 0x7f, 0x5d, 0xab, 0x7e, 0xb7, 0x02, 0xe7,
-	0xe2, 0xc3, 0xe7, 0x86, 0x3a, 0x7c, 0x8a, 0xf1, 0xe1, 0xb3, 0xfa, 0xa6, 0xcf, 0x1d, 0x74, 0x0f,
-	0xce, 0xfb, 0x5e, 0x7b, 0xdc, 0xb5, 0x19, 0x11, 0x69, 0xb6, 0x24, 0x98, 0x3e, 0x25, 0x99, 0xce,
-	0xe3, 0x38, 0x01, 0x1e, 0xe6, 0x41, 0x2d, 0x48, 0x76, 0x5d, 0x5a, 0xcf, 0x2f, 0x4f, 0x79, 0x7b,
-	0x8a, 0x85, 0x42, 0x7f, 0xea, 0xd2, 0x78, 0xd6, 0x70, 0xd0, 0x70, 0xd6, 0x70, 0x2d, 0xe8, 0xa7,
-	0x1a, 0xac, 0x1a, 0xc4, 0x38, 0xa2, 0x75, 0x9e, 0x72, 0x3c, 0x81, 0xf2, 0x2b, 0x42, 0xf1, 0xee,
-	0xcc, 0x8a, 0xab, 0x8a, 0x18, 0xcf, 0x84, 0xcb, 0x41, 0x95, 0x2a, 0xc8, 0x21, 0x63, 0x62, 0x36,
-	0x20, 0x07, 0x32, 0x7c, 0xf6, 0x98, 0x87, 0xa6, 0x41, 0x98, 0xd7, 0x2c, 0x66, 0x1a, 0xae, 0x7c,
-	0x44, 0x54, 0x36, 0x45, 0x63, 0x09, 0xc5, 0xf0, 0x26, 0xa8, 0x50, 0xe0, 0xa8, 0x12, 0x5e, 0x40,
-	0x81, 0xef, 0xce, 0xb2, 0x80, 0x0a, 0xdf, 0x85, 0x0b, 0x23, 0x7c, 0x75, 0xa6, 0x35, 0xfb, 0xeb,
-	0x04, 0x64, 0xf7, 0xdc, 0xbb, 0x6d, 0xb3, 0xe1, 0x10, 0x5e, 0x05, 0x73, 0x58, 0x8c, 0x9e, 0x28,
-	0x8b, 0xd1, 0xe4, 0x27, 0xbe, 0xa8, 0x79, 0x63, 0x77, 0xa3, 0x6f, 0xc5, 0x76, 0xa3, 0x6b, 0xb3,
-	0x89, 0x3d, 0x7d, 0x3d, 0xfa, 0x9b, 0x06, 0x6b, 0x51, 0xf2, 0x39, 0x6c, 0x07, 0x58, 0xdd, 0x0e,
-	0xae, 0xce, 0x74, 0x9c, 0xf1, 0x17, 0xa0, 0xb5, 0xb8, 0x33, 0x95, 0x11, 0xaf, 0x4d, 0x1c, 0xf1,
-	0xca, 0xa0, 0x4b, 0x4c, 0x31, 0xe8, 0x76, 0x00, 0x2c, 0xf7, 0xc9, 0x91, 0xfd, 0x51, 0x64, 0x25,
-	0x08, 0xd2, 0x63, 0x2f, 0xc0, 0xe0, 0x08, 0x95, 0xb8, 0x00, 0x51, 0x97, 0x99, 0x96, 0xb0, 0x72,
-	0xe8, 0x02, 0x14, 0xa2, 0x70, 0x94, 0x8e, 0x2f, 0xf8, 0x68, 0x38, 0xa8, 0xe8, 0x96, 0x3a, 0x81,
-	0x4a, 0xf1, 0x09, 0x74, 0x3e, 0xca, 0xf3, 0xa6, 0x5e, 0x7e, 0xfe, 0xac, 0x41, 0xaa, 0xd6, 0x22,
-	0xec, 0xd0, 0x76, 0xda, 0x73, 0x28, 0xf1, 0x47, 0x4a, 0x89, 0x4f, 0x4e, 0x5e, 0xdf, 0xb4, 0x71,
-	0xe5, 0x5d, 0xfa, 0x93, 0x06, 0x59, 0x9f, 0x68, 0x0e, 0xd5, 0xb7, 0xa7, 0x56, 0xdf, 0x95, 0xa9,
-	0x0f, 0x30, 0xa6, 0xf2, 0x3e, 0x0e, 0xad, 0xff, 0x0f, 0x8a, 0xee, 0x36, 0xac, 0x92, 0x7a, 0xdb,
-	0xb4, 0x4c, 0x97, 0x39, 0x84, 0xd9, 0x8e, 0x67, 0x56, 0xba, 0x82, 0xf8, 0xfc, 0xbc, 0xa3, 0x60,
-	0x70, 0x8c, 0xb2, 0xf4, 0xfb, 0x24, 0x2c, 0xd7, 0x6c, 0x87, 0x91, 0xd6, 0x1c, 0xc2, 0xfe, 0x3e,
-	0xe4, 0x14, 0xf5, 0x22, 0xfe, 0xa9, 0xca, 0x27, 0x24, 0x53, 0x4e, 0xb1, 0x15, 0xab, 0xb4, 0xc8,
-	0x80, 0x54, 0xc7, 0xb1, 0xb9, 0x54, 0x57, 0x3e, 0x10, 0x4f, 0x7e, 0xca, 0xf2, 0x4e, 0xa6, 0xd7,
-	0x24, 0x9f, 0xb7, 0x5a, 0x04, 0xae, 0xf4, 0xc1, 0x38, 0x10, 0x8c, 0xbe, 0x0f, 0x69, 0xfa, 0x31,
-	0xa3, 0x96, 0xeb, 0x35, 0x16, 0xae, 0xe5, 0xe6, 0xb4, 0x5a, 0xee, 0xfa, 0x8c, 0x9e, 0x9a, 0x4b,
-	0x7e, 0xdf, 0x0b, 0xe0, 0x27, 0xfd, 0xe2, 0x9a, 0xd4, 0x19, 0xc0, 0x70, 0xa8, 0xaf, 0xf0, 0x3e,
-	0xe4, 0x14, 0x4b, 0x67, 0x7a, 0x98, 0x6e, 0xc1, 0xaa, 0x6a, 0xc0, 0x34, 0x6b, 0xc1, 0x74, 0x27,
-	0x93, 0x46, 0x45, 0xd7, 0x82, 0x0f, 0x21, 0xa7, 0xe0, 0xd0, 0xe7, 0xd4, 0x2e, 0x9a, 0x53, 0xba,
-	0xa8, 0xdf, 0x30, 0x2f, 0xc3, 0x72, 0x87, 0x38, 0xd4, 0x62, 0x72, 0x34, 0x04, 0x8d, 0xab, 0x26,
-	0xa0, 0x58, 0x62, 0x4b, 0x3f, 0x49, 0xc0, 0x8a, 0x2f, 0xf8, 0xec, 0xb3, 0x72, 0x4f, 0x69, 0x46,
-	0xef, 0x4c, 0x76, 0x8a, 0x67, 0xd9, 0xd8, 0x55, 0xe3, 0x59, 0x6c, 0xd5, 0xd0, 0xa7, 0x96, 0x78,
-	0xfa, 0x96, 0xf1, 0x47, 0x0d, 0x32, 0x92, 0x72, 0x0e, 0x2d, 0xee, 0xa1, 0xda, 0xe2, 0xb6, 0xa6,
-	0x3d, 0xc4, 0x98, 0x0e, 0xf7, 0xc3, 0x64, 0x60, 0xfc, 0xff, 0xe8, 0xe9, 0x21, 0xfa, 0x66, 0xba,
-	0x38, 0xdd, 0x9b, 0x29, 0xba, 0xc4, 0x27, 0x68, 0xfb, 0x80, 0x9b, 0x98, 0x14, 0x26, 0x66, 0xbc,
-	0xe9, 0x29, 0x40, 0xd8, 0xc7, 0xf1, 0x2b, 0x9c, 0x97, 0xb9, 0xf2, 0x84, 0xa3, 0xae, 0x70, 0xb5,
-	0x38, 0x01, 0x1e, 0xe6, 0x41, 0x1f, 0x41, 0x4a, 0xbe, 0x1e, 0xb8, 0x53, 0x5f, 0xe3, 0x22, 0x5e,
-	0xd5, 0xe5, 0x73, 0x84, 0x6c, 0x74, 0xfe, 0x65, 0x3a, 0xe5, 0x83, 0x4f, 0xc2, 0xf7, 0x12, 0x7e,
-	0x7b, 0xc0, 0x81, 0xb2, 0xc2, 0x73, 0xc8, 0x29, 0xbc, 0x23, 0x9a, 0x47, 0x55, 0x6d, 0x1e, 0x57,
-	0x67, 0xfa, 0xa7, 0x27, 0xda, 0x3b, 0x7e, 0x94, 0x0a, 0xfa, 0x9c, 0x5c, 0xc1, 0x4a, 0xb0, 0xdc,
-	0xb2, 0x8d, 0x26, 0xad, 0x0b, 0x7d, 0x29, 0xef, 0x95, 0xf0, 0x81, 0x80, 0x60, 0x89, 0x41, 0xd7,
-	0xfc, 0x06, 0xe3, 0x05, 0xfb, 0xb3, 0xf1, 0x35, 0x2d, 0x2b, 0x45, 0x2a, 0x0d, 0xa7, 0x17, 0xf1,
-	0xa7, 0x37, 0x33, 0xbe, 0x30, 0x5b, 0x31, 0xce, 0xe0, 0x51, 0x7e, 0x05, 0x0c, 0x3d, 0x8a, 0x9e,
-	0xc2, 0x45, 0x83, 0xb4, 0x8c, 0x6e, 0x8b, 0x30, 0x5a, 0xaf, 0x1e, 0x99, 0xad, 0xba, 0xdf, 0xdb,
-	0x65, 0x2a, 0x7d, 0x7a, 0xd0, 0x2f, 0x5e, 0xac, 0x8e, 0x26, 0xc1, 0xe3, 0x78, 0xd1, 0x03, 0x58,
-	0x0f, 0x51, 0xc1, 0x46, 0xed, 0x8a, 0x87, 0xa2, 0x74, 0x25, 0x3f, 0xe8, 0x17, 0xd7, 0xab, 0x23,
-	0xf0, 0x78, 0x24, 0x17, 0xfa, 0x95, 0x06, 0x28, 0xbc, 0x40, 0x57, 0xd5, 0xd4, 0xfb, 0x60, 0x56,
-	0x57, 0x0d, 0x09, 0xf2, 0x9c, 0x76, 0x25, 0x78, 0x2c, 0x1b, 0x22, 0x88, 0x27, 0xe4, 0x08, 0x63,
-	0xd0, 0x75, 0xc8, 0x7a, 0x50, 0xaf, 0x82, 0xe4, 0x0b, 0xdc, 0xda, 0xa0, 0x5f, 0xcc, 0x56, 0x23,
-	0x70, 0xac, 0x50, 0x8d, 0xd9, 0xcd, 0x53, 0x73, 0xdc, 0xcd, 0xd3, 0xd3, 0xee, 0xe6, 0x70, 0xfa,
-	0x6e, 0x7e, 0x26, 0xb5, 0xc9, 0x93, 0x75, 0xd4, 0x0b, 0x03, 0x83, 0x8b, 0x63, 0xc2, 0x78, 0x96,
-	0x1d, 0x61, 0xa0, 0x41, 0x36, 0x6a, 0x11, 0x32, 0xe5, 0x53, 0xd6, 0xb4, 0x7f, 0x2a, 0x47, 0x99,
-	0x67, 0x7b, 0xc7, 0x9a, 0xdb, 0xe3, 0x4d, 0x65, 0xeb, 0xc5, 0xeb, 0x8d, 0x85, 0x97, 0xaf, 0x37,
-	0x16, 0x5e, 0xbd, 0xde, 0x58, 0xf8, 0xc1, 0x60, 0x43, 0x7b, 0x31, 0xd8, 0xd0, 0x5e, 0x0e, 0x36,
-	0xb4, 0x57, 0x83, 0x0d, 0xed, 0x1f, 0x83, 0x0d, 0xed, 0xc7, 0xff, 0xdc, 0x58, 0xf8, 0x66, 0xe2,
-	0x78, 0xfb, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xaa, 0x28, 0xc1, 0xb9, 0x00, 0x27, 0x00, 0x00,
+func (m *PlatformSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ChartGroup) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PlatformSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ChartGroup) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PlatformSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlatformSpec.Merge(m, src)
 }
-
-func (m *ChartGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *PlatformSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlatformSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlatformSpec.DiscardUnknown(m)
 }
 
-func (m *ChartGroupList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PlatformSpec proto.InternalMessageInfo
+
+func (m *Portal) Reset()      { *m = Portal{} }
+func (*Portal) ProtoMessage() {}
+func (*Portal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{37}
+}
+func (m *Portal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Portal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ChartGroupList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Portal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Portal.Merge(m, src)
+}
+func (m *Portal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Portal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Portal.DiscardUnknown(m)
 }
 
-func (m *ChartGroupList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+var xxx_messageInfo_Portal proto.InternalMessageInfo
+
+func (m *PortalProject) Reset()      { *m = PortalProject{} }
+func (*PortalProject) ProtoMessage() {}
+func (*PortalProject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{38}
+}
+func (m *PortalProject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PortalProject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *PortalProject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortalProject.Merge(m, src)
+}
+func (m *PortalProject) XXX_Size() int {
+	return m.Size()
+}
+func (m *PortalProject) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortalProject.DiscardUnknown(m)
 }
 
-func (m *ChartGroupSpec) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PortalProject proto.InternalMessageInfo
+
+func (m *Project) Reset()      { *m = Project{} }
+func (*Project) ProtoMessage() {}
+func (*Project) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{39}
+}
+func (m *Project) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
+}
+func (m *Project) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Project.Merge(m, src)
+}
+func (m *Project) XXX_Size() int {
+	return m.Size()
+}
+func (m *Project) XXX_DiscardUnknown() {
+	xxx_messageInfo_Project.DiscardUnknown(m)
 }
 
-func (m *ChartGroupSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Project proto.InternalMessageInfo
+
+func (m *ProjectFreezeStatus) Reset()      { *m = ProjectFreezeStatus{} }
+func (*ProjectFreezeStatus) ProtoMessage() {}
+func (*ProjectFreezeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{40}
+}
+func (m *ProjectFreezeStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectFreezeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectFreezeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectFreezeStatus.Merge(m, src)
+}
+func (m *ProjectFreezeStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectFreezeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectFreezeStatus.DiscardUnknown(m)
 }
 
-func (m *ChartGroupSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.DisplayName)
-	copy(dAtA[i:], m.DisplayName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.TenantID)
-	copy(dAtA[i:], m.TenantID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0x12
-	if len(m.Finalizers) > 0 {
-		for iNdEx := len(m.Finalizers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Finalizers[iNdEx])
-			copy(dAtA[i:], m.Finalizers[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Finalizers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_ProjectFreezeStatus proto.InternalMessageInfo
+
+func (m *ProjectList) Reset()      { *m = ProjectList{} }
+func (*ProjectList) ProtoMessage() {}
+func (*ProjectList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{41}
+}
+func (m *ProjectList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *ProjectList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectList.Merge(m, src)
+}
+func (m *ProjectList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectList.DiscardUnknown(m)
 }
 
-func (m *ChartGroupStatus) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ProjectList proto.InternalMessageInfo
+
+func (m *ProjectQuotaNode) Reset()      { *m = ProjectQuotaNode{} }
+func (*ProjectQuotaNode) ProtoMessage() {}
+func (*ProjectQuotaNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{42}
+}
+func (m *ProjectQuotaNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectQuotaNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
+}
+func (m *ProjectQuotaNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectQuotaNode.Merge(m, src)
+}
+func (m *ProjectQuotaNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectQuotaNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectQuotaNode.DiscardUnknown(m)
 }
 
-func (m *ChartGroupStatus) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ProjectQuotaNode proto.InternalMessageInfo
+
+func (m *ProjectQuotaTree) Reset()      { *m = ProjectQuotaTree{} }
+func (*ProjectQuotaTree) ProtoMessage() {}
+func (*ProjectQuotaTree) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{43}
+}
+func (m *ProjectQuotaTree) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectQuotaTree) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectQuotaTree) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectQuotaTree.Merge(m, src)
+}
+func (m *ProjectQuotaTree) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectQuotaTree) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectQuotaTree.DiscardUnknown(m)
 }
 
-func (m *ChartGroupStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.Reason)
-	copy(dAtA[i:], m.Reason)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+var xxx_messageInfo_ProjectQuotaTree proto.InternalMessageInfo
+
+func (m *ProjectRequest) Reset()      { *m = ProjectRequest{} }
+func (*ProjectRequest) ProtoMessage() {}
+func (*ProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{44}
+}
+func (m *ProjectRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *ProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectRequest.Merge(m, src)
+}
+func (m *ProjectRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectRequest.DiscardUnknown(m)
 }
 
-func (m *ConfigMap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ProjectRequest proto.InternalMessageInfo
+
+func (m *ProjectRequestList) Reset()      { *m = ProjectRequestList{} }
+func (*ProjectRequestList) ProtoMessage() {}
+func (*ProjectRequestList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{45}
+}
+func (m *ProjectRequestList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectRequestList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
+}
+func (m *ProjectRequestList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectRequestList.Merge(m, src)
+}
+func (m *ProjectRequestList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectRequestList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectRequestList.DiscardUnknown(m)
 }
 
-func (m *ConfigMap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ProjectRequestList proto.InternalMessageInfo
+
+func (m *ProjectRequestSpec) Reset()      { *m = ProjectRequestSpec{} }
+func (*ProjectRequestSpec) ProtoMessage() {}
+func (*ProjectRequestSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{46}
+}
+func (m *ProjectRequestSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectRequestSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectRequestSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectRequestSpec.Merge(m, src)
+}
+func (m *ProjectRequestSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectRequestSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectRequestSpec.DiscardUnknown(m)
 }
 
-func (m *ConfigMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BinaryData) > 0 {
-		keysForBinaryData := make([]string, 0, len(m.BinaryData))
-		for k := range m.BinaryData {
-			keysForBinaryData = append(keysForBinaryData, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForBinaryData)
-		for iNdEx := len(keysForBinaryData) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.BinaryData[string(keysForBinaryData[iNdEx])]
-			baseI := i
-			if v != nil {
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(keysForBinaryData[iNdEx])
-			copy(dAtA[i:], keysForBinaryData[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForBinaryData[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Data) > 0 {
-		keysForData := make([]string, 0, len(m.Data))
-		for k := range m.Data {
-			keysForData = append(keysForData, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForData)
-		for iNdEx := len(keysForData) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Data[string(keysForData[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForData[iNdEx])
-			copy(dAtA[i:], keysForData[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForData[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+var xxx_messageInfo_ProjectRequestSpec proto.InternalMessageInfo
+
+func (m *ProjectRequestStatus) Reset()      { *m = ProjectRequestStatus{} }
+func (*ProjectRequestStatus) ProtoMessage() {}
+func (*ProjectRequestStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{47}
+}
+func (m *ProjectRequestStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectRequestStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *ProjectRequestStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectRequestStatus.Merge(m, src)
+}
+func (m *ProjectRequestStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectRequestStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectRequestStatus.DiscardUnknown(m)
 }
 
-func (m *ConfigMapList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ProjectRequestStatus proto.InternalMessageInfo
+
+func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
+func (*ProjectSpec) ProtoMessage() {}
+func (*ProjectSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{48}
+}
+func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ConfigMapList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProjectSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectSpec.Merge(m, src)
+}
+func (m *ProjectSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectSpec.DiscardUnknown(m)
 }
 
-func (m *ConfigMapList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
+
+func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
+func (*ProjectStatus) ProtoMessage() {}
+func (*ProjectStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{49}
+}
+func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *ProjectStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectStatus.Merge(m, src)
+}
+func (m *ProjectStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectStatus.DiscardUnknown(m)
 }
 
-func (m *HardQuantity) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
+
+func (m *QuotaAlert) Reset()      { *m = QuotaAlert{} }
+func (*QuotaAlert) ProtoMessage() {}
+func (*QuotaAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{50}
+}
+func (m *QuotaAlert) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *HardQuantity) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuotaAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaAlert.Merge(m, src)
+}
+func (m *QuotaAlert) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaAlert.DiscardUnknown(m)
 }
 
-func (m *HardQuantity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hard) > 0 {
-		keysForHard := make([]string, 0, len(m.Hard))
-		for k := range m.Hard {
-			keysForHard = append(keysForHard, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
-		for iNdEx := len(keysForHard) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Hard[string(keysForHard[iNdEx])]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForHard[iNdEx])
-			copy(dAtA[i:], keysForHard[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHard[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QuotaAlert proto.InternalMessageInfo
+
+func (m *QuotaAlertRule) Reset()      { *m = QuotaAlertRule{} }
+func (*QuotaAlertRule) ProtoMessage() {}
+func (*QuotaAlertRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{51}
+}
+func (m *QuotaAlertRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaAlertRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return b[:n], nil
+}
+func (m *QuotaAlertRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaAlertRule.Merge(m, src)
+}
+func (m *QuotaAlertRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaAlertRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaAlertRule.DiscardUnknown(m)
 }
 
-func (m *ImageNamespace) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuotaAlertRule proto.InternalMessageInfo
+
+func (m *QuotaAlertState) Reset()      { *m = QuotaAlertState{} }
+func (*QuotaAlertState) ProtoMessage() {}
+func (*QuotaAlertState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{52}
+}
+func (m *QuotaAlertState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaAlertState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
 }
-
-func (m *ImageNamespace) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuotaAlertState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaAlertState.Merge(m, src)
+}
+func (m *QuotaAlertState) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaAlertState) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaAlertState.DiscardUnknown(m)
 }
 
-func (m *ImageNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+var xxx_messageInfo_QuotaAlertState proto.InternalMessageInfo
+
+func (m *QuotaBorrowing) Reset()      { *m = QuotaBorrowing{} }
+func (*QuotaBorrowing) ProtoMessage() {}
+func (*QuotaBorrowing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{53}
+}
+func (m *QuotaBorrowing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaBorrowing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaBorrowing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaBorrowing.Merge(m, src)
+}
+func (m *QuotaBorrowing) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaBorrowing) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaBorrowing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaBorrowing proto.InternalMessageInfo
+
+func (m *UsedQuantity) Reset()      { *m = UsedQuantity{} }
+func (*UsedQuantity) ProtoMessage() {}
+func (*UsedQuantity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_237074a6af309550, []int{54}
+}
+func (m *UsedQuantity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsedQuantity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UsedQuantity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsedQuantity.Merge(m, src)
+}
+func (m *UsedQuantity) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsedQuantity) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsedQuantity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsedQuantity proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*CertificateAlert)(nil), "tkestack.io.tke.api.business.v1.CertificateAlert")
+	proto.RegisterType((*ChartGroup)(nil), "tkestack.io.tke.api.business.v1.ChartGroup")
+	proto.RegisterType((*ChartGroupList)(nil), "tkestack.io.tke.api.business.v1.ChartGroupList")
+	proto.RegisterType((*ChartGroupSpec)(nil), "tkestack.io.tke.api.business.v1.ChartGroupSpec")
+	proto.RegisterType((*ChartGroupStatus)(nil), "tkestack.io.tke.api.business.v1.ChartGroupStatus")
+	proto.RegisterType((*ConfigMap)(nil), "tkestack.io.tke.api.business.v1.ConfigMap")
+	proto.RegisterMapType((map[string][]byte)(nil), "tkestack.io.tke.api.business.v1.ConfigMap.BinaryDataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.business.v1.ConfigMap.DataEntry")
+	proto.RegisterType((*ConfigMapList)(nil), "tkestack.io.tke.api.business.v1.ConfigMapList")
+	proto.RegisterType((*FrozenNamespace)(nil), "tkestack.io.tke.api.business.v1.FrozenNamespace")
+	proto.RegisterType((*FrozenWorkload)(nil), "tkestack.io.tke.api.business.v1.FrozenWorkload")
+	proto.RegisterType((*HardQuantity)(nil), "tkestack.io.tke.api.business.v1.HardQuantity")
+	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.business.v1.HardQuantity.HardEntry")
+	proto.RegisterType((*ImageNamespace)(nil), "tkestack.io.tke.api.business.v1.ImageNamespace")
+	proto.RegisterType((*ImageNamespaceList)(nil), "tkestack.io.tke.api.business.v1.ImageNamespaceList")
+	proto.RegisterType((*ImageNamespaceSpec)(nil), "tkestack.io.tke.api.business.v1.ImageNamespaceSpec")
+	proto.RegisterType((*ImageNamespaceStatus)(nil), "tkestack.io.tke.api.business.v1.ImageNamespaceStatus")
+	proto.RegisterType((*Namespace)(nil), "tkestack.io.tke.api.business.v1.Namespace")
+	proto.RegisterType((*NamespaceCert)(nil), "tkestack.io.tke.api.business.v1.NamespaceCert")
+	proto.RegisterType((*NamespaceCertOptions)(nil), "tkestack.io.tke.api.business.v1.NamespaceCertOptions")
+	proto.RegisterType((*NamespaceCertStatus)(nil), "tkestack.io.tke.api.business.v1.NamespaceCertStatus")
+	proto.RegisterType((*NamespaceList)(nil), "tkestack.io.tke.api.business.v1.NamespaceList")
+	proto.RegisterType((*NamespaceQuotaNode)(nil), "tkestack.io.tke.api.business.v1.NamespaceQuotaNode")
+	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.business.v1.NamespaceQuotaNode.HardEntry")
+	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.business.v1.NamespaceQuotaNode.UsedEntry")
+	proto.RegisterType((*NamespaceSpec)(nil), "tkestack.io.tke.api.business.v1.NamespaceSpec")
+	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.business.v1.NamespaceSpec.HardEntry")
+	proto.RegisterType((*NamespaceStatus)(nil), "tkestack.io.tke.api.business.v1.NamespaceStatus")
+	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.business.v1.NamespaceStatus.CachedSpecHardEntry")
+	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.business.v1.NamespaceStatus.UsedEntry")
+	proto.RegisterType((*NamespaceTemplate)(nil), "tkestack.io.tke.api.business.v1.NamespaceTemplate")
+	proto.RegisterType((*NamespaceTemplateList)(nil), "tkestack.io.tke.api.business.v1.NamespaceTemplateList")
+	proto.RegisterType((*NamespaceTemplateNetworkPolicy)(nil), "tkestack.io.tke.api.business.v1.NamespaceTemplateNetworkPolicy")
+	proto.RegisterType((*NamespaceTemplatePodSecurity)(nil), "tkestack.io.tke.api.business.v1.NamespaceTemplatePodSecurity")
+	proto.RegisterType((*NamespaceTemplateRoleBinding)(nil), "tkestack.io.tke.api.business.v1.NamespaceTemplateRoleBinding")
+	proto.RegisterType((*NamespaceTemplateSpec)(nil), "tkestack.io.tke.api.business.v1.NamespaceTemplateSpec")
+	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.business.v1.NamespaceTemplateSpec.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.business.v1.NamespaceTemplateSpec.LabelsEntry")
+	proto.RegisterType((*NamespaceTemplateSubject)(nil), "tkestack.io.tke.api.business.v1.NamespaceTemplateSubject")
+	proto.RegisterType((*NamespaceTemplateSync)(nil), "tkestack.io.tke.api.business.v1.NamespaceTemplateSync")
+	proto.RegisterType((*NsEmigration)(nil), "tkestack.io.tke.api.business.v1.NsEmigration")
+	proto.RegisterType((*NsEmigrationList)(nil), "tkestack.io.tke.api.business.v1.NsEmigrationList")
+	proto.RegisterType((*NsEmigrationSpec)(nil), "tkestack.io.tke.api.business.v1.NsEmigrationSpec")
+	proto.RegisterType((*NsEmigrationStatus)(nil), "tkestack.io.tke.api.business.v1.NsEmigrationStatus")
+	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.business.v1.NsEmigrationStatus.TransferredQuotaEntry")
+	proto.RegisterType((*Platform)(nil), "tkestack.io.tke.api.business.v1.Platform")
+	proto.RegisterType((*PlatformList)(nil), "tkestack.io.tke.api.business.v1.PlatformList")
+	proto.RegisterType((*PlatformSpec)(nil), "tkestack.io.tke.api.business.v1.PlatformSpec")
+	proto.RegisterType((*Portal)(nil), "tkestack.io.tke.api.business.v1.Portal")
+	proto.RegisterMapType((ProjectExtension)(nil), "tkestack.io.tke.api.business.v1.Portal.ExtensionEntry")
+	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.business.v1.Portal.ProjectsEntry")
+	proto.RegisterType((*PortalProject)(nil), "tkestack.io.tke.api.business.v1.PortalProject")
+	proto.RegisterType((*Project)(nil), "tkestack.io.tke.api.business.v1.Project")
+	proto.RegisterType((*ProjectFreezeStatus)(nil), "tkestack.io.tke.api.business.v1.ProjectFreezeStatus")
+	proto.RegisterType((*ProjectList)(nil), "tkestack.io.tke.api.business.v1.ProjectList")
+	proto.RegisterType((*ProjectQuotaNode)(nil), "tkestack.io.tke.api.business.v1.ProjectQuotaNode")
+	proto.RegisterMapType((ClusterUsed)(nil), "tkestack.io.tke.api.business.v1.ProjectQuotaNode.AllocatedEntry")
+	proto.RegisterMapType((ClusterUsed)(nil), "tkestack.io.tke.api.business.v1.ProjectQuotaNode.BorrowedEntry")
+	proto.RegisterMapType((ClusterHard)(nil), "tkestack.io.tke.api.business.v1.ProjectQuotaNode.HardEntry")
+	proto.RegisterMapType((ClusterUsed)(nil), "tkestack.io.tke.api.business.v1.ProjectQuotaNode.UsedEntry")
+	proto.RegisterType((*ProjectQuotaTree)(nil), "tkestack.io.tke.api.business.v1.ProjectQuotaTree")
+	proto.RegisterType((*ProjectRequest)(nil), "tkestack.io.tke.api.business.v1.ProjectRequest")
+	proto.RegisterType((*ProjectRequestList)(nil), "tkestack.io.tke.api.business.v1.ProjectRequestList")
+	proto.RegisterType((*ProjectRequestSpec)(nil), "tkestack.io.tke.api.business.v1.ProjectRequestSpec")
+	proto.RegisterMapType((ClusterHard)(nil), "tkestack.io.tke.api.business.v1.ProjectRequestSpec.ClustersEntry")
+	proto.RegisterType((*ProjectRequestStatus)(nil), "tkestack.io.tke.api.business.v1.ProjectRequestStatus")
+	proto.RegisterType((*ProjectSpec)(nil), "tkestack.io.tke.api.business.v1.ProjectSpec")
+	proto.RegisterMapType((ClusterHard)(nil), "tkestack.io.tke.api.business.v1.ProjectSpec.ClustersEntry")
+	proto.RegisterType((*ProjectStatus)(nil), "tkestack.io.tke.api.business.v1.ProjectStatus")
+	proto.RegisterMapType((ClusterHard)(nil), "tkestack.io.tke.api.business.v1.ProjectStatus.CachedSpecClustersEntry")
+	proto.RegisterMapType((ClusterUsed)(nil), "tkestack.io.tke.api.business.v1.ProjectStatus.ClustersEntry")
+	proto.RegisterType((*QuotaAlert)(nil), "tkestack.io.tke.api.business.v1.QuotaAlert")
+	proto.RegisterType((*QuotaAlertRule)(nil), "tkestack.io.tke.api.business.v1.QuotaAlertRule")
+	proto.RegisterType((*QuotaAlertState)(nil), "tkestack.io.tke.api.business.v1.QuotaAlertState")
+	proto.RegisterType((*QuotaBorrowing)(nil), "tkestack.io.tke.api.business.v1.QuotaBorrowing")
+	proto.RegisterMapType((ClusterHard)(nil), "tkestack.io.tke.api.business.v1.QuotaBorrowing.LimitsEntry")
+	proto.RegisterType((*UsedQuantity)(nil), "tkestack.io.tke.api.business.v1.UsedQuantity")
+	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.business.v1.UsedQuantity.UsedEntry")
+}
+
+func init() {
+	proto.RegisterFile("tkestack.io/tke/api/business/v1/generated.proto", fileDescriptor_237074a6af309550)
+}
+
+var fileDescriptor_237074a6af309550 = []byte{
+	// 3963 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3c, 0x5d, 0x6c, 0x63, 0x57,
+	0x5a, 0x73, 0x1d, 0x3b, 0xb1, 0x3f, 0x3b, 0x9e, 0xcc, 0x99, 0x94, 0x9a, 0x74, 0x37, 0x89, 0x5c,
+	0x5a, 0x4d, 0xb7, 0x53, 0xa7, 0x93, 0x4e, 0xbb, 0xd3, 0x0e, 0xdd, 0x12, 0x27, 0xd3, 0x61, 0xba,
+	0x33, 0x99, 0xf4, 0x24, 0xd3, 0x76, 0xe9, 0x02, 0x7b, 0x73, 0x7d, 0xe2, 0xdc, 0xb1, 0x7d, 0xaf,
+	0x7b, 0xef, 0x75, 0x52, 0x0f, 0x68, 0xb5, 0xe2, 0x11, 0x1e, 0x58, 0x09, 0x78, 0x40, 0x02, 0xa1,
+	0x05, 0x21, 0xf1, 0xc2, 0x1b, 0x4f, 0x2c, 0xac, 0x78, 0x58, 0xa1, 0x59, 0x09, 0xc1, 0x8a, 0x07,
+	0x54, 0x24, 0x14, 0x6d, 0x03, 0xaf, 0xc0, 0x7b, 0x25, 0x24, 0x74, 0x7e, 0xee, 0xb9, 0xe7, 0x5c,
+	0xdb, 0xf1, 0xbd, 0xd6, 0xc4, 0x5b, 0xcd, 0x5b, 0xfc, 0xfd, 0x9e, 0x9f, 0xef, 0xfb, 0xce, 0xf7,
+	0x7d, 0xe7, 0xdc, 0xc0, 0x5a, 0xd0, 0x22, 0x7e, 0x60, 0x5a, 0xad, 0x9a, 0xed, 0xd2, 0xbf, 0xd7,
+	0xcc, 0xae, 0xbd, 0xb6, 0xdf, 0xf3, 0x6d, 0x87, 0xf8, 0xfe, 0xda, 0xd1, 0xb5, 0xb5, 0x26, 0x71,
+	0x88, 0x67, 0x06, 0xa4, 0x51, 0xeb, 0x7a, 0x6e, 0xe0, 0xa2, 0x15, 0x85, 0xa1, 0x16, 0xb4, 0x48,
+	0xcd, 0xec, 0xda, 0xb5, 0x90, 0xa1, 0x76, 0x74, 0x6d, 0xe9, 0x95, 0xa6, 0x1d, 0x1c, 0xf6, 0xf6,
+	0x6b, 0x96, 0xdb, 0x59, 0x6b, 0xba, 0x4d, 0x77, 0x8d, 0xf1, 0xed, 0xf7, 0x0e, 0xd8, 0x2f, 0xf6,
+	0x83, 0xfd, 0xc5, 0xe5, 0x2d, 0x55, 0x5b, 0x37, 0x7c, 0xaa, 0x9b, 0xea, 0xb5, 0x5c, 0x8f, 0x0c,
+	0xd1, 0xb9, 0x74, 0x3d, 0xa2, 0xe9, 0x98, 0xd6, 0xa1, 0xed, 0x10, 0xaf, 0xbf, 0xd6, 0x6d, 0x35,
+	0x19, 0x93, 0x47, 0x7c, 0xb7, 0xe7, 0x59, 0x24, 0x15, 0x97, 0xbf, 0xd6, 0x21, 0x81, 0x39, 0x4c,
+	0xd7, 0xda, 0x28, 0x2e, 0xaf, 0xe7, 0x04, 0x76, 0x67, 0x50, 0xcd, 0x1b, 0xe3, 0x18, 0x7c, 0xeb,
+	0x90, 0x74, 0xcc, 0x38, 0x5f, 0xb5, 0x05, 0x0b, 0x9b, 0xc4, 0x0b, 0xec, 0x03, 0xdb, 0x32, 0x03,
+	0xb2, 0xd1, 0x26, 0x5e, 0x80, 0x5e, 0x82, 0x39, 0xeb, 0xd0, 0x74, 0x1c, 0xd2, 0xae, 0x18, 0xab,
+	0xc6, 0x95, 0x42, 0xfd, 0xe2, 0xe3, 0x93, 0x95, 0x0b, 0xa7, 0x27, 0x2b, 0x73, 0x9b, 0x1c, 0x8c,
+	0x43, 0x3c, 0xba, 0x0a, 0xf9, 0x80, 0x74, 0xba, 0x6d, 0x33, 0x20, 0x95, 0x0c, 0xa3, 0x5d, 0x10,
+	0xb4, 0xf9, 0x3d, 0x01, 0xc7, 0x92, 0xa2, 0xfa, 0x27, 0x19, 0x80, 0xcd, 0x43, 0xd3, 0x0b, 0x6e,
+	0x7b, 0x6e, 0xaf, 0x8b, 0xbe, 0x03, 0x79, 0x3a, 0xff, 0x86, 0x19, 0x98, 0x4c, 0x51, 0x71, 0xfd,
+	0xd5, 0x1a, 0x9f, 0x46, 0x4d, 0x9d, 0x46, 0xad, 0xdb, 0x6a, 0x52, 0x80, 0x5f, 0xa3, 0xd4, 0xb5,
+	0xa3, 0x6b, 0xb5, 0xfb, 0xfb, 0x0f, 0x89, 0x15, 0xdc, 0x23, 0x81, 0x59, 0x47, 0x42, 0x1d, 0x44,
+	0x30, 0x2c, 0xa5, 0xa2, 0xf7, 0x21, 0xeb, 0x77, 0x89, 0xc5, 0x86, 0x56, 0x5c, 0x5f, 0xab, 0x8d,
+	0xb1, 0x9a, 0x5a, 0x34, 0xb8, 0xdd, 0x2e, 0xb1, 0xea, 0x25, 0x21, 0x3c, 0x4b, 0x7f, 0x61, 0x26,
+	0x0a, 0x7d, 0x0b, 0x66, 0xfd, 0xc0, 0x0c, 0x7a, 0x7e, 0x65, 0x86, 0x09, 0xbd, 0x96, 0x46, 0x28,
+	0x63, 0xac, 0x97, 0x85, 0xd8, 0x59, 0xfe, 0x1b, 0x0b, 0x81, 0xd5, 0x7f, 0x30, 0xa0, 0x1c, 0x11,
+	0xdf, 0xb5, 0xfd, 0x00, 0x7d, 0x7b, 0x60, 0x89, 0x6a, 0xc9, 0x96, 0x88, 0x72, 0xb3, 0x05, 0x92,
+	0xfb, 0x11, 0x42, 0x94, 0xe5, 0xd9, 0x81, 0x9c, 0x1d, 0x90, 0x8e, 0x5f, 0xc9, 0xac, 0xce, 0x5c,
+	0x29, 0xae, 0xbf, 0x9c, 0x62, 0x2a, 0xf5, 0x79, 0x21, 0x37, 0x77, 0x87, 0x4a, 0xc0, 0x5c, 0x50,
+	0xf5, 0x33, 0x6d, 0x0a, 0x74, 0xd9, 0xd0, 0x3b, 0x00, 0x07, 0xb6, 0x63, 0xb6, 0xed, 0x47, 0xc4,
+	0xf3, 0x2b, 0xc6, 0xea, 0xcc, 0x95, 0x42, 0x7d, 0x85, 0xee, 0xd8, 0xbb, 0x12, 0xfa, 0xc5, 0xc9,
+	0xca, 0xbc, 0xfc, 0xb5, 0x6d, 0x76, 0x08, 0x56, 0x58, 0xd0, 0x2a, 0x64, 0x1d, 0xb3, 0x13, 0xda,
+	0x97, 0xdc, 0x13, 0x46, 0xc7, 0x30, 0xdc, 0x0a, 0x1d, 0xd3, 0x09, 0xee, 0x6c, 0xb1, 0x5d, 0xd1,
+	0xac, 0x90, 0xc3, 0xb1, 0xa4, 0x40, 0xaf, 0x43, 0xb1, 0x61, 0xfb, 0xdd, 0xb6, 0xd9, 0xa7, 0x22,
+	0x2a, 0x59, 0xc6, 0x70, 0x59, 0x30, 0x14, 0xb7, 0x22, 0x14, 0x56, 0xe9, 0xaa, 0x7f, 0x94, 0x81,
+	0x85, 0xf8, 0x56, 0xa2, 0x37, 0x20, 0xd7, 0x3d, 0x34, 0x7d, 0x22, 0x1c, 0x65, 0x35, 0x5c, 0x94,
+	0x1d, 0x0a, 0xfc, 0xe2, 0x64, 0xe5, 0x62, 0xc4, 0xc1, 0x40, 0x98, 0x93, 0xa3, 0x23, 0x40, 0x6d,
+	0xd3, 0x0f, 0xf6, 0x3c, 0xd3, 0xf1, 0xed, 0xc0, 0x76, 0x9d, 0x3d, 0x5b, 0xcc, 0xb0, 0xb8, 0xfe,
+	0xb5, 0x64, 0x3b, 0x4c, 0x39, 0xea, 0x4b, 0x42, 0x21, 0xba, 0x3b, 0x20, 0x0d, 0x0f, 0xd1, 0x80,
+	0x5e, 0x84, 0x59, 0x8f, 0x98, 0xbe, 0xeb, 0x88, 0x75, 0x92, 0xa6, 0x88, 0x19, 0x14, 0x0b, 0x2c,
+	0x0d, 0x01, 0x1d, 0xe2, 0xfb, 0x66, 0x33, 0x5c, 0x1f, 0x19, 0x02, 0xee, 0x71, 0x30, 0x0e, 0xf1,
+	0xd5, 0xbf, 0x9e, 0x81, 0xc2, 0xa6, 0xeb, 0x1c, 0xd8, 0xcd, 0x7b, 0xe6, 0x34, 0x7c, 0xfa, 0x03,
+	0xc8, 0x32, 0xe9, 0xdc, 0x66, 0xaf, 0x8f, 0xb7, 0xd9, 0x70, 0x6c, 0xb5, 0x2d, 0x33, 0x30, 0x6f,
+	0x39, 0x81, 0xd7, 0x8f, 0x8c, 0x88, 0x82, 0x30, 0x93, 0x87, 0x1c, 0x80, 0x7d, 0xdb, 0x31, 0xbd,
+	0x3e, 0x85, 0x55, 0x66, 0x98, 0xf4, 0xb7, 0x52, 0x48, 0xaf, 0x4b, 0x66, 0xae, 0x43, 0xce, 0x22,
+	0x42, 0x60, 0x45, 0xc3, 0xd2, 0xd7, 0xa1, 0x20, 0x89, 0xd1, 0x02, 0xcc, 0xb4, 0x48, 0x9f, 0x5b,
+	0x11, 0xa6, 0x7f, 0xa2, 0x45, 0xc8, 0x1d, 0x99, 0xed, 0x9e, 0x30, 0x7b, 0xcc, 0x7f, 0xbc, 0x95,
+	0xb9, 0x61, 0x2c, 0xbd, 0x0d, 0x17, 0x63, 0xba, 0xc6, 0xb1, 0x97, 0x14, 0xf6, 0xea, 0x8f, 0x0c,
+	0x98, 0x97, 0xa3, 0x9e, 0x42, 0x90, 0xb9, 0xaf, 0x07, 0x99, 0xaf, 0x25, 0x5f, 0xd2, 0x11, 0x31,
+	0xe6, 0x2f, 0x33, 0x70, 0xf1, 0x5d, 0xcf, 0x7d, 0x44, 0x1c, 0xea, 0x97, 0x7e, 0xd7, 0xb4, 0x88,
+	0x8c, 0x11, 0xc6, 0xc8, 0x18, 0xf1, 0x3a, 0x14, 0xad, 0x76, 0xcf, 0x0f, 0x78, 0x80, 0x11, 0xc1,
+	0x44, 0x7a, 0xfd, 0x66, 0x84, 0xc2, 0x2a, 0x1d, 0x5a, 0x83, 0x82, 0x13, 0x6a, 0x11, 0x3e, 0x73,
+	0x49, 0x30, 0x15, 0xa4, 0x7a, 0x1c, 0xd1, 0xa0, 0xef, 0x40, 0xe1, 0xd8, 0xf5, 0x5a, 0x6d, 0xd7,
+	0x6c, 0xf8, 0x95, 0x2c, 0x9b, 0xf2, 0xf8, 0x73, 0x87, 0x4f, 0xe7, 0x43, 0xc1, 0x17, 0x69, 0x08,
+	0x21, 0x3e, 0x8e, 0x84, 0x52, 0x1f, 0x3e, 0x60, 0xf4, 0x95, 0xdc, 0xaa, 0x71, 0x25, 0x1f, 0xf9,
+	0x30, 0x97, 0x82, 0x05, 0xb6, 0xfa, 0x3b, 0x06, 0x94, 0x75, 0xc1, 0x74, 0x99, 0x5a, 0xb6, 0xd3,
+	0x88, 0x2f, 0xd3, 0x37, 0x6d, 0xa7, 0x81, 0x19, 0x26, 0x59, 0xb0, 0xf5, 0x48, 0xb7, 0x6d, 0x5b,
+	0x26, 0x3f, 0x02, 0x73, 0xd1, 0xee, 0x63, 0x01, 0xc7, 0x92, 0xa2, 0x7a, 0x6a, 0x40, 0xe9, 0x57,
+	0x4d, 0xaf, 0xf1, 0x7e, 0xcf, 0x74, 0x02, 0x3b, 0xe8, 0x23, 0x1b, 0xb2, 0x87, 0xa6, 0xd7, 0x60,
+	0x07, 0x41, 0x71, 0xfd, 0xeb, 0x63, 0x97, 0x46, 0x65, 0x66, 0x3f, 0xb8, 0x77, 0x7d, 0x25, 0x1c,
+	0x19, 0x05, 0x7d, 0x71, 0xb2, 0x52, 0xc2, 0x22, 0x01, 0xa3, 0x16, 0x88, 0x99, 0x8a, 0xa5, 0x26,
+	0x14, 0x24, 0xc3, 0x10, 0x17, 0xd9, 0x52, 0x5d, 0x64, 0x8c, 0xcd, 0xd7, 0xc2, 0xfc, 0xae, 0x16,
+	0x8e, 0x45, 0x75, 0xa9, 0xbf, 0xca, 0x40, 0xf9, 0x4e, 0xc7, 0x6c, 0x92, 0x6d, 0xc5, 0x0c, 0xce,
+	0x3b, 0x0e, 0x3e, 0xd0, 0x72, 0x9b, 0xd7, 0xc6, 0x2e, 0xa4, 0x3e, 0xc0, 0x91, 0xf9, 0xcd, 0xaf,
+	0xc7, 0xf2, 0x9b, 0xd7, 0xd3, 0x0a, 0x3e, 0x3b, 0xc7, 0x79, 0x6c, 0x00, 0xd2, 0x19, 0xa6, 0x10,
+	0x82, 0xf6, 0xf4, 0x10, 0xb4, 0x96, 0x72, 0x4a, 0x23, 0xe2, 0xd0, 0x7f, 0x0c, 0x4c, 0xe5, 0xa9,
+	0xca, 0x77, 0xfe, 0x34, 0x03, 0x8b, 0xc3, 0xb6, 0x16, 0xbd, 0xa5, 0xe7, 0x3c, 0xbf, 0x14, 0xcf,
+	0x79, 0x2e, 0xeb, 0x5c, 0x4f, 0x6b, 0xde, 0xf3, 0xc7, 0x19, 0x28, 0x4c, 0xd3, 0xdf, 0x77, 0x34,
+	0x7f, 0xaf, 0x8d, 0xb5, 0xe1, 0xf1, 0xae, 0xfe, 0x51, 0xcc, 0xd5, 0x5f, 0x4d, 0x21, 0xf3, 0x6c,
+	0x2f, 0xff, 0xa7, 0x0c, 0xcc, 0x4b, 0x5a, 0x5a, 0x5f, 0xa2, 0x17, 0x60, 0xce, 0x22, 0x5e, 0xb0,
+	0x43, 0x3a, 0x6c, 0x79, 0x4a, 0xf5, 0x22, 0xab, 0x27, 0x39, 0x08, 0x87, 0x38, 0x54, 0x85, 0xd9,
+	0x16, 0xe9, 0x53, 0x2a, 0x96, 0xb7, 0xd4, 0x81, 0x0a, 0xff, 0x26, 0x83, 0x60, 0x81, 0x41, 0x2f,
+	0x43, 0xc1, 0x32, 0x05, 0x27, 0x1b, 0x79, 0xa9, 0x3e, 0x4f, 0x0f, 0xcb, 0xcd, 0x8d, 0x50, 0x5c,
+	0x84, 0xa7, 0xe7, 0xb7, 0xd9, 0xb5, 0x77, 0x89, 0x77, 0x44, 0x3c, 0xb1, 0xa5, 0xf2, 0x74, 0xdd,
+	0xd8, 0xb9, 0xc3, 0x11, 0x38, 0xa2, 0x41, 0x37, 0xa0, 0xe4, 0x13, 0xcf, 0x36, 0xdb, 0xdb, 0xbd,
+	0xce, 0x3e, 0xf1, 0xd8, 0x19, 0x5b, 0xa8, 0x2f, 0x0a, 0x9e, 0xd2, 0xae, 0x82, 0xc3, 0x1a, 0x25,
+	0xfa, 0x08, 0xf2, 0x8e, 0x1b, 0x6c, 0x1c, 0x04, 0xc4, 0xab, 0xcc, 0xa6, 0xb6, 0x68, 0xe9, 0xc1,
+	0xdb, 0x42, 0x06, 0x96, 0xd2, 0xaa, 0xb7, 0x61, 0x51, 0x5b, 0xcd, 0xfb, 0x5d, 0x6a, 0xd8, 0x3e,
+	0x9d, 0xdc, 0x91, 0xd9, 0xb6, 0x1b, 0x5b, 0x66, 0xdf, 0x17, 0xde, 0x28, 0x27, 0xf7, 0x41, 0x88,
+	0xc0, 0x11, 0x4d, 0xf5, 0x47, 0x39, 0xb8, 0xac, 0x49, 0x12, 0x2e, 0x7d, 0x15, 0xf2, 0x3d, 0x9f,
+	0x78, 0x4a, 0x0a, 0x25, 0x87, 0xf3, 0x40, 0xc0, 0xb1, 0xa4, 0x18, 0x58, 0xa2, 0x4c, 0xe2, 0x25,
+	0xd2, 0x06, 0xcc, 0x93, 0x87, 0x33, 0x07, 0x8c, 0x3e, 0x86, 0x82, 0xe3, 0x06, 0x75, 0x72, 0xe0,
+	0x7a, 0xdc, 0x23, 0xd3, 0x2d, 0x6a, 0x94, 0xaa, 0x85, 0x42, 0x70, 0x24, 0x4f, 0xdb, 0xb0, 0xdc,
+	0x93, 0xdc, 0x30, 0xf4, 0x66, 0x18, 0x22, 0x67, 0xd9, 0xd2, 0x3c, 0x1f, 0x0f, 0x91, 0x48, 0xdb,
+	0x04, 0x2d, 0x42, 0x2a, 0x8e, 0x32, 0x77, 0x86, 0xa3, 0x28, 0x81, 0xaa, 0x70, 0x76, 0xa0, 0x42,
+	0x0f, 0xa1, 0x4c, 0x23, 0xe2, 0xb6, 0x1b, 0xd8, 0x07, 0x7d, 0x16, 0x6f, 0x21, 0xf5, 0x64, 0x7f,
+	0x41, 0x48, 0x2f, 0xdf, 0xd5, 0x24, 0xe1, 0x98, 0x64, 0xf4, 0x1b, 0x00, 0x1e, 0x71, 0xc8, 0xf1,
+	0x03, 0x27, 0xb0, 0xdb, 0x95, 0x62, 0x6a, 0x3d, 0x32, 0x04, 0x62, 0x29, 0x05, 0x2b, 0x12, 0x59,
+	0xf1, 0x32, 0xcd, 0xcc, 0x21, 0x75, 0xf1, 0x32, 0x2e, 0x69, 0xf8, 0x41, 0x16, 0xa2, 0xcd, 0x7f,
+	0xbf, 0xe7, 0x06, 0xe6, 0xb6, 0xdb, 0x38, 0xc7, 0xfa, 0xc5, 0x15, 0xe9, 0x36, 0xaf, 0x67, 0xdf,
+	0x4e, 0x3e, 0x7e, 0x39, 0xb6, 0x74, 0x49, 0x37, 0x55, 0xd8, 0xf3, 0x49, 0x43, 0x94, 0x3e, 0x13,
+	0x29, 0x7c, 0xe0, 0x93, 0xb8, 0x42, 0x0a, 0x1a, 0x54, 0x48, 0x15, 0x4d, 0x2d, 0xcb, 0xa7, 0x8a,
+	0xe4, 0xc8, 0xce, 0xb5, 0x9c, 0xf8, 0x49, 0x4e, 0x31, 0xf2, 0x27, 0x93, 0x53, 0xaa, 0x19, 0x63,
+	0x26, 0x49, 0xc6, 0xa8, 0xda, 0xda, 0x4c, 0x42, 0x5b, 0x8b, 0xd8, 0xf6, 0xfa, 0x5d, 0x52, 0xc9,
+	0x0f, 0x65, 0xa3, 0x28, 0xac, 0xd2, 0xa1, 0x6f, 0x40, 0x59, 0xfc, 0xfc, 0x80, 0x78, 0xbe, 0xed,
+	0x3a, 0x22, 0x6a, 0xca, 0x98, 0xb3, 0xa9, 0x61, 0x71, 0x8c, 0x1a, 0xbd, 0x07, 0x48, 0x40, 0x94,
+	0x5c, 0x96, 0x05, 0xcf, 0x42, 0x94, 0x27, 0x6e, 0x0e, 0x50, 0xe0, 0x21, 0x5c, 0x7a, 0xb9, 0x9f,
+	0x4d, 0x50, 0xee, 0x3f, 0x14, 0xfe, 0x95, 0x63, 0xe6, 0x7e, 0x23, 0x5d, 0x56, 0x96, 0xd2, 0xb5,
+	0xee, 0xc0, 0x65, 0x8f, 0x1c, 0xb9, 0x2d, 0xd2, 0x50, 0x5a, 0xf6, 0x7e, 0xa5, 0xc0, 0xcc, 0xe1,
+	0xd9, 0xd3, 0x93, 0x95, 0xcb, 0x78, 0x10, 0x8d, 0x87, 0xf1, 0x4c, 0xaf, 0x34, 0xfe, 0xdd, 0x02,
+	0x5c, 0x8c, 0x17, 0x10, 0xaf, 0xeb, 0x05, 0xc4, 0x4a, 0xfc, 0x74, 0x2c, 0x3f, 0xed, 0xb5, 0x03,
+	0xba, 0x0d, 0x97, 0xc2, 0x55, 0xe3, 0x61, 0x8f, 0x5a, 0x2c, 0xcf, 0x34, 0x7f, 0x51, 0x30, 0x5d,
+	0xc2, 0x71, 0x02, 0x3c, 0xc8, 0x83, 0xda, 0x22, 0xda, 0xce, 0x26, 0x6c, 0x57, 0xc6, 0xb6, 0x22,
+	0x5d, 0xa8, 0x45, 0x7f, 0x68, 0x40, 0xd9, 0x32, 0xad, 0x43, 0xd2, 0xa0, 0xd6, 0x4b, 0x0d, 0xa8,
+	0x32, 0xc7, 0x14, 0x6f, 0xa5, 0x56, 0xbc, 0xa9, 0x89, 0xe1, 0x43, 0x78, 0x51, 0x3a, 0xbc, 0x86,
+	0x1c, 0x18, 0x4c, 0x6c, 0x0c, 0xc8, 0x83, 0xa2, 0x15, 0x19, 0x37, 0x8b, 0x3b, 0xa9, 0x0a, 0x24,
+	0xea, 0x19, 0xf5, 0x55, 0x16, 0xa3, 0x22, 0x31, 0x34, 0x9e, 0x6a, 0x14, 0x58, 0x55, 0x82, 0x9a,
+	0x50, 0x08, 0xaf, 0xb5, 0xb8, 0x07, 0x16, 0xd7, 0xdf, 0x48, 0xae, 0x31, 0xbc, 0x1b, 0xdb, 0xed,
+	0x3b, 0x56, 0x14, 0x60, 0x42, 0xa8, 0x8f, 0x23, 0xd9, 0xc8, 0x81, 0x92, 0xa5, 0x7a, 0x3b, 0x24,
+	0x6c, 0x7b, 0x0f, 0x49, 0xf3, 0xa3, 0x14, 0x5d, 0x0b, 0x10, 0x9a, 0xfc, 0xa9, 0x9d, 0x72, 0x4b,
+	0x9f, 0xc0, 0xe5, 0x21, 0x46, 0x70, 0xae, 0xc1, 0xe8, 0x5f, 0x0c, 0xb8, 0x34, 0xb0, 0x07, 0x53,
+	0x28, 0xdd, 0x3f, 0xd2, 0x4a, 0xf7, 0x49, 0xec, 0x64, 0x44, 0x09, 0x5f, 0xfd, 0x67, 0x03, 0x9e,
+	0x19, 0xa0, 0x9e, 0x42, 0x5e, 0xfc, 0xa1, 0x9e, 0x17, 0xaf, 0xa7, 0x9f, 0xd2, 0x88, 0xfc, 0xf8,
+	0xf7, 0x0c, 0x58, 0x1e, 0xa0, 0xdd, 0x26, 0xc1, 0xb1, 0xeb, 0xb5, 0x76, 0xdc, 0xb6, 0x6d, 0xf5,
+	0x59, 0x3f, 0x8b, 0x38, 0xfd, 0x3b, 0x4e, 0xd3, 0x23, 0x3e, 0xaf, 0x7b, 0xf3, 0x4a, 0x3f, 0x2b,
+	0x42, 0x61, 0x95, 0x0e, 0xad, 0x03, 0xd0, 0x9f, 0xb7, 0x38, 0x57, 0x86, 0x71, 0xc9, 0x6d, 0xdb,
+	0x92, 0x18, 0xac, 0x50, 0x55, 0xbf, 0x6f, 0xc0, 0x57, 0x06, 0x46, 0xb3, 0xe3, 0x36, 0x76, 0x89,
+	0xd5, 0xf3, 0xec, 0xa0, 0x4f, 0x63, 0x3e, 0x71, 0x0e, 0x5c, 0xcf, 0x22, 0xf1, 0xab, 0xf2, 0x5b,
+	0x1c, 0x8c, 0x43, 0x3c, 0x7a, 0x1e, 0x72, 0x66, 0xaf, 0x61, 0x07, 0x22, 0xff, 0x92, 0xd3, 0xdf,
+	0xa0, 0x40, 0xcc, 0x71, 0xb4, 0x0e, 0x38, 0x36, 0xbd, 0xf0, 0xa4, 0x91, 0x3b, 0xfe, 0xa1, 0xe9,
+	0x39, 0x98, 0x61, 0xaa, 0x3f, 0x1b, 0x36, 0x24, 0xec, 0xb6, 0x49, 0xdd, 0x76, 0x1a, 0xb6, 0xd3,
+	0x4c, 0x55, 0x4a, 0x50, 0xbe, 0x11, 0xa5, 0x04, 0x45, 0x61, 0x95, 0x0e, 0x35, 0x21, 0xef, 0xf7,
+	0x98, 0x79, 0xfb, 0xa2, 0x9c, 0x78, 0x73, 0x02, 0x4b, 0xe6, 0x12, 0x22, 0xe3, 0x12, 0x00, 0x1f,
+	0x4b, 0xe1, 0xd5, 0xbf, 0x98, 0x1b, 0x62, 0xd4, 0x2c, 0x0f, 0x56, 0xd3, 0x58, 0x23, 0x6d, 0xe3,
+	0x33, 0x93, 0xac, 0xf1, 0x89, 0x1e, 0xc2, 0x6c, 0xdb, 0xdc, 0x27, 0xed, 0x70, 0x96, 0xf5, 0xc9,
+	0xfc, 0xb5, 0x76, 0x97, 0x09, 0xe1, 0x47, 0x9b, 0xcc, 0x19, 0x38, 0x10, 0x0b, 0x0d, 0xe8, 0xbb,
+	0x50, 0x34, 0x1d, 0xc7, 0x0d, 0x4c, 0xd6, 0xd0, 0x11, 0x45, 0xd3, 0xed, 0x09, 0x15, 0x6e, 0x44,
+	0x92, 0xb8, 0x56, 0x39, 0x57, 0x05, 0x83, 0x55, 0x85, 0xe8, 0x5b, 0x50, 0x6c, 0xdb, 0x1d, 0x3b,
+	0xc0, 0xa6, 0xd3, 0x24, 0xbe, 0xc8, 0x62, 0xab, 0x4a, 0xa0, 0xa8, 0x59, 0xae, 0x47, 0x78, 0x58,
+	0x08, 0xc9, 0xa8, 0xbf, 0x46, 0xa2, 0x23, 0xb8, 0x8f, 0x55, 0x59, 0xe8, 0x53, 0x98, 0x77, 0x54,
+	0xbf, 0x15, 0x3d, 0xb1, 0x77, 0xd2, 0x4f, 0x4e, 0x73, 0xff, 0xfa, 0xa5, 0x53, 0x7a, 0x32, 0xab,
+	0x20, 0xac, 0x2b, 0x42, 0xc7, 0x50, 0xf2, 0x22, 0x87, 0xf0, 0x45, 0x8e, 0xf2, 0x76, 0x7a, 0xc5,
+	0x8a, 0x5b, 0x45, 0x67, 0xa7, 0x02, 0xf4, 0xb1, 0xa6, 0x08, 0x75, 0xa1, 0xd8, 0x8d, 0x82, 0x83,
+	0x48, 0x44, 0x26, 0xd0, 0xab, 0x44, 0x98, 0xfa, 0x45, 0xba, 0xc8, 0x0a, 0x00, 0xab, 0x2a, 0x96,
+	0xde, 0x84, 0xa2, 0x62, 0x66, 0xa9, 0xae, 0x91, 0xbf, 0x01, 0x0b, 0x71, 0x83, 0x49, 0xc3, 0x5f,
+	0xfd, 0x7d, 0x03, 0x2a, 0xa3, 0xdc, 0xfb, 0x89, 0x5c, 0x34, 0xa6, 0xbd, 0x7a, 0xad, 0xfe, 0x7b,
+	0x66, 0x58, 0xdc, 0xe8, 0x3b, 0x56, 0x82, 0x98, 0xf8, 0x1e, 0x20, 0x77, 0xdf, 0x27, 0xde, 0x11,
+	0x69, 0xdc, 0xe6, 0x4f, 0xa4, 0x68, 0x21, 0x4a, 0x07, 0x37, 0x13, 0x15, 0x0c, 0xf7, 0x07, 0x28,
+	0xf0, 0x10, 0x2e, 0xb4, 0x11, 0xd6, 0x37, 0x7c, 0xd0, 0x2f, 0xc7, 0xeb, 0x9b, 0xa5, 0xa1, 0x83,
+	0xd4, 0x6a, 0x9d, 0x06, 0x94, 0x68, 0x25, 0x42, 0xe1, 0xac, 0xca, 0x49, 0xdf, 0xfa, 0x94, 0xf6,
+	0x7a, 0x57, 0x91, 0x83, 0x35, 0xa9, 0x6a, 0xc5, 0x92, 0x1b, 0x73, 0xdb, 0xf1, 0xe7, 0x19, 0x28,
+	0x6d, 0xfb, 0xb7, 0x3a, 0x76, 0x53, 0x4c, 0xf2, 0xfc, 0xb3, 0xa6, 0x5d, 0x2d, 0x6b, 0x1a, 0xff,
+	0xce, 0x4a, 0x1d, 0xde, 0xc8, 0x3b, 0x8f, 0x8f, 0x63, 0x77, 0x1e, 0xaf, 0xa5, 0x13, 0x7b, 0xf6,
+	0xb5, 0xc7, 0x8f, 0x0d, 0x58, 0x50, 0xc9, 0xa7, 0x90, 0x88, 0x61, 0x3d, 0x11, 0x7b, 0x25, 0xd5,
+	0x74, 0x46, 0xe4, 0x60, 0xff, 0x38, 0xa3, 0x4f, 0x63, 0x82, 0xa3, 0x57, 0xf3, 0xdd, 0x4c, 0x82,
+	0x3e, 0xca, 0x3a, 0x80, 0xe3, 0xef, 0x1e, 0xba, 0xc7, 0x4a, 0xc7, 0x49, 0x9a, 0xc7, 0xb6, 0xc4,
+	0x60, 0x85, 0x8a, 0x27, 0x82, 0x7e, 0x60, 0x3b, 0xdc, 0x59, 0xe3, 0x17, 0x9b, 0x11, 0x0a, 0xab,
+	0x74, 0xe8, 0x3a, 0x64, 0x3b, 0x6e, 0x23, 0x34, 0xf9, 0xf0, 0xc9, 0x56, 0xf6, 0x9e, 0xdb, 0xa0,
+	0xce, 0xa9, 0xcd, 0x9c, 0xc2, 0x30, 0xa3, 0xa6, 0x01, 0x42, 0x11, 0x22, 0x92, 0x24, 0xd1, 0xa9,
+	0x92, 0x01, 0x62, 0x6b, 0x80, 0x02, 0x0f, 0xe1, 0x42, 0x37, 0x61, 0x3e, 0xf0, 0x4c, 0xc7, 0x3f,
+	0x20, 0x1e, 0x2b, 0xe5, 0x59, 0xb3, 0x2a, 0x5f, 0x7f, 0x46, 0x88, 0x99, 0xdf, 0x53, 0x91, 0x58,
+	0xa7, 0x65, 0xaf, 0x33, 0x7b, 0xc1, 0xfd, 0x23, 0xe2, 0xb1, 0x03, 0x26, 0xaf, 0xbc, 0xce, 0xe4,
+	0x60, 0x1c, 0xe2, 0xab, 0xff, 0x9d, 0x05, 0x34, 0x68, 0xbe, 0xe8, 0x86, 0xde, 0x7f, 0xa9, 0xc6,
+	0xe3, 0xd3, 0x25, 0x95, 0xe7, 0x69, 0x6d, 0xc1, 0xdc, 0x84, 0x79, 0x5e, 0x29, 0x86, 0x5b, 0xc9,
+	0xcd, 0x41, 0xee, 0xc1, 0xae, 0x8a, 0xc4, 0x3a, 0x2d, 0xfa, 0x33, 0x03, 0x16, 0xc2, 0x5d, 0xf1,
+	0x48, 0x83, 0x6f, 0x22, 0xef, 0xc1, 0xdc, 0x99, 0x20, 0xa0, 0xd4, 0xf6, 0x62, 0xb2, 0x78, 0xfa,
+	0x76, 0x45, 0x8c, 0x65, 0x21, 0x8e, 0x1e, 0xe8, 0x88, 0x0c, 0x0c, 0x66, 0xc9, 0x87, 0x67, 0x86,
+	0x0a, 0x3d, 0xd7, 0xfa, 0xfa, 0xef, 0x0d, 0xc8, 0xef, 0xb4, 0xcd, 0xe0, 0xc0, 0xf5, 0x3a, 0x53,
+	0x38, 0x20, 0xee, 0x6b, 0x07, 0xc4, 0xf8, 0xd0, 0x17, 0x0e, 0x6d, 0x64, 0x35, 0xfd, 0x77, 0x06,
+	0x94, 0x42, 0xa2, 0x29, 0xc4, 0xee, 0x6d, 0x3d, 0x76, 0xbf, 0x94, 0x78, 0x02, 0x23, 0xe2, 0xf6,
+	0xa7, 0xd1, 0xe8, 0x27, 0x08, 0xd9, 0x6f, 0x41, 0xd9, 0x6c, 0x74, 0x6c, 0xc7, 0xf6, 0x03, 0xcf,
+	0x0c, 0x5c, 0x8f, 0x0f, 0xab, 0x50, 0x47, 0xa7, 0x27, 0x2b, 0xe5, 0x0d, 0x0d, 0x83, 0x63, 0x94,
+	0xd5, 0xbf, 0xc9, 0xc2, 0xec, 0x8e, 0xeb, 0x05, 0x66, 0x7b, 0x0a, 0xdb, 0x7e, 0x13, 0xe6, 0x35,
+	0xf5, 0xa2, 0x96, 0x97, 0x9e, 0xab, 0x8d, 0x15, 0xeb, 0xb4, 0xc8, 0x82, 0x7c, 0xd7, 0x73, 0xd5,
+	0x22, 0x76, 0xfc, 0x03, 0x27, 0x3e, 0xb3, 0xda, 0x8e, 0xe0, 0xe3, 0xce, 0x29, 0x97, 0x32, 0x04,
+	0x63, 0x29, 0x18, 0xfd, 0x16, 0x14, 0xc8, 0xa7, 0x01, 0x71, 0x7c, 0x7e, 0x2c, 0x25, 0x6b, 0x0e,
+	0x0a, 0x2d, 0xb7, 0x42, 0x46, 0xae, 0xe6, 0x85, 0xf0, 0xd4, 0x94, 0x70, 0x7a, 0x46, 0x09, 0x9d,
+	0x12, 0x86, 0x23, 0x7d, 0x4b, 0x37, 0x61, 0x5e, 0x1b, 0x69, 0xaa, 0xa2, 0xa0, 0x0d, 0x65, 0x7d,
+	0x00, 0x49, 0xe2, 0x45, 0xb2, 0x99, 0x89, 0x41, 0xa9, 0xf1, 0xe2, 0xdb, 0x30, 0xaf, 0xe1, 0xd0,
+	0xf3, 0xfa, 0xc9, 0x34, 0xaf, 0x9d, 0x4c, 0xe1, 0x21, 0xf4, 0x22, 0xcc, 0x76, 0x4d, 0x8f, 0x38,
+	0x61, 0x27, 0x45, 0x1e, 0x06, 0x3b, 0x0c, 0x8a, 0x05, 0xb6, 0xfa, 0x07, 0x19, 0x98, 0x0b, 0x05,
+	0x9f, 0xbf, 0x55, 0x6e, 0x6b, 0xc1, 0xe8, 0xea, 0xf8, 0x45, 0xe1, 0x23, 0x1b, 0x99, 0xa8, 0x7e,
+	0x10, 0x4b, 0x54, 0x6b, 0x89, 0x25, 0x9e, 0x9d, 0xa3, 0xfe, 0x5f, 0x06, 0x2e, 0x0b, 0xca, 0x77,
+	0x3d, 0x42, 0x1e, 0x85, 0x97, 0x32, 0x6f, 0xea, 0x4b, 0x3f, 0xf8, 0x64, 0x41, 0x63, 0xd2, 0x36,
+	0xa4, 0x0a, 0xb3, 0x6d, 0xd7, 0x6a, 0x91, 0x86, 0xf0, 0x44, 0xf6, 0x68, 0xe7, 0x2e, 0x83, 0x60,
+	0x81, 0x41, 0x0d, 0x00, 0x99, 0xec, 0x85, 0x9e, 0xf7, 0x6a, 0xc2, 0x77, 0xb1, 0xd1, 0x9d, 0x7a,
+	0x94, 0x11, 0x4a, 0x59, 0x58, 0x91, 0x3b, 0x22, 0x3f, 0xc9, 0x9e, 0x7b, 0x7e, 0x92, 0xa2, 0x90,
+	0xfa, 0x5b, 0x03, 0x8a, 0x62, 0x29, 0xa7, 0x70, 0xc4, 0xdc, 0xd3, 0x8f, 0x98, 0x2b, 0x49, 0x8d,
+	0x68, 0xc4, 0x09, 0xf3, 0xc3, 0x02, 0x84, 0xb1, 0x27, 0xe5, 0xdb, 0x85, 0x49, 0x1a, 0x71, 0x6d,
+	0xed, 0xed, 0xc2, 0xcd, 0xa4, 0x63, 0x1f, 0xf6, 0x72, 0xe1, 0xb9, 0xd8, 0xf5, 0x6a, 0xd8, 0xe5,
+	0xa4, 0x3f, 0xc5, 0xed, 0xea, 0xf7, 0x0c, 0x28, 0x98, 0xed, 0xb6, 0x6b, 0x99, 0x81, 0x7c, 0xbe,
+	0xf0, 0x2b, 0xe9, 0x75, 0x6e, 0x84, 0x22, 0xb8, 0xe2, 0x55, 0xf9, 0xd8, 0x2c, 0x84, 0x2b, 0xda,
+	0x1f, 0xf8, 0xa4, 0x81, 0x23, 0xa5, 0xe8, 0xb7, 0x21, 0xbf, 0xef, 0x7a, 0x9e, 0x7b, 0x4c, 0xc2,
+	0x0b, 0xe5, 0x77, 0xd2, 0x0f, 0xa0, 0x2e, 0x24, 0x70, 0xfd, 0xe1, 0xe5, 0x6a, 0x3e, 0x04, 0xc7,
+	0xd5, 0x4b, 0x8d, 0xb1, 0xbb, 0xc4, 0x09, 0x96, 0x3b, 0xba, 0x4c, 0x7c, 0x2e, 0x76, 0x99, 0xa8,
+	0x69, 0xe4, 0x77, 0x89, 0x4d, 0x2d, 0x20, 0xf0, 0x16, 0xdd, 0x6b, 0x13, 0xbc, 0x16, 0x19, 0x1b,
+	0x13, 0x7e, 0x13, 0xf2, 0xd6, 0xa1, 0xdd, 0x6e, 0x78, 0xc4, 0xa9, 0xe4, 0x99, 0x9a, 0x6b, 0xa9,
+	0xa7, 0x16, 0xf9, 0xd8, 0xa6, 0x10, 0x85, 0xa5, 0xd0, 0xa5, 0x83, 0xb3, 0xef, 0xd2, 0x37, 0xf5,
+	0xe3, 0xf2, 0x95, 0x54, 0x2f, 0xde, 0xd5, 0xb3, 0xb9, 0x05, 0x65, 0xdd, 0xb8, 0x9e, 0x84, 0x32,
+	0xba, 0x23, 0xc3, 0x94, 0x3d, 0x84, 0x79, 0xcd, 0x90, 0xce, 0x53, 0xd7, 0xc1, 0xd9, 0x57, 0x8e,
+	0x4f, 0x4a, 0x4f, 0xf5, 0x27, 0x86, 0x1e, 0xbd, 0xf6, 0x3c, 0x42, 0xa6, 0xd3, 0xc7, 0xf2, 0x5c,
+	0x37, 0x48, 0xdc, 0xc7, 0x1a, 0x30, 0x3e, 0x19, 0x52, 0xb1, 0xeb, 0x06, 0x98, 0x09, 0x63, 0x9f,
+	0x1c, 0x84, 0x19, 0x15, 0xf9, 0xa4, 0x47, 0xfc, 0xe0, 0x4b, 0xf8, 0xc9, 0x81, 0x3e, 0xc0, 0x27,
+	0xf8, 0xc9, 0x41, 0x4c, 0xf0, 0xf8, 0x4f, 0x0e, 0x74, 0x86, 0x2f, 0xe3, 0x27, 0x07, 0xfa, 0x08,
+	0x47, 0x9c, 0xbf, 0xff, 0x95, 0x8b, 0x4f, 0x65, 0xb2, 0xde, 0x9c, 0xc7, 0x99, 0xe5, 0xdb, 0x5d,
+	0xd9, 0x9b, 0xc3, 0x21, 0x02, 0x47, 0x34, 0xe8, 0x0d, 0xc8, 0x06, 0xfd, 0x6e, 0xd8, 0x95, 0x0b,
+	0xdb, 0x45, 0xd9, 0xbd, 0x7e, 0x57, 0x4d, 0x0c, 0x05, 0x2b, 0x7b, 0xdf, 0xc5, 0xe8, 0xe9, 0xb1,
+	0x2f, 0x4a, 0xa2, 0x61, 0x1f, 0x1e, 0xec, 0x44, 0x28, 0xac, 0xd2, 0xc5, 0xb3, 0x85, 0x5c, 0xc2,
+	0x6c, 0xe1, 0x36, 0x5c, 0xe2, 0x89, 0xbf, 0x22, 0x58, 0xf4, 0xe7, 0xe4, 0x9b, 0x9a, 0x9d, 0x38,
+	0x01, 0x1e, 0xe4, 0x41, 0xdf, 0x85, 0xbc, 0xb8, 0xf6, 0x0c, 0xcf, 0xa5, 0x8d, 0x09, 0x2c, 0xbd,
+	0x26, 0x8e, 0x3c, 0x3f, 0x76, 0x0e, 0x87, 0xe0, 0x78, 0x12, 0x22, 0x75, 0xd2, 0xfa, 0xf6, 0x61,
+	0xcf, 0x17, 0x2f, 0x32, 0x68, 0x05, 0x99, 0xd7, 0x3b, 0x53, 0xef, 0xa9, 0x48, 0xac, 0xd3, 0xaa,
+	0xdf, 0x6e, 0x17, 0x52, 0x7c, 0xbb, 0x0d, 0xe3, 0xbe, 0xdd, 0xa6, 0x07, 0x82, 0x36, 0xa3, 0x73,
+	0x3c, 0xe9, 0xaa, 0xff, 0x36, 0x03, 0x8b, 0xc3, 0x5c, 0x7c, 0xfc, 0xa7, 0x27, 0x3a, 0x97, 0x56,
+	0xa5, 0x5c, 0x85, 0xbc, 0xd9, 0xed, 0x7a, 0xee, 0x91, 0xb4, 0x7a, 0x39, 0xdd, 0x0d, 0x01, 0xc7,
+	0x92, 0x22, 0x6e, 0xbb, 0x33, 0x09, 0x6d, 0x17, 0xc3, 0xbc, 0xe3, 0xd2, 0xed, 0x20, 0x0d, 0xa6,
+	0x5c, 0x18, 0xfd, 0xd5, 0x70, 0xef, 0xb6, 0x55, 0xe4, 0xa8, 0x01, 0xeb, 0x22, 0x46, 0x14, 0x35,
+	0xb9, 0x29, 0x36, 0x5d, 0x67, 0x93, 0x36, 0x5d, 0xe7, 0xc6, 0x14, 0x3f, 0xff, 0x3b, 0x2b, 0x8b,
+	0x9f, 0x9f, 0xd3, 0xbb, 0x56, 0x35, 0xb2, 0xcc, 0x24, 0x8c, 0x2c, 0x2f, 0xd0, 0x09, 0x76, 0xf6,
+	0xe9, 0x10, 0xb3, 0x6c, 0x88, 0x45, 0x3e, 0x39, 0x06, 0xc2, 0x21, 0x6e, 0x78, 0x00, 0xca, 0x4d,
+	0x10, 0x80, 0x8e, 0x95, 0x00, 0x94, 0xf4, 0x61, 0x9f, 0xb2, 0xaa, 0x93, 0x47, 0x9e, 0x16, 0x94,
+	0x3f, 0xa1, 0x59, 0x07, 0xcf, 0xfc, 0x6c, 0xa7, 0xc9, 0x36, 0x34, 0xc9, 0xe9, 0xf5, 0xbe, 0xc6,
+	0xc6, 0x7b, 0x86, 0x3a, 0x0c, 0xc7, 0x44, 0xa3, 0x8f, 0x01, 0x18, 0x84, 0xfd, 0xcf, 0x09, 0x71,
+	0x57, 0xfe, 0x72, 0x32, 0x45, 0x8c, 0xa5, 0x5e, 0xa6, 0x86, 0x12, 0xfd, 0xc6, 0x8a, 0x38, 0xe4,
+	0xc3, 0x82, 0x15, 0xfb, 0xb7, 0x16, 0x2c, 0x1e, 0x26, 0xfa, 0x7f, 0x0d, 0x31, 0xc6, 0xfa, 0xe2,
+	0xe9, 0xc9, 0xca, 0xc0, 0x7f, 0xc9, 0xc0, 0x03, 0x0a, 0x94, 0x0f, 0x73, 0xe1, 0xac, 0x0f, 0x73,
+	0xa7, 0x1a, 0x4a, 0xff, 0xb5, 0x20, 0xdb, 0x81, 0x22, 0x86, 0x46, 0xdd, 0x1a, 0x63, 0x64, 0xb7,
+	0xe6, 0xb5, 0x30, 0xce, 0x72, 0x9f, 0xfa, 0x6a, 0x3c, 0xce, 0x96, 0x84, 0x48, 0x2d, 0xc0, 0xf6,
+	0x15, 0xb3, 0xe5, 0x25, 0xfb, 0x2f, 0xa7, 0xeb, 0x59, 0xa5, 0x30, 0x5c, 0x5e, 0xba, 0x4a, 0xc3,
+	0x7d, 0x00, 0xcf, 0x5a, 0x66, 0xdb, 0xea, 0xd1, 0x93, 0xaa, 0xc1, 0x4a, 0xb4, 0xb0, 0x05, 0x2a,
+	0x3c, 0xf6, 0xb9, 0xd3, 0x93, 0x95, 0x67, 0x37, 0x87, 0x93, 0xe0, 0x51, 0xbc, 0xe8, 0x2e, 0x2c,
+	0x46, 0xa8, 0xa8, 0xbc, 0x64, 0xb5, 0x79, 0xa1, 0x5e, 0x39, 0x3d, 0x59, 0x59, 0xdc, 0x1c, 0x82,
+	0xc7, 0x43, 0xb9, 0xd0, 0x0f, 0x0c, 0x40, 0xd1, 0xcb, 0xd5, 0x4d, 0xdd, 0xc3, 0xdf, 0x4d, 0xbb,
+	0x54, 0x03, 0x82, 0xf8, 0xa2, 0xbd, 0x24, 0x1f, 0xbc, 0x0f, 0x10, 0xc4, 0xfd, 0x7e, 0xc8, 0x60,
+	0xd0, 0x75, 0x28, 0x71, 0x28, 0x0f, 0x54, 0x22, 0xa0, 0x2f, 0xb0, 0x37, 0xa3, 0x0a, 0x1c, 0x6b,
+	0x54, 0x23, 0x4e, 0xa8, 0xfc, 0x14, 0x4f, 0xa8, 0x42, 0xd2, 0x13, 0x0a, 0xc6, 0x5c, 0x0b, 0x36,
+	0xa1, 0x18, 0x85, 0x11, 0xbf, 0x52, 0x4c, 0xd8, 0xa8, 0x8c, 0xc2, 0x10, 0xdd, 0x1f, 0x12, 0x1d,
+	0x2a, 0x11, 0xc2, 0xc7, 0xaa, 0x64, 0xf4, 0x11, 0x0d, 0x16, 0x84, 0x3c, 0x22, 0x95, 0x12, 0x5b,
+	0xa7, 0xeb, 0x49, 0x0d, 0x40, 0xed, 0xda, 0x72, 0xe7, 0xe5, 0x10, 0x2c, 0xe4, 0x9d, 0x4b, 0x78,
+	0x19, 0x55, 0xba, 0x07, 0xf0, 0xec, 0x08, 0x4b, 0x3c, 0xcf, 0xa0, 0xf6, 0x43, 0x03, 0x94, 0xc0,
+	0x7f, 0x6e, 0xff, 0xaf, 0x88, 0x16, 0x71, 0x5e, 0xaf, 0x2d, 0xfb, 0xd5, 0x6b, 0x29, 0xcc, 0x00,
+	0xf7, 0xda, 0xca, 0x13, 0x57, 0xfa, 0xcb, 0xc7, 0x5c, 0x58, 0xd5, 0x81, 0xb2, 0x4e, 0xc7, 0xff,
+	0xa5, 0x02, 0xbf, 0x57, 0x8d, 0xd7, 0x6f, 0xe1, 0x35, 0x2f, 0x96, 0x14, 0xa8, 0x06, 0x10, 0x1c,
+	0x7a, 0xc4, 0x3f, 0x74, 0xdb, 0x0d, 0x5e, 0x5f, 0xe6, 0xf8, 0x59, 0xb8, 0x27, 0xa1, 0x58, 0xa1,
+	0xa8, 0xfe, 0x38, 0x03, 0x17, 0x63, 0xf6, 0x19, 0xff, 0xc2, 0xc7, 0x98, 0xe4, 0xbf, 0x61, 0x24,
+	0x79, 0xd6, 0xa1, 0xce, 0x6c, 0x66, 0xec, 0xcc, 0xd6, 0xa0, 0x20, 0xc7, 0xcd, 0x32, 0x67, 0xe5,
+	0xf3, 0x50, 0x39, 0x39, 0x1c, 0xd1, 0x0c, 0xf9, 0xb4, 0x31, 0x77, 0x5e, 0x9f, 0x36, 0x56, 0xff,
+	0xc7, 0x80, 0x58, 0x4a, 0x83, 0x3c, 0x98, 0x65, 0x2f, 0x1e, 0x7d, 0xf1, 0xdf, 0x2c, 0x6e, 0xa6,
+	0xcc, 0x93, 0xf8, 0xa3, 0x4a, 0x11, 0xb9, 0xbf, 0x2a, 0x9f, 0x88, 0x32, 0x60, 0x3c, 0x5a, 0x0b,
+	0x4d, 0x4b, 0x87, 0x50, 0x54, 0xb8, 0xce, 0xd3, 0xcb, 0x4e, 0x0d, 0x28, 0xa9, 0x7e, 0x8f, 0x6c,
+	0xd1, 0x20, 0x4e, 0xfa, 0xaf, 0x3b, 0x54, 0xe6, 0xf4, 0x1f, 0xf5, 0x4d, 0xe5, 0x2b, 0x84, 0xfa,
+	0x95, 0xc7, 0x9f, 0x2f, 0x5f, 0xf8, 0xe9, 0xe7, 0xcb, 0x17, 0x3e, 0xfb, 0x7c, 0xf9, 0xc2, 0xf7,
+	0x4e, 0x97, 0x8d, 0xc7, 0xa7, 0xcb, 0xc6, 0x4f, 0x4f, 0x97, 0x8d, 0xcf, 0x4e, 0x97, 0x8d, 0x9f,
+	0x9d, 0x2e, 0x1b, 0xdf, 0xff, 0xcf, 0xe5, 0x0b, 0xbf, 0x96, 0x39, 0xba, 0xf6, 0xff, 0x01, 0x00,
+	0x00, 0xff, 0xff, 0xde, 0xc7, 0x53, 0x14, 0xa4, 0x4e, 0x00, 0x00,
+}
+
+func (m *CertificateAlert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CertificateAlert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CertificateAlert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Channel)
+	copy(dAtA[i:], m.Channel)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Channel)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChartGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChartGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChartGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -1527,7 +2010,7 @@ func (m *ImageNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ImageNamespaceList) Marshal() (dAtA []byte, err error) {
+func (m *ChartGroupList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1537,12 +2020,12 @@ func (m *ImageNamespaceList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ImageNamespaceList) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChartGroupList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ImageNamespaceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChartGroupList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1574,7 +2057,7 @@ func (m *ImageNamespaceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ImageNamespaceSpec) Marshal() (dAtA []byte, err error) {
+func (m *ChartGroupSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1584,12 +2067,12 @@ func (m *ImageNamespaceSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ImageNamespaceSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChartGroupSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ImageNamespaceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChartGroupSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1621,7 +2104,7 @@ func (m *ImageNamespaceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ImageNamespaceStatus) Marshal() (dAtA []byte, err error) {
+func (m *ChartGroupStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1631,12 +2114,12 @@ func (m *ImageNamespaceStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ImageNamespaceStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChartGroupStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ImageNamespaceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChartGroupStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1669,7 +2152,7 @@ func (m *ImageNamespaceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Namespace) Marshal() (dAtA []byte, err error) {
+func (m *ConfigMap) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1679,36 +2162,66 @@ func (m *Namespace) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Namespace) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConfigMap) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Namespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConfigMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.BinaryData) > 0 {
+		keysForBinaryData := make([]string, 0, len(m.BinaryData))
+		for k := range m.BinaryData {
+			keysForBinaryData = append(keysForBinaryData, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForBinaryData)
+		for iNdEx := len(keysForBinaryData) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.BinaryData[string(keysForBinaryData[iNdEx])]
+			baseI := i
+			if v != nil {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForBinaryData[iNdEx])
+			copy(dAtA[i:], keysForBinaryData[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForBinaryData[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Data) > 0 {
+		keysForData := make([]string, 0, len(m.Data))
+		for k := range m.Data {
+			keysForData = append(keysForData, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForData)
+		for iNdEx := len(keysForData) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Data[string(keysForData[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForData[iNdEx])
+			copy(dAtA[i:], keysForData[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForData[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	{
 		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1722,7 +2235,7 @@ func (m *Namespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NamespaceCert) Marshal() (dAtA []byte, err error) {
+func (m *ConfigMapList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1732,89 +2245,12 @@ func (m *NamespaceCert) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NamespaceCert) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConfigMapList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NamespaceCert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.APIServer)
-	copy(dAtA[i:], m.APIServer)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIServer)))
-	i--
-	dAtA[i] = 0x22
-	if m.CACertPem != nil {
-		i -= len(m.CACertPem)
-		copy(dAtA[i:], m.CACertPem)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(m.CACertPem)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.KeyPem != nil {
-		i -= len(m.KeyPem)
-		copy(dAtA[i:], m.KeyPem)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyPem)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CertPem != nil {
-		i -= len(m.CertPem)
-		copy(dAtA[i:], m.CertPem)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(m.CertPem)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *NamespaceCertOptions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *NamespaceCertOptions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *NamespaceCertOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.ValidDays)
-	copy(dAtA[i:], m.ValidDays)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ValidDays)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *NamespaceList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *NamespaceList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *NamespaceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConfigMapList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1846,7 +2282,7 @@ func (m *NamespaceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NamespaceSpec) Marshal() (dAtA []byte, err error) {
+func (m *FrozenNamespace) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1856,42 +2292,28 @@ func (m *NamespaceSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NamespaceSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *FrozenNamespace) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NamespaceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FrozenNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.ClusterType)
-	copy(dAtA[i:], m.ClusterType)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterType)))
-	i--
-	dAtA[i] = 0x42
-	i -= len(m.ClusterDisplayName)
-	copy(dAtA[i:], m.ClusterDisplayName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterDisplayName)))
 	i--
-	dAtA[i] = 0x3a
-	i -= len(m.ClusterVersion)
-	copy(dAtA[i:], m.ClusterVersion)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterVersion)))
+	if m.Frozen {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
 	i--
-	dAtA[i] = 0x32
-	if len(m.Hard) > 0 {
-		keysForHard := make([]string, 0, len(m.Hard))
-		for k := range m.Hard {
-			keysForHard = append(keysForHard, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
-		for iNdEx := len(keysForHard) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Hard[string(keysForHard[iNdEx])]
-			baseI := i
+	dAtA[i] = 0x28
+	if len(m.Workloads) > 0 {
+		for iNdEx := len(m.Workloads) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Workloads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1899,45 +2321,28 @@ func (m *NamespaceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
-			i -= len(keysForHard[iNdEx])
-			copy(dAtA[i:], keysForHard[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHard[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x22
 		}
 	}
 	i -= len(m.Namespace)
 	copy(dAtA[i:], m.Namespace)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
 	i--
-	dAtA[i] = 0x22
+	dAtA[i] = 0x1a
 	i -= len(m.ClusterName)
 	copy(dAtA[i:], m.ClusterName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterName)))
 	i--
-	dAtA[i] = 0x1a
-	i -= len(m.TenantID)
-	copy(dAtA[i:], m.TenantID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
-	i--
 	dAtA[i] = 0x12
-	if len(m.Finalizers) > 0 {
-		for iNdEx := len(m.Finalizers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Finalizers[iNdEx])
-			copy(dAtA[i:], m.Finalizers[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Finalizers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *NamespaceStatus) Marshal() (dAtA []byte, err error) {
+func (m *FrozenWorkload) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1947,36 +2352,60 @@ func (m *NamespaceStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NamespaceStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *FrozenWorkload) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NamespaceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FrozenWorkload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Certificate != nil {
-		{
-			size, err := m.Certificate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Replicas))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HardQuantity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CachedSpecHard) > 0 {
-		keysForCachedSpecHard := make([]string, 0, len(m.CachedSpecHard))
-		for k := range m.CachedSpecHard {
-			keysForCachedSpecHard = append(keysForCachedSpecHard, string(k))
+	return dAtA[:n], nil
+}
+
+func (m *HardQuantity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HardQuantity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hard) > 0 {
+		keysForHard := make([]string, 0, len(m.Hard))
+		for k := range m.Hard {
+			keysForHard = append(keysForHard, string(k))
 		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForCachedSpecHard)
-		for iNdEx := len(keysForCachedSpecHard) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.CachedSpecHard[string(keysForCachedSpecHard[iNdEx])]
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+		for iNdEx := len(keysForHard) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hard[string(keysForHard[iNdEx])]
 			baseI := i
 			{
 				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
@@ -1988,79 +2417,20 @@ func (m *NamespaceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			}
 			i--
 			dAtA[i] = 0x12
-			i -= len(keysForCachedSpecHard[iNdEx])
-			copy(dAtA[i:], keysForCachedSpecHard[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForCachedSpecHard[iNdEx])))
+			i -= len(keysForHard[iNdEx])
+			copy(dAtA[i:], keysForHard[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHard[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.Used) > 0 {
-		keysForUsed := make([]string, 0, len(m.Used))
-		for k := range m.Used {
-			keysForUsed = append(keysForUsed, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
-		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Used[string(keysForUsed[iNdEx])]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForUsed[iNdEx])
-			copy(dAtA[i:], keysForUsed[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
-			i--
 			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	i -= len(m.ResourceQuotaName)
-	copy(dAtA[i:], m.ResourceQuotaName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResourceQuotaName)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.Reason)
-	copy(dAtA[i:], m.Reason)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *NsEmigration) Marshal() (dAtA []byte, err error) {
+func (m *ImageNamespace) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2070,12 +2440,12 @@ func (m *NsEmigration) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NsEmigration) MarshalTo(dAtA []byte) (int, error) {
+func (m *ImageNamespace) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NsEmigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ImageNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2113,7 +2483,7 @@ func (m *NsEmigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NsEmigrationList) Marshal() (dAtA []byte, err error) {
+func (m *ImageNamespaceList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2123,12 +2493,12 @@ func (m *NsEmigrationList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NsEmigrationList) MarshalTo(dAtA []byte) (int, error) {
+func (m *ImageNamespaceList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NsEmigrationList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ImageNamespaceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2160,7 +2530,7 @@ func (m *NsEmigrationList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NsEmigrationSpec) Marshal() (dAtA []byte, err error) {
+func (m *ImageNamespaceSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2170,40 +2540,44 @@ func (m *NsEmigrationSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NsEmigrationSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *ImageNamespaceSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NsEmigrationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ImageNamespaceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Destination)
-	copy(dAtA[i:], m.Destination)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Destination)))
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
 	i--
 	dAtA[i] = 0x22
-	i -= len(m.NsShowName)
-	copy(dAtA[i:], m.NsShowName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NsShowName)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Namespace)
-	copy(dAtA[i:], m.Namespace)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
-	i--
-	dAtA[i] = 0x12
 	i -= len(m.TenantID)
 	copy(dAtA[i:], m.TenantID)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Finalizers) > 0 {
+		for iNdEx := len(m.Finalizers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Finalizers[iNdEx])
+			copy(dAtA[i:], m.Finalizers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Finalizers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NsEmigrationStatus) Marshal() (dAtA []byte, err error) {
+func (m *ImageNamespaceStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2213,12 +2587,12 @@ func (m *NsEmigrationStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NsEmigrationStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *ImageNamespaceStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NsEmigrationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ImageNamespaceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2251,7 +2625,7 @@ func (m *NsEmigrationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Platform) Marshal() (dAtA []byte, err error) {
+func (m *Namespace) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2261,16 +2635,26 @@ func (m *Platform) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Platform) MarshalTo(dAtA []byte) (int, error) {
+func (m *Namespace) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Platform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Namespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2294,7 +2678,7 @@ func (m *Platform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PlatformList) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceCert) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2304,32 +2688,18 @@ func (m *PlatformList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PlatformList) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceCert) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PlatformList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceCert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
 	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.NotAfter.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2337,11 +2707,42 @@ func (m *PlatformList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x32
+	i -= len(m.SerialNumber)
+	copy(dAtA[i:], m.SerialNumber)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SerialNumber)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.APIServer)
+	copy(dAtA[i:], m.APIServer)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIServer)))
+	i--
+	dAtA[i] = 0x22
+	if m.CACertPem != nil {
+		i -= len(m.CACertPem)
+		copy(dAtA[i:], m.CACertPem)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.CACertPem)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.KeyPem != nil {
+		i -= len(m.KeyPem)
+		copy(dAtA[i:], m.KeyPem)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyPem)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CertPem != nil {
+		i -= len(m.CertPem)
+		copy(dAtA[i:], m.CertPem)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.CertPem)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PlatformSpec) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceCertOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2351,34 +2752,25 @@ func (m *PlatformSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PlatformSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceCertOptions) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PlatformSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceCertOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Administrators) > 0 {
-		for iNdEx := len(m.Administrators) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Administrators[iNdEx])
-			copy(dAtA[i:], m.Administrators[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Administrators[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	i -= len(m.TenantID)
-	copy(dAtA[i:], m.TenantID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i -= len(m.ValidDays)
+	copy(dAtA[i:], m.ValidDays)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ValidDays)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Portal) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceCertStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2388,79 +2780,65 @@ func (m *Portal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Portal) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceCertStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Portal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceCertStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Extension) > 0 {
-		keysForExtension := make([]string, 0, len(m.Extension))
-		for k := range m.Extension {
-			keysForExtension = append(keysForExtension, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForExtension)
-		for iNdEx := len(keysForExtension) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Extension[string(keysForExtension[iNdEx])]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForExtension[iNdEx])
-			copy(dAtA[i:], keysForExtension[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForExtension[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
+	{
+		size, err := m.RenewUntil.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.Projects) > 0 {
-		keysForProjects := make([]string, 0, len(m.Projects))
-		for k := range m.Projects {
-			keysForProjects = append(keysForProjects, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForProjects)
-		for iNdEx := len(keysForProjects) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Projects[string(keysForProjects[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForProjects[iNdEx])
-			copy(dAtA[i:], keysForProjects[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForProjects[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.LastNotifyTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	if m.Administrator {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	dAtA[i] = 0x52
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x4a
+	if m.CertPem != nil {
+		i -= len(m.CertPem)
+		copy(dAtA[i:], m.CertPem)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(m.CertPem)))
+		i--
+		dAtA[i] = 0x3a
 	}
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
 	i--
-	dAtA[i] = 0x10
+	dAtA[i] = 0x32
 	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.NotAfter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.NotBefore.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2468,11 +2846,24 @@ func (m *Portal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ValidDays))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.SerialNumber)
+	copy(dAtA[i:], m.SerialNumber)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SerialNumber)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Username)
+	copy(dAtA[i:], m.Username)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Username)))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PortalProject) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2482,71 +2873,32 @@ func (m *PortalProject) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PortalProject) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PortalProject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Parent)
-	copy(dAtA[i:], m.Parent)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parent)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Project) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Project) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
 	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2558,7 +2910,7 @@ func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ProjectList) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceQuotaNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2568,20 +2920,27 @@ func (m *ProjectList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProjectList) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceQuotaNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProjectList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceQuotaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[string(keysForUsed[iNdEx])]
+			baseI := i
 			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2590,22 +2949,59 @@ func (m *ProjectList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			}
 			i--
 			dAtA[i] = 0x12
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Hard) > 0 {
+		keysForHard := make([]string, 0, len(m.Hard))
+		for k := range m.Hard {
+			keysForHard = append(keysForHard, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+		for iNdEx := len(keysForHard) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hard[string(keysForHard[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHard[iNdEx])
+			copy(dAtA[i:], keysForHard[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHard[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i -= len(m.ClusterName)
+	copy(dAtA[i:], m.ClusterName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ProjectSpec) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2615,24 +3011,48 @@ func (m *ProjectSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProjectSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Clusters) > 0 {
-		keysForClusters := make([]string, 0, len(m.Clusters))
-		for k := range m.Clusters {
-			keysForClusters = append(keysForClusters, string(k))
+	if len(m.RevokedCertificates) > 0 {
+		for iNdEx := len(m.RevokedCertificates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RevokedCertificates[iNdEx])
+			copy(dAtA[i:], m.RevokedCertificates[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.RevokedCertificates[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
 		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForClusters)
-		for iNdEx := len(keysForClusters) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Clusters[string(keysForClusters[iNdEx])]
+	}
+	i -= len(m.ClusterType)
+	copy(dAtA[i:], m.ClusterType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterType)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.ClusterDisplayName)
+	copy(dAtA[i:], m.ClusterDisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterDisplayName)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.ClusterVersion)
+	copy(dAtA[i:], m.ClusterVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterVersion)))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Hard) > 0 {
+		keysForHard := make([]string, 0, len(m.Hard))
+		for k := range m.Hard {
+			keysForHard = append(keysForHard, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+		for iNdEx := len(keysForHard) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hard[string(keysForHard[iNdEx])]
 			baseI := i
 			{
 				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
@@ -2644,33 +3064,24 @@ func (m *ProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			}
 			i--
 			dAtA[i] = 0x12
-			i -= len(keysForClusters[iNdEx])
-			copy(dAtA[i:], keysForClusters[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForClusters[iNdEx])))
+			i -= len(keysForHard[iNdEx])
+			copy(dAtA[i:], keysForHard[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHard[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x2a
 		}
 	}
-	i -= len(m.ParentProjectName)
-	copy(dAtA[i:], m.ParentProjectName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ParentProjectName)))
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
 	i--
-	dAtA[i] = 0x2a
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Members[iNdEx])
-			copy(dAtA[i:], m.Members[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Members[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	i -= len(m.DisplayName)
-	copy(dAtA[i:], m.DisplayName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	dAtA[i] = 0x22
+	i -= len(m.ClusterName)
+	copy(dAtA[i:], m.ClusterName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterName)))
 	i--
 	dAtA[i] = 0x1a
 	i -= len(m.TenantID)
@@ -2690,7 +3101,7 @@ func (m *ProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ProjectStatus) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2700,51 +3111,64 @@ func (m *ProjectStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProjectStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x52
-	i -= len(m.Reason)
-	copy(dAtA[i:], m.Reason)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Certificates) > 0 {
+		for iNdEx := len(m.Certificates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Certificates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x42
-	if m.CachedParent != nil {
-		i -= len(*m.CachedParent)
-		copy(dAtA[i:], *m.CachedParent)
-		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.CachedParent)))
+	if len(m.Templates) > 0 {
+		for iNdEx := len(m.Templates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Templates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Certificate != nil {
+		{
+			size, err := m.Certificate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x42
 	}
-	if len(m.CachedSpecClusters) > 0 {
-		keysForCachedSpecClusters := make([]string, 0, len(m.CachedSpecClusters))
-		for k := range m.CachedSpecClusters {
-			keysForCachedSpecClusters = append(keysForCachedSpecClusters, string(k))
+	if len(m.CachedSpecHard) > 0 {
+		keysForCachedSpecHard := make([]string, 0, len(m.CachedSpecHard))
+		for k := range m.CachedSpecHard {
+			keysForCachedSpecHard = append(keysForCachedSpecHard, string(k))
 		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForCachedSpecClusters)
-		for iNdEx := len(keysForCachedSpecClusters) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.CachedSpecClusters[string(keysForCachedSpecClusters[iNdEx])]
+		github_com_gogo_protobuf_sortkeys.Strings(keysForCachedSpecHard)
+		for iNdEx := len(keysForCachedSpecHard) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.CachedSpecHard[string(keysForCachedSpecHard[iNdEx])]
 			baseI := i
 			{
 				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
@@ -2756,42 +3180,24 @@ func (m *ProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			}
 			i--
 			dAtA[i] = 0x12
-			i -= len(keysForCachedSpecClusters[iNdEx])
-			copy(dAtA[i:], keysForCachedSpecClusters[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForCachedSpecClusters[iNdEx])))
+			i -= len(keysForCachedSpecHard[iNdEx])
+			copy(dAtA[i:], keysForCachedSpecHard[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForCachedSpecHard[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.CalculatedNamespaces) > 0 {
-		for iNdEx := len(m.CalculatedNamespaces) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CalculatedNamespaces[iNdEx])
-			copy(dAtA[i:], m.CalculatedNamespaces[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.CalculatedNamespaces[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.CalculatedChildProjects) > 0 {
-		for iNdEx := len(m.CalculatedChildProjects) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CalculatedChildProjects[iNdEx])
-			copy(dAtA[i:], m.CalculatedChildProjects[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.CalculatedChildProjects[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x3a
 		}
 	}
-	if len(m.Clusters) > 0 {
-		keysForClusters := make([]string, 0, len(m.Clusters))
-		for k := range m.Clusters {
-			keysForClusters = append(keysForClusters, string(k))
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
 		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForClusters)
-		for iNdEx := len(keysForClusters) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Clusters[string(keysForClusters[iNdEx])]
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[string(keysForUsed[iNdEx])]
 			baseI := i
 			{
 				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
@@ -2803,35 +3209,93 @@ func (m *ProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			}
 			i--
 			dAtA[i] = 0x12
-			i -= len(keysForClusters[iNdEx])
-			copy(dAtA[i:], keysForClusters[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForClusters[iNdEx])))
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x32
+		}
+	}
+	i -= len(m.ResourceQuotaName)
+	copy(dAtA[i:], m.ResourceQuotaName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ResourceQuotaName)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	i -= len(m.Phase)
 	copy(dAtA[i:], m.Phase)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
 	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NamespaceTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
 	dAtA[i] = 0x12
-	if m.Locked != nil {
-		i--
-		if *m.Locked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *UsedQuantity) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceTemplateList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2841,27 +3305,20 @@ func (m *UsedQuantity) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UsedQuantity) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceTemplateList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UsedQuantity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Used) > 0 {
-		keysForUsed := make([]string, 0, len(m.Used))
-		for k := range m.Used {
-			keysForUsed = append(keysForUsed, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
-		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Used[string(keysForUsed[iNdEx])]
-			baseI := i
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -2870,1187 +3327,4253 @@ func (m *UsedQuantity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			}
 			i--
 			dAtA[i] = 0x12
-			i -= len(keysForUsed[iNdEx])
-			copy(dAtA[i:], keysForUsed[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
 		}
 	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *NamespaceTemplateNetworkPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ChartGroup) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+
+func (m *NamespaceTemplateNetworkPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChartGroupList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NamespaceTemplateNetworkPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	i--
+	if m.DenyEgress {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	return n
+	i--
+	dAtA[i] = 0x10
+	i--
+	if m.DenyIngress {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
 
-func (m *ChartGroupSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Finalizers) > 0 {
-		for _, s := range m.Finalizers {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+func (m *NamespaceTemplatePodSecurity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.TenantID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DisplayName)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ChartGroupStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.LastTransitionTime.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Reason)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *NamespaceTemplatePodSecurity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConfigMap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NamespaceTemplatePodSecurity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Data) > 0 {
-		for k, v := range m.Data {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.BinaryData) > 0 {
-		for k, v := range m.BinaryData {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = 1 + len(v) + sovGenerated(uint64(len(v)))
-			}
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	return n
+	i -= len(m.Warn)
+	copy(dAtA[i:], m.Warn)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Warn)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Audit)
+	copy(dAtA[i:], m.Audit)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Audit)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Enforce)
+	copy(dAtA[i:], m.Enforce)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Enforce)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ConfigMapList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+func (m *NamespaceTemplateRoleBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *HardQuantity) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NamespaceTemplateRoleBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceTemplateRoleBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Hard) > 0 {
-		for k, v := range m.Hard {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Subjects) > 0 {
+		for iNdEx := len(m.Subjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	i -= len(m.ClusterRole)
+	copy(dAtA[i:], m.ClusterRole)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterRole)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ImageNamespace) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NamespaceTemplateSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ImageNamespaceList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NamespaceTemplateSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.PodSecurity != nil {
+		{
+			size, err := m.PodSecurity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-
-func (m *ImageNamespaceSpec) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.RoleBindings) > 0 {
+		for iNdEx := len(m.RoleBindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleBindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	var l int
-	_ = l
-	if len(m.Finalizers) > 0 {
-		for _, s := range m.Finalizers {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.NetworkPolicy != nil {
+		{
+			size, err := m.NetworkPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.TenantID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DisplayName)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	if len(m.LimitRanges) > 0 {
+		for iNdEx := len(m.LimitRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Annotations) > 0 {
+		keysForAnnotations := make([]string, 0, len(m.Annotations))
+		for k := range m.Annotations {
+			keysForAnnotations = append(keysForAnnotations, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+		for iNdEx := len(keysForAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Annotations[string(keysForAnnotations[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAnnotations[iNdEx])
+			copy(dAtA[i:], keysForAnnotations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Labels) > 0 {
+		keysForLabels := make([]string, 0, len(m.Labels))
+		for k := range m.Labels {
+			keysForLabels = append(keysForLabels, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+		for iNdEx := len(keysForLabels) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Labels[string(keysForLabels[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLabels[iNdEx])
+			copy(dAtA[i:], keysForLabels[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLabels[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ImageNamespaceStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NamespaceTemplateSubject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.LastTransitionTime.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Reason)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Namespace) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *NamespaceTemplateSubject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NamespaceCert) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NamespaceTemplateSubject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CertPem != nil {
-		l = len(m.CertPem)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.KeyPem != nil {
-		l = len(m.KeyPem)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.CACertPem != nil {
-		l = len(m.CACertPem)
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.APIServer)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *NamespaceCertOptions) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NamespaceTemplateSync) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValidDays)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NamespaceList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
+func (m *NamespaceTemplateSync) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NamespaceSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NamespaceTemplateSync) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Finalizers) > 0 {
-		for _, s := range m.Finalizers {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	l = len(m.TenantID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ClusterName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Hard) > 0 {
-		for k, v := range m.Hard {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.LastSyncTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	l = len(m.ClusterVersion)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ClusterDisplayName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ClusterType)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *NamespaceStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NsEmigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NsEmigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NsEmigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.LastTransitionTime.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Reason)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ResourceQuotaName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Used) > 0 {
-		for k, v := range m.Used {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.CachedSpecHard) > 0 {
-		for k, v := range m.CachedSpecHard {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.Certificate != nil {
-		l = m.Certificate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *NsEmigration) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NsEmigrationList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NsEmigrationList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NsEmigrationList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NsEmigrationList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *NsEmigrationSpec) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NsEmigrationSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.TenantID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.NsShowName)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Destination)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NsEmigrationStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.LastTransitionTime.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Reason)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *NsEmigrationSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Platform) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NsEmigrationSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *PlatformList) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	if m.CutOver {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	var l int
-	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	i--
+	dAtA[i] = 0x40
+	i--
+	if m.TransferQuota {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	return n
+	i--
+	dAtA[i] = 0x38
+	i -= len(m.DestinationCluster)
+	copy(dAtA[i:], m.DestinationCluster)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DestinationCluster)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Mode)
+	copy(dAtA[i:], m.Mode)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Mode)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Destination)
+	copy(dAtA[i:], m.Destination)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Destination)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.NsShowName)
+	copy(dAtA[i:], m.NsShowName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NsShowName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PlatformSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TenantID)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Administrators) > 0 {
-		for _, s := range m.Administrators {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+func (m *NsEmigrationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Portal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NsEmigrationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NsEmigrationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	if len(m.Projects) > 0 {
-		for k, v := range m.Projects {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.TransferredQuota) > 0 {
+		keysForTransferredQuota := make([]string, 0, len(m.TransferredQuota))
+		for k := range m.TransferredQuota {
+			keysForTransferredQuota = append(keysForTransferredQuota, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForTransferredQuota)
+		for iNdEx := len(keysForTransferredQuota) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.TransferredQuota[string(keysForTransferredQuota[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForTransferredQuota[iNdEx])
+			copy(dAtA[i:], keysForTransferredQuota[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForTransferredQuota[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Extension) > 0 {
-		for k, v := range m.Extension {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	i -= len(m.SourceCluster)
+	copy(dAtA[i:], m.SourceCluster)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SourceCluster)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PortalProject) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Platform) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Parent)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Project) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *Platform) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProjectList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Platform) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ProjectSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Finalizers) > 0 {
-		for _, s := range m.Finalizers {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+func (m *PlatformList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.TenantID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.DisplayName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Members) > 0 {
-		for _, s := range m.Members {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *PlatformList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlatformList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	l = len(m.ParentProjectName)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Clusters) > 0 {
-		for k, v := range m.Clusters {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ProjectStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PlatformSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PlatformSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlatformSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Locked != nil {
-		n += 2
-	}
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Clusters) > 0 {
-		for k, v := range m.Clusters {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Administrators) > 0 {
+		for iNdEx := len(m.Administrators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Administrators[iNdEx])
+			copy(dAtA[i:], m.Administrators[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Administrators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.CalculatedChildProjects) > 0 {
-		for _, s := range m.CalculatedChildProjects {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Portal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CalculatedNamespaces) > 0 {
-		for _, s := range m.CalculatedNamespaces {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Portal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Portal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Extension) > 0 {
+		keysForExtension := make([]string, 0, len(m.Extension))
+		for k := range m.Extension {
+			keysForExtension = append(keysForExtension, string(k))
 		}
-	}
-	if len(m.CachedSpecClusters) > 0 {
-		for k, v := range m.CachedSpecClusters {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		github_com_gogo_protobuf_sortkeys.Strings(keysForExtension)
+		for iNdEx := len(keysForExtension) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Extension[string(keysForExtension[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForExtension[iNdEx])
+			copy(dAtA[i:], keysForExtension[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForExtension[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if m.CachedParent != nil {
-		l = len(*m.CachedParent)
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Projects) > 0 {
+		keysForProjects := make([]string, 0, len(m.Projects))
+		for k := range m.Projects {
+			keysForProjects = append(keysForProjects, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForProjects)
+		for iNdEx := len(keysForProjects) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Projects[string(keysForProjects[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForProjects[iNdEx])
+			copy(dAtA[i:], keysForProjects[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForProjects[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = m.LastTransitionTime.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Reason)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *UsedQuantity) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	if m.Administrator {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	var l int
-	_ = l
-	if len(m.Used) > 0 {
-		for k, v := range m.Used {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	i--
+	dAtA[i] = 0x10
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *PortalProject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *PortalProject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ChartGroup) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ChartGroup{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ChartGroupSpec", "ChartGroupSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ChartGroupStatus", "ChartGroupStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ChartGroupList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]ChartGroup{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ChartGroup", "ChartGroup", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ChartGroupList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *PortalProject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Parent)
+	copy(dAtA[i:], m.Parent)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Parent)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *ChartGroupSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Project) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&ChartGroupSpec{`,
-		`Finalizers:` + fmt.Sprintf("%v", this.Finalizers) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
-		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ChartGroupStatus) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ChartGroupStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *Project) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ConfigMap) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForData := make([]string, 0, len(this.Data))
-	for k := range this.Data {
-		keysForData = append(keysForData, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForData)
-	mapStringForData := "map[string]string{"
-	for _, k := range keysForData {
-		mapStringForData += fmt.Sprintf("%v: %v,", k, this.Data[k])
+
+func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	mapStringForData += "}"
-	keysForBinaryData := make([]string, 0, len(this.BinaryData))
-	for k := range this.BinaryData {
-		keysForBinaryData = append(keysForBinaryData, k)
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForBinaryData)
-	mapStringForBinaryData := "map[string][]byte{"
-	for _, k := range keysForBinaryData {
-		mapStringForBinaryData += fmt.Sprintf("%v: %v,", k, this.BinaryData[k])
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	mapStringForBinaryData += "}"
-	s := strings.Join([]string{`&ConfigMap{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Data:` + mapStringForData + `,`,
-		`BinaryData:` + mapStringForBinaryData + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *ConfigMapList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]ConfigMap{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ConfigMap", "ConfigMap", 1), `&`, ``, 1) + ","
+
+func (m *ProjectFreezeStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ConfigMapList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *HardQuantity) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForHard := make([]string, 0, len(this.Hard))
-	for k := range this.Hard {
-		keysForHard = append(keysForHard, k)
+
+func (m *ProjectFreezeStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectFreezeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
-	mapStringForHard := "ResourceList{"
-	for _, k := range keysForHard {
-		mapStringForHard += fmt.Sprintf("%v: %v,", k, this.Hard[k])
+	i--
+	dAtA[i] = 0x22
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Namespaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	mapStringForHard += "}"
-	s := strings.Join([]string{`&HardQuantity{`,
-		`Hard:` + mapStringForHard + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ImageNamespace) String() string {
-	if this == nil {
-		return "nil"
+	if m.Locked != nil {
+		i--
+		if *m.Locked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	s := strings.Join([]string{`&ImageNamespace{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ImageNamespaceSpec", "ImageNamespaceSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ImageNamespaceStatus", "ImageNamespaceStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *ImageNamespaceList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]ImageNamespace{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ImageNamespace", "ImageNamespace", 1), `&`, ``, 1) + ","
+
+func (m *ProjectList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ImageNamespaceList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ImageNamespaceSpec) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ImageNamespaceSpec{`,
-		`Finalizers:` + fmt.Sprintf("%v", this.Finalizers) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
-		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ProjectList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *ImageNamespaceStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	s := strings.Join([]string{`&ImageNamespaceStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Namespace) String() string {
-	if this == nil {
-		return "nil"
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	s := strings.Join([]string{`&Namespace{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "NamespaceSpec", "NamespaceSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "NamespaceStatus", "NamespaceStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *NamespaceCert) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectQuotaNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&NamespaceCert{`,
-		`CertPem:` + valueToStringGenerated(this.CertPem) + `,`,
-		`KeyPem:` + valueToStringGenerated(this.KeyPem) + `,`,
-		`CACertPem:` + valueToStringGenerated(this.CACertPem) + `,`,
-		`APIServer:` + fmt.Sprintf("%v", this.APIServer) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *NamespaceCertOptions) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&NamespaceCertOptions{`,
-		`ValidDays:` + fmt.Sprintf("%v", this.ValidDays) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ProjectQuotaNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *NamespaceList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectQuotaNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Children) > 0 {
+		for iNdEx := len(m.Children) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Children[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
 	}
-	repeatedStringForItems := "[]Namespace{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Namespace", "Namespace", 1), `&`, ``, 1) + ","
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Namespaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&NamespaceList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NamespaceSpec) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[string(keysForUsed[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	keysForHard := make([]string, 0, len(this.Hard))
-	for k := range this.Hard {
-		keysForHard = append(keysForHard, k)
+	if len(m.Borrowed) > 0 {
+		keysForBorrowed := make([]string, 0, len(m.Borrowed))
+		for k := range m.Borrowed {
+			keysForBorrowed = append(keysForBorrowed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForBorrowed)
+		for iNdEx := len(keysForBorrowed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Borrowed[string(keysForBorrowed[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForBorrowed[iNdEx])
+			copy(dAtA[i:], keysForBorrowed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForBorrowed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
-	mapStringForHard := "ResourceList{"
-	for _, k := range keysForHard {
-		mapStringForHard += fmt.Sprintf("%v: %v,", k, this.Hard[k])
+	if len(m.Allocated) > 0 {
+		keysForAllocated := make([]string, 0, len(m.Allocated))
+		for k := range m.Allocated {
+			keysForAllocated = append(keysForAllocated, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAllocated)
+		for iNdEx := len(keysForAllocated) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Allocated[string(keysForAllocated[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAllocated[iNdEx])
+			copy(dAtA[i:], keysForAllocated[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAllocated[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	mapStringForHard += "}"
-	s := strings.Join([]string{`&NamespaceSpec{`,
-		`Finalizers:` + fmt.Sprintf("%v", this.Finalizers) + `,`,
-		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
-		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Hard:` + mapStringForHard + `,`,
-		`ClusterVersion:` + fmt.Sprintf("%v", this.ClusterVersion) + `,`,
-		`ClusterDisplayName:` + fmt.Sprintf("%v", this.ClusterDisplayName) + `,`,
-		`ClusterType:` + fmt.Sprintf("%v", this.ClusterType) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NamespaceStatus) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Hard) > 0 {
+		keysForHard := make([]string, 0, len(m.Hard))
+		for k := range m.Hard {
+			keysForHard = append(keysForHard, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+		for iNdEx := len(keysForHard) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hard[string(keysForHard[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHard[iNdEx])
+			copy(dAtA[i:], keysForHard[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHard[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	keysForUsed := make([]string, 0, len(this.Used))
-	for k := range this.Used {
-		keysForUsed = append(keysForUsed, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
-	mapStringForUsed := "ResourceList{"
-	for _, k := range keysForUsed {
-		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k])
-	}
-	mapStringForUsed += "}"
-	keysForCachedSpecHard := make([]string, 0, len(this.CachedSpecHard))
-	for k := range this.CachedSpecHard {
-		keysForCachedSpecHard = append(keysForCachedSpecHard, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForCachedSpecHard)
-	mapStringForCachedSpecHard := "ResourceList{"
-	for _, k := range keysForCachedSpecHard {
-		mapStringForCachedSpecHard += fmt.Sprintf("%v: %v,", k, this.CachedSpecHard[k])
-	}
-	mapStringForCachedSpecHard += "}"
-	s := strings.Join([]string{`&NamespaceStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`ResourceQuotaName:` + fmt.Sprintf("%v", this.ResourceQuotaName) + `,`,
-		`Used:` + mapStringForUsed + `,`,
-		`CachedSpecHard:` + mapStringForCachedSpecHard + `,`,
-		`Certificate:` + strings.Replace(this.Certificate.String(), "NamespaceCert", "NamespaceCert", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *NsEmigration) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectQuotaTree) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&NsEmigration{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "NsEmigrationSpec", "NsEmigrationSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "NsEmigrationStatus", "NsEmigrationStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *NsEmigrationList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectQuotaTree) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectQuotaTree) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Root.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	repeatedStringForItems := "[]NsEmigration{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "NsEmigration", "NsEmigration", 1), `&`, ``, 1) + ","
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&NsEmigrationList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *NsEmigrationSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&NsEmigrationSpec{`,
-		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`NsShowName:` + fmt.Sprintf("%v", this.NsShowName) + `,`,
-		`Destination:` + fmt.Sprintf("%v", this.Destination) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *NsEmigrationStatus) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&NsEmigrationStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ProjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *Platform) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	s := strings.Join([]string{`&Platform{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PlatformSpec", "PlatformSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PlatformList) String() string {
-	if this == nil {
-		return "nil"
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	repeatedStringForItems := "[]Platform{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Platform", "Platform", 1), `&`, ``, 1) + ","
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&PlatformList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *PlatformSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectRequestList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	s := strings.Join([]string{`&PlatformSpec{`,
-		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
-		`Administrators:` + fmt.Sprintf("%v", this.Administrators) + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *Portal) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForProjects := make([]string, 0, len(this.Projects))
-	for k := range this.Projects {
-		keysForProjects = append(keysForProjects, k)
+
+func (m *ProjectRequestList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectRequestList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForProjects)
-	mapStringForProjects := "map[string]string{"
-	for _, k := range keysForProjects {
-		mapStringForProjects += fmt.Sprintf("%v: %v,", k, this.Projects[k])
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	mapStringForProjects += "}"
-	keysForExtension := make([]string, 0, len(this.Extension))
-	for k := range this.Extension {
-		keysForExtension = append(keysForExtension, k)
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectRequestSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForExtension)
-	mapStringForExtension := "ProjectExtension{"
-	for _, k := range keysForExtension {
-		mapStringForExtension += fmt.Sprintf("%v: %v,", k, this.Extension[k])
-	}
-	mapStringForExtension += "}"
-	s := strings.Join([]string{`&Portal{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Administrator:` + fmt.Sprintf("%v", this.Administrator) + `,`,
-		`Projects:` + mapStringForProjects + `,`,
-		`Extension:` + mapStringForExtension + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *PortalProject) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&PortalProject{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Parent:` + fmt.Sprintf("%v", this.Parent) + `,`,
-		`}`,
-	}, "")
-	return s
+
+func (m *ProjectRequestSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *Project) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectRequestSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.Channel)
+	copy(dAtA[i:], m.Channel)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Channel)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.Justification)
+	copy(dAtA[i:], m.Justification)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Justification)))
+	i--
+	dAtA[i] = 0x42
+	if len(m.Clusters) > 0 {
+		keysForClusters := make([]string, 0, len(m.Clusters))
+		for k := range m.Clusters {
+			keysForClusters = append(keysForClusters, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForClusters)
+		for iNdEx := len(keysForClusters) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Clusters[string(keysForClusters[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForClusters[iNdEx])
+			copy(dAtA[i:], keysForClusters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForClusters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	s := strings.Join([]string{`&Project{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ProjectSpec", "ProjectSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ProjectStatus", "ProjectStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	i -= len(m.ParentProjectName)
+	copy(dAtA[i:], m.ParentProjectName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ParentProjectName)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.ProjectName)
+	copy(dAtA[i:], m.ProjectName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProjectName)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Requester)
+	copy(dAtA[i:], m.Requester)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Requester)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *ProjectList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]Project{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Project", "Project", 1), `&`, ``, 1) + ","
+
+func (m *ProjectRequestStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ProjectList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return dAtA[:n], nil
 }
-func (this *ProjectSpec) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForClusters := make([]string, 0, len(this.Clusters))
-	for k := range this.Clusters {
-		keysForClusters = append(keysForClusters, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForClusters)
-	mapStringForClusters := "ClusterHard{"
-	for _, k := range keysForClusters {
-		mapStringForClusters += fmt.Sprintf("%v: %v,", k, this.Clusters[k])
+
+func (m *ProjectRequestStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectRequestStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	mapStringForClusters += "}"
-	s := strings.Join([]string{`&ProjectSpec{`,
-		`Finalizers:` + fmt.Sprintf("%v", this.Finalizers) + `,`,
-		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
-		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
-		`Members:` + fmt.Sprintf("%v", this.Members) + `,`,
-		`ParentProjectName:` + fmt.Sprintf("%v", this.ParentProjectName) + `,`,
-		`Clusters:` + mapStringForClusters + `,`,
-		`}`,
-	}, "")
-	return s
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.NotifiedPhase)
+	copy(dAtA[i:], m.NotifiedPhase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NotifiedPhase)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.ProjectName)
+	copy(dAtA[i:], m.ProjectName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProjectName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Approver)
+	copy(dAtA[i:], m.Approver)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Approver)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (this *ProjectStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	keysForClusters := make([]string, 0, len(this.Clusters))
-	for k := range this.Clusters {
-		keysForClusters = append(keysForClusters, k)
+	return dAtA[:n], nil
+}
+
+func (m *ProjectSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Frozen {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForClusters)
-	mapStringForClusters := "ClusterUsed{"
-	for _, k := range keysForClusters {
-		mapStringForClusters += fmt.Sprintf("%v: %v,", k, this.Clusters[k])
+	i--
+	dAtA[i] = 0x50
+	if m.CertificateAlert != nil {
+		{
+			size, err := m.CertificateAlert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	mapStringForClusters += "}"
-	keysForCachedSpecClusters := make([]string, 0, len(this.CachedSpecClusters))
-	for k := range this.CachedSpecClusters {
-		keysForCachedSpecClusters = append(keysForCachedSpecClusters, k)
+	if m.QuotaAlert != nil {
+		{
+			size, err := m.QuotaAlert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForCachedSpecClusters)
-	mapStringForCachedSpecClusters := "ClusterHard{"
-	for _, k := range keysForCachedSpecClusters {
-		mapStringForCachedSpecClusters += fmt.Sprintf("%v: %v,", k, this.CachedSpecClusters[k])
+	if m.QuotaBorrowing != nil {
+		{
+			size, err := m.QuotaBorrowing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	mapStringForCachedSpecClusters += "}"
-	s := strings.Join([]string{`&ProjectStatus{`,
-		`Locked:` + valueToStringGenerated(this.Locked) + `,`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+	if len(m.Clusters) > 0 {
+		keysForClusters := make([]string, 0, len(m.Clusters))
+		for k := range m.Clusters {
+			keysForClusters = append(keysForClusters, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForClusters)
+		for iNdEx := len(keysForClusters) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Clusters[string(keysForClusters[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForClusters[iNdEx])
+			copy(dAtA[i:], keysForClusters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForClusters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i -= len(m.ParentProjectName)
+	copy(dAtA[i:], m.ParentProjectName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ParentProjectName)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i -= len(m.DisplayName)
+	copy(dAtA[i:], m.DisplayName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DisplayName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Finalizers) > 0 {
+		for iNdEx := len(m.Finalizers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Finalizers[iNdEx])
+			copy(dAtA[i:], m.Finalizers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Finalizers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Freeze != nil {
+		{
+			size, err := m.Freeze.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.QuotaAlerts) > 0 {
+		for iNdEx := len(m.QuotaAlerts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuotaAlerts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x52
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.LastTransitionTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.CachedParent != nil {
+		i -= len(*m.CachedParent)
+		copy(dAtA[i:], *m.CachedParent)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.CachedParent)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CachedSpecClusters) > 0 {
+		keysForCachedSpecClusters := make([]string, 0, len(m.CachedSpecClusters))
+		for k := range m.CachedSpecClusters {
+			keysForCachedSpecClusters = append(keysForCachedSpecClusters, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForCachedSpecClusters)
+		for iNdEx := len(keysForCachedSpecClusters) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.CachedSpecClusters[string(keysForCachedSpecClusters[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForCachedSpecClusters[iNdEx])
+			copy(dAtA[i:], keysForCachedSpecClusters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForCachedSpecClusters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CalculatedNamespaces) > 0 {
+		for iNdEx := len(m.CalculatedNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CalculatedNamespaces[iNdEx])
+			copy(dAtA[i:], m.CalculatedNamespaces[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.CalculatedNamespaces[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CalculatedChildProjects) > 0 {
+		for iNdEx := len(m.CalculatedChildProjects) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CalculatedChildProjects[iNdEx])
+			copy(dAtA[i:], m.CalculatedChildProjects[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.CalculatedChildProjects[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Clusters) > 0 {
+		keysForClusters := make([]string, 0, len(m.Clusters))
+		for k := range m.Clusters {
+			keysForClusters = append(keysForClusters, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForClusters)
+		for iNdEx := len(keysForClusters) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Clusters[string(keysForClusters[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForClusters[iNdEx])
+			copy(dAtA[i:], keysForClusters[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForClusters[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x12
+	if m.Locked != nil {
+		i--
+		if *m.Locked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaAlert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaAlert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaAlert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Channel)
+	copy(dAtA[i:], m.Channel)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Channel)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaAlertRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaAlertRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaAlertRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Thresholds) > 0 {
+		for iNdEx := len(m.Thresholds) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.Thresholds[iNdEx]))
+			i--
+			dAtA[i] = 0x10
+		}
+	}
+	i -= len(m.Resource)
+	copy(dAtA[i:], m.Resource)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Resource)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaAlertState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaAlertState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaAlertState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LastNotifyTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Threshold))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.Resource)
+	copy(dAtA[i:], m.Resource)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Resource)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ClusterName)
+	copy(dAtA[i:], m.ClusterName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaBorrowing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaBorrowing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaBorrowing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Limits) > 0 {
+		keysForLimits := make([]string, 0, len(m.Limits))
+		for k := range m.Limits {
+			keysForLimits = append(keysForLimits, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLimits)
+		for iNdEx := len(keysForLimits) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Limits[string(keysForLimits[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLimits[iNdEx])
+			copy(dAtA[i:], keysForLimits[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLimits[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsedQuantity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsedQuantity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsedQuantity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[string(keysForUsed[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CertificateAlert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChartGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChartGroupList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChartGroupSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Finalizers) > 0 {
+		for _, s := range m.Finalizers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ChartGroupStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastTransitionTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ConfigMap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Data) > 0 {
+		for k, v := range m.Data {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.BinaryData) > 0 {
+		for k, v := range m.BinaryData {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = 1 + len(v) + sovGenerated(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ConfigMapList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FrozenNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ClusterName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Workloads) > 0 {
+		for _, e := range m.Workloads {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	return n
+}
+
+func (m *FrozenWorkload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Replicas))
+	return n
+}
+
+func (m *HardQuantity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hard) > 0 {
+		for k, v := range m.Hard {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ImageNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ImageNamespaceList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ImageNamespaceSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Finalizers) > 0 {
+		for _, s := range m.Finalizers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ImageNamespaceStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastTransitionTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Namespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NamespaceCert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CertPem != nil {
+		l = len(m.CertPem)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.KeyPem != nil {
+		l = len(m.KeyPem)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CACertPem != nil {
+		l = len(m.CACertPem)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.APIServer)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SerialNumber)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.NotAfter.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NamespaceCertOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidDays)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NamespaceCertStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SerialNumber)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ValidDays))
+	l = m.NotBefore.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.NotAfter.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CertPem != nil {
+		l = len(m.CertPem)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastNotifyTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.RenewUntil.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NamespaceList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceQuotaNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ClusterName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Hard) > 0 {
+		for k, v := range m.Hard {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Finalizers) > 0 {
+		for _, s := range m.Finalizers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ClusterName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Hard) > 0 {
+		for k, v := range m.Hard {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.ClusterVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ClusterDisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ClusterType)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RevokedCertificates) > 0 {
+		for _, s := range m.RevokedCertificates {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastTransitionTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ResourceQuotaName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.CachedSpecHard) > 0 {
+		for k, v := range m.CachedSpecHard {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Certificate != nil {
+		l = m.Certificate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Certificates) > 0 {
+		for _, e := range m.Certificates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NamespaceTemplateList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceTemplateNetworkPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	n += 2
+	return n
+}
+
+func (m *NamespaceTemplatePodSecurity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Enforce)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Audit)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Warn)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NamespaceTemplateRoleBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ClusterRole)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Subjects) > 0 {
+		for _, e := range m.Subjects {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceTemplateSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.LimitRanges) > 0 {
+		for _, e := range m.LimitRanges {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.NetworkPolicy != nil {
+		l = m.NetworkPolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.RoleBindings) > 0 {
+		for _, e := range m.RoleBindings {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.PodSecurity != nil {
+		l = m.PodSecurity.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespaceTemplateSubject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NamespaceTemplateSync) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastSyncTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NsEmigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NsEmigrationList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NsEmigrationSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.NsShowName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Destination)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Mode)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DestinationCluster)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	return n
+}
+
+func (m *NsEmigrationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastTransitionTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SourceCluster)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.TransferredQuota) > 0 {
+		for k, v := range m.TransferredQuota {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Platform) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *PlatformList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PlatformSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Administrators) > 0 {
+		for _, s := range m.Administrators {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Portal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.Projects) > 0 {
+		for k, v := range m.Projects {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Extension) > 0 {
+		for k, v := range m.Extension {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PortalProject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Parent)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *Project) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ProjectFreezeStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Locked != nil {
+		n += 2
+	}
+	if len(m.Namespaces) > 0 {
+		for _, e := range m.Namespaces {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.LastTransitionTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ProjectList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectQuotaNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Hard) > 0 {
+		for k, v := range m.Hard {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Allocated) > 0 {
+		for k, v := range m.Allocated {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Borrowed) > 0 {
+		for k, v := range m.Borrowed {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, e := range m.Namespaces {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Children) > 0 {
+		for _, e := range m.Children {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectQuotaTree) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Root.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ProjectRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ProjectRequestList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectRequestSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Requester)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ProjectName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ParentProjectName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Clusters) > 0 {
+		for k, v := range m.Clusters {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Justification)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Channel)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ProjectRequestStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Approver)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ProjectName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.NotifiedPhase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastTransitionTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ProjectSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Finalizers) > 0 {
+		for _, s := range m.Finalizers {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DisplayName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.ParentProjectName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Clusters) > 0 {
+		for k, v := range m.Clusters {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.QuotaBorrowing != nil {
+		l = m.QuotaBorrowing.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.QuotaAlert != nil {
+		l = m.QuotaAlert.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.CertificateAlert != nil {
+		l = m.CertificateAlert.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
+func (m *ProjectStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Locked != nil {
+		n += 2
+	}
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Clusters) > 0 {
+		for k, v := range m.Clusters {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.CalculatedChildProjects) > 0 {
+		for _, s := range m.CalculatedChildProjects {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.CalculatedNamespaces) > 0 {
+		for _, s := range m.CalculatedNamespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.CachedSpecClusters) > 0 {
+		for k, v := range m.CachedSpecClusters {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.CachedParent != nil {
+		l = len(*m.CachedParent)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.LastTransitionTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.QuotaAlerts) > 0 {
+		for _, e := range m.QuotaAlerts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Freeze != nil {
+		l = m.Freeze.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *QuotaAlert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuotaAlertRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resource)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Thresholds) > 0 {
+		for _, e := range m.Thresholds {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	return n
+}
+
+func (m *QuotaAlertState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Resource)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Threshold))
+	l = m.LastNotifyTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *QuotaBorrowing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Limits) > 0 {
+		for k, v := range m.Limits {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *UsedQuantity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *CertificateAlert) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CertificateAlert{`,
+		`Channel:` + fmt.Sprintf("%v", this.Channel) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChartGroup) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChartGroup{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ChartGroupSpec", "ChartGroupSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ChartGroupStatus", "ChartGroupStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChartGroupList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ChartGroup{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ChartGroup", "ChartGroup", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ChartGroupList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChartGroupSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChartGroupSpec{`,
+		`Finalizers:` + fmt.Sprintf("%v", this.Finalizers) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChartGroupStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChartGroupStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfigMap) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForData := make([]string, 0, len(this.Data))
+	for k := range this.Data {
+		keysForData = append(keysForData, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForData)
+	mapStringForData := "map[string]string{"
+	for _, k := range keysForData {
+		mapStringForData += fmt.Sprintf("%v: %v,", k, this.Data[k])
+	}
+	mapStringForData += "}"
+	keysForBinaryData := make([]string, 0, len(this.BinaryData))
+	for k := range this.BinaryData {
+		keysForBinaryData = append(keysForBinaryData, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForBinaryData)
+	mapStringForBinaryData := "map[string][]byte{"
+	for _, k := range keysForBinaryData {
+		mapStringForBinaryData += fmt.Sprintf("%v: %v,", k, this.BinaryData[k])
+	}
+	mapStringForBinaryData += "}"
+	s := strings.Join([]string{`&ConfigMap{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Data:` + mapStringForData + `,`,
+		`BinaryData:` + mapStringForBinaryData + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfigMapList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ConfigMap{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ConfigMap", "ConfigMap", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ConfigMapList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FrozenNamespace) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWorkloads := "[]FrozenWorkload{"
+	for _, f := range this.Workloads {
+		repeatedStringForWorkloads += strings.Replace(strings.Replace(f.String(), "FrozenWorkload", "FrozenWorkload", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWorkloads += "}"
+	s := strings.Join([]string{`&FrozenNamespace{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Workloads:` + repeatedStringForWorkloads + `,`,
+		`Frozen:` + fmt.Sprintf("%v", this.Frozen) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FrozenWorkload) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FrozenWorkload{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Replicas:` + fmt.Sprintf("%v", this.Replicas) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HardQuantity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHard := make([]string, 0, len(this.Hard))
+	for k := range this.Hard {
+		keysForHard = append(keysForHard, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+	mapStringForHard := "ResourceList{"
+	for _, k := range keysForHard {
+		mapStringForHard += fmt.Sprintf("%v: %v,", k, this.Hard[k])
+	}
+	mapStringForHard += "}"
+	s := strings.Join([]string{`&HardQuantity{`,
+		`Hard:` + mapStringForHard + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageNamespace) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageNamespace{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ImageNamespaceSpec", "ImageNamespaceSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ImageNamespaceStatus", "ImageNamespaceStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageNamespaceList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ImageNamespace{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ImageNamespace", "ImageNamespace", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ImageNamespaceList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageNamespaceSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageNamespaceSpec{`,
+		`Finalizers:` + fmt.Sprintf("%v", this.Finalizers) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageNamespaceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageNamespaceStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Namespace) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Namespace{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "NamespaceSpec", "NamespaceSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "NamespaceStatus", "NamespaceStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceCert) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceCert{`,
+		`CertPem:` + valueToStringGenerated(this.CertPem) + `,`,
+		`KeyPem:` + valueToStringGenerated(this.KeyPem) + `,`,
+		`CACertPem:` + valueToStringGenerated(this.CACertPem) + `,`,
+		`APIServer:` + fmt.Sprintf("%v", this.APIServer) + `,`,
+		`SerialNumber:` + fmt.Sprintf("%v", this.SerialNumber) + `,`,
+		`NotAfter:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NotAfter), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceCertOptions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceCertOptions{`,
+		`ValidDays:` + fmt.Sprintf("%v", this.ValidDays) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceCertStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceCertStatus{`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`SerialNumber:` + fmt.Sprintf("%v", this.SerialNumber) + `,`,
+		`ValidDays:` + fmt.Sprintf("%v", this.ValidDays) + `,`,
+		`NotBefore:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NotBefore), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`NotAfter:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NotAfter), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`CertPem:` + valueToStringGenerated(this.CertPem) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`LastNotifyTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastNotifyTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`RenewUntil:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.RenewUntil), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Namespace{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Namespace", "Namespace", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&NamespaceList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceQuotaNode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHard := make([]string, 0, len(this.Hard))
+	for k := range this.Hard {
+		keysForHard = append(keysForHard, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+	mapStringForHard := "ResourceList{"
+	for _, k := range keysForHard {
+		mapStringForHard += fmt.Sprintf("%v: %v,", k, this.Hard[k])
+	}
+	mapStringForHard += "}"
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k := range this.Used {
+		keysForUsed = append(keysForUsed, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "ResourceList{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k])
+	}
+	mapStringForUsed += "}"
+	s := strings.Join([]string{`&NamespaceQuotaNode{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`Hard:` + mapStringForHard + `,`,
+		`Used:` + mapStringForUsed + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForHard := make([]string, 0, len(this.Hard))
+	for k := range this.Hard {
+		keysForHard = append(keysForHard, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+	mapStringForHard := "ResourceList{"
+	for _, k := range keysForHard {
+		mapStringForHard += fmt.Sprintf("%v: %v,", k, this.Hard[k])
+	}
+	mapStringForHard += "}"
+	s := strings.Join([]string{`&NamespaceSpec{`,
+		`Finalizers:` + fmt.Sprintf("%v", this.Finalizers) + `,`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Hard:` + mapStringForHard + `,`,
+		`ClusterVersion:` + fmt.Sprintf("%v", this.ClusterVersion) + `,`,
+		`ClusterDisplayName:` + fmt.Sprintf("%v", this.ClusterDisplayName) + `,`,
+		`ClusterType:` + fmt.Sprintf("%v", this.ClusterType) + `,`,
+		`RevokedCertificates:` + fmt.Sprintf("%v", this.RevokedCertificates) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTemplates := "[]NamespaceTemplateSync{"
+	for _, f := range this.Templates {
+		repeatedStringForTemplates += strings.Replace(strings.Replace(f.String(), "NamespaceTemplateSync", "NamespaceTemplateSync", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTemplates += "}"
+	repeatedStringForCertificates := "[]NamespaceCertStatus{"
+	for _, f := range this.Certificates {
+		repeatedStringForCertificates += strings.Replace(strings.Replace(f.String(), "NamespaceCertStatus", "NamespaceCertStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCertificates += "}"
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k := range this.Used {
+		keysForUsed = append(keysForUsed, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "ResourceList{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k])
+	}
+	mapStringForUsed += "}"
+	keysForCachedSpecHard := make([]string, 0, len(this.CachedSpecHard))
+	for k := range this.CachedSpecHard {
+		keysForCachedSpecHard = append(keysForCachedSpecHard, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCachedSpecHard)
+	mapStringForCachedSpecHard := "ResourceList{"
+	for _, k := range keysForCachedSpecHard {
+		mapStringForCachedSpecHard += fmt.Sprintf("%v: %v,", k, this.CachedSpecHard[k])
+	}
+	mapStringForCachedSpecHard += "}"
+	s := strings.Join([]string{`&NamespaceStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`ResourceQuotaName:` + fmt.Sprintf("%v", this.ResourceQuotaName) + `,`,
+		`Used:` + mapStringForUsed + `,`,
+		`CachedSpecHard:` + mapStringForCachedSpecHard + `,`,
+		`Certificate:` + strings.Replace(this.Certificate.String(), "NamespaceCert", "NamespaceCert", 1) + `,`,
+		`Templates:` + repeatedStringForTemplates + `,`,
+		`Certificates:` + repeatedStringForCertificates + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceTemplate{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "NamespaceTemplateSpec", "NamespaceTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceTemplateList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]NamespaceTemplate{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "NamespaceTemplate", "NamespaceTemplate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&NamespaceTemplateList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceTemplateNetworkPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceTemplateNetworkPolicy{`,
+		`DenyIngress:` + fmt.Sprintf("%v", this.DenyIngress) + `,`,
+		`DenyEgress:` + fmt.Sprintf("%v", this.DenyEgress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceTemplatePodSecurity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceTemplatePodSecurity{`,
+		`Enforce:` + fmt.Sprintf("%v", this.Enforce) + `,`,
+		`Audit:` + fmt.Sprintf("%v", this.Audit) + `,`,
+		`Warn:` + fmt.Sprintf("%v", this.Warn) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceTemplateRoleBinding) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSubjects := "[]NamespaceTemplateSubject{"
+	for _, f := range this.Subjects {
+		repeatedStringForSubjects += strings.Replace(strings.Replace(f.String(), "NamespaceTemplateSubject", "NamespaceTemplateSubject", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSubjects += "}"
+	s := strings.Join([]string{`&NamespaceTemplateRoleBinding{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ClusterRole:` + fmt.Sprintf("%v", this.ClusterRole) + `,`,
+		`Subjects:` + repeatedStringForSubjects + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceTemplateSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLimitRanges := "[]LimitRangeItem{"
+	for _, f := range this.LimitRanges {
+		repeatedStringForLimitRanges += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForLimitRanges += "}"
+	repeatedStringForRoleBindings := "[]NamespaceTemplateRoleBinding{"
+	for _, f := range this.RoleBindings {
+		repeatedStringForRoleBindings += strings.Replace(strings.Replace(f.String(), "NamespaceTemplateRoleBinding", "NamespaceTemplateRoleBinding", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRoleBindings += "}"
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&NamespaceTemplateSpec{`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`LimitRanges:` + repeatedStringForLimitRanges + `,`,
+		`NetworkPolicy:` + strings.Replace(this.NetworkPolicy.String(), "NamespaceTemplateNetworkPolicy", "NamespaceTemplateNetworkPolicy", 1) + `,`,
+		`RoleBindings:` + repeatedStringForRoleBindings + `,`,
+		`PodSecurity:` + strings.Replace(this.PodSecurity.String(), "NamespaceTemplatePodSecurity", "NamespaceTemplatePodSecurity", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceTemplateSubject) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceTemplateSubject{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceTemplateSync) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceTemplateSync{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`LastSyncTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastSyncTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NsEmigration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NsEmigration{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "NsEmigrationSpec", "NsEmigrationSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "NsEmigrationStatus", "NsEmigrationStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NsEmigrationList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]NsEmigration{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "NsEmigration", "NsEmigration", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&NsEmigrationList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NsEmigrationSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NsEmigrationSpec{`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`NsShowName:` + fmt.Sprintf("%v", this.NsShowName) + `,`,
+		`Destination:` + fmt.Sprintf("%v", this.Destination) + `,`,
+		`Mode:` + fmt.Sprintf("%v", this.Mode) + `,`,
+		`DestinationCluster:` + fmt.Sprintf("%v", this.DestinationCluster) + `,`,
+		`TransferQuota:` + fmt.Sprintf("%v", this.TransferQuota) + `,`,
+		`CutOver:` + fmt.Sprintf("%v", this.CutOver) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NsEmigrationStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForTransferredQuota := make([]string, 0, len(this.TransferredQuota))
+	for k := range this.TransferredQuota {
+		keysForTransferredQuota = append(keysForTransferredQuota, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTransferredQuota)
+	mapStringForTransferredQuota := "ResourceList{"
+	for _, k := range keysForTransferredQuota {
+		mapStringForTransferredQuota += fmt.Sprintf("%v: %v,", k, this.TransferredQuota[k])
+	}
+	mapStringForTransferredQuota += "}"
+	s := strings.Join([]string{`&NsEmigrationStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`SourceCluster:` + fmt.Sprintf("%v", this.SourceCluster) + `,`,
+		`TransferredQuota:` + mapStringForTransferredQuota + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Platform) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Platform{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PlatformSpec", "PlatformSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PlatformList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Platform{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Platform", "Platform", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&PlatformList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PlatformSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PlatformSpec{`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`Administrators:` + fmt.Sprintf("%v", this.Administrators) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Portal) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForProjects := make([]string, 0, len(this.Projects))
+	for k := range this.Projects {
+		keysForProjects = append(keysForProjects, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForProjects)
+	mapStringForProjects := "map[string]string{"
+	for _, k := range keysForProjects {
+		mapStringForProjects += fmt.Sprintf("%v: %v,", k, this.Projects[k])
+	}
+	mapStringForProjects += "}"
+	keysForExtension := make([]string, 0, len(this.Extension))
+	for k := range this.Extension {
+		keysForExtension = append(keysForExtension, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExtension)
+	mapStringForExtension := "ProjectExtension{"
+	for _, k := range keysForExtension {
+		mapStringForExtension += fmt.Sprintf("%v: %v,", k, this.Extension[k])
+	}
+	mapStringForExtension += "}"
+	s := strings.Join([]string{`&Portal{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Administrator:` + fmt.Sprintf("%v", this.Administrator) + `,`,
+		`Projects:` + mapStringForProjects + `,`,
+		`Extension:` + mapStringForExtension + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PortalProject) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PortalProject{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Parent:` + fmt.Sprintf("%v", this.Parent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Project) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Project{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ProjectSpec", "ProjectSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ProjectStatus", "ProjectStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectFreezeStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNamespaces := "[]FrozenNamespace{"
+	for _, f := range this.Namespaces {
+		repeatedStringForNamespaces += strings.Replace(strings.Replace(f.String(), "FrozenNamespace", "FrozenNamespace", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForNamespaces += "}"
+	s := strings.Join([]string{`&ProjectFreezeStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Locked:` + valueToStringGenerated(this.Locked) + `,`,
+		`Namespaces:` + repeatedStringForNamespaces + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Project{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Project", "Project", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ProjectList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectQuotaNode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNamespaces := "[]NamespaceQuotaNode{"
+	for _, f := range this.Namespaces {
+		repeatedStringForNamespaces += strings.Replace(strings.Replace(f.String(), "NamespaceQuotaNode", "NamespaceQuotaNode", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForNamespaces += "}"
+	repeatedStringForChildren := "[]ProjectQuotaNode{"
+	for _, f := range this.Children {
+		repeatedStringForChildren += strings.Replace(strings.Replace(f.String(), "ProjectQuotaNode", "ProjectQuotaNode", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForChildren += "}"
+	keysForHard := make([]string, 0, len(this.Hard))
+	for k := range this.Hard {
+		keysForHard = append(keysForHard, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+	mapStringForHard := "ClusterHard{"
+	for _, k := range keysForHard {
+		mapStringForHard += fmt.Sprintf("%v: %v,", k, this.Hard[k])
+	}
+	mapStringForHard += "}"
+	keysForAllocated := make([]string, 0, len(this.Allocated))
+	for k := range this.Allocated {
+		keysForAllocated = append(keysForAllocated, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAllocated)
+	mapStringForAllocated := "ClusterUsed{"
+	for _, k := range keysForAllocated {
+		mapStringForAllocated += fmt.Sprintf("%v: %v,", k, this.Allocated[k])
+	}
+	mapStringForAllocated += "}"
+	keysForBorrowed := make([]string, 0, len(this.Borrowed))
+	for k := range this.Borrowed {
+		keysForBorrowed = append(keysForBorrowed, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForBorrowed)
+	mapStringForBorrowed := "ClusterUsed{"
+	for _, k := range keysForBorrowed {
+		mapStringForBorrowed += fmt.Sprintf("%v: %v,", k, this.Borrowed[k])
+	}
+	mapStringForBorrowed += "}"
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k := range this.Used {
+		keysForUsed = append(keysForUsed, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "ClusterUsed{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k])
+	}
+	mapStringForUsed += "}"
+	s := strings.Join([]string{`&ProjectQuotaNode{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Hard:` + mapStringForHard + `,`,
+		`Allocated:` + mapStringForAllocated + `,`,
+		`Borrowed:` + mapStringForBorrowed + `,`,
+		`Used:` + mapStringForUsed + `,`,
+		`Namespaces:` + repeatedStringForNamespaces + `,`,
+		`Children:` + repeatedStringForChildren + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectQuotaTree) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectQuotaTree{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Root:` + strings.Replace(strings.Replace(this.Root.String(), "ProjectQuotaNode", "ProjectQuotaNode", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectRequest{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ProjectRequestSpec", "ProjectRequestSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ProjectRequestStatus", "ProjectRequestStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectRequestList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ProjectRequest{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ProjectRequest", "ProjectRequest", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ProjectRequestList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectRequestSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForClusters := make([]string, 0, len(this.Clusters))
+	for k := range this.Clusters {
+		keysForClusters = append(keysForClusters, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForClusters)
+	mapStringForClusters := "ClusterHard{"
+	for _, k := range keysForClusters {
+		mapStringForClusters += fmt.Sprintf("%v: %v,", k, this.Clusters[k])
+	}
+	mapStringForClusters += "}"
+	s := strings.Join([]string{`&ProjectRequestSpec{`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`Requester:` + fmt.Sprintf("%v", this.Requester) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`ProjectName:` + fmt.Sprintf("%v", this.ProjectName) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`ParentProjectName:` + fmt.Sprintf("%v", this.ParentProjectName) + `,`,
+		`Clusters:` + mapStringForClusters + `,`,
+		`Justification:` + fmt.Sprintf("%v", this.Justification) + `,`,
+		`Channel:` + fmt.Sprintf("%v", this.Channel) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectRequestStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectRequestStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Approver:` + fmt.Sprintf("%v", this.Approver) + `,`,
+		`ProjectName:` + fmt.Sprintf("%v", this.ProjectName) + `,`,
+		`NotifiedPhase:` + fmt.Sprintf("%v", this.NotifiedPhase) + `,`,
+		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForClusters := make([]string, 0, len(this.Clusters))
+	for k := range this.Clusters {
+		keysForClusters = append(keysForClusters, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForClusters)
+	mapStringForClusters := "ClusterHard{"
+	for _, k := range keysForClusters {
+		mapStringForClusters += fmt.Sprintf("%v: %v,", k, this.Clusters[k])
+	}
+	mapStringForClusters += "}"
+	s := strings.Join([]string{`&ProjectSpec{`,
+		`Finalizers:` + fmt.Sprintf("%v", this.Finalizers) + `,`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`DisplayName:` + fmt.Sprintf("%v", this.DisplayName) + `,`,
+		`Members:` + fmt.Sprintf("%v", this.Members) + `,`,
+		`ParentProjectName:` + fmt.Sprintf("%v", this.ParentProjectName) + `,`,
+		`Clusters:` + mapStringForClusters + `,`,
+		`QuotaBorrowing:` + strings.Replace(this.QuotaBorrowing.String(), "QuotaBorrowing", "QuotaBorrowing", 1) + `,`,
+		`QuotaAlert:` + strings.Replace(this.QuotaAlert.String(), "QuotaAlert", "QuotaAlert", 1) + `,`,
+		`CertificateAlert:` + strings.Replace(this.CertificateAlert.String(), "CertificateAlert", "CertificateAlert", 1) + `,`,
+		`Frozen:` + fmt.Sprintf("%v", this.Frozen) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForQuotaAlerts := "[]QuotaAlertState{"
+	for _, f := range this.QuotaAlerts {
+		repeatedStringForQuotaAlerts += strings.Replace(strings.Replace(f.String(), "QuotaAlertState", "QuotaAlertState", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForQuotaAlerts += "}"
+	keysForClusters := make([]string, 0, len(this.Clusters))
+	for k := range this.Clusters {
+		keysForClusters = append(keysForClusters, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForClusters)
+	mapStringForClusters := "ClusterUsed{"
+	for _, k := range keysForClusters {
+		mapStringForClusters += fmt.Sprintf("%v: %v,", k, this.Clusters[k])
+	}
+	mapStringForClusters += "}"
+	keysForCachedSpecClusters := make([]string, 0, len(this.CachedSpecClusters))
+	for k := range this.CachedSpecClusters {
+		keysForCachedSpecClusters = append(keysForCachedSpecClusters, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCachedSpecClusters)
+	mapStringForCachedSpecClusters := "ClusterHard{"
+	for _, k := range keysForCachedSpecClusters {
+		mapStringForCachedSpecClusters += fmt.Sprintf("%v: %v,", k, this.CachedSpecClusters[k])
+	}
+	mapStringForCachedSpecClusters += "}"
+	s := strings.Join([]string{`&ProjectStatus{`,
+		`Locked:` + valueToStringGenerated(this.Locked) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`Clusters:` + mapStringForClusters + `,`,
 		`CalculatedChildProjects:` + fmt.Sprintf("%v", this.CalculatedChildProjects) + `,`,
 		`CalculatedNamespaces:` + fmt.Sprintf("%v", this.CalculatedNamespaces) + `,`,
@@ -4059,39 +7582,5387 @@ func (this *ProjectStatus) String() string {
 		`LastTransitionTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastTransitionTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`QuotaAlerts:` + repeatedStringForQuotaAlerts + `,`,
+		`Freeze:` + strings.Replace(this.Freeze.String(), "ProjectFreezeStatus", "ProjectFreezeStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaAlert) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRules := "[]QuotaAlertRule{"
+	for _, f := range this.Rules {
+		repeatedStringForRules += strings.Replace(strings.Replace(f.String(), "QuotaAlertRule", "QuotaAlertRule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRules += "}"
+	s := strings.Join([]string{`&QuotaAlert{`,
+		`Channel:` + fmt.Sprintf("%v", this.Channel) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`Rules:` + repeatedStringForRules + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaAlertRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuotaAlertRule{`,
+		`Resource:` + fmt.Sprintf("%v", this.Resource) + `,`,
+		`Thresholds:` + fmt.Sprintf("%v", this.Thresholds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaAlertState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuotaAlertState{`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Resource:` + fmt.Sprintf("%v", this.Resource) + `,`,
+		`Threshold:` + fmt.Sprintf("%v", this.Threshold) + `,`,
+		`LastNotifyTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastNotifyTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaBorrowing) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLimits := make([]string, 0, len(this.Limits))
+	for k := range this.Limits {
+		keysForLimits = append(keysForLimits, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLimits)
+	mapStringForLimits := "ClusterHard{"
+	for _, k := range keysForLimits {
+		mapStringForLimits += fmt.Sprintf("%v: %v,", k, this.Limits[k])
+	}
+	mapStringForLimits += "}"
+	s := strings.Join([]string{`&QuotaBorrowing{`,
+		`Limits:` + mapStringForLimits + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UsedQuantity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k := range this.Used {
+		keysForUsed = append(keysForUsed, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "ResourceList{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k])
+	}
+	mapStringForUsed += "}"
+	s := strings.Join([]string{`&UsedQuantity{`,
+		`Used:` + mapStringForUsed + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *UsedQuantity) String() string {
-	if this == nil {
-		return "nil"
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *CertificateAlert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CertificateAlert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CertificateAlert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartGroupList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartGroupList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartGroupList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ChartGroup{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartGroupSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartGroupSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartGroupSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Finalizers = append(m.Finalizers, FinalizerName(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartGroupStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartGroupStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartGroupStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = ChartGroupPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigMap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigMap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigMap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Data[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BinaryData == nil {
+				m.BinaryData = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.BinaryData[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigMapList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigMapList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ConfigMap{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrozenNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workloads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workloads = append(m.Workloads, FrozenWorkload{})
+			if err := m.Workloads[len(m.Workloads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrozenWorkload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenWorkload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenWorkload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HardQuantity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HardQuantity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HardQuantity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hard == nil {
+				m.Hard = make(ResourceList)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hard[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageNamespaceList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageNamespaceList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageNamespaceList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ImageNamespace{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageNamespaceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageNamespaceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageNamespaceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Finalizers = append(m.Finalizers, FinalizerName(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImageNamespaceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImageNamespaceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImageNamespaceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = ImageNamespacePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Namespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Namespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Namespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceCert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceCert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceCert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertPem", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertPem = append(m.CertPem[:0], dAtA[iNdEx:postIndex]...)
+			if m.CertPem == nil {
+				m.CertPem = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyPem", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyPem = append(m.KeyPem[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyPem == nil {
+				m.KeyPem = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CACertPem", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CACertPem = append(m.CACertPem[:0], dAtA[iNdEx:postIndex]...)
+			if m.CACertPem == nil {
+				m.CACertPem = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIServer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIServer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SerialNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SerialNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NotAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceCertOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceCertOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceCertOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidDays", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidDays = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceCertStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceCertStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceCertStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SerialNumber", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SerialNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidDays", wireType)
+			}
+			m.ValidDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidDays |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NotBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NotAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = NamespaceCertPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertPem", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertPem = append(m.CertPem[:0], dAtA[iNdEx:postIndex]...)
+			if m.CertPem == nil {
+				m.CertPem = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNotifyTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastNotifyTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RenewUntil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Namespace{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceQuotaNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceQuotaNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceQuotaNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hard == nil {
+				m.Hard = make(ResourceList)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hard[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Used == nil {
+				m.Used = make(ResourceList)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Used[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Finalizers = append(m.Finalizers, FinalizerName(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hard == nil {
+				m.Hard = make(ResourceList)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hard[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterDisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedCertificates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedCertificates = append(m.RevokedCertificates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = NamespacePhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceQuotaName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceQuotaName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Used == nil {
+				m.Used = make(ResourceList)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Used[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CachedSpecHard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CachedSpecHard == nil {
+				m.CachedSpecHard = make(ResourceList)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CachedSpecHard[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Certificate == nil {
+				m.Certificate = &NamespaceCert{}
+			}
+			if err := m.Certificate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, NamespaceTemplateSync{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificates = append(m.Certificates, NamespaceCertStatus{})
+			if err := m.Certificates[len(m.Certificates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceTemplateList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceTemplateList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, NamespaceTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceTemplateNetworkPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceTemplateNetworkPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceTemplateNetworkPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyIngress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DenyIngress = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyEgress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DenyEgress = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceTemplatePodSecurity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceTemplatePodSecurity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceTemplatePodSecurity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enforce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Enforce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Audit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	keysForUsed := make([]string, 0, len(this.Used))
-	for k := range this.Used {
-		keysForUsed = append(keysForUsed, k)
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
-	mapStringForUsed := "ResourceList{"
-	for _, k := range keysForUsed {
-		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k])
+	return nil
+}
+func (m *NamespaceTemplateRoleBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceTemplateRoleBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceTemplateRoleBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterRole", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterRole = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subjects = append(m.Subjects, NamespaceTemplateSubject{})
+			if err := m.Subjects[len(m.Subjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	mapStringForUsed += "}"
-	s := strings.Join([]string{`&UsedQuantity{`,
-		`Used:` + mapStringForUsed + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *ChartGroup) Unmarshal(dAtA []byte) error {
+func (m *NamespaceTemplateSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4110,19 +12981,210 @@ func (m *ChartGroup) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChartGroup: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartGroup: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceTemplateSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceTemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4149,13 +13211,107 @@ func (m *ChartGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitRanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4182,13 +13338,14 @@ func (m *ChartGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LimitRanges = append(m.LimitRanges, v11.LimitRangeItem{})
+			if err := m.LimitRanges[len(m.LimitRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4215,63 +13372,16 @@ func (m *ChartGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.NetworkPolicy == nil {
+				m.NetworkPolicy = &NamespaceTemplateNetworkPolicy{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.NetworkPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChartGroupList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChartGroupList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartGroupList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleBindings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4298,13 +13408,14 @@ func (m *ChartGroupList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RoleBindings = append(m.RoleBindings, NamespaceTemplateRoleBinding{})
+			if err := m.RoleBindings[len(m.RoleBindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSecurity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4331,8 +13442,10 @@ func (m *ChartGroupList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ChartGroup{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PodSecurity == nil {
+				m.PodSecurity = &NamespaceTemplatePodSecurity{}
+			}
+			if err := m.PodSecurity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4357,7 +13470,7 @@ func (m *ChartGroupList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChartGroupSpec) Unmarshal(dAtA []byte) error {
+func (m *NamespaceTemplateSubject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4380,15 +13493,15 @@ func (m *ChartGroupSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChartGroupSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceTemplateSubject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartGroupSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceTemplateSubject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4416,7 +13529,7 @@ func (m *ChartGroupSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Finalizers = append(m.Finalizers, FinalizerName(dAtA[iNdEx:postIndex]))
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4452,39 +13565,7 @@ func (m *ChartGroupSpec) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TenantID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4512,7 +13593,7 @@ func (m *ChartGroupSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4535,7 +13616,7 @@ func (m *ChartGroupSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChartGroupStatus) Unmarshal(dAtA []byte) error {
+func (m *NamespaceTemplateSync) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4558,15 +13639,15 @@ func (m *ChartGroupStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChartGroupStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceTemplateSync: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartGroupStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceTemplateSync: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4594,13 +13675,32 @@ func (m *ChartGroupStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = ChartGroupPhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4610,30 +13710,29 @@ func (m *ChartGroupStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = NamespaceTemplateSyncPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSyncTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4643,25 +13742,26 @@ func (m *ChartGroupStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if err := m.LastSyncTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
@@ -4714,7 +13814,7 @@ func (m *ChartGroupStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigMap) Unmarshal(dAtA []byte) error {
+func (m *NsEmigration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4737,10 +13837,10 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigMap: wiretype end group for non-group")
+			return fmt.Errorf("proto: NsEmigration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigMap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NsEmigration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4778,7 +13878,7 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4805,107 +13905,13 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Data[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4913,123 +13919,28 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 					return ErrIntOverflowGenerated
 				}
 				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BinaryData == nil {
-				m.BinaryData = make(map[string][]byte)
-			}
-			var mapkey string
-			mapvalue := []byte{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapbyteLen uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postbytesIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-					iNdEx = postbytesIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.BinaryData[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5052,7 +13963,7 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
+func (m *NsEmigrationList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5075,10 +13986,10 @@ func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigMapList: wiretype end group for non-group")
+			return fmt.Errorf("proto: NsEmigrationList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigMapList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NsEmigrationList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5143,7 +14054,7 @@ func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ConfigMap{})
+			m.Items = append(m.Items, NsEmigration{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5169,7 +14080,7 @@ func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HardQuantity) Unmarshal(dAtA []byte) error {
+func (m *NsEmigrationSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5192,17 +14103,17 @@ func (m *HardQuantity) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HardQuantity: wiretype end group for non-group")
+			return fmt.Errorf("proto: NsEmigrationSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HardQuantity: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NsEmigrationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5212,121 +14123,224 @@ func (m *HardQuantity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hard == nil {
-				m.Hard = make(ResourceList)
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var mapkey string
-			mapvalue := &resource.Quantity{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &resource.Quantity{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NsShowName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NsShowName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = NsEmigrationMode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Hard[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferQuota", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TransferQuota = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CutOver", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CutOver = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5348,7 +14362,7 @@ func (m *HardQuantity) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageNamespace) Unmarshal(dAtA []byte) error {
+func (m *NsEmigrationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5371,17 +14385,17 @@ func (m *ImageNamespace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageNamespace: wiretype end group for non-group")
+			return fmt.Errorf("proto: NsEmigrationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NsEmigrationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5391,28 +14405,27 @@ func (m *ImageNamespace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = NsEmigrationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5439,13 +14452,109 @@ func (m *ImageNamespace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferredQuota", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5462,19 +14571,115 @@ func (m *ImageNamespace) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferredQuota == nil {
+				m.TransferredQuota = make(ResourceList)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TransferredQuota[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5497,7 +14702,7 @@ func (m *ImageNamespace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageNamespaceList) Unmarshal(dAtA []byte) error {
+func (m *Platform) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5520,15 +14725,15 @@ func (m *ImageNamespaceList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageNamespaceList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Platform: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageNamespaceList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Platform: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5555,13 +14760,13 @@ func (m *ImageNamespaceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5588,8 +14793,7 @@ func (m *ImageNamespaceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ImageNamespace{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5614,7 +14818,7 @@ func (m *ImageNamespaceList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageNamespaceSpec) Unmarshal(dAtA []byte) error {
+func (m *PlatformList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5637,17 +14841,17 @@ func (m *ImageNamespaceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageNamespaceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlatformList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageNamespaceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlatformList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5657,61 +14861,30 @@ func (m *ImageNamespaceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Finalizers = append(m.Finalizers, FinalizerName(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5721,55 +14894,25 @@ func (m *ImageNamespaceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TenantID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, Platform{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5792,103 +14935,38 @@ func (m *ImageNamespaceSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageNamespaceStatus) Unmarshal(dAtA []byte) error {
+func (m *PlatformSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ImageNamespaceStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageNamespaceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Phase = ImageNamespacePhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlatformSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlatformSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5916,11 +14994,11 @@ func (m *ImageNamespaceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.TenantID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5948,7 +15026,7 @@ func (m *ImageNamespaceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Administrators = append(m.Administrators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5971,7 +15049,7 @@ func (m *ImageNamespaceStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Namespace) Unmarshal(dAtA []byte) error {
+func (m *Portal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5994,10 +15072,10 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Namespace: wiretype end group for non-group")
+			return fmt.Errorf("proto: Portal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Namespace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Portal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6034,8 +15112,28 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Administrator = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6062,13 +15160,107 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Projects == nil {
+				m.Projects = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Projects[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6095,9 +15287,105 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Extension == nil {
+				m.Extension = make(ProjectExtension)
+			}
+			var mapkey string
+			mapvalue := &PortalProject{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &PortalProject{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Extension[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6120,7 +15408,7 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespaceCert) Unmarshal(dAtA []byte) error {
+func (m *PortalProject) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6143,17 +15431,17 @@ func (m *NamespaceCert) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceCert: wiretype end group for non-group")
+			return fmt.Errorf("proto: PortalProject: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceCert: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PortalProject: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CertPem", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6163,97 +15451,27 @@ func (m *NamespaceCert) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CertPem = append(m.CertPem[:0], dAtA[iNdEx:postIndex]...)
-			if m.CertPem == nil {
-				m.CertPem = []byte{}
-			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyPem", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeyPem = append(m.KeyPem[:0], dAtA[iNdEx:postIndex]...)
-			if m.KeyPem == nil {
-				m.KeyPem = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CACertPem", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CACertPem = append(m.CACertPem[:0], dAtA[iNdEx:postIndex]...)
-			if m.CACertPem == nil {
-				m.CACertPem = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIServer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6281,7 +15499,7 @@ func (m *NamespaceCert) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIServer = string(dAtA[iNdEx:postIndex])
+			m.Parent = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6304,7 +15522,7 @@ func (m *NamespaceCert) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespaceCertOptions) Unmarshal(dAtA []byte) error {
+func (m *Project) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6327,17 +15545,17 @@ func (m *NamespaceCertOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceCertOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: Project: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceCertOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidDays", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6347,77 +15565,28 @@ func (m *NamespaceCertOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidDays = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NamespaceList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6444,13 +15613,13 @@ func (m *NamespaceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6477,8 +15646,7 @@ func (m *NamespaceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Namespace{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6503,7 +15671,7 @@ func (m *NamespaceList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespaceSpec) Unmarshal(dAtA []byte) error {
+func (m *ProjectFreezeStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6526,79 +15694,15 @@ func (m *NamespaceSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectFreezeStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectFreezeStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Finalizers = append(m.Finalizers, FinalizerName(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TenantID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6626,13 +15730,13 @@ func (m *NamespaceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.Phase = ProjectFreezePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6642,27 +15746,16 @@ func (m *NamespaceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := bool(v != 0)
+			m.Locked = &b
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6689,111 +15782,16 @@ func (m *NamespaceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Hard == nil {
-				m.Hard = make(ResourceList)
-			}
-			var mapkey string
-			mapvalue := &resource.Quantity{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &resource.Quantity{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Namespaces = append(m.Namespaces, FrozenNamespace{})
+			if err := m.Namespaces[len(m.Namespaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Hard[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6803,59 +15801,28 @@ func (m *NamespaceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDisplayName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ClusterDisplayName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6883,7 +15850,7 @@ func (m *NamespaceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterType = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6906,7 +15873,7 @@ func (m *NamespaceSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespaceStatus) Unmarshal(dAtA []byte) error {
+func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6929,17 +15896,17 @@ func (m *NamespaceStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6949,27 +15916,28 @@ func (m *NamespaceStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NamespacePhase(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6996,45 +15964,64 @@ func (m *NamespaceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Project{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectQuotaNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectQuotaNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectQuotaNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7062,11 +16049,11 @@ func (m *NamespaceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceQuotaName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7094,11 +16081,11 @@ func (m *NamespaceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResourceQuotaName = string(dAtA[iNdEx:postIndex])
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7125,11 +16112,11 @@ func (m *NamespaceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Used == nil {
-				m.Used = make(ResourceList)
+			if m.Hard == nil {
+				m.Hard = make(ClusterHard)
 			}
 			var mapkey string
-			mapvalue := &resource.Quantity{}
+			mapvalue := &HardQuantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -7203,7 +16190,7 @@ func (m *NamespaceStatus) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &resource.Quantity{}
+					mapvalue = &HardQuantity{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -7223,11 +16210,11 @@ func (m *NamespaceStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Used[mapkey] = *mapvalue
+			m.Hard[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CachedSpecHard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7254,11 +16241,11 @@ func (m *NamespaceStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CachedSpecHard == nil {
-				m.CachedSpecHard = make(ResourceList)
+			if m.Allocated == nil {
+				m.Allocated = make(ClusterUsed)
 			}
 			var mapkey string
-			mapvalue := &resource.Quantity{}
+			mapvalue := &UsedQuantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -7332,7 +16319,7 @@ func (m *NamespaceStatus) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &resource.Quantity{}
+					mapvalue = &UsedQuantity{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -7352,97 +16339,11 @@ func (m *NamespaceStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.CachedSpecHard[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Certificate == nil {
-				m.Certificate = &NamespaceCert{}
-			}
-			if err := m.Certificate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Allocated[mapkey] = *mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NsEmigration) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NsEmigration: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NsEmigration: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrowed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7469,46 +16370,109 @@ func (m *NsEmigration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			if m.Borrowed == nil {
+				m.Borrowed = make(ClusterUsed)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			mapvalue := &UsedQuantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &UsedQuantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Borrowed[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7535,63 +16499,109 @@ func (m *NsEmigration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NsEmigrationList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Used == nil {
+				m.Used = make(ClusterUsed)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var mapkey string
+			mapvalue := &UsedQuantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &UsedQuantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NsEmigrationList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NsEmigrationList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Used[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7618,13 +16628,14 @@ func (m *NsEmigrationList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Namespaces = append(m.Namespaces, NamespaceQuotaNode{})
+			if err := m.Namespaces[len(m.Namespaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7651,8 +16662,8 @@ func (m *NsEmigrationList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, NsEmigration{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Children = append(m.Children, ProjectQuotaNode{})
+			if err := m.Children[len(m.Children)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7677,7 +16688,7 @@ func (m *NsEmigrationList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NsEmigrationSpec) Unmarshal(dAtA []byte) error {
+func (m *ProjectQuotaTree) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7700,49 +16711,17 @@ func (m *NsEmigrationSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NsEmigrationSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectQuotaTree: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NsEmigrationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectQuotaTree: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TenantID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7752,61 +16731,30 @@ func (m *NsEmigrationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NsShowName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NsShowName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7816,23 +16764,24 @@ func (m *NsEmigrationSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
+			if err := m.Root.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7855,7 +16804,7 @@ func (m *NsEmigrationSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NsEmigrationStatus) Unmarshal(dAtA []byte) error {
+func (m *ProjectRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7878,17 +16827,17 @@ func (m *NsEmigrationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NsEmigrationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NsEmigrationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7898,27 +16847,28 @@ func (m *NsEmigrationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = NsEmigrationPhase(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7945,15 +16895,15 @@ func (m *NsEmigrationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -7963,55 +16913,24 @@ func (m *NsEmigrationStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8034,7 +16953,7 @@ func (m *NsEmigrationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Platform) Unmarshal(dAtA []byte) error {
+func (m *ProjectRequestList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8057,15 +16976,15 @@ func (m *Platform) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Platform: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectRequestList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Platform: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectRequestList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8092,13 +17011,13 @@ func (m *Platform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8125,7 +17044,8 @@ func (m *Platform) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ProjectRequest{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8150,7 +17070,7 @@ func (m *Platform) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PlatformList) Unmarshal(dAtA []byte) error {
+func (m *ProjectRequestSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8160,30 +17080,190 @@ func (m *PlatformList) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowGenerated
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectRequestSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectRequestSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = ProjectRequestType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PlatformList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PlatformList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentProjectName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8193,28 +17273,27 @@ func (m *PlatformList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ParentProjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8241,64 +17320,141 @@ func (m *PlatformList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Platform{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Clusters == nil {
+				m.Clusters = make(ClusterHard)
+			}
+			var mapkey string
+			mapvalue := &HardQuantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &HardQuantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Clusters[mapkey] = *mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Justification", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PlatformSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PlatformSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PlatformSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Justification = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8326,11 +17482,11 @@ func (m *PlatformSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TenantID = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8358,7 +17514,7 @@ func (m *PlatformSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Administrators = append(m.Administrators, string(dAtA[iNdEx:postIndex]))
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8381,7 +17537,7 @@ func (m *PlatformSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Portal) Unmarshal(dAtA []byte) error {
+func (m *ProjectRequestStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8404,17 +17560,49 @@ func (m *Portal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Portal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectRequestStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Portal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectRequestStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = ProjectRequestPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8424,30 +17612,29 @@ func (m *Portal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Administrator", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8457,17 +17644,29 @@ func (m *Portal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Administrator = bool(v != 0)
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Projects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotifiedPhase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8477,122 +17676,27 @@ func (m *Portal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Projects == nil {
-				m.Projects = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Projects[mapkey] = mapvalue
+			m.NotifiedPhase = ProjectRequestPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8619,105 +17723,73 @@ func (m *Portal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Extension == nil {
-				m.Extension = make(ProjectExtension)
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &PortalProject{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &PortalProject{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Extension[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8740,7 +17812,7 @@ func (m *Portal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PortalProject) Unmarshal(dAtA []byte) error {
+func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8763,15 +17835,15 @@ func (m *PortalProject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PortalProject: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PortalProject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8799,11 +17871,11 @@ func (m *PortalProject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
+			m.Finalizers = append(m.Finalizers, FinalizerName(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8831,61 +17903,107 @@ func (m *PortalProject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parent = string(dAtA[iNdEx:postIndex])
+			m.TenantID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Project) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentProjectName", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Project: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8902,23 +18020,119 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Clusters == nil {
+				m.Clusters = make(ClusterHard)
+			}
+			var mapkey string
+			mapvalue := &HardQuantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &HardQuantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Clusters[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaBorrowing", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8945,13 +18159,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.QuotaBorrowing == nil {
+				m.QuotaBorrowing = &QuotaBorrowing{}
+			}
+			if err := m.QuotaBorrowing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaAlert", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8978,63 +18195,16 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.QuotaAlert == nil {
+				m.QuotaAlert = &QuotaAlert{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.QuotaAlert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProjectList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateAlert", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9061,15 +18231,18 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CertificateAlert == nil {
+				m.CertificateAlert = &CertificateAlert{}
+			}
+			if err := m.CertificateAlert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9079,26 +18252,12 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, Project{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -9120,7 +18279,7 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
+func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9143,17 +18302,17 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalizers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9163,27 +18322,16 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Finalizers = append(m.Finalizers, FinalizerName(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.Locked = &b
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9211,13 +18359,13 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TenantID = string(dAtA[iNdEx:postIndex])
+			m.Phase = ProjectPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9227,27 +18375,124 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DisplayName = string(dAtA[iNdEx:postIndex])
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Clusters == nil {
+				m.Clusters = make(ClusterUsed)
+			}
+			var mapkey string
+			mapvalue := &UsedQuantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &UsedQuantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Clusters[mapkey] = *mapvalue
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CalculatedChildProjects", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9275,11 +18520,11 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			m.CalculatedChildProjects = append(m.CalculatedChildProjects, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentProjectName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CalculatedNamespaces", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9307,11 +18552,11 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentProjectName = string(dAtA[iNdEx:postIndex])
+			m.CalculatedNamespaces = append(m.CalculatedNamespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CachedSpecClusters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9338,8 +18583,8 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clusters == nil {
-				m.Clusters = make(ClusterHard)
+			if m.CachedSpecClusters == nil {
+				m.CachedSpecClusters = make(ClusterHard)
 			}
 			var mapkey string
 			mapvalue := &HardQuantity{}
@@ -9436,7 +18681,207 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Clusters[mapkey] = *mapvalue
+			m.CachedSpecClusters[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CachedParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.CachedParent = &s
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaAlerts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuotaAlerts = append(m.QuotaAlerts, QuotaAlertState{})
+			if err := m.QuotaAlerts[len(m.QuotaAlerts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freeze", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Freeze == nil {
+				m.Freeze = &ProjectFreezeStatus{}
+			}
+			if err := m.Freeze.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9459,7 +18904,7 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
+func (m *QuotaAlert) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9482,17 +18927,17 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuotaAlert: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuotaAlert: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9502,16 +18947,27 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Locked = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9539,11 +18995,11 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = ProjectPhase(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9570,14 +19026,96 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Clusters == nil {
-				m.Clusters = make(ClusterUsed)
+			m.Rules = append(m.Rules, QuotaAlertRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &UsedQuantity{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaAlertRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaAlertRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaAlertRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int32
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowGenerated
@@ -9587,43 +19125,51 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					wire |= uint64(b&0x7F) << shift
+					v |= int32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
+				m.Thresholds = append(m.Thresholds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
 					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
 					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
 					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Thresholds) == 0 {
+					m.Thresholds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -9633,46 +19179,101 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						v |= int32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &UsedQuantity{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+					m.Thresholds = append(m.Thresholds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thresholds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaAlertState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaAlertState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaAlertState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Clusters[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CalculatedChildProjects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9700,11 +19301,11 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CalculatedChildProjects = append(m.CalculatedChildProjects, string(dAtA[iNdEx:postIndex]))
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CalculatedNamespaces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9732,11 +19333,30 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CalculatedNamespaces = append(m.CalculatedNamespaces, string(dAtA[iNdEx:postIndex]))
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CachedSpecClusters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastNotifyTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9763,8 +19383,91 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CachedSpecClusters == nil {
-				m.CachedSpecClusters = make(ClusterHard)
+			if err := m.LastNotifyTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaBorrowing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaBorrowing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaBorrowing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Limits == nil {
+				m.Limits = make(ClusterHard)
 			}
 			var mapkey string
 			mapvalue := &HardQuantity{}
@@ -9861,137 +19564,7 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.CachedSpecClusters[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CachedParent", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.CachedParent = &s
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Limits[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -50,6 +50,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Project{},
 		&ProjectList{},
+		&ProjectQuotaTree{},
 
 		&Namespace{},
 		&NamespaceList{},
@@ -71,6 +72,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 
 		&NsEmigration{},
 		&NsEmigrationList{},
+		&NamespaceTemplate{},
+		&NamespaceTemplateList{},
+		&ProjectRequest{},
+		&ProjectRequestList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
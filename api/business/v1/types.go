@@ -19,6 +19,7 @@
 package v1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -72,6 +73,71 @@ type ProjectSpec struct {
 	// Clusters represents clusters that can be used and the resource limits of each cluster.
 	// +optional
 	Clusters ClusterHard `json:"clusters,omitempty" protobuf:"bytes,6,rep,name=clusters,casttype=ClusterHard"`
+	// QuotaBorrowing lets the project allocate the idle quota of the parent
+	// project beyond its own quota, borrowing is disabled if nil.
+	// +optional
+	QuotaBorrowing *QuotaBorrowing `json:"quotaBorrowing,omitempty" protobuf:"bytes,7,opt,name=quotaBorrowing"`
+	// QuotaAlert notifies the members of the project when the quota usage of
+	// the project or its namespaces crosses the thresholds.
+	// The usage of the project in a cluster is the quota allocated to its
+	// child projects and namespaces, not the resources used in them.
+	// +optional
+	QuotaAlert *QuotaAlert `json:"quotaAlert,omitempty" protobuf:"bytes,8,opt,name=quotaAlert"`
+	// CertificateAlert notifies the members of the project when the
+	// certificates of its namespaces fail to be renewed.
+	// +optional
+	CertificateAlert *CertificateAlert `json:"certificateAlert,omitempty" protobuf:"bytes,9,opt,name=certificateAlert"`
+	// Frozen scales the workloads of the namespaces of the project down to
+	// zero and stops new pods from running, they are restored once unfrozen.
+	// +optional
+	Frozen bool `json:"frozen,omitempty" protobuf:"varint,10,opt,name=frozen"`
+}
+
+// CertificateAlert represents where the alerts of the certificates of the
+// namespaces in a project are sent.
+type CertificateAlert struct {
+	// Channel is the name of the notify channel the alerts are sent through.
+	Channel string `json:"channel" protobuf:"bytes,1,opt,name=channel"`
+	// Template is the name of the message template in the channel.
+	Template string `json:"template" protobuf:"bytes,2,opt,name=template"`
+}
+
+// QuotaBorrowing is the borrowing of quota from the parent project.
+type QuotaBorrowing struct {
+	// Limits caps the quantity borrowed from the parent project per cluster
+	// and resource, the resources absent can not be borrowed.
+	Limits ClusterHard `json:"limits" protobuf:"bytes,1,rep,name=limits,casttype=ClusterHard"`
+}
+
+// QuotaAlert represents the quota usage alert rules of a project.
+type QuotaAlert struct {
+	// Channel is the name of the notify channel the alerts are sent through.
+	Channel string `json:"channel" protobuf:"bytes,1,opt,name=channel"`
+	// Template is the name of the message template in the channel.
+	Template string           `json:"template" protobuf:"bytes,2,opt,name=template"`
+	Rules    []QuotaAlertRule `json:"rules" protobuf:"bytes,3,rep,name=rules"`
+}
+
+// QuotaAlertRule represents the alert thresholds of a resource.
+type QuotaAlertRule struct {
+	// Resource is the name of the resource, such as requests.cpu, limits.memory or pods.
+	Resource string `json:"resource" protobuf:"bytes,1,opt,name=resource"`
+	// Thresholds are the percentages of the quota to alert at, such as 80 and 95.
+	Thresholds []int32 `json:"thresholds" protobuf:"varint,2,rep,name=thresholds"`
+}
+
+// QuotaAlertState represents the highest threshold alerted of a resource in
+// a cluster of the project or a namespace of the project.
+type QuotaAlertState struct {
+	ClusterName string `json:"clusterName" protobuf:"bytes,1,opt,name=clusterName"`
+	// Namespace is the name of the namespace, empty for the project.
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	Resource  string `json:"resource" protobuf:"bytes,3,opt,name=resource"`
+	Threshold int32  `json:"threshold" protobuf:"varint,4,opt,name=threshold"`
+	// The last time the alert was sent.
+	// +optional
+	LastNotifyTime metav1.Time `json:"lastNotifyTime,omitempty" protobuf:"bytes,5,opt,name=lastNotifyTime"`
 }
 
 // ProjectStatus represents information about the status of a project.
@@ -100,6 +166,69 @@ type ProjectStatus struct {
 	// A human readable message indicating details about the transition.
 	// +optional
 	Message string `json:"message,omitempty" protobuf:"bytes,10,opt,name=message"`
+	// QuotaAlerts represents the alerts sent for the quota usage that is
+	// still above the thresholds.
+	// +optional
+	QuotaAlerts []QuotaAlertState `json:"quotaAlerts,omitempty" protobuf:"bytes,11,rep,name=quotaAlerts"`
+	// Freeze represents the progress of freezing or unfreezing the project,
+	// nil if the project is not frozen.
+	// +optional
+	Freeze *ProjectFreezeStatus `json:"freeze,omitempty" protobuf:"bytes,12,opt,name=freeze"`
+}
+
+// ProjectFreezeStatus represents the state of the project saved when it was
+// frozen, which is restored when it is unfrozen.
+type ProjectFreezeStatus struct {
+	// +optional
+	Phase ProjectFreezePhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=ProjectFreezePhase"`
+	// Locked is the lock of the project before it was frozen.
+	// +optional
+	Locked *bool `json:"locked,omitempty" protobuf:"varint,2,opt,name=locked"`
+	// Namespaces represents the namespaces of the project that have been frozen.
+	// +optional
+	Namespaces []FrozenNamespace `json:"namespaces,omitempty" protobuf:"bytes,3,rep,name=namespaces"`
+	// The last time the freeze transitioned from one phase to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,4,opt,name=lastTransitionTime"`
+	// A human readable message indicating details about the last failure.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+}
+
+// ProjectFreezePhase defines the phase of freezing a project.
+type ProjectFreezePhase string
+
+const (
+	// ProjectFreezing indicates the workloads of the project are being scaled down.
+	ProjectFreezing ProjectFreezePhase = "Freezing"
+	// ProjectFrozen indicates the workloads of the project have been scaled down.
+	ProjectFrozen ProjectFreezePhase = "Frozen"
+	// ProjectUnfreezing indicates the workloads of the project are being restored.
+	ProjectUnfreezing ProjectFreezePhase = "Unfreezing"
+)
+
+// FrozenNamespace represents the workloads of a namespace scaled down when
+// the project was frozen.
+type FrozenNamespace struct {
+	// Name is the name of the namespace object in the project.
+	Name        string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	Namespace   string `json:"namespace" protobuf:"bytes,3,opt,name=namespace"`
+	// Workloads represents the replicas of the workloads before they were scaled down.
+	// +optional
+	Workloads []FrozenWorkload `json:"workloads,omitempty" protobuf:"bytes,4,rep,name=workloads"`
+	// Frozen indicates all the workloads of the namespace have been scaled down.
+	// +optional
+	Frozen bool `json:"frozen,omitempty" protobuf:"varint,5,opt,name=frozen"`
+}
+
+// FrozenWorkload represents the replicas of a workload before it was scaled down.
+type FrozenWorkload struct {
+	// Kind is the kind of the workload, Deployment, StatefulSet, DaemonSet, Job
+	// or CronJob, the replicas of a Job are its parallelism.
+	Kind     string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	Name     string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	Replicas int32  `json:"replicas" protobuf:"varint,3,opt,name=replicas"`
 }
 
 // ProjectPhase defines the phase of project constructor.
@@ -150,7 +279,55 @@ type UsedQuantity struct {
 type ClusterUsed map[string]UsedQuantity
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
-// NamespaceCertOptions is query options of getting namespace with a x509 certificate.
+
+// ProjectQuotaTree shows the quota allocated and used at every level of the
+// tree of a project.
+type ProjectQuotaTree struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Root is the project the tree is requested for.
+	Root ProjectQuotaNode `json:"root" protobuf:"bytes,2,opt,name=root"`
+}
+
+// ProjectQuotaNode is the quota of a project in the quota tree.
+type ProjectQuotaNode struct {
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// +optional
+	DisplayName string `json:"displayName,omitempty" protobuf:"bytes,2,opt,name=displayName"`
+	// Hard is the quota of the project.
+	// +optional
+	Hard ClusterHard `json:"hard,omitempty" protobuf:"bytes,3,rep,name=hard,casttype=ClusterHard"`
+	// Allocated is the quota allocated to the child projects and namespaces.
+	// +optional
+	Allocated ClusterUsed `json:"allocated,omitempty" protobuf:"bytes,4,rep,name=allocated,casttype=ClusterUsed"`
+	// Borrowed is the quota allocated beyond the quota of the project, which
+	// is borrowed from the parent project.
+	// +optional
+	Borrowed ClusterUsed `json:"borrowed,omitempty" protobuf:"bytes,5,rep,name=borrowed,casttype=ClusterUsed"`
+	// Used is the quota used by the namespaces of the project and of all its
+	// descendants.
+	// +optional
+	Used ClusterUsed `json:"used,omitempty" protobuf:"bytes,6,rep,name=used,casttype=ClusterUsed"`
+	// +optional
+	Namespaces []NamespaceQuotaNode `json:"namespaces,omitempty" protobuf:"bytes,7,rep,name=namespaces"`
+	// +optional
+	Children []ProjectQuotaNode `json:"children,omitempty" protobuf:"bytes,8,rep,name=children"`
+}
+
+// NamespaceQuotaNode is the quota of a namespace in the quota tree.
+type NamespaceQuotaNode struct {
+	Name        string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	// +optional
+	Hard ResourceList `json:"hard,omitempty" protobuf:"bytes,3,rep,name=hard,casttype=ResourceList"`
+	// +optional
+	Used ResourceList `json:"used,omitempty" protobuf:"bytes,4,rep,name=used,casttype=ResourceList"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// NamespaceCertOptions is the options of issuing a x509 certificate of a namespace.
 type NamespaceCertOptions struct {
 	metav1.TypeMeta `json:",inline"`
 
@@ -200,6 +377,11 @@ type NamespaceSpec struct {
 	// Hard represents the total resources of a namespace.
 	// +optional
 	Hard ResourceList `json:"hard,omitempty" protobuf:"bytes,5,rep,name=hard,casttype=ResourceList"`
+	// RevokedCertificates are the serial numbers of the certificates of the
+	// namespace to revoke, the revoked certificates are no longer renewed and
+	// expire within a day.
+	// +optional
+	RevokedCertificates []string `json:"revokedCertificates,omitempty" protobuf:"bytes,9,rep,name=revokedCertificates"`
 }
 
 // NamespaceStatus represents information about the status of a namespace in project.
@@ -224,8 +406,87 @@ type NamespaceStatus struct {
 	CachedSpecHard ResourceList `json:"cachedSpecHard,omitempty" protobuf:"bytes,7,rep,name=cachedSpecHard,casttype=ResourceList"`
 	// +optional
 	Certificate *NamespaceCert `json:"certificate,omitempty" protobuf:"bytes,8,name=certificate,casttype=NamespaceCert"`
+	// Templates represents the sync status of the namespace templates of the
+	// project in the namespace.
+	// +optional
+	Templates []NamespaceTemplateSync `json:"templates,omitempty" protobuf:"bytes,9,rep,name=templates"`
+	// Certificates represents the short-lived x509 certificates issued for
+	// the users of the namespace, which are renewed before they expire.
+	// +optional
+	Certificates []NamespaceCertStatus `json:"certificates,omitempty" protobuf:"bytes,10,rep,name=certificates"`
+}
+
+// NamespaceCertStatus represents a x509 certificate issued for a user of a namespace.
+type NamespaceCertStatus struct {
+	Username     string `json:"username" protobuf:"bytes,1,opt,name=username"`
+	SerialNumber string `json:"serialNumber" protobuf:"bytes,2,opt,name=serialNumber"`
+	// ValidDays is how many days after the key was issued the certificate is
+	// renewed with the same key.
+	ValidDays int32 `json:"validDays" protobuf:"varint,3,opt,name=validDays"`
+	// +optional
+	NotBefore metav1.Time `json:"notBefore,omitempty" protobuf:"bytes,4,opt,name=notBefore"`
+	// NotAfter is the time the certificate expires.
+	// +optional
+	NotAfter metav1.Time `json:"notAfter,omitempty" protobuf:"bytes,5,opt,name=notAfter"`
+	// +optional
+	Phase NamespaceCertPhase `json:"phase,omitempty" protobuf:"bytes,6,opt,name=phase,casttype=NamespaceCertPhase"`
+	// CertPem is the certificate, the private key is only returned when the
+	// certificate is issued and is never stored.
+	// +optional
+	CertPem []byte `json:"certPem,omitempty" protobuf:"bytes,7,opt,name=certPem"`
+	// A human readable message indicating why the certificate failed to be renewed.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,9,opt,name=message"`
+	// The last time the failure to renew the certificate was notified.
+	// +optional
+	LastNotifyTime metav1.Time `json:"lastNotifyTime,omitempty" protobuf:"bytes,10,opt,name=lastNotifyTime"`
+	// RenewUntil is the time the certificate stops being renewed.
+	// +optional
+	RenewUntil metav1.Time `json:"renewUntil,omitempty" protobuf:"bytes,11,opt,name=renewUntil"`
+}
+
+// NamespaceCertPhase indicates the status of a certificate of a namespace.
+type NamespaceCertPhase string
+
+// These are valid status of the certificates of a namespace.
+const (
+	// NamespaceCertActive indicates the certificate is the one in use by the user.
+	NamespaceCertActive NamespaceCertPhase = "Active"
+	// NamespaceCertSuperseded indicates the certificate has been renewed, it
+	// is kept valid until it expires so that the user can switch to the new one.
+	NamespaceCertSuperseded NamespaceCertPhase = "Superseded"
+	// NamespaceCertRevoked indicates the certificate has been revoked.
+	NamespaceCertRevoked NamespaceCertPhase = "Revoked"
+)
+
+// NamespaceTemplateSync represents the sync status of a namespace template in a namespace.
+type NamespaceTemplateSync struct {
+	// Name is the name of the namespace template.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ObservedGeneration is the generation of the namespace template last applied.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,2,opt,name=observedGeneration"`
+	// +optional
+	Phase NamespaceTemplateSyncPhase `json:"phase,omitempty" protobuf:"bytes,3,opt,name=phase,casttype=NamespaceTemplateSyncPhase"`
+	// The last time the namespace template was applied.
+	// +optional
+	LastSyncTime metav1.Time `json:"lastSyncTime,omitempty" protobuf:"bytes,4,opt,name=lastSyncTime"`
+	// A human readable message indicating why the namespace template failed to be applied.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
 }
 
+// NamespaceTemplateSyncPhase indicates the sync status of a namespace template in a namespace.
+type NamespaceTemplateSyncPhase string
+
+// These are valid sync status of namespace templates.
+const (
+	// NamespaceTemplateSynced indicates the namespace template has been applied to the namespace.
+	NamespaceTemplateSynced NamespaceTemplateSyncPhase = "Synced"
+	// NamespaceTemplateSyncFailed indicates the namespace template failed to be applied to the namespace.
+	NamespaceTemplateSyncFailed NamespaceTemplateSyncPhase = "Failed"
+)
+
 // NamespaceCert represents a x509 certificate of a namespace in project.
 type NamespaceCert struct {
 	// +optional
@@ -236,6 +497,11 @@ type NamespaceCert struct {
 	CACertPem []byte `json:"caCertPem,omitempty" protobuf:"bytes,3,rep,name=caCertPem"`
 	// +optional
 	APIServer string `json:"apiServer,omitempty" protobuf:"bytes,4,rep,name=apiServer"`
+	// +optional
+	SerialNumber string `json:"serialNumber,omitempty" protobuf:"bytes,5,opt,name=serialNumber"`
+	// NotAfter is the time the certificate expires.
+	// +optional
+	NotAfter metav1.Time `json:"notAfter,omitempty" protobuf:"bytes,6,opt,name=notAfter"`
 }
 
 // NamespacePhase indicates the status of namespace in project.
@@ -545,8 +811,35 @@ type NsEmigrationSpec struct {
 	Namespace   string `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
 	NsShowName  string `json:"nsShowName" protobuf:"bytes,3,opt,name=nsShowName"`
 	Destination string `json:"destination" protobuf:"bytes,4,opt,name=destination"`
+	// Mode is Project to move the namespace to another project, or Cluster to
+	// move the workloads of the namespace to another cluster in the project.
+	// +optional
+	Mode NsEmigrationMode `json:"mode,omitempty" protobuf:"bytes,5,opt,name=mode,casttype=NsEmigrationMode"`
+	// DestinationCluster is the cluster the workloads are moved to in Cluster mode.
+	// +optional
+	DestinationCluster string `json:"destinationCluster,omitempty" protobuf:"bytes,6,opt,name=destinationCluster"`
+	// TransferQuota moves the quota of the namespace in the project from the
+	// source cluster to the destination cluster in Cluster mode, it requires
+	// CutOver.
+	// +optional
+	TransferQuota bool `json:"transferQuota,omitempty" protobuf:"varint,7,opt,name=transferQuota"`
+	// CutOver scales down the workloads in the source cluster and releases the
+	// source namespace after the workloads are moved in Cluster mode.
+	// +optional
+	CutOver bool `json:"cutOver,omitempty" protobuf:"varint,8,opt,name=cutOver"`
 }
 
+// NsEmigrationMode indicates what a namespace emigration moves.
+type NsEmigrationMode string
+
+const (
+	// NsEmigrationModeProject moves the namespace to another project.
+	NsEmigrationModeProject NsEmigrationMode = "Project"
+	// NsEmigrationModeCluster moves the workloads of the namespace to another
+	// cluster in the same project.
+	NsEmigrationModeCluster NsEmigrationMode = "Cluster"
+)
+
 // NsEmigrationStatus represents information about the status of a namespace emigration.
 type NsEmigrationStatus struct {
 	// +optional
@@ -560,6 +853,15 @@ type NsEmigrationStatus struct {
 	// A human readable message indicating details about the transition.
 	// +optional
 	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// SourceCluster is the cluster the workloads are moved from in Cluster mode.
+	// +optional
+	SourceCluster string `json:"sourceCluster,omitempty" protobuf:"bytes,5,opt,name=sourceCluster"`
+	// TransferredQuota is the quota added to the destination cluster of the
+	// project, recorded before it is added. It is released from the source
+	// cluster once the emigration finishes, or rolled back from the
+	// destination cluster if the emigration fails.
+	// +optional
+	TransferredQuota ResourceList `json:"transferredQuota,omitempty" protobuf:"bytes,6,rep,name=transferredQuota,casttype=ResourceList"`
 }
 
 // NsEmigrationPhase indicates the phase of namespace emigrations.
@@ -577,8 +879,219 @@ const (
 	NsEmigrationNewOneCreated NsEmigrationPhase = "NewOneCreated"
 	// NsEmigrationOldOneTerminating indicates that old namespace is terminating.
 	NsEmigrationOldOneTerminating NsEmigrationPhase = "OldOneTerminating"
+	// NsEmigrationResourcesMigrated indicates that the resources of the namespace
+	// have been applied to the destination cluster.
+	NsEmigrationResourcesMigrated NsEmigrationPhase = "ResourcesMigrated"
+	// NsEmigrationOldOneScaledDown indicates that the workloads of the namespace
+	// in the source cluster have been scaled down.
+	NsEmigrationOldOneScaledDown NsEmigrationPhase = "OldOneScaledDown"
 	// NsEmigrationFinished indicates that the emigration finished.
 	NsEmigrationFinished NsEmigrationPhase = "Finished"
 	// NsEmigrationFailed indicates that the emigration failed.
 	NsEmigrationFailed NsEmigrationPhase = "Failed"
 )
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceTemplate is the default policies rendered into every namespace of a project.
+type NamespaceTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the policies rendered into the namespaces.
+	// +optional
+	Spec NamespaceTemplateSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceTemplateList is the whole list of all namespace templates which owned by a project.
+type NamespaceTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of namespace templates
+	Items []NamespaceTemplate `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// NamespaceTemplateSpec represents the policies rendered into the namespaces of a project.
+type NamespaceTemplateSpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	// +optional
+	DisplayName string `json:"displayName,omitempty" protobuf:"bytes,2,opt,name=displayName"`
+	// Labels are added to the namespaces on the cluster.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,3,rep,name=labels"`
+	// Annotations are added to the namespaces on the cluster.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,4,rep,name=annotations"`
+	// LimitRanges are created as a LimitRange named after the template.
+	// +optional
+	LimitRanges []corev1.LimitRangeItem `json:"limitRanges,omitempty" protobuf:"bytes,5,rep,name=limitRanges"`
+	// NetworkPolicy creates a default-deny NetworkPolicy named after the template.
+	// +optional
+	NetworkPolicy *NamespaceTemplateNetworkPolicy `json:"networkPolicy,omitempty" protobuf:"bytes,6,opt,name=networkPolicy"`
+	// RoleBindings are created with names prefixed by the name of the template.
+	// +optional
+	RoleBindings []NamespaceTemplateRoleBinding `json:"roleBindings,omitempty" protobuf:"bytes,7,rep,name=roleBindings"`
+	// PodSecurity sets the Pod Security admission levels of the namespaces.
+	// +optional
+	PodSecurity *NamespaceTemplatePodSecurity `json:"podSecurity,omitempty" protobuf:"bytes,8,opt,name=podSecurity"`
+}
+
+// NamespaceTemplateNetworkPolicy represents the default-deny network policy of the namespaces.
+type NamespaceTemplateNetworkPolicy struct {
+	// DenyIngress denies all ingress traffic of the pods by default.
+	// +optional
+	DenyIngress bool `json:"denyIngress,omitempty" protobuf:"varint,1,opt,name=denyIngress"`
+	// DenyEgress denies all egress traffic of the pods by default.
+	// +optional
+	DenyEgress bool `json:"denyEgress,omitempty" protobuf:"varint,2,opt,name=denyEgress"`
+}
+
+// NamespaceTemplateRoleBinding represents a role binding in the namespaces.
+type NamespaceTemplateRoleBinding struct {
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ClusterRole is the name of the cluster role bound in the namespaces, one of admin, edit and view.
+	ClusterRole string `json:"clusterRole" protobuf:"bytes,2,opt,name=clusterRole"`
+	// +optional
+	Subjects []NamespaceTemplateSubject `json:"subjects,omitempty" protobuf:"bytes,3,rep,name=subjects"`
+}
+
+// NamespaceTemplateSubject represents a subject of a role binding.
+type NamespaceTemplateSubject struct {
+	// Kind is one of User, Group and ServiceAccount.
+	Kind string `json:"kind" protobuf:"bytes,1,opt,name=kind"`
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// Namespace must be empty, service accounts are always bound in the namespace of the role binding.
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+}
+
+// NamespaceTemplatePodSecurity represents the Pod Security admission levels of the namespaces.
+type NamespaceTemplatePodSecurity struct {
+	// Enforce is one of privileged, baseline and restricted, only the platform administrators may set privileged.
+	// +optional
+	Enforce string `json:"enforce,omitempty" protobuf:"bytes,1,opt,name=enforce"`
+	// +optional
+	Audit string `json:"audit,omitempty" protobuf:"bytes,2,opt,name=audit"`
+	// +optional
+	Warn string `json:"warn,omitempty" protobuf:"bytes,3,opt,name=warn"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectRequest is a request of a user for a new project, or for more quota
+// of an existing project, which is approved or rejected by the administrators.
+type ProjectRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// +optional
+	Spec ProjectRequestSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status ProjectRequestStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectRequestList is the whole list of all project requests.
+type ProjectRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of project requests
+	Items []ProjectRequest `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ProjectRequestSpec is a description of a project request.
+type ProjectRequestSpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	// Requester is the name of the user who made the request, it is set by the server.
+	// +optional
+	Requester string             `json:"requester,omitempty" protobuf:"bytes,2,opt,name=requester"`
+	Type      ProjectRequestType `json:"type" protobuf:"bytes,3,opt,name=type,casttype=ProjectRequestType"`
+	// ProjectName is the name of the project to raise the quota of in Quota requests.
+	// +optional
+	ProjectName string `json:"projectName,omitempty" protobuf:"bytes,4,opt,name=projectName"`
+	// DisplayName is the display name of the project created in Create requests.
+	// +optional
+	DisplayName string `json:"displayName,omitempty" protobuf:"bytes,5,opt,name=displayName"`
+	// ParentProjectName is the parent of the project created in Create requests.
+	// +optional
+	ParentProjectName string `json:"parentProjectName,omitempty" protobuf:"bytes,6,opt,name=parentProjectName"`
+	// Clusters is the quota of the project created in Create requests, or the
+	// quota added to the project in Quota requests.
+	// +optional
+	Clusters ClusterHard `json:"clusters,omitempty" protobuf:"bytes,7,rep,name=clusters,casttype=ClusterHard"`
+	// Justification tells the administrators why the project or the quota is needed.
+	Justification string `json:"justification" protobuf:"bytes,8,opt,name=justification"`
+	// Channel is the name of the notify channel the requester and the
+	// administrators are informed through, nobody is informed if empty.
+	// +optional
+	Channel string `json:"channel,omitempty" protobuf:"bytes,9,opt,name=channel"`
+	// Template is the name of the message template in the channel.
+	// +optional
+	Template string `json:"template,omitempty" protobuf:"bytes,10,opt,name=template"`
+}
+
+// ProjectRequestStatus represents information about the status of a project request.
+type ProjectRequestStatus struct {
+	// +optional
+	Phase ProjectRequestPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=ProjectRequestPhase"`
+	// Approver is the name of the administrator who approved or rejected the request.
+	// +optional
+	Approver string `json:"approver,omitempty" protobuf:"bytes,2,opt,name=approver"`
+	// ProjectName is the name of the project created or updated by the request.
+	// +optional
+	ProjectName string `json:"projectName,omitempty" protobuf:"bytes,3,opt,name=projectName"`
+	// NotifiedPhase is the last phase the requester and the administrators
+	// were informed of.
+	// +optional
+	NotifiedPhase ProjectRequestPhase `json:"notifiedPhase,omitempty" protobuf:"bytes,4,opt,name=notifiedPhase,casttype=ProjectRequestPhase"`
+	// The last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,5,opt,name=lastTransitionTime"`
+	// The reason for the condition's last transition, such as the comment of the approver.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,6,opt,name=reason"`
+	// A human readable message indicating details about the transition.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,7,opt,name=message"`
+}
+
+// ProjectRequestType indicates what a project request asks for.
+type ProjectRequestType string
+
+const (
+	// ProjectRequestCreate asks for a new project.
+	ProjectRequestCreate ProjectRequestType = "Create"
+	// ProjectRequestQuota asks for more quota of an existing project.
+	ProjectRequestQuota ProjectRequestType = "Quota"
+)
+
+// ProjectRequestPhase indicates the phase of a project request.
+type ProjectRequestPhase string
+
+// These are valid phases of project requests.
+const (
+	// ProjectRequestPending indicates that the request is waiting for the administrators.
+	ProjectRequestPending ProjectRequestPhase = "Pending"
+	// ProjectRequestApproved indicates that the request is approved and being carried out.
+	ProjectRequestApproved ProjectRequestPhase = "Approved"
+	// ProjectRequestRejected indicates that the request is rejected.
+	ProjectRequestRejected ProjectRequestPhase = "Rejected"
+	// ProjectRequestCompleted indicates that the project has been created or updated.
+	ProjectRequestCompleted ProjectRequestPhase = "Completed"
+	// ProjectRequestFailed indicates that the project failed to be created or updated.
+	ProjectRequestFailed ProjectRequestPhase = "Failed"
+)
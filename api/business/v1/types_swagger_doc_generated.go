@@ -29,6 +29,16 @@ package v1
 // Those methods can be generated by using hack/update-generated-swagger-docs.sh
 
 // AUTO-GENERATED FUNCTIONS START HERE. DO NOT EDIT.
+var map_CertificateAlert = map[string]string{
+	"":         "CertificateAlert represents where the alerts of the certificates of the namespaces in a project are sent.",
+	"channel":  "Channel is the name of the notify channel the alerts are sent through.",
+	"template": "Template is the name of the message template in the channel.",
+}
+
+func (CertificateAlert) SwaggerDoc() map[string]string {
+	return map_CertificateAlert
+}
+
 var map_ChartGroup = map[string]string{
 	"":     "ChartGroup is an chart group.",
 	"spec": "Spec defines the desired identities of namespaces in this set.",
@@ -86,6 +96,26 @@ func (ConfigMapList) SwaggerDoc() map[string]string {
 	return map_ConfigMapList
 }
 
+var map_FrozenNamespace = map[string]string{
+	"":          "FrozenNamespace represents the workloads of a namespace scaled down when the project was frozen.",
+	"name":      "Name is the name of the namespace object in the project.",
+	"workloads": "Workloads represents the replicas of the workloads before they were scaled down.",
+	"frozen":    "Frozen indicates all the workloads of the namespace have been scaled down.",
+}
+
+func (FrozenNamespace) SwaggerDoc() map[string]string {
+	return map_FrozenNamespace
+}
+
+var map_FrozenWorkload = map[string]string{
+	"":     "FrozenWorkload represents the replicas of a workload before it was scaled down.",
+	"kind": "Kind is the kind of the workload, Deployment, StatefulSet, DaemonSet, Job or CronJob, the replicas of a Job are its parallelism.",
+}
+
+func (FrozenWorkload) SwaggerDoc() map[string]string {
+	return map_FrozenWorkload
+}
+
 var map_HardQuantity = map[string]string{
 	"": "HardQuantity is a straightforward wrapper of ResourceList.",
 }
@@ -142,7 +172,8 @@ func (Namespace) SwaggerDoc() map[string]string {
 }
 
 var map_NamespaceCert = map[string]string{
-	"": "NamespaceCert represents a x509 certificate of a namespace in project.",
+	"":         "NamespaceCert represents a x509 certificate of a namespace in project.",
+	"notAfter": "NotAfter is the time the certificate expires.",
 }
 
 func (NamespaceCert) SwaggerDoc() map[string]string {
@@ -150,7 +181,7 @@ func (NamespaceCert) SwaggerDoc() map[string]string {
 }
 
 var map_NamespaceCertOptions = map[string]string{
-	"":          "NamespaceCertOptions is query options of getting namespace with a x509 certificate.",
+	"":          "NamespaceCertOptions is the options of issuing a x509 certificate of a namespace.",
 	"validDays": "Pay attention to const CertOptionValiddays!",
 }
 
@@ -158,6 +189,20 @@ func (NamespaceCertOptions) SwaggerDoc() map[string]string {
 	return map_NamespaceCertOptions
 }
 
+var map_NamespaceCertStatus = map[string]string{
+	"":               "NamespaceCertStatus represents a x509 certificate issued for a user of a namespace.",
+	"validDays":      "ValidDays is how many days after the key was issued the certificate is renewed with the same key.",
+	"notAfter":       "NotAfter is the time the certificate expires.",
+	"certPem":        "CertPem is the certificate, the private key is only returned when the certificate is issued and is never stored.",
+	"message":        "A human readable message indicating why the certificate failed to be renewed.",
+	"lastNotifyTime": "The last time the failure to renew the certificate was notified.",
+	"renewUntil":     "RenewUntil is the time the certificate stops being renewed.",
+}
+
+func (NamespaceCertStatus) SwaggerDoc() map[string]string {
+	return map_NamespaceCertStatus
+}
+
 var map_NamespaceList = map[string]string{
 	"":      "NamespaceList is the whole list of all namespaces which owned by a tenant.",
 	"items": "List of namespaces",
@@ -167,10 +212,19 @@ func (NamespaceList) SwaggerDoc() map[string]string {
 	return map_NamespaceList
 }
 
+var map_NamespaceQuotaNode = map[string]string{
+	"": "NamespaceQuotaNode is the quota of a namespace in the quota tree.",
+}
+
+func (NamespaceQuotaNode) SwaggerDoc() map[string]string {
+	return map_NamespaceQuotaNode
+}
+
 var map_NamespaceSpec = map[string]string{
-	"":           "NamespaceSpec represents a namespace in cluster of a project.",
-	"finalizers": "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
-	"hard":       "Hard represents the total resources of a namespace.",
+	"":                    "NamespaceSpec represents a namespace in cluster of a project.",
+	"finalizers":          "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+	"hard":                "Hard represents the total resources of a namespace.",
+	"revokedCertificates": "RevokedCertificates are the serial numbers of the certificates of the namespace to revoke, the revoked certificates are no longer renewed and expire within a day.",
 }
 
 func (NamespaceSpec) SwaggerDoc() map[string]string {
@@ -183,12 +237,96 @@ var map_NamespaceStatus = map[string]string{
 	"reason":             "The reason for the condition's last transition.",
 	"message":            "A human readable message indicating details about the transition.",
 	"used":               "Used represents the resources of a namespace that are used.",
+	"templates":          "Templates represents the sync status of the namespace templates of the project in the namespace.",
+	"certificates":       "Certificates represents the short-lived x509 certificates issued for the users of the namespace, which are renewed before they expire.",
 }
 
 func (NamespaceStatus) SwaggerDoc() map[string]string {
 	return map_NamespaceStatus
 }
 
+var map_NamespaceTemplate = map[string]string{
+	"":     "NamespaceTemplate is the default policies rendered into every namespace of a project.",
+	"spec": "Spec defines the policies rendered into the namespaces.",
+}
+
+func (NamespaceTemplate) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplate
+}
+
+var map_NamespaceTemplateList = map[string]string{
+	"":      "NamespaceTemplateList is the whole list of all namespace templates which owned by a project.",
+	"items": "List of namespace templates",
+}
+
+func (NamespaceTemplateList) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplateList
+}
+
+var map_NamespaceTemplateNetworkPolicy = map[string]string{
+	"":            "NamespaceTemplateNetworkPolicy represents the default-deny network policy of the namespaces.",
+	"denyIngress": "DenyIngress denies all ingress traffic of the pods by default.",
+	"denyEgress":  "DenyEgress denies all egress traffic of the pods by default.",
+}
+
+func (NamespaceTemplateNetworkPolicy) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplateNetworkPolicy
+}
+
+var map_NamespaceTemplatePodSecurity = map[string]string{
+	"":        "NamespaceTemplatePodSecurity represents the Pod Security admission levels of the namespaces.",
+	"enforce": "Enforce is one of privileged, baseline and restricted, only the platform administrators may set privileged.",
+}
+
+func (NamespaceTemplatePodSecurity) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplatePodSecurity
+}
+
+var map_NamespaceTemplateRoleBinding = map[string]string{
+	"":            "NamespaceTemplateRoleBinding represents a role binding in the namespaces.",
+	"clusterRole": "ClusterRole is the name of the cluster role bound in the namespaces, one of admin, edit and view.",
+}
+
+func (NamespaceTemplateRoleBinding) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplateRoleBinding
+}
+
+var map_NamespaceTemplateSpec = map[string]string{
+	"":              "NamespaceTemplateSpec represents the policies rendered into the namespaces of a project.",
+	"labels":        "Labels are added to the namespaces on the cluster.",
+	"annotations":   "Annotations are added to the namespaces on the cluster.",
+	"limitRanges":   "LimitRanges are created as a LimitRange named after the template.",
+	"networkPolicy": "NetworkPolicy creates a default-deny NetworkPolicy named after the template.",
+	"roleBindings":  "RoleBindings are created with names prefixed by the name of the template.",
+	"podSecurity":   "PodSecurity sets the Pod Security admission levels of the namespaces.",
+}
+
+func (NamespaceTemplateSpec) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplateSpec
+}
+
+var map_NamespaceTemplateSubject = map[string]string{
+	"":          "NamespaceTemplateSubject represents a subject of a role binding.",
+	"kind":      "Kind is one of User, Group and ServiceAccount.",
+	"namespace": "Namespace must be empty, service accounts are always bound in the namespace of the role binding.",
+}
+
+func (NamespaceTemplateSubject) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplateSubject
+}
+
+var map_NamespaceTemplateSync = map[string]string{
+	"":                   "NamespaceTemplateSync represents the sync status of a namespace template in a namespace.",
+	"name":               "Name is the name of the namespace template.",
+	"observedGeneration": "ObservedGeneration is the generation of the namespace template last applied.",
+	"lastSyncTime":       "The last time the namespace template was applied.",
+	"message":            "A human readable message indicating why the namespace template failed to be applied.",
+}
+
+func (NamespaceTemplateSync) SwaggerDoc() map[string]string {
+	return map_NamespaceTemplateSync
+}
+
 var map_NsEmigration = map[string]string{
 	"":     "NsEmigration is an namespace emigration.",
 	"spec": "Spec defines the desired identities of emigrations in this set.",
@@ -208,7 +346,11 @@ func (NsEmigrationList) SwaggerDoc() map[string]string {
 }
 
 var map_NsEmigrationSpec = map[string]string{
-	"": "NsEmigrationSpec represents a namespace emigration.",
+	"":                   "NsEmigrationSpec represents a namespace emigration.",
+	"mode":               "Mode is Project to move the namespace to another project, or Cluster to move the workloads of the namespace to another cluster in the project.",
+	"destinationCluster": "DestinationCluster is the cluster the workloads are moved to in Cluster mode.",
+	"transferQuota":      "TransferQuota moves the quota of the namespace in the project from the source cluster to the destination cluster in Cluster mode, it requires CutOver.",
+	"cutOver":            "CutOver scales down the workloads in the source cluster and releases the source namespace after the workloads are moved in Cluster mode.",
 }
 
 func (NsEmigrationSpec) SwaggerDoc() map[string]string {
@@ -220,6 +362,8 @@ var map_NsEmigrationStatus = map[string]string{
 	"lastTransitionTime": "The last time the condition transitioned from one status to another.",
 	"reason":             "The reason for the condition's last transition.",
 	"message":            "A human readable message indicating details about the transition.",
+	"sourceCluster":      "SourceCluster is the cluster the workloads are moved from in Cluster mode.",
+	"transferredQuota":   "TransferredQuota is the quota added to the destination cluster of the project, recorded before it is added. It is released from the source cluster once the emigration finishes, or rolled back from the destination cluster if the emigration fails.",
 }
 
 func (NsEmigrationStatus) SwaggerDoc() map[string]string {
@@ -282,6 +426,18 @@ func (Project) SwaggerDoc() map[string]string {
 	return map_Project
 }
 
+var map_ProjectFreezeStatus = map[string]string{
+	"":                   "ProjectFreezeStatus represents the state of the project saved when it was frozen, which is restored when it is unfrozen.",
+	"locked":             "Locked is the lock of the project before it was frozen.",
+	"namespaces":         "Namespaces represents the namespaces of the project that have been frozen.",
+	"lastTransitionTime": "The last time the freeze transitioned from one phase to another.",
+	"message":            "A human readable message indicating details about the last failure.",
+}
+
+func (ProjectFreezeStatus) SwaggerDoc() map[string]string {
+	return map_ProjectFreezeStatus
+}
+
 var map_ProjectList = map[string]string{
 	"":      "ProjectList is the whole list of all projects which owned by a tenant.",
 	"items": "List of projects",
@@ -291,12 +447,84 @@ func (ProjectList) SwaggerDoc() map[string]string {
 	return map_ProjectList
 }
 
+var map_ProjectQuotaNode = map[string]string{
+	"":          "ProjectQuotaNode is the quota of a project in the quota tree.",
+	"hard":      "Hard is the quota of the project.",
+	"allocated": "Allocated is the quota allocated to the child projects and namespaces.",
+	"borrowed":  "Borrowed is the quota allocated beyond the quota of the project, which is borrowed from the parent project.",
+	"used":      "Used is the quota used by the namespaces of the project and of all its descendants.",
+}
+
+func (ProjectQuotaNode) SwaggerDoc() map[string]string {
+	return map_ProjectQuotaNode
+}
+
+var map_ProjectQuotaTree = map[string]string{
+	"":     "ProjectQuotaTree shows the quota allocated and used at every level of the tree of a project.",
+	"root": "Root is the project the tree is requested for.",
+}
+
+func (ProjectQuotaTree) SwaggerDoc() map[string]string {
+	return map_ProjectQuotaTree
+}
+
+var map_ProjectRequest = map[string]string{
+	"": "ProjectRequest is a request of a user for a new project, or for more quota of an existing project, which is approved or rejected by the administrators.",
+}
+
+func (ProjectRequest) SwaggerDoc() map[string]string {
+	return map_ProjectRequest
+}
+
+var map_ProjectRequestList = map[string]string{
+	"":      "ProjectRequestList is the whole list of all project requests.",
+	"items": "List of project requests",
+}
+
+func (ProjectRequestList) SwaggerDoc() map[string]string {
+	return map_ProjectRequestList
+}
+
+var map_ProjectRequestSpec = map[string]string{
+	"":                  "ProjectRequestSpec is a description of a project request.",
+	"requester":         "Requester is the name of the user who made the request, it is set by the server.",
+	"projectName":       "ProjectName is the name of the project to raise the quota of in Quota requests.",
+	"displayName":       "DisplayName is the display name of the project created in Create requests.",
+	"parentProjectName": "ParentProjectName is the parent of the project created in Create requests.",
+	"clusters":          "Clusters is the quota of the project created in Create requests, or the quota added to the project in Quota requests.",
+	"justification":     "Justification tells the administrators why the project or the quota is needed.",
+	"channel":           "Channel is the name of the notify channel the requester and the administrators are informed through, nobody is informed if empty.",
+	"template":          "Template is the name of the message template in the channel.",
+}
+
+func (ProjectRequestSpec) SwaggerDoc() map[string]string {
+	return map_ProjectRequestSpec
+}
+
+var map_ProjectRequestStatus = map[string]string{
+	"":                   "ProjectRequestStatus represents information about the status of a project request.",
+	"approver":           "Approver is the name of the administrator who approved or rejected the request.",
+	"projectName":        "ProjectName is the name of the project created or updated by the request.",
+	"notifiedPhase":      "NotifiedPhase is the last phase the requester and the administrators were informed of.",
+	"lastTransitionTime": "The last time the condition transitioned from one status to another.",
+	"reason":             "The reason for the condition's last transition, such as the comment of the approver.",
+	"message":            "A human readable message indicating details about the transition.",
+}
+
+func (ProjectRequestStatus) SwaggerDoc() map[string]string {
+	return map_ProjectRequestStatus
+}
+
 var map_ProjectSpec = map[string]string{
 	"":                  "ProjectSpec is a description of a project.",
 	"finalizers":        "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
 	"members":           "Users represents the user list of project.",
 	"parentProjectName": "ParentProjectName indicates the superior project name of this service.",
 	"clusters":          "Clusters represents clusters that can be used and the resource limits of each cluster.",
+	"quotaBorrowing":    "QuotaBorrowing lets the project allocate the idle quota of the parent project beyond its own quota, borrowing is disabled if nil.",
+	"quotaAlert":        "QuotaAlert notifies the members of the project when the quota usage of the project or its namespaces crosses the thresholds. The usage of the project in a cluster is the quota allocated to its child projects and namespaces, not the resources used in them.",
+	"certificateAlert":  "CertificateAlert notifies the members of the project when the certificates of its namespaces fail to be renewed.",
+	"frozen":            "Frozen scales the workloads of the namespaces of the project down to zero and stops new pods from running, they are restored once unfrozen.",
 }
 
 func (ProjectSpec) SwaggerDoc() map[string]string {
@@ -309,12 +537,53 @@ var map_ProjectStatus = map[string]string{
 	"lastTransitionTime": "The last time the condition transitioned from one status to another.",
 	"reason":             "The reason for the condition's last transition.",
 	"message":            "A human readable message indicating details about the transition.",
+	"quotaAlerts":        "QuotaAlerts represents the alerts sent for the quota usage that is still above the thresholds.",
+	"freeze":             "Freeze represents the progress of freezing or unfreezing the project, nil if the project is not frozen.",
 }
 
 func (ProjectStatus) SwaggerDoc() map[string]string {
 	return map_ProjectStatus
 }
 
+var map_QuotaAlert = map[string]string{
+	"":         "QuotaAlert represents the quota usage alert rules of a project.",
+	"channel":  "Channel is the name of the notify channel the alerts are sent through.",
+	"template": "Template is the name of the message template in the channel.",
+}
+
+func (QuotaAlert) SwaggerDoc() map[string]string {
+	return map_QuotaAlert
+}
+
+var map_QuotaAlertRule = map[string]string{
+	"":           "QuotaAlertRule represents the alert thresholds of a resource.",
+	"resource":   "Resource is the name of the resource, such as requests.cpu, limits.memory or pods.",
+	"thresholds": "Thresholds are the percentages of the quota to alert at, such as 80 and 95.",
+}
+
+func (QuotaAlertRule) SwaggerDoc() map[string]string {
+	return map_QuotaAlertRule
+}
+
+var map_QuotaAlertState = map[string]string{
+	"":               "QuotaAlertState represents the highest threshold alerted of a resource in a cluster of the project or a namespace of the project.",
+	"namespace":      "Namespace is the name of the namespace, empty for the project.",
+	"lastNotifyTime": "The last time the alert was sent.",
+}
+
+func (QuotaAlertState) SwaggerDoc() map[string]string {
+	return map_QuotaAlertState
+}
+
+var map_QuotaBorrowing = map[string]string{
+	"":       "QuotaBorrowing is the borrowing of quota from the parent project.",
+	"limits": "Limits caps the quantity borrowed from the parent project per cluster and resource, the resources absent can not be borrowed.",
+}
+
+func (QuotaBorrowing) SwaggerDoc() map[string]string {
+	return map_QuotaBorrowing
+}
+
 var map_UsedQuantity = map[string]string{
 	"": "UsedQuantity is a straightforward wrapper of ResourceList.",
 }
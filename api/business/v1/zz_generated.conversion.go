@@ -25,6 +25,7 @@ package v1
 import (
 	unsafe "unsafe"
 
+	corev1 "k8s.io/api/core/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	business "tkestack.io/tke/api/business"
@@ -37,6 +38,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*CertificateAlert)(nil), (*business.CertificateAlert)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateAlert_To_business_CertificateAlert(a.(*CertificateAlert), b.(*business.CertificateAlert), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.CertificateAlert)(nil), (*CertificateAlert)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_CertificateAlert_To_v1_CertificateAlert(a.(*business.CertificateAlert), b.(*CertificateAlert), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ChartGroup)(nil), (*business.ChartGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ChartGroup_To_business_ChartGroup(a.(*ChartGroup), b.(*business.ChartGroup), scope)
 	}); err != nil {
@@ -97,6 +108,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FrozenNamespace)(nil), (*business.FrozenNamespace)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FrozenNamespace_To_business_FrozenNamespace(a.(*FrozenNamespace), b.(*business.FrozenNamespace), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.FrozenNamespace)(nil), (*FrozenNamespace)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_FrozenNamespace_To_v1_FrozenNamespace(a.(*business.FrozenNamespace), b.(*FrozenNamespace), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FrozenWorkload)(nil), (*business.FrozenWorkload)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FrozenWorkload_To_business_FrozenWorkload(a.(*FrozenWorkload), b.(*business.FrozenWorkload), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.FrozenWorkload)(nil), (*FrozenWorkload)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_FrozenWorkload_To_v1_FrozenWorkload(a.(*business.FrozenWorkload), b.(*FrozenWorkload), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HardQuantity)(nil), (*business.HardQuantity)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_HardQuantity_To_business_HardQuantity(a.(*HardQuantity), b.(*business.HardQuantity), scope)
 	}); err != nil {
@@ -177,6 +208,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceCertStatus)(nil), (*business.NamespaceCertStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceCertStatus_To_business_NamespaceCertStatus(a.(*NamespaceCertStatus), b.(*business.NamespaceCertStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceCertStatus)(nil), (*NamespaceCertStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceCertStatus_To_v1_NamespaceCertStatus(a.(*business.NamespaceCertStatus), b.(*NamespaceCertStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamespaceList)(nil), (*business.NamespaceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_NamespaceList_To_business_NamespaceList(a.(*NamespaceList), b.(*business.NamespaceList), scope)
 	}); err != nil {
@@ -187,6 +228,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceQuotaNode)(nil), (*business.NamespaceQuotaNode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceQuotaNode_To_business_NamespaceQuotaNode(a.(*NamespaceQuotaNode), b.(*business.NamespaceQuotaNode), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceQuotaNode)(nil), (*NamespaceQuotaNode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceQuotaNode_To_v1_NamespaceQuotaNode(a.(*business.NamespaceQuotaNode), b.(*NamespaceQuotaNode), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamespaceSpec)(nil), (*business.NamespaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_NamespaceSpec_To_business_NamespaceSpec(a.(*NamespaceSpec), b.(*business.NamespaceSpec), scope)
 	}); err != nil {
@@ -207,6 +258,86 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplate)(nil), (*business.NamespaceTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplate_To_business_NamespaceTemplate(a.(*NamespaceTemplate), b.(*business.NamespaceTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplate)(nil), (*NamespaceTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplate_To_v1_NamespaceTemplate(a.(*business.NamespaceTemplate), b.(*NamespaceTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplateList)(nil), (*business.NamespaceTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplateList_To_business_NamespaceTemplateList(a.(*NamespaceTemplateList), b.(*business.NamespaceTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplateList)(nil), (*NamespaceTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplateList_To_v1_NamespaceTemplateList(a.(*business.NamespaceTemplateList), b.(*NamespaceTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplateNetworkPolicy)(nil), (*business.NamespaceTemplateNetworkPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplateNetworkPolicy_To_business_NamespaceTemplateNetworkPolicy(a.(*NamespaceTemplateNetworkPolicy), b.(*business.NamespaceTemplateNetworkPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplateNetworkPolicy)(nil), (*NamespaceTemplateNetworkPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplateNetworkPolicy_To_v1_NamespaceTemplateNetworkPolicy(a.(*business.NamespaceTemplateNetworkPolicy), b.(*NamespaceTemplateNetworkPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplatePodSecurity)(nil), (*business.NamespaceTemplatePodSecurity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplatePodSecurity_To_business_NamespaceTemplatePodSecurity(a.(*NamespaceTemplatePodSecurity), b.(*business.NamespaceTemplatePodSecurity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplatePodSecurity)(nil), (*NamespaceTemplatePodSecurity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplatePodSecurity_To_v1_NamespaceTemplatePodSecurity(a.(*business.NamespaceTemplatePodSecurity), b.(*NamespaceTemplatePodSecurity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplateRoleBinding)(nil), (*business.NamespaceTemplateRoleBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplateRoleBinding_To_business_NamespaceTemplateRoleBinding(a.(*NamespaceTemplateRoleBinding), b.(*business.NamespaceTemplateRoleBinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplateRoleBinding)(nil), (*NamespaceTemplateRoleBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplateRoleBinding_To_v1_NamespaceTemplateRoleBinding(a.(*business.NamespaceTemplateRoleBinding), b.(*NamespaceTemplateRoleBinding), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplateSpec)(nil), (*business.NamespaceTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplateSpec_To_business_NamespaceTemplateSpec(a.(*NamespaceTemplateSpec), b.(*business.NamespaceTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplateSpec)(nil), (*NamespaceTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplateSpec_To_v1_NamespaceTemplateSpec(a.(*business.NamespaceTemplateSpec), b.(*NamespaceTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplateSubject)(nil), (*business.NamespaceTemplateSubject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplateSubject_To_business_NamespaceTemplateSubject(a.(*NamespaceTemplateSubject), b.(*business.NamespaceTemplateSubject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplateSubject)(nil), (*NamespaceTemplateSubject)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplateSubject_To_v1_NamespaceTemplateSubject(a.(*business.NamespaceTemplateSubject), b.(*NamespaceTemplateSubject), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTemplateSync)(nil), (*business.NamespaceTemplateSync)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceTemplateSync_To_business_NamespaceTemplateSync(a.(*NamespaceTemplateSync), b.(*business.NamespaceTemplateSync), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceTemplateSync)(nil), (*NamespaceTemplateSync)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceTemplateSync_To_v1_NamespaceTemplateSync(a.(*business.NamespaceTemplateSync), b.(*NamespaceTemplateSync), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NsEmigration)(nil), (*business.NsEmigration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_NsEmigration_To_business_NsEmigration(a.(*NsEmigration), b.(*business.NsEmigration), scope)
 	}); err != nil {
@@ -307,6 +438,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectFreezeStatus)(nil), (*business.ProjectFreezeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectFreezeStatus_To_business_ProjectFreezeStatus(a.(*ProjectFreezeStatus), b.(*business.ProjectFreezeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.ProjectFreezeStatus)(nil), (*ProjectFreezeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_ProjectFreezeStatus_To_v1_ProjectFreezeStatus(a.(*business.ProjectFreezeStatus), b.(*ProjectFreezeStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectList)(nil), (*business.ProjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ProjectList_To_business_ProjectList(a.(*ProjectList), b.(*business.ProjectList), scope)
 	}); err != nil {
@@ -317,6 +458,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectQuotaNode)(nil), (*business.ProjectQuotaNode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectQuotaNode_To_business_ProjectQuotaNode(a.(*ProjectQuotaNode), b.(*business.ProjectQuotaNode), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.ProjectQuotaNode)(nil), (*ProjectQuotaNode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_ProjectQuotaNode_To_v1_ProjectQuotaNode(a.(*business.ProjectQuotaNode), b.(*ProjectQuotaNode), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectQuotaTree)(nil), (*business.ProjectQuotaTree)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectQuotaTree_To_business_ProjectQuotaTree(a.(*ProjectQuotaTree), b.(*business.ProjectQuotaTree), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.ProjectQuotaTree)(nil), (*ProjectQuotaTree)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_ProjectQuotaTree_To_v1_ProjectQuotaTree(a.(*business.ProjectQuotaTree), b.(*ProjectQuotaTree), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectRequest)(nil), (*business.ProjectRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectRequest_To_business_ProjectRequest(a.(*ProjectRequest), b.(*business.ProjectRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.ProjectRequest)(nil), (*ProjectRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_ProjectRequest_To_v1_ProjectRequest(a.(*business.ProjectRequest), b.(*ProjectRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectRequestList)(nil), (*business.ProjectRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectRequestList_To_business_ProjectRequestList(a.(*ProjectRequestList), b.(*business.ProjectRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.ProjectRequestList)(nil), (*ProjectRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_ProjectRequestList_To_v1_ProjectRequestList(a.(*business.ProjectRequestList), b.(*ProjectRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectRequestSpec)(nil), (*business.ProjectRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectRequestSpec_To_business_ProjectRequestSpec(a.(*ProjectRequestSpec), b.(*business.ProjectRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.ProjectRequestSpec)(nil), (*ProjectRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_ProjectRequestSpec_To_v1_ProjectRequestSpec(a.(*business.ProjectRequestSpec), b.(*ProjectRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectRequestStatus)(nil), (*business.ProjectRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectRequestStatus_To_business_ProjectRequestStatus(a.(*ProjectRequestStatus), b.(*business.ProjectRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.ProjectRequestStatus)(nil), (*ProjectRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_ProjectRequestStatus_To_v1_ProjectRequestStatus(a.(*business.ProjectRequestStatus), b.(*ProjectRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectSpec)(nil), (*business.ProjectSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ProjectSpec_To_business_ProjectSpec(a.(*ProjectSpec), b.(*business.ProjectSpec), scope)
 	}); err != nil {
@@ -337,6 +538,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaAlert)(nil), (*business.QuotaAlert)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_QuotaAlert_To_business_QuotaAlert(a.(*QuotaAlert), b.(*business.QuotaAlert), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.QuotaAlert)(nil), (*QuotaAlert)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_QuotaAlert_To_v1_QuotaAlert(a.(*business.QuotaAlert), b.(*QuotaAlert), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QuotaAlertRule)(nil), (*business.QuotaAlertRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_QuotaAlertRule_To_business_QuotaAlertRule(a.(*QuotaAlertRule), b.(*business.QuotaAlertRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.QuotaAlertRule)(nil), (*QuotaAlertRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_QuotaAlertRule_To_v1_QuotaAlertRule(a.(*business.QuotaAlertRule), b.(*QuotaAlertRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QuotaAlertState)(nil), (*business.QuotaAlertState)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_QuotaAlertState_To_business_QuotaAlertState(a.(*QuotaAlertState), b.(*business.QuotaAlertState), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.QuotaAlertState)(nil), (*QuotaAlertState)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_QuotaAlertState_To_v1_QuotaAlertState(a.(*business.QuotaAlertState), b.(*QuotaAlertState), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QuotaBorrowing)(nil), (*business.QuotaBorrowing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_QuotaBorrowing_To_business_QuotaBorrowing(a.(*QuotaBorrowing), b.(*business.QuotaBorrowing), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.QuotaBorrowing)(nil), (*QuotaBorrowing)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_QuotaBorrowing_To_v1_QuotaBorrowing(a.(*business.QuotaBorrowing), b.(*QuotaBorrowing), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*UsedQuantity)(nil), (*business.UsedQuantity)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_UsedQuantity_To_business_UsedQuantity(a.(*UsedQuantity), b.(*business.UsedQuantity), scope)
 	}); err != nil {
@@ -350,6 +591,28 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1_CertificateAlert_To_business_CertificateAlert(in *CertificateAlert, out *business.CertificateAlert, s conversion.Scope) error {
+	out.Channel = in.Channel
+	out.Template = in.Template
+	return nil
+}
+
+// Convert_v1_CertificateAlert_To_business_CertificateAlert is an autogenerated conversion function.
+func Convert_v1_CertificateAlert_To_business_CertificateAlert(in *CertificateAlert, out *business.CertificateAlert, s conversion.Scope) error {
+	return autoConvert_v1_CertificateAlert_To_business_CertificateAlert(in, out, s)
+}
+
+func autoConvert_business_CertificateAlert_To_v1_CertificateAlert(in *business.CertificateAlert, out *CertificateAlert, s conversion.Scope) error {
+	out.Channel = in.Channel
+	out.Template = in.Template
+	return nil
+}
+
+// Convert_business_CertificateAlert_To_v1_CertificateAlert is an autogenerated conversion function.
+func Convert_business_CertificateAlert_To_v1_CertificateAlert(in *business.CertificateAlert, out *CertificateAlert, s conversion.Scope) error {
+	return autoConvert_business_CertificateAlert_To_v1_CertificateAlert(in, out, s)
+}
+
 func autoConvert_v1_ChartGroup_To_business_ChartGroup(in *ChartGroup, out *business.ChartGroup, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_ChartGroupSpec_To_business_ChartGroupSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -502,6 +765,58 @@ func Convert_business_ConfigMapList_To_v1_ConfigMapList(in *business.ConfigMapLi
 	return autoConvert_business_ConfigMapList_To_v1_ConfigMapList(in, out, s)
 }
 
+func autoConvert_v1_FrozenNamespace_To_business_FrozenNamespace(in *FrozenNamespace, out *business.FrozenNamespace, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ClusterName = in.ClusterName
+	out.Namespace = in.Namespace
+	out.Workloads = *(*[]business.FrozenWorkload)(unsafe.Pointer(&in.Workloads))
+	out.Frozen = in.Frozen
+	return nil
+}
+
+// Convert_v1_FrozenNamespace_To_business_FrozenNamespace is an autogenerated conversion function.
+func Convert_v1_FrozenNamespace_To_business_FrozenNamespace(in *FrozenNamespace, out *business.FrozenNamespace, s conversion.Scope) error {
+	return autoConvert_v1_FrozenNamespace_To_business_FrozenNamespace(in, out, s)
+}
+
+func autoConvert_business_FrozenNamespace_To_v1_FrozenNamespace(in *business.FrozenNamespace, out *FrozenNamespace, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ClusterName = in.ClusterName
+	out.Namespace = in.Namespace
+	out.Workloads = *(*[]FrozenWorkload)(unsafe.Pointer(&in.Workloads))
+	out.Frozen = in.Frozen
+	return nil
+}
+
+// Convert_business_FrozenNamespace_To_v1_FrozenNamespace is an autogenerated conversion function.
+func Convert_business_FrozenNamespace_To_v1_FrozenNamespace(in *business.FrozenNamespace, out *FrozenNamespace, s conversion.Scope) error {
+	return autoConvert_business_FrozenNamespace_To_v1_FrozenNamespace(in, out, s)
+}
+
+func autoConvert_v1_FrozenWorkload_To_business_FrozenWorkload(in *FrozenWorkload, out *business.FrozenWorkload, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Replicas = in.Replicas
+	return nil
+}
+
+// Convert_v1_FrozenWorkload_To_business_FrozenWorkload is an autogenerated conversion function.
+func Convert_v1_FrozenWorkload_To_business_FrozenWorkload(in *FrozenWorkload, out *business.FrozenWorkload, s conversion.Scope) error {
+	return autoConvert_v1_FrozenWorkload_To_business_FrozenWorkload(in, out, s)
+}
+
+func autoConvert_business_FrozenWorkload_To_v1_FrozenWorkload(in *business.FrozenWorkload, out *FrozenWorkload, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Replicas = in.Replicas
+	return nil
+}
+
+// Convert_business_FrozenWorkload_To_v1_FrozenWorkload is an autogenerated conversion function.
+func Convert_business_FrozenWorkload_To_v1_FrozenWorkload(in *business.FrozenWorkload, out *FrozenWorkload, s conversion.Scope) error {
+	return autoConvert_business_FrozenWorkload_To_v1_FrozenWorkload(in, out, s)
+}
+
 func autoConvert_v1_HardQuantity_To_business_HardQuantity(in *HardQuantity, out *business.HardQuantity, s conversion.Scope) error {
 	out.Hard = *(*business.ResourceList)(unsafe.Pointer(&in.Hard))
 	return nil
@@ -665,6 +980,8 @@ func autoConvert_v1_NamespaceCert_To_business_NamespaceCert(in *NamespaceCert, o
 	out.KeyPem = *(*[]byte)(unsafe.Pointer(&in.KeyPem))
 	out.CACertPem = *(*[]byte)(unsafe.Pointer(&in.CACertPem))
 	out.APIServer = in.APIServer
+	out.SerialNumber = in.SerialNumber
+	out.NotAfter = in.NotAfter
 	return nil
 }
 
@@ -678,6 +995,8 @@ func autoConvert_business_NamespaceCert_To_v1_NamespaceCert(in *business.Namespa
 	out.KeyPem = *(*[]byte)(unsafe.Pointer(&in.KeyPem))
 	out.CACertPem = *(*[]byte)(unsafe.Pointer(&in.CACertPem))
 	out.APIServer = in.APIServer
+	out.SerialNumber = in.SerialNumber
+	out.NotAfter = in.NotAfter
 	return nil
 }
 
@@ -706,6 +1025,44 @@ func Convert_business_NamespaceCertOptions_To_v1_NamespaceCertOptions(in *busine
 	return autoConvert_business_NamespaceCertOptions_To_v1_NamespaceCertOptions(in, out, s)
 }
 
+func autoConvert_v1_NamespaceCertStatus_To_business_NamespaceCertStatus(in *NamespaceCertStatus, out *business.NamespaceCertStatus, s conversion.Scope) error {
+	out.Username = in.Username
+	out.SerialNumber = in.SerialNumber
+	out.ValidDays = in.ValidDays
+	out.NotBefore = in.NotBefore
+	out.NotAfter = in.NotAfter
+	out.Phase = business.NamespaceCertPhase(in.Phase)
+	out.CertPem = *(*[]byte)(unsafe.Pointer(&in.CertPem))
+	out.Message = in.Message
+	out.LastNotifyTime = in.LastNotifyTime
+	out.RenewUntil = in.RenewUntil
+	return nil
+}
+
+// Convert_v1_NamespaceCertStatus_To_business_NamespaceCertStatus is an autogenerated conversion function.
+func Convert_v1_NamespaceCertStatus_To_business_NamespaceCertStatus(in *NamespaceCertStatus, out *business.NamespaceCertStatus, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceCertStatus_To_business_NamespaceCertStatus(in, out, s)
+}
+
+func autoConvert_business_NamespaceCertStatus_To_v1_NamespaceCertStatus(in *business.NamespaceCertStatus, out *NamespaceCertStatus, s conversion.Scope) error {
+	out.Username = in.Username
+	out.SerialNumber = in.SerialNumber
+	out.ValidDays = in.ValidDays
+	out.NotBefore = in.NotBefore
+	out.NotAfter = in.NotAfter
+	out.Phase = NamespaceCertPhase(in.Phase)
+	out.CertPem = *(*[]byte)(unsafe.Pointer(&in.CertPem))
+	out.Message = in.Message
+	out.LastNotifyTime = in.LastNotifyTime
+	out.RenewUntil = in.RenewUntil
+	return nil
+}
+
+// Convert_business_NamespaceCertStatus_To_v1_NamespaceCertStatus is an autogenerated conversion function.
+func Convert_business_NamespaceCertStatus_To_v1_NamespaceCertStatus(in *business.NamespaceCertStatus, out *NamespaceCertStatus, s conversion.Scope) error {
+	return autoConvert_business_NamespaceCertStatus_To_v1_NamespaceCertStatus(in, out, s)
+}
+
 func autoConvert_v1_NamespaceList_To_business_NamespaceList(in *NamespaceList, out *business.NamespaceList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]business.Namespace)(unsafe.Pointer(&in.Items))
@@ -728,6 +1085,32 @@ func Convert_business_NamespaceList_To_v1_NamespaceList(in *business.NamespaceLi
 	return autoConvert_business_NamespaceList_To_v1_NamespaceList(in, out, s)
 }
 
+func autoConvert_v1_NamespaceQuotaNode_To_business_NamespaceQuotaNode(in *NamespaceQuotaNode, out *business.NamespaceQuotaNode, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ClusterName = in.ClusterName
+	out.Hard = *(*business.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Used = *(*business.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_v1_NamespaceQuotaNode_To_business_NamespaceQuotaNode is an autogenerated conversion function.
+func Convert_v1_NamespaceQuotaNode_To_business_NamespaceQuotaNode(in *NamespaceQuotaNode, out *business.NamespaceQuotaNode, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceQuotaNode_To_business_NamespaceQuotaNode(in, out, s)
+}
+
+func autoConvert_business_NamespaceQuotaNode_To_v1_NamespaceQuotaNode(in *business.NamespaceQuotaNode, out *NamespaceQuotaNode, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ClusterName = in.ClusterName
+	out.Hard = *(*ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Used = *(*ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_business_NamespaceQuotaNode_To_v1_NamespaceQuotaNode is an autogenerated conversion function.
+func Convert_business_NamespaceQuotaNode_To_v1_NamespaceQuotaNode(in *business.NamespaceQuotaNode, out *NamespaceQuotaNode, s conversion.Scope) error {
+	return autoConvert_business_NamespaceQuotaNode_To_v1_NamespaceQuotaNode(in, out, s)
+}
+
 func autoConvert_v1_NamespaceSpec_To_business_NamespaceSpec(in *NamespaceSpec, out *business.NamespaceSpec, s conversion.Scope) error {
 	out.Finalizers = *(*[]business.FinalizerName)(unsafe.Pointer(&in.Finalizers))
 	out.TenantID = in.TenantID
@@ -737,6 +1120,7 @@ func autoConvert_v1_NamespaceSpec_To_business_NamespaceSpec(in *NamespaceSpec, o
 	out.ClusterDisplayName = in.ClusterDisplayName
 	out.Namespace = in.Namespace
 	out.Hard = *(*business.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.RevokedCertificates = *(*[]string)(unsafe.Pointer(&in.RevokedCertificates))
 	return nil
 }
 
@@ -754,6 +1138,7 @@ func autoConvert_business_NamespaceSpec_To_v1_NamespaceSpec(in *business.Namespa
 	out.ClusterDisplayName = in.ClusterDisplayName
 	out.Namespace = in.Namespace
 	out.Hard = *(*ResourceList)(unsafe.Pointer(&in.Hard))
+	out.RevokedCertificates = *(*[]string)(unsafe.Pointer(&in.RevokedCertificates))
 	return nil
 }
 
@@ -771,6 +1156,8 @@ func autoConvert_v1_NamespaceStatus_To_business_NamespaceStatus(in *NamespaceSta
 	out.Used = *(*business.ResourceList)(unsafe.Pointer(&in.Used))
 	out.CachedSpecHard = *(*business.ResourceList)(unsafe.Pointer(&in.CachedSpecHard))
 	out.Certificate = (*business.NamespaceCert)(unsafe.Pointer(in.Certificate))
+	out.Templates = *(*[]business.NamespaceTemplateSync)(unsafe.Pointer(&in.Templates))
+	out.Certificates = *(*[]business.NamespaceCertStatus)(unsafe.Pointer(&in.Certificates))
 	return nil
 }
 
@@ -788,6 +1175,8 @@ func autoConvert_business_NamespaceStatus_To_v1_NamespaceStatus(in *business.Nam
 	out.Used = *(*ResourceList)(unsafe.Pointer(&in.Used))
 	out.CachedSpecHard = *(*ResourceList)(unsafe.Pointer(&in.CachedSpecHard))
 	out.Certificate = (*NamespaceCert)(unsafe.Pointer(in.Certificate))
+	out.Templates = *(*[]NamespaceTemplateSync)(unsafe.Pointer(&in.Templates))
+	out.Certificates = *(*[]NamespaceCertStatus)(unsafe.Pointer(&in.Certificates))
 	return nil
 }
 
@@ -796,6 +1185,210 @@ func Convert_business_NamespaceStatus_To_v1_NamespaceStatus(in *business.Namespa
 	return autoConvert_business_NamespaceStatus_To_v1_NamespaceStatus(in, out, s)
 }
 
+func autoConvert_v1_NamespaceTemplate_To_business_NamespaceTemplate(in *NamespaceTemplate, out *business.NamespaceTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_NamespaceTemplateSpec_To_business_NamespaceTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_NamespaceTemplate_To_business_NamespaceTemplate is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplate_To_business_NamespaceTemplate(in *NamespaceTemplate, out *business.NamespaceTemplate, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplate_To_business_NamespaceTemplate(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplate_To_v1_NamespaceTemplate(in *business.NamespaceTemplate, out *NamespaceTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_business_NamespaceTemplateSpec_To_v1_NamespaceTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_business_NamespaceTemplate_To_v1_NamespaceTemplate is an autogenerated conversion function.
+func Convert_business_NamespaceTemplate_To_v1_NamespaceTemplate(in *business.NamespaceTemplate, out *NamespaceTemplate, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplate_To_v1_NamespaceTemplate(in, out, s)
+}
+
+func autoConvert_v1_NamespaceTemplateList_To_business_NamespaceTemplateList(in *NamespaceTemplateList, out *business.NamespaceTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]business.NamespaceTemplate)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_NamespaceTemplateList_To_business_NamespaceTemplateList is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplateList_To_business_NamespaceTemplateList(in *NamespaceTemplateList, out *business.NamespaceTemplateList, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplateList_To_business_NamespaceTemplateList(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplateList_To_v1_NamespaceTemplateList(in *business.NamespaceTemplateList, out *NamespaceTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]NamespaceTemplate)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_business_NamespaceTemplateList_To_v1_NamespaceTemplateList is an autogenerated conversion function.
+func Convert_business_NamespaceTemplateList_To_v1_NamespaceTemplateList(in *business.NamespaceTemplateList, out *NamespaceTemplateList, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplateList_To_v1_NamespaceTemplateList(in, out, s)
+}
+
+func autoConvert_v1_NamespaceTemplateNetworkPolicy_To_business_NamespaceTemplateNetworkPolicy(in *NamespaceTemplateNetworkPolicy, out *business.NamespaceTemplateNetworkPolicy, s conversion.Scope) error {
+	out.DenyIngress = in.DenyIngress
+	out.DenyEgress = in.DenyEgress
+	return nil
+}
+
+// Convert_v1_NamespaceTemplateNetworkPolicy_To_business_NamespaceTemplateNetworkPolicy is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplateNetworkPolicy_To_business_NamespaceTemplateNetworkPolicy(in *NamespaceTemplateNetworkPolicy, out *business.NamespaceTemplateNetworkPolicy, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplateNetworkPolicy_To_business_NamespaceTemplateNetworkPolicy(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplateNetworkPolicy_To_v1_NamespaceTemplateNetworkPolicy(in *business.NamespaceTemplateNetworkPolicy, out *NamespaceTemplateNetworkPolicy, s conversion.Scope) error {
+	out.DenyIngress = in.DenyIngress
+	out.DenyEgress = in.DenyEgress
+	return nil
+}
+
+// Convert_business_NamespaceTemplateNetworkPolicy_To_v1_NamespaceTemplateNetworkPolicy is an autogenerated conversion function.
+func Convert_business_NamespaceTemplateNetworkPolicy_To_v1_NamespaceTemplateNetworkPolicy(in *business.NamespaceTemplateNetworkPolicy, out *NamespaceTemplateNetworkPolicy, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplateNetworkPolicy_To_v1_NamespaceTemplateNetworkPolicy(in, out, s)
+}
+
+func autoConvert_v1_NamespaceTemplatePodSecurity_To_business_NamespaceTemplatePodSecurity(in *NamespaceTemplatePodSecurity, out *business.NamespaceTemplatePodSecurity, s conversion.Scope) error {
+	out.Enforce = in.Enforce
+	out.Audit = in.Audit
+	out.Warn = in.Warn
+	return nil
+}
+
+// Convert_v1_NamespaceTemplatePodSecurity_To_business_NamespaceTemplatePodSecurity is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplatePodSecurity_To_business_NamespaceTemplatePodSecurity(in *NamespaceTemplatePodSecurity, out *business.NamespaceTemplatePodSecurity, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplatePodSecurity_To_business_NamespaceTemplatePodSecurity(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplatePodSecurity_To_v1_NamespaceTemplatePodSecurity(in *business.NamespaceTemplatePodSecurity, out *NamespaceTemplatePodSecurity, s conversion.Scope) error {
+	out.Enforce = in.Enforce
+	out.Audit = in.Audit
+	out.Warn = in.Warn
+	return nil
+}
+
+// Convert_business_NamespaceTemplatePodSecurity_To_v1_NamespaceTemplatePodSecurity is an autogenerated conversion function.
+func Convert_business_NamespaceTemplatePodSecurity_To_v1_NamespaceTemplatePodSecurity(in *business.NamespaceTemplatePodSecurity, out *NamespaceTemplatePodSecurity, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplatePodSecurity_To_v1_NamespaceTemplatePodSecurity(in, out, s)
+}
+
+func autoConvert_v1_NamespaceTemplateRoleBinding_To_business_NamespaceTemplateRoleBinding(in *NamespaceTemplateRoleBinding, out *business.NamespaceTemplateRoleBinding, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ClusterRole = in.ClusterRole
+	out.Subjects = *(*[]business.NamespaceTemplateSubject)(unsafe.Pointer(&in.Subjects))
+	return nil
+}
+
+// Convert_v1_NamespaceTemplateRoleBinding_To_business_NamespaceTemplateRoleBinding is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplateRoleBinding_To_business_NamespaceTemplateRoleBinding(in *NamespaceTemplateRoleBinding, out *business.NamespaceTemplateRoleBinding, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplateRoleBinding_To_business_NamespaceTemplateRoleBinding(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplateRoleBinding_To_v1_NamespaceTemplateRoleBinding(in *business.NamespaceTemplateRoleBinding, out *NamespaceTemplateRoleBinding, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ClusterRole = in.ClusterRole
+	out.Subjects = *(*[]NamespaceTemplateSubject)(unsafe.Pointer(&in.Subjects))
+	return nil
+}
+
+// Convert_business_NamespaceTemplateRoleBinding_To_v1_NamespaceTemplateRoleBinding is an autogenerated conversion function.
+func Convert_business_NamespaceTemplateRoleBinding_To_v1_NamespaceTemplateRoleBinding(in *business.NamespaceTemplateRoleBinding, out *NamespaceTemplateRoleBinding, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplateRoleBinding_To_v1_NamespaceTemplateRoleBinding(in, out, s)
+}
+
+func autoConvert_v1_NamespaceTemplateSpec_To_business_NamespaceTemplateSpec(in *NamespaceTemplateSpec, out *business.NamespaceTemplateSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.LimitRanges = *(*[]corev1.LimitRangeItem)(unsafe.Pointer(&in.LimitRanges))
+	out.NetworkPolicy = (*business.NamespaceTemplateNetworkPolicy)(unsafe.Pointer(in.NetworkPolicy))
+	out.RoleBindings = *(*[]business.NamespaceTemplateRoleBinding)(unsafe.Pointer(&in.RoleBindings))
+	out.PodSecurity = (*business.NamespaceTemplatePodSecurity)(unsafe.Pointer(in.PodSecurity))
+	return nil
+}
+
+// Convert_v1_NamespaceTemplateSpec_To_business_NamespaceTemplateSpec is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplateSpec_To_business_NamespaceTemplateSpec(in *NamespaceTemplateSpec, out *business.NamespaceTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplateSpec_To_business_NamespaceTemplateSpec(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplateSpec_To_v1_NamespaceTemplateSpec(in *business.NamespaceTemplateSpec, out *NamespaceTemplateSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.LimitRanges = *(*[]corev1.LimitRangeItem)(unsafe.Pointer(&in.LimitRanges))
+	out.NetworkPolicy = (*NamespaceTemplateNetworkPolicy)(unsafe.Pointer(in.NetworkPolicy))
+	out.RoleBindings = *(*[]NamespaceTemplateRoleBinding)(unsafe.Pointer(&in.RoleBindings))
+	out.PodSecurity = (*NamespaceTemplatePodSecurity)(unsafe.Pointer(in.PodSecurity))
+	return nil
+}
+
+// Convert_business_NamespaceTemplateSpec_To_v1_NamespaceTemplateSpec is an autogenerated conversion function.
+func Convert_business_NamespaceTemplateSpec_To_v1_NamespaceTemplateSpec(in *business.NamespaceTemplateSpec, out *NamespaceTemplateSpec, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplateSpec_To_v1_NamespaceTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1_NamespaceTemplateSubject_To_business_NamespaceTemplateSubject(in *NamespaceTemplateSubject, out *business.NamespaceTemplateSubject, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1_NamespaceTemplateSubject_To_business_NamespaceTemplateSubject is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplateSubject_To_business_NamespaceTemplateSubject(in *NamespaceTemplateSubject, out *business.NamespaceTemplateSubject, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplateSubject_To_business_NamespaceTemplateSubject(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplateSubject_To_v1_NamespaceTemplateSubject(in *business.NamespaceTemplateSubject, out *NamespaceTemplateSubject, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_business_NamespaceTemplateSubject_To_v1_NamespaceTemplateSubject is an autogenerated conversion function.
+func Convert_business_NamespaceTemplateSubject_To_v1_NamespaceTemplateSubject(in *business.NamespaceTemplateSubject, out *NamespaceTemplateSubject, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplateSubject_To_v1_NamespaceTemplateSubject(in, out, s)
+}
+
+func autoConvert_v1_NamespaceTemplateSync_To_business_NamespaceTemplateSync(in *NamespaceTemplateSync, out *business.NamespaceTemplateSync, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Phase = business.NamespaceTemplateSyncPhase(in.Phase)
+	out.LastSyncTime = in.LastSyncTime
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_NamespaceTemplateSync_To_business_NamespaceTemplateSync is an autogenerated conversion function.
+func Convert_v1_NamespaceTemplateSync_To_business_NamespaceTemplateSync(in *NamespaceTemplateSync, out *business.NamespaceTemplateSync, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceTemplateSync_To_business_NamespaceTemplateSync(in, out, s)
+}
+
+func autoConvert_business_NamespaceTemplateSync_To_v1_NamespaceTemplateSync(in *business.NamespaceTemplateSync, out *NamespaceTemplateSync, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Phase = NamespaceTemplateSyncPhase(in.Phase)
+	out.LastSyncTime = in.LastSyncTime
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_business_NamespaceTemplateSync_To_v1_NamespaceTemplateSync is an autogenerated conversion function.
+func Convert_business_NamespaceTemplateSync_To_v1_NamespaceTemplateSync(in *business.NamespaceTemplateSync, out *NamespaceTemplateSync, s conversion.Scope) error {
+	return autoConvert_business_NamespaceTemplateSync_To_v1_NamespaceTemplateSync(in, out, s)
+}
+
 func autoConvert_v1_NsEmigration_To_business_NsEmigration(in *NsEmigration, out *business.NsEmigration, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_NsEmigrationSpec_To_business_NsEmigrationSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -855,6 +1448,10 @@ func autoConvert_v1_NsEmigrationSpec_To_business_NsEmigrationSpec(in *NsEmigrati
 	out.Namespace = in.Namespace
 	out.NsShowName = in.NsShowName
 	out.Destination = in.Destination
+	out.Mode = business.NsEmigrationMode(in.Mode)
+	out.DestinationCluster = in.DestinationCluster
+	out.TransferQuota = in.TransferQuota
+	out.CutOver = in.CutOver
 	return nil
 }
 
@@ -868,6 +1465,10 @@ func autoConvert_business_NsEmigrationSpec_To_v1_NsEmigrationSpec(in *business.N
 	out.Namespace = in.Namespace
 	out.NsShowName = in.NsShowName
 	out.Destination = in.Destination
+	out.Mode = NsEmigrationMode(in.Mode)
+	out.DestinationCluster = in.DestinationCluster
+	out.TransferQuota = in.TransferQuota
+	out.CutOver = in.CutOver
 	return nil
 }
 
@@ -881,6 +1482,8 @@ func autoConvert_v1_NsEmigrationStatus_To_business_NsEmigrationStatus(in *NsEmig
 	out.LastTransitionTime = in.LastTransitionTime
 	out.Reason = in.Reason
 	out.Message = in.Message
+	out.SourceCluster = in.SourceCluster
+	out.TransferredQuota = *(*business.ResourceList)(unsafe.Pointer(&in.TransferredQuota))
 	return nil
 }
 
@@ -894,6 +1497,8 @@ func autoConvert_business_NsEmigrationStatus_To_v1_NsEmigrationStatus(in *busine
 	out.LastTransitionTime = in.LastTransitionTime
 	out.Reason = in.Reason
 	out.Message = in.Message
+	out.SourceCluster = in.SourceCluster
+	out.TransferredQuota = *(*ResourceList)(unsafe.Pointer(&in.TransferredQuota))
 	return nil
 }
 
@@ -1052,6 +1657,34 @@ func Convert_business_Project_To_v1_Project(in *business.Project, out *Project,
 	return autoConvert_business_Project_To_v1_Project(in, out, s)
 }
 
+func autoConvert_v1_ProjectFreezeStatus_To_business_ProjectFreezeStatus(in *ProjectFreezeStatus, out *business.ProjectFreezeStatus, s conversion.Scope) error {
+	out.Phase = business.ProjectFreezePhase(in.Phase)
+	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
+	out.Namespaces = *(*[]business.FrozenNamespace)(unsafe.Pointer(&in.Namespaces))
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_ProjectFreezeStatus_To_business_ProjectFreezeStatus is an autogenerated conversion function.
+func Convert_v1_ProjectFreezeStatus_To_business_ProjectFreezeStatus(in *ProjectFreezeStatus, out *business.ProjectFreezeStatus, s conversion.Scope) error {
+	return autoConvert_v1_ProjectFreezeStatus_To_business_ProjectFreezeStatus(in, out, s)
+}
+
+func autoConvert_business_ProjectFreezeStatus_To_v1_ProjectFreezeStatus(in *business.ProjectFreezeStatus, out *ProjectFreezeStatus, s conversion.Scope) error {
+	out.Phase = ProjectFreezePhase(in.Phase)
+	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
+	out.Namespaces = *(*[]FrozenNamespace)(unsafe.Pointer(&in.Namespaces))
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_business_ProjectFreezeStatus_To_v1_ProjectFreezeStatus is an autogenerated conversion function.
+func Convert_business_ProjectFreezeStatus_To_v1_ProjectFreezeStatus(in *business.ProjectFreezeStatus, out *ProjectFreezeStatus, s conversion.Scope) error {
+	return autoConvert_business_ProjectFreezeStatus_To_v1_ProjectFreezeStatus(in, out, s)
+}
+
 func autoConvert_v1_ProjectList_To_business_ProjectList(in *ProjectList, out *business.ProjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]business.Project)(unsafe.Pointer(&in.Items))
@@ -1074,6 +1707,190 @@ func Convert_business_ProjectList_To_v1_ProjectList(in *business.ProjectList, ou
 	return autoConvert_business_ProjectList_To_v1_ProjectList(in, out, s)
 }
 
+func autoConvert_v1_ProjectQuotaNode_To_business_ProjectQuotaNode(in *ProjectQuotaNode, out *business.ProjectQuotaNode, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DisplayName = in.DisplayName
+	out.Hard = *(*business.ClusterHard)(unsafe.Pointer(&in.Hard))
+	out.Allocated = *(*business.ClusterUsed)(unsafe.Pointer(&in.Allocated))
+	out.Borrowed = *(*business.ClusterUsed)(unsafe.Pointer(&in.Borrowed))
+	out.Used = *(*business.ClusterUsed)(unsafe.Pointer(&in.Used))
+	out.Namespaces = *(*[]business.NamespaceQuotaNode)(unsafe.Pointer(&in.Namespaces))
+	out.Children = *(*[]business.ProjectQuotaNode)(unsafe.Pointer(&in.Children))
+	return nil
+}
+
+// Convert_v1_ProjectQuotaNode_To_business_ProjectQuotaNode is an autogenerated conversion function.
+func Convert_v1_ProjectQuotaNode_To_business_ProjectQuotaNode(in *ProjectQuotaNode, out *business.ProjectQuotaNode, s conversion.Scope) error {
+	return autoConvert_v1_ProjectQuotaNode_To_business_ProjectQuotaNode(in, out, s)
+}
+
+func autoConvert_business_ProjectQuotaNode_To_v1_ProjectQuotaNode(in *business.ProjectQuotaNode, out *ProjectQuotaNode, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DisplayName = in.DisplayName
+	out.Hard = *(*ClusterHard)(unsafe.Pointer(&in.Hard))
+	out.Allocated = *(*ClusterUsed)(unsafe.Pointer(&in.Allocated))
+	out.Borrowed = *(*ClusterUsed)(unsafe.Pointer(&in.Borrowed))
+	out.Used = *(*ClusterUsed)(unsafe.Pointer(&in.Used))
+	out.Namespaces = *(*[]NamespaceQuotaNode)(unsafe.Pointer(&in.Namespaces))
+	out.Children = *(*[]ProjectQuotaNode)(unsafe.Pointer(&in.Children))
+	return nil
+}
+
+// Convert_business_ProjectQuotaNode_To_v1_ProjectQuotaNode is an autogenerated conversion function.
+func Convert_business_ProjectQuotaNode_To_v1_ProjectQuotaNode(in *business.ProjectQuotaNode, out *ProjectQuotaNode, s conversion.Scope) error {
+	return autoConvert_business_ProjectQuotaNode_To_v1_ProjectQuotaNode(in, out, s)
+}
+
+func autoConvert_v1_ProjectQuotaTree_To_business_ProjectQuotaTree(in *ProjectQuotaTree, out *business.ProjectQuotaTree, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_ProjectQuotaNode_To_business_ProjectQuotaNode(&in.Root, &out.Root, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ProjectQuotaTree_To_business_ProjectQuotaTree is an autogenerated conversion function.
+func Convert_v1_ProjectQuotaTree_To_business_ProjectQuotaTree(in *ProjectQuotaTree, out *business.ProjectQuotaTree, s conversion.Scope) error {
+	return autoConvert_v1_ProjectQuotaTree_To_business_ProjectQuotaTree(in, out, s)
+}
+
+func autoConvert_business_ProjectQuotaTree_To_v1_ProjectQuotaTree(in *business.ProjectQuotaTree, out *ProjectQuotaTree, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_business_ProjectQuotaNode_To_v1_ProjectQuotaNode(&in.Root, &out.Root, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_business_ProjectQuotaTree_To_v1_ProjectQuotaTree is an autogenerated conversion function.
+func Convert_business_ProjectQuotaTree_To_v1_ProjectQuotaTree(in *business.ProjectQuotaTree, out *ProjectQuotaTree, s conversion.Scope) error {
+	return autoConvert_business_ProjectQuotaTree_To_v1_ProjectQuotaTree(in, out, s)
+}
+
+func autoConvert_v1_ProjectRequest_To_business_ProjectRequest(in *ProjectRequest, out *business.ProjectRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_ProjectRequestSpec_To_business_ProjectRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ProjectRequestStatus_To_business_ProjectRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ProjectRequest_To_business_ProjectRequest is an autogenerated conversion function.
+func Convert_v1_ProjectRequest_To_business_ProjectRequest(in *ProjectRequest, out *business.ProjectRequest, s conversion.Scope) error {
+	return autoConvert_v1_ProjectRequest_To_business_ProjectRequest(in, out, s)
+}
+
+func autoConvert_business_ProjectRequest_To_v1_ProjectRequest(in *business.ProjectRequest, out *ProjectRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_business_ProjectRequestSpec_To_v1_ProjectRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_business_ProjectRequestStatus_To_v1_ProjectRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_business_ProjectRequest_To_v1_ProjectRequest is an autogenerated conversion function.
+func Convert_business_ProjectRequest_To_v1_ProjectRequest(in *business.ProjectRequest, out *ProjectRequest, s conversion.Scope) error {
+	return autoConvert_business_ProjectRequest_To_v1_ProjectRequest(in, out, s)
+}
+
+func autoConvert_v1_ProjectRequestList_To_business_ProjectRequestList(in *ProjectRequestList, out *business.ProjectRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]business.ProjectRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ProjectRequestList_To_business_ProjectRequestList is an autogenerated conversion function.
+func Convert_v1_ProjectRequestList_To_business_ProjectRequestList(in *ProjectRequestList, out *business.ProjectRequestList, s conversion.Scope) error {
+	return autoConvert_v1_ProjectRequestList_To_business_ProjectRequestList(in, out, s)
+}
+
+func autoConvert_business_ProjectRequestList_To_v1_ProjectRequestList(in *business.ProjectRequestList, out *ProjectRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ProjectRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_business_ProjectRequestList_To_v1_ProjectRequestList is an autogenerated conversion function.
+func Convert_business_ProjectRequestList_To_v1_ProjectRequestList(in *business.ProjectRequestList, out *ProjectRequestList, s conversion.Scope) error {
+	return autoConvert_business_ProjectRequestList_To_v1_ProjectRequestList(in, out, s)
+}
+
+func autoConvert_v1_ProjectRequestSpec_To_business_ProjectRequestSpec(in *ProjectRequestSpec, out *business.ProjectRequestSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Requester = in.Requester
+	out.Type = business.ProjectRequestType(in.Type)
+	out.ProjectName = in.ProjectName
+	out.DisplayName = in.DisplayName
+	out.ParentProjectName = in.ParentProjectName
+	out.Clusters = *(*business.ClusterHard)(unsafe.Pointer(&in.Clusters))
+	out.Justification = in.Justification
+	out.Channel = in.Channel
+	out.Template = in.Template
+	return nil
+}
+
+// Convert_v1_ProjectRequestSpec_To_business_ProjectRequestSpec is an autogenerated conversion function.
+func Convert_v1_ProjectRequestSpec_To_business_ProjectRequestSpec(in *ProjectRequestSpec, out *business.ProjectRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1_ProjectRequestSpec_To_business_ProjectRequestSpec(in, out, s)
+}
+
+func autoConvert_business_ProjectRequestSpec_To_v1_ProjectRequestSpec(in *business.ProjectRequestSpec, out *ProjectRequestSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.Requester = in.Requester
+	out.Type = ProjectRequestType(in.Type)
+	out.ProjectName = in.ProjectName
+	out.DisplayName = in.DisplayName
+	out.ParentProjectName = in.ParentProjectName
+	out.Clusters = *(*ClusterHard)(unsafe.Pointer(&in.Clusters))
+	out.Justification = in.Justification
+	out.Channel = in.Channel
+	out.Template = in.Template
+	return nil
+}
+
+// Convert_business_ProjectRequestSpec_To_v1_ProjectRequestSpec is an autogenerated conversion function.
+func Convert_business_ProjectRequestSpec_To_v1_ProjectRequestSpec(in *business.ProjectRequestSpec, out *ProjectRequestSpec, s conversion.Scope) error {
+	return autoConvert_business_ProjectRequestSpec_To_v1_ProjectRequestSpec(in, out, s)
+}
+
+func autoConvert_v1_ProjectRequestStatus_To_business_ProjectRequestStatus(in *ProjectRequestStatus, out *business.ProjectRequestStatus, s conversion.Scope) error {
+	out.Phase = business.ProjectRequestPhase(in.Phase)
+	out.Approver = in.Approver
+	out.ProjectName = in.ProjectName
+	out.NotifiedPhase = business.ProjectRequestPhase(in.NotifiedPhase)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_ProjectRequestStatus_To_business_ProjectRequestStatus is an autogenerated conversion function.
+func Convert_v1_ProjectRequestStatus_To_business_ProjectRequestStatus(in *ProjectRequestStatus, out *business.ProjectRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1_ProjectRequestStatus_To_business_ProjectRequestStatus(in, out, s)
+}
+
+func autoConvert_business_ProjectRequestStatus_To_v1_ProjectRequestStatus(in *business.ProjectRequestStatus, out *ProjectRequestStatus, s conversion.Scope) error {
+	out.Phase = ProjectRequestPhase(in.Phase)
+	out.Approver = in.Approver
+	out.ProjectName = in.ProjectName
+	out.NotifiedPhase = ProjectRequestPhase(in.NotifiedPhase)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_business_ProjectRequestStatus_To_v1_ProjectRequestStatus is an autogenerated conversion function.
+func Convert_business_ProjectRequestStatus_To_v1_ProjectRequestStatus(in *business.ProjectRequestStatus, out *ProjectRequestStatus, s conversion.Scope) error {
+	return autoConvert_business_ProjectRequestStatus_To_v1_ProjectRequestStatus(in, out, s)
+}
+
 func autoConvert_v1_ProjectSpec_To_business_ProjectSpec(in *ProjectSpec, out *business.ProjectSpec, s conversion.Scope) error {
 	out.Finalizers = *(*[]business.FinalizerName)(unsafe.Pointer(&in.Finalizers))
 	out.TenantID = in.TenantID
@@ -1081,6 +1898,10 @@ func autoConvert_v1_ProjectSpec_To_business_ProjectSpec(in *ProjectSpec, out *bu
 	out.Members = *(*[]string)(unsafe.Pointer(&in.Members))
 	out.ParentProjectName = in.ParentProjectName
 	out.Clusters = *(*business.ClusterHard)(unsafe.Pointer(&in.Clusters))
+	out.QuotaBorrowing = (*business.QuotaBorrowing)(unsafe.Pointer(in.QuotaBorrowing))
+	out.QuotaAlert = (*business.QuotaAlert)(unsafe.Pointer(in.QuotaAlert))
+	out.CertificateAlert = (*business.CertificateAlert)(unsafe.Pointer(in.CertificateAlert))
+	out.Frozen = in.Frozen
 	return nil
 }
 
@@ -1096,6 +1917,10 @@ func autoConvert_business_ProjectSpec_To_v1_ProjectSpec(in *business.ProjectSpec
 	out.Members = *(*[]string)(unsafe.Pointer(&in.Members))
 	out.ParentProjectName = in.ParentProjectName
 	out.Clusters = *(*ClusterHard)(unsafe.Pointer(&in.Clusters))
+	out.QuotaBorrowing = (*QuotaBorrowing)(unsafe.Pointer(in.QuotaBorrowing))
+	out.QuotaAlert = (*QuotaAlert)(unsafe.Pointer(in.QuotaAlert))
+	out.CertificateAlert = (*CertificateAlert)(unsafe.Pointer(in.CertificateAlert))
+	out.Frozen = in.Frozen
 	return nil
 }
 
@@ -1115,6 +1940,8 @@ func autoConvert_v1_ProjectStatus_To_business_ProjectStatus(in *ProjectStatus, o
 	out.LastTransitionTime = in.LastTransitionTime
 	out.Reason = in.Reason
 	out.Message = in.Message
+	out.QuotaAlerts = *(*[]business.QuotaAlertState)(unsafe.Pointer(&in.QuotaAlerts))
+	out.Freeze = (*business.ProjectFreezeStatus)(unsafe.Pointer(in.Freeze))
 	return nil
 }
 
@@ -1134,6 +1961,8 @@ func autoConvert_business_ProjectStatus_To_v1_ProjectStatus(in *business.Project
 	out.LastTransitionTime = in.LastTransitionTime
 	out.Reason = in.Reason
 	out.Message = in.Message
+	out.QuotaAlerts = *(*[]QuotaAlertState)(unsafe.Pointer(&in.QuotaAlerts))
+	out.Freeze = (*ProjectFreezeStatus)(unsafe.Pointer(in.Freeze))
 	return nil
 }
 
@@ -1142,6 +1971,100 @@ func Convert_business_ProjectStatus_To_v1_ProjectStatus(in *business.ProjectStat
 	return autoConvert_business_ProjectStatus_To_v1_ProjectStatus(in, out, s)
 }
 
+func autoConvert_v1_QuotaAlert_To_business_QuotaAlert(in *QuotaAlert, out *business.QuotaAlert, s conversion.Scope) error {
+	out.Channel = in.Channel
+	out.Template = in.Template
+	out.Rules = *(*[]business.QuotaAlertRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1_QuotaAlert_To_business_QuotaAlert is an autogenerated conversion function.
+func Convert_v1_QuotaAlert_To_business_QuotaAlert(in *QuotaAlert, out *business.QuotaAlert, s conversion.Scope) error {
+	return autoConvert_v1_QuotaAlert_To_business_QuotaAlert(in, out, s)
+}
+
+func autoConvert_business_QuotaAlert_To_v1_QuotaAlert(in *business.QuotaAlert, out *QuotaAlert, s conversion.Scope) error {
+	out.Channel = in.Channel
+	out.Template = in.Template
+	out.Rules = *(*[]QuotaAlertRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_business_QuotaAlert_To_v1_QuotaAlert is an autogenerated conversion function.
+func Convert_business_QuotaAlert_To_v1_QuotaAlert(in *business.QuotaAlert, out *QuotaAlert, s conversion.Scope) error {
+	return autoConvert_business_QuotaAlert_To_v1_QuotaAlert(in, out, s)
+}
+
+func autoConvert_v1_QuotaAlertRule_To_business_QuotaAlertRule(in *QuotaAlertRule, out *business.QuotaAlertRule, s conversion.Scope) error {
+	out.Resource = in.Resource
+	out.Thresholds = *(*[]int32)(unsafe.Pointer(&in.Thresholds))
+	return nil
+}
+
+// Convert_v1_QuotaAlertRule_To_business_QuotaAlertRule is an autogenerated conversion function.
+func Convert_v1_QuotaAlertRule_To_business_QuotaAlertRule(in *QuotaAlertRule, out *business.QuotaAlertRule, s conversion.Scope) error {
+	return autoConvert_v1_QuotaAlertRule_To_business_QuotaAlertRule(in, out, s)
+}
+
+func autoConvert_business_QuotaAlertRule_To_v1_QuotaAlertRule(in *business.QuotaAlertRule, out *QuotaAlertRule, s conversion.Scope) error {
+	out.Resource = in.Resource
+	out.Thresholds = *(*[]int32)(unsafe.Pointer(&in.Thresholds))
+	return nil
+}
+
+// Convert_business_QuotaAlertRule_To_v1_QuotaAlertRule is an autogenerated conversion function.
+func Convert_business_QuotaAlertRule_To_v1_QuotaAlertRule(in *business.QuotaAlertRule, out *QuotaAlertRule, s conversion.Scope) error {
+	return autoConvert_business_QuotaAlertRule_To_v1_QuotaAlertRule(in, out, s)
+}
+
+func autoConvert_v1_QuotaAlertState_To_business_QuotaAlertState(in *QuotaAlertState, out *business.QuotaAlertState, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Namespace = in.Namespace
+	out.Resource = in.Resource
+	out.Threshold = in.Threshold
+	out.LastNotifyTime = in.LastNotifyTime
+	return nil
+}
+
+// Convert_v1_QuotaAlertState_To_business_QuotaAlertState is an autogenerated conversion function.
+func Convert_v1_QuotaAlertState_To_business_QuotaAlertState(in *QuotaAlertState, out *business.QuotaAlertState, s conversion.Scope) error {
+	return autoConvert_v1_QuotaAlertState_To_business_QuotaAlertState(in, out, s)
+}
+
+func autoConvert_business_QuotaAlertState_To_v1_QuotaAlertState(in *business.QuotaAlertState, out *QuotaAlertState, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Namespace = in.Namespace
+	out.Resource = in.Resource
+	out.Threshold = in.Threshold
+	out.LastNotifyTime = in.LastNotifyTime
+	return nil
+}
+
+// Convert_business_QuotaAlertState_To_v1_QuotaAlertState is an autogenerated conversion function.
+func Convert_business_QuotaAlertState_To_v1_QuotaAlertState(in *business.QuotaAlertState, out *QuotaAlertState, s conversion.Scope) error {
+	return autoConvert_business_QuotaAlertState_To_v1_QuotaAlertState(in, out, s)
+}
+
+func autoConvert_v1_QuotaBorrowing_To_business_QuotaBorrowing(in *QuotaBorrowing, out *business.QuotaBorrowing, s conversion.Scope) error {
+	out.Limits = *(*business.ClusterHard)(unsafe.Pointer(&in.Limits))
+	return nil
+}
+
+// Convert_v1_QuotaBorrowing_To_business_QuotaBorrowing is an autogenerated conversion function.
+func Convert_v1_QuotaBorrowing_To_business_QuotaBorrowing(in *QuotaBorrowing, out *business.QuotaBorrowing, s conversion.Scope) error {
+	return autoConvert_v1_QuotaBorrowing_To_business_QuotaBorrowing(in, out, s)
+}
+
+func autoConvert_business_QuotaBorrowing_To_v1_QuotaBorrowing(in *business.QuotaBorrowing, out *QuotaBorrowing, s conversion.Scope) error {
+	out.Limits = *(*ClusterHard)(unsafe.Pointer(&in.Limits))
+	return nil
+}
+
+// Convert_business_QuotaBorrowing_To_v1_QuotaBorrowing is an autogenerated conversion function.
+func Convert_business_QuotaBorrowing_To_v1_QuotaBorrowing(in *business.QuotaBorrowing, out *QuotaBorrowing, s conversion.Scope) error {
+	return autoConvert_business_QuotaBorrowing_To_v1_QuotaBorrowing(in, out, s)
+}
+
 func autoConvert_v1_UsedQuantity_To_business_UsedQuantity(in *UsedQuantity, out *business.UsedQuantity, s conversion.Scope) error {
 	out.Used = *(*business.ResourceList)(unsafe.Pointer(&in.Used))
 	return nil
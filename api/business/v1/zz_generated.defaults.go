@@ -42,6 +42,8 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&NsEmigrationList{}, func(obj interface{}) { SetObjectDefaults_NsEmigrationList(obj.(*NsEmigrationList)) })
 	scheme.AddTypeDefaultingFunc(&Project{}, func(obj interface{}) { SetObjectDefaults_Project(obj.(*Project)) })
 	scheme.AddTypeDefaultingFunc(&ProjectList{}, func(obj interface{}) { SetObjectDefaults_ProjectList(obj.(*ProjectList)) })
+	scheme.AddTypeDefaultingFunc(&ProjectRequest{}, func(obj interface{}) { SetObjectDefaults_ProjectRequest(obj.(*ProjectRequest)) })
+	scheme.AddTypeDefaultingFunc(&ProjectRequestList{}, func(obj interface{}) { SetObjectDefaults_ProjectRequestList(obj.(*ProjectRequestList)) })
 	return nil
 }
 
@@ -91,6 +93,7 @@ func SetObjectDefaults_NamespaceList(in *NamespaceList) {
 }
 
 func SetObjectDefaults_NsEmigration(in *NsEmigration) {
+	SetDefaults_NsEmigrationSpec(&in.Spec)
 	SetDefaults_NsEmigrationStatus(&in.Status)
 }
 
@@ -112,3 +115,14 @@ func SetObjectDefaults_ProjectList(in *ProjectList) {
 		SetObjectDefaults_Project(a)
 	}
 }
+
+func SetObjectDefaults_ProjectRequest(in *ProjectRequest) {
+	SetDefaults_ProjectRequestStatus(&in.Status)
+}
+
+func SetObjectDefaults_ProjectRequestList(in *ProjectRequestList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ProjectRequest(a)
+	}
+}
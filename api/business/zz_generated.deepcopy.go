@@ -23,9 +23,26 @@
 package business
 
 import (
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateAlert) DeepCopyInto(out *CertificateAlert) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAlert.
+func (in *CertificateAlert) DeepCopy() *CertificateAlert {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateAlert)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChartGroup) DeepCopyInto(out *ChartGroup) {
 	*out = *in
@@ -250,6 +267,43 @@ func (in *ConfigMapList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrozenNamespace) DeepCopyInto(out *FrozenNamespace) {
+	*out = *in
+	if in.Workloads != nil {
+		in, out := &in.Workloads, &out.Workloads
+		*out = make([]FrozenWorkload, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrozenNamespace.
+func (in *FrozenNamespace) DeepCopy() *FrozenNamespace {
+	if in == nil {
+		return nil
+	}
+	out := new(FrozenNamespace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FrozenWorkload) DeepCopyInto(out *FrozenWorkload) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FrozenWorkload.
+func (in *FrozenWorkload) DeepCopy() *FrozenWorkload {
+	if in == nil {
+		return nil
+	}
+	out := new(FrozenWorkload)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HardQuantity) DeepCopyInto(out *HardQuantity) {
 	*out = *in
@@ -418,6 +472,7 @@ func (in *NamespaceCert) DeepCopyInto(out *NamespaceCert) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
 	return
 }
 
@@ -456,6 +511,31 @@ func (in *NamespaceCertOptions) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceCertStatus) DeepCopyInto(out *NamespaceCertStatus) {
+	*out = *in
+	in.NotBefore.DeepCopyInto(&out.NotBefore)
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
+	if in.CertPem != nil {
+		in, out := &in.CertPem, &out.CertPem
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	in.LastNotifyTime.DeepCopyInto(&out.LastNotifyTime)
+	in.RenewUntil.DeepCopyInto(&out.RenewUntil)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceCertStatus.
+func (in *NamespaceCertStatus) DeepCopy() *NamespaceCertStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceCertStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceList) DeepCopyInto(out *NamespaceList) {
 	*out = *in
@@ -489,6 +569,36 @@ func (in *NamespaceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceQuotaNode) DeepCopyInto(out *NamespaceQuotaNode) {
+	*out = *in
+	if in.Hard != nil {
+		in, out := &in.Hard, &out.Hard
+		*out = make(ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = make(ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceQuotaNode.
+func (in *NamespaceQuotaNode) DeepCopy() *NamespaceQuotaNode {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceQuotaNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceSpec) DeepCopyInto(out *NamespaceSpec) {
 	*out = *in
@@ -504,6 +614,11 @@ func (in *NamespaceSpec) DeepCopyInto(out *NamespaceSpec) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.RevokedCertificates != nil {
+		in, out := &in.RevokedCertificates, &out.RevokedCertificates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -540,6 +655,20 @@ func (in *NamespaceStatus) DeepCopyInto(out *NamespaceStatus) {
 		*out = new(NamespaceCert)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]NamespaceTemplateSync, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]NamespaceCertStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -553,6 +682,206 @@ func (in *NamespaceStatus) DeepCopy() *NamespaceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceTemplate) DeepCopyInto(out *NamespaceTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceTemplate.
+func (in *NamespaceTemplate) DeepCopy() *NamespaceTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespaceTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceTemplateList) DeepCopyInto(out *NamespaceTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NamespaceTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceTemplateList.
+func (in *NamespaceTemplateList) DeepCopy() *NamespaceTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespaceTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceTemplateNetworkPolicy) DeepCopyInto(out *NamespaceTemplateNetworkPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceTemplateNetworkPolicy.
+func (in *NamespaceTemplateNetworkPolicy) DeepCopy() *NamespaceTemplateNetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceTemplateNetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceTemplatePodSecurity) DeepCopyInto(out *NamespaceTemplatePodSecurity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceTemplatePodSecurity.
+func (in *NamespaceTemplatePodSecurity) DeepCopy() *NamespaceTemplatePodSecurity {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceTemplatePodSecurity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceTemplateRoleBinding) DeepCopyInto(out *NamespaceTemplateRoleBinding) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]NamespaceTemplateSubject, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceTemplateRoleBinding.
+func (in *NamespaceTemplateRoleBinding) DeepCopy() *NamespaceTemplateRoleBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceTemplateRoleBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceTemplateSpec) DeepCopyInto(out *NamespaceTemplateSpec) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LimitRanges != nil {
+		in, out := &in.LimitRanges, &out.LimitRanges
+		*out = make([]v1.LimitRangeItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NamespaceTemplateNetworkPolicy)
+		**out = **in
+	}
+	if in.RoleBindings != nil {
+		in, out := &in.RoleBindings, &out.RoleBindings
+		*out = make([]NamespaceTemplateRoleBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodSecurity != nil {
+		in, out := &in.PodSecurity, &out.PodSecurity
+		*out = new(NamespaceTemplatePodSecurity)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceTemplateSpec.
+func (in *NamespaceTemplateSpec) DeepCopy() *NamespaceTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceTemplateSubject) DeepCopyInto(out *NamespaceTemplateSubject) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceTemplateSubject.
+func (in *NamespaceTemplateSubject) DeepCopy() *NamespaceTemplateSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceTemplateSubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceTemplateSync) DeepCopyInto(out *NamespaceTemplateSync) {
+	*out = *in
+	in.LastSyncTime.DeepCopyInto(&out.LastSyncTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceTemplateSync.
+func (in *NamespaceTemplateSync) DeepCopy() *NamespaceTemplateSync {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceTemplateSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NsEmigration) DeepCopyInto(out *NsEmigration) {
 	*out = *in
@@ -634,6 +963,13 @@ func (in *NsEmigrationSpec) DeepCopy() *NsEmigrationSpec {
 func (in *NsEmigrationStatus) DeepCopyInto(out *NsEmigrationStatus) {
 	*out = *in
 	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.TransferredQuota != nil {
+		in, out := &in.TransferredQuota, &out.TransferredQuota
+		*out = make(ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -834,6 +1170,35 @@ func (in ProjectExtension) DeepCopy() ProjectExtension {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectFreezeStatus) DeepCopyInto(out *ProjectFreezeStatus) {
+	*out = *in
+	if in.Locked != nil {
+		in, out := &in.Locked, &out.Locked
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]FrozenNamespace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectFreezeStatus.
+func (in *ProjectFreezeStatus) DeepCopy() *ProjectFreezeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectFreezeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectList) DeepCopyInto(out *ProjectList) {
 	*out = *in
@@ -867,6 +1232,192 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectQuotaNode) DeepCopyInto(out *ProjectQuotaNode) {
+	*out = *in
+	if in.Hard != nil {
+		in, out := &in.Hard, &out.Hard
+		*out = make(ClusterHard, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Allocated != nil {
+		in, out := &in.Allocated, &out.Allocated
+		*out = make(ClusterUsed, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Borrowed != nil {
+		in, out := &in.Borrowed, &out.Borrowed
+		*out = make(ClusterUsed, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Used != nil {
+		in, out := &in.Used, &out.Used
+		*out = make(ClusterUsed, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]NamespaceQuotaNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]ProjectQuotaNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectQuotaNode.
+func (in *ProjectQuotaNode) DeepCopy() *ProjectQuotaNode {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectQuotaNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectQuotaTree) DeepCopyInto(out *ProjectQuotaTree) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Root.DeepCopyInto(&out.Root)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectQuotaTree.
+func (in *ProjectQuotaTree) DeepCopy() *ProjectQuotaTree {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectQuotaTree)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectQuotaTree) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRequest) DeepCopyInto(out *ProjectRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRequest.
+func (in *ProjectRequest) DeepCopy() *ProjectRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRequestList) DeepCopyInto(out *ProjectRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProjectRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRequestList.
+func (in *ProjectRequestList) DeepCopy() *ProjectRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRequestSpec) DeepCopyInto(out *ProjectRequestSpec) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make(ClusterHard, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRequestSpec.
+func (in *ProjectRequestSpec) DeepCopy() *ProjectRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectRequestStatus) DeepCopyInto(out *ProjectRequestStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectRequestStatus.
+func (in *ProjectRequestStatus) DeepCopy() *ProjectRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
@@ -887,6 +1438,21 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.QuotaBorrowing != nil {
+		in, out := &in.QuotaBorrowing, &out.QuotaBorrowing
+		*out = new(QuotaBorrowing)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QuotaAlert != nil {
+		in, out := &in.QuotaAlert, &out.QuotaAlert
+		*out = new(QuotaAlert)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificateAlert != nil {
+		in, out := &in.CertificateAlert, &out.CertificateAlert
+		*out = new(CertificateAlert)
+		**out = **in
+	}
 	return
 }
 
@@ -938,6 +1504,18 @@ func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 		**out = **in
 	}
 	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.QuotaAlerts != nil {
+		in, out := &in.QuotaAlerts, &out.QuotaAlerts
+		*out = make([]QuotaAlertState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Freeze != nil {
+		in, out := &in.Freeze, &out.Freeze
+		*out = new(ProjectFreezeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -951,6 +1529,90 @@ func (in *ProjectStatus) DeepCopy() *ProjectStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaAlert) DeepCopyInto(out *QuotaAlert) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]QuotaAlertRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaAlert.
+func (in *QuotaAlert) DeepCopy() *QuotaAlert {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaAlert)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaAlertRule) DeepCopyInto(out *QuotaAlertRule) {
+	*out = *in
+	if in.Thresholds != nil {
+		in, out := &in.Thresholds, &out.Thresholds
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaAlertRule.
+func (in *QuotaAlertRule) DeepCopy() *QuotaAlertRule {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaAlertRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaAlertState) DeepCopyInto(out *QuotaAlertState) {
+	*out = *in
+	in.LastNotifyTime.DeepCopyInto(&out.LastNotifyTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaAlertState.
+func (in *QuotaAlertState) DeepCopy() *QuotaAlertState {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaAlertState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaBorrowing) DeepCopyInto(out *QuotaBorrowing) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make(ClusterHard, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaBorrowing.
+func (in *QuotaBorrowing) DeepCopy() *QuotaBorrowing {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaBorrowing)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in ResourceList) DeepCopyInto(out *ResourceList) {
 	{
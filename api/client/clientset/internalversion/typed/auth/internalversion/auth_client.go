@@ -29,6 +29,8 @@ type AuthInterface interface {
 	RESTClient() rest.Interface
 	APIKeysGetter
 	APISigningKeysGetter
+	AccessRequestsGetter
+	AccessReviewsGetter
 	CategoriesGetter
 	ClientsGetter
 	ConfigMapsGetter
@@ -42,6 +44,9 @@ type AuthInterface interface {
 	ProjectPolicyBindingsGetter
 	RolesGetter
 	RulesGetter
+	SessionsGetter
+	SessionRevocationsGetter
+	TenantsGetter
 	UsersGetter
 }
 
@@ -58,6 +63,14 @@ func (c *AuthClient) APISigningKeys() APISigningKeyInterface {
 	return newAPISigningKeys(c)
 }
 
+func (c *AuthClient) AccessRequests() AccessRequestInterface {
+	return newAccessRequests(c)
+}
+
+func (c *AuthClient) AccessReviews() AccessReviewInterface {
+	return newAccessReviews(c)
+}
+
 func (c *AuthClient) Categories() CategoryInterface {
 	return newCategories(c)
 }
@@ -110,6 +123,18 @@ func (c *AuthClient) Rules() RuleInterface {
 	return newRules(c)
 }
 
+func (c *AuthClient) Sessions() SessionInterface {
+	return newSessions(c)
+}
+
+func (c *AuthClient) SessionRevocations() SessionRevocationInterface {
+	return newSessionRevocations(c)
+}
+
+func (c *AuthClient) Tenants() TenantInterface {
+	return newTenants(c)
+}
+
 func (c *AuthClient) Users() UserInterface {
 	return newUsers(c)
 }
@@ -0,0 +1,107 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	testing "k8s.io/client-go/testing"
+	auth "tkestack.io/tke/api/auth"
+)
+
+// FakeAccessReviews implements AccessReviewInterface
+type FakeAccessReviews struct {
+	Fake *FakeAuth
+}
+
+var accessreviewsResource = schema.GroupVersionResource{Group: "auth.tkestack.io", Version: "", Resource: "accessreviews"}
+
+var accessreviewsKind = schema.GroupVersionKind{Group: "auth.tkestack.io", Version: "", Kind: "AccessReview"}
+
+// Get takes name of the accessReview, and returns the corresponding accessReview object, and an error if there is any.
+func (c *FakeAccessReviews) Get(ctx context.Context, name string, options v1.GetOptions) (result *auth.AccessReview, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(accessreviewsResource, name), &auth.AccessReview{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*auth.AccessReview), err
+}
+
+// List takes label and field selectors, and returns the list of AccessReviews that match those selectors.
+func (c *FakeAccessReviews) List(ctx context.Context, opts v1.ListOptions) (result *auth.AccessReviewList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(accessreviewsResource, accessreviewsKind, opts), &auth.AccessReviewList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &auth.AccessReviewList{ListMeta: obj.(*auth.AccessReviewList).ListMeta}
+	for _, item := range obj.(*auth.AccessReviewList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Create takes the representation of a accessReview and creates it.  Returns the server's representation of the accessReview, and an error, if there is any.
+func (c *FakeAccessReviews) Create(ctx context.Context, accessReview *auth.AccessReview, opts v1.CreateOptions) (result *auth.AccessReview, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(accessreviewsResource, accessReview), &auth.AccessReview{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*auth.AccessReview), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAccessReviews) UpdateStatus(ctx context.Context, accessReview *auth.AccessReview, opts v1.UpdateOptions) (*auth.AccessReview, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(accessreviewsResource, "status", accessReview), &auth.AccessReview{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*auth.AccessReview), err
+}
+
+// Delete takes name of the accessReview and deletes it. Returns an error if one occurs.
+func (c *FakeAccessReviews) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(accessreviewsResource, name), &auth.AccessReview{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAccessReviews) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(accessreviewsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &auth.AccessReviewList{})
+	return err
+}
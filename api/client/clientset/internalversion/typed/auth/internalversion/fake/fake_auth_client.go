@@ -38,6 +38,14 @@ func (c *FakeAuth) APISigningKeys() internalversion.APISigningKeyInterface {
 	return &FakeAPISigningKeys{c}
 }
 
+func (c *FakeAuth) AccessRequests() internalversion.AccessRequestInterface {
+	return &FakeAccessRequests{c}
+}
+
+func (c *FakeAuth) AccessReviews() internalversion.AccessReviewInterface {
+	return &FakeAccessReviews{c}
+}
+
 func (c *FakeAuth) Categories() internalversion.CategoryInterface {
 	return &FakeCategories{c}
 }
@@ -90,6 +98,18 @@ func (c *FakeAuth) Rules() internalversion.RuleInterface {
 	return &FakeRules{c}
 }
 
+func (c *FakeAuth) Sessions() internalversion.SessionInterface {
+	return &FakeSessions{c}
+}
+
+func (c *FakeAuth) SessionRevocations() internalversion.SessionRevocationInterface {
+	return &FakeSessionRevocations{c}
+}
+
+func (c *FakeAuth) Tenants() internalversion.TenantInterface {
+	return &FakeTenants{c}
+}
+
 func (c *FakeAuth) Users() internalversion.UserInterface {
 	return &FakeUsers{c}
 }
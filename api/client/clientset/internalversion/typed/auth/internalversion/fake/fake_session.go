@@ -0,0 +1,97 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	testing "k8s.io/client-go/testing"
+	auth "tkestack.io/tke/api/auth"
+)
+
+// FakeSessions implements SessionInterface
+type FakeSessions struct {
+	Fake *FakeAuth
+}
+
+var sessionsResource = schema.GroupVersionResource{Group: "auth.tkestack.io", Version: "", Resource: "sessions"}
+
+var sessionsKind = schema.GroupVersionKind{Group: "auth.tkestack.io", Version: "", Kind: "Session"}
+
+// Get takes name of the session, and returns the corresponding session object, and an error if there is any.
+func (c *FakeSessions) Get(ctx context.Context, name string, options v1.GetOptions) (result *auth.Session, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(sessionsResource, name), &auth.Session{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*auth.Session), err
+}
+
+// List takes label and field selectors, and returns the list of Sessions that match those selectors.
+func (c *FakeSessions) List(ctx context.Context, opts v1.ListOptions) (result *auth.SessionList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(sessionsResource, sessionsKind, opts), &auth.SessionList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &auth.SessionList{ListMeta: obj.(*auth.SessionList).ListMeta}
+	for _, item := range obj.(*auth.SessionList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeSessions) UpdateStatus(ctx context.Context, session *auth.Session, opts v1.UpdateOptions) (*auth.Session, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(sessionsResource, "status", session), &auth.Session{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*auth.Session), err
+}
+
+// Delete takes name of the session and deletes it. Returns an error if one occurs.
+func (c *FakeSessions) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(sessionsResource, name), &auth.Session{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeSessions) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(sessionsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &auth.SessionList{})
+	return err
+}
@@ -24,6 +24,10 @@ type APIKeyExpansion interface{}
 
 type APISigningKeyExpansion interface{}
 
+type AccessRequestExpansion interface{}
+
+type AccessReviewExpansion interface{}
+
 type CategoryExpansion interface{}
 
 type ClientExpansion interface{}
@@ -50,4 +54,10 @@ type RoleExpansion interface{}
 
 type RuleExpansion interface{}
 
+type SessionExpansion interface{}
+
+type SessionRevocationExpansion interface{}
+
+type TenantExpansion interface{}
+
 type UserExpansion interface{}
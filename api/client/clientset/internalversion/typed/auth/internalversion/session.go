@@ -0,0 +1,127 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package internalversion
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	rest "k8s.io/client-go/rest"
+	auth "tkestack.io/tke/api/auth"
+	scheme "tkestack.io/tke/api/client/clientset/internalversion/scheme"
+)
+
+// SessionsGetter has a method to return a SessionInterface.
+// A group's client should implement this interface.
+type SessionsGetter interface {
+	Sessions() SessionInterface
+}
+
+// SessionInterface has methods to work with Session resources.
+type SessionInterface interface {
+	UpdateStatus(ctx context.Context, session *auth.Session, opts v1.UpdateOptions) (*auth.Session, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*auth.Session, error)
+	List(ctx context.Context, opts v1.ListOptions) (*auth.SessionList, error)
+	SessionExpansion
+}
+
+// sessions implements SessionInterface
+type sessions struct {
+	client rest.Interface
+}
+
+// newSessions returns a Sessions
+func newSessions(c *AuthClient) *sessions {
+	return &sessions{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the session, and returns the corresponding session object, and an error if there is any.
+func (c *sessions) Get(ctx context.Context, name string, options v1.GetOptions) (result *auth.Session, err error) {
+	result = &auth.Session{}
+	err = c.client.Get().
+		Resource("sessions").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Sessions that match those selectors.
+func (c *sessions) List(ctx context.Context, opts v1.ListOptions) (result *auth.SessionList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &auth.SessionList{}
+	err = c.client.Get().
+		Resource("sessions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *sessions) UpdateStatus(ctx context.Context, session *auth.Session, opts v1.UpdateOptions) (result *auth.Session, err error) {
+	result = &auth.Session{}
+	err = c.client.Put().
+		Resource("sessions").
+		Name(session.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(session).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the session and deletes it. Returns an error if one occurs.
+func (c *sessions) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("sessions").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *sessions) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("sessions").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
@@ -0,0 +1,170 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package internalversion
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+	auth "tkestack.io/tke/api/auth"
+	scheme "tkestack.io/tke/api/client/clientset/internalversion/scheme"
+)
+
+// TenantsGetter has a method to return a TenantInterface.
+// A group's client should implement this interface.
+type TenantsGetter interface {
+	Tenants() TenantInterface
+}
+
+// TenantInterface has methods to work with Tenant resources.
+type TenantInterface interface {
+	Create(ctx context.Context, tenant *auth.Tenant, opts v1.CreateOptions) (*auth.Tenant, error)
+	Update(ctx context.Context, tenant *auth.Tenant, opts v1.UpdateOptions) (*auth.Tenant, error)
+	UpdateStatus(ctx context.Context, tenant *auth.Tenant, opts v1.UpdateOptions) (*auth.Tenant, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*auth.Tenant, error)
+	List(ctx context.Context, opts v1.ListOptions) (*auth.TenantList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *auth.Tenant, err error)
+	TenantExpansion
+}
+
+// tenants implements TenantInterface
+type tenants struct {
+	client rest.Interface
+}
+
+// newTenants returns a Tenants
+func newTenants(c *AuthClient) *tenants {
+	return &tenants{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the tenant, and returns the corresponding tenant object, and an error if there is any.
+func (c *tenants) Get(ctx context.Context, name string, options v1.GetOptions) (result *auth.Tenant, err error) {
+	result = &auth.Tenant{}
+	err = c.client.Get().
+		Resource("tenants").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Tenants that match those selectors.
+func (c *tenants) List(ctx context.Context, opts v1.ListOptions) (result *auth.TenantList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &auth.TenantList{}
+	err = c.client.Get().
+		Resource("tenants").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested tenants.
+func (c *tenants) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("tenants").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a tenant and creates it.  Returns the server's representation of the tenant, and an error, if there is any.
+func (c *tenants) Create(ctx context.Context, tenant *auth.Tenant, opts v1.CreateOptions) (result *auth.Tenant, err error) {
+	result = &auth.Tenant{}
+	err = c.client.Post().
+		Resource("tenants").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(tenant).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a tenant and updates it. Returns the server's representation of the tenant, and an error, if there is any.
+func (c *tenants) Update(ctx context.Context, tenant *auth.Tenant, opts v1.UpdateOptions) (result *auth.Tenant, err error) {
+	result = &auth.Tenant{}
+	err = c.client.Put().
+		Resource("tenants").
+		Name(tenant.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(tenant).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *tenants) UpdateStatus(ctx context.Context, tenant *auth.Tenant, opts v1.UpdateOptions) (result *auth.Tenant, err error) {
+	result = &auth.Tenant{}
+	err = c.client.Put().
+		Resource("tenants").
+		Name(tenant.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(tenant).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the tenant and deletes it. Returns an error if one occurs.
+func (c *tenants) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("tenants").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched tenant.
+func (c *tenants) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *auth.Tenant, err error) {
+	result = &auth.Tenant{}
+	err = c.client.Patch(pt).
+		Resource("tenants").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
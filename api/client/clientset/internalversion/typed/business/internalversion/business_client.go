@@ -31,10 +31,12 @@ type BusinessInterface interface {
 	ConfigMapsGetter
 	ImageNamespacesGetter
 	NamespacesGetter
+	NamespaceTemplatesGetter
 	NsEmigrationsGetter
 	PlatformsGetter
 	PortalsGetter
 	ProjectsGetter
+	ProjectRequestsGetter
 }
 
 // BusinessClient is used to interact with features provided by the business.tkestack.io group.
@@ -58,6 +60,10 @@ func (c *BusinessClient) Namespaces(namespace string) NamespaceInterface {
 	return newNamespaces(c, namespace)
 }
 
+func (c *BusinessClient) NamespaceTemplates(namespace string) NamespaceTemplateInterface {
+	return newNamespaceTemplates(c, namespace)
+}
+
 func (c *BusinessClient) NsEmigrations(namespace string) NsEmigrationInterface {
 	return newNsEmigrations(c, namespace)
 }
@@ -74,6 +80,10 @@ func (c *BusinessClient) Projects() ProjectInterface {
 	return newProjects(c)
 }
 
+func (c *BusinessClient) ProjectRequests() ProjectRequestInterface {
+	return newProjectRequests(c)
+}
+
 // NewForConfig creates a new BusinessClient for the given config.
 func NewForConfig(c *rest.Config) (*BusinessClient, error) {
 	config := *c
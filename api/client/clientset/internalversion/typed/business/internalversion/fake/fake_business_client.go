@@ -46,6 +46,10 @@ func (c *FakeBusiness) Namespaces(namespace string) internalversion.NamespaceInt
 	return &FakeNamespaces{c, namespace}
 }
 
+func (c *FakeBusiness) NamespaceTemplates(namespace string) internalversion.NamespaceTemplateInterface {
+	return &FakeNamespaceTemplates{c, namespace}
+}
+
 func (c *FakeBusiness) NsEmigrations(namespace string) internalversion.NsEmigrationInterface {
 	return &FakeNsEmigrations{c, namespace}
 }
@@ -62,6 +66,10 @@ func (c *FakeBusiness) Projects() internalversion.ProjectInterface {
 	return &FakeProjects{c}
 }
 
+func (c *FakeBusiness) ProjectRequests() internalversion.ProjectRequestInterface {
+	return &FakeProjectRequests{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeBusiness) RESTClient() rest.Interface {
@@ -0,0 +1,127 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	business "tkestack.io/tke/api/business"
+)
+
+// FakeProjectRequests implements ProjectRequestInterface
+type FakeProjectRequests struct {
+	Fake *FakeBusiness
+}
+
+var projectrequestsResource = schema.GroupVersionResource{Group: "business.tkestack.io", Version: "", Resource: "projectrequests"}
+
+var projectrequestsKind = schema.GroupVersionKind{Group: "business.tkestack.io", Version: "", Kind: "ProjectRequest"}
+
+// Get takes name of the projectRequest, and returns the corresponding projectRequest object, and an error if there is any.
+func (c *FakeProjectRequests) Get(ctx context.Context, name string, options v1.GetOptions) (result *business.ProjectRequest, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(projectrequestsResource, name), &business.ProjectRequest{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*business.ProjectRequest), err
+}
+
+// List takes label and field selectors, and returns the list of ProjectRequests that match those selectors.
+func (c *FakeProjectRequests) List(ctx context.Context, opts v1.ListOptions) (result *business.ProjectRequestList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(projectrequestsResource, projectrequestsKind, opts), &business.ProjectRequestList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &business.ProjectRequestList{ListMeta: obj.(*business.ProjectRequestList).ListMeta}
+	for _, item := range obj.(*business.ProjectRequestList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested projectRequests.
+func (c *FakeProjectRequests) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(projectrequestsResource, opts))
+}
+
+// Create takes the representation of a projectRequest and creates it.  Returns the server's representation of the projectRequest, and an error, if there is any.
+func (c *FakeProjectRequests) Create(ctx context.Context, projectRequest *business.ProjectRequest, opts v1.CreateOptions) (result *business.ProjectRequest, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(projectrequestsResource, projectRequest), &business.ProjectRequest{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*business.ProjectRequest), err
+}
+
+// Update takes the representation of a projectRequest and updates it. Returns the server's representation of the projectRequest, and an error, if there is any.
+func (c *FakeProjectRequests) Update(ctx context.Context, projectRequest *business.ProjectRequest, opts v1.UpdateOptions) (result *business.ProjectRequest, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(projectrequestsResource, projectRequest), &business.ProjectRequest{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*business.ProjectRequest), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeProjectRequests) UpdateStatus(ctx context.Context, projectRequest *business.ProjectRequest, opts v1.UpdateOptions) (*business.ProjectRequest, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(projectrequestsResource, "status", projectRequest), &business.ProjectRequest{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*business.ProjectRequest), err
+}
+
+// Delete takes name of the projectRequest and deletes it. Returns an error if one occurs.
+func (c *FakeProjectRequests) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(projectrequestsResource, name), &business.ProjectRequest{})
+	return err
+}
+
+// Patch applies the patch and returns the patched projectRequest.
+func (c *FakeProjectRequests) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *business.ProjectRequest, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(projectrequestsResource, name, pt, data, subresources...), &business.ProjectRequest{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*business.ProjectRequest), err
+}
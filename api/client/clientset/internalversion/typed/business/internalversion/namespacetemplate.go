@@ -0,0 +1,180 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package internalversion
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+	business "tkestack.io/tke/api/business"
+	scheme "tkestack.io/tke/api/client/clientset/internalversion/scheme"
+)
+
+// NamespaceTemplatesGetter has a method to return a NamespaceTemplateInterface.
+// A group's client should implement this interface.
+type NamespaceTemplatesGetter interface {
+	NamespaceTemplates(namespace string) NamespaceTemplateInterface
+}
+
+// NamespaceTemplateInterface has methods to work with NamespaceTemplate resources.
+type NamespaceTemplateInterface interface {
+	Create(ctx context.Context, namespaceTemplate *business.NamespaceTemplate, opts v1.CreateOptions) (*business.NamespaceTemplate, error)
+	Update(ctx context.Context, namespaceTemplate *business.NamespaceTemplate, opts v1.UpdateOptions) (*business.NamespaceTemplate, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*business.NamespaceTemplate, error)
+	List(ctx context.Context, opts v1.ListOptions) (*business.NamespaceTemplateList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *business.NamespaceTemplate, err error)
+	NamespaceTemplateExpansion
+}
+
+// namespaceTemplates implements NamespaceTemplateInterface
+type namespaceTemplates struct {
+	client rest.Interface
+	ns     string
+}
+
+// newNamespaceTemplates returns a NamespaceTemplates
+func newNamespaceTemplates(c *BusinessClient, namespace string) *namespaceTemplates {
+	return &namespaceTemplates{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the namespaceTemplate, and returns the corresponding namespaceTemplate object, and an error if there is any.
+func (c *namespaceTemplates) Get(ctx context.Context, name string, options v1.GetOptions) (result *business.NamespaceTemplate, err error) {
+	result = &business.NamespaceTemplate{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("namespacetemplates").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of NamespaceTemplates that match those selectors.
+func (c *namespaceTemplates) List(ctx context.Context, opts v1.ListOptions) (result *business.NamespaceTemplateList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &business.NamespaceTemplateList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("namespacetemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested namespaceTemplates.
+func (c *namespaceTemplates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("namespacetemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a namespaceTemplate and creates it.  Returns the server's representation of the namespaceTemplate, and an error, if there is any.
+func (c *namespaceTemplates) Create(ctx context.Context, namespaceTemplate *business.NamespaceTemplate, opts v1.CreateOptions) (result *business.NamespaceTemplate, err error) {
+	result = &business.NamespaceTemplate{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("namespacetemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(namespaceTemplate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a namespaceTemplate and updates it. Returns the server's representation of the namespaceTemplate, and an error, if there is any.
+func (c *namespaceTemplates) Update(ctx context.Context, namespaceTemplate *business.NamespaceTemplate, opts v1.UpdateOptions) (result *business.NamespaceTemplate, err error) {
+	result = &business.NamespaceTemplate{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("namespacetemplates").
+		Name(namespaceTemplate.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(namespaceTemplate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the namespaceTemplate and deletes it. Returns an error if one occurs.
+func (c *namespaceTemplates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("namespacetemplates").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *namespaceTemplates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("namespacetemplates").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched namespaceTemplate.
+func (c *namespaceTemplates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *business.NamespaceTemplate, err error) {
+	result = &business.NamespaceTemplate{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("namespacetemplates").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
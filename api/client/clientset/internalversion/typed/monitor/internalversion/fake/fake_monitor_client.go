@@ -42,10 +42,18 @@ func (c *FakeMonitor) Metrics() internalversion.MetricInterface {
 	return &FakeMetrics{c}
 }
 
+func (c *FakeMonitor) ProjectUsages() internalversion.ProjectUsageInterface {
+	return &FakeProjectUsages{c}
+}
+
 func (c *FakeMonitor) Prometheuses() internalversion.PrometheusInterface {
 	return &FakePrometheuses{c}
 }
 
+func (c *FakeMonitor) UsageReports() internalversion.UsageReportInterface {
+	return &FakeUsageReports{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeMonitor) RESTClient() rest.Interface {
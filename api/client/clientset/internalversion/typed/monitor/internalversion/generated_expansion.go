@@ -26,4 +26,8 @@ type ConfigMapExpansion interface{}
 
 type MetricExpansion interface{}
 
+type ProjectUsageExpansion interface{}
+
 type PrometheusExpansion interface{}
+
+type UsageReportExpansion interface{}
@@ -30,7 +30,9 @@ type MonitorInterface interface {
 	ClusterOverviewsGetter
 	ConfigMapsGetter
 	MetricsGetter
+	ProjectUsagesGetter
 	PrometheusesGetter
+	UsageReportsGetter
 }
 
 // MonitorClient is used to interact with features provided by the monitor.tkestack.io group.
@@ -50,10 +52,18 @@ func (c *MonitorClient) Metrics() MetricInterface {
 	return newMetrics(c)
 }
 
+func (c *MonitorClient) ProjectUsages() ProjectUsageInterface {
+	return newProjectUsages(c)
+}
+
 func (c *MonitorClient) Prometheuses() PrometheusInterface {
 	return newPrometheuses(c)
 }
 
+func (c *MonitorClient) UsageReports() UsageReportInterface {
+	return newUsageReports(c)
+}
+
 // NewForConfig creates a new MonitorClient for the given config.
 func NewForConfig(c *rest.Config) (*MonitorClient, error) {
 	config := *c
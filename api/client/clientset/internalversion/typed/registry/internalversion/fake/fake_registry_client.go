@@ -50,10 +50,18 @@ func (c *FakeRegistry) Namespaces() internalversion.NamespaceInterface {
 	return &FakeNamespaces{c}
 }
 
+func (c *FakeRegistry) ReplicationPolicies() internalversion.ReplicationPolicyInterface {
+	return &FakeReplicationPolicies{c}
+}
+
 func (c *FakeRegistry) Repositories(namespace string) internalversion.RepositoryInterface {
 	return &FakeRepositories{c, namespace}
 }
 
+func (c *FakeRegistry) TrustedKeys() internalversion.TrustedKeyInterface {
+	return &FakeTrustedKeys{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeRegistry) RESTClient() rest.Interface {
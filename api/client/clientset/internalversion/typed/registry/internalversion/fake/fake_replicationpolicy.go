@@ -0,0 +1,127 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	registry "tkestack.io/tke/api/registry"
+)
+
+// FakeReplicationPolicies implements ReplicationPolicyInterface
+type FakeReplicationPolicies struct {
+	Fake *FakeRegistry
+}
+
+var replicationpoliciesResource = schema.GroupVersionResource{Group: "registry.tkestack.io", Version: "", Resource: "replicationpolicies"}
+
+var replicationpoliciesKind = schema.GroupVersionKind{Group: "registry.tkestack.io", Version: "", Kind: "ReplicationPolicy"}
+
+// Get takes name of the replicationPolicy, and returns the corresponding replicationPolicy object, and an error if there is any.
+func (c *FakeReplicationPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *registry.ReplicationPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(replicationpoliciesResource, name), &registry.ReplicationPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*registry.ReplicationPolicy), err
+}
+
+// List takes label and field selectors, and returns the list of ReplicationPolicies that match those selectors.
+func (c *FakeReplicationPolicies) List(ctx context.Context, opts v1.ListOptions) (result *registry.ReplicationPolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(replicationpoliciesResource, replicationpoliciesKind, opts), &registry.ReplicationPolicyList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &registry.ReplicationPolicyList{ListMeta: obj.(*registry.ReplicationPolicyList).ListMeta}
+	for _, item := range obj.(*registry.ReplicationPolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested replicationPolicies.
+func (c *FakeReplicationPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(replicationpoliciesResource, opts))
+}
+
+// Create takes the representation of a replicationPolicy and creates it.  Returns the server's representation of the replicationPolicy, and an error, if there is any.
+func (c *FakeReplicationPolicies) Create(ctx context.Context, replicationPolicy *registry.ReplicationPolicy, opts v1.CreateOptions) (result *registry.ReplicationPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(replicationpoliciesResource, replicationPolicy), &registry.ReplicationPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*registry.ReplicationPolicy), err
+}
+
+// Update takes the representation of a replicationPolicy and updates it. Returns the server's representation of the replicationPolicy, and an error, if there is any.
+func (c *FakeReplicationPolicies) Update(ctx context.Context, replicationPolicy *registry.ReplicationPolicy, opts v1.UpdateOptions) (result *registry.ReplicationPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(replicationpoliciesResource, replicationPolicy), &registry.ReplicationPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*registry.ReplicationPolicy), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeReplicationPolicies) UpdateStatus(ctx context.Context, replicationPolicy *registry.ReplicationPolicy, opts v1.UpdateOptions) (*registry.ReplicationPolicy, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(replicationpoliciesResource, "status", replicationPolicy), &registry.ReplicationPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*registry.ReplicationPolicy), err
+}
+
+// Delete takes name of the replicationPolicy and deletes it. Returns an error if one occurs.
+func (c *FakeReplicationPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(replicationpoliciesResource, name), &registry.ReplicationPolicy{})
+	return err
+}
+
+// Patch applies the patch and returns the patched replicationPolicy.
+func (c *FakeReplicationPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *registry.ReplicationPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(replicationpoliciesResource, name, pt, data, subresources...), &registry.ReplicationPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*registry.ReplicationPolicy), err
+}
@@ -0,0 +1,116 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	registry "tkestack.io/tke/api/registry"
+)
+
+// FakeTrustedKeys implements TrustedKeyInterface
+type FakeTrustedKeys struct {
+	Fake *FakeRegistry
+}
+
+var trustedkeysResource = schema.GroupVersionResource{Group: "registry.tkestack.io", Version: "", Resource: "trustedkeys"}
+
+var trustedkeysKind = schema.GroupVersionKind{Group: "registry.tkestack.io", Version: "", Kind: "TrustedKey"}
+
+// Get takes name of the trustedKey, and returns the corresponding trustedKey object, and an error if there is any.
+func (c *FakeTrustedKeys) Get(ctx context.Context, name string, options v1.GetOptions) (result *registry.TrustedKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(trustedkeysResource, name), &registry.TrustedKey{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*registry.TrustedKey), err
+}
+
+// List takes label and field selectors, and returns the list of TrustedKeys that match those selectors.
+func (c *FakeTrustedKeys) List(ctx context.Context, opts v1.ListOptions) (result *registry.TrustedKeyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(trustedkeysResource, trustedkeysKind, opts), &registry.TrustedKeyList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &registry.TrustedKeyList{ListMeta: obj.(*registry.TrustedKeyList).ListMeta}
+	for _, item := range obj.(*registry.TrustedKeyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested trustedKeys.
+func (c *FakeTrustedKeys) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(trustedkeysResource, opts))
+}
+
+// Create takes the representation of a trustedKey and creates it.  Returns the server's representation of the trustedKey, and an error, if there is any.
+func (c *FakeTrustedKeys) Create(ctx context.Context, trustedKey *registry.TrustedKey, opts v1.CreateOptions) (result *registry.TrustedKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(trustedkeysResource, trustedKey), &registry.TrustedKey{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*registry.TrustedKey), err
+}
+
+// Update takes the representation of a trustedKey and updates it. Returns the server's representation of the trustedKey, and an error, if there is any.
+func (c *FakeTrustedKeys) Update(ctx context.Context, trustedKey *registry.TrustedKey, opts v1.UpdateOptions) (result *registry.TrustedKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(trustedkeysResource, trustedKey), &registry.TrustedKey{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*registry.TrustedKey), err
+}
+
+// Delete takes name of the trustedKey and deletes it. Returns an error if one occurs.
+func (c *FakeTrustedKeys) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(trustedkeysResource, name), &registry.TrustedKey{})
+	return err
+}
+
+// Patch applies the patch and returns the patched trustedKey.
+func (c *FakeTrustedKeys) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *registry.TrustedKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(trustedkeysResource, name, pt, data, subresources...), &registry.TrustedKey{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*registry.TrustedKey), err
+}
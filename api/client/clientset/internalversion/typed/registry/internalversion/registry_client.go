@@ -32,7 +32,9 @@ type RegistryInterface interface {
 	ChartInfosGetter
 	ConfigMapsGetter
 	NamespacesGetter
+	ReplicationPoliciesGetter
 	RepositoriesGetter
+	TrustedKeysGetter
 }
 
 // RegistryClient is used to interact with features provided by the registry.tkestack.io group.
@@ -60,10 +62,18 @@ func (c *RegistryClient) Namespaces() NamespaceInterface {
 	return newNamespaces(c)
 }
 
+func (c *RegistryClient) ReplicationPolicies() ReplicationPolicyInterface {
+	return newReplicationPolicies(c)
+}
+
 func (c *RegistryClient) Repositories(namespace string) RepositoryInterface {
 	return newRepositories(c, namespace)
 }
 
+func (c *RegistryClient) TrustedKeys() TrustedKeyInterface {
+	return newTrustedKeys(c)
+}
+
 // NewForConfig creates a new RegistryClient for the given config.
 func NewForConfig(c *rest.Config) (*RegistryClient, error) {
 	config := *c
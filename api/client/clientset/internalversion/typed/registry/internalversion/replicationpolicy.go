@@ -0,0 +1,170 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package internalversion
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+	scheme "tkestack.io/tke/api/client/clientset/internalversion/scheme"
+	registry "tkestack.io/tke/api/registry"
+)
+
+// ReplicationPoliciesGetter has a method to return a ReplicationPolicyInterface.
+// A group's client should implement this interface.
+type ReplicationPoliciesGetter interface {
+	ReplicationPolicies() ReplicationPolicyInterface
+}
+
+// ReplicationPolicyInterface has methods to work with ReplicationPolicy resources.
+type ReplicationPolicyInterface interface {
+	Create(ctx context.Context, replicationPolicy *registry.ReplicationPolicy, opts v1.CreateOptions) (*registry.ReplicationPolicy, error)
+	Update(ctx context.Context, replicationPolicy *registry.ReplicationPolicy, opts v1.UpdateOptions) (*registry.ReplicationPolicy, error)
+	UpdateStatus(ctx context.Context, replicationPolicy *registry.ReplicationPolicy, opts v1.UpdateOptions) (*registry.ReplicationPolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*registry.ReplicationPolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*registry.ReplicationPolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *registry.ReplicationPolicy, err error)
+	ReplicationPolicyExpansion
+}
+
+// replicationPolicies implements ReplicationPolicyInterface
+type replicationPolicies struct {
+	client rest.Interface
+}
+
+// newReplicationPolicies returns a ReplicationPolicies
+func newReplicationPolicies(c *RegistryClient) *replicationPolicies {
+	return &replicationPolicies{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the replicationPolicy, and returns the corresponding replicationPolicy object, and an error if there is any.
+func (c *replicationPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *registry.ReplicationPolicy, err error) {
+	result = &registry.ReplicationPolicy{}
+	err = c.client.Get().
+		Resource("replicationpolicies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ReplicationPolicies that match those selectors.
+func (c *replicationPolicies) List(ctx context.Context, opts v1.ListOptions) (result *registry.ReplicationPolicyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &registry.ReplicationPolicyList{}
+	err = c.client.Get().
+		Resource("replicationpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested replicationPolicies.
+func (c *replicationPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("replicationpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a replicationPolicy and creates it.  Returns the server's representation of the replicationPolicy, and an error, if there is any.
+func (c *replicationPolicies) Create(ctx context.Context, replicationPolicy *registry.ReplicationPolicy, opts v1.CreateOptions) (result *registry.ReplicationPolicy, err error) {
+	result = &registry.ReplicationPolicy{}
+	err = c.client.Post().
+		Resource("replicationpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(replicationPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a replicationPolicy and updates it. Returns the server's representation of the replicationPolicy, and an error, if there is any.
+func (c *replicationPolicies) Update(ctx context.Context, replicationPolicy *registry.ReplicationPolicy, opts v1.UpdateOptions) (result *registry.ReplicationPolicy, err error) {
+	result = &registry.ReplicationPolicy{}
+	err = c.client.Put().
+		Resource("replicationpolicies").
+		Name(replicationPolicy.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(replicationPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *replicationPolicies) UpdateStatus(ctx context.Context, replicationPolicy *registry.ReplicationPolicy, opts v1.UpdateOptions) (result *registry.ReplicationPolicy, err error) {
+	result = &registry.ReplicationPolicy{}
+	err = c.client.Put().
+		Resource("replicationpolicies").
+		Name(replicationPolicy.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(replicationPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the replicationPolicy and deletes it. Returns an error if one occurs.
+func (c *replicationPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("replicationpolicies").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched replicationPolicy.
+func (c *replicationPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *registry.ReplicationPolicy, err error) {
+	result = &registry.ReplicationPolicy{}
+	err = c.client.Patch(pt).
+		Resource("replicationpolicies").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
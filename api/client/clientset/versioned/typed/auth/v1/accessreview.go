@@ -0,0 +1,140 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	rest "k8s.io/client-go/rest"
+	v1 "tkestack.io/tke/api/auth/v1"
+	scheme "tkestack.io/tke/api/client/clientset/versioned/scheme"
+)
+
+// AccessReviewsGetter has a method to return a AccessReviewInterface.
+// A group's client should implement this interface.
+type AccessReviewsGetter interface {
+	AccessReviews() AccessReviewInterface
+}
+
+// AccessReviewInterface has methods to work with AccessReview resources.
+type AccessReviewInterface interface {
+	Create(ctx context.Context, accessReview *v1.AccessReview, opts metav1.CreateOptions) (*v1.AccessReview, error)
+	UpdateStatus(ctx context.Context, accessReview *v1.AccessReview, opts metav1.UpdateOptions) (*v1.AccessReview, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.AccessReview, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.AccessReviewList, error)
+	AccessReviewExpansion
+}
+
+// accessReviews implements AccessReviewInterface
+type accessReviews struct {
+	client rest.Interface
+}
+
+// newAccessReviews returns a AccessReviews
+func newAccessReviews(c *AuthV1Client) *accessReviews {
+	return &accessReviews{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the accessReview, and returns the corresponding accessReview object, and an error if there is any.
+func (c *accessReviews) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.AccessReview, err error) {
+	result = &v1.AccessReview{}
+	err = c.client.Get().
+		Resource("accessreviews").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of AccessReviews that match those selectors.
+func (c *accessReviews) List(ctx context.Context, opts metav1.ListOptions) (result *v1.AccessReviewList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.AccessReviewList{}
+	err = c.client.Get().
+		Resource("accessreviews").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Create takes the representation of a accessReview and creates it.  Returns the server's representation of the accessReview, and an error, if there is any.
+func (c *accessReviews) Create(ctx context.Context, accessReview *v1.AccessReview, opts metav1.CreateOptions) (result *v1.AccessReview, err error) {
+	result = &v1.AccessReview{}
+	err = c.client.Post().
+		Resource("accessreviews").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(accessReview).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *accessReviews) UpdateStatus(ctx context.Context, accessReview *v1.AccessReview, opts metav1.UpdateOptions) (result *v1.AccessReview, err error) {
+	result = &v1.AccessReview{}
+	err = c.client.Put().
+		Resource("accessreviews").
+		Name(accessReview.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(accessReview).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the accessReview and deletes it. Returns an error if one occurs.
+func (c *accessReviews) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("accessreviews").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *accessReviews) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("accessreviews").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
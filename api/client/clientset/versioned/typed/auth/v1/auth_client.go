@@ -30,6 +30,8 @@ type AuthV1Interface interface {
 	RESTClient() rest.Interface
 	APIKeysGetter
 	APISigningKeysGetter
+	AccessRequestsGetter
+	AccessReviewsGetter
 	CategoriesGetter
 	ClientsGetter
 	ConfigMapsGetter
@@ -42,6 +44,9 @@ type AuthV1Interface interface {
 	ProjectPolicyBindingsGetter
 	RolesGetter
 	RulesGetter
+	SessionsGetter
+	SessionRevocationsGetter
+	TenantsGetter
 	UsersGetter
 }
 
@@ -58,6 +63,14 @@ func (c *AuthV1Client) APISigningKeys() APISigningKeyInterface {
 	return newAPISigningKeys(c)
 }
 
+func (c *AuthV1Client) AccessRequests() AccessRequestInterface {
+	return newAccessRequests(c)
+}
+
+func (c *AuthV1Client) AccessReviews() AccessReviewInterface {
+	return newAccessReviews(c)
+}
+
 func (c *AuthV1Client) Categories() CategoryInterface {
 	return newCategories(c)
 }
@@ -106,6 +119,18 @@ func (c *AuthV1Client) Rules() RuleInterface {
 	return newRules(c)
 }
 
+func (c *AuthV1Client) Sessions() SessionInterface {
+	return newSessions(c)
+}
+
+func (c *AuthV1Client) SessionRevocations() SessionRevocationInterface {
+	return newSessionRevocations(c)
+}
+
+func (c *AuthV1Client) Tenants() TenantInterface {
+	return newTenants(c)
+}
+
 func (c *AuthV1Client) Users() UserInterface {
 	return newUsers(c)
 }
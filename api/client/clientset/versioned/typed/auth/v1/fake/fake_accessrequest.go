@@ -0,0 +1,135 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	authv1 "tkestack.io/tke/api/auth/v1"
+)
+
+// FakeAccessRequests implements AccessRequestInterface
+type FakeAccessRequests struct {
+	Fake *FakeAuthV1
+}
+
+var accessrequestsResource = schema.GroupVersionResource{Group: "auth.tkestack.io", Version: "v1", Resource: "accessrequests"}
+
+var accessrequestsKind = schema.GroupVersionKind{Group: "auth.tkestack.io", Version: "v1", Kind: "AccessRequest"}
+
+// Get takes name of the accessRequest, and returns the corresponding accessRequest object, and an error if there is any.
+func (c *FakeAccessRequests) Get(ctx context.Context, name string, options v1.GetOptions) (result *authv1.AccessRequest, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(accessrequestsResource, name), &authv1.AccessRequest{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*authv1.AccessRequest), err
+}
+
+// List takes label and field selectors, and returns the list of AccessRequests that match those selectors.
+func (c *FakeAccessRequests) List(ctx context.Context, opts v1.ListOptions) (result *authv1.AccessRequestList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(accessrequestsResource, accessrequestsKind, opts), &authv1.AccessRequestList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &authv1.AccessRequestList{ListMeta: obj.(*authv1.AccessRequestList).ListMeta}
+	for _, item := range obj.(*authv1.AccessRequestList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested accessRequests.
+func (c *FakeAccessRequests) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(accessrequestsResource, opts))
+}
+
+// Create takes the representation of a accessRequest and creates it.  Returns the server's representation of the accessRequest, and an error, if there is any.
+func (c *FakeAccessRequests) Create(ctx context.Context, accessRequest *authv1.AccessRequest, opts v1.CreateOptions) (result *authv1.AccessRequest, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(accessrequestsResource, accessRequest), &authv1.AccessRequest{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*authv1.AccessRequest), err
+}
+
+// Update takes the representation of a accessRequest and updates it. Returns the server's representation of the accessRequest, and an error, if there is any.
+func (c *FakeAccessRequests) Update(ctx context.Context, accessRequest *authv1.AccessRequest, opts v1.UpdateOptions) (result *authv1.AccessRequest, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(accessrequestsResource, accessRequest), &authv1.AccessRequest{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*authv1.AccessRequest), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAccessRequests) UpdateStatus(ctx context.Context, accessRequest *authv1.AccessRequest, opts v1.UpdateOptions) (*authv1.AccessRequest, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(accessrequestsResource, "status", accessRequest), &authv1.AccessRequest{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*authv1.AccessRequest), err
+}
+
+// Delete takes name of the accessRequest and deletes it. Returns an error if one occurs.
+func (c *FakeAccessRequests) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(accessrequestsResource, name), &authv1.AccessRequest{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAccessRequests) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(accessrequestsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &authv1.AccessRequestList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched accessRequest.
+func (c *FakeAccessRequests) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *authv1.AccessRequest, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(accessrequestsResource, name, pt, data, subresources...), &authv1.AccessRequest{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*authv1.AccessRequest), err
+}
@@ -38,6 +38,14 @@ func (c *FakeAuthV1) APISigningKeys() v1.APISigningKeyInterface {
 	return &FakeAPISigningKeys{c}
 }
 
+func (c *FakeAuthV1) AccessRequests() v1.AccessRequestInterface {
+	return &FakeAccessRequests{c}
+}
+
+func (c *FakeAuthV1) AccessReviews() v1.AccessReviewInterface {
+	return &FakeAccessReviews{c}
+}
+
 func (c *FakeAuthV1) Categories() v1.CategoryInterface {
 	return &FakeCategories{c}
 }
@@ -86,6 +94,18 @@ func (c *FakeAuthV1) Rules() v1.RuleInterface {
 	return &FakeRules{c}
 }
 
+func (c *FakeAuthV1) Sessions() v1.SessionInterface {
+	return &FakeSessions{c}
+}
+
+func (c *FakeAuthV1) SessionRevocations() v1.SessionRevocationInterface {
+	return &FakeSessionRevocations{c}
+}
+
+func (c *FakeAuthV1) Tenants() v1.TenantInterface {
+	return &FakeTenants{c}
+}
+
 func (c *FakeAuthV1) Users() v1.UserInterface {
 	return &FakeUsers{c}
 }
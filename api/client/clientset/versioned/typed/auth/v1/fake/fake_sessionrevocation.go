@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	authv1 "tkestack.io/tke/api/auth/v1"
+)
+
+// FakeSessionRevocations implements SessionRevocationInterface
+type FakeSessionRevocations struct {
+	Fake *FakeAuthV1
+}
+
+var sessionrevocationsResource = schema.GroupVersionResource{Group: "auth.tkestack.io", Version: "v1", Resource: "sessionrevocations"}
+
+var sessionrevocationsKind = schema.GroupVersionKind{Group: "auth.tkestack.io", Version: "v1", Kind: "SessionRevocation"}
+
+// Get takes name of the sessionRevocation, and returns the corresponding sessionRevocation object, and an error if there is any.
+func (c *FakeSessionRevocations) Get(ctx context.Context, name string, options v1.GetOptions) (result *authv1.SessionRevocation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(sessionrevocationsResource, name), &authv1.SessionRevocation{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*authv1.SessionRevocation), err
+}
+
+// List takes label and field selectors, and returns the list of SessionRevocations that match those selectors.
+func (c *FakeSessionRevocations) List(ctx context.Context, opts v1.ListOptions) (result *authv1.SessionRevocationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(sessionrevocationsResource, sessionrevocationsKind, opts), &authv1.SessionRevocationList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &authv1.SessionRevocationList{ListMeta: obj.(*authv1.SessionRevocationList).ListMeta}
+	for _, item := range obj.(*authv1.SessionRevocationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested sessionRevocations.
+func (c *FakeSessionRevocations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(sessionrevocationsResource, opts))
+}
+
+// Create takes the representation of a sessionRevocation and creates it.  Returns the server's representation of the sessionRevocation, and an error, if there is any.
+func (c *FakeSessionRevocations) Create(ctx context.Context, sessionRevocation *authv1.SessionRevocation, opts v1.CreateOptions) (result *authv1.SessionRevocation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(sessionrevocationsResource, sessionRevocation), &authv1.SessionRevocation{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*authv1.SessionRevocation), err
+}
+
+// Delete takes name of the sessionRevocation and deletes it. Returns an error if one occurs.
+func (c *FakeSessionRevocations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(sessionrevocationsResource, name), &authv1.SessionRevocation{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeSessionRevocations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(sessionrevocationsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &authv1.SessionRevocationList{})
+	return err
+}
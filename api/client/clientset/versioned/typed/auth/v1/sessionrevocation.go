@@ -0,0 +1,140 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+	v1 "tkestack.io/tke/api/auth/v1"
+	scheme "tkestack.io/tke/api/client/clientset/versioned/scheme"
+)
+
+// SessionRevocationsGetter has a method to return a SessionRevocationInterface.
+// A group's client should implement this interface.
+type SessionRevocationsGetter interface {
+	SessionRevocations() SessionRevocationInterface
+}
+
+// SessionRevocationInterface has methods to work with SessionRevocation resources.
+type SessionRevocationInterface interface {
+	Create(ctx context.Context, sessionRevocation *v1.SessionRevocation, opts metav1.CreateOptions) (*v1.SessionRevocation, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.SessionRevocation, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.SessionRevocationList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	SessionRevocationExpansion
+}
+
+// sessionRevocations implements SessionRevocationInterface
+type sessionRevocations struct {
+	client rest.Interface
+}
+
+// newSessionRevocations returns a SessionRevocations
+func newSessionRevocations(c *AuthV1Client) *sessionRevocations {
+	return &sessionRevocations{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the sessionRevocation, and returns the corresponding sessionRevocation object, and an error if there is any.
+func (c *sessionRevocations) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.SessionRevocation, err error) {
+	result = &v1.SessionRevocation{}
+	err = c.client.Get().
+		Resource("sessionrevocations").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of SessionRevocations that match those selectors.
+func (c *sessionRevocations) List(ctx context.Context, opts metav1.ListOptions) (result *v1.SessionRevocationList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.SessionRevocationList{}
+	err = c.client.Get().
+		Resource("sessionrevocations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested sessionRevocations.
+func (c *sessionRevocations) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("sessionrevocations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a sessionRevocation and creates it.  Returns the server's representation of the sessionRevocation, and an error, if there is any.
+func (c *sessionRevocations) Create(ctx context.Context, sessionRevocation *v1.SessionRevocation, opts metav1.CreateOptions) (result *v1.SessionRevocation, err error) {
+	result = &v1.SessionRevocation{}
+	err = c.client.Post().
+		Resource("sessionrevocations").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(sessionRevocation).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the sessionRevocation and deletes it. Returns an error if one occurs.
+func (c *sessionRevocations) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("sessionrevocations").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *sessionRevocations) DeleteCollection(ctx context.Context, opts metav1.DeleteOptions, listOpts metav1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("sessionrevocations").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
@@ -46,6 +46,10 @@ func (c *FakeBusinessV1) Namespaces(namespace string) v1.NamespaceInterface {
 	return &FakeNamespaces{c, namespace}
 }
 
+func (c *FakeBusinessV1) NamespaceTemplates(namespace string) v1.NamespaceTemplateInterface {
+	return &FakeNamespaceTemplates{c, namespace}
+}
+
 func (c *FakeBusinessV1) NsEmigrations(namespace string) v1.NsEmigrationInterface {
 	return &FakeNsEmigrations{c, namespace}
 }
@@ -62,6 +66,10 @@ func (c *FakeBusinessV1) Projects() v1.ProjectInterface {
 	return &FakeProjects{c}
 }
 
+func (c *FakeBusinessV1) ProjectRequests() v1.ProjectRequestInterface {
+	return &FakeProjectRequests{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeBusinessV1) RESTClient() rest.Interface {
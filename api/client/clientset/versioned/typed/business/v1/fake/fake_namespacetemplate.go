@@ -0,0 +1,132 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	businessv1 "tkestack.io/tke/api/business/v1"
+)
+
+// FakeNamespaceTemplates implements NamespaceTemplateInterface
+type FakeNamespaceTemplates struct {
+	Fake *FakeBusinessV1
+	ns   string
+}
+
+var namespacetemplatesResource = schema.GroupVersionResource{Group: "business.tkestack.io", Version: "v1", Resource: "namespacetemplates"}
+
+var namespacetemplatesKind = schema.GroupVersionKind{Group: "business.tkestack.io", Version: "v1", Kind: "NamespaceTemplate"}
+
+// Get takes name of the namespaceTemplate, and returns the corresponding namespaceTemplate object, and an error if there is any.
+func (c *FakeNamespaceTemplates) Get(ctx context.Context, name string, options v1.GetOptions) (result *businessv1.NamespaceTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(namespacetemplatesResource, c.ns, name), &businessv1.NamespaceTemplate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*businessv1.NamespaceTemplate), err
+}
+
+// List takes label and field selectors, and returns the list of NamespaceTemplates that match those selectors.
+func (c *FakeNamespaceTemplates) List(ctx context.Context, opts v1.ListOptions) (result *businessv1.NamespaceTemplateList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(namespacetemplatesResource, namespacetemplatesKind, c.ns, opts), &businessv1.NamespaceTemplateList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &businessv1.NamespaceTemplateList{ListMeta: obj.(*businessv1.NamespaceTemplateList).ListMeta}
+	for _, item := range obj.(*businessv1.NamespaceTemplateList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested namespaceTemplates.
+func (c *FakeNamespaceTemplates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(namespacetemplatesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a namespaceTemplate and creates it.  Returns the server's representation of the namespaceTemplate, and an error, if there is any.
+func (c *FakeNamespaceTemplates) Create(ctx context.Context, namespaceTemplate *businessv1.NamespaceTemplate, opts v1.CreateOptions) (result *businessv1.NamespaceTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(namespacetemplatesResource, c.ns, namespaceTemplate), &businessv1.NamespaceTemplate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*businessv1.NamespaceTemplate), err
+}
+
+// Update takes the representation of a namespaceTemplate and updates it. Returns the server's representation of the namespaceTemplate, and an error, if there is any.
+func (c *FakeNamespaceTemplates) Update(ctx context.Context, namespaceTemplate *businessv1.NamespaceTemplate, opts v1.UpdateOptions) (result *businessv1.NamespaceTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(namespacetemplatesResource, c.ns, namespaceTemplate), &businessv1.NamespaceTemplate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*businessv1.NamespaceTemplate), err
+}
+
+// Delete takes name of the namespaceTemplate and deletes it. Returns an error if one occurs.
+func (c *FakeNamespaceTemplates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(namespacetemplatesResource, c.ns, name), &businessv1.NamespaceTemplate{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeNamespaceTemplates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(namespacetemplatesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &businessv1.NamespaceTemplateList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched namespaceTemplate.
+func (c *FakeNamespaceTemplates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *businessv1.NamespaceTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(namespacetemplatesResource, c.ns, name, pt, data, subresources...), &businessv1.NamespaceTemplate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*businessv1.NamespaceTemplate), err
+}
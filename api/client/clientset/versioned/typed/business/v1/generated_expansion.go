@@ -28,6 +28,8 @@ type ImageNamespaceExpansion interface{}
 
 type NamespaceExpansion interface{}
 
+type NamespaceTemplateExpansion interface{}
+
 type NsEmigrationExpansion interface{}
 
 type PlatformExpansion interface{}
@@ -35,3 +37,5 @@ type PlatformExpansion interface{}
 type PortalExpansion interface{}
 
 type ProjectExpansion interface{}
+
+type ProjectRequestExpansion interface{}
@@ -0,0 +1,170 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+	v1 "tkestack.io/tke/api/business/v1"
+	scheme "tkestack.io/tke/api/client/clientset/versioned/scheme"
+)
+
+// ProjectRequestsGetter has a method to return a ProjectRequestInterface.
+// A group's client should implement this interface.
+type ProjectRequestsGetter interface {
+	ProjectRequests() ProjectRequestInterface
+}
+
+// ProjectRequestInterface has methods to work with ProjectRequest resources.
+type ProjectRequestInterface interface {
+	Create(ctx context.Context, projectRequest *v1.ProjectRequest, opts metav1.CreateOptions) (*v1.ProjectRequest, error)
+	Update(ctx context.Context, projectRequest *v1.ProjectRequest, opts metav1.UpdateOptions) (*v1.ProjectRequest, error)
+	UpdateStatus(ctx context.Context, projectRequest *v1.ProjectRequest, opts metav1.UpdateOptions) (*v1.ProjectRequest, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.ProjectRequest, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.ProjectRequestList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.ProjectRequest, err error)
+	ProjectRequestExpansion
+}
+
+// projectRequests implements ProjectRequestInterface
+type projectRequests struct {
+	client rest.Interface
+}
+
+// newProjectRequests returns a ProjectRequests
+func newProjectRequests(c *BusinessV1Client) *projectRequests {
+	return &projectRequests{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the projectRequest, and returns the corresponding projectRequest object, and an error if there is any.
+func (c *projectRequests) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.ProjectRequest, err error) {
+	result = &v1.ProjectRequest{}
+	err = c.client.Get().
+		Resource("projectrequests").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ProjectRequests that match those selectors.
+func (c *projectRequests) List(ctx context.Context, opts metav1.ListOptions) (result *v1.ProjectRequestList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.ProjectRequestList{}
+	err = c.client.Get().
+		Resource("projectrequests").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested projectRequests.
+func (c *projectRequests) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("projectrequests").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a projectRequest and creates it.  Returns the server's representation of the projectRequest, and an error, if there is any.
+func (c *projectRequests) Create(ctx context.Context, projectRequest *v1.ProjectRequest, opts metav1.CreateOptions) (result *v1.ProjectRequest, err error) {
+	result = &v1.ProjectRequest{}
+	err = c.client.Post().
+		Resource("projectrequests").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(projectRequest).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a projectRequest and updates it. Returns the server's representation of the projectRequest, and an error, if there is any.
+func (c *projectRequests) Update(ctx context.Context, projectRequest *v1.ProjectRequest, opts metav1.UpdateOptions) (result *v1.ProjectRequest, err error) {
+	result = &v1.ProjectRequest{}
+	err = c.client.Put().
+		Resource("projectrequests").
+		Name(projectRequest.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(projectRequest).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *projectRequests) UpdateStatus(ctx context.Context, projectRequest *v1.ProjectRequest, opts metav1.UpdateOptions) (result *v1.ProjectRequest, err error) {
+	result = &v1.ProjectRequest{}
+	err = c.client.Put().
+		Resource("projectrequests").
+		Name(projectRequest.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(projectRequest).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the projectRequest and deletes it. Returns an error if one occurs.
+func (c *projectRequests) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("projectrequests").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched projectRequest.
+func (c *projectRequests) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.ProjectRequest, err error) {
+	result = &v1.ProjectRequest{}
+	err = c.client.Patch(pt).
+		Resource("projectrequests").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
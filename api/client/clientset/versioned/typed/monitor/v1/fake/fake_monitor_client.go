@@ -42,10 +42,18 @@ func (c *FakeMonitorV1) Metrics() v1.MetricInterface {
 	return &FakeMetrics{c}
 }
 
+func (c *FakeMonitorV1) ProjectUsages() v1.ProjectUsageInterface {
+	return &FakeProjectUsages{c}
+}
+
 func (c *FakeMonitorV1) Prometheuses() v1.PrometheusInterface {
 	return &FakePrometheuses{c}
 }
 
+func (c *FakeMonitorV1) UsageReports() v1.UsageReportInterface {
+	return &FakeUsageReports{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeMonitorV1) RESTClient() rest.Interface {
@@ -0,0 +1,88 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	testing "k8s.io/client-go/testing"
+	monitorv1 "tkestack.io/tke/api/monitor/v1"
+)
+
+// FakeProjectUsages implements ProjectUsageInterface
+type FakeProjectUsages struct {
+	Fake *FakeMonitorV1
+}
+
+var projectusagesResource = schema.GroupVersionResource{Group: "monitor.tkestack.io", Version: "v1", Resource: "projectusages"}
+
+var projectusagesKind = schema.GroupVersionKind{Group: "monitor.tkestack.io", Version: "v1", Kind: "ProjectUsage"}
+
+// Get takes name of the projectUsage, and returns the corresponding projectUsage object, and an error if there is any.
+func (c *FakeProjectUsages) Get(ctx context.Context, name string, options v1.GetOptions) (result *monitorv1.ProjectUsage, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(projectusagesResource, name), &monitorv1.ProjectUsage{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*monitorv1.ProjectUsage), err
+}
+
+// List takes label and field selectors, and returns the list of ProjectUsages that match those selectors.
+func (c *FakeProjectUsages) List(ctx context.Context, opts v1.ListOptions) (result *monitorv1.ProjectUsageList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(projectusagesResource, projectusagesKind, opts), &monitorv1.ProjectUsageList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &monitorv1.ProjectUsageList{ListMeta: obj.(*monitorv1.ProjectUsageList).ListMeta}
+	for _, item := range obj.(*monitorv1.ProjectUsageList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Create takes the representation of a projectUsage and creates it.  Returns the server's representation of the projectUsage, and an error, if there is any.
+func (c *FakeProjectUsages) Create(ctx context.Context, projectUsage *monitorv1.ProjectUsage, opts v1.CreateOptions) (result *monitorv1.ProjectUsage, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(projectusagesResource, projectUsage), &monitorv1.ProjectUsage{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*monitorv1.ProjectUsage), err
+}
+
+// Delete takes name of the projectUsage and deletes it. Returns an error if one occurs.
+func (c *FakeProjectUsages) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(projectusagesResource, name), &monitorv1.ProjectUsage{})
+	return err
+}
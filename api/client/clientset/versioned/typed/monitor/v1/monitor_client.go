@@ -31,7 +31,9 @@ type MonitorV1Interface interface {
 	ClusterOverviewsGetter
 	ConfigMapsGetter
 	MetricsGetter
+	ProjectUsagesGetter
 	PrometheusesGetter
+	UsageReportsGetter
 }
 
 // MonitorV1Client is used to interact with features provided by the monitor.tkestack.io group.
@@ -51,10 +53,18 @@ func (c *MonitorV1Client) Metrics() MetricInterface {
 	return newMetrics(c)
 }
 
+func (c *MonitorV1Client) ProjectUsages() ProjectUsageInterface {
+	return newProjectUsages(c)
+}
+
 func (c *MonitorV1Client) Prometheuses() PrometheusInterface {
 	return newPrometheuses(c)
 }
 
+func (c *MonitorV1Client) UsageReports() UsageReportInterface {
+	return newUsageReports(c)
+}
+
 // NewForConfig creates a new MonitorV1Client for the given config.
 func NewForConfig(c *rest.Config) (*MonitorV1Client, error) {
 	config := *c
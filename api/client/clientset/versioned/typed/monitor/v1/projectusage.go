@@ -0,0 +1,108 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	rest "k8s.io/client-go/rest"
+	scheme "tkestack.io/tke/api/client/clientset/versioned/scheme"
+	v1 "tkestack.io/tke/api/monitor/v1"
+)
+
+// ProjectUsagesGetter has a method to return a ProjectUsageInterface.
+// A group's client should implement this interface.
+type ProjectUsagesGetter interface {
+	ProjectUsages() ProjectUsageInterface
+}
+
+// ProjectUsageInterface has methods to work with ProjectUsage resources.
+type ProjectUsageInterface interface {
+	Create(ctx context.Context, projectUsage *v1.ProjectUsage, opts metav1.CreateOptions) (*v1.ProjectUsage, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.ProjectUsage, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.ProjectUsageList, error)
+	ProjectUsageExpansion
+}
+
+// projectUsages implements ProjectUsageInterface
+type projectUsages struct {
+	client rest.Interface
+}
+
+// newProjectUsages returns a ProjectUsages
+func newProjectUsages(c *MonitorV1Client) *projectUsages {
+	return &projectUsages{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the projectUsage, and returns the corresponding projectUsage object, and an error if there is any.
+func (c *projectUsages) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.ProjectUsage, err error) {
+	result = &v1.ProjectUsage{}
+	err = c.client.Get().
+		Resource("projectusages").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ProjectUsages that match those selectors.
+func (c *projectUsages) List(ctx context.Context, opts metav1.ListOptions) (result *v1.ProjectUsageList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.ProjectUsageList{}
+	err = c.client.Get().
+		Resource("projectusages").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Create takes the representation of a projectUsage and creates it.  Returns the server's representation of the projectUsage, and an error, if there is any.
+func (c *projectUsages) Create(ctx context.Context, projectUsage *v1.ProjectUsage, opts metav1.CreateOptions) (result *v1.ProjectUsage, err error) {
+	result = &v1.ProjectUsage{}
+	err = c.client.Post().
+		Resource("projectusages").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(projectUsage).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the projectUsage and deletes it. Returns an error if one occurs.
+func (c *projectUsages) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("projectusages").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
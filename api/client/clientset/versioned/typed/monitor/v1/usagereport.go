@@ -0,0 +1,66 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	rest "k8s.io/client-go/rest"
+	scheme "tkestack.io/tke/api/client/clientset/versioned/scheme"
+	v1 "tkestack.io/tke/api/monitor/v1"
+)
+
+// UsageReportsGetter has a method to return a UsageReportInterface.
+// A group's client should implement this interface.
+type UsageReportsGetter interface {
+	UsageReports() UsageReportInterface
+}
+
+// UsageReportInterface has methods to work with UsageReport resources.
+type UsageReportInterface interface {
+	Create(ctx context.Context, usageReport *v1.UsageReport, opts metav1.CreateOptions) (*v1.UsageReport, error)
+	UsageReportExpansion
+}
+
+// usageReports implements UsageReportInterface
+type usageReports struct {
+	client rest.Interface
+}
+
+// newUsageReports returns a UsageReports
+func newUsageReports(c *MonitorV1Client) *usageReports {
+	return &usageReports{
+		client: c.RESTClient(),
+	}
+}
+
+// Create takes the representation of a usageReport and creates it.  Returns the server's representation of the usageReport, and an error, if there is any.
+func (c *usageReports) Create(ctx context.Context, usageReport *v1.UsageReport, opts metav1.CreateOptions) (result *v1.UsageReport, err error) {
+	result = &v1.UsageReport{}
+	err = c.client.Post().
+		Resource("usagereports").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(usageReport).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -50,10 +50,18 @@ func (c *FakeRegistryV1) Namespaces() v1.NamespaceInterface {
 	return &FakeNamespaces{c}
 }
 
+func (c *FakeRegistryV1) ReplicationPolicies() v1.ReplicationPolicyInterface {
+	return &FakeReplicationPolicies{c}
+}
+
 func (c *FakeRegistryV1) Repositories(namespace string) v1.RepositoryInterface {
 	return &FakeRepositories{c, namespace}
 }
 
+func (c *FakeRegistryV1) TrustedKeys() v1.TrustedKeyInterface {
+	return &FakeTrustedKeys{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeRegistryV1) RESTClient() rest.Interface {
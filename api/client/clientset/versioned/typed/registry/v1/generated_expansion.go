@@ -30,4 +30,8 @@ type ConfigMapExpansion interface{}
 
 type NamespaceExpansion interface{}
 
+type ReplicationPolicyExpansion interface{}
+
 type RepositoryExpansion interface{}
+
+type TrustedKeyExpansion interface{}
@@ -33,7 +33,9 @@ type RegistryV1Interface interface {
 	ChartInfosGetter
 	ConfigMapsGetter
 	NamespacesGetter
+	ReplicationPoliciesGetter
 	RepositoriesGetter
+	TrustedKeysGetter
 }
 
 // RegistryV1Client is used to interact with features provided by the registry.tkestack.io group.
@@ -61,10 +63,18 @@ func (c *RegistryV1Client) Namespaces() NamespaceInterface {
 	return newNamespaces(c)
 }
 
+func (c *RegistryV1Client) ReplicationPolicies() ReplicationPolicyInterface {
+	return newReplicationPolicies(c)
+}
+
 func (c *RegistryV1Client) Repositories(namespace string) RepositoryInterface {
 	return newRepositories(c, namespace)
 }
 
+func (c *RegistryV1Client) TrustedKeys() TrustedKeyInterface {
+	return newTrustedKeys(c)
+}
+
 // NewForConfig creates a new RegistryV1Client for the given config.
 func NewForConfig(c *rest.Config) (*RegistryV1Client, error) {
 	config := *c
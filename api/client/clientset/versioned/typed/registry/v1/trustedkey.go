@@ -0,0 +1,154 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+	scheme "tkestack.io/tke/api/client/clientset/versioned/scheme"
+	v1 "tkestack.io/tke/api/registry/v1"
+)
+
+// TrustedKeysGetter has a method to return a TrustedKeyInterface.
+// A group's client should implement this interface.
+type TrustedKeysGetter interface {
+	TrustedKeys() TrustedKeyInterface
+}
+
+// TrustedKeyInterface has methods to work with TrustedKey resources.
+type TrustedKeyInterface interface {
+	Create(ctx context.Context, trustedKey *v1.TrustedKey, opts metav1.CreateOptions) (*v1.TrustedKey, error)
+	Update(ctx context.Context, trustedKey *v1.TrustedKey, opts metav1.UpdateOptions) (*v1.TrustedKey, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.TrustedKey, error)
+	List(ctx context.Context, opts metav1.ListOptions) (*v1.TrustedKeyList, error)
+	Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.TrustedKey, err error)
+	TrustedKeyExpansion
+}
+
+// trustedKeys implements TrustedKeyInterface
+type trustedKeys struct {
+	client rest.Interface
+}
+
+// newTrustedKeys returns a TrustedKeys
+func newTrustedKeys(c *RegistryV1Client) *trustedKeys {
+	return &trustedKeys{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the trustedKey, and returns the corresponding trustedKey object, and an error if there is any.
+func (c *trustedKeys) Get(ctx context.Context, name string, options metav1.GetOptions) (result *v1.TrustedKey, err error) {
+	result = &v1.TrustedKey{}
+	err = c.client.Get().
+		Resource("trustedkeys").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of TrustedKeys that match those selectors.
+func (c *trustedKeys) List(ctx context.Context, opts metav1.ListOptions) (result *v1.TrustedKeyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1.TrustedKeyList{}
+	err = c.client.Get().
+		Resource("trustedkeys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested trustedKeys.
+func (c *trustedKeys) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("trustedkeys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a trustedKey and creates it.  Returns the server's representation of the trustedKey, and an error, if there is any.
+func (c *trustedKeys) Create(ctx context.Context, trustedKey *v1.TrustedKey, opts metav1.CreateOptions) (result *v1.TrustedKey, err error) {
+	result = &v1.TrustedKey{}
+	err = c.client.Post().
+		Resource("trustedkeys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(trustedKey).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a trustedKey and updates it. Returns the server's representation of the trustedKey, and an error, if there is any.
+func (c *trustedKeys) Update(ctx context.Context, trustedKey *v1.TrustedKey, opts metav1.UpdateOptions) (result *v1.TrustedKey, err error) {
+	result = &v1.TrustedKey{}
+	err = c.client.Put().
+		Resource("trustedkeys").
+		Name(trustedKey.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(trustedKey).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the trustedKey and deletes it. Returns an error if one occurs.
+func (c *trustedKeys) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("trustedkeys").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched trustedKey.
+func (c *trustedKeys) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (result *v1.TrustedKey, err error) {
+	result = &v1.TrustedKey{}
+	err = c.client.Patch(pt).
+		Resource("trustedkeys").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
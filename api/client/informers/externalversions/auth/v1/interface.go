@@ -30,6 +30,8 @@ type Interface interface {
 	APIKeys() APIKeyInformer
 	// APISigningKeys returns a APISigningKeyInformer.
 	APISigningKeys() APISigningKeyInformer
+	// AccessRequests returns a AccessRequestInformer.
+	AccessRequests() AccessRequestInformer
 	// Categories returns a CategoryInformer.
 	Categories() CategoryInformer
 	// Clients returns a ClientInformer.
@@ -54,6 +56,10 @@ type Interface interface {
 	Roles() RoleInformer
 	// Rules returns a RuleInformer.
 	Rules() RuleInformer
+	// SessionRevocations returns a SessionRevocationInformer.
+	SessionRevocations() SessionRevocationInformer
+	// Tenants returns a TenantInformer.
+	Tenants() TenantInformer
 	// Users returns a UserInformer.
 	Users() UserInformer
 }
@@ -79,6 +85,11 @@ func (v *version) APISigningKeys() APISigningKeyInformer {
 	return &aPISigningKeyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// AccessRequests returns a AccessRequestInformer.
+func (v *version) AccessRequests() AccessRequestInformer {
+	return &accessRequestInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Categories returns a CategoryInformer.
 func (v *version) Categories() CategoryInformer {
 	return &categoryInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -139,6 +150,16 @@ func (v *version) Rules() RuleInformer {
 	return &ruleInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// SessionRevocations returns a SessionRevocationInformer.
+func (v *version) SessionRevocations() SessionRevocationInformer {
+	return &sessionRevocationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Tenants returns a TenantInformer.
+func (v *version) Tenants() TenantInformer {
+	return &tenantInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Users returns a UserInformer.
 func (v *version) Users() UserInformer {
 	return &userInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
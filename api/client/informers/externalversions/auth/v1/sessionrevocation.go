@@ -0,0 +1,91 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	time "time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+	authv1 "tkestack.io/tke/api/auth/v1"
+	versioned "tkestack.io/tke/api/client/clientset/versioned"
+	internalinterfaces "tkestack.io/tke/api/client/informers/externalversions/internalinterfaces"
+	v1 "tkestack.io/tke/api/client/listers/auth/v1"
+)
+
+// SessionRevocationInformer provides access to a shared informer and lister for
+// SessionRevocations.
+type SessionRevocationInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1.SessionRevocationLister
+}
+
+type sessionRevocationInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewSessionRevocationInformer constructs a new informer for SessionRevocation type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewSessionRevocationInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredSessionRevocationInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredSessionRevocationInformer constructs a new informer for SessionRevocation type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredSessionRevocationInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.AuthV1().SessionRevocations().List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.AuthV1().SessionRevocations().Watch(context.TODO(), options)
+			},
+		},
+		&authv1.SessionRevocation{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *sessionRevocationInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredSessionRevocationInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *sessionRevocationInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&authv1.SessionRevocation{}, f.defaultInformer)
+}
+
+func (f *sessionRevocationInformer) Lister() v1.SessionRevocationLister {
+	return v1.NewSessionRevocationLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"context"
+	time "time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+	businessv1 "tkestack.io/tke/api/business/v1"
+	versioned "tkestack.io/tke/api/client/clientset/versioned"
+	internalinterfaces "tkestack.io/tke/api/client/informers/externalversions/internalinterfaces"
+	v1 "tkestack.io/tke/api/client/listers/business/v1"
+)
+
+// NamespaceTemplateInformer provides access to a shared informer and lister for
+// NamespaceTemplates.
+type NamespaceTemplateInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1.NamespaceTemplateLister
+}
+
+type namespaceTemplateInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewNamespaceTemplateInformer constructs a new informer for NamespaceTemplate type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewNamespaceTemplateInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredNamespaceTemplateInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredNamespaceTemplateInformer constructs a new informer for NamespaceTemplate type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredNamespaceTemplateInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.BusinessV1().NamespaceTemplates(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.BusinessV1().NamespaceTemplates(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&businessv1.NamespaceTemplate{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *namespaceTemplateInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredNamespaceTemplateInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *namespaceTemplateInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&businessv1.NamespaceTemplate{}, f.defaultInformer)
+}
+
+func (f *namespaceTemplateInformer) Lister() v1.NamespaceTemplateLister {
+	return v1.NewNamespaceTemplateLister(f.Informer().GetIndexer())
+}
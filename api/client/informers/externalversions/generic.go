@@ -73,6 +73,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().APIKeys().Informer()}, nil
 	case authv1.SchemeGroupVersion.WithResource("apisigningkeys"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().APISigningKeys().Informer()}, nil
+	case authv1.SchemeGroupVersion.WithResource("accessrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().AccessRequests().Informer()}, nil
 	case authv1.SchemeGroupVersion.WithResource("categories"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().Categories().Informer()}, nil
 	case authv1.SchemeGroupVersion.WithResource("clients"):
@@ -97,6 +99,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().Roles().Informer()}, nil
 	case authv1.SchemeGroupVersion.WithResource("rules"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().Rules().Informer()}, nil
+	case authv1.SchemeGroupVersion.WithResource("sessionrevocations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().SessionRevocations().Informer()}, nil
+	case authv1.SchemeGroupVersion.WithResource("tenants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().Tenants().Informer()}, nil
 	case authv1.SchemeGroupVersion.WithResource("users"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().V1().Users().Informer()}, nil
 
@@ -109,12 +115,16 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().ImageNamespaces().Informer()}, nil
 	case businessv1.SchemeGroupVersion.WithResource("namespaces"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().Namespaces().Informer()}, nil
+	case businessv1.SchemeGroupVersion.WithResource("namespacetemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().NamespaceTemplates().Informer()}, nil
 	case businessv1.SchemeGroupVersion.WithResource("nsemigrations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().NsEmigrations().Informer()}, nil
 	case businessv1.SchemeGroupVersion.WithResource("platforms"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().Platforms().Informer()}, nil
 	case businessv1.SchemeGroupVersion.WithResource("projects"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().Projects().Informer()}, nil
+	case businessv1.SchemeGroupVersion.WithResource("projectrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().ProjectRequests().Informer()}, nil
 
 		// Group=logagent.tkestack.io, Version=v1
 	case logagentv1.SchemeGroupVersion.WithResource("configmaps"):
@@ -179,8 +189,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Registry().V1().ConfigMaps().Informer()}, nil
 	case registryv1.SchemeGroupVersion.WithResource("namespaces"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Registry().V1().Namespaces().Informer()}, nil
+	case registryv1.SchemeGroupVersion.WithResource("replicationpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Registry().V1().ReplicationPolicies().Informer()}, nil
 	case registryv1.SchemeGroupVersion.WithResource("repositories"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Registry().V1().Repositories().Informer()}, nil
+	case registryv1.SchemeGroupVersion.WithResource("trustedkeys"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Registry().V1().TrustedKeys().Informer()}, nil
 
 	}
 
@@ -34,8 +34,12 @@ type Interface interface {
 	ConfigMaps() ConfigMapInformer
 	// Namespaces returns a NamespaceInformer.
 	Namespaces() NamespaceInformer
+	// ReplicationPolicies returns a ReplicationPolicyInformer.
+	ReplicationPolicies() ReplicationPolicyInformer
 	// Repositories returns a RepositoryInformer.
 	Repositories() RepositoryInformer
+	// TrustedKeys returns a TrustedKeyInformer.
+	TrustedKeys() TrustedKeyInformer
 }
 
 type version struct {
@@ -69,7 +73,17 @@ func (v *version) Namespaces() NamespaceInformer {
 	return &namespaceInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ReplicationPolicies returns a ReplicationPolicyInformer.
+func (v *version) ReplicationPolicies() ReplicationPolicyInformer {
+	return &replicationPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Repositories returns a RepositoryInformer.
 func (v *version) Repositories() RepositoryInformer {
 	return &repositoryInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// TrustedKeys returns a TrustedKeyInformer.
+func (v *version) TrustedKeys() TrustedKeyInformer {
+	return &trustedKeyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
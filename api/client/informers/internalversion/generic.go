@@ -73,6 +73,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().APIKeys().Informer()}, nil
 	case auth.SchemeGroupVersion.WithResource("apisigningkeys"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().APISigningKeys().Informer()}, nil
+	case auth.SchemeGroupVersion.WithResource("accessrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().AccessRequests().Informer()}, nil
 	case auth.SchemeGroupVersion.WithResource("categories"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().Categories().Informer()}, nil
 	case auth.SchemeGroupVersion.WithResource("clients"):
@@ -99,6 +101,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().Roles().Informer()}, nil
 	case auth.SchemeGroupVersion.WithResource("rules"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().Rules().Informer()}, nil
+	case auth.SchemeGroupVersion.WithResource("sessionrevocations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().SessionRevocations().Informer()}, nil
+	case auth.SchemeGroupVersion.WithResource("tenants"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().Tenants().Informer()}, nil
 	case auth.SchemeGroupVersion.WithResource("users"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Auth().InternalVersion().Users().Informer()}, nil
 
@@ -111,12 +117,16 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().ImageNamespaces().Informer()}, nil
 	case business.SchemeGroupVersion.WithResource("namespaces"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().Namespaces().Informer()}, nil
+	case business.SchemeGroupVersion.WithResource("namespacetemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().NamespaceTemplates().Informer()}, nil
 	case business.SchemeGroupVersion.WithResource("nsemigrations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().NsEmigrations().Informer()}, nil
 	case business.SchemeGroupVersion.WithResource("platforms"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().Platforms().Informer()}, nil
 	case business.SchemeGroupVersion.WithResource("projects"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().Projects().Informer()}, nil
+	case business.SchemeGroupVersion.WithResource("projectrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().ProjectRequests().Informer()}, nil
 
 		// Group=logagent.tkestack.io, Version=internalVersion
 	case logagent.SchemeGroupVersion.WithResource("configmaps"):
@@ -181,8 +191,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Registry().InternalVersion().ConfigMaps().Informer()}, nil
 	case registry.SchemeGroupVersion.WithResource("namespaces"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Registry().InternalVersion().Namespaces().Informer()}, nil
+	case registry.SchemeGroupVersion.WithResource("replicationpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Registry().InternalVersion().ReplicationPolicies().Informer()}, nil
 	case registry.SchemeGroupVersion.WithResource("repositories"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Registry().InternalVersion().Repositories().Informer()}, nil
+	case registry.SchemeGroupVersion.WithResource("trustedkeys"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Registry().InternalVersion().TrustedKeys().Informer()}, nil
 
 	}
 
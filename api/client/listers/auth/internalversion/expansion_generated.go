@@ -28,6 +28,14 @@ type APIKeyListerExpansion interface{}
 // APISigningKeyLister.
 type APISigningKeyListerExpansion interface{}
 
+// AccessRequestListerExpansion allows custom methods to be added to
+// AccessRequestLister.
+type AccessRequestListerExpansion interface{}
+
+// AccessReviewListerExpansion allows custom methods to be added to
+// AccessReviewLister.
+type AccessReviewListerExpansion interface{}
+
 // CategoryListerExpansion allows custom methods to be added to
 // CategoryLister.
 type CategoryListerExpansion interface{}
@@ -84,6 +92,18 @@ type RoleListerExpansion interface{}
 // RuleLister.
 type RuleListerExpansion interface{}
 
+// SessionListerExpansion allows custom methods to be added to
+// SessionLister.
+type SessionListerExpansion interface{}
+
+// SessionRevocationListerExpansion allows custom methods to be added to
+// SessionRevocationLister.
+type SessionRevocationListerExpansion interface{}
+
+// TenantListerExpansion allows custom methods to be added to
+// TenantLister.
+type TenantListerExpansion interface{}
+
 // UserListerExpansion allows custom methods to be added to
 // UserLister.
 type UserListerExpansion interface{}
@@ -0,0 +1,101 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package internalversion
+
+import (
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	business "tkestack.io/tke/api/business"
+)
+
+// NamespaceTemplateLister helps list NamespaceTemplates.
+// All objects returned here must be treated as read-only.
+type NamespaceTemplateLister interface {
+	// List lists all NamespaceTemplates in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*business.NamespaceTemplate, err error)
+	// NamespaceTemplates returns an object that can list and get NamespaceTemplates.
+	NamespaceTemplates(namespace string) NamespaceTemplateNamespaceLister
+	NamespaceTemplateListerExpansion
+}
+
+// namespaceTemplateLister implements the NamespaceTemplateLister interface.
+type namespaceTemplateLister struct {
+	indexer cache.Indexer
+}
+
+// NewNamespaceTemplateLister returns a new NamespaceTemplateLister.
+func NewNamespaceTemplateLister(indexer cache.Indexer) NamespaceTemplateLister {
+	return &namespaceTemplateLister{indexer: indexer}
+}
+
+// List lists all NamespaceTemplates in the indexer.
+func (s *namespaceTemplateLister) List(selector labels.Selector) (ret []*business.NamespaceTemplate, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*business.NamespaceTemplate))
+	})
+	return ret, err
+}
+
+// NamespaceTemplates returns an object that can list and get NamespaceTemplates.
+func (s *namespaceTemplateLister) NamespaceTemplates(namespace string) NamespaceTemplateNamespaceLister {
+	return namespaceTemplateNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// NamespaceTemplateNamespaceLister helps list and get NamespaceTemplates.
+// All objects returned here must be treated as read-only.
+type NamespaceTemplateNamespaceLister interface {
+	// List lists all NamespaceTemplates in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*business.NamespaceTemplate, err error)
+	// Get retrieves the NamespaceTemplate from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*business.NamespaceTemplate, error)
+	NamespaceTemplateNamespaceListerExpansion
+}
+
+// namespaceTemplateNamespaceLister implements the NamespaceTemplateNamespaceLister
+// interface.
+type namespaceTemplateNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all NamespaceTemplates in the indexer for a given namespace.
+func (s namespaceTemplateNamespaceLister) List(selector labels.Selector) (ret []*business.NamespaceTemplate, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*business.NamespaceTemplate))
+	})
+	return ret, err
+}
+
+// Get retrieves the NamespaceTemplate from the indexer for a given namespace and name.
+func (s namespaceTemplateNamespaceLister) Get(name string) (*business.NamespaceTemplate, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(business.Resource("namespacetemplate"), name)
+	}
+	return obj.(*business.NamespaceTemplate), nil
+}
@@ -48,6 +48,14 @@ type NamespaceListerExpansion interface{}
 // NamespaceNamespaceLister.
 type NamespaceNamespaceListerExpansion interface{}
 
+// NamespaceTemplateListerExpansion allows custom methods to be added to
+// NamespaceTemplateLister.
+type NamespaceTemplateListerExpansion interface{}
+
+// NamespaceTemplateNamespaceListerExpansion allows custom methods to be added to
+// NamespaceTemplateNamespaceLister.
+type NamespaceTemplateNamespaceListerExpansion interface{}
+
 // NsEmigrationListerExpansion allows custom methods to be added to
 // NsEmigrationLister.
 type NsEmigrationListerExpansion interface{}
@@ -67,3 +75,7 @@ type PortalListerExpansion interface{}
 // ProjectListerExpansion allows custom methods to be added to
 // ProjectLister.
 type ProjectListerExpansion interface{}
+
+// ProjectRequestListerExpansion allows custom methods to be added to
+// ProjectRequestLister.
+type ProjectRequestListerExpansion interface{}
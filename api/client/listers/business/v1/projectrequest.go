@@ -0,0 +1,70 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	v1 "tkestack.io/tke/api/business/v1"
+)
+
+// ProjectRequestLister helps list ProjectRequests.
+// All objects returned here must be treated as read-only.
+type ProjectRequestLister interface {
+	// List lists all ProjectRequests in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.ProjectRequest, err error)
+	// Get retrieves the ProjectRequest from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.ProjectRequest, error)
+	ProjectRequestListerExpansion
+}
+
+// projectRequestLister implements the ProjectRequestLister interface.
+type projectRequestLister struct {
+	indexer cache.Indexer
+}
+
+// NewProjectRequestLister returns a new ProjectRequestLister.
+func NewProjectRequestLister(indexer cache.Indexer) ProjectRequestLister {
+	return &projectRequestLister{indexer: indexer}
+}
+
+// List lists all ProjectRequests in the indexer.
+func (s *projectRequestLister) List(selector labels.Selector) (ret []*v1.ProjectRequest, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.ProjectRequest))
+	})
+	return ret, err
+}
+
+// Get retrieves the ProjectRequest from the index for a given name.
+func (s *projectRequestLister) Get(name string) (*v1.ProjectRequest, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("projectrequest"), name)
+	}
+	return obj.(*v1.ProjectRequest), nil
+}
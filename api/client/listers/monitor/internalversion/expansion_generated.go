@@ -24,6 +24,10 @@ package internalversion
 // ConfigMapLister.
 type ConfigMapListerExpansion interface{}
 
+// ProjectUsageListerExpansion allows custom methods to be added to
+// ProjectUsageLister.
+type ProjectUsageListerExpansion interface{}
+
 // PrometheusListerExpansion allows custom methods to be added to
 // PrometheusLister.
 type PrometheusListerExpansion interface{}
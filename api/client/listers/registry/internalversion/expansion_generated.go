@@ -48,6 +48,10 @@ type ConfigMapListerExpansion interface{}
 // NamespaceLister.
 type NamespaceListerExpansion interface{}
 
+// ReplicationPolicyListerExpansion allows custom methods to be added to
+// ReplicationPolicyLister.
+type ReplicationPolicyListerExpansion interface{}
+
 // RepositoryListerExpansion allows custom methods to be added to
 // RepositoryLister.
 type RepositoryListerExpansion interface{}
@@ -55,3 +59,7 @@ type RepositoryListerExpansion interface{}
 // RepositoryNamespaceListerExpansion allows custom methods to be added to
 // RepositoryNamespaceLister.
 type RepositoryNamespaceListerExpansion interface{}
+
+// TrustedKeyListerExpansion allows custom methods to be added to
+// TrustedKeyLister.
+type TrustedKeyListerExpansion interface{}
@@ -0,0 +1,70 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2020 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package internalversion
+
+import (
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	registry "tkestack.io/tke/api/registry"
+)
+
+// TrustedKeyLister helps list TrustedKeys.
+// All objects returned here must be treated as read-only.
+type TrustedKeyLister interface {
+	// List lists all TrustedKeys in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*registry.TrustedKey, err error)
+	// Get retrieves the TrustedKey from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*registry.TrustedKey, error)
+	TrustedKeyListerExpansion
+}
+
+// trustedKeyLister implements the TrustedKeyLister interface.
+type trustedKeyLister struct {
+	indexer cache.Indexer
+}
+
+// NewTrustedKeyLister returns a new TrustedKeyLister.
+func NewTrustedKeyLister(indexer cache.Indexer) TrustedKeyLister {
+	return &trustedKeyLister{indexer: indexer}
+}
+
+// List lists all TrustedKeys in the indexer.
+func (s *trustedKeyLister) List(selector labels.Selector) (ret []*registry.TrustedKey, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*registry.TrustedKey))
+	})
+	return ret, err
+}
+
+// Get retrieves the TrustedKey from the index for a given name.
+func (s *trustedKeyLister) Get(name string) (*registry.TrustedKey, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(registry.Resource("trustedkey"), name)
+	}
+	return obj.(*registry.TrustedKey), nil
+}
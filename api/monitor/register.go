@@ -69,6 +69,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ConfigMap{},
 		&ConfigMapList{},
 
-		&ClusterOverview{})
+		&ClusterOverview{},
+
+		&ProjectUsage{},
+		&ProjectUsageList{},
+
+		&UsageReport{},
+		&UsageReportList{})
 	return nil
 }
@@ -307,3 +307,189 @@ type ConfigMapList struct {
 	// Items is the list of ConfigMaps.
 	Items []ConfigMap
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection,update,patch,watch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectUsage is a periodic snapshot of the allocation and usage of a project.
+type ProjectUsage struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// +optional
+	Spec ProjectUsageSpec
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectUsageList is the whole list of all project usage snapshots.
+type ProjectUsageList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of project usage snapshots
+	Items []ProjectUsage
+}
+
+// ProjectUsageSpec describes the allocation and usage of a project at a time.
+type ProjectUsageSpec struct {
+	TenantID    string
+	ProjectName string
+	// Timestamp is the time the snapshot was taken.
+	Timestamp metav1.Time
+	// Period is the time the snapshot stands for until the next snapshot.
+	// The hourly snapshots older than a week are rolled up into daily snapshots.
+	Period metav1.Duration
+	// +optional
+	Clusters []ClusterUsage
+}
+
+// ClusterUsage describes the allocation and usage of a project in a cluster.
+type ClusterUsage struct {
+	ClusterName string
+	// Hard is the quota of the project in the cluster.
+	// +optional
+	Hard ResourceList
+	// Allocated is the sum of the quota of the namespaces of the project in the cluster.
+	// +optional
+	Allocated ResourceList
+	// Used is the sum of the usage of the namespaces of the project in the cluster.
+	// +optional
+	Used ResourceList
+	// +optional
+	Namespaces []NamespaceUsage
+}
+
+// NamespaceUsage describes the allocation and usage of a namespace.
+type NamespaceUsage struct {
+	Namespace string
+	// +optional
+	Allocated ResourceList
+	// +optional
+	Used ResourceList
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// UsageReport defines the structure for querying the chargeback report
+// aggregated from the project usage snapshots.
+type UsageReport struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// +optional
+	Query UsageReportQuery
+	// +optional
+	Result *UsageReportResult
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// UsageReportList is the whole list of all usage reports.
+type UsageReportList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of usage reports
+	Items []UsageReport
+}
+
+// UsageReportQuery describes the time range and the aggregation of a usage report.
+type UsageReportQuery struct {
+	StartTime metav1.Time
+	EndTime   metav1.Time
+	// GroupBy is one of Project, Cluster and Tenant, defaults to Project.
+	// +optional
+	GroupBy UsageReportGroupBy
+	// ProjectName limits the report to a project.
+	// +optional
+	ProjectName string
+	// ClusterName limits the report to a cluster.
+	// +optional
+	ClusterName string
+	// ChargeBy is Allocated to charge the quota allocated to the namespaces,
+	// or Used to charge the usage, defaults to Allocated.
+	// +optional
+	ChargeBy UsageReportChargeBy
+	// Prices overrides the configured unit prices, which are the prices of a
+	// unit of the resources per hour. The unit is a core for cpu, a GiB for
+	// memory and storage, and one for the others.
+	// +optional
+	Prices map[string]string
+	// Format is JSON or CSV, the report is exported into the result in CSV
+	// format as well if CSV.
+	// +optional
+	Format UsageReportFormat
+}
+
+// UsageReportResult is the aggregated usage of a usage report.
+type UsageReportResult struct {
+	// +optional
+	Items []UsageReportItem
+	// TotalCost is the sum of the cost of the items.
+	TotalCost float64
+	// CSV is the report in CSV format.
+	// +optional
+	CSV string
+}
+
+// UsageReportItem is the aggregated usage of a project, a cluster or a tenant.
+type UsageReportItem struct {
+	// Name is the name of the project, the cluster or the tenant.
+	Name string
+	// +optional
+	Resources []UsageReportResource
+	Cost      float64
+}
+
+// UsageReportResource is the aggregated usage of a resource in unit hours.
+type UsageReportResource struct {
+	Resource       string
+	AllocatedHours float64
+	UsedHours      float64
+	Price          float64
+	Cost           float64
+}
+
+// UsageReportGroupBy indicates how the usage is aggregated.
+type UsageReportGroupBy string
+
+const (
+	// UsageReportGroupByProject aggregates the usage by project.
+	UsageReportGroupByProject UsageReportGroupBy = "Project"
+	// UsageReportGroupByCluster aggregates the usage by cluster.
+	UsageReportGroupByCluster UsageReportGroupBy = "Cluster"
+	// UsageReportGroupByTenant aggregates the usage by tenant.
+	UsageReportGroupByTenant UsageReportGroupBy = "Tenant"
+)
+
+// UsageReportChargeBy indicates what is charged.
+type UsageReportChargeBy string
+
+const (
+	// UsageReportChargeByAllocated charges the quota allocated to the namespaces.
+	UsageReportChargeByAllocated UsageReportChargeBy = "Allocated"
+	// UsageReportChargeByUsed charges the usage of the namespaces.
+	UsageReportChargeByUsed UsageReportChargeBy = "Used"
+)
+
+// UsageReportFormat indicates the format a usage report is exported in.
+type UsageReportFormat string
+
+const (
+	// UsageReportFormatJSON exports the report in JSON.
+	UsageReportFormatJSON UsageReportFormat = "JSON"
+	// UsageReportFormatCSV exports the report in CSV as well.
+	UsageReportFormatCSV UsageReportFormat = "CSV"
+)
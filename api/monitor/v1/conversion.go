@@ -26,6 +26,7 @@ import (
 func addConversionFuncs(scheme *runtime.Scheme) error {
 	funcs := []func(scheme *runtime.Scheme) error{
 		AddFieldLabelConversionsForPrometheus,
+		AddFieldLabelConversionsForProjectUsage,
 	}
 	for _, f := range funcs {
 		if err := f(scheme); err != nil {
@@ -55,3 +56,20 @@ func AddFieldLabelConversionsForPrometheus(scheme *runtime.Scheme) error {
 			}
 		})
 }
+
+// AddFieldLabelConversionsForProjectUsage adds a conversion function to convert
+// field selectors of ProjectUsage from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForProjectUsage(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("ProjectUsage"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.projectName",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
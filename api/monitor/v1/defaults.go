@@ -37,3 +37,15 @@ func SetDefaults_PrometheusStatus(obj *PrometheusStatus) {
 		obj.Phase = AddonPhaseInitializing
 	}
 }
+
+func SetDefaults_UsageReportQuery(obj *UsageReportQuery) {
+	if obj.GroupBy == "" {
+		obj.GroupBy = UsageReportGroupByProject
+	}
+	if obj.ChargeBy == "" {
+		obj.ChargeBy = UsageReportChargeByAllocated
+	}
+	if obj.Format == "" {
+		obj.Format = UsageReportFormatJSON
+	}
+}
@@ -131,10 +131,38 @@ func (m *ClusterStatistic) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterStatistic proto.InternalMessageInfo
 
+func (m *ClusterUsage) Reset()      { *m = ClusterUsage{} }
+func (*ClusterUsage) ProtoMessage() {}
+func (*ClusterUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9feea175c75e123, []int{3}
+}
+func (m *ClusterUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterUsage.Merge(m, src)
+}
+func (m *ClusterUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterUsage proto.InternalMessageInfo
+
 func (m *ConfigMap) Reset()      { *m = ConfigMap{} }
 func (*ConfigMap) ProtoMessage() {}
 func (*ConfigMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9feea175c75e123, []int{3}
+	return fileDescriptor_c9feea175c75e123, []int{4}
 }
 func (m *ConfigMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,7 +190,7 @@ var xxx_messageInfo_ConfigMap proto.InternalMessageInfo
 func (m *ConfigMapList) Reset()      { *m = ConfigMapList{} }
 func (*ConfigMapList) ProtoMessage() {}
 func (*ConfigMapList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9feea175c75e123, []int{4}
+	return fileDescriptor_c9feea175c75e123, []int{5}
 }
 func (m *ConfigMapList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -190,7 +218,7 @@ var xxx_messageInfo_ConfigMapList proto.InternalMessageInfo
 func (m *Metric) Reset()      { *m = Metric{} }
 func (*Metric) ProtoMessage() {}
 func (*Metric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9feea175c75e123, []int{5}
+	return fileDescriptor_c9feea175c75e123, []int{6}
 }
 func (m *Metric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +246,7 @@ var xxx_messageInfo_Metric proto.InternalMessageInfo
 func (m *MetricList) Reset()      { *m = MetricList{} }
 func (*MetricList) ProtoMessage() {}
 func (*MetricList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9feea175c75e123, []int{6}
+	return fileDescriptor_c9feea175c75e123, []int{7}
 }
 func (m *MetricList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,7 +274,7 @@ var xxx_messageInfo_MetricList proto.InternalMessageInfo
 func (m *MetricQuery) Reset()      { *m = MetricQuery{} }
 func (*MetricQuery) ProtoMessage() {}
 func (*MetricQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9feea175c75e123, []int{7}
+	return fileDescriptor_c9feea175c75e123, []int{8}
 }
 func (m *MetricQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -274,7 +302,7 @@ var xxx_messageInfo_MetricQuery proto.InternalMessageInfo
 func (m *MetricQueryCondition) Reset()      { *m = MetricQueryCondition{} }
 func (*MetricQueryCondition) ProtoMessage() {}
 func (*MetricQueryCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9feea175c75e123, []int{8}
+	return fileDescriptor_c9feea175c75e123, []int{9}
 }
 func (m *MetricQueryCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,10 +327,122 @@ func (m *MetricQueryCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MetricQueryCondition proto.InternalMessageInfo
 
+func (m *NamespaceUsage) Reset()      { *m = NamespaceUsage{} }
+func (*NamespaceUsage) ProtoMessage() {}
+func (*NamespaceUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9feea175c75e123, []int{10}
+}
+func (m *NamespaceUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NamespaceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceUsage.Merge(m, src)
+}
+func (m *NamespaceUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceUsage proto.InternalMessageInfo
+
+func (m *ProjectUsage) Reset()      { *m = ProjectUsage{} }
+func (*ProjectUsage) ProtoMessage() {}
+func (*ProjectUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9feea175c75e123, []int{11}
+}
+func (m *ProjectUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectUsage.Merge(m, src)
+}
+func (m *ProjectUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectUsage proto.InternalMessageInfo
+
+func (m *ProjectUsageList) Reset()      { *m = ProjectUsageList{} }
+func (*ProjectUsageList) ProtoMessage() {}
+func (*ProjectUsageList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9feea175c75e123, []int{12}
+}
+func (m *ProjectUsageList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectUsageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectUsageList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectUsageList.Merge(m, src)
+}
+func (m *ProjectUsageList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectUsageList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectUsageList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectUsageList proto.InternalMessageInfo
+
+func (m *ProjectUsageSpec) Reset()      { *m = ProjectUsageSpec{} }
+func (*ProjectUsageSpec) ProtoMessage() {}
+func (*ProjectUsageSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9feea175c75e123, []int{13}
+}
+func (m *ProjectUsageSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectUsageSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectUsageSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectUsageSpec.Merge(m, src)
+}
+func (m *ProjectUsageSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectUsageSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectUsageSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectUsageSpec proto.InternalMessageInfo
+
 func (m *Prometheus) Reset()      { *m = Prometheus{} }
 func (*Prometheus) ProtoMessage() {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9feea175c75e123, []int{9}
+	return fileDescriptor_c9feea175c75e123, []int{14}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -330,7 +470,7 @@ var xxx_messageInfo_Prometheus proto.InternalMessageInfo
 func (m *PrometheusList) Reset()      { *m = PrometheusList{} }
 func (*PrometheusList) ProtoMessage() {}
 func (*PrometheusList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9feea175c75e123, []int{10}
+	return fileDescriptor_c9feea175c75e123, []int{15}
 }
 func (m *PrometheusList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +498,7 @@ var xxx_messageInfo_PrometheusList proto.InternalMessageInfo
 func (m *PrometheusRemoteAddr) Reset()      { *m = PrometheusRemoteAddr{} }
 func (*PrometheusRemoteAddr) ProtoMessage() {}
 func (*PrometheusRemoteAddr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9feea175c75e123, []int{11}
+	return fileDescriptor_c9feea175c75e123, []int{16}
 }
 func (m *PrometheusRemoteAddr) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +526,7 @@ var xxx_messageInfo_PrometheusRemoteAddr proto.InternalMessageInfo
 func (m *PrometheusSpec) Reset()      { *m = PrometheusSpec{} }
 func (*PrometheusSpec) ProtoMessage() {}
 func (*PrometheusSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9feea175c75e123, []int{12}
+	return fileDescriptor_c9feea175c75e123, []int{17}
 }
 func (m *PrometheusSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +554,7 @@ var xxx_messageInfo_PrometheusSpec proto.InternalMessageInfo
 func (m *PrometheusStatus) Reset()      { *m = PrometheusStatus{} }
 func (*PrometheusStatus) ProtoMessage() {}
 func (*PrometheusStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9feea175c75e123, []int{13}
+	return fileDescriptor_c9feea175c75e123, []int{18}
 }
 func (m *PrometheusStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -442,7 +582,7 @@ var xxx_messageInfo_PrometheusStatus proto.InternalMessageInfo
 func (m *ResourceRequirements) Reset()      { *m = ResourceRequirements{} }
 func (*ResourceRequirements) ProtoMessage() {}
 func (*ResourceRequirements) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c9feea175c75e123, []int{14}
+	return fileDescriptor_c9feea175c75e123, []int{19}
 }
 func (m *ResourceRequirements) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,10 +607,182 @@ func (m *ResourceRequirements) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceRequirements proto.InternalMessageInfo
 
+func (m *UsageReport) Reset()      { *m = UsageReport{} }
+func (*UsageReport) ProtoMessage() {}
+func (*UsageReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9feea175c75e123, []int{20}
+}
+func (m *UsageReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UsageReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageReport.Merge(m, src)
+}
+func (m *UsageReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageReport proto.InternalMessageInfo
+
+func (m *UsageReportItem) Reset()      { *m = UsageReportItem{} }
+func (*UsageReportItem) ProtoMessage() {}
+func (*UsageReportItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9feea175c75e123, []int{21}
+}
+func (m *UsageReportItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageReportItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UsageReportItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageReportItem.Merge(m, src)
+}
+func (m *UsageReportItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageReportItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageReportItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageReportItem proto.InternalMessageInfo
+
+func (m *UsageReportList) Reset()      { *m = UsageReportList{} }
+func (*UsageReportList) ProtoMessage() {}
+func (*UsageReportList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9feea175c75e123, []int{22}
+}
+func (m *UsageReportList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageReportList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UsageReportList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageReportList.Merge(m, src)
+}
+func (m *UsageReportList) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageReportList) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageReportList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageReportList proto.InternalMessageInfo
+
+func (m *UsageReportQuery) Reset()      { *m = UsageReportQuery{} }
+func (*UsageReportQuery) ProtoMessage() {}
+func (*UsageReportQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9feea175c75e123, []int{23}
+}
+func (m *UsageReportQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageReportQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UsageReportQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageReportQuery.Merge(m, src)
+}
+func (m *UsageReportQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageReportQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageReportQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageReportQuery proto.InternalMessageInfo
+
+func (m *UsageReportResource) Reset()      { *m = UsageReportResource{} }
+func (*UsageReportResource) ProtoMessage() {}
+func (*UsageReportResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9feea175c75e123, []int{24}
+}
+func (m *UsageReportResource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageReportResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UsageReportResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageReportResource.Merge(m, src)
+}
+func (m *UsageReportResource) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageReportResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageReportResource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageReportResource proto.InternalMessageInfo
+
+func (m *UsageReportResult) Reset()      { *m = UsageReportResult{} }
+func (*UsageReportResult) ProtoMessage() {}
+func (*UsageReportResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9feea175c75e123, []int{25}
+}
+func (m *UsageReportResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsageReportResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UsageReportResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageReportResult.Merge(m, src)
+}
+func (m *UsageReportResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsageReportResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageReportResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageReportResult proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ClusterOverview)(nil), "tkestack.io.tke.api.monitor.v1.ClusterOverview")
 	proto.RegisterType((*ClusterOverviewResult)(nil), "tkestack.io.tke.api.monitor.v1.ClusterOverviewResult")
 	proto.RegisterType((*ClusterStatistic)(nil), "tkestack.io.tke.api.monitor.v1.ClusterStatistic")
+	proto.RegisterType((*ClusterUsage)(nil), "tkestack.io.tke.api.monitor.v1.ClusterUsage")
+	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.monitor.v1.ClusterUsage.AllocatedEntry")
+	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.monitor.v1.ClusterUsage.HardEntry")
+	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.monitor.v1.ClusterUsage.UsedEntry")
 	proto.RegisterType((*ConfigMap)(nil), "tkestack.io.tke.api.monitor.v1.ConfigMap")
 	proto.RegisterMapType((map[string][]byte)(nil), "tkestack.io.tke.api.monitor.v1.ConfigMap.BinaryDataEntry")
 	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.monitor.v1.ConfigMap.DataEntry")
@@ -479,6 +791,12 @@ func init() {
 	proto.RegisterType((*MetricList)(nil), "tkestack.io.tke.api.monitor.v1.MetricList")
 	proto.RegisterType((*MetricQuery)(nil), "tkestack.io.tke.api.monitor.v1.MetricQuery")
 	proto.RegisterType((*MetricQueryCondition)(nil), "tkestack.io.tke.api.monitor.v1.MetricQueryCondition")
+	proto.RegisterType((*NamespaceUsage)(nil), "tkestack.io.tke.api.monitor.v1.NamespaceUsage")
+	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.monitor.v1.NamespaceUsage.AllocatedEntry")
+	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.monitor.v1.NamespaceUsage.UsedEntry")
+	proto.RegisterType((*ProjectUsage)(nil), "tkestack.io.tke.api.monitor.v1.ProjectUsage")
+	proto.RegisterType((*ProjectUsageList)(nil), "tkestack.io.tke.api.monitor.v1.ProjectUsageList")
+	proto.RegisterType((*ProjectUsageSpec)(nil), "tkestack.io.tke.api.monitor.v1.ProjectUsageSpec")
 	proto.RegisterType((*Prometheus)(nil), "tkestack.io.tke.api.monitor.v1.Prometheus")
 	proto.RegisterType((*PrometheusList)(nil), "tkestack.io.tke.api.monitor.v1.PrometheusList")
 	proto.RegisterType((*PrometheusRemoteAddr)(nil), "tkestack.io.tke.api.monitor.v1.PrometheusRemoteAddr")
@@ -489,6 +807,13 @@ func init() {
 	proto.RegisterType((*ResourceRequirements)(nil), "tkestack.io.tke.api.monitor.v1.ResourceRequirements")
 	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.monitor.v1.ResourceRequirements.LimitsEntry")
 	proto.RegisterMapType((ResourceList)(nil), "tkestack.io.tke.api.monitor.v1.ResourceRequirements.RequestsEntry")
+	proto.RegisterType((*UsageReport)(nil), "tkestack.io.tke.api.monitor.v1.UsageReport")
+	proto.RegisterType((*UsageReportItem)(nil), "tkestack.io.tke.api.monitor.v1.UsageReportItem")
+	proto.RegisterType((*UsageReportList)(nil), "tkestack.io.tke.api.monitor.v1.UsageReportList")
+	proto.RegisterType((*UsageReportQuery)(nil), "tkestack.io.tke.api.monitor.v1.UsageReportQuery")
+	proto.RegisterMapType((map[string]string)(nil), "tkestack.io.tke.api.monitor.v1.UsageReportQuery.PricesEntry")
+	proto.RegisterType((*UsageReportResource)(nil), "tkestack.io.tke.api.monitor.v1.UsageReportResource")
+	proto.RegisterType((*UsageReportResult)(nil), "tkestack.io.tke.api.monitor.v1.UsageReportResult")
 }
 
 func init() {
@@ -496,148 +821,193 @@ func init() {
 }
 
 var fileDescriptor_c9feea175c75e123 = []byte{
-	// 2253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcd, 0x73, 0x1c, 0x47,
-	0x15, 0xf7, 0x68, 0xb5, 0x6b, 0x6d, 0xeb, 0x33, 0x6d, 0xd9, 0x59, 0x64, 0xb2, 0x12, 0x9b, 0x22,
-	0xa5, 0x24, 0x30, 0x8b, 0x4d, 0x02, 0x86, 0x14, 0x29, 0xb4, 0x2b, 0x43, 0x9c, 0x78, 0xa5, 0x4d,
-	0xcb, 0xb2, 0x21, 0x95, 0x03, 0xad, 0xd9, 0xd6, 0x6a, 0xa2, 0x9d, 0x0f, 0xcf, 0xf4, 0xac, 0xbd,
-	0x14, 0x87, 0xfc, 0x09, 0x70, 0xe0, 0x02, 0x7f, 0x02, 0x7f, 0x00, 0x27, 0x8a, 0x13, 0x55, 0x3e,
-	0x51, 0x39, 0xfa, 0xe4, 0xc2, 0xe2, 0xc8, 0x85, 0x73, 0xb8, 0x50, 0xfd, 0xba, 0xa7, 0xa7, 0x67,
-	0x76, 0x65, 0xad, 0x3f, 0xd0, 0x85, 0xdb, 0xce, 0x7b, 0xbf, 0xf7, 0xd1, 0xaf, 0x5f, 0xff, 0xfa,
-	0x63, 0x91, 0xcd, 0x8f, 0x59, 0xcc, 0xa9, 0x73, 0x6c, 0xbb, 0x41, 0x93, 0x1f, 0xb3, 0x26, 0x0d,
-	0xdd, 0xa6, 0x17, 0xf8, 0x2e, 0x0f, 0xa2, 0xe6, 0xf0, 0x5a, 0xb3, 0xcf, 0x7c, 0x16, 0x51, 0xce,
-	0x7a, 0x76, 0x18, 0x05, 0x3c, 0xc0, 0x75, 0x03, 0x2f, 0x6c, 0x6d, 0x1a, 0xba, 0xb6, 0xc2, 0xdb,
-	0xc3, 0x6b, 0x6b, 0xdf, 0xed, 0xbb, 0xfc, 0x28, 0x39, 0xb0, 0x9d, 0xc0, 0x6b, 0xf6, 0x83, 0x7e,
-	0xd0, 0x04, 0xb3, 0x83, 0xe4, 0x10, 0xbe, 0xe0, 0x03, 0x7e, 0x49, 0x77, 0x6b, 0xef, 0x1d, 0xdf,
-	0x88, 0x45, 0x64, 0x1a, 0xba, 0x1e, 0x75, 0x8e, 0x5c, 0x9f, 0x45, 0xa3, 0x66, 0x78, 0xdc, 0x87,
-	0x34, 0x22, 0x16, 0x07, 0x49, 0xe4, 0xb0, 0x62, 0x12, 0xcf, 0xb4, 0x8a, 0x9b, 0x1e, 0xe3, 0x74,
-	0x42, 0xea, 0x6b, 0xcd, 0xd3, 0xac, 0xa2, 0xc4, 0xe7, 0xae, 0x37, 0x1e, 0xe6, 0x07, 0x67, 0x19,
-	0xc4, 0xce, 0x11, 0xf3, 0x68, 0xd1, 0xae, 0xf1, 0x77, 0x0b, 0x2d, 0xb7, 0x07, 0x49, 0xcc, 0x59,
-	0xb4, 0x3b, 0x64, 0xd1, 0xd0, 0x65, 0x0f, 0xf0, 0xaf, 0xd0, 0x9c, 0xc8, 0xab, 0x47, 0x39, 0xad,
-	0x59, 0x1b, 0xd6, 0xe6, 0xfc, 0xf5, 0xef, 0xd9, 0xd2, 0xbd, 0x6d, 0xba, 0xb7, 0xc3, 0xe3, 0xbe,
-	0x10, 0xc4, 0xb6, 0x40, 0xdb, 0xc3, 0x6b, 0xf6, 0xee, 0xc1, 0x17, 0xcc, 0xe1, 0x1d, 0xc6, 0x69,
-	0x0b, 0x3f, 0x7a, 0xb2, 0x7e, 0xe1, 0xe4, 0xc9, 0x3a, 0xca, 0x64, 0x44, 0x7b, 0xc5, 0xbf, 0x44,
-	0x95, 0x88, 0xc5, 0xc9, 0x80, 0xd7, 0x66, 0xc0, 0xff, 0xfb, 0xf6, 0xb3, 0xa7, 0xca, 0x2e, 0xa4,
-	0x48, 0xc0, 0xb8, 0x85, 0x4e, 0x9e, 0xac, 0x57, 0xe4, 0x6f, 0xa2, 0x1c, 0x36, 0xfe, 0x56, 0x45,
-	0x97, 0x27, 0xa2, 0xf1, 0x0d, 0xb4, 0xe0, 0x48, 0x45, 0x3b, 0x48, 0x7c, 0x0e, 0x43, 0x2b, 0xb7,
-	0x56, 0x55, 0xa2, 0x0b, 0x6d, 0x43, 0x47, 0x72, 0x48, 0xbc, 0x85, 0x96, 0xd5, 0xf7, 0xd6, 0x81,
-	0x1f, 0x44, 0x1e, 0x1d, 0x40, 0xde, 0xe5, 0xd6, 0xeb, 0xca, 0x38, 0x2d, 0x61, 0xaa, 0x26, 0x45,
-	0xbc, 0x08, 0x1e, 0x46, 0x81, 0x28, 0x85, 0x0c, 0x5e, 0xca, 0x07, 0xef, 0x1a, 0x3a, 0x92, 0x43,
-	0x8a, 0xe0, 0xea, 0x5b, 0x07, 0x9f, 0xcd, 0x07, 0xef, 0xe6, 0xd5, 0xa4, 0x88, 0xc7, 0x4d, 0x54,
-	0xf5, 0x83, 0x1e, 0x93, 0x91, 0xcb, 0x60, 0xfc, 0x9a, 0x32, 0xae, 0xee, 0xa4, 0x0a, 0x92, 0x61,
-	0x44, 0xb6, 0xe2, 0x43, 0x07, 0xac, 0xe4, 0xb3, 0xdd, 0x31, 0x74, 0x24, 0x87, 0xc4, 0x1f, 0xa0,
-	0xc5, 0x07, 0x41, 0x74, 0x3c, 0x08, 0x68, 0x4f, 0x86, 0xbb, 0x08, 0xa6, 0x97, 0x95, 0xe9, 0xe2,
-	0x3d, 0x53, 0x49, 0xf2, 0x58, 0xbc, 0x8d, 0x56, 0x52, 0x81, 0x0e, 0x3d, 0x07, 0xf6, 0x35, 0x65,
-	0xbf, 0x72, 0xaf, 0xa0, 0x27, 0x63, 0x16, 0xf8, 0x7d, 0x34, 0xef, 0x84, 0x49, 0x9b, 0x86, 0xd4,
-	0x71, 0xf9, 0xa8, 0x56, 0xdd, 0xb0, 0x36, 0xad, 0xd6, 0x25, 0xe5, 0x60, 0xbe, 0xdd, 0xdd, 0x4f,
-	0x55, 0xc4, 0xc4, 0xe1, 0x0f, 0xd1, 0x92, 0x13, 0x26, 0x5b, 0x83, 0x41, 0xe0, 0x50, 0x4e, 0x0f,
-	0x06, 0xac, 0x86, 0xc0, 0xf2, 0x8a, 0xb2, 0x5c, 0x6a, 0x77, 0xf7, 0x0d, 0x2d, 0x29, 0xa0, 0x71,
-	0x07, 0x5d, 0x72, 0xc2, 0x64, 0x27, 0xe0, 0x84, 0xd1, 0xde, 0x48, 0x87, 0x9f, 0x07, 0x27, 0x57,
-	0x95, 0x93, 0x4b, 0xed, 0xee, 0x7e, 0x11, 0x42, 0x26, 0xd9, 0xe1, 0xbb, 0xe8, 0x8a, 0x21, 0x36,
-	0xd3, 0x5a, 0x00, 0x8f, 0x75, 0xe5, 0xf1, 0x8a, 0xe1, 0xd1, 0x4c, 0xef, 0x14, 0x6b, 0x51, 0x1d,
-	0x8f, 0x79, 0x3a, 0xbd, 0xc5, 0x0d, 0x6b, 0xb3, 0x94, 0x55, 0xa7, 0x93, 0xa9, 0x88, 0x89, 0x13,
-	0xd5, 0xf1, 0x98, 0x67, 0xa6, 0xb1, 0x04, 0x96, 0xba, 0x3a, 0x9d, 0x9c, 0x96, 0x14, 0xd0, 0xa2,
-	0x3a, 0x1e, 0xf3, 0xc6, 0xaa, 0xb3, 0x0c, 0x4e, 0x74, 0x75, 0x3a, 0xe3, 0x10, 0x32, 0xc9, 0x4e,
-	0x54, 0xc7, 0x10, 0x9b, 0x69, 0xad, 0x80, 0x47, 0x5d, 0x9d, 0xce, 0x44, 0x14, 0x39, 0xc5, 0x1a,
-	0x7f, 0x07, 0xcd, 0x85, 0x81, 0xea, 0xdc, 0xd7, 0xa0, 0xf3, 0x56, 0x94, 0xa7, 0xb9, 0xae, 0x92,
-	0x13, 0x8d, 0xc0, 0x9f, 0xa1, 0x39, 0xb5, 0xce, 0xe3, 0x1a, 0xde, 0x28, 0x01, 0x51, 0x4e, 0x47,
-	0x64, 0x7b, 0x9c, 0x72, 0x37, 0xe6, 0xae, 0xd3, 0x5a, 0x10, 0xbe, 0x95, 0x34, 0x26, 0xda, 0x5f,
-	0xe3, 0x3f, 0xcb, 0x68, 0xa5, 0x08, 0x16, 0x0b, 0x59, 0x01, 0x6e, 0x6d, 0x03, 0x7f, 0x55, 0xb3,
-	0x85, 0xdc, 0x4e, 0x15, 0x24, 0xc3, 0xe0, 0x8f, 0x11, 0x56, 0x1f, 0xdb, 0x6e, 0x1c, 0x0e, 0xe8,
-	0x68, 0x87, 0x7a, 0x0c, 0xc8, 0xab, 0xda, 0x5a, 0x53, 0x96, 0xb8, 0x3d, 0x86, 0x20, 0x13, 0xac,
-	0x44, 0x6d, 0x38, 0xf3, 0xa9, 0xcf, 0x6f, 0x6d, 0x03, 0x7d, 0x55, 0xb3, 0xda, 0xdc, 0x51, 0x72,
-	0xa2, 0x11, 0x06, 0xdb, 0x76, 0x8f, 0x68, 0xcc, 0x80, 0xb3, 0xaa, 0x63, 0x6c, 0x0b, 0x3a, 0x92,
-	0x43, 0xfe, 0x9f, 0xb1, 0xd5, 0x16, 0x5a, 0x3e, 0xa2, 0x71, 0x87, 0xf1, 0xc8, 0x75, 0xf6, 0x58,
-	0x34, 0x64, 0x11, 0x30, 0xd6, 0x5c, 0x46, 0xef, 0x1f, 0xe5, 0xd5, 0xa4, 0x88, 0xc7, 0x6f, 0xa3,
-	0x8b, 0x4e, 0x98, 0xec, 0xc7, 0xac, 0xa7, 0x28, 0x6b, 0x59, 0x99, 0x5e, 0x6c, 0x77, 0xf7, 0x85,
-	0x98, 0xa4, 0x7a, 0x7c, 0x1d, 0x21, 0x27, 0x4c, 0x08, 0xbb, 0x9f, 0xb0, 0x98, 0x2b, 0x6e, 0xd2,
-	0x5b, 0x75, 0xbb, 0xbb, 0xaf, 0x34, 0xc4, 0x40, 0x89, 0x79, 0x77, 0xc2, 0xe4, 0xb6, 0xeb, 0xb9,
-	0x5c, 0x71, 0x8f, 0x9e, 0xf7, 0x76, 0x77, 0x1f, 0xe4, 0x44, 0x23, 0x8a, 0xec, 0xbb, 0xf8, 0xc2,
-	0xec, 0xbb, 0xf4, 0x2a, 0xd8, 0x77, 0xf9, 0x95, 0xb3, 0xef, 0xca, 0x4b, 0xb1, 0xaf, 0x1c, 0x66,
-	0x5a, 0x65, 0xca, 0x19, 0xb0, 0x4c, 0x35, 0x37, 0x4c, 0x43, 0x4b, 0x0a, 0x68, 0x58, 0xcf, 0xb9,
-	0x81, 0x83, 0x0f, 0x5c, 0x58, 0xcf, 0xf9, 0x52, 0x09, 0x3f, 0x13, 0xac, 0xd4, 0xbc, 0xee, 0xc7,
-	0xb4, 0xcf, 0x6a, 0x97, 0xf2, 0xeb, 0x19, 0xfa, 0x86, 0xf6, 0x19, 0xd1, 0x08, 0xd1, 0x64, 0x1e,
-	0xf3, 0xa0, 0xc9, 0x56, 0x81, 0x62, 0x75, 0x93, 0x75, 0xa4, 0x98, 0xa4, 0x7a, 0xd1, 0x64, 0x1e,
-	0xf3, 0xd2, 0x26, 0xbb, 0x0c, 0x68, 0xdd, 0x64, 0x1d, 0xad, 0x21, 0x06, 0x4a, 0x24, 0xe3, 0x31,
-	0x4f, 0x36, 0xd9, 0x15, 0xb0, 0xd0, 0xc9, 0x74, 0x94, 0x9c, 0x68, 0x44, 0x71, 0x13, 0x7b, 0xfd,
-	0x85, 0x37, 0xb1, 0xda, 0xab, 0xd8, 0xc4, 0xbe, 0xf1, 0xca, 0x37, 0xb1, 0xb5, 0x97, 0xda, 0xc4,
-	0xe4, 0x30, 0xcd, 0x26, 0xbb, 0x9a, 0x6f, 0xb2, 0x4e, 0x4e, 0x4b, 0x0a, 0x68, 0xd1, 0x64, 0xf9,
-	0x81, 0x83, 0x8f, 0x6f, 0xe6, 0x9b, 0xac, 0x33, 0x86, 0x20, 0x13, 0xac, 0xd4, 0xbc, 0xca, 0x26,
-	0x7b, 0x23, 0xdf, 0x64, 0x1d, 0x25, 0x27, 0x1a, 0x91, 0xdb, 0x7e, 0xeb, 0x67, 0x6e, 0xbf, 0xdb,
-	0x68, 0x45, 0xdc, 0x6a, 0x7a, 0xc9, 0x80, 0x45, 0x1f, 0x31, 0x3a, 0xe0, 0x47, 0xa3, 0xda, 0x3a,
-	0x70, 0xa7, 0x26, 0xe0, 0xbd, 0x82, 0x9e, 0x8c, 0x59, 0xe0, 0xcf, 0x51, 0xcd, 0x09, 0x7c, 0x1e,
-	0x05, 0x83, 0x01, 0x8b, 0x3a, 0xd4, 0xa7, 0xfd, 0xcc, 0xdb, 0x06, 0x78, 0xdb, 0x50, 0xde, 0x6a,
-	0xed, 0x53, 0x70, 0xe4, 0x54, 0x0f, 0xa2, 0x53, 0x19, 0x77, 0x7a, 0xa9, 0xc3, 0x6f, 0x81, 0x43,
-	0xdd, 0xa9, 0x37, 0x33, 0x15, 0x31, 0x71, 0x8d, 0x3f, 0x95, 0x50, 0xb5, 0x1d, 0xf8, 0x87, 0x6e,
-	0xbf, 0x43, 0xc3, 0x73, 0xb8, 0x90, 0xed, 0xa3, 0x59, 0xf0, 0x3e, 0x03, 0xa7, 0x98, 0xef, 0x9f,
-	0x79, 0x8a, 0x49, 0x53, 0xb3, 0xb7, 0x29, 0xa7, 0x37, 0x7d, 0x1e, 0x8d, 0x5a, 0x0b, 0x2a, 0xc0,
-	0xac, 0x10, 0x11, 0x70, 0x87, 0x3d, 0x84, 0x0e, 0x5c, 0x9f, 0x46, 0x23, 0x21, 0xab, 0x95, 0xc0,
-	0xf9, 0x8f, 0xa6, 0x77, 0xde, 0xd2, 0xb6, 0x32, 0x84, 0x1e, 0x43, 0xa6, 0x20, 0x46, 0x80, 0xb5,
-	0x1f, 0xa2, 0xaa, 0x06, 0xe3, 0x15, 0x54, 0x3a, 0x66, 0x23, 0x79, 0x4a, 0x22, 0xe2, 0x27, 0x5e,
-	0x45, 0xe5, 0x21, 0x1d, 0x24, 0xea, 0xfc, 0x43, 0xe4, 0xc7, 0x8f, 0x67, 0x6e, 0x58, 0x6b, 0x3f,
-	0x41, 0xcb, 0x85, 0x58, 0x67, 0x99, 0x2f, 0x18, 0xe6, 0x8d, 0xbf, 0x58, 0x68, 0x51, 0x67, 0x7d,
-	0xdb, 0x8d, 0x39, 0xfe, 0x7c, 0x6c, 0xc6, 0xec, 0xe9, 0x66, 0x4c, 0x58, 0xc3, 0x7c, 0xe9, 0xc6,
-	0x4f, 0x25, 0xc6, 0x6c, 0xed, 0xa0, 0xb2, 0xcb, 0x99, 0x17, 0xab, 0xe9, 0x7a, 0x7b, 0xea, 0x8a,
-	0xb6, 0x16, 0x95, 0xd7, 0xf2, 0x2d, 0x61, 0x4f, 0xa4, 0x9b, 0xc6, 0xbf, 0x2d, 0x54, 0x91, 0x27,
-	0x8a, 0x73, 0x68, 0xb5, 0x2e, 0x2a, 0xdf, 0x4f, 0x58, 0x34, 0x52, 0x57, 0xff, 0x77, 0xcf, 0x4a,
-	0x5e, 0x26, 0xf6, 0xa9, 0x30, 0xc9, 0xd2, 0x87, 0x4f, 0x22, 0x1d, 0x89, 0xfd, 0xe6, 0x8b, 0x38,
-	0xf0, 0xe5, 0x35, 0x5f, 0x1d, 0x4d, 0x75, 0x0e, 0x1f, 0xef, 0xed, 0xee, 0xa8, 0x27, 0x02, 0x03,
-	0xd5, 0xf8, 0xb3, 0x85, 0x90, 0xf4, 0x7c, 0x0e, 0xf3, 0xf5, 0x49, 0x7e, 0xbe, 0xde, 0x9a, 0x6e,
-	0xc8, 0xa7, 0x4c, 0xd6, 0xe3, 0x12, 0x9a, 0x37, 0x6a, 0x82, 0xdf, 0x44, 0x65, 0xb9, 0x69, 0xc8,
-	0xfb, 0x80, 0x36, 0xba, 0x03, 0x1c, 0x2c, 0x75, 0xf8, 0x5d, 0x54, 0x8d, 0x39, 0x8d, 0xf8, 0x1d,
-	0x57, 0x1d, 0xff, 0x4b, 0xad, 0x45, 0x71, 0x9e, 0xde, 0x4b, 0x85, 0x24, 0xd3, 0xe3, 0x6f, 0xa3,
-	0x8b, 0xcc, 0xef, 0x01, 0xb4, 0x04, 0xd0, 0x79, 0xb1, 0xcd, 0xdf, 0x94, 0x22, 0x92, 0xea, 0x70,
-	0x03, 0x55, 0x0e, 0x5d, 0x36, 0xe8, 0xc5, 0xb5, 0xd9, 0x8d, 0xd2, 0x66, 0x55, 0xbe, 0xc6, 0xfc,
-	0x0c, 0x24, 0x44, 0x69, 0xf0, 0x11, 0x42, 0x4e, 0xe0, 0xf7, 0x5c, 0xee, 0x06, 0x7e, 0x5c, 0x2b,
-	0xc3, 0xf0, 0xdf, 0x7b, 0x8e, 0x19, 0x6f, 0xa7, 0xc6, 0xc6, 0x29, 0x55, 0xfb, 0x23, 0x86, 0x6f,
-	0x71, 0x3e, 0x09, 0xa2, 0x1e, 0x8b, 0x5a, 0x23, 0x38, 0xff, 0x57, 0xb3, 0xf3, 0xc9, 0xae, 0x14,
-	0x93, 0x54, 0x2f, 0x2a, 0x06, 0x3f, 0xe1, 0xb4, 0x6f, 0x54, 0x0c, 0x80, 0x44, 0xea, 0x44, 0x11,
-	0xfa, 0x51, 0x90, 0x84, 0xad, 0x51, 0x6d, 0x0e, 0x86, 0x07, 0x45, 0xf8, 0xb9, 0x14, 0x91, 0x54,
-	0x27, 0x7c, 0x0d, 0xe0, 0xd0, 0x52, 0x85, 0xed, 0x4a, 0xfb, 0x92, 0x27, 0x16, 0xa9, 0xc3, 0x6f,
-	0xa1, 0x4a, 0x70, 0x78, 0x18, 0x33, 0x0e, 0xe7, 0xf3, 0x72, 0x6b, 0x49, 0xa1, 0x2a, 0xbb, 0x20,
-	0x25, 0x4a, 0xdb, 0xf8, 0x0d, 0x5a, 0x9d, 0x34, 0x76, 0xfc, 0x86, 0xc1, 0x45, 0xad, 0x79, 0x65,
-	0x5c, 0xfa, 0x84, 0x8d, 0x24, 0x31, 0x6d, 0xa0, 0x59, 0xf6, 0x30, 0x8c, 0xd4, 0xb5, 0x4e, 0xf3,
-	0xf0, 0xcd, 0x87, 0x61, 0x44, 0x40, 0x23, 0xb2, 0x94, 0xd4, 0x55, 0xca, 0x8f, 0xf8, 0xae, 0x10,
-	0x2a, 0x26, 0x6b, 0xfc, 0x61, 0x06, 0xa1, 0x6e, 0x14, 0x78, 0x8c, 0x1f, 0xb1, 0x24, 0x3e, 0x17,
-	0x26, 0x98, 0x8d, 0x43, 0xe6, 0x28, 0x22, 0xb0, 0xcf, 0x6a, 0x8b, 0x2c, 0xb7, 0xbd, 0x90, 0x39,
-	0xd9, 0x38, 0xc5, 0x17, 0x01, 0x4f, 0xf8, 0x17, 0xa8, 0x12, 0x73, 0xca, 0x93, 0x18, 0x06, 0x3a,
-	0xc5, 0x75, 0xdc, 0xf0, 0x09, 0x76, 0xd9, 0xd4, 0xc8, 0x6f, 0xa2, 0xfc, 0x35, 0xfe, 0x6a, 0xa1,
-	0xa5, 0x0c, 0x7c, 0x0e, 0x9c, 0xb1, 0x9b, 0xe7, 0x8c, 0x77, 0xa6, 0x1f, 0xc9, 0x29, 0xbc, 0xe1,
-	0xa1, 0xd5, 0x0c, 0x43, 0x98, 0x17, 0x70, 0xb6, 0xd5, 0xeb, 0x45, 0x82, 0x1a, 0x1e, 0x44, 0xae,
-	0xfc, 0xa8, 0x59, 0xd0, 0xea, 0x40, 0x0d, 0xf7, 0x52, 0x21, 0xc9, 0xf4, 0x78, 0x13, 0xcd, 0x45,
-	0x8c, 0xf6, 0x00, 0x3b, 0x03, 0x58, 0x78, 0xbf, 0x20, 0x4a, 0x46, 0xb4, 0xb6, 0xf1, 0xc7, 0x8a,
-	0x59, 0x30, 0x31, 0x47, 0xb9, 0x07, 0x04, 0xeb, 0xcc, 0x07, 0x04, 0x71, 0x91, 0x94, 0xcf, 0x02,
-	0xc6, 0x9b, 0x45, 0x76, 0x91, 0xcc, 0x54, 0xc4, 0xc4, 0x09, 0x1e, 0x18, 0xb2, 0x28, 0x76, 0x03,
-	0x5f, 0x35, 0xbb, 0xe6, 0x81, 0xbb, 0x52, 0x4c, 0x52, 0x3d, 0x8e, 0x10, 0x8a, 0x93, 0x03, 0x25,
-	0x06, 0x12, 0x9b, 0xbf, 0xfe, 0xe1, 0xf3, 0x75, 0xa1, 0xbd, 0xa7, 0x1d, 0x14, 0x8e, 0x28, 0x99,
-	0x82, 0x18, 0x51, 0xf0, 0x7d, 0xb4, 0x18, 0xe9, 0xda, 0xb3, 0x38, 0x86, 0x07, 0x8e, 0x29, 0x38,
-	0x71, 0xd2, 0xd4, 0x65, 0xef, 0x14, 0xc4, 0x74, 0x49, 0xf2, 0x11, 0xf0, 0x07, 0x68, 0xd1, 0x0f,
-	0xb8, 0x7b, 0x38, 0xba, 0xc7, 0x0e, 0x8e, 0x82, 0xe0, 0x58, 0xf1, 0xa3, 0x36, 0xde, 0x31, 0x95,
-	0x24, 0x8f, 0xc5, 0x0c, 0x55, 0xd3, 0xbf, 0x36, 0x62, 0xe0, 0xcb, 0x29, 0x72, 0x25, 0xca, 0x40,
-	0xdc, 0x29, 0xdc, 0x88, 0x79, 0xcc, 0xe7, 0x71, 0xf6, 0x84, 0x93, 0x6a, 0x63, 0x92, 0x79, 0x16,
-	0x93, 0x1d, 0x25, 0xfe, 0xae, 0xdf, 0xa1, 0x62, 0x22, 0xe1, 0x19, 0xc5, 0x38, 0x26, 0x93, 0x4c,
-	0x45, 0x4c, 0x9c, 0xb8, 0x90, 0xd1, 0x01, 0x8b, 0x38, 0x61, 0x21, 0xa3, 0xfc, 0x96, 0xcf, 0x59,
-	0x34, 0xa4, 0x03, 0xe0, 0xe2, 0x6a, 0x76, 0x21, 0xdb, 0x1a, 0x87, 0x90, 0x49, 0x76, 0xa2, 0x77,
-	0x1e, 0xb8, 0xfc, 0x68, 0xa7, 0xbb, 0x0d, 0x44, 0x3d, 0x97, 0xf5, 0xce, 0x3d, 0x29, 0x26, 0xa9,
-	0x5e, 0x9c, 0x18, 0x0b, 0x53, 0xff, 0x3c, 0x07, 0xce, 0xc6, 0xef, 0x67, 0xd1, 0x4a, 0x91, 0x7b,
-	0xcc, 0xd6, 0xb5, 0xce, 0x68, 0xdd, 0x6b, 0xa8, 0x1c, 0xc2, 0xb3, 0xda, 0x4c, 0x6e, 0xa8, 0x65,
-	0x78, 0x41, 0xfb, 0xfa, 0xc9, 0x3a, 0xda, 0xea, 0xf5, 0x02, 0x5f, 0xbe, 0xae, 0x49, 0xa4, 0xd8,
-	0x84, 0x22, 0x46, 0x63, 0xbd, 0x2e, 0x34, 0xd3, 0x11, 0x90, 0x12, 0xa5, 0x15, 0xa7, 0xa9, 0x88,
-	0x71, 0xb1, 0xfd, 0x88, 0x5b, 0x98, 0xfc, 0xab, 0x41, 0x77, 0x35, 0xd1, 0x1a, 0x62, 0xa0, 0xf0,
-	0xef, 0x2c, 0x74, 0x75, 0x40, 0x63, 0x4e, 0xd8, 0x2d, 0xdf, 0xe5, 0x2e, 0x1d, 0xb8, 0xbf, 0x76,
-	0xfd, 0xbe, 0x38, 0x25, 0xc4, 0x9c, 0x7a, 0xa1, 0x6a, 0xf2, 0x77, 0xa6, 0xa3, 0x47, 0x61, 0xd6,
-	0x7a, 0x53, 0x45, 0xbc, 0x7a, 0xfb, 0x74, 0xb7, 0xe4, 0x59, 0x31, 0x31, 0xcf, 0xad, 0xee, 0x0a,
-	0xac, 0xee, 0x9f, 0x3e, 0xef, 0x7e, 0xf0, 0xbc, 0xeb, 0xfb, 0x65, 0xfb, 0xe2, 0x5f, 0x25, 0xb4,
-	0x3a, 0x69, 0xf9, 0xe0, 0x87, 0xa8, 0x02, 0x67, 0x89, 0x18, 0x28, 0x7a, 0x8a, 0x91, 0x4c, 0xf2,
-	0x62, 0xc3, 0xa9, 0x24, 0x96, 0x23, 0x49, 0x5f, 0x17, 0x2a, 0x52, 0xf8, 0xf5, 0x93, 0xf5, 0x85,
-	0xd4, 0x46, 0x6c, 0x47, 0x44, 0xc5, 0xc3, 0x5f, 0x5a, 0x82, 0xf3, 0xe1, 0x75, 0x20, 0xdd, 0x8c,
-	0x5a, 0x2f, 0x14, 0x5c, 0x3d, 0x31, 0xa8, 0xf0, 0xe9, 0xa5, 0x7a, 0x2e, 0x15, 0x8f, 0x25, 0xa0,
-	0xa3, 0xae, 0xb9, 0x68, 0xde, 0xc8, 0x7c, 0x42, 0x41, 0xb7, 0xcd, 0x82, 0x9e, 0xb1, 0x0f, 0xdb,
-	0x29, 0xeb, 0xd8, 0x9f, 0x26, 0xd4, 0xe7, 0x2e, 0x1f, 0x99, 0x37, 0xc1, 0x63, 0xb4, 0x98, 0xcb,
-	0xf3, 0x7f, 0x19, 0xac, 0xb5, 0xf9, 0xe8, 0x69, 0xfd, 0xc2, 0x57, 0x4f, 0xeb, 0x17, 0x1e, 0x3f,
-	0xad, 0x5f, 0xf8, 0xf2, 0xa4, 0x6e, 0x3d, 0x3a, 0xa9, 0x5b, 0x5f, 0x9d, 0xd4, 0xad, 0xc7, 0x27,
-	0x75, 0xeb, 0x1f, 0x27, 0x75, 0xeb, 0xb7, 0xff, 0xac, 0x5f, 0xf8, 0x6c, 0x66, 0x78, 0xed, 0xbf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x53, 0xea, 0x0f, 0x4a, 0xfb, 0x1e, 0x00, 0x00,
+	// 2973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x73, 0x1c, 0x47,
+	0x15, 0xf7, 0x68, 0xb5, 0x6b, 0x6d, 0xcb, 0xfa, 0x70, 0xcb, 0x71, 0x16, 0x39, 0x91, 0x94, 0x4d,
+	0x25, 0x38, 0x1f, 0x8c, 0xe2, 0x90, 0x04, 0x07, 0x93, 0x80, 0x76, 0xe5, 0x60, 0x27, 0x59, 0x69,
+	0xd3, 0xb2, 0x6c, 0x08, 0x39, 0xd0, 0x9a, 0x6d, 0xad, 0x26, 0xda, 0xf9, 0xf0, 0x4c, 0x8f, 0x1c,
+	0x51, 0x29, 0x2a, 0x07, 0x0e, 0x1c, 0xe1, 0x40, 0x15, 0x05, 0xc5, 0x5f, 0xc0, 0x1f, 0x40, 0x51,
+	0x29, 0x8a, 0xa2, 0x80, 0xaa, 0x9c, 0xa8, 0x1c, 0x73, 0x52, 0x11, 0x71, 0xe4, 0xc2, 0xd9, 0xb9,
+	0x50, 0xfd, 0xba, 0xa7, 0xa7, 0x67, 0x76, 0x65, 0xed, 0xfa, 0x43, 0x07, 0xb8, 0x69, 0xdf, 0xfb,
+	0xbd, 0x8f, 0xee, 0x7e, 0xfd, 0xde, 0xeb, 0x37, 0x42, 0x36, 0xdf, 0x65, 0x31, 0xa7, 0xce, 0xae,
+	0xed, 0x06, 0xcb, 0x7c, 0x97, 0x2d, 0xd3, 0xd0, 0x5d, 0xf6, 0x02, 0xdf, 0xe5, 0x41, 0xb4, 0xbc,
+	0x77, 0x69, 0xb9, 0xcb, 0x7c, 0x16, 0x51, 0xce, 0x3a, 0x76, 0x18, 0x05, 0x3c, 0xc0, 0x0b, 0x06,
+	0x5e, 0xc8, 0xda, 0x34, 0x74, 0x6d, 0x85, 0xb7, 0xf7, 0x2e, 0xcd, 0x7f, 0xa3, 0xeb, 0xf2, 0x9d,
+	0x64, 0xcb, 0x76, 0x02, 0x6f, 0xb9, 0x1b, 0x74, 0x83, 0x65, 0x10, 0xdb, 0x4a, 0xb6, 0xe1, 0x17,
+	0xfc, 0x80, 0xbf, 0xa4, 0xba, 0xf9, 0x57, 0x76, 0x2f, 0xc7, 0xc2, 0x32, 0x0d, 0x5d, 0x8f, 0x3a,
+	0x3b, 0xae, 0xcf, 0xa2, 0xfd, 0xe5, 0x70, 0xb7, 0x0b, 0x6e, 0x44, 0x2c, 0x0e, 0x92, 0xc8, 0x61,
+	0x45, 0x27, 0xee, 0x29, 0x15, 0x2f, 0x7b, 0x8c, 0xd3, 0x01, 0xae, 0xcf, 0x2f, 0x1f, 0x25, 0x15,
+	0x25, 0x3e, 0x77, 0xbd, 0x7e, 0x33, 0xaf, 0x1d, 0x27, 0x10, 0x3b, 0x3b, 0xcc, 0xa3, 0x45, 0xb9,
+	0xfa, 0x3f, 0x2c, 0x34, 0xd3, 0xec, 0x25, 0x31, 0x67, 0xd1, 0xfa, 0x1e, 0x8b, 0xf6, 0x5c, 0x76,
+	0x07, 0xff, 0x18, 0x4d, 0x08, 0xbf, 0x3a, 0x94, 0xd3, 0x9a, 0xb5, 0x64, 0x5d, 0x9c, 0x7c, 0xf9,
+	0x25, 0x5b, 0xaa, 0xb7, 0x4d, 0xf5, 0x76, 0xb8, 0xdb, 0x15, 0x84, 0xd8, 0x16, 0x68, 0x7b, 0xef,
+	0x92, 0xbd, 0xbe, 0xf5, 0x21, 0x73, 0x78, 0x8b, 0x71, 0xda, 0xc0, 0x9f, 0x1d, 0x2c, 0x9e, 0x3a,
+	0x3c, 0x58, 0x44, 0x19, 0x8d, 0x68, 0xad, 0xf8, 0x87, 0xa8, 0x12, 0xb1, 0x38, 0xe9, 0xf1, 0xda,
+	0x18, 0xe8, 0x7f, 0xd5, 0xbe, 0xf7, 0x51, 0xd9, 0x05, 0x17, 0x09, 0x08, 0x37, 0xd0, 0xe1, 0xc1,
+	0x62, 0x45, 0xfe, 0x4d, 0x94, 0xc2, 0xfa, 0xdf, 0xab, 0xe8, 0xb1, 0x81, 0x68, 0x7c, 0x19, 0x9d,
+	0x71, 0x24, 0xa3, 0x19, 0x24, 0x3e, 0x87, 0xa5, 0x95, 0x1b, 0xe7, 0x94, 0xa3, 0x67, 0x9a, 0x06,
+	0x8f, 0xe4, 0x90, 0x78, 0x05, 0xcd, 0xa8, 0xdf, 0x2b, 0x5b, 0x7e, 0x10, 0x79, 0xb4, 0x07, 0x7e,
+	0x97, 0x1b, 0x8f, 0x2b, 0xe1, 0x74, 0x0b, 0x53, 0x36, 0x29, 0xe2, 0x85, 0xf1, 0x30, 0x0a, 0xc4,
+	0x56, 0x48, 0xe3, 0xa5, 0xbc, 0xf1, 0xb6, 0xc1, 0x23, 0x39, 0xa4, 0x30, 0xae, 0x7e, 0x6b, 0xe3,
+	0xe3, 0x79, 0xe3, 0xed, 0x3c, 0x9b, 0x14, 0xf1, 0x78, 0x19, 0x55, 0xfd, 0xa0, 0xc3, 0xa4, 0xe5,
+	0x32, 0x08, 0x9f, 0x55, 0xc2, 0xd5, 0xb5, 0x94, 0x41, 0x32, 0x8c, 0xf0, 0x56, 0xfc, 0xd0, 0x06,
+	0x2b, 0x79, 0x6f, 0xd7, 0x0c, 0x1e, 0xc9, 0x21, 0xf1, 0x15, 0x34, 0x75, 0x27, 0x88, 0x76, 0x7b,
+	0x01, 0xed, 0x48, 0x73, 0xa7, 0x41, 0xf4, 0x31, 0x25, 0x3a, 0x75, 0xcb, 0x64, 0x92, 0x3c, 0x16,
+	0xaf, 0xa2, 0xd9, 0x94, 0xa0, 0x4d, 0x4f, 0x80, 0x7c, 0x4d, 0xc9, 0xcf, 0xde, 0x2a, 0xf0, 0x49,
+	0x9f, 0x04, 0x7e, 0x15, 0x4d, 0x3a, 0x61, 0xd2, 0xa4, 0x21, 0x75, 0x5c, 0xbe, 0x5f, 0xab, 0x2e,
+	0x59, 0x17, 0xad, 0xc6, 0x9c, 0x52, 0x30, 0xd9, 0x6c, 0x6f, 0xa6, 0x2c, 0x62, 0xe2, 0xf0, 0x9b,
+	0x68, 0xda, 0x09, 0x93, 0x95, 0x5e, 0x2f, 0x70, 0x28, 0xa7, 0x5b, 0x3d, 0x56, 0x43, 0x20, 0x79,
+	0x5e, 0x49, 0x4e, 0x37, 0xdb, 0x9b, 0x06, 0x97, 0x14, 0xd0, 0xb8, 0x85, 0xe6, 0x9c, 0x30, 0x59,
+	0x0b, 0x38, 0x61, 0xb4, 0xb3, 0xaf, 0xcd, 0x4f, 0x82, 0x92, 0x0b, 0x4a, 0xc9, 0x5c, 0xb3, 0xbd,
+	0x59, 0x84, 0x90, 0x41, 0x72, 0xf8, 0x26, 0x3a, 0x6f, 0x90, 0x4d, 0xb7, 0xce, 0x80, 0xc6, 0x05,
+	0xa5, 0xf1, 0xbc, 0xa1, 0xd1, 0x74, 0xef, 0x08, 0x69, 0xb1, 0x3b, 0x1e, 0xf3, 0xb4, 0x7b, 0x53,
+	0x4b, 0xd6, 0xc5, 0x52, 0xb6, 0x3b, 0xad, 0x8c, 0x45, 0x4c, 0x9c, 0xd8, 0x1d, 0x8f, 0x79, 0xa6,
+	0x1b, 0xd3, 0x20, 0xa9, 0x77, 0xa7, 0x95, 0xe3, 0x92, 0x02, 0x5a, 0xec, 0x8e, 0xc7, 0xbc, 0xbe,
+	0xdd, 0x99, 0x01, 0x25, 0x7a, 0x77, 0x5a, 0xfd, 0x10, 0x32, 0x48, 0x4e, 0xec, 0x8e, 0x41, 0x36,
+	0xdd, 0x9a, 0x05, 0x8d, 0x7a, 0x77, 0x5a, 0x03, 0x51, 0xe4, 0x08, 0x69, 0xfc, 0x22, 0x9a, 0x08,
+	0x03, 0x15, 0xb9, 0x67, 0x21, 0xf2, 0x66, 0x95, 0xa6, 0x89, 0xb6, 0xa2, 0x13, 0x8d, 0xc0, 0xef,
+	0xa3, 0x09, 0x75, 0xcf, 0xe3, 0x1a, 0x5e, 0x2a, 0x41, 0xa2, 0x1c, 0x2e, 0x91, 0x6d, 0x70, 0xca,
+	0xdd, 0x98, 0xbb, 0x4e, 0xe3, 0x8c, 0xd0, 0xad, 0xa8, 0x31, 0xd1, 0xfa, 0xea, 0x5f, 0xcd, 0xa0,
+	0xd9, 0x22, 0x58, 0x5c, 0x64, 0x05, 0xb8, 0xbe, 0x0a, 0xf9, 0xab, 0x9a, 0x5d, 0xe4, 0x66, 0xca,
+	0x20, 0x19, 0x06, 0xbf, 0x8d, 0xb0, 0xfa, 0xb1, 0xea, 0xc6, 0x61, 0x8f, 0xee, 0xaf, 0x51, 0x8f,
+	0x41, 0xf2, 0xaa, 0x36, 0xe6, 0x95, 0x24, 0x6e, 0xf6, 0x21, 0xc8, 0x00, 0x29, 0xb1, 0x37, 0x9c,
+	0xf9, 0xd4, 0xe7, 0xd7, 0x57, 0x21, 0x7d, 0x55, 0xb3, 0xbd, 0xb9, 0xa1, 0xe8, 0x44, 0x23, 0x8c,
+	0x6c, 0xdb, 0xde, 0xa1, 0x31, 0x83, 0x9c, 0x55, 0xed, 0xcb, 0xb6, 0xc0, 0x23, 0x39, 0xe4, 0xff,
+	0x59, 0xb6, 0x5a, 0x41, 0x33, 0x3b, 0x34, 0x6e, 0x31, 0x1e, 0xb9, 0xce, 0x06, 0x8b, 0xf6, 0x58,
+	0x04, 0x19, 0x6b, 0x22, 0x4b, 0xef, 0xd7, 0xf2, 0x6c, 0x52, 0xc4, 0xe3, 0xe7, 0xd0, 0x69, 0x27,
+	0x4c, 0x36, 0x63, 0xd6, 0x51, 0x29, 0x6b, 0x46, 0x89, 0x9e, 0x6e, 0xb6, 0x37, 0x05, 0x99, 0xa4,
+	0x7c, 0xfc, 0x32, 0x42, 0x4e, 0x98, 0x10, 0x76, 0x3b, 0x61, 0x31, 0x57, 0xb9, 0x49, 0x97, 0xea,
+	0x66, 0x7b, 0x53, 0x71, 0x88, 0x81, 0x12, 0xe7, 0xee, 0x84, 0xc9, 0xbb, 0xae, 0xe7, 0x72, 0x95,
+	0x7b, 0xf4, 0xb9, 0x37, 0xdb, 0x9b, 0x40, 0x27, 0x1a, 0x51, 0xcc, 0xbe, 0x53, 0xf7, 0x9d, 0x7d,
+	0xa7, 0x1f, 0x46, 0xf6, 0x9d, 0x79, 0xe8, 0xd9, 0x77, 0xf6, 0x81, 0xb2, 0xaf, 0x5c, 0x66, 0xba,
+	0xcb, 0x94, 0x33, 0xc8, 0x32, 0xd5, 0xdc, 0x32, 0x0d, 0x2e, 0x29, 0xa0, 0xe1, 0x3e, 0xe7, 0x16,
+	0x0e, 0x3a, 0x70, 0xe1, 0x3e, 0xe7, 0xb7, 0x4a, 0xe8, 0x19, 0x20, 0xa5, 0xce, 0x75, 0x33, 0xa6,
+	0x5d, 0x56, 0x9b, 0xcb, 0xdf, 0x67, 0x88, 0x1b, 0xda, 0x65, 0x44, 0x23, 0x44, 0x90, 0x79, 0xcc,
+	0x83, 0x20, 0x3b, 0x07, 0x29, 0x56, 0x07, 0x59, 0x4b, 0x92, 0x49, 0xca, 0x17, 0x41, 0xe6, 0x31,
+	0x2f, 0x0d, 0xb2, 0xc7, 0x00, 0xad, 0x83, 0xac, 0xa5, 0x39, 0xc4, 0x40, 0x09, 0x67, 0x3c, 0xe6,
+	0xc9, 0x20, 0x3b, 0x0f, 0x12, 0xda, 0x99, 0x96, 0xa2, 0x13, 0x8d, 0x28, 0x16, 0xb1, 0xc7, 0xef,
+	0xbb, 0x88, 0xd5, 0x1e, 0x46, 0x11, 0xfb, 0xda, 0x43, 0x2f, 0x62, 0xf3, 0x0f, 0x54, 0xc4, 0xe4,
+	0x32, 0xcd, 0x20, 0xbb, 0x90, 0x0f, 0xb2, 0x56, 0x8e, 0x4b, 0x0a, 0x68, 0x11, 0x64, 0xf9, 0x85,
+	0x83, 0x8e, 0x27, 0xf2, 0x41, 0xd6, 0xea, 0x43, 0x90, 0x01, 0x52, 0xea, 0x5c, 0x65, 0x90, 0x3d,
+	0x99, 0x0f, 0xb2, 0x96, 0xa2, 0x13, 0x8d, 0xc8, 0x95, 0xdf, 0x85, 0x63, 0xcb, 0xef, 0x2a, 0x9a,
+	0x15, 0xaf, 0x9a, 0x4e, 0xd2, 0x63, 0xd1, 0x35, 0x46, 0x7b, 0x7c, 0x67, 0xbf, 0xb6, 0x08, 0xb9,
+	0x53, 0x27, 0xe0, 0x8d, 0x02, 0x9f, 0xf4, 0x49, 0xe0, 0x0f, 0x50, 0xcd, 0x09, 0x7c, 0x1e, 0x05,
+	0xbd, 0x1e, 0x8b, 0x5a, 0xd4, 0xa7, 0xdd, 0x4c, 0xdb, 0x12, 0x68, 0x5b, 0x52, 0xda, 0x6a, 0xcd,
+	0x23, 0x70, 0xe4, 0x48, 0x0d, 0x22, 0x52, 0x19, 0x77, 0x3a, 0xa9, 0xc2, 0xa7, 0x40, 0xa1, 0x8e,
+	0xd4, 0xab, 0x19, 0x8b, 0x98, 0xb8, 0xfa, 0xa7, 0x15, 0x94, 0x96, 0x48, 0xb9, 0x33, 0x22, 0xad,
+	0xca, 0xdf, 0x50, 0xc1, 0x65, 0xed, 0xcf, 0xd2, 0x6a, 0xc6, 0x22, 0x26, 0x0e, 0xef, 0xa0, 0xf1,
+	0x1d, 0x1a, 0x75, 0x6a, 0x63, 0xd0, 0x9d, 0xbc, 0x36, 0x64, 0x77, 0x02, 0x26, 0xed, 0x6b, 0x34,
+	0xea, 0x5c, 0xf5, 0x79, 0xb4, 0xdf, 0x78, 0x42, 0xd9, 0x19, 0x17, 0xa4, 0xbb, 0x07, 0x8b, 0x67,
+	0x88, 0x7a, 0xed, 0xbe, 0xeb, 0xc6, 0x9c, 0x80, 0x05, 0xfc, 0x31, 0xaa, 0x52, 0x79, 0xf6, 0xac,
+	0x53, 0x2b, 0x81, 0xb9, 0x2b, 0x23, 0x99, 0x5b, 0x49, 0xa5, 0xa5, 0xcd, 0xa7, 0xd2, 0x92, 0xaf,
+	0xe9, 0x7d, 0x86, 0x33, 0x83, 0x62, 0x9d, 0x89, 0x48, 0x4d, 0xe3, 0xf7, 0xb1, 0x4e, 0x91, 0xb3,
+	0x0a, 0xeb, 0x14, 0xa4, 0xfe, 0x75, 0x0a, 0x0b, 0x78, 0x0b, 0x21, 0x9f, 0x7a, 0x2c, 0x0e, 0xa9,
+	0xc3, 0xe2, 0x5a, 0x19, 0xec, 0xd9, 0xc7, 0xd9, 0x5b, 0x4b, 0x25, 0xc0, 0x62, 0x96, 0x0c, 0x35,
+	0x3d, 0x26, 0x86, 0xd6, 0xf9, 0x2e, 0xaa, 0xea, 0xcd, 0xc7, 0xb3, 0xa8, 0xb4, 0xcb, 0xf6, 0xe5,
+	0x89, 0x13, 0xf1, 0x27, 0x5e, 0x45, 0xe5, 0x3d, 0xda, 0x4b, 0x98, 0x7a, 0x3c, 0xdb, 0xf7, 0x7a,
+	0x9c, 0xdb, 0xe9, 0x60, 0xc2, 0x7e, 0x2f, 0xa1, 0x3e, 0x17, 0xb9, 0x48, 0x0a, 0x7f, 0x7b, 0xec,
+	0xb2, 0x35, 0xdf, 0x43, 0xd3, 0xf9, 0x6d, 0x7f, 0xa4, 0xd6, 0xba, 0xa8, 0xaa, 0xf7, 0xfa, 0x51,
+	0x1a, 0xaa, 0xff, 0xbe, 0x84, 0xaa, 0xcd, 0xc0, 0xdf, 0x76, 0xbb, 0x2d, 0x1a, 0x9e, 0xc0, 0x38,
+	0x63, 0x13, 0x8d, 0x83, 0x76, 0x79, 0xcb, 0xbe, 0x79, 0x6c, 0xf4, 0xa5, 0xae, 0xd9, 0xab, 0x94,
+	0x53, 0x19, 0x7a, 0x67, 0xd2, 0xd0, 0x13, 0x24, 0x02, 0xea, 0xb0, 0x87, 0xd0, 0x96, 0xeb, 0xd3,
+	0x68, 0x5f, 0xd0, 0xd4, 0x9d, 0x7a, 0x7d, 0x78, 0xe5, 0x0d, 0x2d, 0x2b, 0x4d, 0xe8, 0x35, 0x64,
+	0x0c, 0x62, 0x18, 0x98, 0xff, 0x16, 0xaa, 0x6a, 0xf0, 0x80, 0xe3, 0x39, 0x67, 0x1e, 0x4f, 0xd5,
+	0x3c, 0xd7, 0x37, 0xd0, 0x4c, 0xc1, 0xd6, 0x71, 0xe2, 0x67, 0xcc, 0xd3, 0xfa, 0x93, 0x85, 0xa6,
+	0xb4, 0xd7, 0xe2, 0xa6, 0xe1, 0x0f, 0xfa, 0x4e, 0xcc, 0x1e, 0xee, 0xc4, 0x84, 0x34, 0x9c, 0x97,
+	0x2e, 0x1b, 0x29, 0xc5, 0x38, 0xad, 0x35, 0x54, 0x76, 0x39, 0xf3, 0x62, 0x75, 0x5c, 0xcf, 0x0d,
+	0xbd, 0xa3, 0x8d, 0x29, 0xa5, 0xb5, 0x7c, 0x5d, 0xc8, 0x13, 0xa9, 0xa6, 0xfe, 0x1f, 0x0b, 0x55,
+	0x64, 0x3f, 0x7e, 0x02, 0xa1, 0xd6, 0x46, 0xe5, 0xdb, 0x09, 0x8b, 0xf6, 0xd5, 0x25, 0x79, 0xe1,
+	0x38, 0xe7, 0xa5, 0x63, 0xef, 0x09, 0x91, 0xcc, 0x7d, 0xf8, 0x49, 0xa4, 0x22, 0xd1, 0xad, 0x7d,
+	0x18, 0x07, 0xbe, 0x1c, 0x92, 0xa9, 0x87, 0x9d, 0xf6, 0xe1, 0xed, 0x8d, 0xf5, 0x35, 0x35, 0x60,
+	0x33, 0x50, 0xf5, 0x3f, 0x58, 0x08, 0x49, 0xcd, 0x27, 0x70, 0x5e, 0xef, 0xe4, 0xcf, 0xeb, 0xd9,
+	0xe1, 0x96, 0x7c, 0xc4, 0x61, 0x7d, 0x51, 0x42, 0x93, 0xc6, 0x9e, 0xe0, 0xa7, 0x51, 0x59, 0xb6,
+	0x5c, 0xb2, 0xa2, 0x6a, 0xa1, 0x1b, 0xd0, 0xc1, 0x48, 0x1e, 0x7e, 0x01, 0x55, 0x63, 0x4e, 0x23,
+	0x7e, 0xc3, 0x55, 0x8f, 0xe7, 0x52, 0x63, 0x4a, 0x94, 0xa6, 0x8d, 0x94, 0x48, 0x32, 0x3e, 0x7e,
+	0x06, 0x9d, 0x66, 0x7e, 0x07, 0xa0, 0x25, 0x80, 0x4e, 0x8a, 0x26, 0xf9, 0xaa, 0x24, 0x91, 0x94,
+	0x87, 0xeb, 0xa8, 0xb2, 0xed, 0xb2, 0x5e, 0x27, 0x86, 0x9a, 0x55, 0x95, 0xb3, 0xcc, 0xb7, 0x80,
+	0x42, 0x14, 0x07, 0xef, 0x20, 0xe4, 0x04, 0x7e, 0xc7, 0xe5, 0x6e, 0xe0, 0xa7, 0xb5, 0xe6, 0x95,
+	0x11, 0x4e, 0xbc, 0x99, 0x0a, 0x1b, 0x6f, 0x3c, 0xad, 0x8f, 0x18, 0xba, 0x45, 0x77, 0x1f, 0x44,
+	0x1d, 0x16, 0x35, 0xf6, 0xe1, 0xf5, 0x5c, 0xcd, 0xba, 0xfb, 0x75, 0x49, 0x26, 0x29, 0x5f, 0xec,
+	0x18, 0xfc, 0x09, 0x6f, 0x65, 0x63, 0xc7, 0x00, 0x48, 0x24, 0x4f, 0x6c, 0x42, 0x37, 0x0a, 0x92,
+	0xb0, 0xb1, 0x5f, 0x9b, 0x80, 0xe5, 0xc1, 0x26, 0x7c, 0x5f, 0x92, 0x48, 0xca, 0x13, 0xba, 0x7a,
+	0xd0, 0xf2, 0x57, 0xa1, 0xd9, 0xd3, 0xba, 0x64, 0xbf, 0x2f, 0x79, 0xf8, 0x59, 0x54, 0x09, 0xb6,
+	0xb7, 0x63, 0xc6, 0xe1, 0x75, 0x5b, 0x6e, 0x4c, 0x2b, 0x54, 0x65, 0x1d, 0xa8, 0x44, 0x71, 0xeb,
+	0x1f, 0xa3, 0x73, 0x83, 0xd6, 0x8e, 0x9f, 0x34, 0x72, 0x51, 0x63, 0x52, 0x09, 0x97, 0xde, 0x61,
+	0xfb, 0x32, 0x31, 0x2d, 0xa1, 0x71, 0xf6, 0x51, 0x18, 0xa9, 0xa1, 0x88, 0xce, 0xc3, 0x57, 0x3f,
+	0x0a, 0x23, 0x02, 0x1c, 0xe1, 0xa5, 0x4c, 0x5d, 0xa5, 0xfc, 0x8a, 0x6f, 0x0a, 0xa2, 0xca, 0x64,
+	0xf5, 0x9f, 0x8d, 0xa3, 0xe9, 0x7c, 0x99, 0x87, 0x41, 0x46, 0x4a, 0x29, 0x4e, 0x6b, 0x34, 0x94,
+	0x64, 0x18, 0xfc, 0x53, 0xb3, 0x87, 0x92, 0xd1, 0xfe, 0xc6, 0x68, 0xad, 0xc5, 0x83, 0x75, 0x51,
+	0x1f, 0xaa, 0x2e, 0x4a, 0x96, 0x9a, 0xcb, 0x23, 0x9a, 0x1e, 0xa9, 0x8f, 0xfa, 0x5f, 0x6d, 0x3d,
+	0xfe, 0x66, 0xa1, 0x74, 0x98, 0x2f, 0x83, 0xe0, 0xd1, 0x97, 0x04, 0x82, 0xc6, 0xe3, 0x90, 0x39,
+	0xca, 0xf7, 0x63, 0x27, 0x90, 0xa6, 0x77, 0x1b, 0x21, 0x73, 0xb2, 0x90, 0x17, 0xbf, 0x08, 0xe8,
+	0xaa, 0xff, 0xd5, 0x42, 0xb3, 0x26, 0xf0, 0x04, 0xd2, 0xfc, 0x7b, 0xf9, 0x34, 0xff, 0xe2, 0x28,
+	0xeb, 0x38, 0x22, 0xd9, 0xff, 0xbc, 0x94, 0x5f, 0x85, 0x58, 0x60, 0x6e, 0x8c, 0x69, 0x1d, 0x3b,
+	0xc6, 0x7c, 0x15, 0x4d, 0xaa, 0xaf, 0x29, 0xc6, 0xe4, 0x54, 0xbf, 0xbb, 0xda, 0x19, 0x8b, 0x98,
+	0x38, 0xfc, 0x23, 0x54, 0xe5, 0xae, 0x27, 0xfc, 0xf7, 0x42, 0x48, 0x1b, 0x93, 0x2f, 0x3f, 0x3f,
+	0xdc, 0x5e, 0x89, 0xe2, 0x90, 0xa5, 0x89, 0x1b, 0xa9, 0x12, 0x92, 0xe9, 0xc3, 0x37, 0x51, 0x25,
+	0x64, 0x91, 0x1b, 0x74, 0x60, 0xa8, 0x3a, 0xf4, 0x29, 0xac, 0x26, 0x11, 0x85, 0x62, 0xa0, 0x13,
+	0x68, 0x1b, 0xb4, 0x10, 0xa5, 0x2d, 0x37, 0xce, 0x2e, 0x0f, 0x77, 0x08, 0xe6, 0x43, 0xca, 0x18,
+	0x1f, 0xf5, 0x8f, 0xb3, 0x7f, 0x33, 0x86, 0x50, 0x3b, 0x0a, 0x3c, 0xc6, 0x77, 0x58, 0x12, 0x9f,
+	0x48, 0xa3, 0x64, 0xde, 0x0a, 0x7b, 0x88, 0x68, 0x52, 0xbe, 0x1d, 0x75, 0x27, 0xf0, 0x0f, 0x50,
+	0x25, 0xe6, 0x94, 0x27, 0xb1, 0x3a, 0xd0, 0x97, 0x46, 0xd0, 0x09, 0x72, 0xd9, 0xc6, 0xcb, 0xdf,
+	0x44, 0xe9, 0xab, 0xff, 0xd9, 0x42, 0xd3, 0x19, 0xf8, 0x04, 0xee, 0xda, 0x7a, 0xfe, 0xae, 0x3d,
+	0x3f, 0xfc, 0x4a, 0x8e, 0xb8, 0x69, 0x1e, 0x3a, 0x97, 0x61, 0x08, 0xf3, 0x02, 0xce, 0x56, 0x3a,
+	0x9d, 0x48, 0x74, 0x4e, 0x77, 0x22, 0x57, 0xfe, 0xa8, 0x59, 0xd0, 0x09, 0x40, 0xe7, 0x74, 0x2b,
+	0x25, 0x92, 0x8c, 0x8f, 0x2f, 0xa2, 0x89, 0x88, 0xd1, 0x0e, 0x60, 0xc7, 0x00, 0x0b, 0x1f, 0x47,
+	0x88, 0xa2, 0x11, 0xcd, 0xad, 0xff, 0xb6, 0x62, 0x6e, 0xd8, 0xfd, 0x5d, 0x6b, 0x73, 0x9c, 0x32,
+	0x36, 0xe4, 0x38, 0xe5, 0x39, 0x74, 0x7a, 0x8f, 0x45, 0xb1, 0x1b, 0xf8, 0xaa, 0x17, 0xd0, 0x6d,
+	0xd2, 0x4d, 0x49, 0x26, 0x29, 0x1f, 0x47, 0x08, 0xc5, 0xc9, 0x96, 0x22, 0xab, 0xb9, 0xc4, 0x9b,
+	0xa3, 0x45, 0xa1, 0xbd, 0xa1, 0x15, 0x14, 0x5e, 0x70, 0x19, 0x83, 0x18, 0x56, 0xf0, 0x6d, 0x34,
+	0x15, 0xe9, 0xbd, 0x67, 0x71, 0x0c, 0x5f, 0x4f, 0x86, 0x68, 0x19, 0x07, 0x1d, 0x5d, 0xf6, 0x11,
+	0x84, 0x98, 0x2a, 0x49, 0xde, 0x02, 0xbe, 0x82, 0xa6, 0xfc, 0x80, 0xbb, 0xdb, 0xfb, 0xb7, 0xd8,
+	0xd6, 0x4e, 0x10, 0xec, 0xaa, 0xf6, 0x51, 0x0b, 0xaf, 0x99, 0x4c, 0x92, 0xc7, 0x62, 0x86, 0xaa,
+	0x69, 0x31, 0x8d, 0xa1, 0x9d, 0x1c, 0xc2, 0xd7, 0xb4, 0x95, 0x20, 0xec, 0x76, 0xe2, 0x46, 0xcc,
+	0x63, 0x3e, 0x8f, 0xb3, 0x7c, 0x99, 0x72, 0x63, 0x92, 0x69, 0x16, 0x87, 0x1d, 0x25, 0xfe, 0xba,
+	0xdf, 0xa2, 0xe2, 0x20, 0xe1, 0x1b, 0x8d, 0x31, 0x83, 0x23, 0x19, 0x8b, 0x98, 0x38, 0xdc, 0x42,
+	0x73, 0xb4, 0xc7, 0x22, 0x4e, 0x58, 0xc8, 0x28, 0xbf, 0xee, 0x73, 0x16, 0xed, 0xd1, 0x1e, 0xb4,
+	0xaa, 0xd5, 0x6c, 0xda, 0xbb, 0xd2, 0x0f, 0x21, 0x83, 0xe4, 0x44, 0xec, 0xdc, 0x71, 0xf9, 0xce,
+	0x5a, 0x7b, 0x15, 0xfa, 0xd8, 0x89, 0x2c, 0x76, 0x6e, 0x49, 0x32, 0x49, 0xf9, 0xe2, 0x41, 0x5d,
+	0x38, 0xfa, 0x51, 0xde, 0xe3, 0xf5, 0x5f, 0x8d, 0x43, 0xd9, 0xcb, 0xe5, 0x1e, 0x33, 0x74, 0xad,
+	0x63, 0x42, 0xf7, 0x12, 0x2a, 0x87, 0xf0, 0xcd, 0x6e, 0x2c, 0xb7, 0xd4, 0x32, 0x7c, 0x9e, 0xbb,
+	0x7b, 0xb0, 0x88, 0x56, 0x3a, 0x9d, 0xc0, 0x97, 0x9f, 0xee, 0x24, 0x52, 0xf4, 0xe8, 0x11, 0xa3,
+	0xb1, 0xbe, 0x17, 0x3a, 0xd3, 0x11, 0xa0, 0x12, 0xc5, 0x15, 0x8f, 0xcd, 0x88, 0x71, 0xd1, 0x9d,
+	0x27, 0x3e, 0x57, 0xff, 0xc7, 0xa0, 0xa3, 0x9a, 0x68, 0x0e, 0x31, 0x50, 0xf8, 0x97, 0x16, 0xba,
+	0xd0, 0xa3, 0x31, 0x27, 0xec, 0xba, 0xef, 0x72, 0x97, 0xf6, 0xdc, 0x9f, 0xb8, 0x7e, 0x57, 0x57,
+	0x46, 0x15, 0xe4, 0xa3, 0x94, 0xd7, 0xa7, 0x95, 0xc5, 0x0b, 0xef, 0x1e, 0xad, 0x96, 0xdc, 0xcb,
+	0x26, 0xe6, 0xb9, 0xdb, 0x5d, 0x81, 0xdb, 0xfd, 0xbd, 0x51, 0xeb, 0xc1, 0xa8, 0xf7, 0xfb, 0x41,
+	0xe3, 0xe2, 0xdf, 0x25, 0x74, 0x6e, 0xd0, 0xf5, 0xc1, 0x1f, 0xa1, 0x0a, 0x3c, 0xb5, 0x62, 0x48,
+	0xd1, 0x43, 0xac, 0x64, 0x90, 0x16, 0x1b, 0x1e, 0x6d, 0xb1, 0x5c, 0x49, 0xfa, 0xe9, 0xa2, 0x22,
+	0x89, 0x7d, 0x6f, 0x00, 0x65, 0x0f, 0x7f, 0x62, 0x89, 0x9c, 0x0f, 0x9f, 0x1e, 0xd2, 0x62, 0xd4,
+	0xb8, 0x2f, 0xe3, 0xea, 0xfb, 0x85, 0x32, 0x9f, 0x4e, 0xec, 0x27, 0x52, 0x72, 0x9f, 0x03, 0xda,
+	0xea, 0xbc, 0x8b, 0x26, 0x0d, 0xcf, 0x1f, 0xe9, 0x2b, 0x64, 0x17, 0x4d, 0xe5, 0xfc, 0x7c, 0xa4,
+	0x2f, 0x91, 0xdf, 0x8d, 0xa1, 0x49, 0xf9, 0x7d, 0x85, 0x85, 0x41, 0xc4, 0x4f, 0x64, 0x0c, 0x9a,
+	0x9b, 0x4d, 0x1d, 0xdb, 0x1f, 0x19, 0xde, 0xdd, 0x6b, 0x40, 0xb5, 0xa9, 0xff, 0x59, 0x4c, 0xf6,
+	0x5d, 0x97, 0x46, 0xd0, 0x7b, 0x8f, 0x7f, 0x14, 0xfb, 0xd4, 0x42, 0x33, 0x06, 0x52, 0xb4, 0x33,
+	0x78, 0x09, 0x8d, 0xfb, 0xd9, 0xe7, 0x15, 0xdd, 0x04, 0x42, 0x23, 0x00, 0x1c, 0xdc, 0x31, 0x4b,
+	0xd6, 0x90, 0xf3, 0xde, 0xbc, 0x3f, 0x20, 0x7b, 0x4c, 0xc5, 0x5a, 0x42, 0xe3, 0x4e, 0x10, 0xcb,
+	0x05, 0x5b, 0x99, 0x1f, 0xcd, 0x40, 0x3c, 0x9f, 0x05, 0xa7, 0xfe, 0x97, 0xbc, 0xf7, 0x27, 0xd0,
+	0x33, 0xb6, 0xf3, 0x3d, 0xe3, 0x0b, 0x23, 0xac, 0xfa, 0x88, 0xa6, 0xf1, 0xd7, 0x65, 0x34, 0x5b,
+	0x8c, 0x01, 0xf1, 0x72, 0xca, 0x66, 0x6d, 0xd6, 0xfd, 0xbf, 0x9c, 0x06, 0xce, 0xe6, 0x36, 0xb3,
+	0xd9, 0xdc, 0xd8, 0xc8, 0xaa, 0x75, 0xc1, 0xec, 0x9b, 0xe5, 0x7d, 0x37, 0x9b, 0x76, 0xc9, 0xf2,
+	0xf7, 0x4c, 0x0a, 0x55, 0x13, 0xaf, 0xbb, 0x07, 0x8b, 0xd8, 0x58, 0x69, 0xdf, 0x1c, 0xac, 0xf0,
+	0xca, 0x1c, 0x1f, 0xf2, 0x95, 0x59, 0xe8, 0x62, 0xcb, 0x43, 0x76, 0xb1, 0x4d, 0x34, 0xe1, 0xec,
+	0xd0, 0xa8, 0xcb, 0xf4, 0xb4, 0xef, 0xeb, 0xfa, 0xe5, 0xa6, 0xe8, 0x77, 0x0f, 0x16, 0xe7, 0x0c,
+	0x87, 0x53, 0x32, 0xd1, 0x82, 0xb8, 0x83, 0x2a, 0x61, 0xe4, 0xca, 0xc6, 0x4d, 0xc4, 0xc3, 0x77,
+	0x46, 0xbd, 0xed, 0x76, 0x1b, 0xc4, 0x65, 0xc2, 0xce, 0x9e, 0xa4, 0x40, 0x24, 0x4a, 0x37, 0xbe,
+	0x82, 0x2a, 0xdb, 0x41, 0xe4, 0x51, 0x0e, 0x5d, 0x5b, 0x55, 0x57, 0xee, 0xca, 0x5b, 0x40, 0xbd,
+	0x7b, 0xb0, 0x78, 0xd6, 0xd0, 0x2b, 0x89, 0x44, 0x89, 0xcc, 0xbf, 0x8e, 0x26, 0x0d, 0x1b, 0x23,
+	0x95, 0xca, 0xaf, 0x2c, 0x34, 0x37, 0xe0, 0xda, 0x8a, 0x57, 0x46, 0x7a, 0x4b, 0x8b, 0xaf, 0x0c,
+	0x5d, 0x96, 0x34, 0x02, 0xbf, 0x89, 0xa6, 0xf5, 0x70, 0xed, 0x5a, 0x90, 0x44, 0x31, 0x18, 0x32,
+	0xfe, 0xa9, 0x65, 0x25, 0xc7, 0x25, 0x05, 0x34, 0x5e, 0x46, 0xd5, 0x24, 0x4e, 0x45, 0x65, 0x2e,
+	0xd0, 0xf1, 0xbd, 0x99, 0x32, 0x48, 0x86, 0xc1, 0x4f, 0xa3, 0x32, 0x6c, 0x1c, 0x44, 0x90, 0x95,
+	0x5d, 0x3b, 0xd8, 0x06, 0x22, 0x79, 0x3a, 0xb9, 0x94, 0x8f, 0x4c, 0x2e, 0x7f, 0xb4, 0xd0, 0xd9,
+	0xbe, 0x24, 0x8a, 0x6f, 0xa4, 0x09, 0x40, 0x36, 0x09, 0xcb, 0x23, 0x1c, 0xb8, 0xb8, 0xf6, 0x83,
+	0x93, 0x80, 0x58, 0x23, 0x0f, 0x38, 0xed, 0x09, 0xf3, 0x6a, 0x7b, 0xb2, 0xe9, 0x47, 0xca, 0x20,
+	0x19, 0x06, 0x3f, 0x89, 0x4a, 0x4e, 0xbc, 0xa7, 0x2e, 0x9a, 0x1e, 0xe7, 0x36, 0x37, 0x6e, 0x12,
+	0x41, 0x6f, 0x5c, 0xfc, 0xec, 0xcb, 0x85, 0x53, 0x9f, 0x7f, 0xb9, 0x70, 0xea, 0x8b, 0x2f, 0x17,
+	0x4e, 0x7d, 0x72, 0xb8, 0x60, 0x7d, 0x76, 0xb8, 0x60, 0x7d, 0x7e, 0xb8, 0x60, 0x7d, 0x71, 0xb8,
+	0x60, 0xfd, 0xf3, 0x70, 0xc1, 0xfa, 0xc5, 0xbf, 0x16, 0x4e, 0xbd, 0x3f, 0xb6, 0x77, 0xe9, 0xbf,
+	0x01, 0x00, 0x00, 0xff, 0xff, 0x07, 0xd4, 0x88, 0x03, 0x4f, 0x2e, 0x00, 0x00,
 }
 
 func (m *ClusterOverview) Marshal() (dAtA []byte, err error) {
@@ -988,7 +1358,7 @@ func (m *ClusterStatistic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ConfigMap) Marshal() (dAtA []byte, err error) {
+func (m *ClusterUsage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -998,67 +1368,196 @@ func (m *ConfigMap) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConfigMap) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClusterUsage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConfigMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClusterUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BinaryData) > 0 {
-		keysForBinaryData := make([]string, 0, len(m.BinaryData))
-		for k := range m.BinaryData {
-			keysForBinaryData = append(keysForBinaryData, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForBinaryData)
-		for iNdEx := len(keysForBinaryData) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.BinaryData[string(keysForBinaryData[iNdEx])]
-			baseI := i
-			if v != nil {
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Namespaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
-			i -= len(keysForBinaryData[iNdEx])
-			copy(dAtA[i:], keysForBinaryData[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForBinaryData[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.Data) > 0 {
-		keysForData := make([]string, 0, len(m.Data))
-		for k := range m.Data {
-			keysForData = append(keysForData, string(k))
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
 		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForData)
-		for iNdEx := len(keysForData) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Data[string(keysForData[iNdEx])]
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[string(keysForUsed[iNdEx])]
 			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x12
-			i -= len(keysForData[iNdEx])
-			copy(dAtA[i:], keysForData[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForData[iNdEx])))
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
 			i--
 			dAtA[i] = 0xa
 			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
-	{
+	if len(m.Allocated) > 0 {
+		keysForAllocated := make([]string, 0, len(m.Allocated))
+		for k := range m.Allocated {
+			keysForAllocated = append(keysForAllocated, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAllocated)
+		for iNdEx := len(keysForAllocated) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Allocated[string(keysForAllocated[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAllocated[iNdEx])
+			copy(dAtA[i:], keysForAllocated[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAllocated[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Hard) > 0 {
+		keysForHard := make([]string, 0, len(m.Hard))
+		for k := range m.Hard {
+			keysForHard = append(keysForHard, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+		for iNdEx := len(keysForHard) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hard[string(keysForHard[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHard[iNdEx])
+			copy(dAtA[i:], keysForHard[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHard[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.ClusterName)
+	copy(dAtA[i:], m.ClusterName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigMap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigMap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BinaryData) > 0 {
+		keysForBinaryData := make([]string, 0, len(m.BinaryData))
+		for k := range m.BinaryData {
+			keysForBinaryData = append(keysForBinaryData, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForBinaryData)
+		for iNdEx := len(keysForBinaryData) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.BinaryData[string(keysForBinaryData[iNdEx])]
+			baseI := i
+			if v != nil {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForBinaryData[iNdEx])
+			copy(dAtA[i:], keysForBinaryData[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForBinaryData[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Data) > 0 {
+		keysForData := make([]string, 0, len(m.Data))
+		for k := range m.Data {
+			keysForData = append(keysForData, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForData)
+		for iNdEx := len(keysForData) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Data[string(keysForData[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForData[iNdEx])
+			copy(dAtA[i:], keysForData[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForData[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
 		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
@@ -1337,7 +1836,7 @@ func (m *MetricQueryCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Prometheus) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceUsage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1347,26 +1846,102 @@ func (m *Prometheus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Prometheus) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceUsage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Prometheus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[string(keysForUsed[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Allocated) > 0 {
+		keysForAllocated := make([]string, 0, len(m.Allocated))
+		for k := range m.Allocated {
+			keysForAllocated = append(keysForAllocated, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAllocated)
+		for iNdEx := len(keysForAllocated) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Allocated[string(keysForAllocated[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForAllocated[iNdEx])
+			copy(dAtA[i:], keysForAllocated[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForAllocated[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1390,7 +1965,7 @@ func (m *Prometheus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PrometheusList) Marshal() (dAtA []byte, err error) {
+func (m *ProjectUsageList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1400,12 +1975,12 @@ func (m *PrometheusList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PrometheusList) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProjectUsageList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PrometheusList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProjectUsageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1437,7 +2012,7 @@ func (m *PrometheusList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PrometheusRemoteAddr) Marshal() (dAtA []byte, err error) {
+func (m *ProjectUsageSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1447,38 +2022,64 @@ func (m *PrometheusRemoteAddr) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PrometheusRemoteAddr) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProjectUsageSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PrometheusRemoteAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProjectUsageSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ReadAddr) > 0 {
-		for iNdEx := len(m.ReadAddr) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ReadAddr[iNdEx])
-			copy(dAtA[i:], m.ReadAddr[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReadAddr[iNdEx])))
+	if len(m.Clusters) > 0 {
+		for iNdEx := len(m.Clusters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clusters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2a
 		}
 	}
-	if len(m.WriteAddr) > 0 {
-		for iNdEx := len(m.WriteAddr) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.WriteAddr[iNdEx])
-			copy(dAtA[i:], m.WriteAddr[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.WriteAddr[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Period.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.ProjectName)
+	copy(dAtA[i:], m.ProjectName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProjectName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TenantID)
+	copy(dAtA[i:], m.TenantID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TenantID)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PrometheusSpec) Marshal() (dAtA []byte, err error) {
+func (m *Prometheus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1488,24 +2089,165 @@ func (m *PrometheusSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PrometheusSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *Prometheus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PrometheusSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Prometheus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.WithNPD {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x50
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PrometheusList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrometheusList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrometheusList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PrometheusRemoteAddr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrometheusRemoteAddr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrometheusRemoteAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReadAddr) > 0 {
+		for iNdEx := len(m.ReadAddr) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReadAddr[iNdEx])
+			copy(dAtA[i:], m.ReadAddr[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReadAddr[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WriteAddr) > 0 {
+		for iNdEx := len(m.WriteAddr) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WriteAddr[iNdEx])
+			copy(dAtA[i:], m.WriteAddr[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.WriteAddr[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrometheusSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrometheusSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrometheusSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.WithNPD {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x50
 	i -= len(m.AlertRepeatInterval)
 	copy(dAtA[i:], m.AlertRepeatInterval)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AlertRepeatInterval)))
@@ -1742,66 +2484,396 @@ func (m *ResourceRequirements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UsageReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ClusterOverview) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UsageReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	{
+		size, err := m.Query.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ClusterOverviewResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UsageReportItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageReportItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageReportItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	n += 1 + sovGenerated(uint64(m.ClusterCount))
-	n += 1 + sovGenerated(uint64(m.ClusterAbnormal))
-	n += 1 + sovGenerated(uint64(m.ProjectCount))
-	n += 1 + sovGenerated(uint64(m.ProjectAbnormal))
-	n += 1 + sovGenerated(uint64(m.NodeCount))
-	n += 1 + sovGenerated(uint64(m.NodeAbnormal))
-	n += 1 + sovGenerated(uint64(m.WorkloadCount))
-	n += 1 + sovGenerated(uint64(m.WorkloadAbnormal))
-	n += 9
-	n += 9
-	n += 9
-	n += 9
-	n += 1 + sovGenerated(uint64(m.MemCapacity))
-	n += 1 + sovGenerated(uint64(m.MemAllocatable))
-	n += 1 + sovGenerated(uint64(m.MemNotReadyCapacity))
-	n += 2 + sovGenerated(uint64(m.MemNotReadyAllocatable))
-	n += 2 + sovGenerated(uint64(m.PodCount))
-	if len(m.Clusters) > 0 {
-		for _, e := range m.Clusters {
-			l = e.Size()
-			n += 2 + l + sovGenerated(uint64(l))
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Cost))))
+	i--
+	dAtA[i] = 0x19
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ClusterStatistic) Size() (n int) {
-	if m == nil {
+func (m *UsageReportList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageReportList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageReportList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UsageReportQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageReportQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageReportQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Format)
+	copy(dAtA[i:], m.Format)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Format)))
+	i--
+	dAtA[i] = 0x42
+	if len(m.Prices) > 0 {
+		keysForPrices := make([]string, 0, len(m.Prices))
+		for k := range m.Prices {
+			keysForPrices = append(keysForPrices, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForPrices)
+		for iNdEx := len(keysForPrices) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Prices[string(keysForPrices[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForPrices[iNdEx])
+			copy(dAtA[i:], keysForPrices[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForPrices[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	i -= len(m.ChargeBy)
+	copy(dAtA[i:], m.ChargeBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChargeBy)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.ClusterName)
+	copy(dAtA[i:], m.ClusterName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ClusterName)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.ProjectName)
+	copy(dAtA[i:], m.ProjectName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProjectName)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.GroupBy)
+	copy(dAtA[i:], m.GroupBy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.GroupBy)))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UsageReportResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageReportResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageReportResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Cost))))
+	i--
+	dAtA[i] = 0x29
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Price))))
+	i--
+	dAtA[i] = 0x21
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.UsedHours))))
+	i--
+	dAtA[i] = 0x19
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.AllocatedHours))))
+	i--
+	dAtA[i] = 0x11
+	i -= len(m.Resource)
+	copy(dAtA[i:], m.Resource)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Resource)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UsageReportResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsageReportResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsageReportResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.CSV)
+	copy(dAtA[i:], m.CSV)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CSV)))
+	i--
+	dAtA[i] = 0x1a
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TotalCost))))
+	i--
+	dAtA[i] = 0x11
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClusterOverview) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ClusterOverviewResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ClusterCount))
+	n += 1 + sovGenerated(uint64(m.ClusterAbnormal))
+	n += 1 + sovGenerated(uint64(m.ProjectCount))
+	n += 1 + sovGenerated(uint64(m.ProjectAbnormal))
+	n += 1 + sovGenerated(uint64(m.NodeCount))
+	n += 1 + sovGenerated(uint64(m.NodeAbnormal))
+	n += 1 + sovGenerated(uint64(m.WorkloadCount))
+	n += 1 + sovGenerated(uint64(m.WorkloadAbnormal))
+	n += 9
+	n += 9
+	n += 9
+	n += 9
+	n += 1 + sovGenerated(uint64(m.MemCapacity))
+	n += 1 + sovGenerated(uint64(m.MemAllocatable))
+	n += 1 + sovGenerated(uint64(m.MemNotReadyCapacity))
+	n += 2 + sovGenerated(uint64(m.MemNotReadyAllocatable))
+	n += 2 + sovGenerated(uint64(m.PodCount))
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterStatistic) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1852,38 +2924,82 @@ func (m *ClusterStatistic) Size() (n int) {
 	return n
 }
 
-func (m *ConfigMap) Size() (n int) {
+func (m *ClusterUsage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = len(m.ClusterName)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Data) > 0 {
-		for k, v := range m.Data {
+	if len(m.Hard) > 0 {
+		for k, v := range m.Hard {
 			_ = k
 			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	if len(m.BinaryData) > 0 {
-		for k, v := range m.BinaryData {
+	if len(m.Allocated) > 0 {
+		for k, v := range m.Allocated {
 			_ = k
 			_ = v
-			l = 0
-			if v != nil {
-				l = 1 + len(v) + sovGenerated(uint64(len(v)))
-			}
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + l
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
 			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	return n
-}
-
-func (m *ConfigMapList) Size() (n int) {
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Namespaces) > 0 {
+		for _, e := range m.Namespaces {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConfigMap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Data) > 0 {
+		for k, v := range m.Data {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.BinaryData) > 0 {
+		for k, v := range m.BinaryData {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = 1 + len(v) + sovGenerated(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ConfigMapList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1988,6 +3104,88 @@ func (m *MetricQueryCondition) Size() (n int) {
 	return n
 }
 
+func (m *NamespaceUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Allocated) > 0 {
+		for k, v := range m.Allocated {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ProjectUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ProjectUsageList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectUsageSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TenantID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ProjectName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Timestamp.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Period.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Clusters) > 0 {
+		for _, e := range m.Clusters {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Prometheus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2127,6 +3325,122 @@ func (m *ResourceRequirements) Size() (n int) {
 	return n
 }
 
+func (m *UsageReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Query.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *UsageReportItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 9
+	return n
+}
+
+func (m *UsageReportList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UsageReportQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StartTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.EndTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GroupBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ProjectName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ClusterName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ChargeBy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Prices) > 0 {
+		for k, v := range m.Prices {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Format)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *UsageReportResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resource)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 9
+	n += 9
+	n += 9
+	n += 9
+	return n
+}
+
+func (m *UsageReportResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 9
+	l = len(m.CSV)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func sovGenerated(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2218,6 +3532,55 @@ func (this *ClusterStatistic) String() string {
 	}, "")
 	return s
 }
+func (this *ClusterUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNamespaces := "[]NamespaceUsage{"
+	for _, f := range this.Namespaces {
+		repeatedStringForNamespaces += strings.Replace(strings.Replace(f.String(), "NamespaceUsage", "NamespaceUsage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForNamespaces += "}"
+	keysForHard := make([]string, 0, len(this.Hard))
+	for k := range this.Hard {
+		keysForHard = append(keysForHard, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+	mapStringForHard := "ResourceList{"
+	for _, k := range keysForHard {
+		mapStringForHard += fmt.Sprintf("%v: %v,", k, this.Hard[k])
+	}
+	mapStringForHard += "}"
+	keysForAllocated := make([]string, 0, len(this.Allocated))
+	for k := range this.Allocated {
+		keysForAllocated = append(keysForAllocated, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAllocated)
+	mapStringForAllocated := "ResourceList{"
+	for _, k := range keysForAllocated {
+		mapStringForAllocated += fmt.Sprintf("%v: %v,", k, this.Allocated[k])
+	}
+	mapStringForAllocated += "}"
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k := range this.Used {
+		keysForUsed = append(keysForUsed, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "ResourceList{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k])
+	}
+	mapStringForUsed += "}"
+	s := strings.Join([]string{`&ClusterUsage{`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`Hard:` + mapStringForHard + `,`,
+		`Allocated:` + mapStringForAllocated + `,`,
+		`Used:` + mapStringForUsed + `,`,
+		`Namespaces:` + repeatedStringForNamespaces + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ConfigMap) String() string {
 	if this == nil {
 		return "nil"
@@ -2330,58 +3693,136 @@ func (this *MetricQueryCondition) String() string {
 	}, "")
 	return s
 }
-func (this *Prometheus) String() string {
+func (this *NamespaceUsage) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&Prometheus{`,
+	keysForAllocated := make([]string, 0, len(this.Allocated))
+	for k := range this.Allocated {
+		keysForAllocated = append(keysForAllocated, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAllocated)
+	mapStringForAllocated := "ResourceList{"
+	for _, k := range keysForAllocated {
+		mapStringForAllocated += fmt.Sprintf("%v: %v,", k, this.Allocated[k])
+	}
+	mapStringForAllocated += "}"
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k := range this.Used {
+		keysForUsed = append(keysForUsed, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "ResourceList{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k])
+	}
+	mapStringForUsed += "}"
+	s := strings.Join([]string{`&NamespaceUsage{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Allocated:` + mapStringForAllocated + `,`,
+		`Used:` + mapStringForUsed + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectUsage{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PrometheusSpec", "PrometheusSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "PrometheusStatus", "PrometheusStatus", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ProjectUsageSpec", "ProjectUsageSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PrometheusList) String() string {
+func (this *ProjectUsageList) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForItems := "[]Prometheus{"
+	repeatedStringForItems := "[]ProjectUsage{"
 	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Prometheus", "Prometheus", 1), `&`, ``, 1) + ","
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ProjectUsage", "ProjectUsage", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&PrometheusList{`,
+	s := strings.Join([]string{`&ProjectUsageList{`,
 		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PrometheusRemoteAddr) String() string {
+func (this *ProjectUsageSpec) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PrometheusRemoteAddr{`,
-		`WriteAddr:` + fmt.Sprintf("%v", this.WriteAddr) + `,`,
-		`ReadAddr:` + fmt.Sprintf("%v", this.ReadAddr) + `,`,
+	repeatedStringForClusters := "[]ClusterUsage{"
+	for _, f := range this.Clusters {
+		repeatedStringForClusters += strings.Replace(strings.Replace(f.String(), "ClusterUsage", "ClusterUsage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForClusters += "}"
+	s := strings.Join([]string{`&ProjectUsageSpec{`,
+		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
+		`ProjectName:` + fmt.Sprintf("%v", this.ProjectName) + `,`,
+		`Timestamp:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Timestamp), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Period:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Period), "Duration", "v1.Duration", 1), `&`, ``, 1) + `,`,
+		`Clusters:` + repeatedStringForClusters + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *PrometheusSpec) String() string {
+func (this *Prometheus) String() string {
 	if this == nil {
 		return "nil"
 	}
-	keysForSubVersion := make([]string, 0, len(this.SubVersion))
-	for k := range this.SubVersion {
-		keysForSubVersion = append(keysForSubVersion, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForSubVersion)
-	mapStringForSubVersion := "map[string]string{"
-	for _, k := range keysForSubVersion {
-		mapStringForSubVersion += fmt.Sprintf("%v: %v,", k, this.SubVersion[k])
-	}
+	s := strings.Join([]string{`&Prometheus{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PrometheusSpec", "PrometheusSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "PrometheusStatus", "PrometheusStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PrometheusList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Prometheus{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Prometheus", "Prometheus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&PrometheusList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PrometheusRemoteAddr) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PrometheusRemoteAddr{`,
+		`WriteAddr:` + fmt.Sprintf("%v", this.WriteAddr) + `,`,
+		`ReadAddr:` + fmt.Sprintf("%v", this.ReadAddr) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PrometheusSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForSubVersion := make([]string, 0, len(this.SubVersion))
+	for k := range this.SubVersion {
+		keysForSubVersion = append(keysForSubVersion, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSubVersion)
+	mapStringForSubVersion := "map[string]string{"
+	for _, k := range keysForSubVersion {
+		mapStringForSubVersion += fmt.Sprintf("%v: %v,", k, this.SubVersion[k])
+	}
 	mapStringForSubVersion += "}"
 	s := strings.Join([]string{`&PrometheusSpec{`,
 		`TenantID:` + fmt.Sprintf("%v", this.TenantID) + `,`,
@@ -2454,6 +3895,109 @@ func (this *ResourceRequirements) String() string {
 	}, "")
 	return s
 }
+func (this *UsageReport) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UsageReport{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Query:` + strings.Replace(strings.Replace(this.Query.String(), "UsageReportQuery", "UsageReportQuery", 1), `&`, ``, 1) + `,`,
+		`Result:` + strings.Replace(this.Result.String(), "UsageReportResult", "UsageReportResult", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UsageReportItem) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForResources := "[]UsageReportResource{"
+	for _, f := range this.Resources {
+		repeatedStringForResources += strings.Replace(strings.Replace(f.String(), "UsageReportResource", "UsageReportResource", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForResources += "}"
+	s := strings.Join([]string{`&UsageReportItem{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Resources:` + repeatedStringForResources + `,`,
+		`Cost:` + fmt.Sprintf("%v", this.Cost) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UsageReportList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]UsageReport{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "UsageReport", "UsageReport", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&UsageReportList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UsageReportQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForPrices := make([]string, 0, len(this.Prices))
+	for k := range this.Prices {
+		keysForPrices = append(keysForPrices, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForPrices)
+	mapStringForPrices := "map[string]string{"
+	for _, k := range keysForPrices {
+		mapStringForPrices += fmt.Sprintf("%v: %v,", k, this.Prices[k])
+	}
+	mapStringForPrices += "}"
+	s := strings.Join([]string{`&UsageReportQuery{`,
+		`StartTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`EndTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.EndTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`GroupBy:` + fmt.Sprintf("%v", this.GroupBy) + `,`,
+		`ProjectName:` + fmt.Sprintf("%v", this.ProjectName) + `,`,
+		`ClusterName:` + fmt.Sprintf("%v", this.ClusterName) + `,`,
+		`ChargeBy:` + fmt.Sprintf("%v", this.ChargeBy) + `,`,
+		`Prices:` + mapStringForPrices + `,`,
+		`Format:` + fmt.Sprintf("%v", this.Format) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UsageReportResource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UsageReportResource{`,
+		`Resource:` + fmt.Sprintf("%v", this.Resource) + `,`,
+		`AllocatedHours:` + fmt.Sprintf("%v", this.AllocatedHours) + `,`,
+		`UsedHours:` + fmt.Sprintf("%v", this.UsedHours) + `,`,
+		`Price:` + fmt.Sprintf("%v", this.Price) + `,`,
+		`Cost:` + fmt.Sprintf("%v", this.Cost) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UsageReportResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]UsageReportItem{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "UsageReportItem", "UsageReportItem", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&UsageReportResult{`,
+		`Items:` + repeatedStringForItems + `,`,
+		`TotalCost:` + fmt.Sprintf("%v", this.TotalCost) + `,`,
+		`CSV:` + fmt.Sprintf("%v", this.CSV) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func valueToStringGenerated(v interface{}) string {
 	rv := reflect.ValueOf(v)
 	if rv.IsNil() {
@@ -2920,20 +4464,1616 @@ func (m *ClusterOverviewResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Clusters = append(m.Clusters, &ClusterStatistic{})
-			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Clusters = append(m.Clusters, &ClusterStatistic{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterStatistic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterStatistic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDisplayName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterDisplayName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPhase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterPhase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeCount", wireType)
+			}
+			m.NodeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAbnormal", wireType)
+			}
+			m.NodeAbnormal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeAbnormal |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadCount", wireType)
+			}
+			m.WorkloadCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkloadCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadAbnormal", wireType)
+			}
+			m.WorkloadAbnormal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkloadAbnormal |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMetricServer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMetricServer = bool(v != 0)
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUUsed", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CPUUsed = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPURequest", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CPURequest = float64(math.Float64frombits(v))
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPULimit", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CPULimit = float64(math.Float64frombits(v))
+		case 13:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUCapacity", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CPUCapacity = float64(math.Float64frombits(v))
+		case 14:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUAllocatable", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CPUAllocatable = float64(math.Float64frombits(v))
+		case 15:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUNotReadyCapacity", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CPUNotReadyCapacity = float64(math.Float64frombits(v))
+		case 16:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUNotReadyAllocatable", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CPUNotReadyAllocatable = float64(math.Float64frombits(v))
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPURequestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CPURequestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUAllocatableRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CPUAllocatableRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUUsage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CPUUsage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemUsed", wireType)
+			}
+			m.MemUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemUsed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemRequest", wireType)
+			}
+			m.MemRequest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemRequest |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemLimit", wireType)
+			}
+			m.MemLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemCapacity", wireType)
+			}
+			m.MemCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemCapacity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemAllocatable", wireType)
+			}
+			m.MemAllocatable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemAllocatable |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemNotReadyCapacity", wireType)
+			}
+			m.MemNotReadyCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemNotReadyCapacity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemNotReadyAllocatable", wireType)
+			}
+			m.MemNotReadyAllocatable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemNotReadyAllocatable |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemRequestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemRequestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemAllocatableRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemAllocatableRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemUsage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemUsage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodCount", wireType)
+			}
+			m.PodCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulerHealthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SchedulerHealthy = bool(v != 0)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerManagerHealthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ControllerManagerHealthy = bool(v != 0)
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EtcdHealthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EtcdHealthy = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hard == nil {
+				m.Hard = make(ResourceList)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hard[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allocated == nil {
+				m.Allocated = make(ResourceList)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Allocated[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Used == nil {
+				m.Used = make(ResourceList)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Used[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, NamespaceUsage{})
+			if err := m.Namespaces[len(m.Namespaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigMap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigMap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigMap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Data[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BinaryData == nil {
+				m.BinaryData = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.BinaryData[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2956,7 +6096,7 @@ func (m *ClusterOverviewResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
+func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2979,17 +6119,17 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterStatistic: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConfigMapList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterStatistic: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConfigMapList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2999,29 +6139,30 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterDisplayName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3031,169 +6172,81 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterDisplayName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, ConfigMap{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.TenantID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterPhase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterPhase = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeCount", wireType)
-			}
-			m.NodeCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NodeCount |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAbnormal", wireType)
-			}
-			m.NodeAbnormal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NodeAbnormal |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadCount", wireType)
-			}
-			m.WorkloadCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkloadCount |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Metric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadAbnormal", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.WorkloadAbnormal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkloadAbnormal |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMetricServer", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Metric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Metric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3203,94 +6256,30 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasMetricServer = bool(v != 0)
-		case 10:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPUUsed", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.CPUUsed = float64(math.Float64frombits(v))
-		case 11:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPURequest", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.CPURequest = float64(math.Float64frombits(v))
-		case 12:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPULimit", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.CPULimit = float64(math.Float64frombits(v))
-		case 13:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPUCapacity", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.CPUCapacity = float64(math.Float64frombits(v))
-		case 14:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPUAllocatable", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.CPUAllocatable = float64(math.Float64frombits(v))
-		case 15:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPUNotReadyCapacity", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.CPUNotReadyCapacity = float64(math.Float64frombits(v))
-		case 16:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPUNotReadyAllocatable", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.CPUNotReadyAllocatable = float64(math.Float64frombits(v))
-		case 17:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPURequestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3300,27 +6289,28 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CPURequestRate = string(dAtA[iNdEx:postIndex])
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 18:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPUAllocatableRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONResult", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3348,13 +6338,63 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CPUAllocatableRate = string(dAtA[iNdEx:postIndex])
+			m.JSONResult = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CPUUsage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3364,29 +6404,30 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CPUUsage = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemUsed", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			m.MemUsed = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3396,16 +6437,81 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemUsed |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemRequest", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Metric{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
 			}
-			m.MemRequest = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3415,35 +6521,29 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemRequest |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemLimit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.MemLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MemLimit |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 23:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemCapacity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.MemCapacity = 0
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3453,16 +6553,17 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemCapacity |= int64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 24:
+			m.StartTime = &v
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemAllocatable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			m.MemAllocatable = 0
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3472,16 +6573,17 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemAllocatable |= int64(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 25:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemNotReadyCapacity", wireType)
+			m.EndTime = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
 			}
-			m.MemNotReadyCapacity = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3491,16 +6593,29 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemNotReadyCapacity |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 26:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemNotReadyAllocatable", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.MemNotReadyAllocatable = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3510,14 +6625,29 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MemNotReadyAllocatable |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 27:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, MetricQueryCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemRequestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3545,11 +6675,11 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemRequestRate = string(dAtA[iNdEx:postIndex])
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 28:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemAllocatableRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3577,11 +6707,11 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemAllocatableRate = string(dAtA[iNdEx:postIndex])
+			m.Order = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 29:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemUsage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3609,13 +6739,13 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemUsage = string(dAtA[iNdEx:postIndex])
+			m.GroupBy = append(m.GroupBy, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 30:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.PodCount = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3625,16 +6755,16 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodCount |= int32(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 31:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulerHealthy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			var v int
+			m.Offset = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3644,17 +6774,130 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Offset |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SchedulerHealthy = bool(v != 0)
-		case 32:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControllerManagerHealthy", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MetricQueryCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetricQueryCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetricQueryCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3664,32 +6907,24 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ControllerManagerHealthy = bool(v != 0)
-		case 33:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EtcdHealthy", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.EtcdHealthy = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -3711,7 +6946,7 @@ func (m *ClusterStatistic) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigMap) Unmarshal(dAtA []byte) error {
+func (m *NamespaceUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3734,17 +6969,17 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigMap: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigMap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3754,28 +6989,27 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3802,11 +7036,11 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = make(map[string]string)
+			if m.Allocated == nil {
+				m.Allocated = make(ResourceList)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &resource.Quantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -3855,7 +7089,7 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -3865,24 +7099,26 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -3898,11 +7134,11 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Data[mapkey] = mapvalue
+			m.Allocated[mapkey] = *mapvalue
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3929,11 +7165,11 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BinaryData == nil {
-				m.BinaryData = make(map[string][]byte)
+			if m.Used == nil {
+				m.Used = make(ResourceList)
 			}
 			var mapkey string
-			mapvalue := []byte{}
+			mapvalue := &resource.Quantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -3982,7 +7218,7 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapbyteLen uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -3992,25 +7228,26 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postbytesIndex > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-					iNdEx = postbytesIndex
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4026,7 +7263,7 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.BinaryData[mapkey] = mapvalue
+			m.Used[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4049,7 +7286,7 @@ func (m *ConfigMap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
+func (m *ProjectUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4072,15 +7309,15 @@ func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigMapList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigMapList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4107,13 +7344,13 @@ func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4140,8 +7377,7 @@ func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ConfigMap{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4166,7 +7402,7 @@ func (m *ConfigMapList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metric) Unmarshal(dAtA []byte) error {
+func (m *ProjectUsageList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4189,15 +7425,15 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metric: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectUsageList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectUsageList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4224,13 +7460,13 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4257,42 +7493,11 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ProjectUsage{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSONResult", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JSONResult = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4314,7 +7519,7 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricList) Unmarshal(dAtA []byte) error {
+func (m *ProjectUsageSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4337,15 +7542,112 @@ func (m *MetricList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectUsageSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectUsageSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4372,13 +7674,13 @@ func (m *MetricList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clusters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4405,8 +7707,8 @@ func (m *MetricList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Metric{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Clusters = append(m.Clusters, ClusterUsage{})
+			if err := m.Clusters[len(m.Clusters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4431,7 +7733,7 @@ func (m *MetricList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricQuery) Unmarshal(dAtA []byte) error {
+func (m *Prometheus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4454,17 +7756,17 @@ func (m *MetricQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4474,69 +7776,30 @@ func (m *MetricQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Table = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.StartTime = &v
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
-			}
-			var v int64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.EndTime = &v
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4546,27 +7809,28 @@ func (m *MetricQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4593,48 +7857,65 @@ func (m *MetricQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, MetricQueryCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrometheusList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrometheusList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrometheusList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4644,29 +7925,30 @@ func (m *MetricQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Order = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4676,29 +7958,81 @@ func (m *MetricQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupBy = append(m.GroupBy, string(dAtA[iNdEx:postIndex]))
+			m.Items = append(m.Items, Prometheus{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Limit = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrometheusRemoteAddr) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrometheusRemoteAddr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrometheusRemoteAddr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteAddr", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4708,16 +8042,29 @@ func (m *MetricQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Offset = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WriteAddr = append(m.WriteAddr, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadAddr", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4727,11 +8074,24 @@ func (m *MetricQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Offset |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadAddr = append(m.ReadAddr, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4753,7 +8113,7 @@ func (m *MetricQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricQueryCondition) Unmarshal(dAtA []byte) error {
+func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4776,15 +8136,15 @@ func (m *MetricQueryCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricQueryCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrometheusSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricQueryCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrometheusSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4812,11 +8172,11 @@ func (m *MetricQueryCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.TenantID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4844,11 +8204,11 @@ func (m *MetricQueryCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expr = string(dAtA[iNdEx:postIndex])
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4876,61 +8236,138 @@ func (m *MetricQueryCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubVersion", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Prometheus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.SubVersion == nil {
+				m.SubVersion = make(map[string]string)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Prometheus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Prometheus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SubVersion[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4957,13 +8394,45 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemoteAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotifyWebhook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotifyWebhook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4990,15 +8459,35 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunOnMaster", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RunOnMaster = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AlertRepeatInterval", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5008,25 +8497,44 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AlertRepeatInterval = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithNPD", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithNPD = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5048,7 +8556,7 @@ func (m *Prometheus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrometheusList) Unmarshal(dAtA []byte) error {
+func (m *PrometheusStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5071,17 +8579,17 @@ func (m *PrometheusList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrometheusList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrometheusStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrometheusList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrometheusStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5091,30 +8599,29 @@ func (m *PrometheusList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5124,81 +8631,80 @@ func (m *PrometheusList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Prometheus{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = AddonPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PrometheusRemoteAddr) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PrometheusRemoteAddr: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrometheusRemoteAddr: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.RetryCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WriteAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReInitializingTimestamp", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5208,29 +8714,30 @@ func (m *PrometheusRemoteAddr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WriteAddr = append(m.WriteAddr, string(dAtA[iNdEx:postIndex]))
+			if err := m.LastReInitializingTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubVersion", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5240,23 +8747,118 @@ func (m *PrometheusRemoteAddr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReadAddr = append(m.ReadAddr, string(dAtA[iNdEx:postIndex]))
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubVersion == nil {
+				m.SubVersion = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SubVersion[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5279,7 +8881,7 @@ func (m *PrometheusRemoteAddr) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
+func (m *ResourceRequirements) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5302,49 +8904,17 @@ func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrometheusSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceRequirements: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrometheusSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceRequirements: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TenantID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TenantID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5354,59 +8924,124 @@ func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if m.Limits == nil {
+				m.Limits = make(ResourceList)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.Limits[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5433,11 +9068,11 @@ func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubVersion == nil {
-				m.SubVersion = make(map[string]string)
+			if m.Requests == nil {
+				m.Requests = make(ResourceList)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &resource.Quantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -5486,7 +9121,7 @@ func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -5496,24 +9131,26 @@ func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5529,11 +9166,61 @@ func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.SubVersion[mapkey] = mapvalue
+			m.Requests[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5560,15 +9247,15 @@ func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemoteAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotifyWebhook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5578,27 +9265,28 @@ func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotifyWebhook = string(dAtA[iNdEx:postIndex])
+			if err := m.Query.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5625,15 +9313,68 @@ func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Result == nil {
+				m.Result = &UsageReportResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunOnMaster", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageReportItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageReportItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageReportItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5643,17 +9384,124 @@ func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RunOnMaster = bool(v != 0)
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, UsageReportResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Cost = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageReportList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageReportList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageReportList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlertRepeatInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5663,29 +9511,30 @@ func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AlertRepeatInterval = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithNPD", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5695,12 +9544,26 @@ func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WithNPD = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, UsageReport{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -5722,7 +9585,7 @@ func (m *PrometheusSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrometheusStatus) Unmarshal(dAtA []byte) error {
+func (m *UsageReportQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5745,17 +9608,17 @@ func (m *PrometheusStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrometheusStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: UsageReportQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrometheusStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UsageReportQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5765,27 +9628,61 @@ func (m *PrometheusStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5813,11 +9710,11 @@ func (m *PrometheusStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AddonPhase(dAtA[iNdEx:postIndex])
+			m.GroupBy = UsageReportGroupBy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5845,13 +9742,13 @@ func (m *PrometheusStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.ProjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryCount", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
 			}
-			m.RetryCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5861,16 +9758,29 @@ func (m *PrometheusStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RetryCount |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastReInitializingTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChargeBy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -5880,28 +9790,27 @@ func (m *PrometheusStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastReInitializingTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ChargeBy = UsageReportChargeBy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5928,8 +9837,8 @@ func (m *PrometheusStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubVersion == nil {
-				m.SubVersion = make(map[string]string)
+			if m.Prices == nil {
+				m.Prices = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -6024,8 +9933,166 @@ func (m *PrometheusStatus) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.SubVersion[mapkey] = mapvalue
-			iNdEx = postIndex
+			m.Prices[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = UsageReportFormat(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsageReportResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsageReportResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsageReportResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedHours", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.AllocatedHours = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedHours", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.UsedHours = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Price = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Cost = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -6047,7 +10114,7 @@ func (m *PrometheusStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceRequirements) Unmarshal(dAtA []byte) error {
+func (m *UsageReportResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6070,15 +10137,15 @@ func (m *ResourceRequirements) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceRequirements: wiretype end group for non-group")
+			return fmt.Errorf("proto: UsageReportResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceRequirements: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UsageReportResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6105,111 +10172,27 @@ func (m *ResourceRequirements) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Limits == nil {
-				m.Limits = make(ResourceList)
-			}
-			var mapkey string
-			mapvalue := &resource.Quantity{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &resource.Quantity{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Items = append(m.Items, UsageReportItem{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Limits[mapkey] = *mapvalue
 			iNdEx = postIndex
 		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCost", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.TotalCost = float64(math.Float64frombits(v))
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CSV", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -6219,120 +10202,23 @@ func (m *ResourceRequirements) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Requests == nil {
-				m.Requests = make(ResourceList)
-			}
-			var mapkey string
-			mapvalue := &resource.Quantity{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &resource.Quantity{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Requests[mapkey] = *mapvalue
+			m.CSV = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
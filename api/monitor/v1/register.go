@@ -57,7 +57,13 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ConfigMap{},
 		&ConfigMapList{},
 
-		&ClusterOverview{})
+		&ClusterOverview{},
+
+		&ProjectUsage{},
+		&ProjectUsageList{},
+
+		&UsageReport{},
+		&UsageReportList{})
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
 }
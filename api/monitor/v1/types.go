@@ -306,3 +306,189 @@ type ConfigMapList struct {
 	// Items is the list of ConfigMaps.
 	Items []ConfigMap `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection,update,patch,watch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectUsage is a periodic snapshot of the allocation and usage of a project.
+type ProjectUsage struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// +optional
+	Spec ProjectUsageSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectUsageList is the whole list of all project usage snapshots.
+type ProjectUsageList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of project usage snapshots
+	Items []ProjectUsage `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ProjectUsageSpec describes the allocation and usage of a project at a time.
+type ProjectUsageSpec struct {
+	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	ProjectName string `json:"projectName" protobuf:"bytes,2,opt,name=projectName"`
+	// Timestamp is the time the snapshot was taken.
+	Timestamp metav1.Time `json:"timestamp" protobuf:"bytes,3,opt,name=timestamp"`
+	// Period is the time the snapshot stands for until the next snapshot.
+	// The hourly snapshots older than a week are rolled up into daily snapshots.
+	Period metav1.Duration `json:"period" protobuf:"bytes,4,opt,name=period"`
+	// +optional
+	Clusters []ClusterUsage `json:"clusters,omitempty" protobuf:"bytes,5,rep,name=clusters"`
+}
+
+// ClusterUsage describes the allocation and usage of a project in a cluster.
+type ClusterUsage struct {
+	ClusterName string `json:"clusterName" protobuf:"bytes,1,opt,name=clusterName"`
+	// Hard is the quota of the project in the cluster.
+	// +optional
+	Hard ResourceList `json:"hard,omitempty" protobuf:"bytes,2,rep,name=hard,casttype=ResourceList"`
+	// Allocated is the sum of the quota of the namespaces of the project in the cluster.
+	// +optional
+	Allocated ResourceList `json:"allocated,omitempty" protobuf:"bytes,3,rep,name=allocated,casttype=ResourceList"`
+	// Used is the sum of the usage of the namespaces of the project in the cluster.
+	// +optional
+	Used ResourceList `json:"used,omitempty" protobuf:"bytes,4,rep,name=used,casttype=ResourceList"`
+	// +optional
+	Namespaces []NamespaceUsage `json:"namespaces,omitempty" protobuf:"bytes,5,rep,name=namespaces"`
+}
+
+// NamespaceUsage describes the allocation and usage of a namespace.
+type NamespaceUsage struct {
+	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	// +optional
+	Allocated ResourceList `json:"allocated,omitempty" protobuf:"bytes,2,rep,name=allocated,casttype=ResourceList"`
+	// +optional
+	Used ResourceList `json:"used,omitempty" protobuf:"bytes,3,rep,name=used,casttype=ResourceList"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// UsageReport defines the structure for querying the chargeback report
+// aggregated from the project usage snapshots.
+type UsageReport struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// +optional
+	Query UsageReportQuery `json:"query,omitempty" protobuf:"bytes,2,opt,name=query"`
+	// +optional
+	Result *UsageReportResult `json:"result,omitempty" protobuf:"bytes,3,opt,name=result"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// UsageReportList is the whole list of all usage reports.
+type UsageReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of usage reports
+	Items []UsageReport `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// UsageReportQuery describes the time range and the aggregation of a usage report.
+type UsageReportQuery struct {
+	StartTime metav1.Time `json:"startTime" protobuf:"bytes,1,opt,name=startTime"`
+	EndTime   metav1.Time `json:"endTime" protobuf:"bytes,2,opt,name=endTime"`
+	// GroupBy is one of Project, Cluster and Tenant, defaults to Project.
+	// +optional
+	GroupBy UsageReportGroupBy `json:"groupBy,omitempty" protobuf:"bytes,3,opt,name=groupBy,casttype=UsageReportGroupBy"`
+	// ProjectName limits the report to a project.
+	// +optional
+	ProjectName string `json:"projectName,omitempty" protobuf:"bytes,4,opt,name=projectName"`
+	// ClusterName limits the report to a cluster.
+	// +optional
+	ClusterName string `json:"clusterName,omitempty" protobuf:"bytes,5,opt,name=clusterName"`
+	// ChargeBy is Allocated to charge the quota allocated to the namespaces,
+	// or Used to charge the usage, defaults to Allocated.
+	// +optional
+	ChargeBy UsageReportChargeBy `json:"chargeBy,omitempty" protobuf:"bytes,6,opt,name=chargeBy,casttype=UsageReportChargeBy"`
+	// Prices overrides the configured unit prices, which are the prices of a
+	// unit of the resources per hour. The unit is a core for cpu, a GiB for
+	// memory and storage, and one for the others.
+	// +optional
+	Prices map[string]string `json:"prices,omitempty" protobuf:"bytes,7,rep,name=prices"`
+	// Format is JSON or CSV, the report is exported into the result in CSV
+	// format as well if CSV.
+	// +optional
+	Format UsageReportFormat `json:"format,omitempty" protobuf:"bytes,8,opt,name=format,casttype=UsageReportFormat"`
+}
+
+// UsageReportResult is the aggregated usage of a usage report.
+type UsageReportResult struct {
+	// +optional
+	Items []UsageReportItem `json:"items,omitempty" protobuf:"bytes,1,rep,name=items"`
+	// TotalCost is the sum of the cost of the items.
+	TotalCost float64 `json:"totalCost" protobuf:"fixed64,2,opt,name=totalCost"`
+	// CSV is the report in CSV format.
+	// +optional
+	CSV string `json:"csv,omitempty" protobuf:"bytes,3,opt,name=csv"`
+}
+
+// UsageReportItem is the aggregated usage of a project, a cluster or a tenant.
+type UsageReportItem struct {
+	// Name is the name of the project, the cluster or the tenant.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// +optional
+	Resources []UsageReportResource `json:"resources,omitempty" protobuf:"bytes,2,rep,name=resources"`
+	Cost      float64               `json:"cost" protobuf:"fixed64,3,opt,name=cost"`
+}
+
+// UsageReportResource is the aggregated usage of a resource in unit hours.
+type UsageReportResource struct {
+	Resource       string  `json:"resource" protobuf:"bytes,1,opt,name=resource"`
+	AllocatedHours float64 `json:"allocatedHours" protobuf:"fixed64,2,opt,name=allocatedHours"`
+	UsedHours      float64 `json:"usedHours" protobuf:"fixed64,3,opt,name=usedHours"`
+	Price          float64 `json:"price" protobuf:"fixed64,4,opt,name=price"`
+	Cost           float64 `json:"cost" protobuf:"fixed64,5,opt,name=cost"`
+}
+
+// UsageReportGroupBy indicates how the usage is aggregated.
+type UsageReportGroupBy string
+
+const (
+	// UsageReportGroupByProject aggregates the usage by project.
+	UsageReportGroupByProject UsageReportGroupBy = "Project"
+	// UsageReportGroupByCluster aggregates the usage by cluster.
+	UsageReportGroupByCluster UsageReportGroupBy = "Cluster"
+	// UsageReportGroupByTenant aggregates the usage by tenant.
+	UsageReportGroupByTenant UsageReportGroupBy = "Tenant"
+)
+
+// UsageReportChargeBy indicates what is charged.
+type UsageReportChargeBy string
+
+const (
+	// UsageReportChargeByAllocated charges the quota allocated to the namespaces.
+	UsageReportChargeByAllocated UsageReportChargeBy = "Allocated"
+	// UsageReportChargeByUsed charges the usage of the namespaces.
+	UsageReportChargeByUsed UsageReportChargeBy = "Used"
+)
+
+// UsageReportFormat indicates the format a usage report is exported in.
+type UsageReportFormat string
+
+const (
+	// UsageReportFormatJSON exports the report in JSON.
+	UsageReportFormatJSON UsageReportFormat = "JSON"
+	// UsageReportFormatCSV exports the report in CSV as well.
+	UsageReportFormatCSV UsageReportFormat = "CSV"
+)
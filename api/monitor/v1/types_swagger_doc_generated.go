@@ -37,6 +37,17 @@ func (ClusterOverview) SwaggerDoc() map[string]string {
 	return map_ClusterOverview
 }
 
+var map_ClusterUsage = map[string]string{
+	"":          "ClusterUsage describes the allocation and usage of a project in a cluster.",
+	"hard":      "Hard is the quota of the project in the cluster.",
+	"allocated": "Allocated is the sum of the quota of the namespaces of the project in the cluster.",
+	"used":      "Used is the sum of the usage of the namespaces of the project in the cluster.",
+}
+
+func (ClusterUsage) SwaggerDoc() map[string]string {
+	return map_ClusterUsage
+}
+
 var map_ConfigMap = map[string]string{
 	"":           "ConfigMap holds configuration data for tke to consume.",
 	"data":       "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
@@ -73,6 +84,41 @@ func (MetricList) SwaggerDoc() map[string]string {
 	return map_MetricList
 }
 
+var map_NamespaceUsage = map[string]string{
+	"": "NamespaceUsage describes the allocation and usage of a namespace.",
+}
+
+func (NamespaceUsage) SwaggerDoc() map[string]string {
+	return map_NamespaceUsage
+}
+
+var map_ProjectUsage = map[string]string{
+	"": "ProjectUsage is a periodic snapshot of the allocation and usage of a project.",
+}
+
+func (ProjectUsage) SwaggerDoc() map[string]string {
+	return map_ProjectUsage
+}
+
+var map_ProjectUsageList = map[string]string{
+	"":      "ProjectUsageList is the whole list of all project usage snapshots.",
+	"items": "List of project usage snapshots",
+}
+
+func (ProjectUsageList) SwaggerDoc() map[string]string {
+	return map_ProjectUsageList
+}
+
+var map_ProjectUsageSpec = map[string]string{
+	"":          "ProjectUsageSpec describes the allocation and usage of a project at a time.",
+	"timestamp": "Timestamp is the time the snapshot was taken.",
+	"period":    "Period is the time the snapshot stands for until the next snapshot. The hourly snapshots older than a week are rolled up into daily snapshots.",
+}
+
+func (ProjectUsageSpec) SwaggerDoc() map[string]string {
+	return map_ProjectUsageSpec
+}
+
 var map_Prometheus = map[string]string{
 	"":     "Prometheus is a kubernetes package manager.",
 	"spec": "Spec defines the desired identities of clusters in this set.",
@@ -135,4 +181,62 @@ func (ResourceRequirements) SwaggerDoc() map[string]string {
 	return map_ResourceRequirements
 }
 
+var map_UsageReport = map[string]string{
+	"": "UsageReport defines the structure for querying the chargeback report aggregated from the project usage snapshots.",
+}
+
+func (UsageReport) SwaggerDoc() map[string]string {
+	return map_UsageReport
+}
+
+var map_UsageReportItem = map[string]string{
+	"":     "UsageReportItem is the aggregated usage of a project, a cluster or a tenant.",
+	"name": "Name is the name of the project, the cluster or the tenant.",
+}
+
+func (UsageReportItem) SwaggerDoc() map[string]string {
+	return map_UsageReportItem
+}
+
+var map_UsageReportList = map[string]string{
+	"":      "UsageReportList is the whole list of all usage reports.",
+	"items": "List of usage reports",
+}
+
+func (UsageReportList) SwaggerDoc() map[string]string {
+	return map_UsageReportList
+}
+
+var map_UsageReportQuery = map[string]string{
+	"":            "UsageReportQuery describes the time range and the aggregation of a usage report.",
+	"groupBy":     "GroupBy is one of Project, Cluster and Tenant, defaults to Project.",
+	"projectName": "ProjectName limits the report to a project.",
+	"clusterName": "ClusterName limits the report to a cluster.",
+	"chargeBy":    "ChargeBy is Allocated to charge the quota allocated to the namespaces, or Used to charge the usage, defaults to Allocated.",
+	"prices":      "Prices overrides the configured unit prices, which are the prices of a unit of the resources per hour. The unit is a core for cpu, a GiB for memory and storage, and one for the others.",
+	"format":      "Format is JSON or CSV, the report is exported into the result in CSV format as well if CSV.",
+}
+
+func (UsageReportQuery) SwaggerDoc() map[string]string {
+	return map_UsageReportQuery
+}
+
+var map_UsageReportResource = map[string]string{
+	"": "UsageReportResource is the aggregated usage of a resource in unit hours.",
+}
+
+func (UsageReportResource) SwaggerDoc() map[string]string {
+	return map_UsageReportResource
+}
+
+var map_UsageReportResult = map[string]string{
+	"":          "UsageReportResult is the aggregated usage of a usage report.",
+	"totalCost": "TotalCost is the sum of the cost of the items.",
+	"csv":       "CSV is the report in CSV format.",
+}
+
+func (UsageReportResult) SwaggerDoc() map[string]string {
+	return map_UsageReportResult
+}
+
 // AUTO-GENERATED FUNCTIONS END HERE
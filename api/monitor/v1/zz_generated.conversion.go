@@ -67,6 +67,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterUsage)(nil), (*monitor.ClusterUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterUsage_To_monitor_ClusterUsage(a.(*ClusterUsage), b.(*monitor.ClusterUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.ClusterUsage)(nil), (*ClusterUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_ClusterUsage_To_v1_ClusterUsage(a.(*monitor.ClusterUsage), b.(*ClusterUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ConfigMap)(nil), (*monitor.ConfigMap)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ConfigMap_To_monitor_ConfigMap(a.(*ConfigMap), b.(*monitor.ConfigMap), scope)
 	}); err != nil {
@@ -127,6 +137,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceUsage)(nil), (*monitor.NamespaceUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceUsage_To_monitor_NamespaceUsage(a.(*NamespaceUsage), b.(*monitor.NamespaceUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.NamespaceUsage)(nil), (*NamespaceUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_NamespaceUsage_To_v1_NamespaceUsage(a.(*monitor.NamespaceUsage), b.(*NamespaceUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectUsage)(nil), (*monitor.ProjectUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectUsage_To_monitor_ProjectUsage(a.(*ProjectUsage), b.(*monitor.ProjectUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.ProjectUsage)(nil), (*ProjectUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_ProjectUsage_To_v1_ProjectUsage(a.(*monitor.ProjectUsage), b.(*ProjectUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectUsageList)(nil), (*monitor.ProjectUsageList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectUsageList_To_monitor_ProjectUsageList(a.(*ProjectUsageList), b.(*monitor.ProjectUsageList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.ProjectUsageList)(nil), (*ProjectUsageList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_ProjectUsageList_To_v1_ProjectUsageList(a.(*monitor.ProjectUsageList), b.(*ProjectUsageList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectUsageSpec)(nil), (*monitor.ProjectUsageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectUsageSpec_To_monitor_ProjectUsageSpec(a.(*ProjectUsageSpec), b.(*monitor.ProjectUsageSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.ProjectUsageSpec)(nil), (*ProjectUsageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_ProjectUsageSpec_To_v1_ProjectUsageSpec(a.(*monitor.ProjectUsageSpec), b.(*ProjectUsageSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Prometheus)(nil), (*monitor.Prometheus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Prometheus_To_monitor_Prometheus(a.(*Prometheus), b.(*monitor.Prometheus), scope)
 	}); err != nil {
@@ -187,6 +237,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*UsageReport)(nil), (*monitor.UsageReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UsageReport_To_monitor_UsageReport(a.(*UsageReport), b.(*monitor.UsageReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.UsageReport)(nil), (*UsageReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_UsageReport_To_v1_UsageReport(a.(*monitor.UsageReport), b.(*UsageReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UsageReportItem)(nil), (*monitor.UsageReportItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UsageReportItem_To_monitor_UsageReportItem(a.(*UsageReportItem), b.(*monitor.UsageReportItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.UsageReportItem)(nil), (*UsageReportItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_UsageReportItem_To_v1_UsageReportItem(a.(*monitor.UsageReportItem), b.(*UsageReportItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UsageReportList)(nil), (*monitor.UsageReportList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UsageReportList_To_monitor_UsageReportList(a.(*UsageReportList), b.(*monitor.UsageReportList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.UsageReportList)(nil), (*UsageReportList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_UsageReportList_To_v1_UsageReportList(a.(*monitor.UsageReportList), b.(*UsageReportList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UsageReportQuery)(nil), (*monitor.UsageReportQuery)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UsageReportQuery_To_monitor_UsageReportQuery(a.(*UsageReportQuery), b.(*monitor.UsageReportQuery), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.UsageReportQuery)(nil), (*UsageReportQuery)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_UsageReportQuery_To_v1_UsageReportQuery(a.(*monitor.UsageReportQuery), b.(*UsageReportQuery), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UsageReportResource)(nil), (*monitor.UsageReportResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UsageReportResource_To_monitor_UsageReportResource(a.(*UsageReportResource), b.(*monitor.UsageReportResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.UsageReportResource)(nil), (*UsageReportResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_UsageReportResource_To_v1_UsageReportResource(a.(*monitor.UsageReportResource), b.(*UsageReportResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UsageReportResult)(nil), (*monitor.UsageReportResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UsageReportResult_To_monitor_UsageReportResult(a.(*UsageReportResult), b.(*monitor.UsageReportResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.UsageReportResult)(nil), (*UsageReportResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_UsageReportResult_To_v1_UsageReportResult(a.(*monitor.UsageReportResult), b.(*UsageReportResult), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -350,6 +460,34 @@ func Convert_monitor_ClusterStatistic_To_v1_ClusterStatistic(in *monitor.Cluster
 	return autoConvert_monitor_ClusterStatistic_To_v1_ClusterStatistic(in, out, s)
 }
 
+func autoConvert_v1_ClusterUsage_To_monitor_ClusterUsage(in *ClusterUsage, out *monitor.ClusterUsage, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Hard = *(*monitor.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Allocated = *(*monitor.ResourceList)(unsafe.Pointer(&in.Allocated))
+	out.Used = *(*monitor.ResourceList)(unsafe.Pointer(&in.Used))
+	out.Namespaces = *(*[]monitor.NamespaceUsage)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_v1_ClusterUsage_To_monitor_ClusterUsage is an autogenerated conversion function.
+func Convert_v1_ClusterUsage_To_monitor_ClusterUsage(in *ClusterUsage, out *monitor.ClusterUsage, s conversion.Scope) error {
+	return autoConvert_v1_ClusterUsage_To_monitor_ClusterUsage(in, out, s)
+}
+
+func autoConvert_monitor_ClusterUsage_To_v1_ClusterUsage(in *monitor.ClusterUsage, out *ClusterUsage, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Hard = *(*ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Allocated = *(*ResourceList)(unsafe.Pointer(&in.Allocated))
+	out.Used = *(*ResourceList)(unsafe.Pointer(&in.Used))
+	out.Namespaces = *(*[]NamespaceUsage)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_monitor_ClusterUsage_To_v1_ClusterUsage is an autogenerated conversion function.
+func Convert_monitor_ClusterUsage_To_v1_ClusterUsage(in *monitor.ClusterUsage, out *ClusterUsage, s conversion.Scope) error {
+	return autoConvert_monitor_ClusterUsage_To_v1_ClusterUsage(in, out, s)
+}
+
 func autoConvert_v1_ConfigMap_To_monitor_ConfigMap(in *ConfigMap, out *monitor.ConfigMap, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
@@ -508,6 +646,106 @@ func Convert_monitor_MetricQueryCondition_To_v1_MetricQueryCondition(in *monitor
 	return autoConvert_monitor_MetricQueryCondition_To_v1_MetricQueryCondition(in, out, s)
 }
 
+func autoConvert_v1_NamespaceUsage_To_monitor_NamespaceUsage(in *NamespaceUsage, out *monitor.NamespaceUsage, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Allocated = *(*monitor.ResourceList)(unsafe.Pointer(&in.Allocated))
+	out.Used = *(*monitor.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_v1_NamespaceUsage_To_monitor_NamespaceUsage is an autogenerated conversion function.
+func Convert_v1_NamespaceUsage_To_monitor_NamespaceUsage(in *NamespaceUsage, out *monitor.NamespaceUsage, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceUsage_To_monitor_NamespaceUsage(in, out, s)
+}
+
+func autoConvert_monitor_NamespaceUsage_To_v1_NamespaceUsage(in *monitor.NamespaceUsage, out *NamespaceUsage, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Allocated = *(*ResourceList)(unsafe.Pointer(&in.Allocated))
+	out.Used = *(*ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_monitor_NamespaceUsage_To_v1_NamespaceUsage is an autogenerated conversion function.
+func Convert_monitor_NamespaceUsage_To_v1_NamespaceUsage(in *monitor.NamespaceUsage, out *NamespaceUsage, s conversion.Scope) error {
+	return autoConvert_monitor_NamespaceUsage_To_v1_NamespaceUsage(in, out, s)
+}
+
+func autoConvert_v1_ProjectUsage_To_monitor_ProjectUsage(in *ProjectUsage, out *monitor.ProjectUsage, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_ProjectUsageSpec_To_monitor_ProjectUsageSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ProjectUsage_To_monitor_ProjectUsage is an autogenerated conversion function.
+func Convert_v1_ProjectUsage_To_monitor_ProjectUsage(in *ProjectUsage, out *monitor.ProjectUsage, s conversion.Scope) error {
+	return autoConvert_v1_ProjectUsage_To_monitor_ProjectUsage(in, out, s)
+}
+
+func autoConvert_monitor_ProjectUsage_To_v1_ProjectUsage(in *monitor.ProjectUsage, out *ProjectUsage, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_monitor_ProjectUsageSpec_To_v1_ProjectUsageSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_monitor_ProjectUsage_To_v1_ProjectUsage is an autogenerated conversion function.
+func Convert_monitor_ProjectUsage_To_v1_ProjectUsage(in *monitor.ProjectUsage, out *ProjectUsage, s conversion.Scope) error {
+	return autoConvert_monitor_ProjectUsage_To_v1_ProjectUsage(in, out, s)
+}
+
+func autoConvert_v1_ProjectUsageList_To_monitor_ProjectUsageList(in *ProjectUsageList, out *monitor.ProjectUsageList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]monitor.ProjectUsage)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ProjectUsageList_To_monitor_ProjectUsageList is an autogenerated conversion function.
+func Convert_v1_ProjectUsageList_To_monitor_ProjectUsageList(in *ProjectUsageList, out *monitor.ProjectUsageList, s conversion.Scope) error {
+	return autoConvert_v1_ProjectUsageList_To_monitor_ProjectUsageList(in, out, s)
+}
+
+func autoConvert_monitor_ProjectUsageList_To_v1_ProjectUsageList(in *monitor.ProjectUsageList, out *ProjectUsageList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ProjectUsage)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_monitor_ProjectUsageList_To_v1_ProjectUsageList is an autogenerated conversion function.
+func Convert_monitor_ProjectUsageList_To_v1_ProjectUsageList(in *monitor.ProjectUsageList, out *ProjectUsageList, s conversion.Scope) error {
+	return autoConvert_monitor_ProjectUsageList_To_v1_ProjectUsageList(in, out, s)
+}
+
+func autoConvert_v1_ProjectUsageSpec_To_monitor_ProjectUsageSpec(in *ProjectUsageSpec, out *monitor.ProjectUsageSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ProjectName = in.ProjectName
+	out.Timestamp = in.Timestamp
+	out.Period = in.Period
+	out.Clusters = *(*[]monitor.ClusterUsage)(unsafe.Pointer(&in.Clusters))
+	return nil
+}
+
+// Convert_v1_ProjectUsageSpec_To_monitor_ProjectUsageSpec is an autogenerated conversion function.
+func Convert_v1_ProjectUsageSpec_To_monitor_ProjectUsageSpec(in *ProjectUsageSpec, out *monitor.ProjectUsageSpec, s conversion.Scope) error {
+	return autoConvert_v1_ProjectUsageSpec_To_monitor_ProjectUsageSpec(in, out, s)
+}
+
+func autoConvert_monitor_ProjectUsageSpec_To_v1_ProjectUsageSpec(in *monitor.ProjectUsageSpec, out *ProjectUsageSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ProjectName = in.ProjectName
+	out.Timestamp = in.Timestamp
+	out.Period = in.Period
+	out.Clusters = *(*[]ClusterUsage)(unsafe.Pointer(&in.Clusters))
+	return nil
+}
+
+// Convert_monitor_ProjectUsageSpec_To_v1_ProjectUsageSpec is an autogenerated conversion function.
+func Convert_monitor_ProjectUsageSpec_To_v1_ProjectUsageSpec(in *monitor.ProjectUsageSpec, out *ProjectUsageSpec, s conversion.Scope) error {
+	return autoConvert_monitor_ProjectUsageSpec_To_v1_ProjectUsageSpec(in, out, s)
+}
+
 func autoConvert_v1_Prometheus_To_monitor_Prometheus(in *Prometheus, out *monitor.Prometheus, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_PrometheusSpec_To_monitor_PrometheusSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -681,3 +919,163 @@ func autoConvert_monitor_ResourceRequirements_To_v1_ResourceRequirements(in *mon
 func Convert_monitor_ResourceRequirements_To_v1_ResourceRequirements(in *monitor.ResourceRequirements, out *ResourceRequirements, s conversion.Scope) error {
 	return autoConvert_monitor_ResourceRequirements_To_v1_ResourceRequirements(in, out, s)
 }
+
+func autoConvert_v1_UsageReport_To_monitor_UsageReport(in *UsageReport, out *monitor.UsageReport, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_UsageReportQuery_To_monitor_UsageReportQuery(&in.Query, &out.Query, s); err != nil {
+		return err
+	}
+	out.Result = (*monitor.UsageReportResult)(unsafe.Pointer(in.Result))
+	return nil
+}
+
+// Convert_v1_UsageReport_To_monitor_UsageReport is an autogenerated conversion function.
+func Convert_v1_UsageReport_To_monitor_UsageReport(in *UsageReport, out *monitor.UsageReport, s conversion.Scope) error {
+	return autoConvert_v1_UsageReport_To_monitor_UsageReport(in, out, s)
+}
+
+func autoConvert_monitor_UsageReport_To_v1_UsageReport(in *monitor.UsageReport, out *UsageReport, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_monitor_UsageReportQuery_To_v1_UsageReportQuery(&in.Query, &out.Query, s); err != nil {
+		return err
+	}
+	out.Result = (*UsageReportResult)(unsafe.Pointer(in.Result))
+	return nil
+}
+
+// Convert_monitor_UsageReport_To_v1_UsageReport is an autogenerated conversion function.
+func Convert_monitor_UsageReport_To_v1_UsageReport(in *monitor.UsageReport, out *UsageReport, s conversion.Scope) error {
+	return autoConvert_monitor_UsageReport_To_v1_UsageReport(in, out, s)
+}
+
+func autoConvert_v1_UsageReportItem_To_monitor_UsageReportItem(in *UsageReportItem, out *monitor.UsageReportItem, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Resources = *(*[]monitor.UsageReportResource)(unsafe.Pointer(&in.Resources))
+	out.Cost = in.Cost
+	return nil
+}
+
+// Convert_v1_UsageReportItem_To_monitor_UsageReportItem is an autogenerated conversion function.
+func Convert_v1_UsageReportItem_To_monitor_UsageReportItem(in *UsageReportItem, out *monitor.UsageReportItem, s conversion.Scope) error {
+	return autoConvert_v1_UsageReportItem_To_monitor_UsageReportItem(in, out, s)
+}
+
+func autoConvert_monitor_UsageReportItem_To_v1_UsageReportItem(in *monitor.UsageReportItem, out *UsageReportItem, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Resources = *(*[]UsageReportResource)(unsafe.Pointer(&in.Resources))
+	out.Cost = in.Cost
+	return nil
+}
+
+// Convert_monitor_UsageReportItem_To_v1_UsageReportItem is an autogenerated conversion function.
+func Convert_monitor_UsageReportItem_To_v1_UsageReportItem(in *monitor.UsageReportItem, out *UsageReportItem, s conversion.Scope) error {
+	return autoConvert_monitor_UsageReportItem_To_v1_UsageReportItem(in, out, s)
+}
+
+func autoConvert_v1_UsageReportList_To_monitor_UsageReportList(in *UsageReportList, out *monitor.UsageReportList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]monitor.UsageReport)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_UsageReportList_To_monitor_UsageReportList is an autogenerated conversion function.
+func Convert_v1_UsageReportList_To_monitor_UsageReportList(in *UsageReportList, out *monitor.UsageReportList, s conversion.Scope) error {
+	return autoConvert_v1_UsageReportList_To_monitor_UsageReportList(in, out, s)
+}
+
+func autoConvert_monitor_UsageReportList_To_v1_UsageReportList(in *monitor.UsageReportList, out *UsageReportList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]UsageReport)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_monitor_UsageReportList_To_v1_UsageReportList is an autogenerated conversion function.
+func Convert_monitor_UsageReportList_To_v1_UsageReportList(in *monitor.UsageReportList, out *UsageReportList, s conversion.Scope) error {
+	return autoConvert_monitor_UsageReportList_To_v1_UsageReportList(in, out, s)
+}
+
+func autoConvert_v1_UsageReportQuery_To_monitor_UsageReportQuery(in *UsageReportQuery, out *monitor.UsageReportQuery, s conversion.Scope) error {
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.GroupBy = monitor.UsageReportGroupBy(in.GroupBy)
+	out.ProjectName = in.ProjectName
+	out.ClusterName = in.ClusterName
+	out.ChargeBy = monitor.UsageReportChargeBy(in.ChargeBy)
+	out.Prices = *(*map[string]string)(unsafe.Pointer(&in.Prices))
+	out.Format = monitor.UsageReportFormat(in.Format)
+	return nil
+}
+
+// Convert_v1_UsageReportQuery_To_monitor_UsageReportQuery is an autogenerated conversion function.
+func Convert_v1_UsageReportQuery_To_monitor_UsageReportQuery(in *UsageReportQuery, out *monitor.UsageReportQuery, s conversion.Scope) error {
+	return autoConvert_v1_UsageReportQuery_To_monitor_UsageReportQuery(in, out, s)
+}
+
+func autoConvert_monitor_UsageReportQuery_To_v1_UsageReportQuery(in *monitor.UsageReportQuery, out *UsageReportQuery, s conversion.Scope) error {
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.GroupBy = UsageReportGroupBy(in.GroupBy)
+	out.ProjectName = in.ProjectName
+	out.ClusterName = in.ClusterName
+	out.ChargeBy = UsageReportChargeBy(in.ChargeBy)
+	out.Prices = *(*map[string]string)(unsafe.Pointer(&in.Prices))
+	out.Format = UsageReportFormat(in.Format)
+	return nil
+}
+
+// Convert_monitor_UsageReportQuery_To_v1_UsageReportQuery is an autogenerated conversion function.
+func Convert_monitor_UsageReportQuery_To_v1_UsageReportQuery(in *monitor.UsageReportQuery, out *UsageReportQuery, s conversion.Scope) error {
+	return autoConvert_monitor_UsageReportQuery_To_v1_UsageReportQuery(in, out, s)
+}
+
+func autoConvert_v1_UsageReportResource_To_monitor_UsageReportResource(in *UsageReportResource, out *monitor.UsageReportResource, s conversion.Scope) error {
+	out.Resource = in.Resource
+	out.AllocatedHours = in.AllocatedHours
+	out.UsedHours = in.UsedHours
+	out.Price = in.Price
+	out.Cost = in.Cost
+	return nil
+}
+
+// Convert_v1_UsageReportResource_To_monitor_UsageReportResource is an autogenerated conversion function.
+func Convert_v1_UsageReportResource_To_monitor_UsageReportResource(in *UsageReportResource, out *monitor.UsageReportResource, s conversion.Scope) error {
+	return autoConvert_v1_UsageReportResource_To_monitor_UsageReportResource(in, out, s)
+}
+
+func autoConvert_monitor_UsageReportResource_To_v1_UsageReportResource(in *monitor.UsageReportResource, out *UsageReportResource, s conversion.Scope) error {
+	out.Resource = in.Resource
+	out.AllocatedHours = in.AllocatedHours
+	out.UsedHours = in.UsedHours
+	out.Price = in.Price
+	out.Cost = in.Cost
+	return nil
+}
+
+// Convert_monitor_UsageReportResource_To_v1_UsageReportResource is an autogenerated conversion function.
+func Convert_monitor_UsageReportResource_To_v1_UsageReportResource(in *monitor.UsageReportResource, out *UsageReportResource, s conversion.Scope) error {
+	return autoConvert_monitor_UsageReportResource_To_v1_UsageReportResource(in, out, s)
+}
+
+func autoConvert_v1_UsageReportResult_To_monitor_UsageReportResult(in *UsageReportResult, out *monitor.UsageReportResult, s conversion.Scope) error {
+	out.Items = *(*[]monitor.UsageReportItem)(unsafe.Pointer(&in.Items))
+	out.TotalCost = in.TotalCost
+	out.CSV = in.CSV
+	return nil
+}
+
+// Convert_v1_UsageReportResult_To_monitor_UsageReportResult is an autogenerated conversion function.
+func Convert_v1_UsageReportResult_To_monitor_UsageReportResult(in *UsageReportResult, out *monitor.UsageReportResult, s conversion.Scope) error {
+	return autoConvert_v1_UsageReportResult_To_monitor_UsageReportResult(in, out, s)
+}
+
+func autoConvert_monitor_UsageReportResult_To_v1_UsageReportResult(in *monitor.UsageReportResult, out *UsageReportResult, s conversion.Scope) error {
+	out.Items = *(*[]UsageReportItem)(unsafe.Pointer(&in.Items))
+	out.TotalCost = in.TotalCost
+	out.CSV = in.CSV
+	return nil
+}
+
+// Convert_monitor_UsageReportResult_To_v1_UsageReportResult is an autogenerated conversion function.
+func Convert_monitor_UsageReportResult_To_v1_UsageReportResult(in *monitor.UsageReportResult, out *UsageReportResult, s conversion.Scope) error {
+	return autoConvert_monitor_UsageReportResult_To_v1_UsageReportResult(in, out, s)
+}
@@ -34,6 +34,8 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&ConfigMapList{}, func(obj interface{}) { SetObjectDefaults_ConfigMapList(obj.(*ConfigMapList)) })
 	scheme.AddTypeDefaultingFunc(&Prometheus{}, func(obj interface{}) { SetObjectDefaults_Prometheus(obj.(*Prometheus)) })
 	scheme.AddTypeDefaultingFunc(&PrometheusList{}, func(obj interface{}) { SetObjectDefaults_PrometheusList(obj.(*PrometheusList)) })
+	scheme.AddTypeDefaultingFunc(&UsageReport{}, func(obj interface{}) { SetObjectDefaults_UsageReport(obj.(*UsageReport)) })
+	scheme.AddTypeDefaultingFunc(&UsageReportList{}, func(obj interface{}) { SetObjectDefaults_UsageReportList(obj.(*UsageReportList)) })
 	return nil
 }
 
@@ -58,3 +60,14 @@ func SetObjectDefaults_PrometheusList(in *PrometheusList) {
 		SetObjectDefaults_Prometheus(a)
 	}
 }
+
+func SetObjectDefaults_UsageReport(in *UsageReport) {
+	SetDefaults_UsageReportQuery(&in.Query)
+}
+
+func SetObjectDefaults_UsageReportList(in *UsageReportList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_UsageReport(a)
+	}
+}
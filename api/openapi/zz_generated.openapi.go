@@ -839,12 +839,31 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/auth/v1.APIKeyList":                                      schema_tke_api_auth_v1_APIKeyList(ref),
 		"tkestack.io/tke/api/auth/v1.APIKeyReq":                                       schema_tke_api_auth_v1_APIKeyReq(ref),
 		"tkestack.io/tke/api/auth/v1.APIKeyReqPassword":                               schema_tke_api_auth_v1_APIKeyReqPassword(ref),
+		"tkestack.io/tke/api/auth/v1.APIKeyRotateReq":                                 schema_tke_api_auth_v1_APIKeyRotateReq(ref),
+		"tkestack.io/tke/api/auth/v1.APIKeyScope":                                     schema_tke_api_auth_v1_APIKeyScope(ref),
 		"tkestack.io/tke/api/auth/v1.APIKeySpec":                                      schema_tke_api_auth_v1_APIKeySpec(ref),
 		"tkestack.io/tke/api/auth/v1.APIKeyStatus":                                    schema_tke_api_auth_v1_APIKeyStatus(ref),
 		"tkestack.io/tke/api/auth/v1.APISigningKey":                                   schema_tke_api_auth_v1_APISigningKey(ref),
 		"tkestack.io/tke/api/auth/v1.APISigningKeyList":                               schema_tke_api_auth_v1_APISigningKeyList(ref),
+		"tkestack.io/tke/api/auth/v1.AccessRequest":                                   schema_tke_api_auth_v1_AccessRequest(ref),
+		"tkestack.io/tke/api/auth/v1.AccessRequestBinding":                            schema_tke_api_auth_v1_AccessRequestBinding(ref),
+		"tkestack.io/tke/api/auth/v1.AccessRequestList":                               schema_tke_api_auth_v1_AccessRequestList(ref),
+		"tkestack.io/tke/api/auth/v1.AccessRequestReview":                             schema_tke_api_auth_v1_AccessRequestReview(ref),
+		"tkestack.io/tke/api/auth/v1.AccessRequestSpec":                               schema_tke_api_auth_v1_AccessRequestSpec(ref),
+		"tkestack.io/tke/api/auth/v1.AccessRequestStatus":                             schema_tke_api_auth_v1_AccessRequestStatus(ref),
+		"tkestack.io/tke/api/auth/v1.AccessReview":                                    schema_tke_api_auth_v1_AccessReview(ref),
+		"tkestack.io/tke/api/auth/v1.AccessReviewDiff":                                schema_tke_api_auth_v1_AccessReviewDiff(ref),
+		"tkestack.io/tke/api/auth/v1.AccessReviewDiffOptions":                         schema_tke_api_auth_v1_AccessReviewDiffOptions(ref),
+		"tkestack.io/tke/api/auth/v1.AccessReviewEntry":                               schema_tke_api_auth_v1_AccessReviewEntry(ref),
+		"tkestack.io/tke/api/auth/v1.AccessReviewExportOptions":                       schema_tke_api_auth_v1_AccessReviewExportOptions(ref),
+		"tkestack.io/tke/api/auth/v1.AccessReviewList":                                schema_tke_api_auth_v1_AccessReviewList(ref),
+		"tkestack.io/tke/api/auth/v1.AccessReviewPermission":                          schema_tke_api_auth_v1_AccessReviewPermission(ref),
+		"tkestack.io/tke/api/auth/v1.AccessReviewSpec":                                schema_tke_api_auth_v1_AccessReviewSpec(ref),
+		"tkestack.io/tke/api/auth/v1.AccessReviewStatus":                              schema_tke_api_auth_v1_AccessReviewStatus(ref),
+		"tkestack.io/tke/api/auth/v1.AccessReviewSubject":                             schema_tke_api_auth_v1_AccessReviewSubject(ref),
 		"tkestack.io/tke/api/auth/v1.Action":                                          schema_tke_api_auth_v1_Action(ref),
 		"tkestack.io/tke/api/auth/v1.AllowedStatus":                                   schema_tke_api_auth_v1_AllowedStatus(ref),
+		"tkestack.io/tke/api/auth/v1.AuthorizationExplanation":                        schema_tke_api_auth_v1_AuthorizationExplanation(ref),
 		"tkestack.io/tke/api/auth/v1.Binding":                                         schema_tke_api_auth_v1_Binding(ref),
 		"tkestack.io/tke/api/auth/v1.Category":                                        schema_tke_api_auth_v1_Category(ref),
 		"tkestack.io/tke/api/auth/v1.CategoryList":                                    schema_tke_api_auth_v1_CategoryList(ref),
@@ -873,6 +892,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/auth/v1.LocalIdentityList":                               schema_tke_api_auth_v1_LocalIdentityList(ref),
 		"tkestack.io/tke/api/auth/v1.LocalIdentitySpec":                               schema_tke_api_auth_v1_LocalIdentitySpec(ref),
 		"tkestack.io/tke/api/auth/v1.LocalIdentityStatus":                             schema_tke_api_auth_v1_LocalIdentityStatus(ref),
+		"tkestack.io/tke/api/auth/v1.MatchedRule":                                     schema_tke_api_auth_v1_MatchedRule(ref),
 		"tkestack.io/tke/api/auth/v1.NonResourceAttributes":                           schema_tke_api_auth_v1_NonResourceAttributes(ref),
 		"tkestack.io/tke/api/auth/v1.PasswordReq":                                     schema_tke_api_auth_v1_PasswordReq(ref),
 		"tkestack.io/tke/api/auth/v1.Policy":                                          schema_tke_api_auth_v1_Policy(ref),
@@ -896,20 +916,38 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/auth/v1.Rule":                                            schema_tke_api_auth_v1_Rule(ref),
 		"tkestack.io/tke/api/auth/v1.RuleList":                                        schema_tke_api_auth_v1_RuleList(ref),
 		"tkestack.io/tke/api/auth/v1.RuleSpec":                                        schema_tke_api_auth_v1_RuleSpec(ref),
+		"tkestack.io/tke/api/auth/v1.Session":                                         schema_tke_api_auth_v1_Session(ref),
+		"tkestack.io/tke/api/auth/v1.SessionList":                                     schema_tke_api_auth_v1_SessionList(ref),
+		"tkestack.io/tke/api/auth/v1.SessionRevocation":                               schema_tke_api_auth_v1_SessionRevocation(ref),
+		"tkestack.io/tke/api/auth/v1.SessionRevocationList":                           schema_tke_api_auth_v1_SessionRevocationList(ref),
+		"tkestack.io/tke/api/auth/v1.SessionRevocationSpec":                           schema_tke_api_auth_v1_SessionRevocationSpec(ref),
+		"tkestack.io/tke/api/auth/v1.SessionSpec":                                     schema_tke_api_auth_v1_SessionSpec(ref),
+		"tkestack.io/tke/api/auth/v1.SessionStatus":                                   schema_tke_api_auth_v1_SessionStatus(ref),
 		"tkestack.io/tke/api/auth/v1.Statement":                                       schema_tke_api_auth_v1_Statement(ref),
 		"tkestack.io/tke/api/auth/v1.Subject":                                         schema_tke_api_auth_v1_Subject(ref),
 		"tkestack.io/tke/api/auth/v1.SubjectAccessReview":                             schema_tke_api_auth_v1_SubjectAccessReview(ref),
 		"tkestack.io/tke/api/auth/v1.SubjectAccessReviewSpec":                         schema_tke_api_auth_v1_SubjectAccessReviewSpec(ref),
 		"tkestack.io/tke/api/auth/v1.SubjectAccessReviewStatus":                       schema_tke_api_auth_v1_SubjectAccessReviewStatus(ref),
+		"tkestack.io/tke/api/auth/v1.Tenant":                                          schema_tke_api_auth_v1_Tenant(ref),
+		"tkestack.io/tke/api/auth/v1.TenantDeletionStatus":                            schema_tke_api_auth_v1_TenantDeletionStatus(ref),
+		"tkestack.io/tke/api/auth/v1.TenantList":                                      schema_tke_api_auth_v1_TenantList(ref),
+		"tkestack.io/tke/api/auth/v1.TenantQuota":                                     schema_tke_api_auth_v1_TenantQuota(ref),
+		"tkestack.io/tke/api/auth/v1.TenantSpec":                                      schema_tke_api_auth_v1_TenantSpec(ref),
+		"tkestack.io/tke/api/auth/v1.TenantStatus":                                    schema_tke_api_auth_v1_TenantStatus(ref),
+		"tkestack.io/tke/api/auth/v1.TenantUsage":                                     schema_tke_api_auth_v1_TenantUsage(ref),
 		"tkestack.io/tke/api/auth/v1.User":                                            schema_tke_api_auth_v1_User(ref),
 		"tkestack.io/tke/api/auth/v1.UserList":                                        schema_tke_api_auth_v1_UserList(ref),
 		"tkestack.io/tke/api/auth/v1.UserSpec":                                        schema_tke_api_auth_v1_UserSpec(ref),
+		"tkestack.io/tke/api/auth/v1.VerificationKey":                                 schema_tke_api_auth_v1_VerificationKey(ref),
+		"tkestack.io/tke/api/business/v1.CertificateAlert":                            schema_tke_api_business_v1_CertificateAlert(ref),
 		"tkestack.io/tke/api/business/v1.ChartGroup":                                  schema_tke_api_business_v1_ChartGroup(ref),
 		"tkestack.io/tke/api/business/v1.ChartGroupList":                              schema_tke_api_business_v1_ChartGroupList(ref),
 		"tkestack.io/tke/api/business/v1.ChartGroupSpec":                              schema_tke_api_business_v1_ChartGroupSpec(ref),
 		"tkestack.io/tke/api/business/v1.ChartGroupStatus":                            schema_tke_api_business_v1_ChartGroupStatus(ref),
 		"tkestack.io/tke/api/business/v1.ConfigMap":                                   schema_tke_api_business_v1_ConfigMap(ref),
 		"tkestack.io/tke/api/business/v1.ConfigMapList":                               schema_tke_api_business_v1_ConfigMapList(ref),
+		"tkestack.io/tke/api/business/v1.FrozenNamespace":                             schema_tke_api_business_v1_FrozenNamespace(ref),
+		"tkestack.io/tke/api/business/v1.FrozenWorkload":                              schema_tke_api_business_v1_FrozenWorkload(ref),
 		"tkestack.io/tke/api/business/v1.HardQuantity":                                schema_tke_api_business_v1_HardQuantity(ref),
 		"tkestack.io/tke/api/business/v1.ImageNamespace":                              schema_tke_api_business_v1_ImageNamespace(ref),
 		"tkestack.io/tke/api/business/v1.ImageNamespaceList":                          schema_tke_api_business_v1_ImageNamespaceList(ref),
@@ -918,9 +956,19 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/business/v1.Namespace":                                   schema_tke_api_business_v1_Namespace(ref),
 		"tkestack.io/tke/api/business/v1.NamespaceCert":                               schema_tke_api_business_v1_NamespaceCert(ref),
 		"tkestack.io/tke/api/business/v1.NamespaceCertOptions":                        schema_tke_api_business_v1_NamespaceCertOptions(ref),
+		"tkestack.io/tke/api/business/v1.NamespaceCertStatus":                         schema_tke_api_business_v1_NamespaceCertStatus(ref),
 		"tkestack.io/tke/api/business/v1.NamespaceList":                               schema_tke_api_business_v1_NamespaceList(ref),
+		"tkestack.io/tke/api/business/v1.NamespaceQuotaNode":                          schema_tke_api_business_v1_NamespaceQuotaNode(ref),
 		"tkestack.io/tke/api/business/v1.NamespaceSpec":                               schema_tke_api_business_v1_NamespaceSpec(ref),
 		"tkestack.io/tke/api/business/v1.NamespaceStatus":                             schema_tke_api_business_v1_NamespaceStatus(ref),
+		"tkestack.io/tke/api/business/v1.NamespaceTemplate":                           schema_tke_api_business_v1_NamespaceTemplate(ref),
+		"tkestack.io/tke/api/business/v1.NamespaceTemplateList":                       schema_tke_api_business_v1_NamespaceTemplateList(ref),
+		"tkestack.io/tke/api/business/v1.NamespaceTemplateNetworkPolicy":              schema_tke_api_business_v1_NamespaceTemplateNetworkPolicy(ref),
+		"tkestack.io/tke/api/business/v1.NamespaceTemplatePodSecurity":                schema_tke_api_business_v1_NamespaceTemplatePodSecurity(ref),
+		"tkestack.io/tke/api/business/v1.NamespaceTemplateRoleBinding":                schema_tke_api_business_v1_NamespaceTemplateRoleBinding(ref),
+		"tkestack.io/tke/api/business/v1.NamespaceTemplateSpec":                       schema_tke_api_business_v1_NamespaceTemplateSpec(ref),
+		"tkestack.io/tke/api/business/v1.NamespaceTemplateSubject":                    schema_tke_api_business_v1_NamespaceTemplateSubject(ref),
+		"tkestack.io/tke/api/business/v1.NamespaceTemplateSync":                       schema_tke_api_business_v1_NamespaceTemplateSync(ref),
 		"tkestack.io/tke/api/business/v1.NsEmigration":                                schema_tke_api_business_v1_NsEmigration(ref),
 		"tkestack.io/tke/api/business/v1.NsEmigrationList":                            schema_tke_api_business_v1_NsEmigrationList(ref),
 		"tkestack.io/tke/api/business/v1.NsEmigrationSpec":                            schema_tke_api_business_v1_NsEmigrationSpec(ref),
@@ -931,9 +979,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/business/v1.Portal":                                      schema_tke_api_business_v1_Portal(ref),
 		"tkestack.io/tke/api/business/v1.PortalProject":                               schema_tke_api_business_v1_PortalProject(ref),
 		"tkestack.io/tke/api/business/v1.Project":                                     schema_tke_api_business_v1_Project(ref),
+		"tkestack.io/tke/api/business/v1.ProjectFreezeStatus":                         schema_tke_api_business_v1_ProjectFreezeStatus(ref),
 		"tkestack.io/tke/api/business/v1.ProjectList":                                 schema_tke_api_business_v1_ProjectList(ref),
+		"tkestack.io/tke/api/business/v1.ProjectQuotaNode":                            schema_tke_api_business_v1_ProjectQuotaNode(ref),
+		"tkestack.io/tke/api/business/v1.ProjectQuotaTree":                            schema_tke_api_business_v1_ProjectQuotaTree(ref),
+		"tkestack.io/tke/api/business/v1.ProjectRequest":                              schema_tke_api_business_v1_ProjectRequest(ref),
+		"tkestack.io/tke/api/business/v1.ProjectRequestList":                          schema_tke_api_business_v1_ProjectRequestList(ref),
+		"tkestack.io/tke/api/business/v1.ProjectRequestSpec":                          schema_tke_api_business_v1_ProjectRequestSpec(ref),
+		"tkestack.io/tke/api/business/v1.ProjectRequestStatus":                        schema_tke_api_business_v1_ProjectRequestStatus(ref),
 		"tkestack.io/tke/api/business/v1.ProjectSpec":                                 schema_tke_api_business_v1_ProjectSpec(ref),
 		"tkestack.io/tke/api/business/v1.ProjectStatus":                               schema_tke_api_business_v1_ProjectStatus(ref),
+		"tkestack.io/tke/api/business/v1.QuotaAlert":                                  schema_tke_api_business_v1_QuotaAlert(ref),
+		"tkestack.io/tke/api/business/v1.QuotaAlertRule":                              schema_tke_api_business_v1_QuotaAlertRule(ref),
+		"tkestack.io/tke/api/business/v1.QuotaAlertState":                             schema_tke_api_business_v1_QuotaAlertState(ref),
+		"tkestack.io/tke/api/business/v1.QuotaBorrowing":                              schema_tke_api_business_v1_QuotaBorrowing(ref),
 		"tkestack.io/tke/api/business/v1.UsedQuantity":                                schema_tke_api_business_v1_UsedQuantity(ref),
 		"tkestack.io/tke/api/logagent/v1.ConfigMap":                                   schema_tke_api_logagent_v1_ConfigMap(ref),
 		"tkestack.io/tke/api/logagent/v1.ConfigMapList":                               schema_tke_api_logagent_v1_ConfigMapList(ref),
@@ -959,18 +1018,29 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/monitor/v1.ClusterOverview":                              schema_tke_api_monitor_v1_ClusterOverview(ref),
 		"tkestack.io/tke/api/monitor/v1.ClusterOverviewResult":                        schema_tke_api_monitor_v1_ClusterOverviewResult(ref),
 		"tkestack.io/tke/api/monitor/v1.ClusterStatistic":                             schema_tke_api_monitor_v1_ClusterStatistic(ref),
+		"tkestack.io/tke/api/monitor/v1.ClusterUsage":                                 schema_tke_api_monitor_v1_ClusterUsage(ref),
 		"tkestack.io/tke/api/monitor/v1.ConfigMap":                                    schema_tke_api_monitor_v1_ConfigMap(ref),
 		"tkestack.io/tke/api/monitor/v1.ConfigMapList":                                schema_tke_api_monitor_v1_ConfigMapList(ref),
 		"tkestack.io/tke/api/monitor/v1.Metric":                                       schema_tke_api_monitor_v1_Metric(ref),
 		"tkestack.io/tke/api/monitor/v1.MetricList":                                   schema_tke_api_monitor_v1_MetricList(ref),
 		"tkestack.io/tke/api/monitor/v1.MetricQuery":                                  schema_tke_api_monitor_v1_MetricQuery(ref),
 		"tkestack.io/tke/api/monitor/v1.MetricQueryCondition":                         schema_tke_api_monitor_v1_MetricQueryCondition(ref),
+		"tkestack.io/tke/api/monitor/v1.NamespaceUsage":                               schema_tke_api_monitor_v1_NamespaceUsage(ref),
+		"tkestack.io/tke/api/monitor/v1.ProjectUsage":                                 schema_tke_api_monitor_v1_ProjectUsage(ref),
+		"tkestack.io/tke/api/monitor/v1.ProjectUsageList":                             schema_tke_api_monitor_v1_ProjectUsageList(ref),
+		"tkestack.io/tke/api/monitor/v1.ProjectUsageSpec":                             schema_tke_api_monitor_v1_ProjectUsageSpec(ref),
 		"tkestack.io/tke/api/monitor/v1.Prometheus":                                   schema_tke_api_monitor_v1_Prometheus(ref),
 		"tkestack.io/tke/api/monitor/v1.PrometheusList":                               schema_tke_api_monitor_v1_PrometheusList(ref),
 		"tkestack.io/tke/api/monitor/v1.PrometheusRemoteAddr":                         schema_tke_api_monitor_v1_PrometheusRemoteAddr(ref),
 		"tkestack.io/tke/api/monitor/v1.PrometheusSpec":                               schema_tke_api_monitor_v1_PrometheusSpec(ref),
 		"tkestack.io/tke/api/monitor/v1.PrometheusStatus":                             schema_tke_api_monitor_v1_PrometheusStatus(ref),
 		"tkestack.io/tke/api/monitor/v1.ResourceRequirements":                         schema_tke_api_monitor_v1_ResourceRequirements(ref),
+		"tkestack.io/tke/api/monitor/v1.UsageReport":                                  schema_tke_api_monitor_v1_UsageReport(ref),
+		"tkestack.io/tke/api/monitor/v1.UsageReportItem":                              schema_tke_api_monitor_v1_UsageReportItem(ref),
+		"tkestack.io/tke/api/monitor/v1.UsageReportList":                              schema_tke_api_monitor_v1_UsageReportList(ref),
+		"tkestack.io/tke/api/monitor/v1.UsageReportQuery":                             schema_tke_api_monitor_v1_UsageReportQuery(ref),
+		"tkestack.io/tke/api/monitor/v1.UsageReportResource":                          schema_tke_api_monitor_v1_UsageReportResource(ref),
+		"tkestack.io/tke/api/monitor/v1.UsageReportResult":                            schema_tke_api_monitor_v1_UsageReportResult(ref),
 		"tkestack.io/tke/api/notify/v1.Channel":                                       schema_tke_api_notify_v1_Channel(ref),
 		"tkestack.io/tke/api/notify/v1.ChannelList":                                   schema_tke_api_notify_v1_ChannelList(ref),
 		"tkestack.io/tke/api/notify/v1.ChannelSMTP":                                   schema_tke_api_notify_v1_ChannelSMTP(ref),
@@ -1095,13 +1165,36 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/registry/v1.ConfigMapList":                               schema_tke_api_registry_v1_ConfigMapList(ref),
 		"tkestack.io/tke/api/registry/v1.Namespace":                                   schema_tke_api_registry_v1_Namespace(ref),
 		"tkestack.io/tke/api/registry/v1.NamespaceList":                               schema_tke_api_registry_v1_NamespaceList(ref),
+		"tkestack.io/tke/api/registry/v1.NamespaceProxy":                              schema_tke_api_registry_v1_NamespaceProxy(ref),
 		"tkestack.io/tke/api/registry/v1.NamespaceSpec":                               schema_tke_api_registry_v1_NamespaceSpec(ref),
 		"tkestack.io/tke/api/registry/v1.NamespaceStatus":                             schema_tke_api_registry_v1_NamespaceStatus(ref),
+		"tkestack.io/tke/api/registry/v1.ReplicationArtifact":                         schema_tke_api_registry_v1_ReplicationArtifact(ref),
+		"tkestack.io/tke/api/registry/v1.ReplicationArtifactStatus":                   schema_tke_api_registry_v1_ReplicationArtifactStatus(ref),
+		"tkestack.io/tke/api/registry/v1.ReplicationEndpoint":                         schema_tke_api_registry_v1_ReplicationEndpoint(ref),
+		"tkestack.io/tke/api/registry/v1.ReplicationPolicy":                           schema_tke_api_registry_v1_ReplicationPolicy(ref),
+		"tkestack.io/tke/api/registry/v1.ReplicationPolicyList":                       schema_tke_api_registry_v1_ReplicationPolicyList(ref),
+		"tkestack.io/tke/api/registry/v1.ReplicationPolicySpec":                       schema_tke_api_registry_v1_ReplicationPolicySpec(ref),
+		"tkestack.io/tke/api/registry/v1.ReplicationPolicyStatus":                     schema_tke_api_registry_v1_ReplicationPolicyStatus(ref),
+		"tkestack.io/tke/api/registry/v1.ReplicationRun":                              schema_tke_api_registry_v1_ReplicationRun(ref),
+		"tkestack.io/tke/api/registry/v1.ReplicationTrigger":                          schema_tke_api_registry_v1_ReplicationTrigger(ref),
 		"tkestack.io/tke/api/registry/v1.Repository":                                  schema_tke_api_registry_v1_Repository(ref),
 		"tkestack.io/tke/api/registry/v1.RepositoryList":                              schema_tke_api_registry_v1_RepositoryList(ref),
+		"tkestack.io/tke/api/registry/v1.RepositoryScanOptions":                       schema_tke_api_registry_v1_RepositoryScanOptions(ref),
 		"tkestack.io/tke/api/registry/v1.RepositorySpec":                              schema_tke_api_registry_v1_RepositorySpec(ref),
 		"tkestack.io/tke/api/registry/v1.RepositoryStatus":                            schema_tke_api_registry_v1_RepositoryStatus(ref),
 		"tkestack.io/tke/api/registry/v1.RepositoryTag":                               schema_tke_api_registry_v1_RepositoryTag(ref),
+		"tkestack.io/tke/api/registry/v1.RetentionPolicy":                             schema_tke_api_registry_v1_RetentionPolicy(ref),
+		"tkestack.io/tke/api/registry/v1.RetentionRule":                               schema_tke_api_registry_v1_RetentionRule(ref),
+		"tkestack.io/tke/api/registry/v1.RetentionStatus":                             schema_tke_api_registry_v1_RetentionStatus(ref),
+		"tkestack.io/tke/api/registry/v1.RetentionTag":                                schema_tke_api_registry_v1_RetentionTag(ref),
+		"tkestack.io/tke/api/registry/v1.SignaturePolicy":                             schema_tke_api_registry_v1_SignaturePolicy(ref),
+		"tkestack.io/tke/api/registry/v1.TagScan":                                     schema_tke_api_registry_v1_TagScan(ref),
+		"tkestack.io/tke/api/registry/v1.TagSignature":                                schema_tke_api_registry_v1_TagSignature(ref),
+		"tkestack.io/tke/api/registry/v1.TrustedKey":                                  schema_tke_api_registry_v1_TrustedKey(ref),
+		"tkestack.io/tke/api/registry/v1.TrustedKeyList":                              schema_tke_api_registry_v1_TrustedKeyList(ref),
+		"tkestack.io/tke/api/registry/v1.TrustedKeySpec":                              schema_tke_api_registry_v1_TrustedKeySpec(ref),
+		"tkestack.io/tke/api/registry/v1.Vulnerability":                               schema_tke_api_registry_v1_Vulnerability(ref),
+		"tkestack.io/tke/api/registry/v1.VulnerabilityPolicy":                         schema_tke_api_registry_v1_VulnerabilityPolicy(ref),
 	}
 }
 
@@ -41991,12 +42084,18 @@ func schema_tke_api_auth_v1_APIKeyReq(ref common.ReferenceCallback) common.OpenA
 							Format:      "",
 						},
 					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scope restricts the api key to a subset of the permissions of the user.",
+							Ref:         ref("tkestack.io/tke/api/auth/v1.APIKeyScope"),
+						},
+					},
 				},
 				Required: []string{"description"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "tkestack.io/tke/api/auth/v1.APIKeyScope"},
 	}
 }
 
@@ -42057,6 +42156,55 @@ func schema_tke_api_auth_v1_APIKeyReqPassword(ref common.ReferenceCallback) comm
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scope restricts the api key to a subset of the permissions of the user.",
+							Ref:         ref("tkestack.io/tke/api/auth/v1.APIKeyScope"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "tkestack.io/tke/api/auth/v1.APIKeyScope"},
+	}
+}
+
+func schema_tke_api_auth_v1_APIKeyRotateReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIKeyRotateReq contains the parameters used to rotate an api key.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expire": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expire holds the duration of the new api key become invalid. By default, the same as the rotated one.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"overlap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Overlap holds the duration the rotated api key stays valid. By default, 24h.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 			},
 		},
@@ -42065,6 +42213,65 @@ func schema_tke_api_auth_v1_APIKeyReqPassword(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_tke_api_auth_v1_APIKeyScope(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIKeyScope restricts what an api key is allowed to do on behalf of its user. The effective permissions are the intersection of the scope and the permissions of the user.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"statements": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Statements is the subset of actions and resources granted to the api key.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Statement"),
+									},
+								},
+							},
+						},
+					},
+					"projects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Projects limits the api key to the given projects.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"sourceCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceCIDRs limits the source addresses the api key can be used from.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/auth/v1.Statement"},
+	}
+}
+
 func schema_tke_api_auth_v1_APIKeySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -42114,11 +42321,17 @@ func schema_tke_api_auth_v1_APIKeySpec(ref common.ReferenceCallback) common.Open
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scope restricts the api key to a subset of the permissions of the user.",
+							Ref:         ref("tkestack.io/tke/api/auth/v1.APIKeyScope"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/auth/v1.APIKeyScope"},
 	}
 }
 
@@ -42145,10 +42358,47 @@ func schema_tke_api_auth_v1_APIKeyStatus(ref common.ReferenceCallback) common.Op
 							Format:      "",
 						},
 					},
+					"lastUsedTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastUsedTime is the last time the api key was used to authenticate.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastSourceIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastSourceIP is the source address of the last request using the api key.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requestCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequestCount is the number of requests authenticated by the api key.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"rotatedTo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RotatedTo is the name of the api key replacing this one.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retireTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetireTime is the time after which a rotated api key is no longer accepted.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 				Required: []string{"expired"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -42191,11 +42441,39 @@ func schema_tke_api_auth_v1_APISigningKey(ref common.ReferenceCallback) common.O
 							Format: "byte",
 						},
 					},
+					"keyID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyID is the ID of the signing key, set as the kid header of the api keys signed with it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rotateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RotateTime is the time the signing key is generated.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"verificationKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VerificationKeys are the public keys of the retired signing keys, kept until every api key signed with them has expired.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.VerificationKey"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/auth/v1.VerificationKey"},
 	}
 }
 
@@ -42249,100 +42527,101 @@ func schema_tke_api_auth_v1_APISigningKeyList(ref common.ReferenceCallback) comm
 	}
 }
 
-func schema_tke_api_auth_v1_Action(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AccessRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Action defines a action verb for authorization.",
+				Description: "AccessRequest represents a request of a user for temporary permissions, which are granted once approved and revoked automatically at expiry.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name represents user access review request verb.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"description": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description describes the action.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/auth/v1.AccessRequestSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/auth/v1.AccessRequestStatus"),
+						},
+					},
 				},
-				Required: []string{"name", "description"},
+				Required: []string{"spec"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.AccessRequestSpec", "tkestack.io/tke/api/auth/v1.AccessRequestStatus"},
 	}
 }
 
-func schema_tke_api_auth_v1_AllowedStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AccessRequestBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AllowedStatus includes the resource access request and response.",
+				Description: "AccessRequestBinding references a binding granting the requested permissions.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"resource": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resource is the resource of request",
+							Description: "Kind is ProjectPolicyBinding or CustomPolicyBinding.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"web": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Verb is the verb of request",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"allowed": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allowed is required. True if the action would be allowed, false otherwise.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"denied": {
+					"created": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Denied is optional. True if the action would be denied, otherwise false. If both allowed is false and denied is false, then the authorizer has no opinion on whether to authorize the action. Denied may not be true if Allowed is true.",
+							Description: "Created is true if the binding was created for the request rather than extended.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"reason": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Reason is optional.  It indicates why a request was allowed or denied.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"evaluationError": {
-						SchemaProps: spec.SchemaProps{
-							Description: "EvaluationError is an indication that some error occurred during the authorization check. It is entirely possible to get an error and be able to continue determine authorization status in spite of it. For instance, RBAC can be missing a role, but enough roles are still present and bound to reason about the request.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
-				Required: []string{"resource", "web", "allowed"},
+				Required: []string{"kind", "name"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_auth_v1_Binding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AccessRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Binding is used to bind or unbind the subjects to or from the policy,role or group.",
+				Description: "AccessRequestList is the whole list of all access requests.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -42359,47 +42638,40 @@ func schema_tke_api_auth_v1_Binding(ref common.ReferenceCallback) common.OpenAPI
 							Format:      "",
 						},
 					},
-					"users": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Users holds references to the objects the policy applies to.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"groups": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Groups holds references to the groups the policy applies to.",
+							Description: "List of access requests.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.AccessRequest"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/auth/v1.Subject"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.AccessRequest"},
 	}
 }
 
-func schema_tke_api_auth_v1_Category(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AccessRequestReview(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Category defines a category of actions for policy.",
+				Description: "AccessRequestReview contains the comment used to approve or reject an access request.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -42416,127 +42688,191 @@ func schema_tke_api_auth_v1_Category(ref common.ReferenceCallback) common.OpenAP
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"Spec": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/auth/v1.CategorySpec"),
+							Description: "Message is the comment of the reviewer.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"Spec"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.CategorySpec"},
 	}
 }
 
-func schema_tke_api_auth_v1_CategoryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AccessRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CategoryList is the whole list of policy Category.",
+				Description: "AccessRequestSpec describes the permissions requested.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"apiVersion": {
+					"username": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Username is the user the permissions are granted to, set to the requester.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"projectID": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "ProjectID is the project the permissions are requested in, the permissions are platform wide if empty.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"policies": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of category.",
+							Description: "Policies are the ids of the policies requested.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Category"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
+					"roleID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoleID is the id of a role whose policies are requested.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long the permissions are granted after approval.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason explains why the permissions are needed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"items"},
+				Required: []string{"tenantID", "username", "duration", "reason"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Category"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_tke_api_auth_v1_CategorySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AccessRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CategorySpec is a description of category.",
+				Description: "AccessRequestStatus represents information about the status of an access request.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"displayName": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DisplayName used to display category name",
-							Default:     "",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"approvers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approvers are the users allowed to approve or reject the request.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"reviewer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reviewer is the user who approved or rejected the request.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"description": {
+					"reviewMessage": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "ReviewMessage is the comment of the reviewer.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"actions": {
+					"reviewTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Actions represents a series of actions work on the policy category",
+							Description: "ReviewTime is the time the request was approved or rejected.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"expireTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpireTime is the time the granted permissions are revoked.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"notifyTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NotifyTime is the time the approvers were alerted.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"bindings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bindings are the bindings the user was added to for the request.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Action"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.AccessRequestBinding"),
 									},
 								},
 							},
 						},
 					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human readable message indicating details about the last failure of granting or revoking the permissions.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"displayName", "actions"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/auth/v1.Action"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/auth/v1.AccessRequestBinding"},
 	}
 }
 
-func schema_tke_api_auth_v1_Client(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AccessReview(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Client represents an OAuth2 client.",
+				Description: "AccessReview is a snapshot of the effective permissions of every user and group in a tenant or a project, taken when it is created.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -42561,24 +42897,30 @@ func schema_tke_api_auth_v1_Client(ref common.ReferenceCallback) common.OpenAPID
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of identity provider in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/auth/v1.ClientSpec"),
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/auth/v1.AccessReviewSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/auth/v1.AccessReviewStatus"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.ClientSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.AccessReviewSpec", "tkestack.io/tke/api/auth/v1.AccessReviewStatus"},
 	}
 }
 
-func schema_tke_api_auth_v1_ClientList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AccessReviewDiff(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClientList is the whole list of OAuth2 client.",
+				Description: "AccessReviewDiff lists the permissions granted or revoked between two access reviews.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -42595,100 +42937,155 @@ func schema_tke_api_auth_v1_ClientList(ref common.ReferenceCallback) common.Open
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"base": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"items": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of identity providers.",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"added": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Added are the permissions in the target but not in the base.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Client"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.AccessReviewEntry"),
+									},
+								},
+							},
+						},
+					},
+					"removed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Removed are the permissions in the base but not in the target.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.AccessReviewEntry"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"base", "target"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Client"},
+			"tkestack.io/tke/api/auth/v1.AccessReviewEntry"},
 	}
 }
 
-func schema_tke_api_auth_v1_ClientSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AccessReviewDiffOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClientSpec is a description of an client.",
+				Description: "AccessReviewDiffOptions is the query options to compare two access reviews.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"secret": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"redirect_uris": {
+					"base": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Base is the name of the access review compared with.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"trusted_peers": {
+				},
+				Required: []string{"base"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_auth_v1_AccessReviewEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AccessReviewEntry is a permission of a subject, as a row of the exported access review.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"subjectKind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TrustedPeers are a list of peers which can issue tokens on this client's behalf using the dynamic \"oauth2:server:client_id:(client_id)\" scope.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"public": {
+					"subject": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Public clients must use either use a redirectURL 127.0.0.1:X or \"urn:ietf:wg:oauth:2.0:oob\".",
-							Type:        []string{"boolean"},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"permission": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/auth/v1.AccessReviewPermission"),
+						},
+					},
+				},
+				Required: []string{"subjectKind", "subject", "permission"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/auth/v1.AccessReviewPermission"},
+	}
+}
+
+func schema_tke_api_auth_v1_AccessReviewExportOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AccessReviewExportOptions is the query options to export an access review.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"logo_url": {
+					"format": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Format is json or csv, json by default.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
@@ -42697,11 +43094,11 @@ func schema_tke_api_auth_v1_ClientSpec(ref common.ReferenceCallback) common.Open
 	}
 }
 
-func schema_tke_api_auth_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AccessReviewList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMap holds configuration data for tke to consume.",
+				Description: "AccessReviewList is the whole list of all access reviews.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -42721,248 +43118,205 @@ func schema_tke_api_auth_v1_ConfigMap(ref common.ReferenceCallback) common.OpenA
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"data": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"binaryData": {
-						SchemaProps: spec.SchemaProps{
-							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "List of access reviews.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "byte",
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.AccessReview"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.AccessReview"},
 	}
 }
 
-func schema_tke_api_auth_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AccessReviewPermission(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
+				Description: "AccessReviewPermission is a policy granted to a subject, together with the actions it allows or denies.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"project": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Project is the project the policy is granted in, platform wide if empty.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"policy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"policyName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"role": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Role is the role the policy is granted through.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"group": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Group is the group the policy is inherited from.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"effect": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ConfigMaps.",
-							Type:        []string{"array"},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.ConfigMap"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"actions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"policy", "effect", "resources", "actions"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.ConfigMap"},
 	}
 }
 
-func schema_tke_api_auth_v1_CustomPolicyBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AccessReviewSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CustomPolicyBinding is a collection of subjects bond to policies in a custom scope.",
+				Description: "AccessReviewSpec describes the scope of an access review.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"apiVersion": {
+					"projectID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "ProjectID limits the review to the permissions in the project, the whole tenant is reviewed if empty.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/auth/v1.CustomPolicyBindingSpec"),
-						},
-					},
-					"Status": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/auth/v1.CustomPolicyBindingStatus"),
-						},
-					},
 				},
-				Required: []string{"spec", "Status"},
+				Required: []string{"tenantID"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.CustomPolicyBindingSpec", "tkestack.io/tke/api/auth/v1.CustomPolicyBindingStatus"},
 	}
 }
 
-func schema_tke_api_auth_v1_CustomPolicyBindingList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AccessReviewStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CustomPolicyBindingList is the whole list of all CustomPolicyBindings.",
+				Description: "AccessReviewStatus holds the permissions resolved by an access review.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"subjects": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of CustomPolicyBinding.",
+							Description: "Subjects are the users and groups with their effective permissions.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.CustomPolicyBinding"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.AccessReviewSubject"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.CustomPolicyBinding"},
+			"tkestack.io/tke/api/auth/v1.AccessReviewSubject"},
 	}
 }
 
-func schema_tke_api_auth_v1_CustomPolicyBindingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AccessReviewSubject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CustomPolicyBindingSpec defines the desired identities of CustomPolicyBindingSpec document in this set.",
+				Description: "AccessReviewSubject is a user or a group with its effective permissions.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"finalizers": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"domain": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kind is User or Group.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastDomain": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"policyID": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"resources": {
+					"groups": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Groups are the groups the user belongs to.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -42974,235 +43328,254 @@ func schema_tke_api_auth_v1_CustomPolicyBindingSpec(ref common.ReferenceCallback
 							},
 						},
 					},
-					"rulePrefix": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"users": {
+					"members": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Members are the users of the group.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"groups": {
+					"permissions": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.AccessReviewPermission"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"tenantID", "domain", "lastDomain", "policyID", "resources", "rulePrefix", "users", "groups"},
+				Required: []string{"kind", "id", "name"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/auth/v1.Subject"},
+			"tkestack.io/tke/api/auth/v1.AccessReviewPermission"},
 	}
 }
 
-func schema_tke_api_auth_v1_CustomPolicyBindingStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_Action(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CustomPolicyBindingStatus represents information about the status of a CustomPolicyBinding.",
+				Description: "Action defines a action verb for authorization.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Name represents user access review request verb.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description describes the action.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"phase"},
+				Required: []string{"name", "description"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_auth_v1_Group(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AllowedStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Group is an object that contains the metadata about identify about tke local idp or third-party idp.",
+				Description: "AllowedStatus includes the resource access request and response.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"resource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Resource is the resource of request",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"web": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Verb is the verb of request",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"allowed": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Allowed is required. True if the action would be allowed, false otherwise.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"denied": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of group in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/auth/v1.GroupSpec"),
+							Description: "Denied is optional. True if the action would be denied, otherwise false. If both allowed is false and denied is false, then the authorizer has no opinion on whether to authorize the action. Denied may not be true if Allowed is true.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/auth/v1.GroupStatus"),
+							Description: "Reason is optional.  It indicates why a request was allowed or denied.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"evaluationError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvaluationError is an indication that some error occurred during the authorization check. It is entirely possible to get an error and be able to continue determine authorization status in spite of it. For instance, RBAC can be missing a role, but enough roles are still present and bound to reason about the request.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"status"},
+				Required: []string{"resource", "web", "allowed"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.GroupSpec", "tkestack.io/tke/api/auth/v1.GroupStatus"},
 	}
 }
 
-func schema_tke_api_auth_v1_GroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_AuthorizationExplanation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GroupList is the whole list of all groups.",
+				Description: "AuthorizationExplanation describes how the decision of a subject access review is made.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"subject": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Subject is the casbin subject of the user.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"tenant": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Tenant is the tenant of the user.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"project": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Project is the project the request is made in.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"action": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of Group.",
+							Description: "Action is the action being requested.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resource is the resource being requested.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Stage is the step of the authorization that made the decision.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"decision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Decision is the final decision, one of Allow, Deny or NoOpinion.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"matchedRules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MatchedRules are all the rules matched by the request.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Group"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.MatchedRule"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"subject", "action", "resource", "stage", "decision"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Group"},
+			"tkestack.io/tke/api/auth/v1.MatchedRule"},
 	}
 }
 
-func schema_tke_api_auth_v1_GroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_Binding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GroupSpec is a description of a Group.",
+				Description: "Binding is used to bind or unbind the subjects to or from the policy,role or group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"displayName": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"tenantID": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"description": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"extra": {
+					"users": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Users holds references to the objects the policy applies to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"id", "displayName", "tenantID", "description", "extra"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_auth_v1_GroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "GroupStatus represents information about the status of a group.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"users": {
+					"groups": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Users represents the members of the group.",
+							Description: "Groups holds references to the groups the policy applies to.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -43215,7 +43588,6 @@ func schema_tke_api_auth_v1_GroupStatus(ref common.ReferenceCallback) common.Ope
 						},
 					},
 				},
-				Required: []string{"users"},
 			},
 		},
 		Dependencies: []string{
@@ -43223,11 +43595,11 @@ func schema_tke_api_auth_v1_GroupStatus(ref common.ReferenceCallback) common.Ope
 	}
 }
 
-func schema_tke_api_auth_v1_IdentityProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_Category(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IdentityProvider is an object that contains the metadata about identify provider used to login to TKE.",
+				Description: "Category defines a category of actions for policy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -43250,26 +43622,26 @@ func schema_tke_api_auth_v1_IdentityProvider(ref common.ReferenceCallback) commo
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"spec": {
+					"Spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of identity provider in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/auth/v1.IdentityProviderSpec"),
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/auth/v1.CategorySpec"),
 						},
 					},
 				},
+				Required: []string{"Spec"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.IdentityProviderSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.CategorySpec"},
 	}
 }
 
-func schema_tke_api_auth_v1_IdentityProviderList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_CategoryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IdentityProviderList is the whole list of all identity providers.",
+				Description: "CategoryList is the whole list of policy Category.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -43294,13 +43666,13 @@ func schema_tke_api_auth_v1_IdentityProviderList(ref common.ReferenceCallback) c
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of identity providers.",
+							Description: "List of category.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.IdentityProvider"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Category"),
 									},
 								},
 							},
@@ -43311,68 +43683,60 @@ func schema_tke_api_auth_v1_IdentityProviderList(ref common.ReferenceCallback) c
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.IdentityProvider"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Category"},
 	}
 }
 
-func schema_tke_api_auth_v1_IdentityProviderSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_CategorySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IdentityProviderSpec is a description of an identity provider.",
+				Description: "CategorySpec is a description of category.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The Name of the connector that is used when displaying it to the end user.",
+							Description: "DisplayName used to display category name",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The type of the connector. E.g. 'oidc' or 'ldap'",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"administrators": {
+					"actions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The administrators means the users is super admin for the idp.",
+							Description: "Actions represents a series of actions work on the policy category",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Action"),
 									},
 								},
 							},
 						},
 					},
-					"config": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Config holds all the configuration information specific to the connector type. Since there no generic struct we can use for this purpose, it is stored as a json string.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
-				Required: []string{"name", "type", "administrators", "config"},
+				Required: []string{"displayName", "actions"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/auth/v1.Action"},
 	}
 }
 
-func schema_tke_api_auth_v1_LocalGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_Client(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalGroup represents a group of users.",
+				Description: "Client represents an OAuth2 client.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -43397,30 +43761,24 @@ func schema_tke_api_auth_v1_LocalGroup(ref common.ReferenceCallback) common.Open
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of group document in this set.",
+							Description: "Spec defines the desired identities of identity provider in this set.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/auth/v1.LocalGroupSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/auth/v1.LocalGroupStatus"),
+							Ref:         ref("tkestack.io/tke/api/auth/v1.ClientSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.LocalGroupSpec", "tkestack.io/tke/api/auth/v1.LocalGroupStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.ClientSpec"},
 	}
 }
 
-func schema_tke_api_auth_v1_LocalGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_ClientList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalGroupList is the whole list of all groups.",
+				Description: "ClientList is the whole list of OAuth2 client.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -43445,13 +43803,13 @@ func schema_tke_api_auth_v1_LocalGroupList(ref common.ReferenceCallback) common.
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of LocalGroup.",
+							Description: "List of identity providers.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.LocalGroup"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Client"),
 									},
 								},
 							},
@@ -43462,18 +43820,30 @@ func schema_tke_api_auth_v1_LocalGroupList(ref common.ReferenceCallback) common.
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.LocalGroup"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Client"},
 	}
 }
 
-func schema_tke_api_auth_v1_LocalGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_ClientSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalGroupSpec is a description of group.",
+				Description: "ClientSpec is a description of an client.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"finalizers": {
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"redirect_uris": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -43487,37 +43857,77 @@ func schema_tke_api_auth_v1_LocalGroupSpec(ref common.ReferenceCallback) common.
 							},
 						},
 					},
-					"displayName": {
+					"trusted_peers": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "TrustedPeers are a list of peers which can issue tokens on this client's behalf using the dynamic \"oauth2:server:client_id:(client_id)\" scope.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"tenantID": {
+					"public": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Public clients must use either use a redirectURL 127.0.0.1:X or \"urn:ietf:wg:oauth:2.0:oob\".",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"username": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"description": {
+					"logo_url": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"extra": {
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_auth_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMap holds configuration data for tke to consume.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
+							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
@@ -43530,54 +43940,84 @@ func schema_tke_api_auth_v1_LocalGroupSpec(ref common.ReferenceCallback) common.
 							},
 						},
 					},
+					"binaryData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "byte",
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"displayName", "tenantID", "username", "description", "extra"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_tke_api_auth_v1_LocalGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalGroupStatus represents information about the status of a group.",
+				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"users": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Users represents the members of the group.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ConfigMaps.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.ConfigMap"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"users"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/auth/v1.Subject"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.ConfigMap"},
 	}
 }
 
-func schema_tke_api_auth_v1_LocalIdentity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_CustomPolicyBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalIdentity is an object that contains the metadata about identify used to login to TKE.",
+				Description: "CustomPolicyBinding is a collection of subjects bond to policies in a custom scope.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -43602,30 +44042,30 @@ func schema_tke_api_auth_v1_LocalIdentity(ref common.ReferenceCallback) common.O
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of identity in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/auth/v1.LocalIdentitySpec"),
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/auth/v1.CustomPolicyBindingSpec"),
 						},
 					},
-					"status": {
+					"Status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/auth/v1.LocalIdentityStatus"),
+							Ref:     ref("tkestack.io/tke/api/auth/v1.CustomPolicyBindingStatus"),
 						},
 					},
 				},
+				Required: []string{"spec", "Status"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.LocalIdentitySpec", "tkestack.io/tke/api/auth/v1.LocalIdentityStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.CustomPolicyBindingSpec", "tkestack.io/tke/api/auth/v1.CustomPolicyBindingStatus"},
 	}
 }
 
-func schema_tke_api_auth_v1_LocalIdentityList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_CustomPolicyBindingList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalIdentityList is the whole list of all identities.",
+				Description: "CustomPolicyBindingList is the whole list of all CustomPolicyBindings.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -43650,13 +44090,13 @@ func schema_tke_api_auth_v1_LocalIdentityList(ref common.ReferenceCallback) comm
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of identities.",
+							Description: "List of CustomPolicyBinding.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.LocalIdentity"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.CustomPolicyBinding"),
 									},
 								},
 							},
@@ -43667,15 +44107,15 @@ func schema_tke_api_auth_v1_LocalIdentityList(ref common.ReferenceCallback) comm
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.LocalIdentity"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.CustomPolicyBinding"},
 	}
 }
 
-func schema_tke_api_auth_v1_LocalIdentitySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_CustomPolicyBindingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalIdentitySpec is a description of an identity.",
+				Description: "CustomPolicyBindingSpec defines the desired identities of CustomPolicyBindingSpec document in this set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"finalizers": {
@@ -43692,47 +44132,35 @@ func schema_tke_api_auth_v1_LocalIdentitySpec(ref common.ReferenceCallback) comm
 							},
 						},
 					},
-					"username": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"displayName": {
+					"domain": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"email": {
+					"lastDomain": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"phoneNumber": {
+					"policyID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"hashedPassword": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"groups": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -43746,133 +44174,74 @@ func schema_tke_api_auth_v1_LocalIdentitySpec(ref common.ReferenceCallback) comm
 							},
 						},
 					},
-					"extra": {
+					"rulePrefix": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"users": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"username", "displayName", "email", "phoneNumber"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_auth_v1_LocalIdentityStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LocalIdentityStatus is a description of an identity status.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"phase": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"locked": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"lastUpdateTime": {
+					"groups": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The last time the local identity was updated.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"tenantID", "domain", "lastDomain", "policyID", "resources", "rulePrefix", "users", "groups"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_tke_api_auth_v1_NonResourceAttributes(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "NonResourceAttributes includes the authorization attributes available for non-resource requests to the Authorizer interface",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"path": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Path is the URL path of the request",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"verb": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Verb is the standard HTTP verb",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-			},
-		},
+			"tkestack.io/tke/api/auth/v1.Subject"},
 	}
 }
 
-func schema_tke_api_auth_v1_PasswordReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_CustomPolicyBindingStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PasswordReq contains info to update password for a localIdentity",
+				Description: "CustomPolicyBindingStatus represents information about the status of a CustomPolicyBinding.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"hashedPassword": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"originalPassword": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
 				},
+				Required: []string{"phase"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_auth_v1_Policy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_Group(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Policy represents a policy document for access control.",
+				Description: "Group is an object that contains the metadata about identify about tke local idp or third-party idp.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -43897,72 +44266,31 @@ func schema_tke_api_auth_v1_Policy(ref common.ReferenceCallback) common.OpenAPID
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of policy document in this set.",
+							Description: "Spec defines the desired identities of group in this set.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/auth/v1.PolicySpec"),
+							Ref:         ref("tkestack.io/tke/api/auth/v1.GroupSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/auth/v1.PolicyStatus"),
+							Ref:     ref("tkestack.io/tke/api/auth/v1.GroupStatus"),
 						},
 					},
 				},
+				Required: []string{"status"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.PolicySpec", "tkestack.io/tke/api/auth/v1.PolicyStatus"},
-	}
-}
-
-func schema_tke_api_auth_v1_PolicyBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "PolicyBinding references the request to bind or unbind policies to the role.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"policies": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Policies holds the policies will bind or unbind to the role.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.GroupSpec", "tkestack.io/tke/api/auth/v1.GroupStatus"},
 	}
 }
 
-func schema_tke_api_auth_v1_PolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_GroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PolicyList is the whole list of all policies.",
+				Description: "GroupList is the whole list of all groups.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -43987,13 +44315,13 @@ func schema_tke_api_auth_v1_PolicyList(ref common.ReferenceCallback) common.Open
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of policies.",
+							Description: "List of Group.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Policy"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Group"),
 									},
 								},
 							},
@@ -44004,67 +44332,32 @@ func schema_tke_api_auth_v1_PolicyList(ref common.ReferenceCallback) common.Open
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Policy"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Group"},
 	}
 }
 
-func schema_tke_api_auth_v1_PolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_GroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PolicySpec is a description of a policy.",
+				Description: "GroupSpec is a description of a Group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"finalizers": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"displayName": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"category": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"type": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"scope": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"username": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
@@ -44078,58 +44371,38 @@ func schema_tke_api_auth_v1_PolicySpec(ref common.ReferenceCallback) common.Open
 							Format:  "",
 						},
 					},
-					"statement": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/auth/v1.Statement"),
-						},
-					},
-					"conditions": {
+					"extra": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"displayName", "tenantID", "category", "type", "scope", "username", "statement"},
+				Required: []string{"id", "displayName", "tenantID", "description", "extra"},
 			},
 		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/auth/v1.Statement"},
 	}
 }
 
-func schema_tke_api_auth_v1_PolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_GroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PolicyStatus represents information about the status of a policy.",
+				Description: "GroupStatus represents information about the status of a group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
 					"users": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Users represents the users the policy applies to.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
-									},
-								},
-							},
-						},
-					},
-					"groups": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Groups represents the groups the policy applies to.",
+							Description: "Users represents the members of the group.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -44142,6 +44415,7 @@ func schema_tke_api_auth_v1_PolicyStatus(ref common.ReferenceCallback) common.Op
 						},
 					},
 				},
+				Required: []string{"users"},
 			},
 		},
 		Dependencies: []string{
@@ -44149,11 +44423,11 @@ func schema_tke_api_auth_v1_PolicyStatus(ref common.ReferenceCallback) common.Op
 	}
 }
 
-func schema_tke_api_auth_v1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_IdentityProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Project contains members of projects.",
+				Description: "IdentityProvider is an object that contains the metadata about identify provider used to login to TKE.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -44176,57 +44450,26 @@ func schema_tke_api_auth_v1_Project(ref common.ReferenceCallback) common.OpenAPI
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"members": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"groups": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Spec defines the desired identities of identity provider in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/auth/v1.IdentityProviderSpec"),
 						},
 					},
 				},
-				Required: []string{"tenantID", "members", "groups"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.IdentityProviderSpec"},
 	}
 }
 
-func schema_tke_api_auth_v1_ProjectBelongs(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_IdentityProviderList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectBelongs contains projects of user belongs.",
+				Description: "IdentityProviderList is the whole list of all identity providers.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -44243,119 +44486,93 @@ func schema_tke_api_auth_v1_ProjectBelongs(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
-					"tenantID": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"managedProjects": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "List of identity providers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type: []string{"array"},
-										Items: &spec.SchemaOrArray{
-											Schema: &spec.Schema{
-												SchemaProps: spec.SchemaProps{
-													Default: "",
-													Type:    []string{"string"},
-													Format:  "",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-					"memberdProjects": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type: []string{"array"},
-										Items: &spec.SchemaOrArray{
-											Schema: &spec.Schema{
-												SchemaProps: spec.SchemaProps{
-													Default: "",
-													Type:    []string{"string"},
-													Format:  "",
-												},
-											},
-										},
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.IdentityProvider"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"tenantID", "managedProjects", "memberdProjects"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.IdentityProvider"},
 	}
 }
 
-func schema_tke_api_auth_v1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_IdentityProviderSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectList is the whole list of all projects.",
+				Description: "IdentityProviderSpec is a description of an identity provider.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "The Name of the connector that is used when displaying it to the end user.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "The type of the connector. E.g. 'oidc' or 'ldap'",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"administrators": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of projects.",
+							Description: "The administrators means the users is super admin for the idp.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Project"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config holds all the configuration information specific to the connector type. Since there no generic struct we can use for this purpose, it is stored as a json string.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name", "type", "administrators", "config"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Project"},
 	}
 }
 
-func schema_tke_api_auth_v1_ProjectPolicyBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_LocalGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectPolicyBinding is a collection of subjects bond to policies in a project scope.",
+				Description: "LocalGroup represents a group of users.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -44380,30 +44597,30 @@ func schema_tke_api_auth_v1_ProjectPolicyBinding(ref common.ReferenceCallback) c
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/auth/v1.ProjectPolicyBindingSpec"),
+							Description: "Spec defines the desired identities of group document in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/auth/v1.LocalGroupSpec"),
 						},
 					},
-					"Status": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/auth/v1.ProjectPolicyBindingStatus"),
+							Ref:     ref("tkestack.io/tke/api/auth/v1.LocalGroupStatus"),
 						},
 					},
 				},
-				Required: []string{"spec", "Status"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.ProjectPolicyBindingSpec", "tkestack.io/tke/api/auth/v1.ProjectPolicyBindingStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.LocalGroupSpec", "tkestack.io/tke/api/auth/v1.LocalGroupStatus"},
 	}
 }
 
-func schema_tke_api_auth_v1_ProjectPolicyBindingList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_LocalGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectPolicyBindingList is the whole list of all ProjectPolicyBindings.",
+				Description: "LocalGroupList is the whole list of all groups.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -44428,13 +44645,13 @@ func schema_tke_api_auth_v1_ProjectPolicyBindingList(ref common.ReferenceCallbac
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of policies.",
+							Description: "List of LocalGroup.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.ProjectPolicyBinding"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.LocalGroup"),
 									},
 								},
 							},
@@ -44445,98 +44662,20 @@ func schema_tke_api_auth_v1_ProjectPolicyBindingList(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.ProjectPolicyBinding"},
-	}
-}
-
-func schema_tke_api_auth_v1_ProjectPolicyBindingRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ProjectPolicyBindingRequest references the request to bind or unbind policies to the role.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"policies": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Policies holds the policies will bind to the subjects.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"users": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
-									},
-								},
-							},
-						},
-					},
-					"groups": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
-									},
-								},
-							},
-						},
-					},
-				},
-				Required: []string{"users", "groups"},
-			},
-		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/auth/v1.Subject"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.LocalGroup"},
 	}
 }
 
-func schema_tke_api_auth_v1_ProjectPolicyBindingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_LocalGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectPolicyBindingSpec defines the desired identities of ProjectPolicyBindingSpec document in this set.",
+				Description: "LocalGroupSpec is a description of group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"finalizers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of role document in this set.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -44548,150 +44687,97 @@ func schema_tke_api_auth_v1_ProjectPolicyBindingSpec(ref common.ReferenceCallbac
 							},
 						},
 					},
-					"tenantID": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"projectID": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"policyID": {
+					"username": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"users": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"groups": {
+					"extra": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"tenantID", "projectID", "policyID", "users", "groups"},
+				Required: []string{"displayName", "tenantID", "username", "description", "extra"},
 			},
 		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/auth/v1.Subject"},
 	}
 }
 
-func schema_tke_api_auth_v1_ProjectPolicyBindingStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_LocalGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectPolicyBindingStatus represents information about the status of a ProjectPolicyBinding.",
+				Description: "LocalGroupStatus represents information about the status of a group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-				},
-				Required: []string{"phase"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_auth_v1_ResourceAttributes(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ResourceAttributes includes the authorization attributes available for resource requests to the Authorizer interface",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of the action being requested.  Currently, there is no distinction between no namespace and all namespaces \"\" (empty) is defaulted for LocalSubjectAccessReviews \"\" (empty) is empty for cluster-scoped resources \"\" (empty) means \"all\" for namespace scoped resources from a SubjectAccessReview or SelfSubjectAccessReview",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"verb": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Verb is a kubernetes resource API verb, like: get, list, watch, create, update, delete, proxy.  \"*\" means all.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"group": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Group is the API Group of the Resource.  \"*\" means all.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"version": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Version is the API Version of the Resource.  \"*\" means all.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"resource": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Resource is one of the existing resource types.  \"*\" means all.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"subresource": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Subresource is one of the existing resource types.  \"\" means none.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"name": {
+					"users": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the resource being requested for a \"get\" or deleted for a \"delete\". \"\" (empty) means all.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Users represents the members of the group.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"users"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/auth/v1.Subject"},
 	}
 }
 
-func schema_tke_api_auth_v1_Role(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_LocalIdentity(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Role is a collection with multiple policies.",
+				Description: "LocalIdentity is an object that contains the metadata about identify used to login to TKE.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -44716,30 +44802,30 @@ func schema_tke_api_auth_v1_Role(ref common.ReferenceCallback) common.OpenAPIDef
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of role document in this set.",
+							Description: "Spec defines the desired identities of identity in this set.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/auth/v1.RoleSpec"),
+							Ref:         ref("tkestack.io/tke/api/auth/v1.LocalIdentitySpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/auth/v1.RoleStatus"),
+							Ref:     ref("tkestack.io/tke/api/auth/v1.LocalIdentityStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.RoleSpec", "tkestack.io/tke/api/auth/v1.RoleStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.LocalIdentitySpec", "tkestack.io/tke/api/auth/v1.LocalIdentityStatus"},
 	}
 }
 
-func schema_tke_api_auth_v1_RoleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_LocalIdentityList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RoleList is the whole list of policy.",
+				Description: "LocalIdentityList is the whole list of all identities.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -44764,13 +44850,13 @@ func schema_tke_api_auth_v1_RoleList(ref common.ReferenceCallback) common.OpenAP
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of rules.",
+							Description: "List of identities.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Role"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.LocalIdentity"),
 									},
 								},
 							},
@@ -44781,15 +44867,15 @@ func schema_tke_api_auth_v1_RoleList(ref common.ReferenceCallback) common.OpenAP
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Role"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.LocalIdentity"},
 	}
 }
 
-func schema_tke_api_auth_v1_RoleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_LocalIdentitySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RoleSpec is a description of role.",
+				Description: "LocalIdentitySpec is a description of an identity.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"finalizers": {
@@ -44806,6 +44892,13 @@ func schema_tke_api_auth_v1_RoleSpec(ref common.ReferenceCallback) common.OpenAP
 							},
 						},
 					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
 					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
@@ -44813,36 +44906,33 @@ func schema_tke_api_auth_v1_RoleSpec(ref common.ReferenceCallback) common.OpenAP
 							Format:  "",
 						},
 					},
-					"tenantID": {
+					"email": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"projectID": {
+					"phoneNumber": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"username": {
+					"hashedPassword": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Username is Creator",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"description": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"policies": {
+					"groups": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -44856,50 +44946,92 @@ func schema_tke_api_auth_v1_RoleSpec(ref common.ReferenceCallback) common.OpenAP
 							},
 						},
 					},
+					"extra": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"displayName", "tenantID", "projectID", "username", "description", "policies"},
+				Required: []string{"username", "displayName", "email", "phoneNumber"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_auth_v1_RoleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_LocalIdentityStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RoleStatus represents information about the status of a role.",
+				Description: "LocalIdentityStatus is a description of an identity status.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"users": {
+					"locked": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Users represents the users the role applies to.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
-									},
-								},
-							},
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"groups": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Groups represents the groups the role applies to.",
+							Description: "The last time the local identity was updated.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"failedLoginAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedLoginAttempts is the number of consecutive failed logins.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastFailedLoginTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time a login of the local identity failed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lockedUntil": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LockedUntil is the time until which the local identity is temporarily locked because of too many failed logins.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"passwordUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the password of the local identity was changed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"passwordHistory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PasswordHistory holds the previous hashed passwords, newest first.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
@@ -44909,255 +45041,170 @@ func schema_tke_api_auth_v1_RoleStatus(ref common.ReferenceCallback) common.Open
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/auth/v1.Subject"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_auth_v1_Rule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_MatchedRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Rule represents a rule document for access control.",
+				Description: "MatchedRule describes a casbin rule matched by a subject access review.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"rule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rule is the name of the rule object.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Kind is the kind of the object the rule belongs to, Policy or Role.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Name is the name of the policy or role the rule belongs to.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"binding": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Binding is the name of the ProjectPolicyBinding granting the rule.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"project": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of policy document in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/auth/v1.RuleSpec"),
+							Description: "Project is the project the rule is granted in, empty if it is granted for the whole platform.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.RuleSpec"},
-	}
-}
-
-func schema_tke_api_auth_v1_RuleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RuleList is the whole list of all rules.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"via": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Via is the user or group the rule is granted to.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"resource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Resource is the resource pattern of the rule.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"action": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Action is the action pattern of the rule.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"effect": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of rules.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.Rule"),
-									},
-								},
-							},
+							Description: "Effect is the effect of the rule.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failedCondition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailedCondition describes the condition of the policy not met by the request, the rule takes no effect if it is set.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"name", "via", "resource", "action", "effect"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Rule"},
 	}
 }
 
-func schema_tke_api_auth_v1_RuleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_NonResourceAttributes(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RuleSpec is a description of a rule.",
+				Description: "NonResourceAttributes includes the authorization attributes available for non-resource requests to the Authorizer interface",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ptype": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"v0": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"v1": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"v2": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"v3": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"v4": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"v5": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Path is the URL path of the request",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"v6": {
+					"verb": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Verb is the standard HTTP verb",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"ptype", "v0", "v1", "v2", "v3", "v4", "v5", "v6"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_auth_v1_Statement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_PasswordReq(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Statement defines a series of action on resource can be done or not.",
+				Description: "PasswordReq contains info to update password for a localIdentity",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"actions": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"resources": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"effect": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Effect indicates action on the resource is allowed or not, can be \"allow\" or \"deny\"",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"actions", "resources", "effect"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_auth_v1_Subject(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Subject references a user can specify by id or name.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"id": {
+					"hashedPassword": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"name": {
+					"originalPassword": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"id", "name"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_auth_v1_SubjectAccessReview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_Policy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubjectAccessReview checks whether or not a user or group can perform an action.  Not filling in a spec.namespace means \"in all namespaces\".",
+				Description: "Policy represents a policy document for access control.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -45182,69 +45229,49 @@ func schema_tke_api_auth_v1_SubjectAccessReview(ref common.ReferenceCallback) co
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec holds information about the request being evaluated",
+							Description: "Spec defines the desired identities of policy document in this set.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/auth/v1.SubjectAccessReviewSpec"),
+							Ref:         ref("tkestack.io/tke/api/auth/v1.PolicySpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status is filled in by the server and indicates whether the request is allowed or not",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/auth/v1.SubjectAccessReviewStatus"),
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/auth/v1.PolicyStatus"),
 						},
 					},
 				},
-				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.SubjectAccessReviewSpec", "tkestack.io/tke/api/auth/v1.SubjectAccessReviewStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.PolicySpec", "tkestack.io/tke/api/auth/v1.PolicyStatus"},
 	}
 }
 
-func schema_tke_api_auth_v1_SubjectAccessReviewSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_PolicyBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubjectAccessReviewSpec is a description of the access request.  Exactly one of ResourceAttributes and NonResourceAttributes must be set",
+				Description: "PolicyBinding references the request to bind or unbind policies to the role.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"resourceAttributes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ResourceAttributes describes information for a resource access request",
-							Ref:         ref("tkestack.io/tke/api/auth/v1.ResourceAttributes"),
-						},
-					},
-					"resourceAttributesList": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ResourceAttributesList describes information for multi resource access request.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/auth/v1.ResourceAttributes"),
-									},
-								},
-							},
-						},
-					},
-					"nonResourceAttributes": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NonResourceAttributes describes information for a non-resource access request",
-							Ref:         ref("tkestack.io/tke/api/auth/v1.NonResourceAttributes"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"user": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "User is the user you're testing for. If you specify \"User\" but not \"Groups\", then is it interpreted as \"What if User were not a member of any groups",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"group": {
+					"policies": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Groups is the groups you're testing for.",
+							Description: "Policies holds the policies will bind or unbind to the role.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -45257,241 +45284,253 @@ func schema_tke_api_auth_v1_SubjectAccessReviewSpec(ref common.ReferenceCallback
 							},
 						},
 					},
-					"extra": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Extra corresponds to the user.Info.GetExtra() method from the authenticator.  Since that is input to the authorizer it needs a reflection here.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type: []string{"array"},
-										Items: &spec.SchemaOrArray{
-											Schema: &spec.Schema{
-												SchemaProps: spec.SchemaProps{
-													Default: "",
-													Type:    []string{"string"},
-													Format:  "",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-					"uid": {
-						SchemaProps: spec.SchemaProps{
-							Description: "UID information about the requesting user.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/auth/v1.NonResourceAttributes", "tkestack.io/tke/api/auth/v1.ResourceAttributes"},
 	}
 }
 
-func schema_tke_api_auth_v1_SubjectAccessReviewStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_PolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SubjectAccessReviewStatus represents the current state of a SubjectAccessReview.",
+				Description: "PolicyList is the whole list of all policies.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"allowed": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Allowed is required. True if the action would be allowed, false otherwise.",
-							Default:     false,
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"denied": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Denied is optional. True if the action would be denied, otherwise false. If both allowed is false and denied is false, then the authorizer has no opinion on whether to authorize the action. Denied may not be true if Allowed is true.",
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is optional.  It indicates why a request was allowed or denied.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"evaluationError": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EvaluationError is an indication that some error occurred during the authorization check. It is entirely possible to get an error and be able to continue determine authorization status in spite of it. For instance, RBAC can be missing a role, but enough roles are still present and bound to reason about the request.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"allowedList": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AllowedList is the allowed response for batch authorization request.",
+							Description: "List of policies.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/auth/v1.AllowedStatus"),
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Policy"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"allowed"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/auth/v1.AllowedStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Policy"},
 	}
 }
 
-func schema_tke_api_auth_v1_User(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_PolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "User is an object that contains the metadata about identify about tke local idp or third-party idp.",
+				Description: "PolicySpec is a description of a policy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"finalizers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"metadata": {
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"category": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"scope": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"statement": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Ref:     ref("tkestack.io/tke/api/auth/v1.Statement"),
 						},
 					},
-					"spec": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of identity in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/auth/v1.UserSpec"),
+							Type:   []string{"string"},
+							Format: "byte",
 						},
 					},
 				},
+				Required: []string{"displayName", "tenantID", "category", "type", "scope", "username", "statement"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.UserSpec"},
+			"tkestack.io/tke/api/auth/v1.Statement"},
 	}
 }
 
-func schema_tke_api_auth_v1_UserList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_PolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "UserList is the whole list of all users.",
+				Description: "PolicyStatus represents information about the status of a policy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"metadata": {
+					"users": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Users represents the users the policy applies to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"groups": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of User.",
+							Description: "Groups represents the groups the policy applies to.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/auth/v1.User"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.User"},
+			"tkestack.io/tke/api/auth/v1.Subject"},
 	}
 }
 
-func schema_tke_api_auth_v1_UserSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "UserSpec is a description of an user.",
+				Description: "Project contains members of projects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name must be unique in the same tenant.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"displayName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"email": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"phoneNumber": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"tenantID": {
+					"members": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"extra": {
+					"groups": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
@@ -45507,17 +45546,19 @@ func schema_tke_api_auth_v1_UserSpec(ref common.ReferenceCallback) common.OpenAP
 						},
 					},
 				},
-				Required: []string{"id", "name"},
+				Required: []string{"tenantID", "members", "groups"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_tke_api_business_v1_ChartGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_ProjectBelongs(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChartGroup is an chart group.",
+				Description: "ProjectBelongs contains projects of user belongs.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -45534,38 +45575,69 @@ func schema_tke_api_business_v1_ChartGroup(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"spec": {
+					"managedProjects": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of namespaces in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/business/v1.ChartGroupSpec"),
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"array"},
+										Items: &spec.SchemaOrArray{
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Default: "",
+													Type:    []string{"string"},
+													Format:  "",
+												},
+											},
+										},
+									},
+								},
+							},
 						},
 					},
-					"status": {
+					"memberdProjects": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/business/v1.ChartGroupStatus"),
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"array"},
+										Items: &spec.SchemaOrArray{
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Default: "",
+													Type:    []string{"string"},
+													Format:  "",
+												},
+											},
+										},
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"tenantID", "managedProjects", "memberdProjects"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.ChartGroupSpec", "tkestack.io/tke/api/business/v1.ChartGroupStatus"},
 	}
 }
 
-func schema_tke_api_business_v1_ChartGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChartGroupList is the whole list of all chart groups which owned by a tenant.",
+				Description: "ProjectList is the whole list of all projects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -45590,13 +45662,13 @@ func schema_tke_api_business_v1_ChartGroupList(ref common.ReferenceCallback) com
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of namespaces",
+							Description: "List of projects.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/business/v1.ChartGroup"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Project"),
 									},
 								},
 							},
@@ -45607,107 +45679,113 @@ func schema_tke_api_business_v1_ChartGroupList(ref common.ReferenceCallback) com
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.ChartGroup"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Project"},
 	}
 }
 
-func schema_tke_api_business_v1_ChartGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_ProjectPolicyBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChartGroupSpec represents an chart group.",
+				Description: "ProjectPolicyBinding is a collection of subjects bond to policies in a project scope.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"finalizers": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"name": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tenantID": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"displayName": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/auth/v1.ProjectPolicyBindingSpec"),
+						},
+					},
+					"Status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/auth/v1.ProjectPolicyBindingStatus"),
 						},
 					},
 				},
-				Required: []string{"name", "tenantID"},
+				Required: []string{"spec", "Status"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.ProjectPolicyBindingSpec", "tkestack.io/tke/api/auth/v1.ProjectPolicyBindingStatus"},
 	}
 }
 
-func schema_tke_api_business_v1_ChartGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_ProjectPolicyBindingList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChartGroupStatus represents information about the status of an chart group.",
+				Description: "ProjectPolicyBindingList is the whole list of all ProjectPolicyBindings.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The last time the condition transitioned from one status to another.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"reason": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"message": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "List of policies.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.ProjectPolicyBinding"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.ProjectPolicyBinding"},
 	}
 }
 
-func schema_tke_api_business_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_ProjectPolicyBindingRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMap holds configuration data for tke to consume.",
+				Description: "ProjectPolicyBindingRequest references the request to bind or unbind policies to the role.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -45724,18 +45802,17 @@ func schema_tke_api_business_v1_ConfigMap(ref common.ReferenceCallback) common.O
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"data": {
+					"policies": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Policies holds the policies will bind to the subjects.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -45746,113 +45823,207 @@ func schema_tke_api_business_v1_ConfigMap(ref common.ReferenceCallback) common.O
 							},
 						},
 					},
-					"binaryData": {
+					"users": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "byte",
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
+									},
+								},
+							},
+						},
+					},
+					"groups": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"users", "groups"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"tkestack.io/tke/api/auth/v1.Subject"},
 	}
 }
 
-func schema_tke_api_business_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_ProjectPolicyBindingSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
+				Description: "ProjectPolicyBindingSpec defines the desired identities of ProjectPolicyBindingSpec document in this set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"finalizers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Spec defines the desired identities of role document in this set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"metadata": {
+					"projectID": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"items": {
+					"policyID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ConfigMaps.",
-							Type:        []string{"array"},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"users": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/business/v1.ConfigMap"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
+									},
+								},
+							},
+						},
+					},
+					"groups": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"tenantID", "projectID", "policyID", "users", "groups"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.ConfigMap"},
+			"tkestack.io/tke/api/auth/v1.Subject"},
 	}
 }
 
-func schema_tke_api_business_v1_HardQuantity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_ProjectPolicyBindingStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HardQuantity is a straightforward wrapper of ResourceList.",
+				Description: "ProjectPolicyBindingStatus represents information about the status of a ProjectPolicyBinding.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"hard": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
 				},
+				Required: []string{"phase"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_tke_api_business_v1_ImageNamespace(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_ResourceAttributes(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImageNamespace is an image namespace.",
+				Description: "ResourceAttributes includes the authorization attributes available for resource requests to the Authorizer interface",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the action being requested.  Currently, there is no distinction between no namespace and all namespaces \"\" (empty) is defaulted for LocalSubjectAccessReviews \"\" (empty) is empty for cluster-scoped resources \"\" (empty) means \"all\" for namespace scoped resources from a SubjectAccessReview or SelfSubjectAccessReview",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"verb": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verb is a kubernetes resource API verb, like: get, list, watch, create, update, delete, proxy.  \"*\" means all.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group is the API Group of the Resource.  \"*\" means all.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the API Version of the Resource.  \"*\" means all.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resource is one of the existing resource types.  \"*\" means all.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subresource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subresource is one of the existing resource types.  \"\" means none.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the resource being requested for a \"get\" or deleted for a \"delete\". \"\" (empty) means all.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_auth_v1_Role(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Role is a collection with multiple policies.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -45877,30 +46048,30 @@ func schema_tke_api_business_v1_ImageNamespace(ref common.ReferenceCallback) com
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of namespaces in this set.",
+							Description: "Spec defines the desired identities of role document in this set.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/business/v1.ImageNamespaceSpec"),
+							Ref:         ref("tkestack.io/tke/api/auth/v1.RoleSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/business/v1.ImageNamespaceStatus"),
+							Ref:     ref("tkestack.io/tke/api/auth/v1.RoleStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.ImageNamespaceSpec", "tkestack.io/tke/api/business/v1.ImageNamespaceStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.RoleSpec", "tkestack.io/tke/api/auth/v1.RoleStatus"},
 	}
 }
 
-func schema_tke_api_business_v1_ImageNamespaceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_RoleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImageNamespaceList is the whole list of all image namespaces which owned by a tenant.",
+				Description: "RoleList is the whole list of policy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -45925,13 +46096,13 @@ func schema_tke_api_business_v1_ImageNamespaceList(ref common.ReferenceCallback)
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of namespaces",
+							Description: "List of rules.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/business/v1.ImageNamespace"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Role"),
 									},
 								},
 							},
@@ -45942,21 +46113,20 @@ func schema_tke_api_business_v1_ImageNamespaceList(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.ImageNamespace"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Role"},
 	}
 }
 
-func schema_tke_api_business_v1_ImageNamespaceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_RoleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImageNamespaceSpec represents an image namespace.",
+				Description: "RoleSpec is a description of role.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"finalizers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -45968,7 +46138,7 @@ func schema_tke_api_business_v1_ImageNamespaceSpec(ref common.ReferenceCallback)
 							},
 						},
 					},
-					"name": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
@@ -45982,152 +46152,104 @@ func schema_tke_api_business_v1_ImageNamespaceSpec(ref common.ReferenceCallback)
 							Format:  "",
 						},
 					},
-					"displayName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-				},
-				Required: []string{"name", "tenantID"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_business_v1_ImageNamespaceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ImageNamespaceStatus represents information about the status of an image namespace.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"phase": {
+					"projectID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The last time the condition transitioned from one status to another.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"reason": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"message": {
-						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_tke_api_business_v1_Namespace(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Namespace is a namespace in cluster.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"username": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Username is Creator",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of namespaces in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/business/v1.NamespaceSpec"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"status": {
+					"policies": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/business/v1.NamespaceStatus"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"displayName", "tenantID", "projectID", "username", "description", "policies"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.NamespaceSpec", "tkestack.io/tke/api/business/v1.NamespaceStatus"},
 	}
 }
 
-func schema_tke_api_business_v1_NamespaceCert(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_RoleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamespaceCert represents a x509 certificate of a namespace in project.",
+				Description: "RoleStatus represents information about the status of a role.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"certPem": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
-						},
-					},
-					"keyPem": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"caCertPem": {
+					"users": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Description: "Users represents the users the role applies to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
+									},
+								},
+							},
 						},
 					},
-					"apiServer": {
+					"groups": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Groups represents the groups the role applies to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Subject"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/auth/v1.Subject"},
 	}
 }
 
-func schema_tke_api_business_v1_NamespaceCertOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_Rule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamespaceCertOptions is query options of getting namespace with a x509 certificate.",
+				Description: "Rule represents a rule document for access control.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46144,24 +46266,32 @@ func schema_tke_api_business_v1_NamespaceCertOptions(ref common.ReferenceCallbac
 							Format:      "",
 						},
 					},
-					"validDays": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pay attention to const CertOptionValiddays!",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of policy document in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/auth/v1.RuleSpec"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.RuleSpec"},
 	}
 }
 
-func schema_tke_api_business_v1_NamespaceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_RuleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamespaceList is the whole list of all namespaces which owned by a tenant.",
+				Description: "RuleList is the whole list of all rules.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46186,13 +46316,13 @@ func schema_tke_api_business_v1_NamespaceList(ref common.ReferenceCallback) comm
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of namespaces",
+							Description: "List of rules.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/business/v1.Namespace"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Rule"),
 									},
 								},
 							},
@@ -46203,186 +46333,183 @@ func schema_tke_api_business_v1_NamespaceList(ref common.ReferenceCallback) comm
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.Namespace"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Rule"},
 	}
 }
 
-func schema_tke_api_business_v1_NamespaceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_RuleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamespaceSpec represents a namespace in cluster of a project.",
+				Description: "RuleSpec is a description of a rule.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"finalizers": {
+					"ptype": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
 					},
-					"tenantID": {
+					"v0": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"clusterName": {
+					"v1": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"clusterType": {
+					"v2": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"clusterVersion": {
+					"v3": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"clusterDisplayName": {
+					"v4": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"namespace": {
+					"v5": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"hard": {
+					"v6": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Hard represents the total resources of a namespace.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
 				},
-				Required: []string{"tenantID", "clusterName", "clusterType", "clusterVersion", "clusterDisplayName", "namespace"},
+				Required: []string{"ptype", "v0", "v1", "v2", "v3", "v4", "v5", "v6"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_tke_api_business_v1_NamespaceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_Session(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamespaceStatus represents information about the status of a namespace in project.",
+				Description: "Session is a login session of a user, backed by the refresh token issued to the client. Deleting a session revokes it.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The last time the condition transitioned from one status to another.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"reason": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"message": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/auth/v1.SessionSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/auth/v1.SessionStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.SessionSpec", "tkestack.io/tke/api/auth/v1.SessionStatus"},
+	}
+}
+
+func schema_tke_api_auth_v1_SessionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SessionList is the whole list of all sessions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resourceQuotaName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"used": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Used represents the resources of a namespace that are used.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"cachedSpecHard": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "List of sessions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Session"),
 									},
 								},
 							},
 						},
 					},
-					"certificate": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/business/v1.NamespaceCert"),
-						},
-					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/business/v1.NamespaceCert"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Session"},
 	}
 }
 
-func schema_tke_api_business_v1_NsEmigration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_SessionRevocation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NsEmigration is an namespace emigration.",
+				Description: "SessionRevocation records the ID tokens revoked before they expire.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46406,31 +46533,25 @@ func schema_tke_api_business_v1_NsEmigration(ref common.ReferenceCallback) commo
 						},
 					},
 					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of emigrations in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/business/v1.NsEmigrationSpec"),
-						},
-					},
-					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/business/v1.NsEmigrationStatus"),
+							Ref:     ref("tkestack.io/tke/api/auth/v1.SessionRevocationSpec"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.NsEmigrationSpec", "tkestack.io/tke/api/business/v1.NsEmigrationStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.SessionRevocationSpec"},
 	}
 }
 
-func schema_tke_api_business_v1_NsEmigrationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_SessionRevocationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NsEmigrationList is the whole list of all namespace emigrations which owned by a tenant.",
+				Description: "SessionRevocationList is the whole list of all session revocations.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46455,13 +46576,13 @@ func schema_tke_api_business_v1_NsEmigrationList(ref common.ReferenceCallback) c
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of namespace emigrations",
+							Description: "List of session revocations.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/business/v1.NsEmigration"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.SessionRevocation"),
 									},
 								},
 							},
@@ -46472,15 +46593,15 @@ func schema_tke_api_business_v1_NsEmigrationList(ref common.ReferenceCallback) c
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.NsEmigration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.SessionRevocation"},
 	}
 }
 
-func schema_tke_api_business_v1_NsEmigrationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_SessionRevocationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NsEmigrationSpec represents a namespace emigration.",
+				Description: "SessionRevocationSpec describes the ID tokens revoked.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"tenantID": {
@@ -46490,209 +46611,210 @@ func schema_tke_api_business_v1_NsEmigrationSpec(ref common.ReferenceCallback) c
 							Format:  "",
 						},
 					},
-					"namespace": {
+					"username": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"nsShowName": {
+					"sessionID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "SessionID is the revoked session, all sessions of the user issued before the revoke time are revoked if empty.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"destination": {
+					"revokeTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"expireTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpireTime is the time the last revoked ID token expires, the revocation is removed after it.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"tenantID", "namespace", "nsShowName", "destination"},
+				Required: []string{"tenantID", "username", "revokeTime", "expireTime"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_business_v1_NsEmigrationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_SessionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NsEmigrationStatus represents information about the status of a namespace emigration.",
+				Description: "SessionSpec describes the attributes of a session.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"lastTransitionTime": {
+					"username": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The last time the condition transitioned from one status to another.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"reason": {
+					"userID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"clientID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"sessionID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SessionID identifies the session in the ID tokens issued for it, it is empty if the session is not logged in through the gateway.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"clientIP": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
+							Description: "ClientIP is the address the user logged in from.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"tenantID", "username", "userID", "clientID"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_business_v1_Platform(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_SessionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Platform is a platform in TKE.",
+				Description: "SessionStatus represents information about the usage of a session.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
+					"issuedTime": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"spec": {
+					"lastUsedTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of platforms in this set.",
+							Description: "LastUsedTime is the last time the session is refreshed.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/business/v1.PlatformSpec"),
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"issuedTime"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.PlatformSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_business_v1_PlatformList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_Statement(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PlatformList is the whole list of all platforms which owned by a tenant.",
+				Description: "Statement defines a series of action on resource can be done or not.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
+					"actions": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of platform.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/business/v1.Platform"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
+					"effect": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Effect indicates action on the resource is allowed or not, can be \"allow\" or \"deny\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"items"},
+				Required: []string{"actions", "resources", "effect"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.Platform"},
 	}
 }
 
-func schema_tke_api_business_v1_PlatformSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_Subject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PlatformSpec is a description of a platform.",
+				Description: "Subject references a user can specify by id or name.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"administrators": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
 				},
-				Required: []string{"tenantID", "administrators"},
+				Required: []string{"id", "name"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_business_v1_Portal(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_SubjectAccessReview(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Portal is a user in TKE.",
+				Description: "SubjectAccessReview checks whether or not a user or group can perform an action.  Not filling in a spec.namespace means \"in all namespaces\".",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46715,20 +46837,73 @@ func schema_tke_api_business_v1_Portal(ref common.ReferenceCallback) common.Open
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"administrator": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Administrator indicates whether the user is a platform administrator",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Spec holds information about the request being evaluated",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/auth/v1.SubjectAccessReviewSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is filled in by the server and indicates whether the request is allowed or not",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/auth/v1.SubjectAccessReviewStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.SubjectAccessReviewSpec", "tkestack.io/tke/api/auth/v1.SubjectAccessReviewStatus"},
+	}
+}
+
+func schema_tke_api_auth_v1_SubjectAccessReviewSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubjectAccessReviewSpec is a description of the access request.  Exactly one of ResourceAttributes and NonResourceAttributes must be set",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceAttributes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceAttributes describes information for a resource access request",
+							Ref:         ref("tkestack.io/tke/api/auth/v1.ResourceAttributes"),
+						},
+					},
+					"resourceAttributesList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceAttributesList describes information for multi resource access request.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/auth/v1.ResourceAttributes"),
+									},
+								},
+							},
+						},
+					},
+					"nonResourceAttributes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NonResourceAttributes describes information for a non-resource access request",
+							Ref:         ref("tkestack.io/tke/api/auth/v1.NonResourceAttributes"),
+						},
+					},
+					"user": {
+						SchemaProps: spec.SchemaProps{
+							Description: "User is the user you're testing for. If you specify \"User\" but not \"Groups\", then is it interpreted as \"What if User were not a member of any groups",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"projects": {
+					"group": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Projects represents the list of projects to which the user belongs, where the key represents project name and the value represents the project display name.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Groups is the groups you're testing for.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -46739,65 +46914,128 @@ func schema_tke_api_business_v1_Portal(ref common.ReferenceCallback) common.Open
 							},
 						},
 					},
-					"extension": {
+					"extra": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Extension is extension info. for projects.",
+							Description: "Extra corresponds to the user.Info.GetExtra() method from the authenticator.  Since that is input to the authorizer it needs a reflection here.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/business/v1.PortalProject"),
+										Type: []string{"array"},
+										Items: &spec.SchemaOrArray{
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Default: "",
+													Type:    []string{"string"},
+													Format:  "",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UID information about the requesting user.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"simulatedGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SimulatedGroups are the ids of local groups the user is considered a member of while explaining the request, so that membership changes can be tested before they are applied.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"administrator", "projects", "extension"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.PortalProject"},
+			"tkestack.io/tke/api/auth/v1.NonResourceAttributes", "tkestack.io/tke/api/auth/v1.ResourceAttributes"},
 	}
 }
 
-func schema_tke_api_business_v1_PortalProject(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_SubjectAccessReviewStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PortalProject is a project extension info for portal.",
+				Description: "SubjectAccessReviewStatus represents the current state of a SubjectAccessReview.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"allowed": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phases of projects.",
-							Default:     "",
+							Description: "Allowed is required. True if the action would be allowed, false otherwise.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"denied": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Denied is optional. True if the action would be denied, otherwise false. If both allowed is false and denied is false, then the authorizer has no opinion on whether to authorize the action. Denied may not be true if Allowed is true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is optional.  It indicates why a request was allowed or denied.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"parent": {
+					"evaluationError": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parents of projects.",
-							Default:     "",
+							Description: "EvaluationError is an indication that some error occurred during the authorization check. It is entirely possible to get an error and be able to continue determine authorization status in spite of it. For instance, RBAC can be missing a role, but enough roles are still present and bound to reason about the request.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"allowedList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedList is the allowed response for batch authorization request.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/auth/v1.AllowedStatus"),
+									},
+								},
+							},
+						},
+					},
+					"explanation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Explanation describes how the decision is made, only set if explain is requested.",
+							Ref:         ref("tkestack.io/tke/api/auth/v1.AuthorizationExplanation"),
+						},
+					},
 				},
-				Required: []string{"phase", "parent"},
+				Required: []string{"allowed"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/auth/v1.AllowedStatus", "tkestack.io/tke/api/auth/v1.AuthorizationExplanation"},
 	}
 }
 
-func schema_tke_api_business_v1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_Tenant(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Project is a project in TKE.",
+				Description: "Tenant is a tenant of TKE, its name is the tenant id the objects of every API group belong to.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46822,30 +47060,71 @@ func schema_tke_api_business_v1_Project(ref common.ReferenceCallback) common.Ope
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of project in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/business/v1.ProjectSpec"),
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/auth/v1.TenantSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/business/v1.ProjectStatus"),
+							Ref:     ref("tkestack.io/tke/api/auth/v1.TenantStatus"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.ProjectSpec", "tkestack.io/tke/api/business/v1.ProjectStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.TenantSpec", "tkestack.io/tke/api/auth/v1.TenantStatus"},
 	}
 }
 
-func schema_tke_api_business_v1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_TenantDeletionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectList is the whole list of all projects which owned by a tenant.",
+				Description: "TenantDeletionStatus is the progress of the deletion of the objects of a tenant in an API group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"remaining": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Remaining is the number of objects not deleted yet.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"group", "phase"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_auth_v1_TenantList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TenantList is the whole list of all tenants.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46870,13 +47149,13 @@ func schema_tke_api_business_v1_ProjectList(ref common.ReferenceCallback) common
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of projects",
+							Description: "List of tenants.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/business/v1.Project"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.Tenant"),
 									},
 								},
 							},
@@ -46887,21 +47166,57 @@ func schema_tke_api_business_v1_ProjectList(ref common.ReferenceCallback) common
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.Project"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.Tenant"},
 	}
 }
 
-func schema_tke_api_business_v1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_TenantQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectSpec is a description of a project.",
+				Description: "TenantQuota limits the number of objects a tenant may own, nil means unlimited.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusters": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"projects": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"registries": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"apps": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_auth_v1_TenantSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TenantSpec is a description of a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"finalizers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -46913,22 +47228,30 @@ func schema_tke_api_business_v1_ProjectSpec(ref common.ReferenceCallback) common
 							},
 						},
 					},
-					"tenantID": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"displayName": {
+					"identityProviderType": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "IdentityProviderType is the type of the default identity provider created with the tenant, tke if empty.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"members": {
+					"identityProviderConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Users represents the user list of project.",
+							Description: "IdentityProviderConfig is the json configuration of the default identity provider.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"administrators": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Administrators are the administrators of the default identity provider.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -46941,208 +47264,267 @@ func schema_tke_api_business_v1_ProjectSpec(ref common.ReferenceCallback) common
 							},
 						},
 					},
-					"parentProjectName": {
+					"quota": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ParentProjectName indicates the superior project name of this service.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Quota limits the number of objects the tenant may own.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/auth/v1.TenantQuota"),
 						},
 					},
-					"clusters": {
+					"suspended": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Clusters represents clusters that can be used and the resource limits of each cluster.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/business/v1.HardQuantity"),
-									},
-								},
-							},
+							Description: "Suspended denies every request of the users of the tenant.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"tenantID", "members"},
+				Required: []string{"displayName"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/business/v1.HardQuantity"},
+			"tkestack.io/tke/api/auth/v1.TenantQuota"},
 	}
 }
 
-func schema_tke_api_business_v1_ProjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_TenantStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectStatus represents information about the status of a project.",
+				Description: "TenantStatus represents information about the status of a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"locked": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"phase": {
+					"used": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Used is the number of objects the tenant owns, refreshed periodically.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/auth/v1.TenantUsage"),
 						},
 					},
-					"clusters": {
+					"lastUsageTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Clusters represents clusters that have been used and the resource usage of each cluster.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/business/v1.UsedQuantity"),
-									},
-								},
-							},
+							Description: "LastUsageTime is the last time the usage is refreshed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"calculatedChildProjects": {
+					"deletion": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Deletion is the progress of the deletion per API group.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/auth/v1.TenantDeletionStatus"),
 									},
 								},
 							},
 						},
 					},
-					"calculatedNamespaces": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"cachedSpecClusters": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/auth/v1.TenantDeletionStatus", "tkestack.io/tke/api/auth/v1.TenantUsage"},
+	}
+}
+
+func schema_tke_api_auth_v1_TenantUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TenantUsage is the number of objects a tenant owns.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusters": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/business/v1.HardQuantity"),
-									},
-								},
-							},
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"cachedParent": {
+					"projects": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"lastTransitionTime": {
+					"registries": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The last time the condition transitioned from one status to another.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"reason": {
+					"apps": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+				},
+				Required: []string{"clusters", "projects", "registries", "apps"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_auth_v1_User(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "User is an object that contains the metadata about identify about tke local idp or third-party idp.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of identity in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/auth/v1.UserSpec"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/business/v1.HardQuantity", "tkestack.io/tke/api/business/v1.UsedQuantity"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/auth/v1.UserSpec"},
 	}
 }
 
-func schema_tke_api_business_v1_UsedQuantity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_UserList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "UsedQuantity is a straightforward wrapper of ResourceList.",
+				Description: "UserList is the whole list of all users.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"used": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of User.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										Ref:     ref("tkestack.io/tke/api/auth/v1.User"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/auth/v1.User"},
 	}
 }
 
-func schema_tke_api_logagent_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_auth_v1_UserSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMap holds configuration data for tke to consume.",
+				Description: "UserSpec is a description of an user.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Name must be unique in the same tenant.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"data": {
+					"email": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
-							Type:        []string{"object"},
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phoneNumber": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"extra": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
@@ -47155,84 +47537,84 @@ func schema_tke_api_logagent_v1_ConfigMap(ref common.ReferenceCallback) common.O
 							},
 						},
 					},
-					"binaryData": {
+				},
+				Required: []string{"id", "name"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_auth_v1_VerificationKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VerificationKey is the public key of a retired signing key.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"keyID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "byte",
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"publicKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"expiry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expiry is the time the last api key signed with the key expires, the key is removed after it.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"keyID", "publicKey", "expiry"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_logagent_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_CertificateAlert(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
+				Description: "CertificateAlert represents where the alerts of the certificates of the namespaces in a project are sent.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"channel": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Channel is the name of the notify channel the alerts are sent through.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Template is the name of the message template in the channel.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ConfigMaps.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/logagent/v1.ConfigMap"),
-									},
-								},
-							},
-						},
-					},
 				},
-				Required: []string{"items"},
+				Required: []string{"channel", "template"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/logagent/v1.ConfigMap"},
 	}
 }
 
-func schema_tke_api_logagent_v1_LogAgent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ChartGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogAgent is a manager to collect logs of workload.",
+				Description: "ChartGroup is an chart group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -47257,30 +47639,30 @@ func schema_tke_api_logagent_v1_LogAgent(ref common.ReferenceCallback) common.Op
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of LogCollector.",
+							Description: "Spec defines the desired identities of namespaces in this set.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/logagent/v1.LogAgentSpec"),
+							Ref:         ref("tkestack.io/tke/api/business/v1.ChartGroupSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/logagent/v1.LogAgentStatus"),
+							Ref:     ref("tkestack.io/tke/api/business/v1.ChartGroupStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/logagent/v1.LogAgentSpec", "tkestack.io/tke/api/logagent/v1.LogAgentStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.ChartGroupSpec", "tkestack.io/tke/api/business/v1.ChartGroupStatus"},
 	}
 }
 
-func schema_tke_api_logagent_v1_LogAgentList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ChartGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogAgentList is the whole list of all logagents which owned by a tenant.",
+				Description: "ChartGroupList is the whole list of all chart groups which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -47305,13 +47687,13 @@ func schema_tke_api_logagent_v1_LogAgentList(ref common.ReferenceCallback) commo
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of volume decorators.",
+							Description: "List of namespaces",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/logagent/v1.LogAgent"),
+										Ref:     ref("tkestack.io/tke/api/business/v1.ChartGroup"),
 									},
 								},
 							},
@@ -47322,138 +47704,173 @@ func schema_tke_api_logagent_v1_LogAgentList(ref common.ReferenceCallback) commo
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/logagent/v1.LogAgent"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.ChartGroup"},
 	}
 }
 
-func schema_tke_api_logagent_v1_LogAgentProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ChartGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogAgentProxyOptions is the query options to a kube-apiserver proxy call for LogAgent crd object.",
+				Description: "ChartGroupSpec represents an chart group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"finalizers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"namespace": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"name": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
+				Required: []string{"name", "tenantID"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_logagent_v1_LogAgentSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ChartGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogAgentSpec describes the attributes of a Logagent.",
+				Description: "ChartGroupStatus represents information about the status of an chart group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"clusterName": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "The last time the condition transitioned from one status to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"version": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"tenantID", "clusterName"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_logagent_v1_LogAgentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogAgentStatus is information about the current status of a Logagent.",
+				Description: "ConfigMap holds configuration data for tke to consume.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"phase": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the LogCollector of cluster.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is a brief CamelCase string that describes any failure.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"retryCount": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"lastReInitializingTimestamp": {
+					"binaryData": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "byte",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_tke_api_logagent_v1_LogEsDetection(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogEsDetection",
+				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -47470,137 +47887,164 @@ func schema_tke_api_logagent_v1_LogEsDetection(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
-					"scheme": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"ip": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"port": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"user": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"password": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Items is the list of ConfigMaps.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.ConfigMap"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.ConfigMap"},
 	}
 }
 
-func schema_tke_api_logagent_v1_LogFileContent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_FrozenNamespace(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogFileContent",
+				Description: "FrozenNamespace represents the workloads of a namespace scaled down when the project was frozen.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the name of the namespace object in the project.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"spec": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/logagent/v1.LogFileContentSpec"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"workloads": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workloads represents the replicas of the workloads before they were scaled down.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.FrozenWorkload"),
+									},
+								},
+							},
+						},
+					},
+					"frozen": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Frozen indicates all the workloads of the namespace have been scaled down.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"name", "clusterName", "namespace"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/logagent/v1.LogFileContentSpec"},
+			"tkestack.io/tke/api/business/v1.FrozenWorkload"},
 	}
 }
 
-func schema_tke_api_logagent_v1_LogFileContentSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_FrozenWorkload(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "FrozenWorkload represents the replicas of a workload before it was scaled down.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"clusterId": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"container": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"pod": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is the kind of the workload, Deployment, StatefulSet, DaemonSet, Job or CronJob, the replicas of a Job are its parallelism.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"start": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"length": {
+					"replicas": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"filepath": {
+				},
+				Required: []string{"kind", "name", "replicas"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_business_v1_HardQuantity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HardQuantity is a straightforward wrapper of ResourceList.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hard": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_tke_api_logagent_v1_LogFileProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ImageNamespace(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogFileProxyOptions is the query options to a kube-apiserver proxy call for LogFile requests.",
+				Description: "ImageNamespace is an image namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -47617,35 +48061,38 @@ func schema_tke_api_logagent_v1_LogFileProxyOptions(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"pod": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Spec defines the desired identities of namespaces in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/business/v1.ImageNamespaceSpec"),
 						},
 					},
-					"container": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/business/v1.ImageNamespaceStatus"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.ImageNamespaceSpec", "tkestack.io/tke/api/business/v1.ImageNamespaceStatus"},
 	}
 }
 
-func schema_tke_api_logagent_v1_LogFileTree(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ImageNamespaceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogFileTree",
+				Description: "ImageNamespaceList is the whole list of all image namespaces which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -47662,128 +48109,132 @@ func schema_tke_api_logagent_v1_LogFileTree(ref common.ReferenceCallback) common
 							Format:      "",
 						},
 					},
-					"spec": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/logagent/v1.LogFileTreeSpec"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of namespaces",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.ImageNamespace"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/logagent/v1.LogFileTreeSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.ImageNamespace"},
 	}
 }
 
-func schema_tke_api_logagent_v1_LogFileTreeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ImageNamespaceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ImageNamespaceSpec represents an image namespace.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"clusterId": {
+					"finalizers": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"namespace": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"container": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"pod": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
+				Required: []string{"name", "tenantID"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_mesh_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ImageNamespaceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMap holds configuration data for tke to consume.",
+				Description: "ImageNamespaceStatus represents information about the status of an image namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"metadata": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "The last time the condition transitioned from one status to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"data": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"binaryData": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "byte",
-									},
-								},
-							},
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_mesh_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_Namespace(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
+				Description: "Namespace is a namespace in cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -47803,86 +48254,87 @@ func schema_tke_api_mesh_v1_ConfigMapList(ref common.ReferenceCallback) common.O
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ConfigMaps.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/mesh/v1.ConfigMap"),
-									},
-								},
-							},
+							Description: "Spec defines the desired identities of namespaces in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/business/v1.NamespaceSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/business/v1.NamespaceStatus"),
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/mesh/v1.ConfigMap"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.NamespaceSpec", "tkestack.io/tke/api/business/v1.NamespaceStatus"},
 	}
 }
 
-func schema_tke_api_mesh_v1_DataBase(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_NamespaceCert(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Database describes the attributes of a MeshManager.",
+				Description: "NamespaceCert represents a x509 certificate of a namespace in project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"host": {
+					"certPem": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "byte",
 						},
 					},
-					"port": {
+					"keyPem": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Type:   []string{"string"},
+							Format: "byte",
 						},
 					},
-					"userName": {
+					"caCertPem": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "byte",
 						},
 					},
-					"password": {
+					"apiServer": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"dbName": {
+					"serialNumber": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"notAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NotAfter is the time the certificate expires.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"host", "port", "userName", "password", "dbName"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_mesh_v1_MeshManager(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_NamespaceCertOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MeshManager is a manager to manager mesh clusters.",
+				Description: "NamespaceCertOptions is the options of issuing a x509 certificate of a namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -47899,38 +48351,109 @@ func schema_tke_api_mesh_v1_MeshManager(ref common.ReferenceCallback) common.Ope
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"validDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pay attention to const CertOptionValiddays!",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_business_v1_NamespaceCertStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceCertStatus represents a x509 certificate issued for a user of a namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"serialNumber": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"validDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValidDays is how many days after the key was issued the certificate is renewed with the same key.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"notBefore": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"spec": {
+					"notAfter": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of MeshManager.",
+							Description: "NotAfter is the time the certificate expires.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/mesh/v1.MeshManagerSpec"),
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"status": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/mesh/v1.MeshManagerStatus"),
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"certPem": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertPem is the certificate, the private key is only returned when the certificate is issued and is never stored.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating why the certificate failed to be renewed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastNotifyTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the failure to renew the certificate was notified.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"renewUntil": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RenewUntil is the time the certificate stops being renewed.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"username", "serialNumber", "validDays"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/mesh/v1.MeshManagerSpec", "tkestack.io/tke/api/mesh/v1.MeshManagerStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_mesh_v1_MeshManagerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_NamespaceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MeshManagerList is the whole list of all meshmanagers which owned by a tenant.",
+				Description: "NamespaceList is the whole list of all namespaces which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -47955,13 +48478,13 @@ func schema_tke_api_mesh_v1_MeshManagerList(ref common.ReferenceCallback) common
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of volume decorators.",
+							Description: "List of namespaces",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/mesh/v1.MeshManager"),
+										Ref:     ref("tkestack.io/tke/api/business/v1.Namespace"),
 									},
 								},
 							},
@@ -47972,18 +48495,18 @@ func schema_tke_api_mesh_v1_MeshManagerList(ref common.ReferenceCallback) common
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/mesh/v1.MeshManager"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.Namespace"},
 	}
 }
 
-func schema_tke_api_mesh_v1_MeshManagerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_NamespaceQuotaNode(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MeshManagerSpec describes the attributes of a MeshManager.",
+				Description: "NamespaceQuotaNode is the quota of a namespace in the quota tree.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
@@ -47997,147 +48520,262 @@ func schema_tke_api_mesh_v1_MeshManagerSpec(ref common.ReferenceCallback) common
 							Format:  "",
 						},
 					},
-					"version": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"dataBase": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/mesh/v1.DataBase"),
-						},
-					},
-					"tracingStorageBackend": {
+					"hard": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/mesh/v1.StorageBackend"),
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
-					"metricStorageBackend": {
+					"used": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/mesh/v1.StorageBackend"),
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"tenantID", "clusterName", "dataBase", "tracingStorageBackend", "metricStorageBackend"},
+				Required: []string{"name", "clusterName"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/mesh/v1.DataBase", "tkestack.io/tke/api/mesh/v1.StorageBackend"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_tke_api_mesh_v1_MeshManagerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_NamespaceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MeshManagerStatus is information about the current status of a MeshManager.",
+				Description: "NamespaceSpec represents a namespace in cluster of a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"finalizers": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
+							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
 					},
-					"phase": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the MeshManager of cluster.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"reason": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is a brief CamelCase string that describes any failure.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"retryCount": {
+					"clusterType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"lastReInitializingTimestamp": {
+					"clusterVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"clusterDisplayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"hard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hard represents the total resources of a namespace.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"revokedCertificates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RevokedCertificates are the serial numbers of the certificates of the namespace to revoke, the revoked certificates are no longer renewed and expire within a day.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"tenantID", "clusterName", "clusterType", "clusterVersion", "clusterDisplayName", "namespace"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_tke_api_mesh_v1_StorageBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_NamespaceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StorageBackend describes the attributes of a backend storage StorageType can be \"influxdb\",\"elasticsearch\",\"es\",\"thanos\"",
+				Description: "NamespaceStatus represents information about the status of a namespace in project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"storageType": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"storageAddresses": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "The last time the condition transitioned from one status to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceQuotaName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used represents the resources of a namespace that are used.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
-					"queryAddress": {
+					"cachedSpecHard": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
-					"userName": {
+					"certificate": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Ref: ref("tkestack.io/tke/api/business/v1.NamespaceCert"),
 						},
 					},
-					"password": {
+					"templates": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Templates represents the sync status of the namespace templates of the project in the namespace.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.NamespaceTemplateSync"),
+									},
+								},
+							},
+						},
+					},
+					"certificates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Certificates represents the short-lived x509 certificates issued for the users of the namespace, which are renewed before they expire.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.NamespaceCertStatus"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"storageType", "storageAddresses"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/business/v1.NamespaceCert", "tkestack.io/tke/api/business/v1.NamespaceCertStatus", "tkestack.io/tke/api/business/v1.NamespaceTemplateSync"},
 	}
 }
 
-func schema_tke_api_monitor_v1_ClusterOverview(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_NamespaceTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterOverview defines the structure for querying clusters' overview data request and result.",
+				Description: "NamespaceTemplate is the default policies rendered into every namespace of a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -48160,530 +48798,600 @@ func schema_tke_api_monitor_v1_ClusterOverview(ref common.ReferenceCallback) com
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"result": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/monitor/v1.ClusterOverviewResult"),
+							Description: "Spec defines the policies rendered into the namespaces.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/business/v1.NamespaceTemplateSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.ClusterOverviewResult"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.NamespaceTemplateSpec"},
 	}
 }
 
-func schema_tke_api_monitor_v1_ClusterOverviewResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_NamespaceTemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "NamespaceTemplateList is the whole list of all namespace templates which owned by a project.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"clusterCount": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"clusterAbnormal": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"projectCount": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"projectAbnormal": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "List of namespace templates",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.NamespaceTemplate"),
+									},
+								},
+							},
 						},
 					},
-					"nodeCount": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.NamespaceTemplate"},
+	}
+}
+
+func schema_tke_api_business_v1_NamespaceTemplateNetworkPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceTemplateNetworkPolicy represents the default-deny network policy of the namespaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"denyIngress": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "DenyIngress denies all ingress traffic of the pods by default.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"nodeAbnormal": {
+					"denyEgress": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "DenyEgress denies all egress traffic of the pods by default.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"workloadCount": {
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_business_v1_NamespaceTemplatePodSecurity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceTemplatePodSecurity represents the Pod Security admission levels of the namespaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enforce": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Enforce is one of privileged, baseline and restricted, only the platform administrators may set privileged.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"workloadAbnormal": {
+					"audit": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"cpuCapacity": {
+					"warn": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"number"},
-							Format:  "double",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"cpuAllocatable": {
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_business_v1_NamespaceTemplateRoleBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceTemplateRoleBinding represents a role binding in the namespaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"number"},
-							Format:  "double",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"cpuNotReadyCapacity": {
+					"clusterRole": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"number"},
-							Format:  "double",
+							Description: "ClusterRole is the name of the cluster role bound in the namespaces, one of admin, edit and view.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"cpuNotReadyAllocatable": {
+					"subjects": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"number"},
-							Format:  "double",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.NamespaceTemplateSubject"),
+									},
+								},
+							},
 						},
 					},
-					"memCapacity": {
+				},
+				Required: []string{"name", "clusterRole"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/business/v1.NamespaceTemplateSubject"},
+	}
+}
+
+func schema_tke_api_business_v1_NamespaceTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceTemplateSpec represents the policies rendered into the namespaces of a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"memAllocatable": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"memNotReadyCapacity": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Description: "Labels are added to the namespaces on the cluster.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"memNotReadyAllocatable": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
+							Description: "Annotations are added to the namespaces on the cluster.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"podCount": {
+					"limitRanges": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "LimitRanges are created as a LimitRange named after the template.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LimitRangeItem"),
+									},
+								},
+							},
 						},
 					},
-					"clusters": {
+					"networkPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "NetworkPolicy creates a default-deny NetworkPolicy named after the template.",
+							Ref:         ref("tkestack.io/tke/api/business/v1.NamespaceTemplateNetworkPolicy"),
+						},
+					},
+					"roleBindings": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RoleBindings are created with names prefixed by the name of the template.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/monitor/v1.ClusterStatistic"),
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.NamespaceTemplateRoleBinding"),
 									},
 								},
 							},
 						},
 					},
+					"podSecurity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodSecurity sets the Pod Security admission levels of the namespaces.",
+							Ref:         ref("tkestack.io/tke/api/business/v1.NamespaceTemplatePodSecurity"),
+						},
+					},
 				},
-				Required: []string{"clusterCount", "clusterAbnormal", "projectCount", "projectAbnormal", "nodeCount", "nodeAbnormal", "workloadCount", "workloadAbnormal", "cpuCapacity", "cpuAllocatable", "cpuNotReadyCapacity", "cpuNotReadyAllocatable", "memCapacity", "memAllocatable", "memNotReadyCapacity", "memNotReadyAllocatable", "podCount", "clusters"},
+				Required: []string{"tenantID"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/monitor/v1.ClusterStatistic"},
+			"k8s.io/api/core/v1.LimitRangeItem", "tkestack.io/tke/api/business/v1.NamespaceTemplateNetworkPolicy", "tkestack.io/tke/api/business/v1.NamespaceTemplatePodSecurity", "tkestack.io/tke/api/business/v1.NamespaceTemplateRoleBinding"},
 	}
 }
 
-func schema_tke_api_monitor_v1_ClusterStatistic(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_NamespaceTemplateSubject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "NamespaceTemplateSubject represents a subject of a role binding.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"clusterID": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kind is one of User, Group and ServiceAccount.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"clusterDisplayName": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"tenantID": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Namespace must be empty, service accounts are always bound in the namespace of the role binding.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"clusterPhase": {
+				},
+				Required: []string{"kind", "name"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_business_v1_NamespaceTemplateSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceTemplateSync represents the sync status of a namespace template in a namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Name is the name of the namespace template.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nodeCount": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "ObservedGeneration is the generation of the namespace template last applied.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"nodeAbnormal": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"workloadCount": {
+					"lastSyncTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "The last time the namespace template was applied.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"workloadAbnormal": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "A human readable message indicating why the namespace template failed to be applied.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"hasMetricServer": {
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_business_v1_NsEmigration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NsEmigration is an namespace emigration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"cpuUsed": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"number"},
-							Format:  "double",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"cpuRequest": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"number"},
-							Format:  "double",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"cpuLimit": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"number"},
-							Format:  "double",
-						},
-					},
-					"cpuCapacity": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"number"},
-							Format:  "double",
+							Description: "Spec defines the desired identities of emigrations in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/business/v1.NsEmigrationSpec"),
 						},
 					},
-					"cpuAllocatable": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"number"},
-							Format:  "double",
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/business/v1.NsEmigrationStatus"),
 						},
 					},
-					"cpuNotReadyCapacity": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.NsEmigrationSpec", "tkestack.io/tke/api/business/v1.NsEmigrationStatus"},
+	}
+}
+
+func schema_tke_api_business_v1_NsEmigrationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NsEmigrationList is the whole list of all namespace emigrations which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"number"},
-							Format:  "double",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"cpuNotReadyAllocatable": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"number"},
-							Format:  "double",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"cpuRequestRate": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"cpuAllocatableRate": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "List of namespace emigrations",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.NsEmigration"),
+									},
+								},
+							},
 						},
 					},
-					"cpuUsage": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.NsEmigration"},
+	}
+}
+
+func schema_tke_api_business_v1_NsEmigrationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NsEmigrationSpec represents a namespace emigration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"memUsed": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
-					"memRequest": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
-					"memLimit": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
-					"memCapacity": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
-					"memAllocatable": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
-					"memNotReadyCapacity": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
-					"memNotReadyAllocatable": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int64",
-						},
-					},
-					"memRequestRate": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"memAllocatableRate": {
+					"nsShowName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"memUsage": {
+					"destination": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"podCount": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Mode is Project to move the namespace to another project, or Cluster to move the workloads of the namespace to another cluster in the project.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"schedulerHealthy": {
+					"destinationCluster": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Description: "DestinationCluster is the cluster the workloads are moved to in Cluster mode.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"controllerManagerHealthy": {
+					"transferQuota": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Description: "TransferQuota moves the quota of the namespace in the project from the source cluster to the destination cluster in Cluster mode, it requires CutOver.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"etcdHealthy": {
+					"cutOver": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
+							Description: "CutOver scales down the workloads in the source cluster and releases the source namespace after the workloads are moved in Cluster mode.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"clusterID", "clusterDisplayName", "tenantID", "clusterPhase", "nodeCount", "nodeAbnormal", "workloadCount", "workloadAbnormal", "hasMetricServer", "cpuUsed", "cpuRequest", "cpuLimit", "cpuCapacity", "cpuAllocatable", "cpuNotReadyCapacity", "cpuNotReadyAllocatable", "cpuRequestRate", "cpuAllocatableRate", "cpuUsage", "memUsed", "memRequest", "memLimit", "memCapacity", "memAllocatable", "memNotReadyCapacity", "memNotReadyAllocatable", "memRequestRate", "memAllocatableRate", "memUsage", "podCount", "schedulerHealthy", "controllerManagerHealthy", "etcdHealthy"},
+				Required: []string{"tenantID", "namespace", "nsShowName", "destination"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_monitor_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_NsEmigrationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMap holds configuration data for tke to consume.",
+				Description: "NsEmigrationStatus represents information about the status of a namespace emigration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"data": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"binaryData": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "byte",
-									},
-								},
-							},
+							Description: "The last time the condition transitioned from one status to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_tke_api_monitor_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "The reason for the condition's last transition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "A human readable message indicating details about the transition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"sourceCluster": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "SourceCluster is the cluster the workloads are moved from in Cluster mode.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"transferredQuota": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ConfigMaps.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "TransferredQuota is the quota added to the destination cluster of the project, recorded before it is added. It is released from the source cluster once the emigration finishes, or rolled back from the destination cluster if the emigration fails.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/monitor/v1.ConfigMap"),
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/monitor/v1.ConfigMap"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_monitor_v1_Metric(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_Platform(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Metric defines the structure for querying monitoring data requests and results.",
+				Description: "Platform is a platform in TKE.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -48706,31 +49414,26 @@ func schema_tke_api_monitor_v1_Metric(ref common.ReferenceCallback) common.OpenA
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"query": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/monitor/v1.MetricQuery"),
-						},
-					},
-					"jsonResult": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Spec defines the desired identities of platforms in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/business/v1.PlatformSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.MetricQuery"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.PlatformSpec"},
 	}
 }
 
-func schema_tke_api_monitor_v1_MetricList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_PlatformList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MetricList is the whole list of all metrics.",
+				Description: "PlatformList is the whole list of all platforms which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -48755,13 +49458,13 @@ func schema_tke_api_monitor_v1_MetricList(ref common.ReferenceCallback) common.O
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of metrics",
+							Description: "List of platform.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/monitor/v1.Metric"),
+										Ref:     ref("tkestack.io/tke/api/business/v1.Platform"),
 									},
 								},
 							},
@@ -48772,36 +49475,25 @@ func schema_tke_api_monitor_v1_MetricList(ref common.ReferenceCallback) common.O
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/monitor/v1.Metric"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.Platform"},
 	}
 }
 
-func schema_tke_api_monitor_v1_MetricQuery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_PlatformSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "PlatformSpec is a description of a platform.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"table": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"startTime": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
-						},
-					},
-					"endTime": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
-						},
-					},
-					"fields": {
+					"administrators": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -48815,35 +49507,54 @@ func schema_tke_api_monitor_v1_MetricQuery(ref common.ReferenceCallback) common.
 							},
 						},
 					},
-					"conditions": {
+				},
+				Required: []string{"tenantID", "administrators"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_business_v1_Portal(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Portal is a user in TKE.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/monitor/v1.MetricQueryCondition"),
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"orderBy": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"order": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"groupBy": {
+					"administrator": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Administrator indicates whether the user is a platform administrator",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"projects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Projects represents the list of projects to which the user belongs, where the key represents project name and the value represents the project display name.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -48854,68 +49565,65 @@ func schema_tke_api_monitor_v1_MetricQuery(ref common.ReferenceCallback) common.
 							},
 						},
 					},
-					"limit": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
-						},
-					},
-					"offset": {
+					"extension": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Extension is extension info. for projects.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.PortalProject"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"table", "fields", "conditions", "groupBy", "limit", "offset"},
+				Required: []string{"administrator", "projects", "extension"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/monitor/v1.MetricQueryCondition"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.PortalProject"},
 	}
 }
 
-func schema_tke_api_monitor_v1_MetricQueryCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_PortalProject(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "PortalProject is a project extension info for portal.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"key": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"expr": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Phases of projects.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"value": {
+					"parent": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Parents of projects.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"key", "expr", "value"},
+				Required: []string{"phase", "parent"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_monitor_v1_Prometheus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Prometheus is a kubernetes package manager.",
+				Description: "Project is a project in TKE.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -48940,30 +49648,86 @@ func schema_tke_api_monitor_v1_Prometheus(ref common.ReferenceCallback) common.O
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of clusters in this set.",
+							Description: "Spec defines the desired identities of project in this set.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/monitor/v1.PrometheusSpec"),
+							Ref:         ref("tkestack.io/tke/api/business/v1.ProjectSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/monitor/v1.PrometheusStatus"),
+							Ref:     ref("tkestack.io/tke/api/business/v1.ProjectStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.PrometheusSpec", "tkestack.io/tke/api/monitor/v1.PrometheusStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.ProjectSpec", "tkestack.io/tke/api/business/v1.ProjectStatus"},
 	}
 }
 
-func schema_tke_api_monitor_v1_PrometheusList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ProjectFreezeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PrometheusList is the whole list of all prometheus which owned by a tenant.",
+				Description: "ProjectFreezeStatus represents the state of the project saved when it was frozen, which is restored when it is unfrozen.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"locked": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Locked is the lock of the project before it was frozen.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces represents the namespaces of the project that have been frozen.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.FrozenNamespace"),
+									},
+								},
+							},
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the freeze transitioned from one phase to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the last failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/business/v1.FrozenNamespace"},
+	}
+}
+
+func schema_tke_api_business_v1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectList is the whole list of all projects which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -48988,13 +49752,13 @@ func schema_tke_api_monitor_v1_PrometheusList(ref common.ReferenceCallback) comm
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of Prometheuss",
+							Description: "List of projects",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/monitor/v1.Prometheus"),
+										Ref:     ref("tkestack.io/tke/api/business/v1.Project"),
 									},
 								},
 							},
@@ -49005,259 +49769,173 @@ func schema_tke_api_monitor_v1_PrometheusList(ref common.ReferenceCallback) comm
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/monitor/v1.Prometheus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.Project"},
 	}
 }
 
-func schema_tke_api_monitor_v1_PrometheusRemoteAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ProjectQuotaNode(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PrometheusRemoteAddr is the remote write/read address for prometheus",
+				Description: "ProjectQuotaNode is the quota of a project in the quota tree.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"writeAddr": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"readAddr": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_tke_api_monitor_v1_PrometheusSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "PrometheusSpec describes the attributes on a Prometheus.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"clusterName": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"version": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"subVersion": {
+					"hard": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubVersion is the components version such as node-exporter.",
+							Description: "Hard is the quota of the project.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.HardQuantity"),
 									},
 								},
 							},
 						},
 					},
-					"remoteAddress": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RemoteAddress is the remote address for prometheus when writing/reading outside of cluster.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/monitor/v1.PrometheusRemoteAddr"),
-						},
-					},
-					"notifyWebhook": {
+					"allocated": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NotifyWebhook is the address that alert messages send to, optional. If not set, a default webhook address \"https://[notify-api-address]/webhook\" will be used.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Allocated is the quota allocated to the child projects and namespaces.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.UsedQuantity"),
+									},
+								},
+							},
 						},
 					},
-					"resources": {
+					"borrowed": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources is the resource request and limit for prometheus",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/monitor/v1.ResourceRequirements"),
+							Description: "Borrowed is the quota allocated beyond the quota of the project, which is borrowed from the parent project.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.UsedQuantity"),
+									},
+								},
+							},
 						},
 					},
-					"runOnMaster": {
+					"used": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RunOnMaster indicates whether to add master Affinity for all monitor components or not",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Used is the quota used by the namespaces of the project and of all its descendants.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.UsedQuantity"),
+									},
+								},
+							},
 						},
 					},
-					"alertRepeatInterval": {
+					"namespaces": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AlertRepeatInterval indicates repeat interval of alerts",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.NamespaceQuotaNode"),
+									},
+								},
+							},
 						},
 					},
-					"withNPD": {
+					"children": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WithNPD indicates whether to deploy node-problem-detector or not",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.ProjectQuotaNode"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"tenantID", "clusterName"},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/monitor/v1.PrometheusRemoteAddr", "tkestack.io/tke/api/monitor/v1.ResourceRequirements"},
+			"tkestack.io/tke/api/business/v1.HardQuantity", "tkestack.io/tke/api/business/v1.NamespaceQuotaNode", "tkestack.io/tke/api/business/v1.ProjectQuotaNode", "tkestack.io/tke/api/business/v1.UsedQuantity"},
 	}
 }
 
-func schema_tke_api_monitor_v1_PrometheusStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ProjectQuotaTree(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PrometheusStatus is information about the current status of a Prometheus.",
+				Description: "ProjectQuotaTree shows the quota allocated and used at every level of the tree of a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"phase": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the helm of cluster.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"retryCount": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"lastReInitializingTimestamp": {
+					"root": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Description: "Root is the project the tree is requested for.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"subVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SubVersion is the components version such as node-exporter.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_tke_api_monitor_v1_ResourceRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ResourceRequirements describes the compute resource requirements.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"limits": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
-						},
-					},
-					"requests": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Ref:         ref("tkestack.io/tke/api/business/v1.ProjectQuotaNode"),
 						},
 					},
 				},
+				Required: []string{"root"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.ProjectQuotaNode"},
 	}
 }
 
-func schema_tke_api_notify_v1_Channel(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ProjectRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Channel represents a message transmission channel in TKE.",
+				Description: "ProjectRequest is a request of a user for a new project, or for more quota of an existing project, which is approved or rejected by the administrators.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -49282,30 +49960,29 @@ func schema_tke_api_notify_v1_Channel(ref common.ReferenceCallback) common.OpenA
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired channel.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/notify/v1.ChannelSpec"),
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/business/v1.ProjectRequestSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/notify/v1.ChannelStatus"),
+							Ref:     ref("tkestack.io/tke/api/business/v1.ProjectRequestStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.ChannelSpec", "tkestack.io/tke/api/notify/v1.ChannelStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.ProjectRequestSpec", "tkestack.io/tke/api/business/v1.ProjectRequestStatus"},
 	}
 }
 
-func schema_tke_api_notify_v1_ChannelList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ProjectRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChannelList is the whole list of all channels which owned by a tenant.",
+				Description: "ProjectRequestList is the whole list of all project requests.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -49330,13 +50007,13 @@ func schema_tke_api_notify_v1_ChannelList(ref common.ReferenceCallback) common.O
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of channels.",
+							Description: "List of project requests",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/notify/v1.Channel"),
+										Ref:     ref("tkestack.io/tke/api/business/v1.ProjectRequest"),
 									},
 								},
 							},
@@ -49347,129 +50024,110 @@ func schema_tke_api_notify_v1_ChannelList(ref common.ReferenceCallback) common.O
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Channel"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.ProjectRequest"},
 	}
 }
 
-func schema_tke_api_notify_v1_ChannelSMTP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ProjectRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChannelSMTP indicates a channel configuration for sending email notifications using the SMTP server.",
+				Description: "ProjectRequestSpec is a description of a project request.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"smtpHost": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"smtpPort": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
-						},
-					},
-					"tls": {
+					"requester": {
 						SchemaProps: spec.SchemaProps{
-							Default: false,
-							Type:    []string{"boolean"},
-							Format:  "",
-						},
-					},
-					"email": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Requester is the name of the user who made the request, it is set by the server.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"password": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-				},
-				Required: []string{"smtpHost", "smtpPort", "tls", "email", "password"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_notify_v1_ChannelSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ChannelSpec is a description of a channel.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"finalizers": {
+					"projectName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ProjectName is the name of the project to raise the quota of in Quota requests.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tenantID": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "DisplayName is the display name of the project created in Create requests.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"displayName": {
+					"parentProjectName": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "ParentProjectName is the parent of the project created in Create requests.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tencentCloudSMS": {
+					"clusters": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelTencentCloudSMS"),
+							Description: "Clusters is the quota of the project created in Create requests, or the quota added to the project in Quota requests.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.HardQuantity"),
+									},
+								},
+							},
 						},
 					},
-					"wechat": {
+					"justification": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelWechat"),
+							Description: "Justification tells the administrators why the project or the quota is needed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"smtp": {
+					"channel": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelSMTP"),
+							Description: "Channel is the name of the notify channel the requester and the administrators are informed through, nobody is informed if empty.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"webhook": {
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelWebhook"),
+							Description: "Template is the name of the message template in the channel.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"tenantID", "displayName"},
+				Required: []string{"tenantID", "type", "justification"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/notify/v1.ChannelSMTP", "tkestack.io/tke/api/notify/v1.ChannelTencentCloudSMS", "tkestack.io/tke/api/notify/v1.ChannelWebhook", "tkestack.io/tke/api/notify/v1.ChannelWechat"},
+			"tkestack.io/tke/api/business/v1.HardQuantity"},
 	}
 }
 
-func schema_tke_api_notify_v1_ChannelStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ProjectRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChannelStatus represents information about the status of a cluster.",
+				Description: "ProjectRequestStatus represents information about the status of a project request.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"phase": {
@@ -49478,144 +50136,96 @@ func schema_tke_api_notify_v1_ChannelStatus(ref common.ReferenceCallback) common
 							Format: "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_tke_api_notify_v1_ChannelTencentCloudSMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ChannelTencentCloudSMS indicates the channel configuration for sending messages using Tencent Cloud SMS Gateway. See: https://cloud.tencent.com/document/product/382/5976",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"appKey": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"sdkAppID": {
+					"approver": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Approver is the name of the administrator who approved or rejected the request.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"extend": {
+					"projectName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ProjectName is the name of the project created or updated by the request.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"appKey", "sdkAppID"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_notify_v1_ChannelWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ChannelWebhook indicates a channel configuration for sending notifications to the webhook server.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"url": {
+					"notifiedPhase": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "NotifiedPhase is the last phase the requester and the administrators were informed of.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"headers": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "The last time the condition transitioned from one status to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-				},
-				Required: []string{"url"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_notify_v1_ChannelWechat(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ChannelWechat indicates a channel configuration for sending template notifications using WeChat.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"appID": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AppID indicates the unique credentials of the third-party user. See https://mp.weixin.qq.com/wiki?t=resource/res_main&id=mp1421140183",
-							Default:     "",
+							Description: "The reason for the condition's last transition, such as the comment of the approver.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"appSecret": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"appID", "appSecret"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_notify_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMap holds configuration data for tke to consume.",
+				Description: "ProjectSpec is a description of a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"finalizers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"metadata": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"data": {
+					"members": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Users represents the user list of project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -49626,378 +50236,373 @@ func schema_tke_api_notify_v1_ConfigMap(ref common.ReferenceCallback) common.Ope
 							},
 						},
 					},
-					"binaryData": {
+					"parentProjectName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
+							Description: "ParentProjectName indicates the superior project name of this service.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusters": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Clusters represents clusters that can be used and the resource limits of each cluster.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "byte",
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.HardQuantity"),
 									},
 								},
 							},
 						},
 					},
+					"quotaBorrowing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuotaBorrowing lets the project allocate the idle quota of the parent project beyond its own quota, borrowing is disabled if nil.",
+							Ref:         ref("tkestack.io/tke/api/business/v1.QuotaBorrowing"),
+						},
+					},
+					"quotaAlert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuotaAlert notifies the members of the project when the quota usage of the project or its namespaces crosses the thresholds. The usage of the project in a cluster is the quota allocated to its child projects and namespaces, not the resources used in them.",
+							Ref:         ref("tkestack.io/tke/api/business/v1.QuotaAlert"),
+						},
+					},
+					"certificateAlert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateAlert notifies the members of the project when the certificates of its namespaces fail to be renewed.",
+							Ref:         ref("tkestack.io/tke/api/business/v1.CertificateAlert"),
+						},
+					},
+					"frozen": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Frozen scales the workloads of the namespaces of the project down to zero and stops new pods from running, they are restored once unfrozen.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"tenantID", "members"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"tkestack.io/tke/api/business/v1.CertificateAlert", "tkestack.io/tke/api/business/v1.HardQuantity", "tkestack.io/tke/api/business/v1.QuotaAlert", "tkestack.io/tke/api/business/v1.QuotaBorrowing"},
 	}
 }
 
-func schema_tke_api_notify_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_ProjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
+				Description: "ProjectStatus represents information about the status of a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"locked": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"apiVersion": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"metadata": {
+					"clusters": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Clusters represents clusters that have been used and the resource usage of each cluster.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.UsedQuantity"),
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"calculatedChildProjects": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ConfigMaps.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/notify/v1.ConfigMap"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.ConfigMap"},
-	}
-}
-
-func schema_tke_api_notify_v1_Message(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Message indicates a message in the notification system.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"calculatedNamespaces": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"cachedSpecClusters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.HardQuantity"),
+									},
+								},
+							},
 						},
 					},
-					"metadata": {
+					"cachedParent": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"spec": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired message.",
+							Description: "The last time the condition transitioned from one status to another.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/notify/v1.MessageSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/notify/v1.MessageStatus"),
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.MessageSpec", "tkestack.io/tke/api/notify/v1.MessageStatus"},
-	}
-}
-
-func schema_tke_api_notify_v1_MessageList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MessageList is the whole list of all message which owned by a tenant.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "The reason for the condition's last transition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "A human readable message indicating details about the transition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"quotaAlerts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of messages.",
+							Description: "QuotaAlerts represents the alerts sent for the quota usage that is still above the thresholds.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/notify/v1.Message"),
+										Ref:     ref("tkestack.io/tke/api/business/v1.QuotaAlertState"),
 									},
 								},
 							},
 						},
 					},
+					"freeze": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Freeze represents the progress of freezing or unfreezing the project, nil if the project is not frozen.",
+							Ref:         ref("tkestack.io/tke/api/business/v1.ProjectFreezeStatus"),
+						},
+					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Message"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/business/v1.HardQuantity", "tkestack.io/tke/api/business/v1.ProjectFreezeStatus", "tkestack.io/tke/api/business/v1.QuotaAlertState", "tkestack.io/tke/api/business/v1.UsedQuantity"},
 	}
 }
 
-func schema_tke_api_notify_v1_MessageRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_QuotaAlert(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MessageRequest represents a message sending request, which may include multiple recipients and multiple receiving groups.",
+				Description: "QuotaAlert represents the quota usage alert rules of a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"channel": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Channel is the name of the notify channel the alerts are sent through.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Template is the name of the message template in the channel.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired message.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/notify/v1.MessageRequestSpec"),
-						},
-					},
-					"status": {
+					"rules": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/notify/v1.MessageRequestStatus"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.QuotaAlertRule"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"channel", "template", "rules"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.MessageRequestSpec", "tkestack.io/tke/api/notify/v1.MessageRequestStatus"},
+			"tkestack.io/tke/api/business/v1.QuotaAlertRule"},
 	}
 }
 
-func schema_tke_api_notify_v1_MessageRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_QuotaAlertRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MessageRequestList is the whole list of all message which owned by a tenant.",
+				Description: "QuotaAlertRule represents the alert thresholds of a resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"resource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Resource is the name of the resource, such as requests.cpu, limits.memory or pods.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"thresholds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of message requests.",
+							Description: "Thresholds are the percentages of the quota to alert at, such as 80 and 95.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/notify/v1.MessageRequest"),
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"resource", "thresholds"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.MessageRequest"},
 	}
 }
 
-func schema_tke_api_notify_v1_MessageRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_QuotaAlertState(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MessageRequestSpec is a description of a message request.",
+				Description: "QuotaAlertState represents the highest threshold alerted of a resource in a cluster of the project or a namespace of the project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"templateName": {
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the name of the namespace, empty for the project.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resource": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"receivers": {
+					"threshold": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"receiverGroups": {
+					"lastNotifyTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "The last time the alert was sent.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"variables": {
+				},
+				Required: []string{"clusterName", "resource", "threshold"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_business_v1_QuotaBorrowing(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuotaBorrowing is the borrowing of quota from the parent project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"limits": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
+							Description: "Limits caps the quantity borrowed from the parent project per cluster and resource, the resources absent can not be borrowed.",
+							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/business/v1.HardQuantity"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"tenantID", "templateName"},
+				Required: []string{"limits"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/business/v1.HardQuantity"},
 	}
 }
 
-func schema_tke_api_notify_v1_MessageRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_business_v1_UsedQuantity(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MessageRequestStatus represents information about the status of a message request.",
+				Description: "UsedQuantity is a straightforward wrapper of ResourceList.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The last time the condition transitioned from one status to another.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"errors": {
+					"used": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Type:        []string{"object"},
+							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
@@ -50007,134 +50612,81 @@ func schema_tke_api_notify_v1_MessageRequestStatus(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_tke_api_notify_v1_MessageSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_logagent_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MessageSpec is a description of a message.",
+				Description: "ConfigMap holds configuration data for tke to consume.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"receiverName": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"receiverChannel": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"identity": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"username": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"header": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"body": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"channelMessageID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"alarmPolicyName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"alarmPolicyType": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"receiverChannelName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"clusterID": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-				},
-				Required: []string{"tenantID", "receiverName", "receiverChannel", "identity"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_notify_v1_MessageStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MessageStatus represents information about the status of a message.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"phase": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"lastTransitionTime": {
+					"binaryData": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The last time the condition transitioned from one status to another.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "byte",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_tke_api_notify_v1_Receiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_logagent_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Receiver indicates a message notification recipient, usually representing a user in the user system or a webhook service address.",
+				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -50154,29 +50706,37 @@ func schema_tke_api_notify_v1_Receiver(ref common.ReferenceCallback) common.Open
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"spec": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired receiver.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/notify/v1.ReceiverSpec"),
+							Description: "Items is the list of ConfigMaps.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/logagent/v1.ConfigMap"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.ReceiverSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/logagent/v1.ConfigMap"},
 	}
 }
 
-func schema_tke_api_notify_v1_ReceiverGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_logagent_v1_LogAgent(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReceiverGroup indicates multiple message recipients.",
+				Description: "LogAgent is a manager to collect logs of workload.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -50201,24 +50761,30 @@ func schema_tke_api_notify_v1_ReceiverGroup(ref common.ReferenceCallback) common
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired receiver group.",
+							Description: "Spec defines the desired identities of LogCollector.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/notify/v1.ReceiverGroupSpec"),
+							Ref:         ref("tkestack.io/tke/api/logagent/v1.LogAgentSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/logagent/v1.LogAgentStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.ReceiverGroupSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/logagent/v1.LogAgentSpec", "tkestack.io/tke/api/logagent/v1.LogAgentStatus"},
 	}
 }
 
-func schema_tke_api_notify_v1_ReceiverGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_logagent_v1_LogAgentList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReceiverGroupList is the whole list of all receiver which owned by a tenant.",
+				Description: "LogAgentList is the whole list of all logagents which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -50243,13 +50809,13 @@ func schema_tke_api_notify_v1_ReceiverGroupList(ref common.ReferenceCallback) co
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of receiver groups.",
+							Description: "List of volume decorators.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/notify/v1.ReceiverGroup"),
+										Ref:     ref("tkestack.io/tke/api/logagent/v1.LogAgent"),
 									},
 								},
 							},
@@ -50260,107 +50826,54 @@ func schema_tke_api_notify_v1_ReceiverGroupList(ref common.ReferenceCallback) co
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.ReceiverGroup"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/logagent/v1.LogAgent"},
 	}
 }
 
-func schema_tke_api_notify_v1_ReceiverGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_logagent_v1_LogAgentProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReceiverGroupSpec is a description of a receiver group.",
+				Description: "LogAgentProxyOptions is the query options to a kube-apiserver proxy call for LogAgent crd object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"displayName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"receivers": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-				},
-				Required: []string{"tenantID", "displayName"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_notify_v1_ReceiverList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ReceiverList is the whole list of all receiver which owned by a tenant.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of receivers.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/notify/v1.Receiver"),
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Receiver"},
 	}
 }
 
-func schema_tke_api_notify_v1_ReceiverSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_logagent_v1_LogAgentSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReceiverSpec is a description of a receiver.",
+				Description: "LogAgentSpec describes the attributes of a Logagent.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"tenantID": {
@@ -50370,89 +50883,81 @@ func schema_tke_api_notify_v1_ReceiverSpec(ref common.ReferenceCallback) common.
 							Format:  "",
 						},
 					},
-					"displayName": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"username": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"identities": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Identities represents the characteristics of the message recipient. The hash table key represents the message delivery channel id, and the value represents the user identification number in the channel. For example, if it is a short message sending channel, then the value is the user's mobile phone number; if it is a mail sending channel, then the value is the user's email address.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
 				},
-				Required: []string{"tenantID", "displayName"},
+				Required: []string{"tenantID", "clusterName"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_notify_v1_Template(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_logagent_v1_LogAgentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Template indicates the template used to send notifications under this channel.",
+				Description: "LogAgentStatus is information about the current status of a Logagent.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current lifecycle phase of the LogCollector of cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Reason is a brief CamelCase string that describes any failure.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"retryCount": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"spec": {
+					"lastReInitializingTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired template.",
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/notify/v1.TemplateSpec"),
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.TemplateSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_notify_v1_TemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_logagent_v1_LogEsDetection(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateList is the whole list of all template which owned by a channel.",
+				Description: "LogEsDetection",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -50469,322 +50974,254 @@ func schema_tke_api_notify_v1_TemplateList(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"scheme": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"items": {
+					"ip": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of templates.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/notify/v1.Template"),
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"user": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Template"},
 	}
 }
 
-func schema_tke_api_notify_v1_TemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_logagent_v1_LogFileContent(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateSpec is a description of a template.",
+				Description: "LogFileContent",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"displayName": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"keys": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"tencentCloudSMS": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateTencentCloudSMS"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"wechat": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateWechat"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"text": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateText"),
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/logagent/v1.LogFileContentSpec"),
 						},
 					},
 				},
-				Required: []string{"tenantID", "displayName"},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/notify/v1.TemplateTencentCloudSMS", "tkestack.io/tke/api/notify/v1.TemplateText", "tkestack.io/tke/api/notify/v1.TemplateWechat"},
+			"tkestack.io/tke/api/logagent/v1.LogFileContentSpec"},
 	}
 }
 
-func schema_tke_api_notify_v1_TemplateTencentCloudSMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_logagent_v1_LogFileContentSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateTencentCloudSMS indicates the template used when sending text messages using Tencent Cloud SMS Gateway. The template must be approved.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"templateID": {
+					"clusterId": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"sign": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"body": {
+					"container": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-				},
-				Required: []string{"templateID"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_notify_v1_TemplateText(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TemplateText indicates the template used to send notifications using other non-templated channels.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"body": {
+					"pod": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"header": {
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"length": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"filepath": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
-				Required: []string{"body"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_notify_v1_TemplateWechat(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_logagent_v1_LogFileProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateWechat indicates the template when sending a text message using the WeChat public account. The template must be approved and registered.",
+				Description: "LogFileProxyOptions is the query options to a kube-apiserver proxy call for LogFile requests.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"templateID": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TemplateID indicates the template id of the template message notification. See https://mp.weixin.qq.com/wiki?t=resource/res_main&id=mp1421140183",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"url": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL indicates the web address of the user who clicked the notification in WeChat.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"miniProgramAppID": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MiniProgramAppID indicates the unique identification number of the WeChat applet that the user clicked on the notification in WeChat.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"miniProgramPagePath": {
+					"pod": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"body": {
+					"container": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
-				Required: []string{"templateID"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_AddonSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_logagent_v1_LogFileTree(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AddonSpec describes the attributes on a Addon.",
+				Description: "LogFileTree",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"clusterName": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"version": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"tenantID", "clusterName"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_platform_v1_App(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"metadata": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/application/v1.AppSpec"),
+							Ref:     ref("tkestack.io/tke/api/logagent/v1.LogFileTreeSpec"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/application/v1.AppSpec"},
+			"tkestack.io/tke/api/logagent/v1.LogFileTreeSpec"},
 	}
 }
 
-func schema_tke_api_platform_v1_AuthzWebhookAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_logagent_v1_LogFileTreeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"builtin": {
+					"clusterId": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.BuiltinAuthzWebhookAddr"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"external": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.ExternalAuthzWebhookAddr"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.BuiltinAuthzWebhookAddr", "tkestack.io/tke/api/platform/v1.ExternalAuthzWebhookAddr"},
-	}
-}
-
-func schema_tke_api_platform_v1_BootstrapApp(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"app": {
+					"container": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/platform/v1.App"),
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"pod": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.App"},
-	}
-}
-
-func schema_tke_api_platform_v1_BuiltinAuthzWebhookAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-			},
-		},
 	}
 }
 
-func schema_tke_api_platform_v1_CSIOperator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_mesh_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CSIOperator is a operator to manages CSI external components.",
+				Description: "ConfigMap holds configuration data for tke to consume.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -50807,52 +51244,50 @@ func schema_tke_api_platform_v1_CSIOperator(ref common.ReferenceCallback) common
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"spec": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of storage operator.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/platform/v1.CSIOperatorSpec"),
+							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"status": {
+					"binaryData": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/platform/v1.CSIOperatorStatus"),
+							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "byte",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.CSIOperatorSpec", "tkestack.io/tke/api/platform/v1.CSIOperatorStatus"},
-	}
-}
-
-func schema_tke_api_platform_v1_CSIOperatorFeature(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"version": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-				},
-				Required: []string{"version"},
-			},
-		},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_tke_api_platform_v1_CSIOperatorList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_mesh_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CSIOperatorList is the whole list of all storage operators which owned by a tenant.",
+				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -50877,13 +51312,13 @@ func schema_tke_api_platform_v1_CSIOperatorList(ref common.ReferenceCallback) co
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of storage operators.",
+							Description: "Items is the list of ConfigMaps.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.CSIOperator"),
+										Ref:     ref("tkestack.io/tke/api/mesh/v1.ConfigMap"),
 									},
 								},
 							},
@@ -50894,107 +51329,112 @@ func schema_tke_api_platform_v1_CSIOperatorList(ref common.ReferenceCallback) co
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.CSIOperator"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/mesh/v1.ConfigMap"},
 	}
 }
 
-func schema_tke_api_platform_v1_CSIOperatorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_mesh_v1_DataBase(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CSIOperatorSpec describes the attributes of a storage operator.",
+				Description: "Database describes the attributes of a MeshManager.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"host": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"clusterName": {
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"userName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"version": {
+					"password": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version of the CSI operator.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"dbName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
 				},
-				Required: []string{"tenantID", "clusterName"},
+				Required: []string{"host", "port", "userName", "password", "dbName"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_CSIOperatorStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_mesh_v1_MeshManager(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CSIOperatorStatus is information about the current status of a storage operator.",
+				Description: "MeshManager is a manager to manager mesh clusters.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"storageVendorVersion": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "StorageVendorVersion will be set to the config version of the storage vendor.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phase": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the tapp controller of cluster.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is a brief CamelCase string that describes any failure.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"retryCount": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Spec defines the desired identities of MeshManager.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/mesh/v1.MeshManagerSpec"),
 						},
 					},
-					"lastReInitializingTimestamp": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/mesh/v1.MeshManagerStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/mesh/v1.MeshManagerSpec", "tkestack.io/tke/api/mesh/v1.MeshManagerStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_CSIProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_mesh_v1_MeshManagerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CSIProxyOptions is the query options to a kube-apiserver proxy call for CSI crd object.",
+				Description: "MeshManagerList is the whole list of all meshmanagers which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -51011,583 +51451,824 @@ func schema_tke_api_platform_v1_CSIProxyOptions(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"name": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "List of volume decorators.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/mesh/v1.MeshManager"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/mesh/v1.MeshManager"},
 	}
 }
 
-func schema_tke_api_platform_v1_Cluster(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_mesh_v1_MeshManagerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Cluster is a Kubernetes cluster in",
+				Description: "MeshManagerSpec describes the attributes of a MeshManager.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"apiVersion": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"metadata": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"spec": {
+					"dataBase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of clusters in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/platform/v1.ClusterSpec"),
+							Ref: ref("tkestack.io/tke/api/mesh/v1.DataBase"),
 						},
 					},
-					"status": {
+					"tracingStorageBackend": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterStatus"),
+							Ref: ref("tkestack.io/tke/api/mesh/v1.StorageBackend"),
+						},
+					},
+					"metricStorageBackend": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/mesh/v1.StorageBackend"),
 						},
 					},
 				},
+				Required: []string{"tenantID", "clusterName", "dataBase", "tracingStorageBackend", "metricStorageBackend"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.ClusterSpec", "tkestack.io/tke/api/platform/v1.ClusterStatus"},
+			"tkestack.io/tke/api/mesh/v1.DataBase", "tkestack.io/tke/api/mesh/v1.StorageBackend"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterAddon(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_mesh_v1_MeshManagerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAddon contains the Addon component for the current kubernetes cluster",
+				Description: "MeshManagerStatus is information about the current status of a MeshManager.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"apiVersion": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Phase is the current lifecycle phase of the MeshManager of cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"retryCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of addons in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/platform/v1.ClusterAddonSpec"),
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"status": {
+					"lastReInitializingTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterAddonStatus"),
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.ClusterAddonSpec", "tkestack.io/tke/api/platform/v1.ClusterAddonStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterAddonList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_mesh_v1_StorageBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAddonList is the whole list of all ClusterAddon.",
+				Description: "StorageBackend describes the attributes of a backend storage StorageType can be \"influxdb\",\"elasticsearch\",\"es\",\"thanos\"",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
+					"storageType": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"items": {
+					"storageAddresses": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of ClusterAddon",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterAddon"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterAddon"},
-	}
-}
-
-func schema_tke_api_platform_v1_ClusterAddonSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAddonSpec indicates the specifications of the ClusterAddon.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
+					"queryAddress": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Addon type, one of PersistentEvent or LogCollector etc.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"level": {
+					"userName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AddonLevel is level of cluster addon.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"version": {
+					"password": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
 				},
-				Required: []string{"type", "level", "version"},
+				Required: []string{"storageType", "storageAddresses"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterAddonStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_ClusterOverview(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAddonStatus is information about the current status of a ClusterAddon.",
+				Description: "ClusterOverview defines the structure for querying clusters' overview data request and result.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"phase": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the addon of cluster.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is a brief CamelCase string that describes any failure.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"result": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/monitor/v1.ClusterOverviewResult"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.ClusterOverviewResult"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterAddonType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_ClusterOverviewResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAddonType records the all addons of cluster available.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"clusterCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"apiVersion": {
+					"clusterAbnormal": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"metadata": {
+					"projectCount": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"type": {
+					"projectAbnormal": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Addon type, one of Helm, PersistentEvent or LogCollector etc.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"level": {
+					"nodeCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AddonLevel is level of cluster addon.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"latestVersion": {
+					"nodeAbnormal": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LatestVersion is latest version of the addon.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"description": {
+					"workloadCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description is desc of the addon.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"compatibleClusterType": {
+					"workloadAbnormal": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-				},
-				Required: []string{"type", "level", "latestVersion"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_tke_api_platform_v1_ClusterAddonTypeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAddonTypeList is a resource containing a list of ClusterAddonType objects.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"cpuCapacity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
 						},
 					},
-					"apiVersion": {
+					"cpuAllocatable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
 						},
 					},
-					"metadata": {
+					"cpuNotReadyCapacity": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
 						},
 					},
-					"items": {
+					"cpuNotReadyAllocatable": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterAddonType"),
-									},
-								},
-							},
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterAddonType"},
-	}
-}
-
-func schema_tke_api_platform_v1_ClusterAddress(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAddress contains information for the cluster's address.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
+					"memCapacity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Cluster address type, one of Public, ExternalIP or InternalIP.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
-					"host": {
+					"memAllocatable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The cluster address.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
 						},
 					},
-					"port": {
+					"memNotReadyCapacity": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"memNotReadyAllocatable": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"podCount": {
 						SchemaProps: spec.SchemaProps{
 							Default: 0,
 							Type:    []string{"integer"},
 							Format:  "int32",
 						},
 					},
-					"path": {
+					"clusters": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/monitor/v1.ClusterStatistic"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"type", "host", "port", "path"},
+				Required: []string{"clusterCount", "clusterAbnormal", "projectCount", "projectAbnormal", "nodeCount", "nodeAbnormal", "workloadCount", "workloadAbnormal", "cpuCapacity", "cpuAllocatable", "cpuNotReadyCapacity", "cpuNotReadyAllocatable", "memCapacity", "memAllocatable", "memNotReadyCapacity", "memNotReadyAllocatable", "podCount", "clusters"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/monitor/v1.ClusterStatistic"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterApplyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_ClusterStatistic(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterApplyOptions is the query options to a kube-apiserver proxy call for cluster object.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"clusterID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"apiVersion": {
+					"clusterDisplayName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"notUpdate": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_tke_api_platform_v1_ClusterComponent(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ClusterComponent records the number of copies of each component of the cluster master.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
+					"clusterPhase": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"replicas": {
+					"nodeCount": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterComponentReplicas"),
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-				},
-				Required: []string{"type", "replicas"},
-			},
-		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.ClusterComponentReplicas"},
-	}
-}
-
-func schema_tke_api_platform_v1_ClusterComponentReplicas(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ClusterComponentReplicas records the number of copies of each state of each component of the cluster master.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"desired": {
+					"nodeAbnormal": {
 						SchemaProps: spec.SchemaProps{
 							Default: 0,
 							Type:    []string{"integer"},
 							Format:  "int32",
 						},
 					},
-					"current": {
+					"workloadCount": {
 						SchemaProps: spec.SchemaProps{
 							Default: 0,
 							Type:    []string{"integer"},
 							Format:  "int32",
 						},
 					},
-					"available": {
+					"workloadAbnormal": {
 						SchemaProps: spec.SchemaProps{
 							Default: 0,
 							Type:    []string{"integer"},
 							Format:  "int32",
 						},
 					},
-					"updated": {
+					"hasMetricServer": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"cpuUsed": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"cpuRequest": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"cpuLimit": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"cpuCapacity": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"cpuAllocatable": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"cpuNotReadyCapacity": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"cpuNotReadyAllocatable": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"cpuRequestRate": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"cpuAllocatableRate": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"cpuUsage": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"memUsed": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"memRequest": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"memLimit": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"memCapacity": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"memAllocatable": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"memNotReadyCapacity": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"memNotReadyAllocatable": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int64",
+						},
+					},
+					"memRequestRate": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"memAllocatableRate": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"memUsage": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"podCount": {
 						SchemaProps: spec.SchemaProps{
 							Default: 0,
 							Type:    []string{"integer"},
 							Format:  "int32",
 						},
 					},
+					"schedulerHealthy": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"controllerManagerHealthy": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"etcdHealthy": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
 				},
-				Required: []string{"desired", "current", "available", "updated"},
+				Required: []string{"clusterID", "clusterDisplayName", "tenantID", "clusterPhase", "nodeCount", "nodeAbnormal", "workloadCount", "workloadAbnormal", "hasMetricServer", "cpuUsed", "cpuRequest", "cpuLimit", "cpuCapacity", "cpuAllocatable", "cpuNotReadyCapacity", "cpuNotReadyAllocatable", "cpuRequestRate", "cpuAllocatableRate", "cpuUsage", "memUsed", "memRequest", "memLimit", "memCapacity", "memAllocatable", "memNotReadyCapacity", "memNotReadyAllocatable", "memRequestRate", "memAllocatableRate", "memUsage", "podCount", "schedulerHealthy", "controllerManagerHealthy", "etcdHealthy"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_ClusterUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterCondition contains details for the current condition of this cluster.",
+				Description: "ClusterUsage describes the allocation and usage of a project in a cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the condition.",
-							Default:     "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"hard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hard is the quota of the project in the cluster.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"allocated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allocated is the sum of the quota of the namespaces of the project in the cluster.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used is the sum of the usage of the namespaces of the project in the cluster.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/monitor/v1.NamespaceUsage"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"clusterName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "tkestack.io/tke/api/monitor/v1.NamespaceUsage"},
+	}
+}
+
+func schema_tke_api_monitor_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMap holds configuration data for tke to consume.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status is the status of the condition. Can be True, False, Unknown.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastProbeTime": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time we probed the condition.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"lastTransitionTime": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"reason": {
+					"binaryData": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Unique, one-word, CamelCase reason for the condition's last transition.",
+							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "byte",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_tke_api_monitor_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Human-readable message indicating details about last transition.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ConfigMaps.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/monitor/v1.ConfigMap"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"type", "status"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/monitor/v1.ConfigMap"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterCredential(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_Metric(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterCredential records the credential information needed to access the cluster.",
+				Description: "Metric defines the structure for querying monitoring data requests and results.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -51610,152 +52291,4847 @@ func schema_tke_api_platform_v1_ClusterCredential(ref common.ReferenceCallback)
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"tenantID": {
+					"query": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/monitor/v1.MetricQuery"),
 						},
 					},
-					"clusterName": {
+					"jsonResult": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"etcdCACert": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.MetricQuery"},
+	}
+}
+
+func schema_tke_api_monitor_v1_MetricList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MetricList is the whole list of all metrics.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For TKE in global reuse",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
-							Format:      "byte",
+							Format:      "",
 						},
 					},
-					"etcdCAKey": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"etcdAPIClientCert": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"etcdAPIClientKey": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Description: "List of metrics",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/monitor/v1.Metric"),
+									},
+								},
+							},
 						},
 					},
-					"caCert": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/monitor/v1.Metric"},
+	}
+}
+
+func schema_tke_api_monitor_v1_MetricQuery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"table": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For connect the cluster",
-							Type:        []string{"string"},
-							Format:      "byte",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"caKey": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"clientCert": {
+					"endTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For kube-apiserver X509 auth",
-							Type:        []string{"string"},
-							Format:      "byte",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"clientKey": {
+					"fields": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For kube-apiserver X509 auth",
-							Type:        []string{"string"},
-							Format:      "byte",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"token": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For kube-apiserver token auth",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/monitor/v1.MetricQueryCondition"),
+									},
+								},
+							},
 						},
 					},
-					"bootstrapToken": {
+					"orderBy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For kubeadm init or join",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"certificateKey": {
+					"order": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For kubeadm init or join",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"username": {
+					"groupBy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Username is the username for basic authentication to the kubernetes cluster.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"as": {
+					"limit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Impersonate is the username to act-as.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"offset": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+				},
+				Required: []string{"table", "fields", "conditions", "groupBy", "limit", "offset"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/monitor/v1.MetricQueryCondition"},
+	}
+}
+
+func schema_tke_api_monitor_v1_MetricQueryCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"expr": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"key", "expr", "value"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_monitor_v1_NamespaceUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceUsage describes the allocation and usage of a namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"allocated": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"namespace"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_tke_api_monitor_v1_ProjectUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectUsage is a periodic snapshot of the allocation and usage of a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/monitor/v1.ProjectUsageSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.ProjectUsageSpec"},
+	}
+}
+
+func schema_tke_api_monitor_v1_ProjectUsageList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectUsageList is the whole list of all project usage snapshots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of project usage snapshots",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/monitor/v1.ProjectUsage"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/monitor/v1.ProjectUsage"},
+	}
+}
+
+func schema_tke_api_monitor_v1_ProjectUsageSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectUsageSpec describes the allocation and usage of a project at a time.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"projectName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timestamp is the time the snapshot was taken.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"period": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Period is the time the snapshot stands for until the next snapshot. The hourly snapshots older than a week are rolled up into daily snapshots.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"clusters": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/monitor/v1.ClusterUsage"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"tenantID", "projectName", "timestamp", "period"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/monitor/v1.ClusterUsage"},
+	}
+}
+
+func schema_tke_api_monitor_v1_Prometheus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Prometheus is a kubernetes package manager.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of clusters in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.PrometheusSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/monitor/v1.PrometheusStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.PrometheusSpec", "tkestack.io/tke/api/monitor/v1.PrometheusStatus"},
+	}
+}
+
+func schema_tke_api_monitor_v1_PrometheusList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrometheusList is the whole list of all prometheus which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of Prometheuss",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/monitor/v1.Prometheus"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/monitor/v1.Prometheus"},
+	}
+}
+
+func schema_tke_api_monitor_v1_PrometheusRemoteAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrometheusRemoteAddr is the remote write/read address for prometheus",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"writeAddr": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"readAddr": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_monitor_v1_PrometheusSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrometheusSpec describes the attributes on a Prometheus.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"subVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubVersion is the components version such as node-exporter.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"remoteAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemoteAddress is the remote address for prometheus when writing/reading outside of cluster.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.PrometheusRemoteAddr"),
+						},
+					},
+					"notifyWebhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NotifyWebhook is the address that alert messages send to, optional. If not set, a default webhook address \"https://[notify-api-address]/webhook\" will be used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources is the resource request and limit for prometheus",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.ResourceRequirements"),
+						},
+					},
+					"runOnMaster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunOnMaster indicates whether to add master Affinity for all monitor components or not",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"alertRepeatInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AlertRepeatInterval indicates repeat interval of alerts",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"withNPD": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WithNPD indicates whether to deploy node-problem-detector or not",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/monitor/v1.PrometheusRemoteAddr", "tkestack.io/tke/api/monitor/v1.ResourceRequirements"},
+	}
+}
+
+func schema_tke_api_monitor_v1_PrometheusStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrometheusStatus is information about the current status of a Prometheus.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current lifecycle phase of the helm of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastReInitializingTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"subVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubVersion is the components version such as node-exporter.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_monitor_v1_ResourceRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceRequirements describes the compute resource requirements.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"limits": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"requests": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_tke_api_monitor_v1_UsageReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UsageReport defines the structure for querying the chargeback report aggregated from the project usage snapshots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"query": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/monitor/v1.UsageReportQuery"),
+						},
+					},
+					"result": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/monitor/v1.UsageReportResult"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.UsageReportQuery", "tkestack.io/tke/api/monitor/v1.UsageReportResult"},
+	}
+}
+
+func schema_tke_api_monitor_v1_UsageReportItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UsageReportItem is the aggregated usage of a project, a cluster or a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the project, the cluster or the tenant.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/monitor/v1.UsageReportResource"),
+									},
+								},
+							},
+						},
+					},
+					"cost": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+				},
+				Required: []string{"name", "cost"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/monitor/v1.UsageReportResource"},
+	}
+}
+
+func schema_tke_api_monitor_v1_UsageReportList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UsageReportList is the whole list of all usage reports.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of usage reports",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/monitor/v1.UsageReport"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/monitor/v1.UsageReport"},
+	}
+}
+
+func schema_tke_api_monitor_v1_UsageReportQuery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UsageReportQuery describes the time range and the aggregation of a usage report.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"groupBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GroupBy is one of Project, Cluster and Tenant, defaults to Project.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"projectName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProjectName limits the report to a project.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterName limits the report to a cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"chargeBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ChargeBy is Allocated to charge the quota allocated to the namespaces, or Used to charge the usage, defaults to Allocated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"prices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prices overrides the configured unit prices, which are the prices of a unit of the resources per hour. The unit is a core for cpu, a GiB for memory and storage, and one for the others.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"format": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Format is JSON or CSV, the report is exported into the result in CSV format as well if CSV.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"startTime", "endTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_monitor_v1_UsageReportResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UsageReportResource is the aggregated usage of a resource in unit hours.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"allocatedHours": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"usedHours": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"price": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+					"cost": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"number"},
+							Format:  "double",
+						},
+					},
+				},
+				Required: []string{"resource", "allocatedHours", "usedHours", "price", "cost"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_monitor_v1_UsageReportResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UsageReportResult is the aggregated usage of a usage report.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/monitor/v1.UsageReportItem"),
+									},
+								},
+							},
+						},
+					},
+					"totalCost": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalCost is the sum of the cost of the items.",
+							Default:     0,
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"csv": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CSV is the report in CSV format.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"totalCost"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/monitor/v1.UsageReportItem"},
+	}
+}
+
+func schema_tke_api_notify_v1_Channel(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Channel represents a message transmission channel in TKE.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired channel.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/notify/v1.ChannelSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/notify/v1.ChannelStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.ChannelSpec", "tkestack.io/tke/api/notify/v1.ChannelStatus"},
+	}
+}
+
+func schema_tke_api_notify_v1_ChannelList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChannelList is the whole list of all channels which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of channels.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/notify/v1.Channel"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Channel"},
+	}
+}
+
+func schema_tke_api_notify_v1_ChannelSMTP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChannelSMTP indicates a channel configuration for sending email notifications using the SMTP server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"smtpHost": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"smtpPort": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Default: false,
+							Type:    []string{"boolean"},
+							Format:  "",
+						},
+					},
+					"email": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"smtpHost", "smtpPort", "tls", "email", "password"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_ChannelSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChannelSpec is a description of a channel.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"finalizers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"tencentCloudSMS": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelTencentCloudSMS"),
+						},
+					},
+					"wechat": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelWechat"),
+						},
+					},
+					"smtp": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelSMTP"),
+						},
+					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelWebhook"),
+						},
+					},
+				},
+				Required: []string{"tenantID", "displayName"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/notify/v1.ChannelSMTP", "tkestack.io/tke/api/notify/v1.ChannelTencentCloudSMS", "tkestack.io/tke/api/notify/v1.ChannelWebhook", "tkestack.io/tke/api/notify/v1.ChannelWechat"},
+	}
+}
+
+func schema_tke_api_notify_v1_ChannelStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChannelStatus represents information about the status of a cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_ChannelTencentCloudSMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChannelTencentCloudSMS indicates the channel configuration for sending messages using Tencent Cloud SMS Gateway. See: https://cloud.tencent.com/document/product/382/5976",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"appKey": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"sdkAppID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"extend": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"appKey", "sdkAppID"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_ChannelWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChannelWebhook indicates a channel configuration for sending notifications to the webhook server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"url"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_ChannelWechat(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChannelWechat indicates a channel configuration for sending template notifications using WeChat.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"appID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppID indicates the unique credentials of the third-party user. See https://mp.weixin.qq.com/wiki?t=resource/res_main&id=mp1421140183",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"appSecret": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"appID", "appSecret"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMap holds configuration data for tke to consume.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"binaryData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "byte",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_tke_api_notify_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ConfigMaps.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/notify/v1.ConfigMap"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.ConfigMap"},
+	}
+}
+
+func schema_tke_api_notify_v1_Message(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Message indicates a message in the notification system.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired message.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/notify/v1.MessageSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/notify/v1.MessageStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.MessageSpec", "tkestack.io/tke/api/notify/v1.MessageStatus"},
+	}
+}
+
+func schema_tke_api_notify_v1_MessageList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MessageList is the whole list of all message which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of messages.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/notify/v1.Message"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Message"},
+	}
+}
+
+func schema_tke_api_notify_v1_MessageRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MessageRequest represents a message sending request, which may include multiple recipients and multiple receiving groups.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired message.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/notify/v1.MessageRequestSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/notify/v1.MessageRequestStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.MessageRequestSpec", "tkestack.io/tke/api/notify/v1.MessageRequestStatus"},
+	}
+}
+
+func schema_tke_api_notify_v1_MessageRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MessageRequestList is the whole list of all message which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of message requests.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/notify/v1.MessageRequest"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.MessageRequest"},
+	}
+}
+
+func schema_tke_api_notify_v1_MessageRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MessageRequestSpec is a description of a message request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"templateName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"receivers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"receiverGroups": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"variables": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"tenantID", "templateName"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_MessageRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MessageRequestStatus represents information about the status of a message request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the condition transitioned from one status to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"errors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_notify_v1_MessageSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MessageSpec is a description of a message.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"receiverName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"receiverChannel": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"identity": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"header": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"channelMessageID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"alarmPolicyName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"alarmPolicyType": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"receiverChannelName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"tenantID", "receiverName", "receiverChannel", "identity"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_MessageStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MessageStatus represents information about the status of a message.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the condition transitioned from one status to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_notify_v1_Receiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Receiver indicates a message notification recipient, usually representing a user in the user system or a webhook service address.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired receiver.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/notify/v1.ReceiverSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.ReceiverSpec"},
+	}
+}
+
+func schema_tke_api_notify_v1_ReceiverGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReceiverGroup indicates multiple message recipients.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired receiver group.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/notify/v1.ReceiverGroupSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.ReceiverGroupSpec"},
+	}
+}
+
+func schema_tke_api_notify_v1_ReceiverGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReceiverGroupList is the whole list of all receiver which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of receiver groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/notify/v1.ReceiverGroup"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.ReceiverGroup"},
+	}
+}
+
+func schema_tke_api_notify_v1_ReceiverGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReceiverGroupSpec is a description of a receiver group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"receivers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"tenantID", "displayName"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_ReceiverList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReceiverList is the whole list of all receiver which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of receivers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/notify/v1.Receiver"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Receiver"},
+	}
+}
+
+func schema_tke_api_notify_v1_ReceiverSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReceiverSpec is a description of a receiver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"identities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Identities represents the characteristics of the message recipient. The hash table key represents the message delivery channel id, and the value represents the user identification number in the channel. For example, if it is a short message sending channel, then the value is the user's mobile phone number; if it is a mail sending channel, then the value is the user's email address.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"tenantID", "displayName"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_Template(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Template indicates the template used to send notifications under this channel.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired template.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/notify/v1.TemplateSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.TemplateSpec"},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateList is the whole list of all template which owned by a channel.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of templates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/notify/v1.Template"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Template"},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateSpec is a description of a template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"keys": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tencentCloudSMS": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateTencentCloudSMS"),
+						},
+					},
+					"wechat": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateWechat"),
+						},
+					},
+					"text": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateText"),
+						},
+					},
+				},
+				Required: []string{"tenantID", "displayName"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/notify/v1.TemplateTencentCloudSMS", "tkestack.io/tke/api/notify/v1.TemplateText", "tkestack.io/tke/api/notify/v1.TemplateWechat"},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateTencentCloudSMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateTencentCloudSMS indicates the template used when sending text messages using Tencent Cloud SMS Gateway. The template must be approved.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"templateID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"sign": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"templateID"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateText(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateText indicates the template used to send notifications using other non-templated channels.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"header": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"body"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateWechat(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateWechat indicates the template when sending a text message using the WeChat public account. The template must be approved and registered.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"templateID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateID indicates the template id of the template message notification. See https://mp.weixin.qq.com/wiki?t=resource/res_main&id=mp1421140183",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL indicates the web address of the user who clicked the notification in WeChat.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"miniProgramAppID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MiniProgramAppID indicates the unique identification number of the WeChat applet that the user clicked on the notification in WeChat.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"miniProgramPagePath": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"templateID"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_AddonSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AddonSpec describes the attributes on a Addon.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_App(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/application/v1.AppSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/application/v1.AppSpec"},
+	}
+}
+
+func schema_tke_api_platform_v1_AuthzWebhookAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"builtin": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.BuiltinAuthzWebhookAddr"),
+						},
+					},
+					"external": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.ExternalAuthzWebhookAddr"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.BuiltinAuthzWebhookAddr", "tkestack.io/tke/api/platform/v1.ExternalAuthzWebhookAddr"},
+	}
+}
+
+func schema_tke_api_platform_v1_BootstrapApp(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"app": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/platform/v1.App"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.App"},
+	}
+}
+
+func schema_tke_api_platform_v1_BuiltinAuthzWebhookAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_CSIOperator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CSIOperator is a operator to manages CSI external components.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of storage operator.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/platform/v1.CSIOperatorSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/platform/v1.CSIOperatorStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.CSIOperatorSpec", "tkestack.io/tke/api/platform/v1.CSIOperatorStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_CSIOperatorFeature(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"version"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_CSIOperatorList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CSIOperatorList is the whole list of all storage operators which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of storage operators.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.CSIOperator"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.CSIOperator"},
+	}
+}
+
+func schema_tke_api_platform_v1_CSIOperatorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CSIOperatorSpec describes the attributes of a storage operator.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version of the CSI operator.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_CSIOperatorStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CSIOperatorStatus is information about the current status of a storage operator.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"storageVendorVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageVendorVersion will be set to the config version of the storage vendor.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current lifecycle phase of the tapp controller of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastReInitializingTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_CSIProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CSIProxyOptions is the query options to a kube-apiserver proxy call for CSI crd object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_Cluster(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Cluster is a Kubernetes cluster in",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of clusters in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ClusterSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.ClusterSpec", "tkestack.io/tke/api/platform/v1.ClusterStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAddon(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAddon contains the Addon component for the current kubernetes cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of addons in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ClusterAddonSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterAddonStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.ClusterAddonSpec", "tkestack.io/tke/api/platform/v1.ClusterAddonStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAddonList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAddonList is the whole list of all ClusterAddon.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of ClusterAddon",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterAddon"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterAddon"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAddonSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAddonSpec indicates the specifications of the ClusterAddon.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Addon type, one of PersistentEvent or LogCollector etc.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"level": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddonLevel is level of cluster addon.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "level", "version"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAddonStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAddonStatus is information about the current status of a ClusterAddon.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current lifecycle phase of the addon of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAddonType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAddonType records the all addons of cluster available.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Addon type, one of Helm, PersistentEvent or LogCollector etc.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"level": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddonLevel is level of cluster addon.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"latestVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LatestVersion is latest version of the addon.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is desc of the addon.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"compatibleClusterType": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"type", "level", "latestVersion"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAddonTypeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAddonTypeList is a resource containing a list of ClusterAddonType objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterAddonType"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterAddonType"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAddress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAddress contains information for the cluster's address.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cluster address type, one of Public, ExternalIP or InternalIP.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The cluster address.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+				Required: []string{"type", "host", "port", "path"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterApplyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterApplyOptions is the query options to a kube-apiserver proxy call for cluster object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"notUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterComponent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterComponent records the number of copies of each component of the cluster master.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterComponentReplicas"),
+						},
+					},
+				},
+				Required: []string{"type", "replicas"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.ClusterComponentReplicas"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterComponentReplicas(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterComponentReplicas records the number of copies of each state of each component of the cluster master.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"desired": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"current": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"available": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"updated": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+				},
+				Required: []string{"desired", "current", "available", "updated"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterCondition contains details for the current condition of this cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the condition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the condition. Can be True, False, Unknown.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastProbeTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time we probed the condition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unique, one-word, CamelCase reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Human-readable message indicating details about last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterCredential(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterCredential records the credential information needed to access the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"etcdCACert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For TKE in global reuse",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"etcdCAKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"etcdAPIClientCert": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"etcdAPIClientKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"caCert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For connect the cluster",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"caKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"clientCert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For kube-apiserver X509 auth",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"clientKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For kube-apiserver X509 auth",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"token": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For kube-apiserver token auth",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bootstrapToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For kubeadm init or join",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"certificateKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For kubeadm init or join",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username is the username for basic authentication to the kubernetes cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"as": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Impersonate is the username to act-as.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"as-groups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImpersonateGroups is the groups to imperonate.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"as-user-extra": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImpersonateUserExtra contains additional information for impersonated user.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterCredentialList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterCredentialList is the whole list of all ClusterCredential which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of clusters",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterCredential"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterCredential"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterFeature(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterFeature records the features that are enabled by the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ipvs": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"publicLB": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"internalLB": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"gpuType": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"enableMasterSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"ha": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.HA"),
+						},
+					},
+					"skipConditions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"files": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.File"),
+									},
+								},
+							},
+						},
+					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"csiOperator": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.CSIOperatorFeature"),
+						},
+					},
+					"authzWebhookAddr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For kube-apiserver authorization webhook",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.AuthzWebhookAddr"),
+						},
+					},
+					"enableMetricsServer": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"ipv6DualStack": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"enableCilium": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"containerRuntime": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"upgrade": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Upgrade control upgrade process.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/platform/v1.Upgrade"),
+						},
+					},
+				},
+				Required: []string{"containerRuntime"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.AuthzWebhookAddr", "tkestack.io/tke/api/platform/v1.CSIOperatorFeature", "tkestack.io/tke/api/platform/v1.File", "tkestack.io/tke/api/platform/v1.HA", "tkestack.io/tke/api/platform/v1.Upgrade"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterGroupAPIResourceItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterGroupAPIResourceItem specifies the name of a resource and whether it is namespaced.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the plural name of the resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"SingularName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "singularName is the singular name of the resource.  This allows clients to handle plural and singular opaquely. The singularName is more correct for reporting status on a single item and both singular and plural are allowed from the kubectl CLI interface.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"Namespaced": {
+						SchemaProps: spec.SchemaProps{
+							Description: "namespaced indicates if a resource is namespaced or not.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"Group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "group is the preferred group of the resource.  Empty implies the group of the containing resource list. For subresources, this may have a different value, for example: Scale\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"Version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "version is the preferred version of the resource.  Empty implies the version of the containing resource list For subresources, this may have a different value, for example: v1 (while inside a v1beta1 version of the core resource's group)\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"Kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "kind is the kind for the resource (e.g. 'Foo' is the kind for a resource 'foo')",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"Verbs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "verbs is a list of supported kube verbs (this includes get, list, watch, create, update, patch, delete, deletecollection, and proxy)",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"ShortNames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "shortNames is a list of suggested short names of the resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"Categories": {
+						SchemaProps: spec.SchemaProps{
+							Description: "categories is a list of the grouped resources this resource belongs to (e.g. 'all')",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"Name", "SingularName", "Namespaced", "Group", "Version", "Kind", "Verbs", "ShortNames", "Categories"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterGroupAPIResourceItems(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterGroupAPIResourceItems contains the GKV for the current kubernetes cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"groupVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "groupVersion is the group and version this APIResourceList is for.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "resources contains the name of the resources and if they are namespaced.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterGroupAPIResourceItem"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"groupVersion", "apiResources"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.ClusterGroupAPIResourceItem"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterGroupAPIResourceItemsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterGroupAPIResourceItemsList is the whole list of all ClusterAPIResource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"Items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of ClusterGroupAPIResourceItems",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterGroupAPIResourceItems"),
+									},
+								},
+							},
+						},
+					},
+					"failedGroupError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Failed Group Error",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"Items", "failedGroupError"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterGroupAPIResourceItems"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterGroupAPIResourceOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterGroupAPIResourceOptions is the query options.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterList is the whole list of all clusters which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of clusters",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.Cluster"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Cluster"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterMachine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterMachine is the master machine definition of cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"privateKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"passPhrase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the node's taints.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"ip", "port", "username"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.Taint"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterProperty(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterProperty records the attribute information of the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxClusterServiceNum": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"maxNodePodNum": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"oversoldRatio": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterResource records the current available and maximum resource quota information for the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity represents the total resources of a cluster.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"allocatable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allocatable represents the resources of a cluster that are available for scheduling. Defaults to Capacity.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"allocated": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterSpec is a description of a cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"finalizers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"networkType": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"networkDevice": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterCIDR": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"serviceCIDR": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceCIDR is used to set a separated CIDR for k8s service, it's exclusive with MaxClusterServiceNum.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSDomain is the dns domain used by k8s services. Defaults to \"cluster.local\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"publicAlternativeNames": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"features": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterFeature"),
+						},
+					},
+					"properties": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterProperty"),
+						},
+					},
+					"machines": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterMachine"),
+									},
+								},
+							},
+						},
+					},
+					"dockerExtraArgs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"kubeletExtraArgs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"apiServerExtraArgs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"controllerManagerExtraArgs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"schedulerExtraArgs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"clusterCredentialRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterCredentialRef for isolate sensitive information. If not specified, cluster controller will create one; If specified, provider must make sure is valid.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"etcd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Etcd holds configuration for etcd.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.Etcd"),
+						},
+					},
+					"hostnameAsNodename": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If true will use hostname as nodename, if false will use machine IP as nodename.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"networkArgs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"scalingMachines": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterMachine"),
+									},
+								},
+							},
+						},
+					},
+					"bootstrapApps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BootstrapApps will install apps during creating cluster",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.BootstrapApp"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"tenantID", "type", "version"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "tkestack.io/tke/api/platform/v1.BootstrapApp", "tkestack.io/tke/api/platform/v1.ClusterFeature", "tkestack.io/tke/api/platform/v1.ClusterMachine", "tkestack.io/tke/api/platform/v1.ClusterProperty", "tkestack.io/tke/api/platform/v1.Etcd"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterStatus represents information about the status of a cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"locked": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterCondition"),
+									},
+								},
+							},
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about why the cluster is in this condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A brief CamelCase message indicating details about why the cluster is in this state.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"addresses": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "List of addresses reachable to the cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterAddress"),
+									},
+								},
+							},
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterResource"),
+						},
+					},
+					"components": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterComponent"),
+									},
+								},
+							},
+						},
+					},
+					"serviceCIDR": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"nodeCIDRMaskSize": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"dnsIP": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"registryIPs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"secondaryServiceCIDR": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterCIDR": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"secondaryClusterCIDR": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"nodeCIDRMaskSizeIPv4": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"nodeCIDRMaskSizeIPv6": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"kubeVendor": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.ClusterAddress", "tkestack.io/tke/api/platform/v1.ClusterComponent", "tkestack.io/tke/api/platform/v1.ClusterCondition", "tkestack.io/tke/api/platform/v1.ClusterResource"},
+	}
+}
+
+func schema_tke_api_platform_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMap holds configuration data for tke to consume.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"binaryData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "byte",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_tke_api_platform_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ConfigMaps.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.ConfigMap"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ConfigMap"},
+	}
+}
+
+func schema_tke_api_platform_v1_CronHPA(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CronHPA is a new kubernetes workload.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"as-groups": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImpersonateGroups is the groups to imperonate.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Spec defines the desired identities of CronHPA.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/platform/v1.CronHPASpec"),
 						},
 					},
-					"as-user-extra": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImpersonateUserExtra contains additional information for impersonated user.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/platform/v1.CronHPAStatus"),
 						},
 					},
 				},
-				Required: []string{"tenantID", "clusterName"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.CronHPASpec", "tkestack.io/tke/api/platform/v1.CronHPAStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterCredentialList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_CronHPAList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterCredentialList is the whole list of all ClusterCredential which owned by a tenant.",
+				Description: "CronHPAList is the whole list of all CronHPAs which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -51780,13 +57156,13 @@ func schema_tke_api_platform_v1_ClusterCredentialList(ref common.ReferenceCallba
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of clusters",
+							Description: "List of CronHPAs",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterCredential"),
+										Ref:     ref("tkestack.io/tke/api/platform/v1.CronHPA"),
 									},
 								},
 							},
@@ -51797,206 +57173,313 @@ func schema_tke_api_platform_v1_ClusterCredentialList(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterCredential"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.CronHPA"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterFeature(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_CronHPAProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterFeature records the features that are enabled by the cluster.",
+				Description: "CronHPAProxyOptions is the query options to a kube-apiserver proxy call.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ipvs": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"publicLB": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"internalLB": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"gpuType": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"enableMasterSchedule": {
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_CronHPASpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CronHPASpec describes the attributes on a CronHPA.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"ha": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.HA"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"skipConditions": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"files": {
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_CronHPAStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CronHPAStatus is information about the current status of a CronHPA.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.File"),
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"hooks": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Phase is the current lifecycle phase of the CronHPA of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"csiOperator": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.CSIOperatorFeature"),
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"authzWebhookAddr": {
+					"retryCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For kube-apiserver authorization webhook",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.AuthzWebhookAddr"),
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"enableMetricsServer": {
+					"lastReInitializingTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"ipv6DualStack": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_Etcd(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Etcd contains elements describing Etcd configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"local": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Local provides configuration knobs for configuring the local etcd instance Local and External are mutually exclusive",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.LocalEtcd"),
 						},
 					},
-					"enableCilium": {
+					"external": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "External describes how to connect to an external etcd cluster Local and External are mutually exclusive",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ExternalEtcd"),
 						},
 					},
-					"containerRuntime": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.ExternalEtcd", "tkestack.io/tke/api/platform/v1.LocalEtcd"},
+	}
+}
+
+func schema_tke_api_platform_v1_ExternalAuthzWebhookAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ip": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"upgrade": {
+					"port": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Upgrade control upgrade process.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/platform/v1.Upgrade"),
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
 				},
-				Required: []string{"containerRuntime"},
+				Required: []string{"ip", "port"},
 			},
 		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.AuthzWebhookAddr", "tkestack.io/tke/api/platform/v1.CSIOperatorFeature", "tkestack.io/tke/api/platform/v1.File", "tkestack.io/tke/api/platform/v1.HA", "tkestack.io/tke/api/platform/v1.Upgrade"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterGroupAPIResourceItem(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_ExternalEtcd(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterGroupAPIResourceItem specifies the name of a resource and whether it is namespaced.",
+				Description: "ExternalEtcd describes an external etcd cluster. Kubeadm has no knowledge of where certificate files live and they must be supplied.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"Name": {
+					"endpoints": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name is the plural name of the resource.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Endpoints of etcd members. Required for ExternalEtcd.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"SingularName": {
+					"caFile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "singularName is the singular name of the resource.  This allows clients to handle plural and singular opaquely. The singularName is more correct for reporting status on a single item and both singular and plural are allowed from the kubectl CLI interface.",
+							Description: "CAFile is an SSL Certificate Authority file used to secure etcd communication. Required if using a TLS connection.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"Namespaced": {
+					"certFile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "namespaced indicates if a resource is namespaced or not.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "CertFile is an SSL certification file used to secure etcd communication. Required if using a TLS connection.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"Group": {
+					"keyFile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "group is the preferred group of the resource.  Empty implies the group of the containing resource list. For subresources, this may have a different value, for example: Scale\".",
+							Description: "KeyFile is an SSL key file used to secure etcd communication. Required if using a TLS connection.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"Version": {
+				},
+				Required: []string{"endpoints", "caFile", "certFile", "keyFile"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_File(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"src": {
 						SchemaProps: spec.SchemaProps{
-							Description: "version is the preferred version of the resource.  Empty implies the version of the containing resource list For subresources, this may have a different value, for example: v1 (while inside a v1beta1 version of the core resource's group)\".",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"dst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Only support regular file",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"Kind": {
+				},
+				Required: []string{"src", "dst"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_HA(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tke": {
 						SchemaProps: spec.SchemaProps{
-							Description: "kind is the kind for the resource (e.g. 'Foo' is the kind for a resource 'foo')",
+							Ref: ref("tkestack.io/tke/api/platform/v1.TKEHA"),
+						},
+					},
+					"thirdParty": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.ThirdPartyHA"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.TKEHA", "tkestack.io/tke/api/platform/v1.ThirdPartyHA"},
+	}
+}
+
+func schema_tke_api_platform_v1_LocalEtcd(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalEtcd describes that kubeadm should run an etcd cluster locally",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dataDir": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataDir is the directory etcd will place its data. Defaults to \"/var/lib/etcd\".",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"Verbs": {
+					"extraArgs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "verbs is a list of supported kube verbs (this includes get, list, watch, create, update, patch, delete, deletecollection, and proxy)",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "ExtraArgs are extra arguments provided to the etcd binary when run inside a static pod.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -52007,9 +57490,9 @@ func schema_tke_api_platform_v1_ClusterGroupAPIResourceItem(ref common.Reference
 							},
 						},
 					},
-					"ShortNames": {
+					"serverCertSANs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "shortNames is a list of suggested short names of the resource.",
+							Description: "ServerCertSANs sets extra Subject Alternative Names for the etcd server signing cert.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -52022,9 +57505,9 @@ func schema_tke_api_platform_v1_ClusterGroupAPIResourceItem(ref common.Reference
 							},
 						},
 					},
-					"Categories": {
+					"peerCertSANs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "categories is a list of the grouped resources this resource belongs to (e.g. 'all')",
+							Description: "PeerCertSANs sets extra Subject Alternative Names for the etcd peer signing cert.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -52038,17 +57521,17 @@ func schema_tke_api_platform_v1_ClusterGroupAPIResourceItem(ref common.Reference
 						},
 					},
 				},
-				Required: []string{"Name", "SingularName", "Namespaced", "Group", "Version", "Kind", "Verbs", "ShortNames", "Categories"},
+				Required: []string{"dataDir"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterGroupAPIResourceItems(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_Machine(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterGroupAPIResourceItems contains the GKV for the current kubernetes cluster",
+				Description: "Machine instance in Kubernetes cluster",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -52071,100 +57554,122 @@ func schema_tke_api_platform_v1_ClusterGroupAPIResourceItems(ref common.Referenc
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"groupVersion": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "groupVersion is the group and version this APIResourceList is for.",
+							Description: "Spec defines the desired identities of the Machine.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/platform/v1.MachineSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/platform/v1.MachineStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.MachineSpec", "tkestack.io/tke/api/platform/v1.MachineStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_MachineAddress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineAddress contains information for the machine's address.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Machine address type, one of Public, ExternalIP or InternalIP.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiResources": {
+					"address": {
 						SchemaProps: spec.SchemaProps{
-							Description: "resources contains the name of the resources and if they are namespaced.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterGroupAPIResourceItem"),
-									},
-								},
-							},
+							Description: "The machine address.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"groupVersion", "apiResources"},
+				Required: []string{"type", "address"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.ClusterGroupAPIResourceItem"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterGroupAPIResourceItemsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MachineCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterGroupAPIResourceItemsList is the whole list of all ClusterAPIResource.",
+				Description: "MachineCondition contains details for the current condition of this Machine.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Type is the type of the condition.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Status is the status of the condition. Can be True, False, Unknown.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"lastProbeTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Last time we probed the condition.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"Items": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of ClusterGroupAPIResourceItems",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterGroupAPIResourceItems"),
-									},
-								},
-							},
+							Description: "Last time the condition transitioned from one status to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"failedGroupError": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Failed Group Error",
-							Default:     "",
+							Description: "Unique, one-word, CamelCase reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Human-readable message indicating details about last transition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"Items", "failedGroupError"},
+				Required: []string{"type", "status"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterGroupAPIResourceItems"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterGroupAPIResourceOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MachineList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterGroupAPIResourceOptions is the query options.",
+				Description: "MachineList is the whole list of all machine in an cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -52181,69 +57686,77 @@ func schema_tke_api_platform_v1_ClusterGroupAPIResourceOptions(ref common.Refere
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of clusters",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/platform/v1.Machine"),
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Machine"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MachineSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterList is the whole list of all clusters which owned by a tenant.",
+				Description: "MachineSpec is a description of machine.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"finalizers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"metadata": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"items": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of clusters",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.Cluster"),
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Cluster"},
-	}
-}
-
-func schema_tke_api_platform_v1_ClusterMachine(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ClusterMachine is the master machine definition of cluster.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
 					"ip": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
@@ -52313,7 +57826,7 @@ func schema_tke_api_platform_v1_ClusterMachine(ref common.ReferenceCallback) com
 						},
 					},
 				},
-				Required: []string{"ip", "port", "username"},
+				Required: []string{"clusterName", "type", "ip", "port", "username"},
 			},
 		},
 		Dependencies: []string{
@@ -52321,605 +57834,594 @@ func schema_tke_api_platform_v1_ClusterMachine(ref common.ReferenceCallback) com
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterProperty(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MachineStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterProperty records the attribute information of the cluster.",
+				Description: "MachineStatus represents information about the status of an machine.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"maxClusterServiceNum": {
+					"locked": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"maxNodePodNum": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"oversoldRatio": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
 							},
 						},
-					},
-				},
-			},
-		},
-	}
-}
-
-func schema_tke_api_platform_v1_ClusterResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ClusterResource records the current available and maximum resource quota information for the cluster.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"capacity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Capacity represents the total resources of a cluster.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										Ref:     ref("tkestack.io/tke/api/platform/v1.MachineCondition"),
 									},
 								},
 							},
 						},
 					},
-					"allocatable": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allocatable represents the resources of a cluster that are available for scheduling. Defaults to Capacity.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Description: "A human readable message indicating details about why the machine is in this condition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"allocated": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "A brief CamelCase message indicating details about why the machine is in this state.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"addresses": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "List of addresses reachable to the machine.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										Ref:     ref("tkestack.io/tke/api/platform/v1.MachineAddress"),
 									},
 								},
 							},
 						},
 					},
+					"machineInfo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Set of ids/uuids to uniquely identify the node.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/platform/v1.MachineSystemInfo"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"tkestack.io/tke/api/platform/v1.MachineAddress", "tkestack.io/tke/api/platform/v1.MachineCondition", "tkestack.io/tke/api/platform/v1.MachineSystemInfo"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MachineSystemInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterSpec is a description of a cluster.",
+				Description: "MachineSystemInfo is a set of ids/uuids to uniquely identify the node.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"finalizers": {
+					"machineID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "MachineID reported by the node. For unique machine identification in the cluster this field is preferred. Learn more from man(5) machine-id: http://man7.org/linux/man-pages/man5/machine-id.5.html",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tenantID": {
+					"systemUUID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "SystemUUID reported by the node. For unique machine identification MachineID is preferred. This field is specific to Red Hat hosts https://access.redhat.com/documentation/en-US/Red_Hat_Subscription_Management/1/html/RHSM/getting-system-uuid.html",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"displayName": {
+					"bootID": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Boot ID reported by the node.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"type": {
+					"kernelVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kernel Version reported by the node.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"version": {
+					"osImage": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "OS Image reported by the node.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"networkType": {
+					"containerRuntimeVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ContainerRuntime Version reported by the node.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"networkDevice": {
+					"kubeletVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kubelet Version reported by the node.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"clusterCIDR": {
+					"kubeProxyVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "KubeProxy Version reported by the node.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"serviceCIDR": {
+					"operatingSystem": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServiceCIDR is used to set a separated CIDR for k8s service, it's exclusive with MaxClusterServiceNum.",
+							Description: "The Operating System reported by the node",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dnsDomain": {
+					"architecture": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSDomain is the dns domain used by k8s services. Defaults to \"cluster.local\".",
+							Description: "The Architecture reported by the node",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"publicAlternativeNames": {
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_PersistentBackEnd(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PersistentBackEnd indicates the backend type and attributes of the persistent log store.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cls": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Ref: ref("tkestack.io/tke/api/platform/v1.StorageBackEndCLS"),
 						},
 					},
-					"features": {
+					"es": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.StorageBackEndES"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.StorageBackEndCLS", "tkestack.io/tke/api/platform/v1.StorageBackEndES"},
+	}
+}
+
+func schema_tke_api_platform_v1_PersistentEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PersistentEvent is a recorder of kubernetes event.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterFeature"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"properties": {
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of clusters in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/platform/v1.PersistentEventSpec"),
+						},
+					},
+					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterProperty"),
+							Ref:     ref("tkestack.io/tke/api/platform/v1.PersistentEventStatus"),
 						},
 					},
-					"machines": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.PersistentEventSpec", "tkestack.io/tke/api/platform/v1.PersistentEventStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_PersistentEventList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PersistentEventList is the whole list of all clusters which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of PersistentEvents",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterMachine"),
+										Ref:     ref("tkestack.io/tke/api/platform/v1.PersistentEvent"),
 									},
 								},
 							},
 						},
 					},
-					"dockerExtraArgs": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.PersistentEvent"},
+	}
+}
+
+func schema_tke_api_platform_v1_PersistentEventSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PersistentEventSpec describes the attributes on a PersistentEvent.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"kubeletExtraArgs": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"apiServerExtraArgs": {
+					"persistentBackEnd": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/platform/v1.PersistentBackEnd"),
 						},
 					},
-					"controllerManagerExtraArgs": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"schedulerExtraArgs": {
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.PersistentBackEnd"},
+	}
+}
+
+func schema_tke_api_platform_v1_PersistentEventStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PersistentEventStatus is information about the current status of a PersistentEvent.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"clusterCredentialRef": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClusterCredentialRef for isolate sensitive information. If not specified, cluster controller will create one; If specified, provider must make sure is valid.",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "Phase is the current lifecycle phase of the persistent event of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"etcd": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Etcd holds configuration for etcd.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.Etcd"),
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"hostnameAsNodename": {
+					"retryCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If true will use hostname as nodename, if false will use machine IP as nodename.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"networkArgs": {
+					"lastReInitializingTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_ProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProxyOptions is the query options to a proxy call.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"scalingMachines": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterMachine"),
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"bootstrapApps": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BootstrapApps will install apps during creating cluster",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.BootstrapApp"),
-									},
-								},
-							},
+							Description: "Path is the URL path to use for the current proxy request.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"tenantID", "type", "version"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference", "tkestack.io/tke/api/platform/v1.BootstrapApp", "tkestack.io/tke/api/platform/v1.ClusterFeature", "tkestack.io/tke/api/platform/v1.ClusterMachine", "tkestack.io/tke/api/platform/v1.ClusterProperty", "tkestack.io/tke/api/platform/v1.Etcd"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_Registry(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterStatus represents information about the status of a cluster.",
+				Description: "Registry records the third-party image repository information stored by the user.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"locked": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"version": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"phase": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterCondition"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/platform/v1.RegistrySpec"),
 						},
 					},
-					"message": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.RegistrySpec"},
+	}
+}
+
+func schema_tke_api_platform_v1_RegistryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistryList is a resource containing a list of Registry objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about why the cluster is in this condition.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A brief CamelCase message indicating details about why the cluster is in this state.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"addresses": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
-						SchemaProps: spec.SchemaProps{
-							Description: "List of addresses reachable to the cluster.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterAddress"),
-									},
-								},
-							},
-						},
-					},
-					"resource": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterResource"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"components": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.ClusterComponent"),
+										Ref:     ref("tkestack.io/tke/api/platform/v1.Registry"),
 									},
 								},
 							},
 						},
 					},
-					"serviceCIDR": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"nodeCIDRMaskSize": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Registry"},
+	}
+}
+
+func schema_tke_api_platform_v1_RegistrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistrySpec indicates the specifications of the third-party image repository.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"dnsIP": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"registryIPs": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"secondaryServiceCIDR": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"clusterCIDR": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"secondaryClusterCIDR": {
+					"userName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"nodeCIDRMaskSizeIPv4": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
-					"nodeCIDRMaskSizeIPv6": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
-					"kubeVendor": {
+					"password": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.ClusterAddress", "tkestack.io/tke/api/platform/v1.ClusterComponent", "tkestack.io/tke/api/platform/v1.ClusterCondition", "tkestack.io/tke/api/platform/v1.ClusterResource"},
 	}
 }
 
-func schema_tke_api_platform_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_ResourceRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMap holds configuration data for tke to consume.",
+				Description: "ResourceRequirements describes the compute resource requirements.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"data": {
+					"limits": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
-							Type:        []string{"object"},
+							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
-					"binaryData": {
+					"requests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
-							Type:        []string{"object"},
+							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "byte",
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
@@ -52929,65 +58431,121 @@ func schema_tke_api_platform_v1_ConfigMap(ref common.ReferenceCallback) common.O
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_tke_api_platform_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_StorageBackEndCLS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
+				Description: "StorageBackEndCLS records the attributes required when the backend storage type is CLS.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"logSetID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"apiVersion": {
+					"topicID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"metadata": {
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_StorageBackEndES(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StorageBackEndES records the attributes required when the backend storage type is ElasticSearch.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ip": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"items": {
+					"port": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ConfigMaps.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.ConfigMap"),
-									},
-								},
-							},
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"scheme": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"indexName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"user": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"reserveDays": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ConfigMap"},
 	}
 }
 
-func schema_tke_api_platform_v1_CronHPA(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_TKEHA(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CronHPA is a new kubernetes workload.",
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vip": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"vrid": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+				Required: []string{"vip"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_TappController(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TappController is a new kubernetes workload.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -53012,30 +58570,30 @@ func schema_tke_api_platform_v1_CronHPA(ref common.ReferenceCallback) common.Ope
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of CronHPA.",
+							Description: "Spec defines the desired identities of tapp controller.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/platform/v1.CronHPASpec"),
+							Ref:         ref("tkestack.io/tke/api/platform/v1.TappControllerSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/platform/v1.CronHPAStatus"),
+							Ref:     ref("tkestack.io/tke/api/platform/v1.TappControllerStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.CronHPASpec", "tkestack.io/tke/api/platform/v1.CronHPAStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.TappControllerSpec", "tkestack.io/tke/api/platform/v1.TappControllerStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_CronHPAList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_TappControllerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CronHPAList is the whole list of all CronHPAs which owned by a tenant.",
+				Description: "TappControllerList is the whole list of all tapp controllers which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -53060,13 +58618,13 @@ func schema_tke_api_platform_v1_CronHPAList(ref common.ReferenceCallback) common
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of CronHPAs",
+							Description: "List of tapp controllers",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.CronHPA"),
+										Ref:     ref("tkestack.io/tke/api/platform/v1.TappController"),
 									},
 								},
 							},
@@ -53077,15 +58635,15 @@ func schema_tke_api_platform_v1_CronHPAList(ref common.ReferenceCallback) common
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.CronHPA"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.TappController"},
 	}
 }
 
-func schema_tke_api_platform_v1_CronHPAProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_TappControllerProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CronHPAProxyOptions is the query options to a kube-apiserver proxy call.",
+				Description: "TappControllerProxyOptions is the query options to a kube-apiserver proxy call.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -53114,17 +58672,23 @@ func schema_tke_api_platform_v1_CronHPAProxyOptions(ref common.ReferenceCallback
 							Format: "",
 						},
 					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 				},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_CronHPASpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_TappControllerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CronHPASpec describes the attributes on a CronHPA.",
+				Description: "TappControllerSpec describes the attributes on a tapp controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"tenantID": {
@@ -53154,11 +58718,11 @@ func schema_tke_api_platform_v1_CronHPASpec(ref common.ReferenceCallback) common
 	}
 }
 
-func schema_tke_api_platform_v1_CronHPAStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_TappControllerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CronHPAStatus is information about the current status of a CronHPA.",
+				Description: "TappControllerStatus is information about the current status of a tapp controller.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"version": {
@@ -53169,7 +58733,7 @@ func schema_tke_api_platform_v1_CronHPAStatus(ref common.ReferenceCallback) comm
 					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the CronHPA of cluster.",
+							Description: "Phase is the current lifecycle phase of the tapp controller of cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -53191,251 +58755,107 @@ func schema_tke_api_platform_v1_CronHPAStatus(ref common.ReferenceCallback) comm
 					},
 					"lastReInitializingTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_tke_api_platform_v1_Etcd(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Etcd contains elements describing Etcd configuration.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"local": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Local provides configuration knobs for configuring the local etcd instance Local and External are mutually exclusive",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.LocalEtcd"),
-						},
-					},
-					"external": {
-						SchemaProps: spec.SchemaProps{
-							Description: "External describes how to connect to an external etcd cluster Local and External are mutually exclusive",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.ExternalEtcd"),
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.ExternalEtcd", "tkestack.io/tke/api/platform/v1.LocalEtcd"},
-	}
-}
-
-func schema_tke_api_platform_v1_ExternalAuthzWebhookAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ip": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"port": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
-						},
-					},
-				},
-				Required: []string{"ip", "port"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_platform_v1_ExternalEtcd(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ExternalEtcd describes an external etcd cluster. Kubeadm has no knowledge of where certificate files live and they must be supplied.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"endpoints": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Endpoints of etcd members. Required for ExternalEtcd.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"caFile": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CAFile is an SSL Certificate Authority file used to secure etcd communication. Required if using a TLS connection.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"certFile": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CertFile is an SSL certification file used to secure etcd communication. Required if using a TLS connection.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"keyFile": {
-						SchemaProps: spec.SchemaProps{
-							Description: "KeyFile is an SSL key file used to secure etcd communication. Required if using a TLS connection.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"endpoints", "caFile", "certFile", "keyFile"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_File(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_ThirdPartyHA(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"src": {
+					"vip": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"dst": {
+					"vport": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Only support regular file",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
 				},
-				Required: []string{"src", "dst"},
+				Required: []string{"vip", "vport"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_HA(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_Upgrade(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tke": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.TKEHA"),
+							Description: "Upgrade mode, default value is Auto.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"thirdParty": {
+					"strategy": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.ThirdPartyHA"),
+							Description: "Upgrade strategy config.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/platform/v1.UpgradeStrategy"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.TKEHA", "tkestack.io/tke/api/platform/v1.ThirdPartyHA"},
+			"tkestack.io/tke/api/platform/v1.UpgradeStrategy"},
 	}
 }
 
-func schema_tke_api_platform_v1_LocalEtcd(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_UpgradeStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalEtcd describes that kubeadm should run an etcd cluster locally",
+				Description: "UpgradeStrategy used to control the upgrade process.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dataDir": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DataDir is the directory etcd will place its data. Defaults to \"/var/lib/etcd\".",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"extraArgs": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExtraArgs are extra arguments provided to the etcd binary when run inside a static pod.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"serverCertSANs": {
+					"maxUnready": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServerCertSANs sets extra Subject Alternative Names for the etcd server signing cert.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "The maximum number of pods that can be unready during the upgrade. 0% means all pods need to be ready after evition. 100% means ignore any pods unready which may be used in one worker node, use this carefully! default value is 0%.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 						},
 					},
-					"peerCertSANs": {
+					"drainNodeBeforeUpgrade": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PeerCertSANs sets extra Subject Alternative Names for the etcd peer signing cert.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Whether drain node before upgrade. Draining node before upgrade is recommended. But not all pod running as cows, a few running as pets. If your pod can not accept be expelled from current node, this value should be false.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"dataDir"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_tke_api_platform_v1_Machine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_Chart(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Machine instance in Kubernetes cluster",
+				Description: "Chart is a chart in chart group of chartmuseum registry.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -53460,120 +58880,111 @@ func schema_tke_api_platform_v1_Machine(ref common.ReferenceCallback) common.Ope
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of the Machine.",
+							Description: "Spec defines the desired identities of chart in this set.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/platform/v1.MachineSpec"),
+							Ref:         ref("tkestack.io/tke/api/registry/v1.ChartSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/platform/v1.MachineStatus"),
+							Ref:     ref("tkestack.io/tke/api/registry/v1.ChartStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.MachineSpec", "tkestack.io/tke/api/platform/v1.MachineStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/registry/v1.ChartSpec", "tkestack.io/tke/api/registry/v1.ChartStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_MachineAddress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ChartGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineAddress contains information for the machine's address.",
+				Description: "ChartGroup is a chart container in chartmuseum registry.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Machine address type, one of Public, ExternalIP or InternalIP.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"address": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The machine address.",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of chart group in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/registry/v1.ChartGroupSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/registry/v1.ChartGroupStatus"),
+						},
+					},
 				},
-				Required: []string{"type", "address"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/registry/v1.ChartGroupSpec", "tkestack.io/tke/api/registry/v1.ChartGroupStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_MachineCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ChartGroupImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineCondition contains details for the current condition of this Machine.",
+				Description: "ChartGroupImport is a description of an import chart group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the condition.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Status is the status of the condition. Can be True, False, Unknown.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"lastProbeTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time we probed the condition.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastTransitionTime": {
+					"addr": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"reason": {
+					"username": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Unique, one-word, CamelCase reason for the condition's last transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"message": {
+					"password": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Human-readable message indicating details about last transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"type", "status"},
+				Required: []string{"addr"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_MachineList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ChartGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineList is the whole list of all machine in an cluster.",
+				Description: "ChartGroupList is the whole list of all chart groups which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -53598,13 +59009,13 @@ func schema_tke_api_platform_v1_MachineList(ref common.ReferenceCallback) common
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of clusters",
+							Description: "List of chart groups",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.Machine"),
+										Ref:     ref("tkestack.io/tke/api/registry/v1.ChartGroup"),
 									},
 								},
 							},
@@ -53615,96 +59026,59 @@ func schema_tke_api_platform_v1_MachineList(ref common.ReferenceCallback) common
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Machine"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/registry/v1.ChartGroup"},
 	}
 }
 
-func schema_tke_api_platform_v1_MachineSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ChartGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineSpec is a description of machine.",
+				Description: "ChartGroupSpec is a description of a chart group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"finalizers": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"clusterName": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"type": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"ip": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"port": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
-						},
-					},
-					"username": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"password": {
+					"visibility": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
-							Format: "byte",
+							Format: "",
 						},
 					},
-					"privateKey": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
-							Format: "byte",
+							Format: "",
 						},
 					},
-					"passPhrase": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
-							Format: "byte",
+							Format: "",
 						},
 					},
-					"labels": {
+					"projects": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -53715,229 +59089,117 @@ func schema_tke_api_platform_v1_MachineSpec(ref common.ReferenceCallback) common
 							},
 						},
 					},
-					"taints": {
+					"finalizers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, the node's taints.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Taint"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"clusterName", "type", "ip", "port", "username"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.Taint"},
-	}
-}
-
-func schema_tke_api_platform_v1_MachineStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MachineStatus represents information about the status of an machine.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"locked": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"phase": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"users": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.MachineCondition"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"message": {
-						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about why the machine is in this condition.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"reason": {
-						SchemaProps: spec.SchemaProps{
-							Description: "A brief CamelCase message indicating details about why the machine is in this state.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"addresses": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"importedInfo": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of addresses reachable to the machine.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.MachineAddress"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/registry/v1.ChartGroupImport"),
 						},
 					},
-					"machineInfo": {
+					"creator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Set of ids/uuids to uniquely identify the node.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/platform/v1.MachineSystemInfo"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
+				Required: []string{"name", "tenantID"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.MachineAddress", "tkestack.io/tke/api/platform/v1.MachineCondition", "tkestack.io/tke/api/platform/v1.MachineSystemInfo"},
+			"tkestack.io/tke/api/registry/v1.ChartGroupImport"},
 	}
 }
 
-func schema_tke_api_platform_v1_MachineSystemInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ChartGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineSystemInfo is a set of ids/uuids to uniquely identify the node.",
+				Description: "ChartGroupStatus represents information about the status of a chart group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"machineID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MachineID reported by the node. For unique machine identification in the cluster this field is preferred. Learn more from man(5) machine-id: http://man7.org/linux/man-pages/man5/machine-id.5.html",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"systemUUID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SystemUUID reported by the node. For unique machine identification MachineID is preferred. This field is specific to Red Hat hosts https://access.redhat.com/documentation/en-US/Red_Hat_Subscription_Management/1/html/RHSM/getting-system-uuid.html",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"bootID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Boot ID reported by the node.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"kernelVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kernel Version reported by the node.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"osImage": {
+					"locked": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OS Image reported by the node.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"containerRuntimeVersion": {
+					"chartCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContainerRuntime Version reported by the node.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
 						},
 					},
-					"kubeletVersion": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet Version reported by the node.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"kubeProxyVersion": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeProxy Version reported by the node.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "The last time the condition transitioned from one status to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"operatingSystem": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The Operating System reported by the node",
+							Description: "The reason for the condition's last transition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"architecture": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The Architecture reported by the node",
+							Description: "A human readable message indicating details about the transition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-			},
-		},
-	}
-}
-
-func schema_tke_api_platform_v1_PersistentBackEnd(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "PersistentBackEnd indicates the backend type and attributes of the persistent log store.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"cls": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.StorageBackEndCLS"),
-						},
-					},
-					"es": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.StorageBackEndES"),
-						},
-					},
-				},
+				Required: []string{"chartCount"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.StorageBackEndCLS", "tkestack.io/tke/api/platform/v1.StorageBackEndES"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_PersistentEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ChartInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PersistentEvent is a recorder of kubernetes event.",
+				Description: "ChartInfo describes detail of a chart version.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -53962,206 +59224,168 @@ func schema_tke_api_platform_v1_PersistentEvent(ref common.ReferenceCallback) co
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of clusters in this set.",
+							Description: "Spec defines the desired identities of a chart.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/platform/v1.PersistentEventSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/platform/v1.PersistentEventStatus"),
+							Ref:         ref("tkestack.io/tke/api/registry/v1.ChartInfoSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.PersistentEventSpec", "tkestack.io/tke/api/platform/v1.PersistentEventStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/registry/v1.ChartInfoSpec"},
 	}
 }
 
-func schema_tke_api_platform_v1_PersistentEventList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ChartInfoSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PersistentEventList is the whole list of all clusters which owned by a tenant.",
+				Description: "ChartInfoSpec is a description of a ChartInfo.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"readme": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"metadata": {
+					"values": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"rawFiles": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of PersistentEvents",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.PersistentEvent"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.PersistentEvent"},
-	}
-}
-
-func schema_tke_api_platform_v1_PersistentEventSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "PersistentEventSpec describes the attributes on a PersistentEvent.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"clusterName": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"persistentBackEnd": {
+					"chartGroupName": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/platform/v1.PersistentBackEnd"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"version": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-				},
-				Required: []string{"tenantID", "clusterName"},
-			},
-		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.PersistentBackEnd"},
-	}
-}
-
-func schema_tke_api_platform_v1_PersistentEventStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "PersistentEventStatus is information about the current status of a PersistentEvent.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"version": {
+					"visibility": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"phase": {
+					"finalizers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the persistent event of cluster.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"reason": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is a brief CamelCase string that describes any failure.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"retryCount": {
+					"chartSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"lastReInitializingTimestamp": {
+					"timeCreated": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_tke_api_platform_v1_ProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ProxyOptions is the query options to a proxy call.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"apiVersion": {
+					"appVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"path": {
+					"icon": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path is the URL path to use for the current proxy request.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
+				Required: []string{"name", "tenantID", "chartGroupName"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_Registry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ChartList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Registry records the third-party image repository information stored by the user.",
+				Description: "ChartList is the whole list of all charts which owned by a chart group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -54181,28 +59405,37 @@ func schema_tke_api_platform_v1_Registry(ref common.ReferenceCallback) common.Op
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"spec": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/platform/v1.RegistrySpec"),
+							Description: "List of charts",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/registry/v1.Chart"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.RegistrySpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/registry/v1.Chart"},
 	}
 }
 
-func schema_tke_api_platform_v1_RegistryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ChartProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RegistryList is a resource containing a list of Registry objects.",
+				Description: "ChartProxyOptions is the query options to a ChartInfo proxy call.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -54219,140 +59452,196 @@ func schema_tke_api_platform_v1_RegistryList(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"items": {
+					"cluster": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.Registry"),
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Registry"},
 	}
 }
 
-func schema_tke_api_platform_v1_RegistrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ChartSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RegistrySpec indicates the specifications of the third-party image repository.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"displayName": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"clusterName": {
+					"chartGroupName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"url": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"userName": {
+					"visibility": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"password": {
+					"finalizers": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"name", "tenantID", "chartGroupName"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_ResourceRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ChartStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceRequirements describes the compute resource requirements.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"limits": {
+					"locked": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"requests": {
+					"pullCount": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Default: 0,
+							Type:    []string{"integer"},
+							Format:  "int32",
+						},
+					},
+					"versions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										Ref:     ref("tkestack.io/tke/api/registry/v1.ChartVersion"),
 									},
 								},
 							},
 						},
 					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the condition transitioned from one status to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"pullCount", "versions"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/registry/v1.ChartVersion"},
 	}
 }
 
-func schema_tke_api_platform_v1_StorageBackEndCLS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ChartVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StorageBackEndCLS records the attributes required when the backend storage type is CLS.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"logSetID": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"topicID": {
+					"chartSize": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"timeCreated": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"appVersion": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"icon": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
@@ -54361,95 +59650,132 @@ func schema_tke_api_platform_v1_StorageBackEndCLS(ref common.ReferenceCallback)
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_StorageBackEndES(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StorageBackEndES records the attributes required when the backend storage type is ElasticSearch.",
+				Description: "ConfigMap holds configuration data for tke to consume.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ip": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"port": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
-					"scheme": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"indexName": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"user": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"password": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"reserveDays": {
+					"binaryData": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "byte",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_tke_api_platform_v1_TKEHA(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"vip": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"vrid": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ConfigMaps.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/registry/v1.ConfigMap"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"vip"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/registry/v1.ConfigMap"},
 	}
 }
 
-func schema_tke_api_platform_v1_TappController(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_Namespace(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TappController is a new kubernetes workload.",
+				Description: "Namespace is an image container in registry.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -54474,30 +59800,30 @@ func schema_tke_api_platform_v1_TappController(ref common.ReferenceCallback) com
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of tapp controller.",
+							Description: "Spec defines the desired identities of namespace in this set.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/platform/v1.TappControllerSpec"),
+							Ref:         ref("tkestack.io/tke/api/registry/v1.NamespaceSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/platform/v1.TappControllerStatus"),
+							Ref:     ref("tkestack.io/tke/api/registry/v1.NamespaceStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.TappControllerSpec", "tkestack.io/tke/api/platform/v1.TappControllerStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/registry/v1.NamespaceSpec", "tkestack.io/tke/api/registry/v1.NamespaceStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_TappControllerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_NamespaceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TappControllerList is the whole list of all tapp controllers which owned by a tenant.",
+				Description: "NamespaceList is the whole list of all namespaces which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -54522,13 +59848,13 @@ func schema_tke_api_platform_v1_TappControllerList(ref common.ReferenceCallback)
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of tapp controllers",
+							Description: "List of namespaces",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/platform/v1.TappController"),
+										Ref:     ref("tkestack.io/tke/api/registry/v1.Namespace"),
 									},
 								},
 							},
@@ -54539,275 +59865,313 @@ func schema_tke_api_platform_v1_TappControllerList(ref common.ReferenceCallback)
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.TappController"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/registry/v1.Namespace"},
 	}
 }
 
-func schema_tke_api_platform_v1_TappControllerProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_NamespaceProxy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TappControllerProxyOptions is the query options to a kube-apiserver proxy call.",
+				Description: "NamespaceProxy represents the upstream registry a namespace caches the images of.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "URL is the address of the upstream registry, such as https://registry-1.docker.io.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Namespace is prepended to the names of the repositories pulled from the upstream registry, such as library for the official images of Docker Hub.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"username": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"name": {
+					"password": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Password is returned to the administrator only, an update leaving it empty keeps the old one as long as the URL and username are the same.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"action": {
+					"insecureSkipVerify": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
+							Type:   []string{"boolean"},
 							Format: "",
 						},
 					},
+					"tagTTLMinutes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TagTTLMinutes is how long a cached tag is served before it is checked against the upstream registry again, defaults to 60 minutes.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
+				Required: []string{"url"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_TappControllerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_NamespaceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TappControllerSpec describes the attributes on a tapp controller.",
+				Description: "NamespaceSpec is a description of a namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"clusterName": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"version": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-				},
-				Required: []string{"tenantID", "clusterName"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_platform_v1_TappControllerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "TappControllerStatus is information about the current status of a tapp controller.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"version": {
+					"visibility": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"phase": {
+					"vulnerabilityPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the tapp controller of cluster.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "VulnerabilityPolicy blocks pulling the images of the namespace with vulnerabilities found, no image is blocked if nil.",
+							Ref:         ref("tkestack.io/tke/api/registry/v1.VulnerabilityPolicy"),
 						},
 					},
-					"reason": {
+					"retentionPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is a brief CamelCase string that describes any failure.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RetentionPolicy deletes the tags of the repositories in the namespace that are not retained by its rules, no tag is deleted if nil.",
+							Ref:         ref("tkestack.io/tke/api/registry/v1.RetentionPolicy"),
 						},
 					},
-					"retryCount": {
+					"proxy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Proxy makes the namespace a read-only pull-through cache of an upstream registry, the images are pushed to the namespace if nil.",
+							Ref:         ref("tkestack.io/tke/api/registry/v1.NamespaceProxy"),
 						},
 					},
-					"lastReInitializingTimestamp": {
+					"signaturePolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "SignaturePolicy blocks pulling the images of the namespace that are not signed by the trusted keys of the tenant, no image is blocked if nil.",
+							Ref:         ref("tkestack.io/tke/api/registry/v1.SignaturePolicy"),
 						},
 					},
 				},
+				Required: []string{"name", "tenantID"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"tkestack.io/tke/api/registry/v1.NamespaceProxy", "tkestack.io/tke/api/registry/v1.RetentionPolicy", "tkestack.io/tke/api/registry/v1.SignaturePolicy", "tkestack.io/tke/api/registry/v1.VulnerabilityPolicy"},
 	}
 }
 
-func schema_tke_api_platform_v1_ThirdPartyHA(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_NamespaceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "NamespaceStatus represents information about the status of a namespace.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"vip": {
+					"locked": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"vport": {
+					"repoCount": {
 						SchemaProps: spec.SchemaProps{
 							Default: 0,
 							Type:    []string{"integer"},
 							Format:  "int32",
 						},
 					},
+					"retention": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retention is the result of the last evaluation of the retention policy.",
+							Ref:         ref("tkestack.io/tke/api/registry/v1.RetentionStatus"),
+						},
+					},
 				},
-				Required: []string{"vip", "vport"},
+				Required: []string{"repoCount"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/registry/v1.RetentionStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_Upgrade(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ReplicationArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ReplicationArtifact is a tag of a repository to copy.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"mode": {
+					"repository": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Upgrade mode, default value is Auto.",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"strategy": {
+					"tag": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Upgrade strategy config.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/platform/v1.UpgradeStrategy"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"digest": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
+				Required: []string{"repository", "tag"},
 			},
 		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.UpgradeStrategy"},
 	}
 }
 
-func schema_tke_api_platform_v1_UpgradeStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ReplicationArtifactStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "UpgradeStrategy used to control the upgrade process.",
+				Description: "ReplicationArtifactStatus represents the result of copying an image.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"maxUnready": {
+					"repository": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The maximum number of pods that can be unready during the upgrade. 0% means all pods need to be ready after evition. 100% means ignore any pods unready which may be used in one worker node, use this carefully! default value is 0%.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"drainNodeBeforeUpgrade": {
+					"tag": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Whether drain node before upgrade. Draining node before upgrade is recommended. But not all pod running as cows, a few running as pets. If your pod can not accept be expelled from current node, this value should be false.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"digest": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"retries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retries is the times the copy was retried.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
+				Required: []string{"repository", "tag", "phase"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_tke_api_registry_v1_Chart(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ReplicationEndpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Chart is a chart in chart group of chartmuseum registry.",
+				Description: "ReplicationEndpoint represents a registry images are copied between.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the address of the registry, such as https://hub.example.com, unused for this registry.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Namespace is the namespace of this registry, the project of harbor, or the path prefix of the repositories of a docker registry.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"username": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"spec": {
+					"password": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of chart in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/registry/v1.ChartSpec"),
+							Description: "Password is returned to the administrator only, an update leaving it empty keeps the old one as long as the type, URL and username are the same.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"insecureSkipVerify": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/registry/v1.ChartStatus"),
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
 				},
+				Required: []string{"type"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/registry/v1.ChartSpec", "tkestack.io/tke/api/registry/v1.ChartStatus"},
 	}
 }
 
-func schema_tke_api_registry_v1_ChartGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ReplicationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChartGroup is a chart container in chartmuseum registry.",
+				Description: "ReplicationPolicy copies the images between the registry and the external registries.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -54832,63 +60196,30 @@ func schema_tke_api_registry_v1_ChartGroup(ref common.ReferenceCallback) common.
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of chart group in this set.",
+							Description: "Spec defines the desired identities of replication policy in this set.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/registry/v1.ChartGroupSpec"),
+							Ref:         ref("tkestack.io/tke/api/registry/v1.ReplicationPolicySpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/registry/v1.ChartGroupStatus"),
+							Ref:     ref("tkestack.io/tke/api/registry/v1.ReplicationPolicyStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/registry/v1.ChartGroupSpec", "tkestack.io/tke/api/registry/v1.ChartGroupStatus"},
-	}
-}
-
-func schema_tke_api_registry_v1_ChartGroupImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ChartGroupImport is a description of an import chart group.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"addr": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"username": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"password": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-				},
-				Required: []string{"addr"},
-			},
-		},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/registry/v1.ReplicationPolicySpec", "tkestack.io/tke/api/registry/v1.ReplicationPolicyStatus"},
 	}
 }
 
-func schema_tke_api_registry_v1_ChartGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ReplicationPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChartGroupList is the whole list of all chart groups which owned by a tenant.",
+				Description: "ReplicationPolicyList is the whole list of all replication policies which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -54913,13 +60244,13 @@ func schema_tke_api_registry_v1_ChartGroupList(ref common.ReferenceCallback) com
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of chart groups",
+							Description: "List of replication policies",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/registry/v1.ChartGroup"),
+										Ref:     ref("tkestack.io/tke/api/registry/v1.ReplicationPolicy"),
 									},
 								},
 							},
@@ -54930,24 +60261,17 @@ func schema_tke_api_registry_v1_ChartGroupList(ref common.ReferenceCallback) com
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/registry/v1.ChartGroup"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/registry/v1.ReplicationPolicy"},
 	}
 }
 
-func schema_tke_api_registry_v1_ChartGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ReplicationPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChartGroupSpec is a description of a chart group.",
+				Description: "ReplicationPolicySpec is a description of a replication policy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
 					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
@@ -54961,335 +60285,284 @@ func schema_tke_api_registry_v1_ChartGroupSpec(ref common.ReferenceCallback) com
 							Format: "",
 						},
 					},
-					"visibility": {
+					"source": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Source is the registry the images are copied from.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/registry/v1.ReplicationEndpoint"),
 						},
 					},
-					"type": {
+					"destination": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Destination is the registry the images are copied to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/registry/v1.ReplicationEndpoint"),
 						},
 					},
-					"description": {
+					"repositories": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Repositories is a regular expression matching the names of the source repositories to copy, all repositories if empty.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"projects": {
+					"includeTags": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "IncludeTags is a regular expression matching the tags to copy, all tags if empty.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"finalizers": {
+					"excludeTags": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ExcludeTags is a regular expression matching the tags never copied.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"users": {
+					"trigger": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/registry/v1.ReplicationTrigger"),
 						},
 					},
-					"importedInfo": {
+					"bandwidthLimitKBps": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/registry/v1.ChartGroupImport"),
+							Description: "BandwidthLimitKBps limits the kilobytes copied per second by a run, unlimited if zero.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"creator": {
+					"maxRetries": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "MaxRetries is the times to retry copying an image before it fails.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"name", "tenantID"},
+				Required: []string{"tenantID", "source", "destination", "trigger"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/registry/v1.ChartGroupImport"},
+			"tkestack.io/tke/api/registry/v1.ReplicationEndpoint", "tkestack.io/tke/api/registry/v1.ReplicationTrigger"},
 	}
 }
 
-func schema_tke_api_registry_v1_ChartGroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ReplicationPolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChartGroupStatus represents information about the status of a chart group.",
+				Description: "ReplicationPolicyStatus represents information about the status of a replication policy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"locked": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"chartCount": {
-						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
-						},
-					},
-					"phase": {
+					"pending": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Pending are the images pushed to the source and waiting to be copied.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/registry/v1.ReplicationArtifact"),
+									},
+								},
+							},
 						},
 					},
-					"lastTransitionTime": {
+					"lastScheduleTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The last time the condition transitioned from one status to another.",
+							Description: "LastScheduleTime is the time the last scheduled run started.",
 							Default:     map[string]interface{}{},
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"reason": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"message": {
+					"runs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Runs are the results of the most recent runs, the latest first.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/registry/v1.ReplicationRun"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"chartCount"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/registry/v1.ReplicationArtifact", "tkestack.io/tke/api/registry/v1.ReplicationRun"},
 	}
 }
 
-func schema_tke_api_registry_v1_ChartInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_ReplicationRun(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChartInfo describes detail of a chart version.",
+				Description: "ReplicationRun represents the result of copying the images once.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of a chart.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/registry/v1.ChartInfoSpec"),
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/registry/v1.ChartInfoSpec"},
-	}
-}
-
-func schema_tke_api_registry_v1_ChartInfoSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ChartInfoSpec is a description of a ChartInfo.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"readme": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"values": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"rawFiles": {
+					"completionTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"name": {
+					"trigger": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"tenantID": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
 							Format:  "",
 						},
 					},
-					"chartGroupName": {
+					"succeeded": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Succeeded is the number of images copied.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"displayName": {
+					"failed": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Failed is the number of images failed to be copied.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"visibility": {
+					"skipped": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Skipped is the number of images already in the destination.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"finalizers": {
+					"artifacts": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Artifacts are the images copied or failed, the skipped ones are omitted.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/registry/v1.ReplicationArtifactStatus"),
 									},
 								},
 							},
 						},
 					},
-					"version": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"chartSize": {
+				},
+				Required: []string{"trigger", "phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/registry/v1.ReplicationArtifactStatus"},
+	}
+}
+
+func schema_tke_api_registry_v1_ReplicationTrigger(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicationTrigger represents when the images are copied.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"timeCreated": {
+					"intervalMinutes": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "IntervalMinutes is the period between two scheduled runs.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"description": {
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_registry_v1_Repository(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Repository is a repo in namespace of registry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"appVersion": {
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Spec defines the desired identities of repository in this set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("tkestack.io/tke/api/registry/v1.RepositorySpec"),
 						},
 					},
-					"icon": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: map[string]interface{}{},
+							Ref:     ref("tkestack.io/tke/api/registry/v1.RepositoryStatus"),
 						},
 					},
 				},
-				Required: []string{"name", "tenantID", "chartGroupName"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/registry/v1.RepositorySpec", "tkestack.io/tke/api/registry/v1.RepositoryStatus"},
 	}
 }
 
-func schema_tke_api_registry_v1_ChartList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_RepositoryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChartList is the whole list of all charts which owned by a chart group.",
+				Description: "RepositoryList is the whole list of all repositories which owned by a namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -55314,13 +60587,13 @@ func schema_tke_api_registry_v1_ChartList(ref common.ReferenceCallback) common.O
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of charts",
+							Description: "List of repositories",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/registry/v1.Chart"),
+										Ref:     ref("tkestack.io/tke/api/registry/v1.Repository"),
 									},
 								},
 							},
@@ -55331,15 +60604,15 @@ func schema_tke_api_registry_v1_ChartList(ref common.ReferenceCallback) common.O
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/registry/v1.Chart"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/registry/v1.Repository"},
 	}
 }
 
-func schema_tke_api_registry_v1_ChartProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_RepositoryScanOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChartProxyOptions is the query options to a ChartInfo proxy call.",
+				Description: "RepositoryScanOptions is the query options to a Repository scan call.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -55356,22 +60629,11 @@ func schema_tke_api_registry_v1_ChartProxyOptions(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
-					"version": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"cluster": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"namespace": {
+					"tag": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Tag is the tag to scan, all the tags of the repository are scanned if empty.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
@@ -55380,7 +60642,7 @@ func schema_tke_api_registry_v1_ChartProxyOptions(ref common.ReferenceCallback)
 	}
 }
 
-func schema_tke_api_registry_v1_ChartSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_RepositorySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -55400,7 +60662,7 @@ func schema_tke_api_registry_v1_ChartSpec(ref common.ReferenceCallback) common.O
 							Format:  "",
 						},
 					},
-					"chartGroupName": {
+					"namespaceName": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
 							Type:    []string{"string"},
@@ -55419,28 +60681,14 @@ func schema_tke_api_registry_v1_ChartSpec(ref common.ReferenceCallback) common.O
 							Format: "",
 						},
 					},
-					"finalizers": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
 				},
-				Required: []string{"name", "tenantID", "chartGroupName"},
+				Required: []string{"name", "tenantID", "namespaceName"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_registry_v1_ChartStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_RepositoryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -55459,72 +60707,46 @@ func schema_tke_api_registry_v1_ChartStatus(ref common.ReferenceCallback) common
 							Format:  "int32",
 						},
 					},
-					"versions": {
+					"tags": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/registry/v1.ChartVersion"),
+										Ref:     ref("tkestack.io/tke/api/registry/v1.RepositoryTag"),
 									},
 								},
 							},
 						},
 					},
-					"phase": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The last time the condition transitioned from one status to another.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"reason": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"message": {
-						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
-				Required: []string{"pullCount", "versions"},
+				Required: []string{"pullCount", "tags"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/registry/v1.ChartVersion"},
+			"tkestack.io/tke/api/registry/v1.RepositoryTag"},
 	}
 }
 
-func schema_tke_api_registry_v1_ChartVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_RepositoryTag(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"chartSize": {
+					"digest": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
 					"timeCreated": {
@@ -55533,318 +60755,374 @@ func schema_tke_api_registry_v1_ChartVersion(ref common.ReferenceCallback) commo
 							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"description": {
+					"scan": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Scan represents the vulnerabilities found in the image of the tag.",
+							Ref:         ref("tkestack.io/tke/api/registry/v1.TagScan"),
 						},
 					},
-					"appVersion": {
+					"lastPullTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "LastPullTime is the time the tag was pulled last, never pulled if zero.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"icon": {
+					"signature": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Signature represents the signatures of the image of the tag.",
+							Ref:         ref("tkestack.io/tke/api/registry/v1.TagSignature"),
 						},
 					},
 				},
+				Required: []string{"name", "digest"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/registry/v1.TagScan", "tkestack.io/tke/api/registry/v1.TagSignature"},
 	}
 }
 
-func schema_tke_api_registry_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_RetentionPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMap holds configuration data for tke to consume.",
+				Description: "RetentionPolicy represents the tags to keep in the repositories of a namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"data": {
+					"rules": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Rules select the tags the policy applies to and the ones of them to keep. A tag selected by a rule is deleted unless one of the rules selecting it retains it, the tags selected by no rule are always kept.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/registry/v1.RetentionRule"),
 									},
 								},
 							},
 						},
 					},
-					"binaryData": {
+					"intervalHours": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "byte",
-									},
-								},
-							},
+							Description: "IntervalHours is the period between two evaluations of the policy, defaults to 24 hours.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DryRun only reports the tags that would be deleted by the policy.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"rules"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"tkestack.io/tke/api/registry/v1.RetentionRule"},
 	}
 }
 
-func schema_tke_api_registry_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_RetentionRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
+				Description: "RetentionRule selects tags by repository and tag name and keeps the latest or recently pulled ones of them.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"repositories": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Repositories is a regular expression matching the names of the repositories the rule applies to, all repositories if empty.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"includeTags": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "IncludeTags is a regular expression matching the tags the rule applies to, all tags if empty.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"excludeTags": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "ExcludeTags is a regular expression matching the tags the rule never applies to.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"keepLastN": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ConfigMaps.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/registry/v1.ConfigMap"),
-									},
-								},
-							},
+							Description: "KeepLastN keeps the N most recently pushed tags of each repository.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"keepPulledWithinDays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeepPulledWithinDays keeps the tags pulled within the given days.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/registry/v1.ConfigMap"},
 	}
 }
 
-func schema_tke_api_registry_v1_Namespace(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_RetentionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Namespace is an image container in registry.",
+				Description: "RetentionStatus represents the tags deleted by a retention policy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"lastRunTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastRunTime is the time the policy was evaluated last.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"apiVersion": {
+					"dryRun": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
+							Description: "DryRun is true if the tags were only reported and not deleted.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
+					"tags": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of namespace in this set.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/registry/v1.NamespaceSpec"),
+							Description: "Tags are the tags deleted, or that would be deleted in dry run.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/registry/v1.RetentionTag"),
+									},
+								},
+							},
 						},
 					},
-					"status": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/registry/v1.NamespaceStatus"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/registry/v1.NamespaceSpec", "tkestack.io/tke/api/registry/v1.NamespaceStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/registry/v1.RetentionTag"},
 	}
 }
 
-func schema_tke_api_registry_v1_NamespaceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_RetentionTag(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamespaceList is the whole list of all namespaces which owned by a tenant.",
+				Description: "RetentionTag is a tag of a repository deleted by a retention policy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"repository": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"apiVersion": {
+					"tag": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"metadata": {
+					"digest": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"items": {
+				},
+				Required: []string{"repository", "tag", "digest"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_registry_v1_SignaturePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SignaturePolicy represents the signatures required to pull the images of a namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"trustedKeys": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of namespaces",
+							Description: "TrustedKeys are the names of the trusted keys of the tenant one of which the images must be signed by, any trusted key of the tenant if empty.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/registry/v1.Namespace"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/registry/v1.Namespace"},
 	}
 }
 
-func schema_tke_api_registry_v1_NamespaceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_TagScan(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamespaceSpec is a description of a namespace.",
+				Description: "TagScan represents the result of scanning the image of a tag for vulnerabilities.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"tenantID": {
+					"digest": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Digest is the digest of the image scanned.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"displayName": {
+					"databaseVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "DatabaseVersion is the version of the vulnerability database scanned with.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"visibility": {
+					"lastScanTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "The last time the image was scanned.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"summary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Summary represents the number of vulnerabilities of each severity.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"vulnerabilities": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("tkestack.io/tke/api/registry/v1.Vulnerability"),
+									},
+								},
+							},
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating why the scan failed.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "tenantID"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/registry/v1.Vulnerability"},
 	}
 }
 
-func schema_tke_api_registry_v1_NamespaceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_TagSignature(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamespaceStatus represents information about the status of a namespace.",
+				Description: "TagSignature represents the cosign signatures stored for the image of a tag and the trusted keys they are verified with.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"locked": {
+					"digest": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Digest is the digest of the image verified.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"repoCount": {
+					"signed": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Description: "Signed is true if any signature of the image is stored in the repository, even if it is not verified by a trusted key.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"keys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Keys are the names of the trusted keys of the tenant the image is signed by.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"lastVerifyTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the signatures were verified.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"repoCount"},
+				Required: []string{"digest"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_registry_v1_Repository(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_TrustedKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Repository is a repo in namespace of registry.",
+				Description: "TrustedKey is a public key of a tenant the signatures of the images are verified with.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -55869,30 +61147,24 @@ func schema_tke_api_registry_v1_Repository(ref common.ReferenceCallback) common.
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of repository in this set.",
+							Description: "Spec defines the desired identities of trusted key in this set.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("tkestack.io/tke/api/registry/v1.RepositorySpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("tkestack.io/tke/api/registry/v1.RepositoryStatus"),
+							Ref:         ref("tkestack.io/tke/api/registry/v1.TrustedKeySpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/registry/v1.RepositorySpec", "tkestack.io/tke/api/registry/v1.RepositoryStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/registry/v1.TrustedKeySpec"},
 	}
 }
 
-func schema_tke_api_registry_v1_RepositoryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_TrustedKeyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RepositoryList is the whole list of all repositories which owned by a namespace.",
+				Description: "TrustedKeyList is the whole list of all trusted keys which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -55917,13 +61189,13 @@ func schema_tke_api_registry_v1_RepositoryList(ref common.ReferenceCallback) com
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of repositories",
+							Description: "List of trusted keys",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/registry/v1.Repository"),
+										Ref:     ref("tkestack.io/tke/api/registry/v1.TrustedKey"),
 									},
 								},
 							},
@@ -55934,23 +61206,17 @@ func schema_tke_api_registry_v1_RepositoryList(ref common.ReferenceCallback) com
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/registry/v1.Repository"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/registry/v1.TrustedKey"},
 	}
 }
 
-func schema_tke_api_registry_v1_RepositorySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_TrustedKeySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "TrustedKeySpec is a description of a trusted key.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
 					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Default: "",
@@ -55958,104 +61224,108 @@ func schema_tke_api_registry_v1_RepositorySpec(ref common.ReferenceCallback) com
 							Format:  "",
 						},
 					},
-					"namespaceName": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
 					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"visibility": {
+					"publicKey": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "PublicKey is the PEM encoded public key the images are signed with by cosign, such as the cosign.pub generated by cosign generate-key-pair.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "tenantID", "namespaceName"},
+				Required: []string{"tenantID", "publicKey"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_registry_v1_RepositoryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_Vulnerability(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Vulnerability represents a vulnerability of a package installed in an image.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"locked": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"pullCount": {
+					"severity": {
 						SchemaProps: spec.SchemaProps{
-							Default: 0,
-							Type:    []string{"integer"},
-							Format:  "int32",
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
 						},
 					},
-					"tags": {
+					"package": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("tkestack.io/tke/api/registry/v1.RepositoryTag"),
-									},
-								},
-							},
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the package installed.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fixedVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FixedVersion is the version of the package that fixes the vulnerability.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"title": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"pullCount", "tags"},
+				Required: []string{"id", "severity", "package", "version"},
 			},
 		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/registry/v1.RepositoryTag"},
 	}
 }
 
-func schema_tke_api_registry_v1_RepositoryTag(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_registry_v1_VulnerabilityPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "VulnerabilityPolicy represents the images that are not allowed to be pulled.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
-						},
-					},
-					"digest": {
+					"severity": {
 						SchemaProps: spec.SchemaProps{
-							Default: "",
-							Type:    []string{"string"},
-							Format:  "",
+							Description: "Severity blocks pulling the images with vulnerabilities of the severity or higher.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"timeCreated": {
+					"blockUnscanned": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "BlockUnscanned blocks pulling the images that have not been scanned.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "digest"},
+				Required: []string{"severity"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
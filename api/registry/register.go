@@ -68,6 +68,13 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 
 		&Repository{},
 		&RepositoryList{},
+		&RepositoryScanOptions{},
+
+		&ReplicationPolicy{},
+		&ReplicationPolicyList{},
+
+		&TrustedKey{},
+		&TrustedKeyList{},
 
 		&ChartGroup{},
 		&ChartGroupList{},
@@ -71,6 +71,104 @@ type NamespaceSpec struct {
 	DisplayName string
 	// +optional
 	Visibility Visibility
+	// VulnerabilityPolicy blocks pulling the images of the namespace with
+	// vulnerabilities found, no image is blocked if nil.
+	// +optional
+	VulnerabilityPolicy *VulnerabilityPolicy
+	// RetentionPolicy deletes the tags of the repositories in the namespace
+	// that are not retained by its rules, no tag is deleted if nil.
+	// +optional
+	RetentionPolicy *RetentionPolicy
+	// Proxy makes the namespace a read-only pull-through cache of an upstream
+	// registry, the images are pushed to the namespace if nil.
+	// +optional
+	Proxy *NamespaceProxy
+	// SignaturePolicy blocks pulling the images of the namespace that are not
+	// signed by the trusted keys of the tenant, no image is blocked if nil.
+	// +optional
+	SignaturePolicy *SignaturePolicy
+}
+
+// VulnerabilityPolicy represents the images that are not allowed to be pulled.
+type VulnerabilityPolicy struct {
+	// Severity blocks pulling the images with vulnerabilities of the severity
+	// or higher.
+	Severity VulnerabilitySeverity
+	// BlockUnscanned blocks pulling the images that have not been scanned.
+	// +optional
+	BlockUnscanned bool
+}
+
+// RetentionPolicy represents the tags to keep in the repositories of a
+// namespace.
+type RetentionPolicy struct {
+	// Rules select the tags the policy applies to and the ones of them to
+	// keep. A tag selected by a rule is deleted unless one of the rules
+	// selecting it retains it, the tags selected by no rule are always kept.
+	Rules []RetentionRule
+	// IntervalHours is the period between two evaluations of the policy,
+	// defaults to 24 hours.
+	// +optional
+	IntervalHours int32
+	// DryRun only reports the tags that would be deleted by the policy.
+	// +optional
+	DryRun bool
+}
+
+// RetentionRule selects tags by repository and tag name and keeps the latest
+// or recently pulled ones of them.
+type RetentionRule struct {
+	// Repositories is a regular expression matching the names of the
+	// repositories the rule applies to, all repositories if empty.
+	// +optional
+	Repositories string
+	// IncludeTags is a regular expression matching the tags the rule applies
+	// to, all tags if empty.
+	// +optional
+	IncludeTags string
+	// ExcludeTags is a regular expression matching the tags the rule never
+	// applies to.
+	// +optional
+	ExcludeTags string
+	// KeepLastN keeps the N most recently pushed tags of each repository.
+	// +optional
+	KeepLastN int32
+	// KeepPulledWithinDays keeps the tags pulled within the given days.
+	// +optional
+	KeepPulledWithinDays int32
+}
+
+// NamespaceProxy represents the upstream registry a namespace caches the
+// images of.
+type NamespaceProxy struct {
+	// URL is the address of the upstream registry, such as
+	// https://registry-1.docker.io.
+	URL string
+	// Namespace is prepended to the names of the repositories pulled from the
+	// upstream registry, such as library for the official images of Docker Hub.
+	// +optional
+	Namespace string
+	// +optional
+	Username string
+	// Password is returned to the administrator only, an update leaving it empty
+	// keeps the old one as long as the URL and username are the same.
+	// +optional
+	Password string
+	// +optional
+	InsecureSkipVerify bool
+	// TagTTLMinutes is how long a cached tag is served before it is checked
+	// against the upstream registry again, defaults to 60 minutes.
+	// +optional
+	TagTTLMinutes int32
+}
+
+// SignaturePolicy represents the signatures required to pull the images of a
+// namespace.
+type SignaturePolicy struct {
+	// TrustedKeys are the names of the trusted keys of the tenant one of which
+	// the images must be signed by, any trusted key of the tenant if empty.
+	// +optional
+	TrustedKeys []string
 }
 
 // NamespaceStatus represents information about the status of a namespace.
@@ -78,6 +176,30 @@ type NamespaceStatus struct {
 	// +optional
 	Locked    *bool
 	RepoCount int32
+	// Retention is the result of the last evaluation of the retention policy.
+	// +optional
+	Retention *RetentionStatus
+}
+
+// RetentionStatus represents the tags deleted by a retention policy.
+type RetentionStatus struct {
+	// LastRunTime is the time the policy was evaluated last.
+	LastRunTime metav1.Time
+	// DryRun is true if the tags were only reported and not deleted.
+	// +optional
+	DryRun bool
+	// Tags are the tags deleted, or that would be deleted in dry run.
+	// +optional
+	Tags []RetentionTag
+	// +optional
+	Message string
+}
+
+// RetentionTag is a tag of a repository deleted by a retention policy.
+type RetentionTag struct {
+	Repository string
+	Tag        string
+	Digest     string
 }
 
 // +genclient
@@ -129,6 +251,330 @@ type RepositoryTag struct {
 	Name        string
 	Digest      string
 	TimeCreated metav1.Time
+	// Scan represents the vulnerabilities found in the image of the tag.
+	// +optional
+	Scan *TagScan
+	// LastPullTime is the time the tag was pulled last, never pulled if zero.
+	// +optional
+	LastPullTime metav1.Time
+	// Signature represents the signatures of the image of the tag.
+	// +optional
+	Signature *TagSignature
+}
+
+// TagSignature represents the cosign signatures stored for the image of a
+// tag and the trusted keys they are verified with.
+type TagSignature struct {
+	// Digest is the digest of the image verified.
+	Digest string
+	// Signed is true if any signature of the image is stored in the
+	// repository, even if it is not verified by a trusted key.
+	// +optional
+	Signed bool
+	// Keys are the names of the trusted keys of the tenant the image is
+	// signed by.
+	// +optional
+	Keys []string
+	// The last time the signatures were verified.
+	// +optional
+	LastVerifyTime metav1.Time
+}
+
+// TagScan represents the result of scanning the image of a tag for
+// vulnerabilities.
+type TagScan struct {
+	// +optional
+	Phase TagScanPhase
+	// Digest is the digest of the image scanned.
+	// +optional
+	Digest string
+	// DatabaseVersion is the version of the vulnerability database scanned with.
+	// +optional
+	DatabaseVersion string
+	// The last time the image was scanned.
+	// +optional
+	LastScanTime metav1.Time
+	// Summary represents the number of vulnerabilities of each severity.
+	// +optional
+	Summary map[string]int32
+	// +optional
+	Vulnerabilities []Vulnerability
+	// A human readable message indicating why the scan failed.
+	// +optional
+	Message string
+}
+
+// TagScanPhase defines the phase of scanning the image of a tag.
+type TagScanPhase string
+
+const (
+	// TagScanPending indicates the image is waiting to be scanned.
+	TagScanPending TagScanPhase = "Pending"
+	// TagScanFinished indicates the image has been scanned.
+	TagScanFinished TagScanPhase = "Finished"
+	// TagScanFailed indicates the image failed to be scanned.
+	TagScanFailed TagScanPhase = "Failed"
+)
+
+// Vulnerability represents a vulnerability of a package installed in an image.
+type Vulnerability struct {
+	ID       string
+	Severity VulnerabilitySeverity
+	Package  string
+	// Version is the version of the package installed.
+	Version string
+	// FixedVersion is the version of the package that fixes the vulnerability.
+	// +optional
+	FixedVersion string
+	// +optional
+	Title string
+}
+
+// VulnerabilitySeverity defines the severity of a vulnerability.
+type VulnerabilitySeverity string
+
+const (
+	VulnerabilityUnknown  VulnerabilitySeverity = "Unknown"
+	VulnerabilityLow      VulnerabilitySeverity = "Low"
+	VulnerabilityMedium   VulnerabilitySeverity = "Medium"
+	VulnerabilityHigh     VulnerabilitySeverity = "High"
+	VulnerabilityCritical VulnerabilitySeverity = "Critical"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ReplicationPolicy copies the images between the registry and the external
+// registries.
+type ReplicationPolicy struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the desired identities of replication policy in this set.
+	// +optional
+	Spec ReplicationPolicySpec
+	// +optional
+	Status ReplicationPolicyStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ReplicationPolicyList is the whole list of all replication policies which
+// owned by a tenant.
+type ReplicationPolicyList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of replication policies
+	Items []ReplicationPolicy
+}
+
+// ReplicationPolicySpec is a description of a replication policy.
+type ReplicationPolicySpec struct {
+	TenantID string
+	// +optional
+	DisplayName string
+	// Source is the registry the images are copied from.
+	Source ReplicationEndpoint
+	// Destination is the registry the images are copied to.
+	Destination ReplicationEndpoint
+	// Repositories is a regular expression matching the names of the source
+	// repositories to copy, all repositories if empty.
+	// +optional
+	Repositories string
+	// IncludeTags is a regular expression matching the tags to copy, all tags
+	// if empty.
+	// +optional
+	IncludeTags string
+	// ExcludeTags is a regular expression matching the tags never copied.
+	// +optional
+	ExcludeTags string
+	Trigger     ReplicationTrigger
+	// BandwidthLimitKBps limits the kilobytes copied per second by a run,
+	// unlimited if zero.
+	// +optional
+	BandwidthLimitKBps int64
+	// MaxRetries is the times to retry copying an image before it fails.
+	// +optional
+	MaxRetries *int32
+}
+
+// ReplicationEndpointType is the kind of a registry images are copied between.
+type ReplicationEndpointType string
+
+const (
+	// ReplicationEndpointTKE is a namespace of this registry.
+	ReplicationEndpointTKE ReplicationEndpointType = "TKE"
+	// ReplicationEndpointHarbor is a project of a harbor instance.
+	ReplicationEndpointHarbor ReplicationEndpointType = "Harbor"
+	// ReplicationEndpointDockerRegistry is a registry implementing the docker
+	// registry http api v2.
+	ReplicationEndpointDockerRegistry ReplicationEndpointType = "DockerRegistry"
+)
+
+// ReplicationEndpoint represents a registry images are copied between.
+type ReplicationEndpoint struct {
+	Type ReplicationEndpointType
+	// URL is the address of the registry, such as https://hub.example.com,
+	// unused for this registry.
+	// +optional
+	URL string
+	// Namespace is the namespace of this registry, the project of harbor, or
+	// the path prefix of the repositories of a docker registry.
+	// +optional
+	Namespace string
+	// +optional
+	Username string
+	// Password is returned to the administrator only, an update leaving it empty
+	// keeps the old one as long as the type, URL and username are the same.
+	// +optional
+	Password string
+	// +optional
+	InsecureSkipVerify bool
+}
+
+// ReplicationTriggerType is the way a replication is started.
+type ReplicationTriggerType string
+
+const (
+	// ReplicationTriggerPush copies the images pushed to the source, which must
+	// be a namespace of this registry.
+	ReplicationTriggerPush ReplicationTriggerType = "Push"
+	// ReplicationTriggerScheduled copies all images of the source periodically.
+	ReplicationTriggerScheduled ReplicationTriggerType = "Scheduled"
+)
+
+// ReplicationTrigger represents when the images are copied.
+type ReplicationTrigger struct {
+	Type ReplicationTriggerType
+	// IntervalMinutes is the period between two scheduled runs.
+	// +optional
+	IntervalMinutes int32
+}
+
+// ReplicationPolicyStatus represents information about the status of a
+// replication policy.
+type ReplicationPolicyStatus struct {
+	// Pending are the images pushed to the source and waiting to be copied.
+	// +optional
+	Pending []ReplicationArtifact
+	// LastScheduleTime is the time the last scheduled run started.
+	// +optional
+	LastScheduleTime metav1.Time
+	// Runs are the results of the most recent runs, the latest first.
+	// +optional
+	Runs []ReplicationRun
+}
+
+// ReplicationArtifact is a tag of a repository to copy.
+type ReplicationArtifact struct {
+	Repository string
+	Tag        string
+	// +optional
+	Digest string
+}
+
+// ReplicationRunPhase indicates the result of a run.
+type ReplicationRunPhase string
+
+const (
+	// ReplicationRunSucceeded means all images are copied.
+	ReplicationRunSucceeded ReplicationRunPhase = "Succeeded"
+	// ReplicationRunFailed means some images failed to be copied.
+	ReplicationRunFailed ReplicationRunPhase = "Failed"
+)
+
+// ReplicationRun represents the result of copying the images once.
+type ReplicationRun struct {
+	StartTime metav1.Time
+	// +optional
+	CompletionTime metav1.Time
+	Trigger        ReplicationTriggerType
+	Phase          ReplicationRunPhase
+	// Succeeded is the number of images copied.
+	// +optional
+	Succeeded int32
+	// Failed is the number of images failed to be copied.
+	// +optional
+	Failed int32
+	// Skipped is the number of images already in the destination.
+	// +optional
+	Skipped int32
+	// Artifacts are the images copied or failed, the skipped ones are omitted.
+	// +optional
+	Artifacts []ReplicationArtifactStatus
+	// +optional
+	Message string
+}
+
+// ReplicationArtifactPhase indicates the result of copying an image.
+type ReplicationArtifactPhase string
+
+const (
+	// ReplicationArtifactSucceeded means the image is copied.
+	ReplicationArtifactSucceeded ReplicationArtifactPhase = "Succeeded"
+	// ReplicationArtifactFailed means the image failed to be copied after all
+	// retries.
+	ReplicationArtifactFailed ReplicationArtifactPhase = "Failed"
+)
+
+// ReplicationArtifactStatus represents the result of copying an image.
+type ReplicationArtifactStatus struct {
+	Repository string
+	Tag        string
+	Digest     string
+	Phase      ReplicationArtifactPhase
+	// Retries is the times the copy was retried.
+	// +optional
+	Retries int32
+	// +optional
+	Message string
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TrustedKey is a public key of a tenant the signatures of the images are
+// verified with.
+type TrustedKey struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the desired identities of trusted key in this set.
+	// +optional
+	Spec TrustedKeySpec
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TrustedKeyList is the whole list of all trusted keys which owned by a
+// tenant.
+type TrustedKeyList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of trusted keys
+	Items []TrustedKey
+}
+
+// TrustedKeySpec is a description of a trusted key.
+type TrustedKeySpec struct {
+	TenantID string
+	// +optional
+	DisplayName string
+	// PublicKey is the PEM encoded public key the images are signed with by
+	// cosign, such as the cosign.pub generated by cosign generate-key-pair.
+	PublicKey string
 }
 
 // +genclient
@@ -368,6 +814,19 @@ type ChartProxyOptions struct {
 	Namespace string
 }
 
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RepositoryScanOptions is the query options to a Repository scan call.
+type RepositoryScanOptions struct {
+	metav1.TypeMeta
+
+	// Tag is the tag to scan, all the tags of the repository are scanned if
+	// empty.
+	// +optional
+	Tag string
+}
+
 // +genclient
 // +genclient:noVerbs
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -30,6 +30,8 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 		AddFieldLabelConversionsForRepository,
 		AddFieldLabelConversionsForChartGroup,
 		AddFieldLabelConversionsForChart,
+		AddFieldLabelConversionsForReplicationPolicy,
+		AddFieldLabelConversionsForTrustedKey,
 	}
 	for _, f := range funcs {
 		if err := f(scheme); err != nil {
@@ -115,3 +117,35 @@ func AddFieldLabelConversionsForChart(scheme *runtime.Scheme) error {
 			}
 		})
 }
+
+// AddFieldLabelConversionsForReplicationPolicy adds a conversion function to
+// convert field selectors of ReplicationPolicy from the given version to
+// internal version representation.
+func AddFieldLabelConversionsForReplicationPolicy(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("ReplicationPolicy"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForTrustedKey adds a conversion function to convert
+// field selectors of TrustedKey from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForTrustedKey(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("TrustedKey"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
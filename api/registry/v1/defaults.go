@@ -36,6 +36,9 @@ func SetDefaults_NamespaceSpec(obj *NamespaceSpec) {
 	if obj.Visibility == "" {
 		obj.Visibility = VisibilityPublic
 	}
+	if obj.Proxy != nil && obj.Proxy.TagTTLMinutes == 0 {
+		obj.Proxy.TagTTLMinutes = 60
+	}
 }
 
 func SetDefaults_RepositorySpec(obj *RepositorySpec) {
@@ -50,6 +53,19 @@ func SetDefaults_RepositoryStatus(obj *RepositoryStatus) {
 	}
 }
 
+func SetDefaults_ReplicationPolicySpec(obj *ReplicationPolicySpec) {
+	if obj.Trigger.Type == "" {
+		obj.Trigger.Type = ReplicationTriggerScheduled
+	}
+	if obj.Trigger.Type == ReplicationTriggerScheduled && obj.Trigger.IntervalMinutes == 0 {
+		obj.Trigger.IntervalMinutes = 60
+	}
+	if obj.MaxRetries == nil {
+		maxRetries := int32(3)
+		obj.MaxRetries = &maxRetries
+	}
+}
+
 func SetDefaults_ChartGroupSpec(obj *ChartGroupSpec) {
 	if obj.Visibility == "" {
 		obj.Visibility = VisibilityPublic
@@ -56,6 +56,13 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 
 		&Repository{},
 		&RepositoryList{},
+		&RepositoryScanOptions{},
+
+		&ReplicationPolicy{},
+		&ReplicationPolicyList{},
+
+		&TrustedKey{},
+		&TrustedKeyList{},
 
 		&ChartGroup{},
 		&ChartGroupList{},
@@ -71,6 +71,104 @@ type NamespaceSpec struct {
 	DisplayName string `json:"displayName,omitempty" protobuf:"bytes,3,opt,name=displayName"`
 	// +optional
 	Visibility Visibility `json:"visibility,omitempty" protobuf:"bytes,4,opt,name=visibility,casttype=Visibility"`
+	// VulnerabilityPolicy blocks pulling the images of the namespace with
+	// vulnerabilities found, no image is blocked if nil.
+	// +optional
+	VulnerabilityPolicy *VulnerabilityPolicy `json:"vulnerabilityPolicy,omitempty" protobuf:"bytes,5,opt,name=vulnerabilityPolicy"`
+	// RetentionPolicy deletes the tags of the repositories in the namespace
+	// that are not retained by its rules, no tag is deleted if nil.
+	// +optional
+	RetentionPolicy *RetentionPolicy `json:"retentionPolicy,omitempty" protobuf:"bytes,6,opt,name=retentionPolicy"`
+	// Proxy makes the namespace a read-only pull-through cache of an upstream
+	// registry, the images are pushed to the namespace if nil.
+	// +optional
+	Proxy *NamespaceProxy `json:"proxy,omitempty" protobuf:"bytes,7,opt,name=proxy"`
+	// SignaturePolicy blocks pulling the images of the namespace that are not
+	// signed by the trusted keys of the tenant, no image is blocked if nil.
+	// +optional
+	SignaturePolicy *SignaturePolicy `json:"signaturePolicy,omitempty" protobuf:"bytes,8,opt,name=signaturePolicy"`
+}
+
+// VulnerabilityPolicy represents the images that are not allowed to be pulled.
+type VulnerabilityPolicy struct {
+	// Severity blocks pulling the images with vulnerabilities of the severity
+	// or higher.
+	Severity VulnerabilitySeverity `json:"severity" protobuf:"bytes,1,opt,name=severity,casttype=VulnerabilitySeverity"`
+	// BlockUnscanned blocks pulling the images that have not been scanned.
+	// +optional
+	BlockUnscanned bool `json:"blockUnscanned,omitempty" protobuf:"varint,2,opt,name=blockUnscanned"`
+}
+
+// RetentionPolicy represents the tags to keep in the repositories of a
+// namespace.
+type RetentionPolicy struct {
+	// Rules select the tags the policy applies to and the ones of them to
+	// keep. A tag selected by a rule is deleted unless one of the rules
+	// selecting it retains it, the tags selected by no rule are always kept.
+	Rules []RetentionRule `json:"rules" protobuf:"bytes,1,rep,name=rules"`
+	// IntervalHours is the period between two evaluations of the policy,
+	// defaults to 24 hours.
+	// +optional
+	IntervalHours int32 `json:"intervalHours,omitempty" protobuf:"varint,2,opt,name=intervalHours"`
+	// DryRun only reports the tags that would be deleted by the policy.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,3,opt,name=dryRun"`
+}
+
+// RetentionRule selects tags by repository and tag name and keeps the latest
+// or recently pulled ones of them.
+type RetentionRule struct {
+	// Repositories is a regular expression matching the names of the
+	// repositories the rule applies to, all repositories if empty.
+	// +optional
+	Repositories string `json:"repositories,omitempty" protobuf:"bytes,1,opt,name=repositories"`
+	// IncludeTags is a regular expression matching the tags the rule applies
+	// to, all tags if empty.
+	// +optional
+	IncludeTags string `json:"includeTags,omitempty" protobuf:"bytes,2,opt,name=includeTags"`
+	// ExcludeTags is a regular expression matching the tags the rule never
+	// applies to.
+	// +optional
+	ExcludeTags string `json:"excludeTags,omitempty" protobuf:"bytes,3,opt,name=excludeTags"`
+	// KeepLastN keeps the N most recently pushed tags of each repository.
+	// +optional
+	KeepLastN int32 `json:"keepLastN,omitempty" protobuf:"varint,4,opt,name=keepLastN"`
+	// KeepPulledWithinDays keeps the tags pulled within the given days.
+	// +optional
+	KeepPulledWithinDays int32 `json:"keepPulledWithinDays,omitempty" protobuf:"varint,5,opt,name=keepPulledWithinDays"`
+}
+
+// NamespaceProxy represents the upstream registry a namespace caches the
+// images of.
+type NamespaceProxy struct {
+	// URL is the address of the upstream registry, such as
+	// https://registry-1.docker.io.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Namespace is prepended to the names of the repositories pulled from the
+	// upstream registry, such as library for the official images of Docker Hub.
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// +optional
+	Username string `json:"username,omitempty" protobuf:"bytes,3,opt,name=username"`
+	// Password is returned to the administrator only, an update leaving it empty
+	// keeps the old one as long as the URL and username are the same.
+	// +optional
+	Password string `json:"password,omitempty" protobuf:"bytes,4,opt,name=password"`
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty" protobuf:"varint,5,opt,name=insecureSkipVerify"`
+	// TagTTLMinutes is how long a cached tag is served before it is checked
+	// against the upstream registry again, defaults to 60 minutes.
+	// +optional
+	TagTTLMinutes int32 `json:"tagTTLMinutes,omitempty" protobuf:"varint,6,opt,name=tagTTLMinutes"`
+}
+
+// SignaturePolicy represents the signatures required to pull the images of a
+// namespace.
+type SignaturePolicy struct {
+	// TrustedKeys are the names of the trusted keys of the tenant one of which
+	// the images must be signed by, any trusted key of the tenant if empty.
+	// +optional
+	TrustedKeys []string `json:"trustedKeys,omitempty" protobuf:"bytes,1,rep,name=trustedKeys"`
 }
 
 // NamespaceStatus represents information about the status of a namespace.
@@ -78,6 +176,30 @@ type NamespaceStatus struct {
 	// +optional
 	Locked    *bool `json:"locked,omitempty" protobuf:"varint,1,opt,name=locked"`
 	RepoCount int32 `json:"repoCount" protobuf:"varint,2,opt,name=repoCount"`
+	// Retention is the result of the last evaluation of the retention policy.
+	// +optional
+	Retention *RetentionStatus `json:"retention,omitempty" protobuf:"bytes,3,opt,name=retention"`
+}
+
+// RetentionStatus represents the tags deleted by a retention policy.
+type RetentionStatus struct {
+	// LastRunTime is the time the policy was evaluated last.
+	LastRunTime metav1.Time `json:"lastRunTime,omitempty" protobuf:"bytes,1,opt,name=lastRunTime"`
+	// DryRun is true if the tags were only reported and not deleted.
+	// +optional
+	DryRun bool `json:"dryRun,omitempty" protobuf:"varint,2,opt,name=dryRun"`
+	// Tags are the tags deleted, or that would be deleted in dry run.
+	// +optional
+	Tags []RetentionTag `json:"tags,omitempty" protobuf:"bytes,3,rep,name=tags"`
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+}
+
+// RetentionTag is a tag of a repository deleted by a retention policy.
+type RetentionTag struct {
+	Repository string `json:"repository" protobuf:"bytes,1,opt,name=repository"`
+	Tag        string `json:"tag" protobuf:"bytes,2,opt,name=tag"`
+	Digest     string `json:"digest" protobuf:"bytes,3,opt,name=digest"`
 }
 
 // +genclient
@@ -129,6 +251,330 @@ type RepositoryTag struct {
 	Name        string      `json:"name" protobuf:"bytes,1,opt,name=name"`
 	Digest      string      `json:"digest" protobuf:"bytes,2,opt,name=digest"`
 	TimeCreated metav1.Time `json:"timeCreated,omitempty" protobuf:"bytes,3,opt,name=timeCreated"`
+	// Scan represents the vulnerabilities found in the image of the tag.
+	// +optional
+	Scan *TagScan `json:"scan,omitempty" protobuf:"bytes,4,opt,name=scan"`
+	// LastPullTime is the time the tag was pulled last, never pulled if zero.
+	// +optional
+	LastPullTime metav1.Time `json:"lastPullTime,omitempty" protobuf:"bytes,5,opt,name=lastPullTime"`
+	// Signature represents the signatures of the image of the tag.
+	// +optional
+	Signature *TagSignature `json:"signature,omitempty" protobuf:"bytes,6,opt,name=signature"`
+}
+
+// TagSignature represents the cosign signatures stored for the image of a
+// tag and the trusted keys they are verified with.
+type TagSignature struct {
+	// Digest is the digest of the image verified.
+	Digest string `json:"digest" protobuf:"bytes,1,opt,name=digest"`
+	// Signed is true if any signature of the image is stored in the
+	// repository, even if it is not verified by a trusted key.
+	// +optional
+	Signed bool `json:"signed,omitempty" protobuf:"varint,2,opt,name=signed"`
+	// Keys are the names of the trusted keys of the tenant the image is
+	// signed by.
+	// +optional
+	Keys []string `json:"keys,omitempty" protobuf:"bytes,3,rep,name=keys"`
+	// The last time the signatures were verified.
+	// +optional
+	LastVerifyTime metav1.Time `json:"lastVerifyTime,omitempty" protobuf:"bytes,4,opt,name=lastVerifyTime"`
+}
+
+// TagScan represents the result of scanning the image of a tag for
+// vulnerabilities.
+type TagScan struct {
+	// +optional
+	Phase TagScanPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=TagScanPhase"`
+	// Digest is the digest of the image scanned.
+	// +optional
+	Digest string `json:"digest,omitempty" protobuf:"bytes,2,opt,name=digest"`
+	// DatabaseVersion is the version of the vulnerability database scanned with.
+	// +optional
+	DatabaseVersion string `json:"databaseVersion,omitempty" protobuf:"bytes,3,opt,name=databaseVersion"`
+	// The last time the image was scanned.
+	// +optional
+	LastScanTime metav1.Time `json:"lastScanTime,omitempty" protobuf:"bytes,4,opt,name=lastScanTime"`
+	// Summary represents the number of vulnerabilities of each severity.
+	// +optional
+	Summary map[string]int32 `json:"summary,omitempty" protobuf:"bytes,5,rep,name=summary"`
+	// +optional
+	Vulnerabilities []Vulnerability `json:"vulnerabilities,omitempty" protobuf:"bytes,6,rep,name=vulnerabilities"`
+	// A human readable message indicating why the scan failed.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,7,opt,name=message"`
+}
+
+// TagScanPhase defines the phase of scanning the image of a tag.
+type TagScanPhase string
+
+const (
+	// TagScanPending indicates the image is waiting to be scanned.
+	TagScanPending TagScanPhase = "Pending"
+	// TagScanFinished indicates the image has been scanned.
+	TagScanFinished TagScanPhase = "Finished"
+	// TagScanFailed indicates the image failed to be scanned.
+	TagScanFailed TagScanPhase = "Failed"
+)
+
+// Vulnerability represents a vulnerability of a package installed in an image.
+type Vulnerability struct {
+	ID       string                `json:"id" protobuf:"bytes,1,opt,name=id"`
+	Severity VulnerabilitySeverity `json:"severity" protobuf:"bytes,2,opt,name=severity,casttype=VulnerabilitySeverity"`
+	Package  string                `json:"package" protobuf:"bytes,3,opt,name=package"`
+	// Version is the version of the package installed.
+	Version string `json:"version" protobuf:"bytes,4,opt,name=version"`
+	// FixedVersion is the version of the package that fixes the vulnerability.
+	// +optional
+	FixedVersion string `json:"fixedVersion,omitempty" protobuf:"bytes,5,opt,name=fixedVersion"`
+	// +optional
+	Title string `json:"title,omitempty" protobuf:"bytes,6,opt,name=title"`
+}
+
+// VulnerabilitySeverity defines the severity of a vulnerability.
+type VulnerabilitySeverity string
+
+const (
+	VulnerabilityUnknown  VulnerabilitySeverity = "Unknown"
+	VulnerabilityLow      VulnerabilitySeverity = "Low"
+	VulnerabilityMedium   VulnerabilitySeverity = "Medium"
+	VulnerabilityHigh     VulnerabilitySeverity = "High"
+	VulnerabilityCritical VulnerabilitySeverity = "Critical"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ReplicationPolicy copies the images between the registry and the external
+// registries.
+type ReplicationPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the desired identities of replication policy in this set.
+	// +optional
+	Spec ReplicationPolicySpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status ReplicationPolicyStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ReplicationPolicyList is the whole list of all replication policies which
+// owned by a tenant.
+type ReplicationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of replication policies
+	Items []ReplicationPolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ReplicationPolicySpec is a description of a replication policy.
+type ReplicationPolicySpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	// +optional
+	DisplayName string `json:"displayName,omitempty" protobuf:"bytes,2,opt,name=displayName"`
+	// Source is the registry the images are copied from.
+	Source ReplicationEndpoint `json:"source" protobuf:"bytes,3,opt,name=source"`
+	// Destination is the registry the images are copied to.
+	Destination ReplicationEndpoint `json:"destination" protobuf:"bytes,4,opt,name=destination"`
+	// Repositories is a regular expression matching the names of the source
+	// repositories to copy, all repositories if empty.
+	// +optional
+	Repositories string `json:"repositories,omitempty" protobuf:"bytes,5,opt,name=repositories"`
+	// IncludeTags is a regular expression matching the tags to copy, all tags
+	// if empty.
+	// +optional
+	IncludeTags string `json:"includeTags,omitempty" protobuf:"bytes,6,opt,name=includeTags"`
+	// ExcludeTags is a regular expression matching the tags never copied.
+	// +optional
+	ExcludeTags string             `json:"excludeTags,omitempty" protobuf:"bytes,7,opt,name=excludeTags"`
+	Trigger     ReplicationTrigger `json:"trigger" protobuf:"bytes,8,opt,name=trigger"`
+	// BandwidthLimitKBps limits the kilobytes copied per second by a run,
+	// unlimited if zero.
+	// +optional
+	BandwidthLimitKBps int64 `json:"bandwidthLimitKBps,omitempty" protobuf:"varint,9,opt,name=bandwidthLimitKBps"`
+	// MaxRetries is the times to retry copying an image before it fails.
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty" protobuf:"varint,10,opt,name=maxRetries"`
+}
+
+// ReplicationEndpointType is the kind of a registry images are copied between.
+type ReplicationEndpointType string
+
+const (
+	// ReplicationEndpointTKE is a namespace of this registry.
+	ReplicationEndpointTKE ReplicationEndpointType = "TKE"
+	// ReplicationEndpointHarbor is a project of a harbor instance.
+	ReplicationEndpointHarbor ReplicationEndpointType = "Harbor"
+	// ReplicationEndpointDockerRegistry is a registry implementing the docker
+	// registry http api v2.
+	ReplicationEndpointDockerRegistry ReplicationEndpointType = "DockerRegistry"
+)
+
+// ReplicationEndpoint represents a registry images are copied between.
+type ReplicationEndpoint struct {
+	Type ReplicationEndpointType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=ReplicationEndpointType"`
+	// URL is the address of the registry, such as https://hub.example.com,
+	// unused for this registry.
+	// +optional
+	URL string `json:"url,omitempty" protobuf:"bytes,2,opt,name=url"`
+	// Namespace is the namespace of this registry, the project of harbor, or
+	// the path prefix of the repositories of a docker registry.
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
+	// +optional
+	Username string `json:"username,omitempty" protobuf:"bytes,4,opt,name=username"`
+	// Password is returned to the administrator only, an update leaving it empty
+	// keeps the old one as long as the type, URL and username are the same.
+	// +optional
+	Password string `json:"password,omitempty" protobuf:"bytes,5,opt,name=password"`
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty" protobuf:"varint,6,opt,name=insecureSkipVerify"`
+}
+
+// ReplicationTriggerType is the way a replication is started.
+type ReplicationTriggerType string
+
+const (
+	// ReplicationTriggerPush copies the images pushed to the source, which must
+	// be a namespace of this registry.
+	ReplicationTriggerPush ReplicationTriggerType = "Push"
+	// ReplicationTriggerScheduled copies all images of the source periodically.
+	ReplicationTriggerScheduled ReplicationTriggerType = "Scheduled"
+)
+
+// ReplicationTrigger represents when the images are copied.
+type ReplicationTrigger struct {
+	Type ReplicationTriggerType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=ReplicationTriggerType"`
+	// IntervalMinutes is the period between two scheduled runs.
+	// +optional
+	IntervalMinutes int32 `json:"intervalMinutes,omitempty" protobuf:"varint,2,opt,name=intervalMinutes"`
+}
+
+// ReplicationPolicyStatus represents information about the status of a
+// replication policy.
+type ReplicationPolicyStatus struct {
+	// Pending are the images pushed to the source and waiting to be copied.
+	// +optional
+	Pending []ReplicationArtifact `json:"pending,omitempty" protobuf:"bytes,1,rep,name=pending"`
+	// LastScheduleTime is the time the last scheduled run started.
+	// +optional
+	LastScheduleTime metav1.Time `json:"lastScheduleTime,omitempty" protobuf:"bytes,2,opt,name=lastScheduleTime"`
+	// Runs are the results of the most recent runs, the latest first.
+	// +optional
+	Runs []ReplicationRun `json:"runs,omitempty" protobuf:"bytes,3,rep,name=runs"`
+}
+
+// ReplicationArtifact is a tag of a repository to copy.
+type ReplicationArtifact struct {
+	Repository string `json:"repository" protobuf:"bytes,1,opt,name=repository"`
+	Tag        string `json:"tag" protobuf:"bytes,2,opt,name=tag"`
+	// +optional
+	Digest string `json:"digest,omitempty" protobuf:"bytes,3,opt,name=digest"`
+}
+
+// ReplicationRunPhase indicates the result of a run.
+type ReplicationRunPhase string
+
+const (
+	// ReplicationRunSucceeded means all images are copied.
+	ReplicationRunSucceeded ReplicationRunPhase = "Succeeded"
+	// ReplicationRunFailed means some images failed to be copied.
+	ReplicationRunFailed ReplicationRunPhase = "Failed"
+)
+
+// ReplicationRun represents the result of copying the images once.
+type ReplicationRun struct {
+	StartTime metav1.Time `json:"startTime,omitempty" protobuf:"bytes,1,opt,name=startTime"`
+	// +optional
+	CompletionTime metav1.Time            `json:"completionTime,omitempty" protobuf:"bytes,2,opt,name=completionTime"`
+	Trigger        ReplicationTriggerType `json:"trigger" protobuf:"bytes,3,opt,name=trigger,casttype=ReplicationTriggerType"`
+	Phase          ReplicationRunPhase    `json:"phase" protobuf:"bytes,4,opt,name=phase,casttype=ReplicationRunPhase"`
+	// Succeeded is the number of images copied.
+	// +optional
+	Succeeded int32 `json:"succeeded,omitempty" protobuf:"varint,5,opt,name=succeeded"`
+	// Failed is the number of images failed to be copied.
+	// +optional
+	Failed int32 `json:"failed,omitempty" protobuf:"varint,6,opt,name=failed"`
+	// Skipped is the number of images already in the destination.
+	// +optional
+	Skipped int32 `json:"skipped,omitempty" protobuf:"varint,7,opt,name=skipped"`
+	// Artifacts are the images copied or failed, the skipped ones are omitted.
+	// +optional
+	Artifacts []ReplicationArtifactStatus `json:"artifacts,omitempty" protobuf:"bytes,8,rep,name=artifacts"`
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,9,opt,name=message"`
+}
+
+// ReplicationArtifactPhase indicates the result of copying an image.
+type ReplicationArtifactPhase string
+
+const (
+	// ReplicationArtifactSucceeded means the image is copied.
+	ReplicationArtifactSucceeded ReplicationArtifactPhase = "Succeeded"
+	// ReplicationArtifactFailed means the image failed to be copied after all
+	// retries.
+	ReplicationArtifactFailed ReplicationArtifactPhase = "Failed"
+)
+
+// ReplicationArtifactStatus represents the result of copying an image.
+type ReplicationArtifactStatus struct {
+	Repository string                   `json:"repository" protobuf:"bytes,1,opt,name=repository"`
+	Tag        string                   `json:"tag" protobuf:"bytes,2,opt,name=tag"`
+	Digest     string                   `json:"digest,omitempty" protobuf:"bytes,3,opt,name=digest"`
+	Phase      ReplicationArtifactPhase `json:"phase" protobuf:"bytes,4,opt,name=phase,casttype=ReplicationArtifactPhase"`
+	// Retries is the times the copy was retried.
+	// +optional
+	Retries int32 `json:"retries,omitempty" protobuf:"varint,5,opt,name=retries"`
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TrustedKey is a public key of a tenant the signatures of the images are
+// verified with.
+type TrustedKey struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the desired identities of trusted key in this set.
+	// +optional
+	Spec TrustedKeySpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TrustedKeyList is the whole list of all trusted keys which owned by a
+// tenant.
+type TrustedKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of trusted keys
+	Items []TrustedKey `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// TrustedKeySpec is a description of a trusted key.
+type TrustedKeySpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	// +optional
+	DisplayName string `json:"displayName,omitempty" protobuf:"bytes,2,opt,name=displayName"`
+	// PublicKey is the PEM encoded public key the images are signed with by
+	// cosign, such as the cosign.pub generated by cosign generate-key-pair.
+	PublicKey string `json:"publicKey" protobuf:"bytes,3,opt,name=publicKey"`
 }
 
 // +genclient
@@ -371,6 +817,19 @@ type ChartProxyOptions struct {
 	Namespace string `json:"namespace,omitempty" protobuf:"bytes,3,opt,name=namespace"`
 }
 
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RepositoryScanOptions is the query options to a Repository scan call.
+type RepositoryScanOptions struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Tag is the tag to scan, all the tags of the repository are scanned if
+	// empty.
+	// +optional
+	Tag string `json:"tag,omitempty" protobuf:"bytes,1,opt,name=tag"`
+}
+
 // +genclient
 // +genclient:noVerbs
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -164,8 +164,24 @@ func (NamespaceList) SwaggerDoc() map[string]string {
 	return map_NamespaceList
 }
 
+var map_NamespaceProxy = map[string]string{
+	"":              "NamespaceProxy represents the upstream registry a namespace caches the images of.",
+	"url":           "URL is the address of the upstream registry, such as https://registry-1.docker.io.",
+	"namespace":     "Namespace is prepended to the names of the repositories pulled from the upstream registry, such as library for the official images of Docker Hub.",
+	"password":      "Password is returned to the administrator only, an update leaving it empty keeps the old one as long as the URL and username are the same.",
+	"tagTTLMinutes": "TagTTLMinutes is how long a cached tag is served before it is checked against the upstream registry again, defaults to 60 minutes.",
+}
+
+func (NamespaceProxy) SwaggerDoc() map[string]string {
+	return map_NamespaceProxy
+}
+
 var map_NamespaceSpec = map[string]string{
-	"": "NamespaceSpec is a description of a namespace.",
+	"":                    "NamespaceSpec is a description of a namespace.",
+	"vulnerabilityPolicy": "VulnerabilityPolicy blocks pulling the images of the namespace with vulnerabilities found, no image is blocked if nil.",
+	"retentionPolicy":     "RetentionPolicy deletes the tags of the repositories in the namespace that are not retained by its rules, no tag is deleted if nil.",
+	"proxy":               "Proxy makes the namespace a read-only pull-through cache of an upstream registry, the images are pushed to the namespace if nil.",
+	"signaturePolicy":     "SignaturePolicy blocks pulling the images of the namespace that are not signed by the trusted keys of the tenant, no image is blocked if nil.",
 }
 
 func (NamespaceSpec) SwaggerDoc() map[string]string {
@@ -173,13 +189,107 @@ func (NamespaceSpec) SwaggerDoc() map[string]string {
 }
 
 var map_NamespaceStatus = map[string]string{
-	"": "NamespaceStatus represents information about the status of a namespace.",
+	"":          "NamespaceStatus represents information about the status of a namespace.",
+	"retention": "Retention is the result of the last evaluation of the retention policy.",
 }
 
 func (NamespaceStatus) SwaggerDoc() map[string]string {
 	return map_NamespaceStatus
 }
 
+var map_ReplicationArtifact = map[string]string{
+	"": "ReplicationArtifact is a tag of a repository to copy.",
+}
+
+func (ReplicationArtifact) SwaggerDoc() map[string]string {
+	return map_ReplicationArtifact
+}
+
+var map_ReplicationArtifactStatus = map[string]string{
+	"":        "ReplicationArtifactStatus represents the result of copying an image.",
+	"retries": "Retries is the times the copy was retried.",
+}
+
+func (ReplicationArtifactStatus) SwaggerDoc() map[string]string {
+	return map_ReplicationArtifactStatus
+}
+
+var map_ReplicationEndpoint = map[string]string{
+	"":          "ReplicationEndpoint represents a registry images are copied between.",
+	"url":       "URL is the address of the registry, such as https://hub.example.com, unused for this registry.",
+	"namespace": "Namespace is the namespace of this registry, the project of harbor, or the path prefix of the repositories of a docker registry.",
+	"password":  "Password is returned to the administrator only, an update leaving it empty keeps the old one as long as the type, URL and username are the same.",
+}
+
+func (ReplicationEndpoint) SwaggerDoc() map[string]string {
+	return map_ReplicationEndpoint
+}
+
+var map_ReplicationPolicy = map[string]string{
+	"":     "ReplicationPolicy copies the images between the registry and the external registries.",
+	"spec": "Spec defines the desired identities of replication policy in this set.",
+}
+
+func (ReplicationPolicy) SwaggerDoc() map[string]string {
+	return map_ReplicationPolicy
+}
+
+var map_ReplicationPolicyList = map[string]string{
+	"":      "ReplicationPolicyList is the whole list of all replication policies which owned by a tenant.",
+	"items": "List of replication policies",
+}
+
+func (ReplicationPolicyList) SwaggerDoc() map[string]string {
+	return map_ReplicationPolicyList
+}
+
+var map_ReplicationPolicySpec = map[string]string{
+	"":                   "ReplicationPolicySpec is a description of a replication policy.",
+	"source":             "Source is the registry the images are copied from.",
+	"destination":        "Destination is the registry the images are copied to.",
+	"repositories":       "Repositories is a regular expression matching the names of the source repositories to copy, all repositories if empty.",
+	"includeTags":        "IncludeTags is a regular expression matching the tags to copy, all tags if empty.",
+	"excludeTags":        "ExcludeTags is a regular expression matching the tags never copied.",
+	"bandwidthLimitKBps": "BandwidthLimitKBps limits the kilobytes copied per second by a run, unlimited if zero.",
+	"maxRetries":         "MaxRetries is the times to retry copying an image before it fails.",
+}
+
+func (ReplicationPolicySpec) SwaggerDoc() map[string]string {
+	return map_ReplicationPolicySpec
+}
+
+var map_ReplicationPolicyStatus = map[string]string{
+	"":                 "ReplicationPolicyStatus represents information about the status of a replication policy.",
+	"pending":          "Pending are the images pushed to the source and waiting to be copied.",
+	"lastScheduleTime": "LastScheduleTime is the time the last scheduled run started.",
+	"runs":             "Runs are the results of the most recent runs, the latest first.",
+}
+
+func (ReplicationPolicyStatus) SwaggerDoc() map[string]string {
+	return map_ReplicationPolicyStatus
+}
+
+var map_ReplicationRun = map[string]string{
+	"":          "ReplicationRun represents the result of copying the images once.",
+	"succeeded": "Succeeded is the number of images copied.",
+	"failed":    "Failed is the number of images failed to be copied.",
+	"skipped":   "Skipped is the number of images already in the destination.",
+	"artifacts": "Artifacts are the images copied or failed, the skipped ones are omitted.",
+}
+
+func (ReplicationRun) SwaggerDoc() map[string]string {
+	return map_ReplicationRun
+}
+
+var map_ReplicationTrigger = map[string]string{
+	"":                "ReplicationTrigger represents when the images are copied.",
+	"intervalMinutes": "IntervalMinutes is the period between two scheduled runs.",
+}
+
+func (ReplicationTrigger) SwaggerDoc() map[string]string {
+	return map_ReplicationTrigger
+}
+
 var map_Repository = map[string]string{
 	"":     "Repository is a repo in namespace of registry.",
 	"spec": "Spec defines the desired identities of repository in this set.",
@@ -198,4 +308,147 @@ func (RepositoryList) SwaggerDoc() map[string]string {
 	return map_RepositoryList
 }
 
+var map_RepositoryScanOptions = map[string]string{
+	"":    "RepositoryScanOptions is the query options to a Repository scan call.",
+	"tag": "Tag is the tag to scan, all the tags of the repository are scanned if empty.",
+}
+
+func (RepositoryScanOptions) SwaggerDoc() map[string]string {
+	return map_RepositoryScanOptions
+}
+
+var map_RepositoryTag = map[string]string{
+	"scan":         "Scan represents the vulnerabilities found in the image of the tag.",
+	"lastPullTime": "LastPullTime is the time the tag was pulled last, never pulled if zero.",
+	"signature":    "Signature represents the signatures of the image of the tag.",
+}
+
+func (RepositoryTag) SwaggerDoc() map[string]string {
+	return map_RepositoryTag
+}
+
+var map_RetentionPolicy = map[string]string{
+	"":              "RetentionPolicy represents the tags to keep in the repositories of a namespace.",
+	"rules":         "Rules select the tags the policy applies to and the ones of them to keep. A tag selected by a rule is deleted unless one of the rules selecting it retains it, the tags selected by no rule are always kept.",
+	"intervalHours": "IntervalHours is the period between two evaluations of the policy, defaults to 24 hours.",
+	"dryRun":        "DryRun only reports the tags that would be deleted by the policy.",
+}
+
+func (RetentionPolicy) SwaggerDoc() map[string]string {
+	return map_RetentionPolicy
+}
+
+var map_RetentionRule = map[string]string{
+	"":                     "RetentionRule selects tags by repository and tag name and keeps the latest or recently pulled ones of them.",
+	"repositories":         "Repositories is a regular expression matching the names of the repositories the rule applies to, all repositories if empty.",
+	"includeTags":          "IncludeTags is a regular expression matching the tags the rule applies to, all tags if empty.",
+	"excludeTags":          "ExcludeTags is a regular expression matching the tags the rule never applies to.",
+	"keepLastN":            "KeepLastN keeps the N most recently pushed tags of each repository.",
+	"keepPulledWithinDays": "KeepPulledWithinDays keeps the tags pulled within the given days.",
+}
+
+func (RetentionRule) SwaggerDoc() map[string]string {
+	return map_RetentionRule
+}
+
+var map_RetentionStatus = map[string]string{
+	"":            "RetentionStatus represents the tags deleted by a retention policy.",
+	"lastRunTime": "LastRunTime is the time the policy was evaluated last.",
+	"dryRun":      "DryRun is true if the tags were only reported and not deleted.",
+	"tags":        "Tags are the tags deleted, or that would be deleted in dry run.",
+}
+
+func (RetentionStatus) SwaggerDoc() map[string]string {
+	return map_RetentionStatus
+}
+
+var map_RetentionTag = map[string]string{
+	"": "RetentionTag is a tag of a repository deleted by a retention policy.",
+}
+
+func (RetentionTag) SwaggerDoc() map[string]string {
+	return map_RetentionTag
+}
+
+var map_SignaturePolicy = map[string]string{
+	"":            "SignaturePolicy represents the signatures required to pull the images of a namespace.",
+	"trustedKeys": "TrustedKeys are the names of the trusted keys of the tenant one of which the images must be signed by, any trusted key of the tenant if empty.",
+}
+
+func (SignaturePolicy) SwaggerDoc() map[string]string {
+	return map_SignaturePolicy
+}
+
+var map_TagScan = map[string]string{
+	"":                "TagScan represents the result of scanning the image of a tag for vulnerabilities.",
+	"digest":          "Digest is the digest of the image scanned.",
+	"databaseVersion": "DatabaseVersion is the version of the vulnerability database scanned with.",
+	"lastScanTime":    "The last time the image was scanned.",
+	"summary":         "Summary represents the number of vulnerabilities of each severity.",
+	"message":         "A human readable message indicating why the scan failed.",
+}
+
+func (TagScan) SwaggerDoc() map[string]string {
+	return map_TagScan
+}
+
+var map_TagSignature = map[string]string{
+	"":               "TagSignature represents the cosign signatures stored for the image of a tag and the trusted keys they are verified with.",
+	"digest":         "Digest is the digest of the image verified.",
+	"signed":         "Signed is true if any signature of the image is stored in the repository, even if it is not verified by a trusted key.",
+	"keys":           "Keys are the names of the trusted keys of the tenant the image is signed by.",
+	"lastVerifyTime": "The last time the signatures were verified.",
+}
+
+func (TagSignature) SwaggerDoc() map[string]string {
+	return map_TagSignature
+}
+
+var map_TrustedKey = map[string]string{
+	"":     "TrustedKey is a public key of a tenant the signatures of the images are verified with.",
+	"spec": "Spec defines the desired identities of trusted key in this set.",
+}
+
+func (TrustedKey) SwaggerDoc() map[string]string {
+	return map_TrustedKey
+}
+
+var map_TrustedKeyList = map[string]string{
+	"":      "TrustedKeyList is the whole list of all trusted keys which owned by a tenant.",
+	"items": "List of trusted keys",
+}
+
+func (TrustedKeyList) SwaggerDoc() map[string]string {
+	return map_TrustedKeyList
+}
+
+var map_TrustedKeySpec = map[string]string{
+	"":          "TrustedKeySpec is a description of a trusted key.",
+	"publicKey": "PublicKey is the PEM encoded public key the images are signed with by cosign, such as the cosign.pub generated by cosign generate-key-pair.",
+}
+
+func (TrustedKeySpec) SwaggerDoc() map[string]string {
+	return map_TrustedKeySpec
+}
+
+var map_Vulnerability = map[string]string{
+	"":             "Vulnerability represents a vulnerability of a package installed in an image.",
+	"version":      "Version is the version of the package installed.",
+	"fixedVersion": "FixedVersion is the version of the package that fixes the vulnerability.",
+}
+
+func (Vulnerability) SwaggerDoc() map[string]string {
+	return map_Vulnerability
+}
+
+var map_VulnerabilityPolicy = map[string]string{
+	"":               "VulnerabilityPolicy represents the images that are not allowed to be pulled.",
+	"severity":       "Severity blocks pulling the images with vulnerabilities of the severity or higher.",
+	"blockUnscanned": "BlockUnscanned blocks pulling the images that have not been scanned.",
+}
+
+func (VulnerabilityPolicy) SwaggerDoc() map[string]string {
+	return map_VulnerabilityPolicy
+}
+
 // AUTO-GENERATED FUNCTIONS END HERE
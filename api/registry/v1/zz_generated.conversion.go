@@ -208,6 +208,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceProxy)(nil), (*registry.NamespaceProxy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceProxy_To_registry_NamespaceProxy(a.(*NamespaceProxy), b.(*registry.NamespaceProxy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.NamespaceProxy)(nil), (*NamespaceProxy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_NamespaceProxy_To_v1_NamespaceProxy(a.(*registry.NamespaceProxy), b.(*NamespaceProxy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamespaceSpec)(nil), (*registry.NamespaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_NamespaceSpec_To_registry_NamespaceSpec(a.(*NamespaceSpec), b.(*registry.NamespaceSpec), scope)
 	}); err != nil {
@@ -228,6 +238,96 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ReplicationArtifact)(nil), (*registry.ReplicationArtifact)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReplicationArtifact_To_registry_ReplicationArtifact(a.(*ReplicationArtifact), b.(*registry.ReplicationArtifact), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.ReplicationArtifact)(nil), (*ReplicationArtifact)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_ReplicationArtifact_To_v1_ReplicationArtifact(a.(*registry.ReplicationArtifact), b.(*ReplicationArtifact), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReplicationArtifactStatus)(nil), (*registry.ReplicationArtifactStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReplicationArtifactStatus_To_registry_ReplicationArtifactStatus(a.(*ReplicationArtifactStatus), b.(*registry.ReplicationArtifactStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.ReplicationArtifactStatus)(nil), (*ReplicationArtifactStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_ReplicationArtifactStatus_To_v1_ReplicationArtifactStatus(a.(*registry.ReplicationArtifactStatus), b.(*ReplicationArtifactStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReplicationEndpoint)(nil), (*registry.ReplicationEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReplicationEndpoint_To_registry_ReplicationEndpoint(a.(*ReplicationEndpoint), b.(*registry.ReplicationEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.ReplicationEndpoint)(nil), (*ReplicationEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_ReplicationEndpoint_To_v1_ReplicationEndpoint(a.(*registry.ReplicationEndpoint), b.(*ReplicationEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReplicationPolicy)(nil), (*registry.ReplicationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReplicationPolicy_To_registry_ReplicationPolicy(a.(*ReplicationPolicy), b.(*registry.ReplicationPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.ReplicationPolicy)(nil), (*ReplicationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_ReplicationPolicy_To_v1_ReplicationPolicy(a.(*registry.ReplicationPolicy), b.(*ReplicationPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReplicationPolicyList)(nil), (*registry.ReplicationPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReplicationPolicyList_To_registry_ReplicationPolicyList(a.(*ReplicationPolicyList), b.(*registry.ReplicationPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.ReplicationPolicyList)(nil), (*ReplicationPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_ReplicationPolicyList_To_v1_ReplicationPolicyList(a.(*registry.ReplicationPolicyList), b.(*ReplicationPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReplicationPolicySpec)(nil), (*registry.ReplicationPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReplicationPolicySpec_To_registry_ReplicationPolicySpec(a.(*ReplicationPolicySpec), b.(*registry.ReplicationPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.ReplicationPolicySpec)(nil), (*ReplicationPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_ReplicationPolicySpec_To_v1_ReplicationPolicySpec(a.(*registry.ReplicationPolicySpec), b.(*ReplicationPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReplicationPolicyStatus)(nil), (*registry.ReplicationPolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReplicationPolicyStatus_To_registry_ReplicationPolicyStatus(a.(*ReplicationPolicyStatus), b.(*registry.ReplicationPolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.ReplicationPolicyStatus)(nil), (*ReplicationPolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_ReplicationPolicyStatus_To_v1_ReplicationPolicyStatus(a.(*registry.ReplicationPolicyStatus), b.(*ReplicationPolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReplicationRun)(nil), (*registry.ReplicationRun)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReplicationRun_To_registry_ReplicationRun(a.(*ReplicationRun), b.(*registry.ReplicationRun), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.ReplicationRun)(nil), (*ReplicationRun)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_ReplicationRun_To_v1_ReplicationRun(a.(*registry.ReplicationRun), b.(*ReplicationRun), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReplicationTrigger)(nil), (*registry.ReplicationTrigger)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ReplicationTrigger_To_registry_ReplicationTrigger(a.(*ReplicationTrigger), b.(*registry.ReplicationTrigger), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.ReplicationTrigger)(nil), (*ReplicationTrigger)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_ReplicationTrigger_To_v1_ReplicationTrigger(a.(*registry.ReplicationTrigger), b.(*ReplicationTrigger), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Repository)(nil), (*registry.Repository)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Repository_To_registry_Repository(a.(*Repository), b.(*registry.Repository), scope)
 	}); err != nil {
@@ -248,6 +348,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RepositoryScanOptions)(nil), (*registry.RepositoryScanOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RepositoryScanOptions_To_registry_RepositoryScanOptions(a.(*RepositoryScanOptions), b.(*registry.RepositoryScanOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.RepositoryScanOptions)(nil), (*RepositoryScanOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_RepositoryScanOptions_To_v1_RepositoryScanOptions(a.(*registry.RepositoryScanOptions), b.(*RepositoryScanOptions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RepositorySpec)(nil), (*registry.RepositorySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_RepositorySpec_To_registry_RepositorySpec(a.(*RepositorySpec), b.(*registry.RepositorySpec), scope)
 	}); err != nil {
@@ -278,11 +388,136 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RetentionPolicy)(nil), (*registry.RetentionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RetentionPolicy_To_registry_RetentionPolicy(a.(*RetentionPolicy), b.(*registry.RetentionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.RetentionPolicy)(nil), (*RetentionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_RetentionPolicy_To_v1_RetentionPolicy(a.(*registry.RetentionPolicy), b.(*RetentionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RetentionRule)(nil), (*registry.RetentionRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RetentionRule_To_registry_RetentionRule(a.(*RetentionRule), b.(*registry.RetentionRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.RetentionRule)(nil), (*RetentionRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_RetentionRule_To_v1_RetentionRule(a.(*registry.RetentionRule), b.(*RetentionRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RetentionStatus)(nil), (*registry.RetentionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RetentionStatus_To_registry_RetentionStatus(a.(*RetentionStatus), b.(*registry.RetentionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.RetentionStatus)(nil), (*RetentionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_RetentionStatus_To_v1_RetentionStatus(a.(*registry.RetentionStatus), b.(*RetentionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RetentionTag)(nil), (*registry.RetentionTag)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RetentionTag_To_registry_RetentionTag(a.(*RetentionTag), b.(*registry.RetentionTag), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.RetentionTag)(nil), (*RetentionTag)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_RetentionTag_To_v1_RetentionTag(a.(*registry.RetentionTag), b.(*RetentionTag), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SignaturePolicy)(nil), (*registry.SignaturePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SignaturePolicy_To_registry_SignaturePolicy(a.(*SignaturePolicy), b.(*registry.SignaturePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.SignaturePolicy)(nil), (*SignaturePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_SignaturePolicy_To_v1_SignaturePolicy(a.(*registry.SignaturePolicy), b.(*SignaturePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TagScan)(nil), (*registry.TagScan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TagScan_To_registry_TagScan(a.(*TagScan), b.(*registry.TagScan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.TagScan)(nil), (*TagScan)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_TagScan_To_v1_TagScan(a.(*registry.TagScan), b.(*TagScan), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TagSignature)(nil), (*registry.TagSignature)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TagSignature_To_registry_TagSignature(a.(*TagSignature), b.(*registry.TagSignature), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.TagSignature)(nil), (*TagSignature)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_TagSignature_To_v1_TagSignature(a.(*registry.TagSignature), b.(*TagSignature), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrustedKey)(nil), (*registry.TrustedKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TrustedKey_To_registry_TrustedKey(a.(*TrustedKey), b.(*registry.TrustedKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.TrustedKey)(nil), (*TrustedKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_TrustedKey_To_v1_TrustedKey(a.(*registry.TrustedKey), b.(*TrustedKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrustedKeyList)(nil), (*registry.TrustedKeyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TrustedKeyList_To_registry_TrustedKeyList(a.(*TrustedKeyList), b.(*registry.TrustedKeyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.TrustedKeyList)(nil), (*TrustedKeyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_TrustedKeyList_To_v1_TrustedKeyList(a.(*registry.TrustedKeyList), b.(*TrustedKeyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrustedKeySpec)(nil), (*registry.TrustedKeySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TrustedKeySpec_To_registry_TrustedKeySpec(a.(*TrustedKeySpec), b.(*registry.TrustedKeySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.TrustedKeySpec)(nil), (*TrustedKeySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_TrustedKeySpec_To_v1_TrustedKeySpec(a.(*registry.TrustedKeySpec), b.(*TrustedKeySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Vulnerability)(nil), (*registry.Vulnerability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Vulnerability_To_registry_Vulnerability(a.(*Vulnerability), b.(*registry.Vulnerability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.Vulnerability)(nil), (*Vulnerability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_Vulnerability_To_v1_Vulnerability(a.(*registry.Vulnerability), b.(*Vulnerability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VulnerabilityPolicy)(nil), (*registry.VulnerabilityPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VulnerabilityPolicy_To_registry_VulnerabilityPolicy(a.(*VulnerabilityPolicy), b.(*registry.VulnerabilityPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*registry.VulnerabilityPolicy)(nil), (*VulnerabilityPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_registry_VulnerabilityPolicy_To_v1_VulnerabilityPolicy(a.(*registry.VulnerabilityPolicy), b.(*VulnerabilityPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*ChartProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_url_Values_To_v1_ChartProxyOptions(a.(*url.Values), b.(*ChartProxyOptions), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*RepositoryScanOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_RepositoryScanOptions(a.(*url.Values), b.(*RepositoryScanOptions), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -802,11 +1037,45 @@ func Convert_registry_NamespaceList_To_v1_NamespaceList(in *registry.NamespaceLi
 	return autoConvert_registry_NamespaceList_To_v1_NamespaceList(in, out, s)
 }
 
+func autoConvert_v1_NamespaceProxy_To_registry_NamespaceProxy(in *NamespaceProxy, out *registry.NamespaceProxy, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Namespace = in.Namespace
+	out.Username = in.Username
+	out.Password = in.Password
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	out.TagTTLMinutes = in.TagTTLMinutes
+	return nil
+}
+
+// Convert_v1_NamespaceProxy_To_registry_NamespaceProxy is an autogenerated conversion function.
+func Convert_v1_NamespaceProxy_To_registry_NamespaceProxy(in *NamespaceProxy, out *registry.NamespaceProxy, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceProxy_To_registry_NamespaceProxy(in, out, s)
+}
+
+func autoConvert_registry_NamespaceProxy_To_v1_NamespaceProxy(in *registry.NamespaceProxy, out *NamespaceProxy, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Namespace = in.Namespace
+	out.Username = in.Username
+	out.Password = in.Password
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	out.TagTTLMinutes = in.TagTTLMinutes
+	return nil
+}
+
+// Convert_registry_NamespaceProxy_To_v1_NamespaceProxy is an autogenerated conversion function.
+func Convert_registry_NamespaceProxy_To_v1_NamespaceProxy(in *registry.NamespaceProxy, out *NamespaceProxy, s conversion.Scope) error {
+	return autoConvert_registry_NamespaceProxy_To_v1_NamespaceProxy(in, out, s)
+}
+
 func autoConvert_v1_NamespaceSpec_To_registry_NamespaceSpec(in *NamespaceSpec, out *registry.NamespaceSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	out.TenantID = in.TenantID
 	out.DisplayName = in.DisplayName
 	out.Visibility = registry.Visibility(in.Visibility)
+	out.VulnerabilityPolicy = (*registry.VulnerabilityPolicy)(unsafe.Pointer(in.VulnerabilityPolicy))
+	out.RetentionPolicy = (*registry.RetentionPolicy)(unsafe.Pointer(in.RetentionPolicy))
+	out.Proxy = (*registry.NamespaceProxy)(unsafe.Pointer(in.Proxy))
+	out.SignaturePolicy = (*registry.SignaturePolicy)(unsafe.Pointer(in.SignaturePolicy))
 	return nil
 }
 
@@ -820,6 +1089,10 @@ func autoConvert_registry_NamespaceSpec_To_v1_NamespaceSpec(in *registry.Namespa
 	out.TenantID = in.TenantID
 	out.DisplayName = in.DisplayName
 	out.Visibility = Visibility(in.Visibility)
+	out.VulnerabilityPolicy = (*VulnerabilityPolicy)(unsafe.Pointer(in.VulnerabilityPolicy))
+	out.RetentionPolicy = (*RetentionPolicy)(unsafe.Pointer(in.RetentionPolicy))
+	out.Proxy = (*NamespaceProxy)(unsafe.Pointer(in.Proxy))
+	out.SignaturePolicy = (*SignaturePolicy)(unsafe.Pointer(in.SignaturePolicy))
 	return nil
 }
 
@@ -831,6 +1104,7 @@ func Convert_registry_NamespaceSpec_To_v1_NamespaceSpec(in *registry.NamespaceSp
 func autoConvert_v1_NamespaceStatus_To_registry_NamespaceStatus(in *NamespaceStatus, out *registry.NamespaceStatus, s conversion.Scope) error {
 	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
 	out.RepoCount = in.RepoCount
+	out.Retention = (*registry.RetentionStatus)(unsafe.Pointer(in.Retention))
 	return nil
 }
 
@@ -842,6 +1116,7 @@ func Convert_v1_NamespaceStatus_To_registry_NamespaceStatus(in *NamespaceStatus,
 func autoConvert_registry_NamespaceStatus_To_v1_NamespaceStatus(in *registry.NamespaceStatus, out *NamespaceStatus, s conversion.Scope) error {
 	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
 	out.RepoCount = in.RepoCount
+	out.Retention = (*RetentionStatus)(unsafe.Pointer(in.Retention))
 	return nil
 }
 
@@ -850,6 +1125,276 @@ func Convert_registry_NamespaceStatus_To_v1_NamespaceStatus(in *registry.Namespa
 	return autoConvert_registry_NamespaceStatus_To_v1_NamespaceStatus(in, out, s)
 }
 
+func autoConvert_v1_ReplicationArtifact_To_registry_ReplicationArtifact(in *ReplicationArtifact, out *registry.ReplicationArtifact, s conversion.Scope) error {
+	out.Repository = in.Repository
+	out.Tag = in.Tag
+	out.Digest = in.Digest
+	return nil
+}
+
+// Convert_v1_ReplicationArtifact_To_registry_ReplicationArtifact is an autogenerated conversion function.
+func Convert_v1_ReplicationArtifact_To_registry_ReplicationArtifact(in *ReplicationArtifact, out *registry.ReplicationArtifact, s conversion.Scope) error {
+	return autoConvert_v1_ReplicationArtifact_To_registry_ReplicationArtifact(in, out, s)
+}
+
+func autoConvert_registry_ReplicationArtifact_To_v1_ReplicationArtifact(in *registry.ReplicationArtifact, out *ReplicationArtifact, s conversion.Scope) error {
+	out.Repository = in.Repository
+	out.Tag = in.Tag
+	out.Digest = in.Digest
+	return nil
+}
+
+// Convert_registry_ReplicationArtifact_To_v1_ReplicationArtifact is an autogenerated conversion function.
+func Convert_registry_ReplicationArtifact_To_v1_ReplicationArtifact(in *registry.ReplicationArtifact, out *ReplicationArtifact, s conversion.Scope) error {
+	return autoConvert_registry_ReplicationArtifact_To_v1_ReplicationArtifact(in, out, s)
+}
+
+func autoConvert_v1_ReplicationArtifactStatus_To_registry_ReplicationArtifactStatus(in *ReplicationArtifactStatus, out *registry.ReplicationArtifactStatus, s conversion.Scope) error {
+	out.Repository = in.Repository
+	out.Tag = in.Tag
+	out.Digest = in.Digest
+	out.Phase = registry.ReplicationArtifactPhase(in.Phase)
+	out.Retries = in.Retries
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_ReplicationArtifactStatus_To_registry_ReplicationArtifactStatus is an autogenerated conversion function.
+func Convert_v1_ReplicationArtifactStatus_To_registry_ReplicationArtifactStatus(in *ReplicationArtifactStatus, out *registry.ReplicationArtifactStatus, s conversion.Scope) error {
+	return autoConvert_v1_ReplicationArtifactStatus_To_registry_ReplicationArtifactStatus(in, out, s)
+}
+
+func autoConvert_registry_ReplicationArtifactStatus_To_v1_ReplicationArtifactStatus(in *registry.ReplicationArtifactStatus, out *ReplicationArtifactStatus, s conversion.Scope) error {
+	out.Repository = in.Repository
+	out.Tag = in.Tag
+	out.Digest = in.Digest
+	out.Phase = ReplicationArtifactPhase(in.Phase)
+	out.Retries = in.Retries
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_registry_ReplicationArtifactStatus_To_v1_ReplicationArtifactStatus is an autogenerated conversion function.
+func Convert_registry_ReplicationArtifactStatus_To_v1_ReplicationArtifactStatus(in *registry.ReplicationArtifactStatus, out *ReplicationArtifactStatus, s conversion.Scope) error {
+	return autoConvert_registry_ReplicationArtifactStatus_To_v1_ReplicationArtifactStatus(in, out, s)
+}
+
+func autoConvert_v1_ReplicationEndpoint_To_registry_ReplicationEndpoint(in *ReplicationEndpoint, out *registry.ReplicationEndpoint, s conversion.Scope) error {
+	out.Type = registry.ReplicationEndpointType(in.Type)
+	out.URL = in.URL
+	out.Namespace = in.Namespace
+	out.Username = in.Username
+	out.Password = in.Password
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_v1_ReplicationEndpoint_To_registry_ReplicationEndpoint is an autogenerated conversion function.
+func Convert_v1_ReplicationEndpoint_To_registry_ReplicationEndpoint(in *ReplicationEndpoint, out *registry.ReplicationEndpoint, s conversion.Scope) error {
+	return autoConvert_v1_ReplicationEndpoint_To_registry_ReplicationEndpoint(in, out, s)
+}
+
+func autoConvert_registry_ReplicationEndpoint_To_v1_ReplicationEndpoint(in *registry.ReplicationEndpoint, out *ReplicationEndpoint, s conversion.Scope) error {
+	out.Type = ReplicationEndpointType(in.Type)
+	out.URL = in.URL
+	out.Namespace = in.Namespace
+	out.Username = in.Username
+	out.Password = in.Password
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_registry_ReplicationEndpoint_To_v1_ReplicationEndpoint is an autogenerated conversion function.
+func Convert_registry_ReplicationEndpoint_To_v1_ReplicationEndpoint(in *registry.ReplicationEndpoint, out *ReplicationEndpoint, s conversion.Scope) error {
+	return autoConvert_registry_ReplicationEndpoint_To_v1_ReplicationEndpoint(in, out, s)
+}
+
+func autoConvert_v1_ReplicationPolicy_To_registry_ReplicationPolicy(in *ReplicationPolicy, out *registry.ReplicationPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_ReplicationPolicySpec_To_registry_ReplicationPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ReplicationPolicyStatus_To_registry_ReplicationPolicyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ReplicationPolicy_To_registry_ReplicationPolicy is an autogenerated conversion function.
+func Convert_v1_ReplicationPolicy_To_registry_ReplicationPolicy(in *ReplicationPolicy, out *registry.ReplicationPolicy, s conversion.Scope) error {
+	return autoConvert_v1_ReplicationPolicy_To_registry_ReplicationPolicy(in, out, s)
+}
+
+func autoConvert_registry_ReplicationPolicy_To_v1_ReplicationPolicy(in *registry.ReplicationPolicy, out *ReplicationPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_registry_ReplicationPolicySpec_To_v1_ReplicationPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_registry_ReplicationPolicyStatus_To_v1_ReplicationPolicyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_registry_ReplicationPolicy_To_v1_ReplicationPolicy is an autogenerated conversion function.
+func Convert_registry_ReplicationPolicy_To_v1_ReplicationPolicy(in *registry.ReplicationPolicy, out *ReplicationPolicy, s conversion.Scope) error {
+	return autoConvert_registry_ReplicationPolicy_To_v1_ReplicationPolicy(in, out, s)
+}
+
+func autoConvert_v1_ReplicationPolicyList_To_registry_ReplicationPolicyList(in *ReplicationPolicyList, out *registry.ReplicationPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]registry.ReplicationPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ReplicationPolicyList_To_registry_ReplicationPolicyList is an autogenerated conversion function.
+func Convert_v1_ReplicationPolicyList_To_registry_ReplicationPolicyList(in *ReplicationPolicyList, out *registry.ReplicationPolicyList, s conversion.Scope) error {
+	return autoConvert_v1_ReplicationPolicyList_To_registry_ReplicationPolicyList(in, out, s)
+}
+
+func autoConvert_registry_ReplicationPolicyList_To_v1_ReplicationPolicyList(in *registry.ReplicationPolicyList, out *ReplicationPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ReplicationPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_registry_ReplicationPolicyList_To_v1_ReplicationPolicyList is an autogenerated conversion function.
+func Convert_registry_ReplicationPolicyList_To_v1_ReplicationPolicyList(in *registry.ReplicationPolicyList, out *ReplicationPolicyList, s conversion.Scope) error {
+	return autoConvert_registry_ReplicationPolicyList_To_v1_ReplicationPolicyList(in, out, s)
+}
+
+func autoConvert_v1_ReplicationPolicySpec_To_registry_ReplicationPolicySpec(in *ReplicationPolicySpec, out *registry.ReplicationPolicySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	if err := Convert_v1_ReplicationEndpoint_To_registry_ReplicationEndpoint(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ReplicationEndpoint_To_registry_ReplicationEndpoint(&in.Destination, &out.Destination, s); err != nil {
+		return err
+	}
+	out.Repositories = in.Repositories
+	out.IncludeTags = in.IncludeTags
+	out.ExcludeTags = in.ExcludeTags
+	if err := Convert_v1_ReplicationTrigger_To_registry_ReplicationTrigger(&in.Trigger, &out.Trigger, s); err != nil {
+		return err
+	}
+	out.BandwidthLimitKBps = in.BandwidthLimitKBps
+	out.MaxRetries = (*int32)(unsafe.Pointer(in.MaxRetries))
+	return nil
+}
+
+// Convert_v1_ReplicationPolicySpec_To_registry_ReplicationPolicySpec is an autogenerated conversion function.
+func Convert_v1_ReplicationPolicySpec_To_registry_ReplicationPolicySpec(in *ReplicationPolicySpec, out *registry.ReplicationPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1_ReplicationPolicySpec_To_registry_ReplicationPolicySpec(in, out, s)
+}
+
+func autoConvert_registry_ReplicationPolicySpec_To_v1_ReplicationPolicySpec(in *registry.ReplicationPolicySpec, out *ReplicationPolicySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	if err := Convert_registry_ReplicationEndpoint_To_v1_ReplicationEndpoint(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	if err := Convert_registry_ReplicationEndpoint_To_v1_ReplicationEndpoint(&in.Destination, &out.Destination, s); err != nil {
+		return err
+	}
+	out.Repositories = in.Repositories
+	out.IncludeTags = in.IncludeTags
+	out.ExcludeTags = in.ExcludeTags
+	if err := Convert_registry_ReplicationTrigger_To_v1_ReplicationTrigger(&in.Trigger, &out.Trigger, s); err != nil {
+		return err
+	}
+	out.BandwidthLimitKBps = in.BandwidthLimitKBps
+	out.MaxRetries = (*int32)(unsafe.Pointer(in.MaxRetries))
+	return nil
+}
+
+// Convert_registry_ReplicationPolicySpec_To_v1_ReplicationPolicySpec is an autogenerated conversion function.
+func Convert_registry_ReplicationPolicySpec_To_v1_ReplicationPolicySpec(in *registry.ReplicationPolicySpec, out *ReplicationPolicySpec, s conversion.Scope) error {
+	return autoConvert_registry_ReplicationPolicySpec_To_v1_ReplicationPolicySpec(in, out, s)
+}
+
+func autoConvert_v1_ReplicationPolicyStatus_To_registry_ReplicationPolicyStatus(in *ReplicationPolicyStatus, out *registry.ReplicationPolicyStatus, s conversion.Scope) error {
+	out.Pending = *(*[]registry.ReplicationArtifact)(unsafe.Pointer(&in.Pending))
+	out.LastScheduleTime = in.LastScheduleTime
+	out.Runs = *(*[]registry.ReplicationRun)(unsafe.Pointer(&in.Runs))
+	return nil
+}
+
+// Convert_v1_ReplicationPolicyStatus_To_registry_ReplicationPolicyStatus is an autogenerated conversion function.
+func Convert_v1_ReplicationPolicyStatus_To_registry_ReplicationPolicyStatus(in *ReplicationPolicyStatus, out *registry.ReplicationPolicyStatus, s conversion.Scope) error {
+	return autoConvert_v1_ReplicationPolicyStatus_To_registry_ReplicationPolicyStatus(in, out, s)
+}
+
+func autoConvert_registry_ReplicationPolicyStatus_To_v1_ReplicationPolicyStatus(in *registry.ReplicationPolicyStatus, out *ReplicationPolicyStatus, s conversion.Scope) error {
+	out.Pending = *(*[]ReplicationArtifact)(unsafe.Pointer(&in.Pending))
+	out.LastScheduleTime = in.LastScheduleTime
+	out.Runs = *(*[]ReplicationRun)(unsafe.Pointer(&in.Runs))
+	return nil
+}
+
+// Convert_registry_ReplicationPolicyStatus_To_v1_ReplicationPolicyStatus is an autogenerated conversion function.
+func Convert_registry_ReplicationPolicyStatus_To_v1_ReplicationPolicyStatus(in *registry.ReplicationPolicyStatus, out *ReplicationPolicyStatus, s conversion.Scope) error {
+	return autoConvert_registry_ReplicationPolicyStatus_To_v1_ReplicationPolicyStatus(in, out, s)
+}
+
+func autoConvert_v1_ReplicationRun_To_registry_ReplicationRun(in *ReplicationRun, out *registry.ReplicationRun, s conversion.Scope) error {
+	out.StartTime = in.StartTime
+	out.CompletionTime = in.CompletionTime
+	out.Trigger = registry.ReplicationTriggerType(in.Trigger)
+	out.Phase = registry.ReplicationRunPhase(in.Phase)
+	out.Succeeded = in.Succeeded
+	out.Failed = in.Failed
+	out.Skipped = in.Skipped
+	out.Artifacts = *(*[]registry.ReplicationArtifactStatus)(unsafe.Pointer(&in.Artifacts))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_ReplicationRun_To_registry_ReplicationRun is an autogenerated conversion function.
+func Convert_v1_ReplicationRun_To_registry_ReplicationRun(in *ReplicationRun, out *registry.ReplicationRun, s conversion.Scope) error {
+	return autoConvert_v1_ReplicationRun_To_registry_ReplicationRun(in, out, s)
+}
+
+func autoConvert_registry_ReplicationRun_To_v1_ReplicationRun(in *registry.ReplicationRun, out *ReplicationRun, s conversion.Scope) error {
+	out.StartTime = in.StartTime
+	out.CompletionTime = in.CompletionTime
+	out.Trigger = ReplicationTriggerType(in.Trigger)
+	out.Phase = ReplicationRunPhase(in.Phase)
+	out.Succeeded = in.Succeeded
+	out.Failed = in.Failed
+	out.Skipped = in.Skipped
+	out.Artifacts = *(*[]ReplicationArtifactStatus)(unsafe.Pointer(&in.Artifacts))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_registry_ReplicationRun_To_v1_ReplicationRun is an autogenerated conversion function.
+func Convert_registry_ReplicationRun_To_v1_ReplicationRun(in *registry.ReplicationRun, out *ReplicationRun, s conversion.Scope) error {
+	return autoConvert_registry_ReplicationRun_To_v1_ReplicationRun(in, out, s)
+}
+
+func autoConvert_v1_ReplicationTrigger_To_registry_ReplicationTrigger(in *ReplicationTrigger, out *registry.ReplicationTrigger, s conversion.Scope) error {
+	out.Type = registry.ReplicationTriggerType(in.Type)
+	out.IntervalMinutes = in.IntervalMinutes
+	return nil
+}
+
+// Convert_v1_ReplicationTrigger_To_registry_ReplicationTrigger is an autogenerated conversion function.
+func Convert_v1_ReplicationTrigger_To_registry_ReplicationTrigger(in *ReplicationTrigger, out *registry.ReplicationTrigger, s conversion.Scope) error {
+	return autoConvert_v1_ReplicationTrigger_To_registry_ReplicationTrigger(in, out, s)
+}
+
+func autoConvert_registry_ReplicationTrigger_To_v1_ReplicationTrigger(in *registry.ReplicationTrigger, out *ReplicationTrigger, s conversion.Scope) error {
+	out.Type = ReplicationTriggerType(in.Type)
+	out.IntervalMinutes = in.IntervalMinutes
+	return nil
+}
+
+// Convert_registry_ReplicationTrigger_To_v1_ReplicationTrigger is an autogenerated conversion function.
+func Convert_registry_ReplicationTrigger_To_v1_ReplicationTrigger(in *registry.ReplicationTrigger, out *ReplicationTrigger, s conversion.Scope) error {
+	return autoConvert_registry_ReplicationTrigger_To_v1_ReplicationTrigger(in, out, s)
+}
+
 func autoConvert_v1_Repository_To_registry_Repository(in *Repository, out *registry.Repository, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_RepositorySpec_To_registry_RepositorySpec(&in.Spec, &out.Spec, s); err != nil {
@@ -904,6 +1449,44 @@ func Convert_registry_RepositoryList_To_v1_RepositoryList(in *registry.Repositor
 	return autoConvert_registry_RepositoryList_To_v1_RepositoryList(in, out, s)
 }
 
+func autoConvert_v1_RepositoryScanOptions_To_registry_RepositoryScanOptions(in *RepositoryScanOptions, out *registry.RepositoryScanOptions, s conversion.Scope) error {
+	out.Tag = in.Tag
+	return nil
+}
+
+// Convert_v1_RepositoryScanOptions_To_registry_RepositoryScanOptions is an autogenerated conversion function.
+func Convert_v1_RepositoryScanOptions_To_registry_RepositoryScanOptions(in *RepositoryScanOptions, out *registry.RepositoryScanOptions, s conversion.Scope) error {
+	return autoConvert_v1_RepositoryScanOptions_To_registry_RepositoryScanOptions(in, out, s)
+}
+
+func autoConvert_registry_RepositoryScanOptions_To_v1_RepositoryScanOptions(in *registry.RepositoryScanOptions, out *RepositoryScanOptions, s conversion.Scope) error {
+	out.Tag = in.Tag
+	return nil
+}
+
+// Convert_registry_RepositoryScanOptions_To_v1_RepositoryScanOptions is an autogenerated conversion function.
+func Convert_registry_RepositoryScanOptions_To_v1_RepositoryScanOptions(in *registry.RepositoryScanOptions, out *RepositoryScanOptions, s conversion.Scope) error {
+	return autoConvert_registry_RepositoryScanOptions_To_v1_RepositoryScanOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1_RepositoryScanOptions(in *url.Values, out *RepositoryScanOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["tag"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Tag, s); err != nil {
+			return err
+		}
+	} else {
+		out.Tag = ""
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1_RepositoryScanOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1_RepositoryScanOptions(in *url.Values, out *RepositoryScanOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1_RepositoryScanOptions(in, out, s)
+}
+
 func autoConvert_v1_RepositorySpec_To_registry_RepositorySpec(in *RepositorySpec, out *registry.RepositorySpec, s conversion.Scope) error {
 	out.Name = in.Name
 	out.TenantID = in.TenantID
@@ -960,6 +1543,9 @@ func autoConvert_v1_RepositoryTag_To_registry_RepositoryTag(in *RepositoryTag, o
 	out.Name = in.Name
 	out.Digest = in.Digest
 	out.TimeCreated = in.TimeCreated
+	out.Scan = (*registry.TagScan)(unsafe.Pointer(in.Scan))
+	out.LastPullTime = in.LastPullTime
+	out.Signature = (*registry.TagSignature)(unsafe.Pointer(in.Signature))
 	return nil
 }
 
@@ -972,6 +1558,9 @@ func autoConvert_registry_RepositoryTag_To_v1_RepositoryTag(in *registry.Reposit
 	out.Name = in.Name
 	out.Digest = in.Digest
 	out.TimeCreated = in.TimeCreated
+	out.Scan = (*TagScan)(unsafe.Pointer(in.Scan))
+	out.LastPullTime = in.LastPullTime
+	out.Signature = (*TagSignature)(unsafe.Pointer(in.Signature))
 	return nil
 }
 
@@ -979,3 +1568,307 @@ func autoConvert_registry_RepositoryTag_To_v1_RepositoryTag(in *registry.Reposit
 func Convert_registry_RepositoryTag_To_v1_RepositoryTag(in *registry.RepositoryTag, out *RepositoryTag, s conversion.Scope) error {
 	return autoConvert_registry_RepositoryTag_To_v1_RepositoryTag(in, out, s)
 }
+
+func autoConvert_v1_RetentionPolicy_To_registry_RetentionPolicy(in *RetentionPolicy, out *registry.RetentionPolicy, s conversion.Scope) error {
+	out.Rules = *(*[]registry.RetentionRule)(unsafe.Pointer(&in.Rules))
+	out.IntervalHours = in.IntervalHours
+	out.DryRun = in.DryRun
+	return nil
+}
+
+// Convert_v1_RetentionPolicy_To_registry_RetentionPolicy is an autogenerated conversion function.
+func Convert_v1_RetentionPolicy_To_registry_RetentionPolicy(in *RetentionPolicy, out *registry.RetentionPolicy, s conversion.Scope) error {
+	return autoConvert_v1_RetentionPolicy_To_registry_RetentionPolicy(in, out, s)
+}
+
+func autoConvert_registry_RetentionPolicy_To_v1_RetentionPolicy(in *registry.RetentionPolicy, out *RetentionPolicy, s conversion.Scope) error {
+	out.Rules = *(*[]RetentionRule)(unsafe.Pointer(&in.Rules))
+	out.IntervalHours = in.IntervalHours
+	out.DryRun = in.DryRun
+	return nil
+}
+
+// Convert_registry_RetentionPolicy_To_v1_RetentionPolicy is an autogenerated conversion function.
+func Convert_registry_RetentionPolicy_To_v1_RetentionPolicy(in *registry.RetentionPolicy, out *RetentionPolicy, s conversion.Scope) error {
+	return autoConvert_registry_RetentionPolicy_To_v1_RetentionPolicy(in, out, s)
+}
+
+func autoConvert_v1_RetentionRule_To_registry_RetentionRule(in *RetentionRule, out *registry.RetentionRule, s conversion.Scope) error {
+	out.Repositories = in.Repositories
+	out.IncludeTags = in.IncludeTags
+	out.ExcludeTags = in.ExcludeTags
+	out.KeepLastN = in.KeepLastN
+	out.KeepPulledWithinDays = in.KeepPulledWithinDays
+	return nil
+}
+
+// Convert_v1_RetentionRule_To_registry_RetentionRule is an autogenerated conversion function.
+func Convert_v1_RetentionRule_To_registry_RetentionRule(in *RetentionRule, out *registry.RetentionRule, s conversion.Scope) error {
+	return autoConvert_v1_RetentionRule_To_registry_RetentionRule(in, out, s)
+}
+
+func autoConvert_registry_RetentionRule_To_v1_RetentionRule(in *registry.RetentionRule, out *RetentionRule, s conversion.Scope) error {
+	out.Repositories = in.Repositories
+	out.IncludeTags = in.IncludeTags
+	out.ExcludeTags = in.ExcludeTags
+	out.KeepLastN = in.KeepLastN
+	out.KeepPulledWithinDays = in.KeepPulledWithinDays
+	return nil
+}
+
+// Convert_registry_RetentionRule_To_v1_RetentionRule is an autogenerated conversion function.
+func Convert_registry_RetentionRule_To_v1_RetentionRule(in *registry.RetentionRule, out *RetentionRule, s conversion.Scope) error {
+	return autoConvert_registry_RetentionRule_To_v1_RetentionRule(in, out, s)
+}
+
+func autoConvert_v1_RetentionStatus_To_registry_RetentionStatus(in *RetentionStatus, out *registry.RetentionStatus, s conversion.Scope) error {
+	out.LastRunTime = in.LastRunTime
+	out.DryRun = in.DryRun
+	out.Tags = *(*[]registry.RetentionTag)(unsafe.Pointer(&in.Tags))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_RetentionStatus_To_registry_RetentionStatus is an autogenerated conversion function.
+func Convert_v1_RetentionStatus_To_registry_RetentionStatus(in *RetentionStatus, out *registry.RetentionStatus, s conversion.Scope) error {
+	return autoConvert_v1_RetentionStatus_To_registry_RetentionStatus(in, out, s)
+}
+
+func autoConvert_registry_RetentionStatus_To_v1_RetentionStatus(in *registry.RetentionStatus, out *RetentionStatus, s conversion.Scope) error {
+	out.LastRunTime = in.LastRunTime
+	out.DryRun = in.DryRun
+	out.Tags = *(*[]RetentionTag)(unsafe.Pointer(&in.Tags))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_registry_RetentionStatus_To_v1_RetentionStatus is an autogenerated conversion function.
+func Convert_registry_RetentionStatus_To_v1_RetentionStatus(in *registry.RetentionStatus, out *RetentionStatus, s conversion.Scope) error {
+	return autoConvert_registry_RetentionStatus_To_v1_RetentionStatus(in, out, s)
+}
+
+func autoConvert_v1_RetentionTag_To_registry_RetentionTag(in *RetentionTag, out *registry.RetentionTag, s conversion.Scope) error {
+	out.Repository = in.Repository
+	out.Tag = in.Tag
+	out.Digest = in.Digest
+	return nil
+}
+
+// Convert_v1_RetentionTag_To_registry_RetentionTag is an autogenerated conversion function.
+func Convert_v1_RetentionTag_To_registry_RetentionTag(in *RetentionTag, out *registry.RetentionTag, s conversion.Scope) error {
+	return autoConvert_v1_RetentionTag_To_registry_RetentionTag(in, out, s)
+}
+
+func autoConvert_registry_RetentionTag_To_v1_RetentionTag(in *registry.RetentionTag, out *RetentionTag, s conversion.Scope) error {
+	out.Repository = in.Repository
+	out.Tag = in.Tag
+	out.Digest = in.Digest
+	return nil
+}
+
+// Convert_registry_RetentionTag_To_v1_RetentionTag is an autogenerated conversion function.
+func Convert_registry_RetentionTag_To_v1_RetentionTag(in *registry.RetentionTag, out *RetentionTag, s conversion.Scope) error {
+	return autoConvert_registry_RetentionTag_To_v1_RetentionTag(in, out, s)
+}
+
+func autoConvert_v1_SignaturePolicy_To_registry_SignaturePolicy(in *SignaturePolicy, out *registry.SignaturePolicy, s conversion.Scope) error {
+	out.TrustedKeys = *(*[]string)(unsafe.Pointer(&in.TrustedKeys))
+	return nil
+}
+
+// Convert_v1_SignaturePolicy_To_registry_SignaturePolicy is an autogenerated conversion function.
+func Convert_v1_SignaturePolicy_To_registry_SignaturePolicy(in *SignaturePolicy, out *registry.SignaturePolicy, s conversion.Scope) error {
+	return autoConvert_v1_SignaturePolicy_To_registry_SignaturePolicy(in, out, s)
+}
+
+func autoConvert_registry_SignaturePolicy_To_v1_SignaturePolicy(in *registry.SignaturePolicy, out *SignaturePolicy, s conversion.Scope) error {
+	out.TrustedKeys = *(*[]string)(unsafe.Pointer(&in.TrustedKeys))
+	return nil
+}
+
+// Convert_registry_SignaturePolicy_To_v1_SignaturePolicy is an autogenerated conversion function.
+func Convert_registry_SignaturePolicy_To_v1_SignaturePolicy(in *registry.SignaturePolicy, out *SignaturePolicy, s conversion.Scope) error {
+	return autoConvert_registry_SignaturePolicy_To_v1_SignaturePolicy(in, out, s)
+}
+
+func autoConvert_v1_TagScan_To_registry_TagScan(in *TagScan, out *registry.TagScan, s conversion.Scope) error {
+	out.Phase = registry.TagScanPhase(in.Phase)
+	out.Digest = in.Digest
+	out.DatabaseVersion = in.DatabaseVersion
+	out.LastScanTime = in.LastScanTime
+	out.Summary = *(*map[string]int32)(unsafe.Pointer(&in.Summary))
+	out.Vulnerabilities = *(*[]registry.Vulnerability)(unsafe.Pointer(&in.Vulnerabilities))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_TagScan_To_registry_TagScan is an autogenerated conversion function.
+func Convert_v1_TagScan_To_registry_TagScan(in *TagScan, out *registry.TagScan, s conversion.Scope) error {
+	return autoConvert_v1_TagScan_To_registry_TagScan(in, out, s)
+}
+
+func autoConvert_registry_TagScan_To_v1_TagScan(in *registry.TagScan, out *TagScan, s conversion.Scope) error {
+	out.Phase = TagScanPhase(in.Phase)
+	out.Digest = in.Digest
+	out.DatabaseVersion = in.DatabaseVersion
+	out.LastScanTime = in.LastScanTime
+	out.Summary = *(*map[string]int32)(unsafe.Pointer(&in.Summary))
+	out.Vulnerabilities = *(*[]Vulnerability)(unsafe.Pointer(&in.Vulnerabilities))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_registry_TagScan_To_v1_TagScan is an autogenerated conversion function.
+func Convert_registry_TagScan_To_v1_TagScan(in *registry.TagScan, out *TagScan, s conversion.Scope) error {
+	return autoConvert_registry_TagScan_To_v1_TagScan(in, out, s)
+}
+
+func autoConvert_v1_TagSignature_To_registry_TagSignature(in *TagSignature, out *registry.TagSignature, s conversion.Scope) error {
+	out.Digest = in.Digest
+	out.Signed = in.Signed
+	out.Keys = *(*[]string)(unsafe.Pointer(&in.Keys))
+	out.LastVerifyTime = in.LastVerifyTime
+	return nil
+}
+
+// Convert_v1_TagSignature_To_registry_TagSignature is an autogenerated conversion function.
+func Convert_v1_TagSignature_To_registry_TagSignature(in *TagSignature, out *registry.TagSignature, s conversion.Scope) error {
+	return autoConvert_v1_TagSignature_To_registry_TagSignature(in, out, s)
+}
+
+func autoConvert_registry_TagSignature_To_v1_TagSignature(in *registry.TagSignature, out *TagSignature, s conversion.Scope) error {
+	out.Digest = in.Digest
+	out.Signed = in.Signed
+	out.Keys = *(*[]string)(unsafe.Pointer(&in.Keys))
+	out.LastVerifyTime = in.LastVerifyTime
+	return nil
+}
+
+// Convert_registry_TagSignature_To_v1_TagSignature is an autogenerated conversion function.
+func Convert_registry_TagSignature_To_v1_TagSignature(in *registry.TagSignature, out *TagSignature, s conversion.Scope) error {
+	return autoConvert_registry_TagSignature_To_v1_TagSignature(in, out, s)
+}
+
+func autoConvert_v1_TrustedKey_To_registry_TrustedKey(in *TrustedKey, out *registry.TrustedKey, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_TrustedKeySpec_To_registry_TrustedKeySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_TrustedKey_To_registry_TrustedKey is an autogenerated conversion function.
+func Convert_v1_TrustedKey_To_registry_TrustedKey(in *TrustedKey, out *registry.TrustedKey, s conversion.Scope) error {
+	return autoConvert_v1_TrustedKey_To_registry_TrustedKey(in, out, s)
+}
+
+func autoConvert_registry_TrustedKey_To_v1_TrustedKey(in *registry.TrustedKey, out *TrustedKey, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_registry_TrustedKeySpec_To_v1_TrustedKeySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_registry_TrustedKey_To_v1_TrustedKey is an autogenerated conversion function.
+func Convert_registry_TrustedKey_To_v1_TrustedKey(in *registry.TrustedKey, out *TrustedKey, s conversion.Scope) error {
+	return autoConvert_registry_TrustedKey_To_v1_TrustedKey(in, out, s)
+}
+
+func autoConvert_v1_TrustedKeyList_To_registry_TrustedKeyList(in *TrustedKeyList, out *registry.TrustedKeyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]registry.TrustedKey)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_TrustedKeyList_To_registry_TrustedKeyList is an autogenerated conversion function.
+func Convert_v1_TrustedKeyList_To_registry_TrustedKeyList(in *TrustedKeyList, out *registry.TrustedKeyList, s conversion.Scope) error {
+	return autoConvert_v1_TrustedKeyList_To_registry_TrustedKeyList(in, out, s)
+}
+
+func autoConvert_registry_TrustedKeyList_To_v1_TrustedKeyList(in *registry.TrustedKeyList, out *TrustedKeyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]TrustedKey)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_registry_TrustedKeyList_To_v1_TrustedKeyList is an autogenerated conversion function.
+func Convert_registry_TrustedKeyList_To_v1_TrustedKeyList(in *registry.TrustedKeyList, out *TrustedKeyList, s conversion.Scope) error {
+	return autoConvert_registry_TrustedKeyList_To_v1_TrustedKeyList(in, out, s)
+}
+
+func autoConvert_v1_TrustedKeySpec_To_registry_TrustedKeySpec(in *TrustedKeySpec, out *registry.TrustedKeySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.PublicKey = in.PublicKey
+	return nil
+}
+
+// Convert_v1_TrustedKeySpec_To_registry_TrustedKeySpec is an autogenerated conversion function.
+func Convert_v1_TrustedKeySpec_To_registry_TrustedKeySpec(in *TrustedKeySpec, out *registry.TrustedKeySpec, s conversion.Scope) error {
+	return autoConvert_v1_TrustedKeySpec_To_registry_TrustedKeySpec(in, out, s)
+}
+
+func autoConvert_registry_TrustedKeySpec_To_v1_TrustedKeySpec(in *registry.TrustedKeySpec, out *TrustedKeySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.PublicKey = in.PublicKey
+	return nil
+}
+
+// Convert_registry_TrustedKeySpec_To_v1_TrustedKeySpec is an autogenerated conversion function.
+func Convert_registry_TrustedKeySpec_To_v1_TrustedKeySpec(in *registry.TrustedKeySpec, out *TrustedKeySpec, s conversion.Scope) error {
+	return autoConvert_registry_TrustedKeySpec_To_v1_TrustedKeySpec(in, out, s)
+}
+
+func autoConvert_v1_Vulnerability_To_registry_Vulnerability(in *Vulnerability, out *registry.Vulnerability, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Severity = registry.VulnerabilitySeverity(in.Severity)
+	out.Package = in.Package
+	out.Version = in.Version
+	out.FixedVersion = in.FixedVersion
+	out.Title = in.Title
+	return nil
+}
+
+// Convert_v1_Vulnerability_To_registry_Vulnerability is an autogenerated conversion function.
+func Convert_v1_Vulnerability_To_registry_Vulnerability(in *Vulnerability, out *registry.Vulnerability, s conversion.Scope) error {
+	return autoConvert_v1_Vulnerability_To_registry_Vulnerability(in, out, s)
+}
+
+func autoConvert_registry_Vulnerability_To_v1_Vulnerability(in *registry.Vulnerability, out *Vulnerability, s conversion.Scope) error {
+	out.ID = in.ID
+	out.Severity = VulnerabilitySeverity(in.Severity)
+	out.Package = in.Package
+	out.Version = in.Version
+	out.FixedVersion = in.FixedVersion
+	out.Title = in.Title
+	return nil
+}
+
+// Convert_registry_Vulnerability_To_v1_Vulnerability is an autogenerated conversion function.
+func Convert_registry_Vulnerability_To_v1_Vulnerability(in *registry.Vulnerability, out *Vulnerability, s conversion.Scope) error {
+	return autoConvert_registry_Vulnerability_To_v1_Vulnerability(in, out, s)
+}
+
+func autoConvert_v1_VulnerabilityPolicy_To_registry_VulnerabilityPolicy(in *VulnerabilityPolicy, out *registry.VulnerabilityPolicy, s conversion.Scope) error {
+	out.Severity = registry.VulnerabilitySeverity(in.Severity)
+	out.BlockUnscanned = in.BlockUnscanned
+	return nil
+}
+
+// Convert_v1_VulnerabilityPolicy_To_registry_VulnerabilityPolicy is an autogenerated conversion function.
+func Convert_v1_VulnerabilityPolicy_To_registry_VulnerabilityPolicy(in *VulnerabilityPolicy, out *registry.VulnerabilityPolicy, s conversion.Scope) error {
+	return autoConvert_v1_VulnerabilityPolicy_To_registry_VulnerabilityPolicy(in, out, s)
+}
+
+func autoConvert_registry_VulnerabilityPolicy_To_v1_VulnerabilityPolicy(in *registry.VulnerabilityPolicy, out *VulnerabilityPolicy, s conversion.Scope) error {
+	out.Severity = VulnerabilitySeverity(in.Severity)
+	out.BlockUnscanned = in.BlockUnscanned
+	return nil
+}
+
+// Convert_registry_VulnerabilityPolicy_To_v1_VulnerabilityPolicy is an autogenerated conversion function.
+func Convert_registry_VulnerabilityPolicy_To_v1_VulnerabilityPolicy(in *registry.VulnerabilityPolicy, out *VulnerabilityPolicy, s conversion.Scope) error {
+	return autoConvert_registry_VulnerabilityPolicy_To_v1_VulnerabilityPolicy(in, out, s)
+}
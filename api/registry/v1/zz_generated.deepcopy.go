@@ -462,7 +462,7 @@ func (in *Namespace) DeepCopyInto(out *Namespace) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -518,9 +518,45 @@ func (in *NamespaceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceProxy) DeepCopyInto(out *NamespaceProxy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceProxy.
+func (in *NamespaceProxy) DeepCopy() *NamespaceProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespaceSpec) DeepCopyInto(out *NamespaceSpec) {
 	*out = *in
+	if in.VulnerabilityPolicy != nil {
+		in, out := &in.VulnerabilityPolicy, &out.VulnerabilityPolicy
+		*out = new(VulnerabilityPolicy)
+		**out = **in
+	}
+	if in.RetentionPolicy != nil {
+		in, out := &in.RetentionPolicy, &out.RetentionPolicy
+		*out = new(RetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(NamespaceProxy)
+		**out = **in
+	}
+	if in.SignaturePolicy != nil {
+		in, out := &in.SignaturePolicy, &out.SignaturePolicy
+		*out = new(SignaturePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -542,6 +578,11 @@ func (in *NamespaceStatus) DeepCopyInto(out *NamespaceStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(RetentionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -555,6 +596,207 @@ func (in *NamespaceStatus) DeepCopy() *NamespaceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationArtifact) DeepCopyInto(out *ReplicationArtifact) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationArtifact.
+func (in *ReplicationArtifact) DeepCopy() *ReplicationArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationArtifactStatus) DeepCopyInto(out *ReplicationArtifactStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationArtifactStatus.
+func (in *ReplicationArtifactStatus) DeepCopy() *ReplicationArtifactStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationArtifactStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationEndpoint) DeepCopyInto(out *ReplicationEndpoint) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationEndpoint.
+func (in *ReplicationEndpoint) DeepCopy() *ReplicationEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPolicy) DeepCopyInto(out *ReplicationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPolicy.
+func (in *ReplicationPolicy) DeepCopy() *ReplicationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPolicyList) DeepCopyInto(out *ReplicationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReplicationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPolicyList.
+func (in *ReplicationPolicyList) DeepCopy() *ReplicationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReplicationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPolicySpec) DeepCopyInto(out *ReplicationPolicySpec) {
+	*out = *in
+	out.Source = in.Source
+	out.Destination = in.Destination
+	out.Trigger = in.Trigger
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPolicySpec.
+func (in *ReplicationPolicySpec) DeepCopy() *ReplicationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationPolicyStatus) DeepCopyInto(out *ReplicationPolicyStatus) {
+	*out = *in
+	if in.Pending != nil {
+		in, out := &in.Pending, &out.Pending
+		*out = make([]ReplicationArtifact, len(*in))
+		copy(*out, *in)
+	}
+	in.LastScheduleTime.DeepCopyInto(&out.LastScheduleTime)
+	if in.Runs != nil {
+		in, out := &in.Runs, &out.Runs
+		*out = make([]ReplicationRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationPolicyStatus.
+func (in *ReplicationPolicyStatus) DeepCopy() *ReplicationPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationRun) DeepCopyInto(out *ReplicationRun) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.CompletionTime.DeepCopyInto(&out.CompletionTime)
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]ReplicationArtifactStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationRun.
+func (in *ReplicationRun) DeepCopy() *ReplicationRun {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationTrigger) DeepCopyInto(out *ReplicationTrigger) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationTrigger.
+func (in *ReplicationTrigger) DeepCopy() *ReplicationTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Repository) DeepCopyInto(out *Repository) {
 	*out = *in
@@ -616,6 +858,31 @@ func (in *RepositoryList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryScanOptions) DeepCopyInto(out *RepositoryScanOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryScanOptions.
+func (in *RepositoryScanOptions) DeepCopy() *RepositoryScanOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryScanOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryScanOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepositorySpec) DeepCopyInto(out *RepositorySpec) {
 	*out = *in
@@ -664,6 +931,17 @@ func (in *RepositoryStatus) DeepCopy() *RepositoryStatus {
 func (in *RepositoryTag) DeepCopyInto(out *RepositoryTag) {
 	*out = *in
 	in.TimeCreated.DeepCopyInto(&out.TimeCreated)
+	if in.Scan != nil {
+		in, out := &in.Scan, &out.Scan
+		*out = new(TagScan)
+		(*in).DeepCopyInto(*out)
+	}
+	in.LastPullTime.DeepCopyInto(&out.LastPullTime)
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(TagSignature)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -676,3 +954,258 @@ func (in *RepositoryTag) DeepCopy() *RepositoryTag {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionPolicy) DeepCopyInto(out *RetentionPolicy) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RetentionRule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionPolicy.
+func (in *RetentionPolicy) DeepCopy() *RetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionRule) DeepCopyInto(out *RetentionRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionRule.
+func (in *RetentionRule) DeepCopy() *RetentionRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionStatus) DeepCopyInto(out *RetentionStatus) {
+	*out = *in
+	in.LastRunTime.DeepCopyInto(&out.LastRunTime)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]RetentionTag, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionStatus.
+func (in *RetentionStatus) DeepCopy() *RetentionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionTag) DeepCopyInto(out *RetentionTag) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionTag.
+func (in *RetentionTag) DeepCopy() *RetentionTag {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionTag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignaturePolicy) DeepCopyInto(out *SignaturePolicy) {
+	*out = *in
+	if in.TrustedKeys != nil {
+		in, out := &in.TrustedKeys, &out.TrustedKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignaturePolicy.
+func (in *SignaturePolicy) DeepCopy() *SignaturePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SignaturePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagScan) DeepCopyInto(out *TagScan) {
+	*out = *in
+	in.LastScanTime.DeepCopyInto(&out.LastScanTime)
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Vulnerabilities != nil {
+		in, out := &in.Vulnerabilities, &out.Vulnerabilities
+		*out = make([]Vulnerability, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagScan.
+func (in *TagScan) DeepCopy() *TagScan {
+	if in == nil {
+		return nil
+	}
+	out := new(TagScan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagSignature) DeepCopyInto(out *TagSignature) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastVerifyTime.DeepCopyInto(&out.LastVerifyTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagSignature.
+func (in *TagSignature) DeepCopy() *TagSignature {
+	if in == nil {
+		return nil
+	}
+	out := new(TagSignature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedKey) DeepCopyInto(out *TrustedKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedKey.
+func (in *TrustedKey) DeepCopy() *TrustedKey {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrustedKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedKeyList) DeepCopyInto(out *TrustedKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrustedKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedKeyList.
+func (in *TrustedKeyList) DeepCopy() *TrustedKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrustedKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedKeySpec) DeepCopyInto(out *TrustedKeySpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedKeySpec.
+func (in *TrustedKeySpec) DeepCopy() *TrustedKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Vulnerability) DeepCopyInto(out *Vulnerability) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Vulnerability.
+func (in *Vulnerability) DeepCopy() *Vulnerability {
+	if in == nil {
+		return nil
+	}
+	out := new(Vulnerability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VulnerabilityPolicy) DeepCopyInto(out *VulnerabilityPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VulnerabilityPolicy.
+func (in *VulnerabilityPolicy) DeepCopy() *VulnerabilityPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VulnerabilityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
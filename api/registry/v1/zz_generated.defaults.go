@@ -39,6 +39,8 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&ConfigMapList{}, func(obj interface{}) { SetObjectDefaults_ConfigMapList(obj.(*ConfigMapList)) })
 	scheme.AddTypeDefaultingFunc(&Namespace{}, func(obj interface{}) { SetObjectDefaults_Namespace(obj.(*Namespace)) })
 	scheme.AddTypeDefaultingFunc(&NamespaceList{}, func(obj interface{}) { SetObjectDefaults_NamespaceList(obj.(*NamespaceList)) })
+	scheme.AddTypeDefaultingFunc(&ReplicationPolicy{}, func(obj interface{}) { SetObjectDefaults_ReplicationPolicy(obj.(*ReplicationPolicy)) })
+	scheme.AddTypeDefaultingFunc(&ReplicationPolicyList{}, func(obj interface{}) { SetObjectDefaults_ReplicationPolicyList(obj.(*ReplicationPolicyList)) })
 	scheme.AddTypeDefaultingFunc(&Repository{}, func(obj interface{}) { SetObjectDefaults_Repository(obj.(*Repository)) })
 	scheme.AddTypeDefaultingFunc(&RepositoryList{}, func(obj interface{}) { SetObjectDefaults_RepositoryList(obj.(*RepositoryList)) })
 	return nil
@@ -95,6 +97,17 @@ func SetObjectDefaults_NamespaceList(in *NamespaceList) {
 	}
 }
 
+func SetObjectDefaults_ReplicationPolicy(in *ReplicationPolicy) {
+	SetDefaults_ReplicationPolicySpec(&in.Spec)
+}
+
+func SetObjectDefaults_ReplicationPolicyList(in *ReplicationPolicyList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ReplicationPolicy(a)
+	}
+}
+
 func SetObjectDefaults_Repository(in *Repository) {
 	SetDefaults_RepositorySpec(&in.Spec)
 	SetDefaults_RepositoryStatus(&in.Status)
@@ -23,14 +23,12 @@ import (
 	"fmt"
 	"io/ioutil"
 	"path/filepath"
-	"regexp"
 	"strings"
 	"time"
 
 	"github.com/casbin/casbin/v2"
 	casbinlog "github.com/casbin/casbin/v2/log"
 	"github.com/casbin/casbin/v2/model"
-	casbinutil "github.com/casbin/casbin/v2/util"
 	dexldap "github.com/dexidp/dex/connector/ldap"
 	dexserver "github.com/dexidp/dex/server"
 	dexstorage "github.com/dexidp/dex/storage"
@@ -46,6 +44,7 @@ import (
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	serverstorage "k8s.io/apiserver/pkg/server/storage"
+	"k8s.io/client-go/rest"
 	"k8s.io/kube-openapi/pkg/validation/spec"
 
 	authapi "tkestack.io/tke/api/auth"
@@ -68,8 +67,12 @@ import (
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/ldap"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/local"
 	"tkestack.io/tke/pkg/auth/authorization/aggregation"
+	authorizationlocal "tkestack.io/tke/pkg/auth/authorization/local"
+	authutil "tkestack.io/tke/pkg/auth/util"
 	dexutil "tkestack.io/tke/pkg/auth/util/dex"
 	casbinlogger "tkestack.io/tke/pkg/auth/util/logger"
+	controllerconfig "tkestack.io/tke/pkg/controller/config"
+	controlleroptions "tkestack.io/tke/pkg/controller/options"
 	"tkestack.io/tke/pkg/util/log"
 	"tkestack.io/tke/pkg/util/log/dex"
 )
@@ -95,6 +98,10 @@ type Config struct {
 	Authorizer           authorizer.Authorizer
 	CasbinReloadInterval time.Duration
 	PrivilegedUsername   string
+	TenantUsageClients   *authorizationlocal.TenantUsageClients
+
+	APISigningKeyRotationPeriod time.Duration
+	APISigningKeyOverlap        time.Duration
 }
 
 // CreateConfigFromOptions creates a running configuration instance based
@@ -151,7 +158,7 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	}
 
 	authClient := authinternalclient.NewForConfigOrDie(genericAPIServerConfig.LoopbackClientConfig)
-	apiKeyAuth, err := authenticator.NewAPIKeyAuthenticator(authClient)
+	apiKeyAuth, err := authenticator.NewAPIKeyAuthenticator(authClient, opts.Auth.APIKeyUnusedDisable)
 	if err != nil {
 		return nil, err
 	}
@@ -161,7 +168,12 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		return nil, err
 	}
 
-	aggregateAuthz, err := aggregation.NewAuthorizer(authClient, opts.Authorization, opts.Auth, enforcer, opts.Authentication.PrivilegedUsername)
+	tenantUsageClients, err := setupTenantUsageClients(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregateAuthz, err := aggregation.NewAuthorizer(authClient, versionedInformers.Auth().V1().Tenants(), tenantUsageClients, opts.Authorization, opts.Auth, enforcer, opts.Authentication.PrivilegedUsername)
 	if err != nil {
 		return nil, err
 	}
@@ -208,9 +220,41 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		Authorizer:                     aggregateAuthz,
 		PrivilegedUsername:             opts.Authentication.PrivilegedUsername,
 		CasbinReloadInterval:           opts.Authorization.CasbinReloadInterval,
+		TenantUsageClients:             tenantUsageClients,
+		APISigningKeyRotationPeriod:    opts.Auth.SigningKeyRotation,
+		APISigningKeyOverlap:           opts.Auth.SigningKeyOverlap,
 	}, nil
 }
 
+// setupTenantUsageClients creates the clients of the api servers storing the
+// objects limited by the tenant quota, the api servers are optional.
+func setupTenantUsageClients(opts *options.Options) (*authorizationlocal.TenantUsageClients, error) {
+	clients := &authorizationlocal.TenantUsageClients{}
+	for _, c := range []struct {
+		opts *controlleroptions.APIServerClientOptions
+		set  func(client *versionedclientset.Clientset)
+	}{
+		{opts.BusinessAPIClient, func(client *versionedclientset.Clientset) { clients.Business = client.BusinessV1() }},
+		{opts.PlatformAPIClient, func(client *versionedclientset.Clientset) { clients.Platform = client.PlatformV1() }},
+		{opts.RegistryAPIClient, func(client *versionedclientset.Clientset) { clients.Registry = client.RegistryV1() }},
+		{opts.ApplicationAPIClient, func(client *versionedclientset.Clientset) { clients.Application = client.ApplicationV1() }},
+	} {
+		clientConfig, ok, err := controllerconfig.BuildClientConfig(c.opts)
+		if err != nil {
+			return nil, err
+		}
+		if !ok || clientConfig == nil {
+			continue
+		}
+		client, err := versionedclientset.NewForConfig(rest.AddUserAgent(clientConfig, "tke-auth-api"))
+		if err != nil {
+			return nil, err
+		}
+		c.set(client)
+	}
+	return clients, nil
+}
+
 func setupAuthentication(genericAPIServerConfig *genericapiserver.Config, opts *apiserveroptions.AuthenticationWithAPIOptions, tokenAuthenticators []genericauthenticator.Token) error {
 	if err := authentication.SetupAuthentication(genericAPIServerConfig, opts); err != nil {
 		return nil
@@ -326,6 +370,9 @@ func setupCasbinEnforcer(authorizationOptions *options.AuthorizationOptions) (*c
 	}
 
 	enforcer.AddFunction("keyMatchCustom", CustomFunctionWrapper)
+	if err := authorizationlocal.EnableConditions(enforcer); err != nil {
+		return nil, err
+	}
 
 	return enforcer, nil
 }
@@ -400,33 +447,10 @@ func setupDefaultClient(store dexstorage.Storage, auth *options.AuthOptions) err
 	return nil
 }
 
-// CustomFunctionWrapper wraps keyMatchCustomFunction
+// CustomFunctionWrapper wraps KeyMatchCustom
 func CustomFunctionWrapper(args ...interface{}) (interface{}, error) {
 	key1 := args[0].(string)
 	key2 := args[1].(string)
 
-	return keyMatchCustomFunction(key1, key2), nil
-}
-
-// keyMatchCustomFunction determines whether key1 matches the pattern of key2 , key2 can contain a * and :*.
-// For example, "/project:123/cluster:456" matches "/project:*/cluster:456", "registry:123/*" matches "registry:123/456"
-func keyMatchCustomFunction(key1 string, key2 string) bool {
-	// case insensitive
-	key1 = strings.ToLower(key1)
-	key2 = strings.ToLower(key2)
-
-	key2 = strings.Replace(key2, "*", ".*", -1)
-
-	re := regexp.MustCompile(`(.*):[^/]+(.*)`)
-	i := 2
-	for {
-		if !strings.Contains(key2, "/:") {
-			break
-		}
-
-		key2 = re.ReplaceAllString(key2, "$1[^/]+$2")
-		i = i + 1
-	}
-
-	return casbinutil.RegexMatch(key1, "^"+key2+"$")
+	return authutil.KeyMatchCustom(key1, key2), nil
 }
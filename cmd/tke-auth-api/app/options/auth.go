@@ -40,6 +40,9 @@ const (
 	flagAuthInitClientSecret       = "init-client-secret"
 	flagAuthInitClientRedirectUris = "init-client-redirect-uris"
 	flagAuthPasswordGrantConnID    = "password-grant-conn-id"
+	flagAuthAPIKeyUnusedDisable    = "apikey-unused-disable-after"
+	flagAuthSigningKeyRotation     = "api-signing-key-rotation-period"
+	flagAuthSigningKeyOverlap      = "api-signing-key-overlap"
 )
 
 const (
@@ -53,6 +56,9 @@ const (
 	configAuthInitClientSecret       = "auth.init_client_secret"
 	configAuthInitClientRedirectUris = "auth.init_client_redirect_uris"
 	configAuthPasswordGrantConnID    = "auth.password_grant_conn_id"
+	configAuthAPIKeyUnusedDisable    = "auth.apikey_unused_disable_after"
+	configAuthSigningKeyRotation     = "auth.api_signing_key_rotation_period"
+	configAuthSigningKeyOverlap      = "auth.api_signing_key_overlap"
 )
 
 // AuthOptions contains configuration items related to auth attributes.
@@ -67,6 +73,9 @@ type AuthOptions struct {
 	InitClientSecret       string
 	InitClientRedirectUris []string
 	PasswordGrantConnID    string
+	APIKeyUnusedDisable    time.Duration
+	SigningKeyRotation     time.Duration
+	SigningKeyOverlap      time.Duration
 }
 
 // NewAuthOptions creates a AuthOptions object with default parameters.
@@ -76,6 +85,9 @@ func NewAuthOptions() *AuthOptions {
 		InitTenantType: local.ConnectorType,
 		InitTenantID:   "default",
 		InitClientID:   "default",
+
+		SigningKeyRotation: 90 * 24 * time.Hour,
+		SigningKeyOverlap:  24 * time.Hour,
 	}
 }
 
@@ -120,6 +132,18 @@ func (o *AuthOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.String(flagAuthPasswordGrantConnID, o.PasswordGrantConnID,
 		"Default connector that can be used for password grant.")
 	_ = viper.BindPFlag(configAuthPasswordGrantConnID, fs.Lookup(flagAuthPasswordGrantConnID))
+
+	fs.Duration(flagAuthAPIKeyUnusedDisable, o.APIKeyUnusedDisable,
+		"Disable the api keys not used for longer than the duration, 0 means never.")
+	_ = viper.BindPFlag(configAuthAPIKeyUnusedDisable, fs.Lookup(flagAuthAPIKeyUnusedDisable))
+
+	fs.Duration(flagAuthSigningKeyRotation, o.SigningKeyRotation,
+		"Rotate the api signing key after the duration, 0 means never.")
+	_ = viper.BindPFlag(configAuthSigningKeyRotation, fs.Lookup(flagAuthSigningKeyRotation))
+
+	fs.Duration(flagAuthSigningKeyOverlap, o.SigningKeyOverlap,
+		"Minimum duration the retired api signing key is still accepted for verification after the rotation.")
+	_ = viper.BindPFlag(configAuthSigningKeyOverlap, fs.Lookup(flagAuthSigningKeyOverlap))
 }
 
 // ApplyFlags parsing parameters from the command line or configuration file
@@ -134,6 +158,15 @@ func (o *AuthOptions) ApplyFlags() []error {
 	}
 
 	o.IDTokenTimeout = viper.GetDuration(configAuthIDTokenTimeout)
+	o.APIKeyUnusedDisable = viper.GetDuration(configAuthAPIKeyUnusedDisable)
+	if o.APIKeyUnusedDisable < 0 {
+		errs = append(errs, fmt.Errorf("--%s must not be negative", flagAuthAPIKeyUnusedDisable))
+	}
+	o.SigningKeyRotation = viper.GetDuration(configAuthSigningKeyRotation)
+	o.SigningKeyOverlap = viper.GetDuration(configAuthSigningKeyOverlap)
+	if o.SigningKeyRotation < 0 || o.SigningKeyOverlap < 0 {
+		errs = append(errs, fmt.Errorf("--%s and --%s must not be negative", flagAuthSigningKeyRotation, flagAuthSigningKeyOverlap))
+	}
 
 	o.InitTenantType = viper.GetString(configAuthInitTenantType)
 	o.LdapConfigFile = viper.GetString(configAuthLDAPConfigFile)
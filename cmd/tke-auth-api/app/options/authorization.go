@@ -32,6 +32,7 @@ const (
 	flagAuthzWebhookCacheUnauthorizedTTL = "authorization-webhook-cache-unauthorized-ttl"
 	flagAuthzWebhookCacheAuthorizedTTL   = "authorization-webhook-cache-authorized-ttl"
 	flagAuthzDebug                       = "authorization-debug"
+	flagAuthzDecisionCacheTTL            = "authorization-decision-cache-ttl"
 	flagCasbinModelFile                  = "casbin-model-file"
 	flagCasbinReLoadInterval             = "casbin-reload-interval"
 )
@@ -43,6 +44,7 @@ const (
 	configAuthzWebhookCacheUnauthorizedTTL = "authorization.webhook_cache_unauthorized_ttl"
 	configAuthzWebhookCacheAuthorizedTTL   = "authorization.webhook_cache_authorized_ttl"
 	configAuthzDebug                       = "authorization.debug"
+	configAuthzDecisionCacheTTL            = "authorization.decision_cache_ttl"
 	configCasbinModelFile                  = "casbin.model_file"
 	configCasbinReloadInterval             = "casbin.reload_interval"
 )
@@ -52,6 +54,7 @@ type AuthorizationOptions struct {
 	CasbinModelFile             string
 	CasbinReloadInterval        time.Duration
 	Debug                       bool
+	DecisionCacheTTL            time.Duration
 	PolicyFile                  string
 	WebhookConfigFile           string
 	WebhookVersion              string
@@ -66,7 +69,11 @@ func NewAuthorizationOptions() *AuthorizationOptions {
 		WebhookVersion:              "v1beta1",
 		WebhookCacheAuthorizedTTL:   5 * time.Minute,
 		WebhookCacheUnauthorizedTTL: 30 * time.Second,
-		CasbinReloadInterval:        100 * time.Millisecond,
+		// The policies are updated incrementally by the rule informer, the
+		// full reload only repairs drift, so it runs far less often than the
+		// former default of 100ms.
+		CasbinReloadInterval: 10 * time.Minute,
+		DecisionCacheTTL:     10 * time.Second,
 	}
 }
 
@@ -100,9 +107,13 @@ func (o *AuthorizationOptions) AddFlags(fs *pflag.FlagSet) {
 	_ = viper.BindPFlag(configCasbinModelFile, fs.Lookup(flagCasbinModelFile))
 
 	fs.Duration(flagCasbinReLoadInterval, o.CasbinReloadInterval,
-		"The interval of casbin reload policy from backend storage. Default 100ms.")
+		"The interval of casbin reload all the policies from backend storage, the policies are updated incrementally in between. Zero disables the reload. Default 10m, it was 100ms before the incremental update.")
 	_ = viper.BindPFlag(configCasbinReloadInterval, fs.Lookup(flagCasbinReLoadInterval))
 
+	fs.Duration(flagAuthzDecisionCacheTTL, o.DecisionCacheTTL,
+		"The duration to cache the decisions of the local authorizer, the cache is discarded whenever the policies change. Zero disables the cache.")
+	_ = viper.BindPFlag(configAuthzDecisionCacheTTL, fs.Lookup(flagAuthzDecisionCacheTTL))
+
 	fs.Bool(flagAuthzDebug, o.Debug,
 		"Enable authorizer to log messages to the Logger.")
 	_ = viper.BindPFlag(configAuthzDebug, fs.Lookup(flagAuthzDebug))
@@ -117,6 +128,7 @@ func (o *AuthorizationOptions) ApplyFlags() []error {
 	o.CasbinModelFile = viper.GetString(configCasbinModelFile)
 	o.CasbinReloadInterval = viper.GetDuration(configCasbinReloadInterval)
 	o.Debug = viper.GetBool(configAuthzDebug)
+	o.DecisionCacheTTL = viper.GetDuration(configAuthzDecisionCacheTTL)
 	o.WebhookCacheAuthorizedTTL = viper.GetDuration(configAuthzWebhookCacheAuthorizedTTL)
 	o.WebhookCacheUnauthorizedTTL = viper.GetDuration(configAuthzWebhookCacheUnauthorizedTTL)
 	o.WebhookConfigFile = viper.GetString(configAuthzWebhookConfigFile)
@@ -25,6 +25,7 @@ import (
 	genericapiserveroptions "k8s.io/apiserver/pkg/server/options"
 	apiserveroptions "tkestack.io/tke/pkg/apiserver/options"
 	storageoptions "tkestack.io/tke/pkg/apiserver/storage/options"
+	controlleroptions "tkestack.io/tke/pkg/controller/options"
 	"tkestack.io/tke/pkg/util/cachesize"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -40,6 +41,11 @@ type Options struct {
 	ETCD           *storageoptions.ETCDStorageOptions
 	Auth           *AuthOptions
 	Audit          *genericapiserveroptions.AuditOptions
+	// The api servers used to count the objects limited by the tenant quota.
+	BusinessAPIClient    *controlleroptions.APIServerClientOptions
+	PlatformAPIClient    *controlleroptions.APIServerClientOptions
+	RegistryAPIClient    *controlleroptions.APIServerClientOptions
+	ApplicationAPIClient *controlleroptions.APIServerClientOptions
 }
 
 // NewOptions creates a new Options with a default config.
@@ -54,6 +60,11 @@ func NewOptions(serverName string) *Options {
 		ETCD:           storageoptions.NewETCDStorageOptions("/tke/auth-api"),
 		Auth:           NewAuthOptions(),
 		Audit:          genericapiserveroptions.NewAuditOptions(),
+
+		BusinessAPIClient:    controlleroptions.NewAPIServerClientOptions("business", false),
+		PlatformAPIClient:    controlleroptions.NewAPIServerClientOptions("platform", false),
+		RegistryAPIClient:    controlleroptions.NewAPIServerClientOptions("registry", false),
+		ApplicationAPIClient: controlleroptions.NewAPIServerClientOptions("application", false),
 	}
 }
 
@@ -68,6 +79,10 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.Authorization.AddFlags(fs)
 	o.Auth.AddFlags(fs)
 	o.Audit.AddFlags(fs)
+	o.BusinessAPIClient.AddFlags(fs)
+	o.PlatformAPIClient.AddFlags(fs)
+	o.RegistryAPIClient.AddFlags(fs)
+	o.ApplicationAPIClient.AddFlags(fs)
 }
 
 // ApplyFlags parsing parameters from the command line or configuration file
@@ -83,6 +98,10 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.Authentication.ApplyFlags()...)
 	errs = append(errs, o.Authorization.ApplyFlags()...)
 	errs = append(errs, o.Auth.ApplyFlags()...)
+	errs = append(errs, o.BusinessAPIClient.ApplyFlags()...)
+	errs = append(errs, o.PlatformAPIClient.ApplyFlags()...)
+	errs = append(errs, o.RegistryAPIClient.ApplyFlags()...)
+	errs = append(errs, o.ApplicationAPIClient.ApplyFlags()...)
 
 	return errs
 }
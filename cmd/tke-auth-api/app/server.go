@@ -71,6 +71,10 @@ func createAPIServerConfig(cfg *config.Config) *apiserver.Config {
 			Authorizer:              cfg.Authorizer,
 			CasbinReloadInterval:    cfg.CasbinReloadInterval,
 			PrivilegedUsername:      cfg.PrivilegedUsername,
+			TenantUsageClients:      cfg.TenantUsageClients,
+
+			APISigningKeyRotationPeriod: cfg.APISigningKeyRotationPeriod,
+			APISigningKeyOverlap:        cfg.APISigningKeyOverlap,
 		},
 	}
 }
@@ -22,12 +22,15 @@ import (
 	"net/http"
 	"time"
 
+	"tkestack.io/tke/pkg/auth/controller/accessrequest"
 	"tkestack.io/tke/pkg/auth/controller/config"
 	"tkestack.io/tke/pkg/auth/controller/custompolicybinding"
 	"tkestack.io/tke/pkg/auth/controller/group"
 	"tkestack.io/tke/pkg/auth/controller/localidentity"
 	"tkestack.io/tke/pkg/auth/controller/projectpolicybinding"
 	"tkestack.io/tke/pkg/auth/controller/role"
+	"tkestack.io/tke/pkg/auth/controller/tenant"
+	"tkestack.io/tke/pkg/auth/controller/tenant/deletion"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	v1 "tkestack.io/tke/api/auth/v1"
@@ -55,6 +58,12 @@ const (
 
 	idpSyncPeriod      = 5 * time.Minute
 	concurrentIDPSyncs = 5
+
+	accessRequestSyncPeriod      = 5 * time.Minute
+	concurrentAccessRequestSyncs = 5
+
+	tenantSyncPeriod      = 5 * time.Minute
+	concurrentTenantSyncs = 5
 )
 
 func startPolicyController(ctx ControllerContext) (http.Handler, bool, error) {
@@ -198,3 +207,45 @@ func startConfigController(ctx ControllerContext) (http.Handler, bool, error) {
 
 	return nil, true, nil
 }
+
+func startAccessRequestController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: v1.GroupName, Version: v1.Version, Resource: "accessrequests"}] {
+		return nil, false, nil
+	}
+
+	ctrl := accessrequest.NewController(
+		ctx.ClientBuilder.ClientOrDie("accessrequest-controller"),
+		ctx.NotifyClient,
+		ctx.InformerFactory.Auth().V1().AccessRequests(),
+		accessRequestSyncPeriod,
+	)
+
+	go ctrl.Run(concurrentAccessRequestSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
+
+func startTenantController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: v1.GroupName, Version: v1.Version, Resource: "tenants"}] {
+		return nil, false, nil
+	}
+
+	ctrl := tenant.NewController(
+		ctx.ClientBuilder.ClientOrDie("tenant-controller"),
+		deletion.GroupClients{
+			Business:    ctx.BusinessClient,
+			Platform:    ctx.PlatformClient,
+			Registry:    ctx.RegistryClient,
+			Notify:      ctx.NotifyClient,
+			Monitor:     ctx.MonitorClient,
+			Application: ctx.ApplicationClient,
+		},
+		ctx.InformerFactory.Auth().V1().Tenants(),
+		tenantSyncPeriod,
+		v1.TenantFinalize,
+	)
+
+	go ctrl.Run(concurrentTenantSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
@@ -45,7 +45,19 @@ type Config struct {
 	LeaderElectionClient *versionedclientset.Clientset
 	// the rest config for the auth apiserver
 	AuthAPIServerClientConfig *restclient.Config
-	Component                 controlleroptions.ComponentConfiguration
+	// the rest config for the notify apiserver
+	NotifyAPIServerClientConfig *restclient.Config
+	// the rest config for the business apiserver
+	BusinessAPIServerClientConfig *restclient.Config
+	// the rest config for the platform apiserver
+	PlatformAPIServerClientConfig *restclient.Config
+	// the rest config for the registry apiserver
+	RegistryAPIServerClientConfig *restclient.Config
+	// the rest config for the monitor apiserver
+	MonitorAPIServerClientConfig *restclient.Config
+	// the rest config for the application apiserver
+	ApplicationAPIServerClientConfig *restclient.Config
+	Component                        controlleroptions.ComponentConfiguration
 
 	PolicyPath           string
 	CategoryPath         string
@@ -71,15 +83,47 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		return nil, fmt.Errorf("failed to initialize client config of auth API server")
 	}
 
+	// optional
+	notifyAPIServerClientConfig, _, err := controllerconfig.BuildClientConfig(opts.NotifyAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	businessAPIServerClientConfig, _, err := controllerconfig.BuildClientConfig(opts.BusinessAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	platformAPIServerClientConfig, _, err := controllerconfig.BuildClientConfig(opts.PlatformAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	registryAPIServerClientConfig, _, err := controllerconfig.BuildClientConfig(opts.RegistryAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	monitorAPIServerClientConfig, _, err := controllerconfig.BuildClientConfig(opts.MonitorAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	applicationAPIServerClientConfig, _, err := controllerconfig.BuildClientConfig(opts.ApplicationAPIClient)
+	if err != nil {
+		return nil, err
+	}
+
 	// shallow copy, do not modify the apiServerClientConfig.Timeout.
 	config := *authAPIServerClientConfig
 	config.Timeout = opts.Component.LeaderElection.RenewDeadline
 	leaderElectionClient := versionedclientset.NewForConfigOrDie(restclient.AddUserAgent(&config, "leader-election"))
 
 	controllerManagerConfig := &Config{
-		ServerName:                serverName,
-		LeaderElectionClient:      leaderElectionClient,
-		AuthAPIServerClientConfig: authAPIServerClientConfig,
+		ServerName:                       serverName,
+		LeaderElectionClient:             leaderElectionClient,
+		AuthAPIServerClientConfig:        authAPIServerClientConfig,
+		NotifyAPIServerClientConfig:      notifyAPIServerClientConfig,
+		BusinessAPIServerClientConfig:    businessAPIServerClientConfig,
+		PlatformAPIServerClientConfig:    platformAPIServerClientConfig,
+		RegistryAPIServerClientConfig:    registryAPIServerClientConfig,
+		MonitorAPIServerClientConfig:     monitorAPIServerClientConfig,
+		ApplicationAPIServerClientConfig: applicationAPIServerClientConfig,
 		Authorization: apiserver.AuthorizationInfo{
 			Authorizer: authorizerfactory.NewAlwaysAllowAuthorizer(),
 		},
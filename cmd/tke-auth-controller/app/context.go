@@ -33,7 +33,13 @@ import (
 
 	"tkestack.io/tke/api/auth"
 	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
+	applicationv1 "tkestack.io/tke/api/client/clientset/versioned/typed/application/v1"
 	v1 "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	businessv1 "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	monitorv1 "tkestack.io/tke/api/client/clientset/versioned/typed/monitor/v1"
+	notifyv1 "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	platformv1 "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	registryv1 "tkestack.io/tke/api/client/clientset/versioned/typed/registry/v1"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	"tkestack.io/tke/cmd/tke-auth-controller/app/config"
 	util2 "tkestack.io/tke/pkg/auth/util"
@@ -83,7 +89,13 @@ type ControllerContext struct {
 	TenantAdmin       string
 	TenantAdminSecret string
 
-	AuthClient v1.AuthV1Interface
+	AuthClient        v1.AuthV1Interface
+	NotifyClient      notifyv1.NotifyV1Interface
+	BusinessClient    businessv1.BusinessV1Interface
+	PlatformClient    platformv1.PlatformV1Interface
+	RegistryClient    registryv1.RegistryV1Interface
+	MonitorClient     monitorv1.MonitorV1Interface
+	ApplicationClient applicationv1.ApplicationV1Interface
 }
 
 // IsControllerEnabled returns whether the controller has been enabled
@@ -162,5 +174,48 @@ func CreateControllerContext(cfg *config.Config, rootClientBuilder controller.Cl
 
 		AuthClient: client.AuthV1(),
 	}
+
+	if cfg.NotifyAPIServerClientConfig != nil {
+		notifyClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(cfg.NotifyAPIServerClientConfig, "tke-auth-controller"))
+		if err != nil {
+			return ControllerContext{}, fmt.Errorf("failed to create the notify client: %v", err)
+		}
+		ctx.NotifyClient = notifyClient.NotifyV1()
+	}
+	if cfg.BusinessAPIServerClientConfig != nil {
+		businessClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(cfg.BusinessAPIServerClientConfig, "tke-auth-controller"))
+		if err != nil {
+			return ControllerContext{}, fmt.Errorf("failed to create the business client: %v", err)
+		}
+		ctx.BusinessClient = businessClient.BusinessV1()
+	}
+	if cfg.PlatformAPIServerClientConfig != nil {
+		platformClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(cfg.PlatformAPIServerClientConfig, "tke-auth-controller"))
+		if err != nil {
+			return ControllerContext{}, fmt.Errorf("failed to create the platform client: %v", err)
+		}
+		ctx.PlatformClient = platformClient.PlatformV1()
+	}
+	if cfg.RegistryAPIServerClientConfig != nil {
+		registryClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(cfg.RegistryAPIServerClientConfig, "tke-auth-controller"))
+		if err != nil {
+			return ControllerContext{}, fmt.Errorf("failed to create the registry client: %v", err)
+		}
+		ctx.RegistryClient = registryClient.RegistryV1()
+	}
+	if cfg.MonitorAPIServerClientConfig != nil {
+		monitorClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(cfg.MonitorAPIServerClientConfig, "tke-auth-controller"))
+		if err != nil {
+			return ControllerContext{}, fmt.Errorf("failed to create the monitor client: %v", err)
+		}
+		ctx.MonitorClient = monitorClient.MonitorV1()
+	}
+	if cfg.ApplicationAPIServerClientConfig != nil {
+		applicationClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(cfg.ApplicationAPIServerClientConfig, "tke-auth-controller"))
+		if err != nil {
+			return ControllerContext{}, fmt.Errorf("failed to create the application client: %v", err)
+		}
+		ctx.ApplicationClient = applicationClient.ApplicationV1()
+	}
 	return ctx, nil
 }
@@ -57,6 +57,8 @@ func NewControllerInitializers() map[string]InitFunc {
 	controllers["groups"] = startGroupController
 	controllers["roles"] = startRoleController
 	controllers["configs"] = startConfigController
+	controllers["accessrequest"] = startAccessRequestController
+	controllers["tenants"] = startTenantController
 	return controllers
 }
 
@@ -28,8 +28,12 @@ import (
 	"tkestack.io/tke/pkg/business/controller/emigration"
 	"tkestack.io/tke/pkg/business/controller/imagenamespace"
 	"tkestack.io/tke/pkg/business/controller/namespace"
+	"tkestack.io/tke/pkg/business/controller/namespacecert"
 	"tkestack.io/tke/pkg/business/controller/platform"
 	"tkestack.io/tke/pkg/business/controller/project"
+	"tkestack.io/tke/pkg/business/controller/projectfreeze"
+	"tkestack.io/tke/pkg/business/controller/projectrequest"
+	"tkestack.io/tke/pkg/business/controller/quotaalert"
 )
 
 const (
@@ -50,6 +54,18 @@ const (
 
 	emigrationSyncPeriod      = 30 * time.Second
 	concurrentEmigrationSyncs = 10
+
+	quotaAlertSyncPeriod      = 5 * time.Minute
+	concurrentQuotaAlertSyncs = 5
+
+	projectRequestSyncPeriod      = 5 * time.Minute
+	concurrentProjectRequestSyncs = 5
+
+	namespaceCertSyncPeriod      = 1 * time.Hour
+	concurrentNamespaceCertSyncs = 5
+
+	projectFreezeSyncPeriod      = 5 * time.Minute
+	concurrentProjectFreezeSyncs = 5
 )
 
 func startNamespaceController(ctx ControllerContext) (http.Handler, bool, error) {
@@ -61,6 +77,7 @@ func startNamespaceController(ctx ControllerContext) (http.Handler, bool, error)
 		ctx.PlatformClient,
 		ctx.ClientBuilder.ClientOrDie("namespace-controller"),
 		ctx.InformerFactory.Business().V1().Namespaces(),
+		ctx.InformerFactory.Business().V1().NamespaceTemplates(),
 		namespaceSyncPeriod,
 		businessv1.NamespaceFinalize,
 	)
@@ -169,3 +186,80 @@ func startNsEmigrationController(ctx ControllerContext) (http.Handler, bool, err
 
 	return nil, true, nil
 }
+
+func startQuotaAlertController(ctx ControllerContext) (http.Handler, bool, error) {
+	if ctx.NotifyClient == nil {
+		return nil, false, nil
+	}
+
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: businessv1.GroupName, Version: "v1", Resource: "projects"}] {
+		return nil, false, nil
+	}
+
+	ctrl := quotaalert.NewController(
+		ctx.ClientBuilder.ClientOrDie("quotaalert-controller"),
+		ctx.NotifyClient,
+		ctx.InformerFactory.Business().V1().Projects(),
+		ctx.InformerFactory.Business().V1().Namespaces(),
+		quotaAlertSyncPeriod,
+	)
+
+	go ctrl.Run(concurrentQuotaAlertSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
+
+func startProjectRequestController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: businessv1.GroupName, Version: "v1", Resource: "projectrequests"}] {
+		return nil, false, nil
+	}
+
+	ctrl := projectrequest.NewController(
+		ctx.ClientBuilder.ClientOrDie("projectrequest-controller"),
+		ctx.AuthClient,
+		ctx.NotifyClient,
+		ctx.InformerFactory.Business().V1().ProjectRequests(),
+		projectRequestSyncPeriod,
+	)
+
+	go ctrl.Run(concurrentProjectRequestSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
+
+func startNamespaceCertController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: businessv1.GroupName, Version: "v1", Resource: "namespaces"}] {
+		return nil, false, nil
+	}
+
+	ctrl := namespacecert.NewController(
+		ctx.ClientBuilder.ClientOrDie("namespacecert-controller"),
+		ctx.PlatformClient,
+		ctx.NotifyClient,
+		ctx.InformerFactory.Business().V1().Namespaces(),
+		ctx.InformerFactory.Business().V1().Projects(),
+		namespaceCertSyncPeriod,
+	)
+
+	go ctrl.Run(concurrentNamespaceCertSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
+
+func startProjectFreezeController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: businessv1.GroupName, Version: "v1", Resource: "projects"}] {
+		return nil, false, nil
+	}
+
+	ctrl := projectfreeze.NewController(
+		ctx.ClientBuilder.ClientOrDie("projectfreeze-controller"),
+		ctx.PlatformClient,
+		ctx.InformerFactory.Business().V1().Projects(),
+		ctx.InformerFactory.Business().V1().Namespaces(),
+		projectFreezeSyncPeriod,
+	)
+
+	go ctrl.Run(concurrentProjectFreezeSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
@@ -30,6 +30,7 @@ import (
 	"k8s.io/client-go/restmapper"
 	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
 	authv1 "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	notifyv1 "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
 	platformv1 "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	registryv1 "tkestack.io/tke/api/client/clientset/versioned/typed/registry/v1"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
@@ -75,6 +76,7 @@ type ControllerContext struct {
 	AuthClient     authv1.AuthV1Interface
 	PlatformClient platformv1.PlatformV1Interface
 	RegistryClient registryv1.RegistryV1Interface
+	NotifyClient   notifyv1.NotifyV1Interface
 }
 
 // IsControllerEnabled returns whether the controller has been enabled
@@ -142,5 +144,13 @@ func CreateControllerContext(cfg *config.Config, rootClientBuilder controller.Cl
 		ctx.AuthClient = authClient.AuthV1()
 	}
 
+	if cfg.NotifyAPIServerClientConfig != nil {
+		notifyClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(cfg.NotifyAPIServerClientConfig, "tke-business-controller"))
+		if err != nil {
+			return ControllerContext{}, fmt.Errorf("failed to create the notify client: %v", err)
+		}
+		ctx.NotifyClient = notifyClient.NotifyV1()
+	}
+
 	return ctx, nil
 }
@@ -54,6 +54,10 @@ func NewControllerInitializers() map[string]InitFunc {
 	controllers["chartgroup"] = startChartGroupController
 	controllers["platform"] = startPlatformController
 	controllers["nsemigration"] = startNsEmigrationController
+	controllers["quotaalert"] = startQuotaAlertController
+	controllers["projectrequest"] = startProjectRequestController
+	controllers["namespacecert"] = startNamespaceCertController
+	controllers["projectfreeze"] = startProjectFreezeController
 	return controllers
 }
 
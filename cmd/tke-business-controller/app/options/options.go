@@ -35,6 +35,7 @@ type Options struct {
 	BusinessAPIClient *controlleroptions.APIServerClientOptions
 	RegistryAPIClient *controlleroptions.APIServerClientOptions
 	AuthAPIClient     *controlleroptions.APIServerClientOptions
+	NotifyAPIClient   *controlleroptions.APIServerClientOptions
 }
 
 // NewOptions creates a new Options with a default config.
@@ -48,6 +49,7 @@ func NewOptions(serverName string, allControllers []string, disabledByDefaultCon
 		BusinessAPIClient: controlleroptions.NewAPIServerClientOptions("business", true),
 		RegistryAPIClient: controlleroptions.NewAPIServerClientOptions("registry", false),
 		AuthAPIClient:     controlleroptions.NewAPIServerClientOptions("auth", false),
+		NotifyAPIClient:   controlleroptions.NewAPIServerClientOptions("notify", false),
 	}
 }
 
@@ -61,6 +63,7 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.BusinessAPIClient.AddFlags(fs)
 	o.RegistryAPIClient.AddFlags(fs)
 	o.AuthAPIClient.AddFlags(fs)
+	o.NotifyAPIClient.AddFlags(fs)
 }
 
 // ApplyFlags parsing parameters from the command line or configuration file
@@ -76,6 +79,7 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.BusinessAPIClient.ApplyFlags()...)
 	errs = append(errs, o.RegistryAPIClient.ApplyFlags()...)
 	errs = append(errs, o.AuthAPIClient.ApplyFlags()...)
+	errs = append(errs, o.NotifyAPIClient.ApplyFlags()...)
 
 	return errs
 }
@@ -135,6 +135,13 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	if err != nil {
 		return nil, err
 	}
+	name, hookFunc, err := oidcAuthenticator.PostStartHook()
+	if err != nil {
+		return nil, err
+	}
+	if err := genericAPIServerConfig.AddPostStartHook(name, hookFunc); err != nil {
+		return nil, err
+	}
 
 	return &Config{
 		ServerName:             serverName,
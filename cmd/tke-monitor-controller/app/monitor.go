@@ -42,7 +42,7 @@ func startMetricController(ctx ControllerContext) (http.Handler, bool, error) {
 		return nil, false, nil
 	}
 
-	projectStorage, err := storage.NewProjectStorage(&ctx.MonitorConfig.Storage, ctx.BusinessClient)
+	projectStorage, err := storage.NewProjectStorage(&ctx.MonitorConfig.Storage, ctx.BusinessClient, ctx.ClientBuilder.ClientOrDie("metric-controller").MonitorV1())
 	if err != nil {
 		log.Error("Failed to create project storage", log.Err(err))
 		return nil, false, err
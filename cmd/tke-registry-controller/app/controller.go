@@ -51,6 +51,11 @@ func NewControllerInitializers() map[string]InitFunc {
 	controllers["chartgroup"] = startChartGroupController
 	controllers["chart"] = startChartController
 	controllers["identityprovider"] = startIdentityProviderController
+	controllers["scan"] = startScanController
+	controllers["retention"] = startRetentionController
+	controllers["replication"] = startReplicationController
+	controllers["signature"] = startSignatureController
+	controllers["garbagecollector"] = startGarbageCollector
 	return controllers
 }
 
@@ -19,6 +19,8 @@
 package options
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	registryconfig "tkestack.io/tke/pkg/registry/apis/config"
@@ -44,20 +46,36 @@ func NewRegistryConfiguration() (*registryconfig.RegistryConfiguration, error) {
 
 const (
 	flagDefaultSystemChartGroups = "registry-setting-default-system-chartgroups"
+	flagVulnerabilityDatabaseDir = "registry-setting-vulnerability-database-dir"
+	flagGarbageCollectionPeriod  = "registry-setting-garbage-collection-period"
+	flagGarbageCollectionGrace   = "registry-setting-garbage-collection-grace-period"
+	flagCAFile                   = "registry-setting-ca-file"
 )
 
 const (
 	configDefaultSystemChartGroups = "registry_setting.default_system_chartgroups"
+	configVulnerabilityDatabaseDir = "registry_setting.vulnerability_database_dir"
+	configGarbageCollectionPeriod  = "registry_setting.garbage_collection_period"
+	configGarbageCollectionGrace   = "registry_setting.garbage_collection_grace_period"
+	configCAFile                   = "registry_setting.ca_file"
 )
 
+const defaultGarbageCollectionGracePeriod = 2 * time.Hour
+
 // RegistryOptions contains configuration items related to registry attributes.
 type RegistryOptions struct {
 	DefaultSystemChartGroups []string
+	VulnerabilityDatabaseDir string
+	GarbageCollectionPeriod  time.Duration
+	GarbageCollectionGrace   time.Duration
+	CAFile                   string
 }
 
 // NewRegistryOptions creates a RegistryOptions object with default parameters.
 func NewRegistryOptions() *RegistryOptions {
-	return &RegistryOptions{}
+	return &RegistryOptions{
+		GarbageCollectionGrace: defaultGarbageCollectionGracePeriod,
+	}
 }
 
 // AddFlags adds flags for console to the specified FlagSet object.
@@ -65,6 +83,18 @@ func (o *RegistryOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringSlice(flagDefaultSystemChartGroups, o.DefaultSystemChartGroups,
 		"Default chartgroups with system type and public visibility.")
 	_ = viper.BindPFlag(configDefaultSystemChartGroups, fs.Lookup(flagDefaultSystemChartGroups))
+	fs.String(flagVulnerabilityDatabaseDir, o.VulnerabilityDatabaseDir,
+		"Directory of the offline vulnerability database files to scan the images with, images are not scanned if empty.")
+	_ = viper.BindPFlag(configVulnerabilityDatabaseDir, fs.Lookup(flagVulnerabilityDatabaseDir))
+	fs.Duration(flagGarbageCollectionPeriod, o.GarbageCollectionPeriod,
+		"Period between two garbage collections of the unreferenced image blobs, blobs are never collected if zero.")
+	_ = viper.BindPFlag(configGarbageCollectionPeriod, fs.Lookup(flagGarbageCollectionPeriod))
+	fs.Duration(flagGarbageCollectionGrace, o.GarbageCollectionGrace,
+		"Minimum age of the unreferenced image blobs and untagged manifests collected, protects the images being pushed.")
+	_ = viper.BindPFlag(configGarbageCollectionGrace, fs.Lookup(flagGarbageCollectionGrace))
+	fs.String(flagCAFile, o.CAFile,
+		"File containing the certificate authority that signs the certificate of the registry, used to copy the images of its namespaces.")
+	_ = viper.BindPFlag(configCAFile, fs.Lookup(flagCAFile))
 }
 
 // ApplyFlags parsing parameters from the command line or configuration file
@@ -73,6 +103,10 @@ func (o *RegistryOptions) ApplyFlags() []error {
 	var errs []error
 
 	o.DefaultSystemChartGroups = viper.GetStringSlice(configDefaultSystemChartGroups)
+	o.VulnerabilityDatabaseDir = viper.GetString(configVulnerabilityDatabaseDir)
+	o.GarbageCollectionPeriod = viper.GetDuration(configGarbageCollectionPeriod)
+	o.GarbageCollectionGrace = viper.GetDuration(configGarbageCollectionGrace)
+	o.CAFile = viper.GetString(configCAFile)
 
 	return errs
 }
@@ -84,6 +118,10 @@ func (o *RegistryOptions) ApplyTo(cfg *registrycontrollerconfig.RegistryDefaultC
 	}
 
 	cfg.DefaultSystemChartGroups = o.DefaultSystemChartGroups[:]
+	cfg.VulnerabilityDatabaseDir = o.VulnerabilityDatabaseDir
+	cfg.GarbageCollectionPeriod = o.GarbageCollectionPeriod
+	cfg.GarbageCollectionGracePeriod = o.GarbageCollectionGrace
+	cfg.CAFile = o.CAFile
 
 	return nil
 }
@@ -19,20 +19,32 @@
 package app
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"net/http"
 	"time"
 
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/registry/storage"
+	storagedriver "github.com/docker/distribution/registry/storage/driver"
 	"helm.sh/chartmuseum/pkg/chartmuseum/server/multitenant"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	authv1 "tkestack.io/tke/api/auth/v1"
 	registryv1 "tkestack.io/tke/api/registry/v1"
+	registryconfig "tkestack.io/tke/pkg/registry/apis/config"
+	registryconfigv1 "tkestack.io/tke/pkg/registry/apis/config/v1"
 	"tkestack.io/tke/pkg/registry/chartmuseum"
 	serveroptionsv1 "tkestack.io/tke/pkg/registry/chartmuseum/serveroptions/v1"
 	"tkestack.io/tke/pkg/registry/controller/chart"
 	"tkestack.io/tke/pkg/registry/controller/chartgroup"
+	"tkestack.io/tke/pkg/registry/controller/garbagecollector"
 	"tkestack.io/tke/pkg/registry/controller/identityprovider"
+	"tkestack.io/tke/pkg/registry/controller/replication"
+	"tkestack.io/tke/pkg/registry/controller/retention"
+	"tkestack.io/tke/pkg/registry/controller/scan"
+	"tkestack.io/tke/pkg/registry/controller/signature"
+	registrydistribution "tkestack.io/tke/pkg/registry/distribution"
 	helm "tkestack.io/tke/pkg/registry/harbor/helmClient"
 	"tkestack.io/tke/pkg/util/log"
 	"tkestack.io/tke/pkg/util/transport"
@@ -47,6 +59,18 @@ const (
 
 	identityProviderSyncPeriod      = 60 * time.Second
 	concurrentIdentityProviderSyncs = 10
+
+	scanSyncPeriod      = 5 * time.Minute
+	concurrentScanSyncs = 2
+
+	retentionSyncPeriod      = 10 * time.Minute
+	concurrentRetentionSyncs = 2
+
+	replicationSyncPeriod      = time.Minute
+	concurrentReplicationSyncs = 5
+
+	signatureSyncPeriod      = time.Minute
+	concurrentSignatureSyncs = 2
 )
 
 func newHelmClient(ctx ControllerContext) *helm.APIClient {
@@ -149,3 +173,149 @@ func startIdentityProviderController(ctx ControllerContext) (http.Handler, bool,
 
 	return nil, true, nil
 }
+
+// newStorageRegistry creates the registry and its storage driver from the
+// storage configuration of the registry.
+func newStorageRegistry(ctx ControllerContext) (distribution.Namespace, storagedriver.StorageDriver, error) {
+	registryConfig := &registryconfig.RegistryConfiguration{}
+	if err := registryconfigv1.Convert_v1_RegistryConfiguration_To_config_RegistryConfiguration(ctx.RegistryConfig, registryConfig, nil); err != nil {
+		log.Error("Failed to convert registry configuration", log.Err(err))
+		return nil, nil, err
+	}
+	driver, err := registrydistribution.NewStorageDriver(registryConfig)
+	if err != nil {
+		log.Error("Failed to create the storage driver of registry", log.Err(err))
+		return nil, nil, err
+	}
+	registry, err := storage.NewRegistry(context.Background(), driver)
+	if err != nil {
+		return nil, nil, err
+	}
+	return registry, driver, nil
+}
+
+func startScanController(ctx ControllerContext) (http.Handler, bool, error) {
+	if ctx.RegistryDefaultConfiguration.VulnerabilityDatabaseDir == "" {
+		return nil, false, nil
+	}
+
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: registryv1.GroupName, Version: "v1", Resource: "repositories"}] {
+		return nil, false, nil
+	}
+
+	registry, _, err := newStorageRegistry(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ctrl := scan.NewController(
+		ctx.ClientBuilder.ClientOrDie("scan-controller"),
+		ctx.InformerFactory.Registry().V1().Repositories(),
+		scanSyncPeriod,
+		registry,
+		ctx.RegistryDefaultConfiguration.VulnerabilityDatabaseDir,
+	)
+
+	go ctrl.Run(concurrentScanSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
+
+func startRetentionController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: registryv1.GroupName, Version: "v1", Resource: "namespaces"}] {
+		return nil, false, nil
+	}
+
+	registry, _, err := newStorageRegistry(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ctrl := retention.NewController(
+		ctx.ClientBuilder.ClientOrDie("retention-controller"),
+		ctx.InformerFactory.Registry().V1().Namespaces(),
+		ctx.InformerFactory.Registry().V1().Repositories(),
+		retentionSyncPeriod,
+		registry,
+	)
+
+	go ctrl.Run(concurrentRetentionSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
+
+func startReplicationController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: registryv1.GroupName, Version: "v1", Resource: "replicationpolicies"}] {
+		return nil, false, nil
+	}
+
+	localTransport, err := transport.NewOneWayTLSTransport(ctx.RegistryDefaultConfiguration.CAFile, false)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ctrl := replication.NewController(
+		ctx.ClientBuilder.ClientOrDie("replication-controller"),
+		ctx.InformerFactory.Registry().V1().ReplicationPolicies(),
+		ctx.InformerFactory.Registry().V1().Namespaces(),
+		ctx.InformerFactory.Registry().V1().Repositories(),
+		replicationSyncPeriod,
+		&replication.LocalRegistry{
+			DomainSuffix:  ctx.RegistryConfig.DomainSuffix,
+			DefaultTenant: ctx.RegistryConfig.DefaultTenant,
+			Username:      ctx.RegistryConfig.Security.AdminUsername,
+			Password:      ctx.RegistryConfig.Security.AdminPassword,
+			Transport:     localTransport,
+		},
+	)
+
+	go ctrl.Run(concurrentReplicationSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
+
+func startSignatureController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: registryv1.GroupName, Version: "v1", Resource: "trustedkeys"}] {
+		return nil, false, nil
+	}
+
+	registry, _, err := newStorageRegistry(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ctrl := signature.NewController(
+		ctx.ClientBuilder.ClientOrDie("signature-controller"),
+		ctx.InformerFactory.Registry().V1().Repositories(),
+		ctx.InformerFactory.Registry().V1().TrustedKeys(),
+		signatureSyncPeriod,
+		registry,
+	)
+
+	go ctrl.Run(concurrentSignatureSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
+
+func startGarbageCollector(ctx ControllerContext) (http.Handler, bool, error) {
+	if ctx.RegistryDefaultConfiguration.GarbageCollectionPeriod <= 0 {
+		return nil, false, nil
+	}
+
+	// only the strongly consistent storages are collected online
+	if ctx.RegistryConfig.Storage.FileSystem == nil && ctx.RegistryConfig.Storage.S3 == nil {
+		log.Warn("Registry garbage collection only supports the filesystem and s3 storage")
+		return nil, false, nil
+	}
+
+	registry, driver, err := newStorageRegistry(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	gc := garbagecollector.NewGarbageCollector(registry, driver, ctx.RegistryDefaultConfiguration.GarbageCollectionGracePeriod)
+
+	go gc.Run(ctx.RegistryDefaultConfiguration.GarbageCollectionPeriod, ctx.Stop)
+
+	return nil, true, nil
+}
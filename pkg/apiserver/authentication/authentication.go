@@ -36,11 +36,23 @@ func SetupAuthentication(genericAPIServerConfig *genericapiserver.Config, authen
 // SetupAuthenticationWithoutAudiences config the generic apiserver by
 // authentication options.
 func SetupAuthenticationWithoutAudiences(genericAPIServerConfig *genericapiserver.Config, authenticationOpts *options.AuthenticationOptions, apiAudiences []string) error {
-	var err error
-	genericAPIServerConfig.Authentication.Authenticator, genericAPIServerConfig.OpenAPIConfig.SecurityDefinitions, err = buildAuthenticator(authenticationOpts, apiAudiences)
+	var (
+		hooks []genericapiserver.PostStartHookProvider
+		err   error
+	)
+	genericAPIServerConfig.Authentication.Authenticator, genericAPIServerConfig.OpenAPIConfig.SecurityDefinitions, hooks, err = buildAuthenticator(authenticationOpts, apiAudiences)
 	if err != nil {
 		return fmt.Errorf("invalid authentication config: %v", err)
 	}
+	for _, hook := range hooks {
+		name, hookFunc, err := hook.PostStartHook()
+		if err != nil {
+			return err
+		}
+		if err := genericAPIServerConfig.AddPostStartHook(name, hookFunc); err != nil {
+			return err
+		}
+	}
 	if authenticationOpts.ClientCert != nil {
 		clientCertificateCAContentProvider, err := authenticationOpts.ClientCert.GetClientCAContentProvider()
 		if err != nil {
@@ -65,7 +77,7 @@ func SetupAuthenticationWithoutAudiences(genericAPIServerConfig *genericapiserve
 }
 
 // buildAuthenticator constructs the authenticator.
-func buildAuthenticator(o *options.AuthenticationOptions, apiAudiences []string) (authenticator.Request, *spec.SecurityDefinitions, error) {
+func buildAuthenticator(o *options.AuthenticationOptions, apiAudiences []string) (authenticator.Request, *spec.SecurityDefinitions, []genericapiserver.PostStartHookProvider, error) {
 	ret := Config{
 		APIAudiences:         apiAudiences,
 		TokenSuccessCacheTTL: o.TokenSuccessCacheTTL,
@@ -105,7 +117,7 @@ func buildAuthenticator(o *options.AuthenticationOptions, apiAudiences []string)
 		var err error
 		ret.RequestHeaderConfig, err = o.RequestHeader.ToAuthenticationRequestHeaderConfig()
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 	}
 
@@ -31,6 +31,7 @@ import (
 	"k8s.io/apiserver/pkg/authentication/authenticator"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"tkestack.io/tke/pkg/util/log"
+	utilnet "tkestack.io/tke/pkg/util/net"
 	"tkestack.io/tke/pkg/util/transport"
 )
 
@@ -81,6 +82,7 @@ func (a *Authenticator) AuthenticateRequest(req *http.Request) (*authenticator.R
 	if !ok {
 		return nil, false, fmt.Errorf("cannot get user info from request")
 	}
+	clientIP := utilnet.ClientIP(req)
 	if a.adminPassword != "" &&
 		a.adminUsername != "" &&
 		username == a.adminUsername &&
@@ -125,6 +127,10 @@ func (a *Authenticator) AuthenticateRequest(req *http.Request) (*authenticator.R
 		return nil, false, err
 	}
 	req.Header.Add("Content-Type", "application/json")
+	if clientIP != nil {
+		// Let the authentication server record the real source of the api key.
+		req.Header.Set("X-Forwarded-For", clientIP.String())
+	}
 	client := &http.Client{
 		Transport: a.tokenReviewTransport,
 	}
@@ -174,6 +180,13 @@ func (a *Authenticator) AuthenticateRequest(req *http.Request) (*authenticator.R
 			u.Extra[k] = v
 		}
 	}
+	if !SourceAllowed(u.Extra, clientIP) {
+		log.Warn("Api key is not allowed to be used from the source address",
+			log.String("username", u.Name),
+			log.Strings("apiKey", u.Extra[NameKey]),
+			log.Stringer("clientIP", clientIP))
+		return nil, false, fmt.Errorf("api key is not allowed to be used from %v", clientIP)
+	}
 	return &authenticator.Response{
 		User: u,
 	}, true, nil
@@ -0,0 +1,116 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package apikey
+
+import (
+	"net"
+
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// NameKey is the user extra key holding the name of the api key used to
+	// authenticate the request.
+	NameKey = "apikey.auth.tke.com/name"
+	// StatementsKey is the user extra key holding the json encoded statements
+	// the api key is restricted to.
+	StatementsKey = "apikey.auth.tke.com/statements"
+	// ProjectsKey is the user extra key holding the projects the api key is
+	// restricted to.
+	ProjectsKey = "apikey.auth.tke.com/projects"
+	// SourceCIDRsKey is the user extra key holding the source addresses the
+	// api key is restricted to.
+	SourceCIDRsKey = "apikey.auth.tke.com/sourceCIDRs"
+)
+
+// SetScope records the name and the scope of the api key in the user extra.
+func SetScope(extra map[string][]string, name string, scope *auth.APIKeyScope) error {
+	extra[NameKey] = []string{name}
+	if scope == nil {
+		return nil
+	}
+	if len(scope.Statements) > 0 {
+		var statements []string
+		for _, st := range scope.Statements {
+			data, err := json.Marshal(st)
+			if err != nil {
+				return err
+			}
+			statements = append(statements, string(data))
+		}
+		extra[StatementsKey] = statements
+	}
+	if len(scope.Projects) > 0 {
+		extra[ProjectsKey] = scope.Projects
+	}
+	if len(scope.SourceCIDRs) > 0 {
+		extra[SourceCIDRsKey] = scope.SourceCIDRs
+	}
+	return nil
+}
+
+// ScopeStatements returns the statements the api key is restricted to. The
+// returned bool is false if the actions are not restricted.
+func ScopeStatements(extra map[string][]string) ([]auth.Statement, bool, error) {
+	values, ok := extra[StatementsKey]
+	if !ok || len(values) == 0 {
+		return nil, false, nil
+	}
+	statements := make([]auth.Statement, 0, len(values))
+	for _, value := range values {
+		var st auth.Statement
+		if err := json.Unmarshal([]byte(value), &st); err != nil {
+			return nil, true, err
+		}
+		statements = append(statements, st)
+	}
+	return statements, true, nil
+}
+
+// ScopeProjects returns the projects the api key is restricted to. The
+// returned bool is false if the projects are not restricted.
+func ScopeProjects(extra map[string][]string) ([]string, bool) {
+	projects, ok := extra[ProjectsKey]
+	return projects, ok && len(projects) > 0
+}
+
+// SourceAllowed returns true if the request from the given address is allowed
+// by the source restriction of the api key. Requests not authenticated by a
+// restricted api key are always allowed.
+func SourceAllowed(extra map[string][]string, ip net.IP) bool {
+	cidrs, ok := extra[SourceCIDRsKey]
+	if !ok || len(cidrs) == 0 {
+		return true
+	}
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			log.Warn("Invalid source cidr of api key", log.String("cidr", cidr), log.Err(err))
+			continue
+		}
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
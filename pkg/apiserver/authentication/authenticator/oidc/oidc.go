@@ -41,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/apiserver/pkg/authentication/authenticator"
 	"k8s.io/apiserver/pkg/authentication/user"
+	genericapiserver "k8s.io/apiserver/pkg/server"
 	certutil "k8s.io/client-go/util/cert"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -127,6 +128,10 @@ type Options struct {
 	// RequiredClaims, if specified, causes the OIDCAuthenticator to verify that all the
 	// required claims key value pairs are present in the ID Token.
 	RequiredClaims map[string]string
+
+	// RevocationSyncPeriod is the period the revoked ID tokens are synced from
+	// the issuer, defaults to 5 seconds.
+	RevocationSyncPeriod time.Duration
 }
 
 // initVerifier creates a new ID token verifier for the given configuration and issuer URL.  On success, calls setVerifier with the
@@ -155,8 +160,13 @@ type Authenticator struct {
 	apiAudiences     authenticator.Audiences
 	verifier         *oidc.IDTokenVerifier
 	resolver         *claimResolver
+	revocations      *revocationCache
+	// revocationSyncPeriod is the period the revoked ID tokens are synced.
+	revocationSyncPeriod time.Duration
 }
 
+var _ genericapiserver.PostStartHookProvider = &Authenticator{}
+
 // New to create the Authenticator object by give options.
 func New(opts *Options) (*Authenticator, error) {
 	u, err := url.Parse(opts.IssuerURL)
@@ -243,11 +253,26 @@ func New(opts *Options) (*Authenticator, error) {
 		apiAudiences:     opts.APIAudiences,
 		verifier:         verifier,
 		resolver:         resolver,
+		revocations:      newRevocationCache(opts.IssuerURL, client),
+	}
+
+	a.revocationSyncPeriod = opts.RevocationSyncPeriod
+	if a.revocationSyncPeriod <= 0 {
+		a.revocationSyncPeriod = defaultRevocationSyncPeriod
 	}
 
 	return a, nil
 }
 
+// PostStartHook starts syncing the revoked ID tokens of the issuer until the
+// server stops.
+func (a *Authenticator) PostStartHook() (string, genericapiserver.PostStartHookFunc, error) {
+	return "oidc-revocation-sync", func(context genericapiserver.PostStartHookContext) error {
+		go a.revocations.run(a.revocationSyncPeriod, context.StopCh)
+		return nil
+	}, nil
+}
+
 // whitelist of signing algorithms to ensure users don't mistakenly pass something
 // goofy.
 var allowedSigningAlgs = map[string]bool{
@@ -543,6 +568,7 @@ func (a *Authenticator) AuthenticateToken(ctx context.Context, token string) (*a
 		}
 	}
 
+	claimedUsername := username
 	if a.usernamePrefix != "" {
 		username = a.usernamePrefix + username
 	}
@@ -584,6 +610,7 @@ func (a *Authenticator) AuthenticateToken(ctx context.Context, token string) (*a
 		}
 	}
 
+	var claimedTenantID string
 	if a.tenantIDClaim != "" {
 		if _, ok := c[a.tenantIDClaim]; ok {
 			var tenantID string
@@ -595,6 +622,7 @@ func (a *Authenticator) AuthenticateToken(ctx context.Context, token string) (*a
 			} else {
 				tenantID = federateIDClaim.ConnectorID
 			}
+			claimedTenantID = tenantID
 
 			if a.tenantIDPrefix != "" {
 				tenantID = a.tenantIDPrefix + tenantID
@@ -604,6 +632,18 @@ func (a *Authenticator) AuthenticateToken(ctx context.Context, token string) (*a
 		}
 	}
 
+	var sessionID string
+	if c.hasClaim(NonceParam) {
+		var nonce string
+		if err := c.unmarshalClaim(NonceParam, &nonce); err != nil {
+			return nil, false, fmt.Errorf("oidc: parse nonce claim: %v", err)
+		}
+		sessionID, _ = ParseSessionNonce(nonce)
+	}
+	if a.revocations.revoked(sessionID, claimedTenantID, claimedUsername, idToken.IssuedAt) {
+		return nil, false, fmt.Errorf("oidc: token has been revoked")
+	}
+
 	// check to ensure all required claims are present in the ID token and have matching values.
 	for claim, value := range a.requiredClaims {
 		if !c.hasClaim(claim) {
@@ -0,0 +1,167 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// RevocationPath is the path under the issuer URL the revoked ID tokens
+	// are published at.
+	RevocationPath = "/revocations"
+
+	// NonceParam is the parameter of the authorization request carrying the
+	// session nonce, it is copied into every ID token of the session.
+	NonceParam = "nonce"
+
+	defaultRevocationSyncPeriod = 5 * time.Second
+)
+
+// RevocationList is the revoked ID tokens published by the issuer. The
+// session IDs and users are hashed so that the list can be published to
+// every API server without authentication.
+type RevocationList struct {
+	// Sessions are the hashes of the revoked session IDs.
+	Sessions []string `json:"sessions,omitempty"`
+	// Users maps the hash of a user to the unix time, the ID tokens of the
+	// user issued before it are revoked.
+	Users map[string]int64 `json:"users,omitempty"`
+}
+
+// SessionHash returns the hash of the session ID published in the revocation list.
+func SessionHash(sessionID string) string {
+	sum := sha256.Sum256([]byte(sessionID))
+	return hex.EncodeToString(sum[:])
+}
+
+// UserHash returns the hash of the user published in the revocation list.
+func UserHash(tenantID, username string) string {
+	sum := sha256.Sum256([]byte(tenantID + "/" + username))
+	return hex.EncodeToString(sum[:])
+}
+
+// NewSessionNonce generates the nonce of a login. It carries a random session
+// ID and the address the user logs in from.
+func NewSessionNonce(clientIP string) (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	sessionID := hex.EncodeToString(b)
+	if clientIP == "" {
+		return sessionID, nil
+	}
+	return fmt.Sprintf("%s.%s", sessionID, clientIP), nil
+}
+
+// ParseSessionNonce returns the session ID and client address of the nonce
+// generated by NewSessionNonce.
+func ParseSessionNonce(nonce string) (sessionID string, clientIP string) {
+	parts := strings.SplitN(nonce, ".", 2)
+	if len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+	return parts[0], ""
+}
+
+// revocationCache keeps the revocation list of the issuer in memory.
+type revocationCache struct {
+	url    string
+	client *http.Client
+
+	lock     sync.RWMutex
+	sessions sets.String
+	users    map[string]int64
+}
+
+func newRevocationCache(issuerURL string, client *http.Client) *revocationCache {
+	return &revocationCache{
+		url:      strings.TrimSuffix(issuerURL, "/") + RevocationPath,
+		client:   client,
+		sessions: sets.NewString(),
+		users:    make(map[string]int64),
+	}
+}
+
+// run syncs the revocation list periodically until stopCh is closed.
+func (c *revocationCache) run(period time.Duration, stopCh <-chan struct{}) {
+	wait.Until(c.sync, period, stopCh)
+}
+
+func (c *revocationCache) sync() {
+	resp, err := c.client.Get(c.url)
+	if err != nil {
+		log.Warn("Failed to get the revoked ID tokens", log.String("url", c.url), log.Err(err))
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		// The issuer does not publish revoked ID tokens.
+		c.set(sets.NewString(), make(map[string]int64))
+		return
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Warn("Failed to get the revoked ID tokens", log.String("url", c.url), log.Int("status", resp.StatusCode))
+		return
+	}
+
+	var list RevocationList
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		log.Warn("Failed to decode the revoked ID tokens", log.String("url", c.url), log.Err(err))
+		return
+	}
+	if list.Users == nil {
+		list.Users = make(map[string]int64)
+	}
+	c.set(sets.NewString(list.Sessions...), list.Users)
+}
+
+func (c *revocationCache) set(sessions sets.String, users map[string]int64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.sessions = sessions
+	c.users = users
+}
+
+// revoked returns true if the ID token of the session or the user is revoked.
+func (c *revocationCache) revoked(sessionID, tenantID, username string, issuedAt time.Time) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	if sessionID != "" && c.sessions.Has(SessionHash(sessionID)) {
+		return true
+	}
+	if revokeTime, ok := c.users[UserHash(tenantID, username)]; ok && issuedAt.Unix() <= revokeTime {
+		return true
+	}
+	return false
+}
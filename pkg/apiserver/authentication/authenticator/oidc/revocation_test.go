@@ -0,0 +1,91 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package oidc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRevocationCache(t *testing.T) {
+	var requests int32
+	issuedAt := time.Now()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Path != RevocationPath {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&RevocationList{
+			Sessions: []string{SessionHash("session-a")},
+			Users:    map[string]int64{UserHash("default", "alice"): issuedAt.Unix()},
+		})
+	}))
+	defer server.Close()
+
+	c := newRevocationCache(server.URL, server.Client())
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		c.run(10*time.Millisecond, stopCh)
+		close(done)
+	}()
+	for atomic.LoadInt32(&requests) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	close(stopCh)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("revocation sync does not stop")
+	}
+
+	testCases := []struct {
+		name      string
+		sessionID string
+		username  string
+		issuedAt  time.Time
+		revoked   bool
+	}{
+		{"revoked session", "session-a", "bob", issuedAt, true},
+		{"other session", "session-b", "bob", issuedAt, false},
+		{"token of revoked user", "", "alice", issuedAt, true},
+		{"token issued after revocation", "", "alice", issuedAt.Add(time.Minute), false},
+	}
+	for _, tc := range testCases {
+		if revoked := c.revoked(tc.sessionID, "default", tc.username, tc.issuedAt); revoked != tc.revoked {
+			t.Errorf("%s: revoked = %v, want %v", tc.name, revoked, tc.revoked)
+		}
+	}
+}
+
+func TestSessionNonce(t *testing.T) {
+	nonce, err := NewSessionNonce("10.0.0.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sessionID, clientIP := ParseSessionNonce(nonce)
+	if sessionID == "" || clientIP != "10.0.0.1" {
+		t.Errorf("unexpected session %q and client address %q of nonce %q", sessionID, clientIP, nonce)
+	}
+}
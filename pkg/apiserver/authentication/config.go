@@ -32,6 +32,7 @@ import (
 	tokencache "k8s.io/apiserver/pkg/authentication/token/cache"
 	"k8s.io/apiserver/pkg/authentication/token/tokenfile"
 	tokenunion "k8s.io/apiserver/pkg/authentication/token/union"
+	genericapiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/apiserver/plugin/pkg/authenticator/token/webhook"
 	certutil "k8s.io/client-go/util/cert"
 	"k8s.io/kube-openapi/pkg/validation/spec"
@@ -67,10 +68,12 @@ type Config struct {
 }
 
 // New returns an authenticator.Request or an error that supports the standard
-// Kubernetes authentication mechanisms.
-func (config Config) New() (authenticator.Request, *spec.SecurityDefinitions, error) {
+// Kubernetes authentication mechanisms, together with the post start hooks the
+// authenticators need to run.
+func (config Config) New() (authenticator.Request, *spec.SecurityDefinitions, []genericapiserver.PostStartHookProvider, error) {
 	var authenticators []authenticator.Request
 	var tokenAuthenticators []authenticator.Token
+	var hooks []genericapiserver.PostStartHookProvider
 	securityDefinitions := spec.SecurityDefinitions{}
 
 	// front-proxy, BasicAuth methods, local first, then remote
@@ -90,7 +93,7 @@ func (config Config) New() (authenticator.Request, *spec.SecurityDefinitions, er
 	if len(config.ClientCAFile) > 0 {
 		certAuth, err := newAuthenticatorFromClientCAFile(config.ClientCAFile)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		authenticators = append(authenticators, certAuth)
 	}
@@ -101,7 +104,7 @@ func (config Config) New() (authenticator.Request, *spec.SecurityDefinitions, er
 	if len(config.TokenAuthFile) > 0 {
 		tokenAuth, err := newAuthenticatorFromTokenFile(config.TokenAuthFile)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		tokenAuthenticators = append(tokenAuthenticators, authenticator.WrapAudienceAgnosticToken(config.APIAudiences, tokenAuth))
 	}
@@ -123,15 +126,16 @@ func (config Config) New() (authenticator.Request, *spec.SecurityDefinitions, er
 			RequiredClaims:       config.OIDCRequiredClaims,
 		})
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		tokenAuthenticators = append(tokenAuthenticators, oidcAuth)
+		hooks = append(hooks, oidcAuth)
 	}
 
 	if len(config.WebhookTokenAuthnConfigFile) > 0 {
 		webhookTokenAuth, err := newWebhookTokenAuthenticator(config.WebhookTokenAuthnConfigFile, config.WebhookTokenAuthnVersion, config.WebhookTokenAuthnCacheTTL, config.APIAudiences)
 		if err != nil {
-			return nil, nil, err
+			return nil, nil, nil, err
 		}
 		tokenAuthenticators = append(tokenAuthenticators, webhookTokenAuth)
 	}
@@ -156,7 +160,7 @@ func (config Config) New() (authenticator.Request, *spec.SecurityDefinitions, er
 
 	finalAuthenticator := union.New(authenticators...)
 	finalAuthenticator = group.NewAuthenticatedGroupAdder(finalAuthenticator)
-	return finalAuthenticator, &securityDefinitions, nil
+	return finalAuthenticator, &securityDefinitions, hooks, nil
 }
 
 // newAuthenticatorFromTokenFile returns an authenticator.Token or an error
@@ -182,8 +186,8 @@ func newAuthenticatorFromClientCAFile(clientCAFile string) (authenticator.Reques
 	return x509.New(opts, x509.CommonNameUserConversion), nil
 }
 
-// newAuthenticatorFromOIDCIssuerURL returns an authenticator.Token or an error.
-func newAuthenticatorFromOIDCIssuerURL(opts *oidc.Options) (authenticator.Token, error) {
+// newAuthenticatorFromOIDCIssuerURL returns an OIDC authenticator or an error.
+func newAuthenticatorFromOIDCIssuerURL(opts *oidc.Options) (*oidc.Authenticator, error) {
 	tokenAuthenticator, err := oidc.New(opts)
 	if err != nil {
 		return nil, err
@@ -25,6 +25,7 @@ import (
 	"strings"
 
 	"k8s.io/apiserver/pkg/authentication/user"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/apikey"
 	"tkestack.io/tke/pkg/platform/apiserver/filter"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -36,6 +37,7 @@ import (
 	"k8s.io/component-base/metrics"
 	"k8s.io/component-base/metrics/legacyregistry"
 	"tkestack.io/tke/pkg/util/log"
+	tkenet "tkestack.io/tke/pkg/util/net"
 )
 
 const (
@@ -135,6 +137,16 @@ func WithAuthentication(handler http.Handler, auth authenticator.Request, failed
 			return
 		}
 
+		if !apikey.SourceAllowed(resp.User.GetExtra(), tkenet.ClientIP(req)) {
+			log.Warn("Api key is not allowed to be used from the source address",
+				log.String("username", resp.User.GetName()),
+				log.Strings("apiKey", resp.User.GetExtra()[apikey.NameKey]),
+				log.String("remoteAddr", req.RemoteAddr))
+			authenticatedAttemptsCounter.WithLabelValues(failureLabel).Inc()
+			failed.ServeHTTP(w, req)
+			return
+		}
+
 		// authorization header is not required anymore in case of a successful authentication.
 		req.Header.Del("Authorization")
 
@@ -154,6 +166,10 @@ func WithAuthentication(handler http.Handler, auth authenticator.Request, failed
 			if projectName != "" {
 				userInfo.Groups = appendGroups(userInfo.Groups, "project", projectName)
 			}
+			// The source address is evaluated by the conditions of policies.
+			if ip := tkenet.ClientIP(req); ip != nil {
+				userInfo.Groups = appendGroups(userInfo.Groups, "sourceip", ip.String())
+			}
 
 			req = req.WithContext(genericapirequest.WithUser(req.Context(), userInfo))
 		} else {
@@ -0,0 +1,54 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package filter
+
+import (
+	"context"
+	"net/http"
+
+	genericrequest "k8s.io/apiserver/pkg/endpoints/request"
+	utilnet "tkestack.io/tke/pkg/util/net"
+)
+
+type clientIPContextKeyType int
+
+const clientIPContextKey clientIPContextKeyType = iota
+
+// WithClientIP adds the source address of the request to the context of the
+// http access chain.
+func WithClientIP(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if ip := utilnet.ClientIP(req); ip != nil {
+			req = req.WithContext(WithClientIPValue(req.Context(), ip.String()))
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// WithClientIPValue returns a copy of parent in which the source address of
+// the request is set.
+func WithClientIPValue(parent context.Context, ip string) context.Context {
+	return genericrequest.WithValue(parent, clientIPContextKey, ip)
+}
+
+// ClientIPFrom get the source address of the request from request context.
+func ClientIPFrom(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPContextKey).(string)
+	return ip
+}
@@ -87,6 +87,7 @@ func BuildHandlerChain(ignoreAuthPathPrefixes []string, ignoreAuthzPathPrefixes
 		handler = genericfilters.WithWaitGroup(handler, c.LongRunningFunc, c.HandlerChainWaitGroup)
 		handler = genericapifilters.WithRequestInfo(handler, c.RequestInfoResolver)
 		handler = apiserverfilter.WithLocal(handler)
+		handler = apiserverfilter.WithClientIP(handler)
 		handler = apiserverfilter.WithRequestID(handler)
 		handler = apiserverfilter.WithProject(handler)
 		handler = genericfilters.WithPanicRecovery(handler, c.RequestInfoResolver)
@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	genericserveroptions "k8s.io/apiserver/pkg/server/options"
 	"tkestack.io/tke/pkg/util/log"
+	tkenet "tkestack.io/tke/pkg/util/net"
 )
 
 const (
@@ -43,6 +44,7 @@ const (
 	flagRequestTimeout              = "request-timeout"
 	flagMaxMutatingRequestsInflight = "max-mutating-requests-inflight"
 	flagMaxRequestsInflight         = "max-requests-inflight"
+	flagTrustedProxies              = "trusted-proxies"
 )
 
 const (
@@ -56,6 +58,7 @@ const (
 	configRequestTimeout              = "generic.request_timeout"
 	configMaxMutatingRequestsInflight = "generic.max_mutating_requests_inflight"
 	configMaxRequestsInflight         = "generic.max_requests_inflight"
+	configTrustedProxies              = "generic.trusted_proxies"
 )
 
 // GenericOptions contains the options while running a generic api server.
@@ -64,6 +67,9 @@ type GenericOptions struct {
 	ExternalPort   int
 	ExternalScheme string
 	ExternalCAFile string
+	// TrustedProxies are the addresses or CIDRs of the proxies whose
+	// forwarded headers are trusted to get the source address of requests.
+	TrustedProxies []string
 }
 
 // NewGenericOptions creates a Options object with default parameters.
@@ -92,6 +98,10 @@ func (o *GenericOptions) AddFlags(fs *pflag.FlagSet) {
 		"The CA file to use when generating externalized URLs for this server.")
 	_ = viper.BindPFlag(configExternalCAFile, fs.Lookup(flagExternalCAFile))
 
+	fs.StringSlice(flagTrustedProxies, o.TrustedProxies,
+		"The addresses or CIDRs of the proxies whose X-Forwarded-For and X-Real-Ip headers are trusted to get the source address of requests. The headers are ignored if empty.")
+	_ = viper.BindPFlag(configTrustedProxies, fs.Lookup(flagTrustedProxies))
+
 	_ = viper.BindPFlag(configAdvertiseAddress, fs.Lookup(flagAdvertiseAddress))
 	_ = viper.BindPFlag(configCORSAllowedOrigins, fs.Lookup(flagCORSAllowedOrigins))
 	_ = viper.BindPFlag(configExternalHostname, fs.Lookup(flagExternalHostname))
@@ -116,6 +126,10 @@ func (o *GenericOptions) ApplyFlags() []error {
 	o.MaxMutatingRequestsInFlight = viper.GetInt(configMaxMutatingRequestsInflight)
 	o.MaxRequestsInFlight = viper.GetInt(configMaxRequestsInflight)
 	o.MinRequestTimeout = viper.GetInt(configMinRequestTimeout)
+	o.TrustedProxies = viper.GetStringSlice(configTrustedProxies)
+	if err := tkenet.SetTrustedProxies(o.TrustedProxies); err != nil {
+		errs = append(errs, err)
+	}
 
 	if validateErrs := o.Validate(); len(validateErrs) > 0 {
 		errs = append(errs, validateErrs...)
@@ -44,11 +44,14 @@ import (
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
 	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
 	"tkestack.io/tke/pkg/apiserver/storage"
 	"tkestack.io/tke/pkg/auth/authentication/authenticator"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/local"
 	authnhandler "tkestack.io/tke/pkg/auth/handler/authn"
 	authzhandler "tkestack.io/tke/pkg/auth/handler/authz"
+	jwkshandler "tkestack.io/tke/pkg/auth/handler/jwks"
+	revocationhandler "tkestack.io/tke/pkg/auth/handler/revocation"
 	authrest "tkestack.io/tke/pkg/auth/registry/rest"
 	"tkestack.io/tke/pkg/auth/route"
 	"tkestack.io/tke/pkg/util/log"
@@ -57,6 +60,7 @@ import (
 const (
 	OIDCPath           = "/oidc/"
 	AuthPath           = "/auth/"
+	AuthzPath          = "/authz/"
 	APIKeyPasswordPath = "/apis/auth.tkestack.io/v1/apikeys/default/password"
 
 	APIKeyPath = "/apis/auth.tkestack.io/v1/apikeys"
@@ -92,6 +96,10 @@ type ExtraConfig struct {
 	Authorizer           authorizer.Authorizer
 	CasbinReloadInterval time.Duration
 	PrivilegedUsername   string
+	TenantUsageClients   *local2.TenantUsageClients
+
+	APISigningKeyRotationPeriod time.Duration
+	APISigningKeyOverlap        time.Duration
 }
 
 // Config contains the core configuration instance of apiserver and
@@ -141,7 +149,8 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 	installHooks(s, hooks)
 	installCasbinPreStopHook(s, c.ExtraConfig.CasbinEnforcer)
 
-	c.registerRoute(&dexHandler, s.Handler.GoRestfulContainer, s.Handler.NonGoRestfulMux)
+	authClient := authinternalclient.NewForConfigOrDie(s.LoopbackClientConfig)
+	c.registerRoute(&dexHandler, authClient, s.Handler.GoRestfulContainer, s.Handler.NonGoRestfulMux)
 
 	m := &APIServer{
 		GenericAPIServer: s,
@@ -155,6 +164,7 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 			DexStorage:           c.ExtraConfig.DexStorage,
 			PrivilegedUsername:   c.ExtraConfig.PrivilegedUsername,
 			VersionedInformers:   c.ExtraConfig.VersionedInformers,
+			IDTokenTimeout:       c.ExtraConfig.DexConfig.IDTokensValidFor,
 		},
 	}
 	m.InstallAPIs(c.ExtraConfig.APIResourceConfigSource, c.GenericConfig.RESTOptionsGetter, restStorageProviders...)
@@ -208,12 +218,15 @@ func DefaultAPIResourceConfigSource() *serverstorage.ResourceConfig {
 }
 
 // registerRoute is used to register routes with the api server of project.
-func (c completedConfig) registerRoute(dexHandler http.Handler, container *restful.Container, mux *mux.PathRecorderMux) {
+func (c completedConfig) registerRoute(dexHandler http.Handler, authClient authinternalclient.AuthInterface, container *restful.Container, mux *mux.PathRecorderMux) {
 	mux.HandlePrefix("/"+auth.IssuerName+"/", dexHandler)
+	revocations := revocationhandler.NewHandler(c.ExtraConfig.VersionedInformers.Auth().V1().SessionRevocations().Lister())
+	mux.Handle("/"+auth.IssuerName+oidc.RevocationPath, revocations)
 
 	token := authnhandler.NewHandler(c.ExtraConfig.TokenAuthn, c.ExtraConfig.APIKeyAuthn)
-	authz := authzhandler.NewHandler(c.ExtraConfig.Authorizer)
-	route.RegisterAuthRoute(container, token, authz)
+	explainer := local2.NewAuthorizer(authClient, c.ExtraConfig.VersionedInformers.Auth().V1().Tenants(), c.ExtraConfig.TenantUsageClients, c.ExtraConfig.CasbinEnforcer, c.ExtraConfig.PrivilegedUsername, 0)
+	authz := authzhandler.NewHandler(c.ExtraConfig.Authorizer, explainer, c.ExtraConfig.PrivilegedUsername)
+	route.RegisterAuthRoute(container, token, authz, jwkshandler.NewHandler(authClient))
 }
 
 // registerHooks is used to register postStart hook to create authn provider with local oidc server.
@@ -224,7 +237,7 @@ func (c completedConfig) registerHooks(dexHandler *identityprovider.DexHander, s
 	dexHook := identityprovider.NewDexHookHandler(context.Background(), authClient, c.ExtraConfig.DexConfig, c.ExtraConfig.DexStorage, dexHandler,
 		c.ExtraConfig.OIDCExternalAddress, fmt.Sprintf("%s/%s", s.LoopbackClientConfig.Host, auth.IssuerName), c.ExtraConfig.TokenAuthn)
 
-	apiSigningKeyHook := authenticator.NewAPISigningKeyHookHandler(authClient)
+	apiSigningKeyHook := authenticator.NewAPISigningKeyHookHandler(authClient, c.ExtraConfig.APISigningKeyRotationPeriod, c.ExtraConfig.APISigningKeyOverlap)
 
 	localIdpHook := local.NewLocalHookHandler(authClient)
 	ldapIdpHook := ldap.NewLdapHookHandler(authClient)
@@ -232,7 +245,7 @@ func (c completedConfig) registerHooks(dexHandler *identityprovider.DexHander, s
 	authVersionedClient := versionedclientset.NewForConfigOrDie(s.LoopbackClientConfig)
 	adapterHook := local2.NewAdapterHookHandler(authVersionedClient, c.ExtraConfig.CasbinEnforcer, c.ExtraConfig.VersionedInformers, c.ExtraConfig.CasbinReloadInterval)
 
-	return []genericapiserver.PostStartHookProvider{dexHook, apiSigningKeyHook, localIdpHook, ldapIdpHook, adapterHook}
+	return []genericapiserver.PostStartHookProvider{dexHook, apiSigningKeyHook, localIdpHook, ldapIdpHook, adapterHook, c.ExtraConfig.APIKeyAuthn}
 }
 
 // installCasbinPreStopHook is used to register preStop hook to stop casbin enforcer sync.
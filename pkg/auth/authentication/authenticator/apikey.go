@@ -29,7 +29,9 @@ import (
 	"k8s.io/apiserver/pkg/authentication/user"
 
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/apikey"
 	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	"tkestack.io/tke/pkg/apiserver/filter"
 	"tkestack.io/tke/pkg/auth/util"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -38,12 +40,21 @@ import (
 type APIKeyAuthenticator struct {
 	authClient authinternalclient.AuthInterface
 	keySigner  util.KeySigner
+
+	usage              *usageRecorder
+	unusedDisableAfter time.Duration
 }
 
-// NewAPIKeyAuthenticator creates new APIKeyAuthenticator object.
-func NewAPIKeyAuthenticator(authClient authinternalclient.AuthInterface) (*APIKeyAuthenticator, error) {
+// NewAPIKeyAuthenticator creates new APIKeyAuthenticator object. Api keys not
+// used for longer than unusedDisableAfter are disabled, zero means never.
+func NewAPIKeyAuthenticator(authClient authinternalclient.AuthInterface, unusedDisableAfter time.Duration) (*APIKeyAuthenticator, error) {
 	keySigner := util.NewGenericKeySigner(authClient)
-	apiKeyAuth := &APIKeyAuthenticator{authClient, keySigner}
+	apiKeyAuth := &APIKeyAuthenticator{
+		authClient:         authClient,
+		keySigner:          keySigner,
+		usage:              newUsageRecorder(),
+		unusedDisableAfter: unusedDisableAfter,
+	}
 	return apiKeyAuth, nil
 }
 
@@ -80,6 +91,11 @@ func (h *APIKeyAuthenticator) AuthenticateToken(ctx context.Context, token strin
 		return nil, false, fmt.Errorf("api key has been disabled")
 	}
 
+	if !apiKey.Status.RetireTime.IsZero() && time.Now().After(apiKey.Status.RetireTime.Time) {
+		log.Info("Api key has been rotated and retired", log.String("api key", apiKey.Name), log.String("rotatedTo", apiKey.Status.RotatedTo))
+		return nil, false, fmt.Errorf("api key has been rotated to %s", apiKey.Status.RotatedTo)
+	}
+
 	info := &user.DefaultInfo{Name: tokenInfo.UserName}
 
 	user, err := util.GetUserByName(ctx, h.authClient, tokenInfo.TenantID, info.Name)
@@ -101,6 +117,12 @@ func (h *APIKeyAuthenticator) AuthenticateToken(ctx context.Context, token strin
 	info.Extra["expireAt"] = []string{time.Unix(tokenInfo.ExpiresAt, 0).String()}
 	info.Extra["issueAt"] = []string{time.Unix(tokenInfo.IssuedAt, 0).String()}
 	info.Extra["description"] = []string{apiKey.Spec.Description}
+	if err := apikey.SetScope(info.Extra, apiKey.Name, apiKey.Spec.Scope); err != nil {
+		log.Error("Set api key scope failed", log.String("api key", apiKey.Name), log.Err(err))
+		return nil, false, err
+	}
+
+	h.usage.record(apiKey.Name, filter.ClientIPFrom(ctx))
 
 	log.Debug("APIkey authenticateToken result", log.Any("user info", info))
 	return &genericauthenticator.Response{User: info}, true, nil
@@ -0,0 +1,150 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package authenticator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/util/retry"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// usageFlushInterval is the interval of writing the aggregated api key
+	// usage to the storage.
+	usageFlushInterval = 30 * time.Second
+	// unusedCheckInterval is the interval of disabling unused api keys.
+	unusedCheckInterval = time.Hour
+)
+
+type apiKeyUsage struct {
+	count    int64
+	lastUsed time.Time
+	lastIP   string
+}
+
+// usageRecorder aggregates the usage of api keys in memory, so that the
+// authentication does not write to the storage on each request.
+type usageRecorder struct {
+	lock    sync.Mutex
+	pending map[string]*apiKeyUsage
+}
+
+func newUsageRecorder() *usageRecorder {
+	return &usageRecorder{pending: map[string]*apiKeyUsage{}}
+}
+
+func (r *usageRecorder) record(name, ip string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	usage, ok := r.pending[name]
+	if !ok {
+		usage = &apiKeyUsage{}
+		r.pending[name] = usage
+	}
+	usage.count++
+	usage.lastUsed = time.Now()
+	if ip != "" {
+		usage.lastIP = ip
+	}
+}
+
+func (r *usageRecorder) drain() map[string]*apiKeyUsage {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	pending := r.pending
+	r.pending = map[string]*apiKeyUsage{}
+	return pending
+}
+
+func (r *usageRecorder) flush(authClient authinternalclient.AuthInterface) {
+	for name, usage := range r.drain() {
+		usage := usage
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			apiKey, err := authClient.APIKeys().Get(context.Background(), name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			apiKey.Status.RequestCount += usage.count
+			apiKey.Status.LastUsedTime = metav1.NewTime(usage.lastUsed)
+			if usage.lastIP != "" {
+				apiKey.Status.LastSourceIP = usage.lastIP
+			}
+			_, err = authClient.APIKeys().UpdateStatus(context.Background(), apiKey, metav1.UpdateOptions{})
+			return err
+		})
+		if err != nil {
+			log.Warn("Failed to record api key usage", log.String("apiKey", name), log.Int64("count", usage.count), log.Err(err))
+		}
+	}
+}
+
+// PostStartHook starts to record the api key usage and to disable the unused
+// api keys.
+func (h *APIKeyAuthenticator) PostStartHook() (string, genericapiserver.PostStartHookFunc, error) {
+	return "apikey-usage-recorder", func(ctx genericapiserver.PostStartHookContext) error {
+		go func() {
+			wait.Until(func() { h.usage.flush(h.authClient) }, usageFlushInterval, ctx.StopCh)
+			h.usage.flush(h.authClient)
+		}()
+		if h.unusedDisableAfter > 0 {
+			go wait.Until(h.disableUnused, unusedCheckInterval, ctx.StopCh)
+		}
+		return nil
+	}, nil
+}
+
+// disableUnused disables the api keys not used for longer than the
+// configured duration, and the rotated api keys after their retire time.
+func (h *APIKeyAuthenticator) disableUnused() {
+	apiKeyList, err := h.authClient.APIKeys().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		log.Error("Failed to list api keys", log.Err(err))
+		return
+	}
+
+	now := time.Now()
+	since := now.Add(-h.unusedDisableAfter)
+	for i := range apiKeyList.Items {
+		apiKey := &apiKeyList.Items[i]
+		if apiKey.Status.Disabled {
+			continue
+		}
+		retired := !apiKey.Status.RetireTime.IsZero() && now.After(apiKey.Status.RetireTime.Time)
+		if !retired && !util.APIKeyUnusedSince(apiKey, since) {
+			continue
+		}
+		apiKey.Status.Disabled = true
+		if _, err := h.authClient.APIKeys().UpdateStatus(context.Background(), apiKey, metav1.UpdateOptions{}); err != nil {
+			log.Error("Failed to disable unused api key", log.String("apiKey", apiKey.Name), log.Err(err))
+			continue
+		}
+		log.Info("Disabled unused api key", log.String("apiKey", apiKey.Name), log.String("username", apiKey.Spec.Username),
+			log.Time("lastUsed", util.APIKeyLastUsed(apiKey)), log.Bool("retired", retired))
+	}
+}
@@ -0,0 +1,126 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package authenticator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/api/client/clientset/internalversion/fake"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+)
+
+func newAPIKeyClient(t *testing.T, apiKeys ...*auth.APIKey) authinternalclient.AuthInterface {
+	client := fake.NewSimpleClientset().Auth()
+	for _, apiKey := range apiKeys {
+		if _, err := client.APIKeys().Create(context.Background(), apiKey, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return client
+}
+
+func TestUsageRecorderFlush(t *testing.T) {
+	client := newAPIKeyClient(t, &auth.APIKey{
+		ObjectMeta: metav1.ObjectMeta{Name: "key1"},
+		Status:     auth.APIKeyStatus{RequestCount: 5, LastSourceIP: "10.0.0.1"},
+	})
+
+	recorder := newUsageRecorder()
+	recorder.record("key1", "10.0.0.2")
+	recorder.record("key1", "")
+	// the usage of deleted api keys is dropped
+	recorder.record("key2", "10.0.0.3")
+	recorder.flush(client)
+
+	apiKey, err := client.APIKeys().Get(context.Background(), "key1", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if apiKey.Status.RequestCount != 7 {
+		t.Errorf("expected 7 requests, got %d", apiKey.Status.RequestCount)
+	}
+	if apiKey.Status.LastSourceIP != "10.0.0.2" {
+		t.Errorf("expected last source 10.0.0.2, got %s", apiKey.Status.LastSourceIP)
+	}
+	if apiKey.Status.LastUsedTime.IsZero() {
+		t.Error("expected the last used time to be recorded")
+	}
+	if len(recorder.pending) != 0 {
+		t.Errorf("expected the flushed usage to be drained, got %v", recorder.pending)
+	}
+
+	recorder.flush(client)
+	apiKey, _ = client.APIKeys().Get(context.Background(), "key1", metav1.GetOptions{})
+	if apiKey.Status.RequestCount != 7 {
+		t.Errorf("expected the usage to be flushed once, got %d requests", apiKey.Status.RequestCount)
+	}
+}
+
+func TestDisableUnused(t *testing.T) {
+	now := time.Now()
+	issued := metav1.NewTime(now.Add(-30 * 24 * time.Hour))
+	client := newAPIKeyClient(t,
+		&auth.APIKey{
+			ObjectMeta: metav1.ObjectMeta{Name: "used"},
+			Spec:       auth.APIKeySpec{IssueAt: issued},
+			Status:     auth.APIKeyStatus{LastUsedTime: metav1.NewTime(now.Add(-time.Hour))},
+		},
+		&auth.APIKey{
+			ObjectMeta: metav1.ObjectMeta{Name: "unused"},
+			Spec:       auth.APIKeySpec{IssueAt: issued},
+			Status:     auth.APIKeyStatus{LastUsedTime: metav1.NewTime(now.Add(-10 * 24 * time.Hour))},
+		},
+		&auth.APIKey{
+			ObjectMeta: metav1.ObjectMeta{Name: "retired"},
+			Spec:       auth.APIKeySpec{IssueAt: issued},
+			Status: auth.APIKeyStatus{
+				LastUsedTime: metav1.NewTime(now.Add(-time.Hour)),
+				RotatedTo:    "used",
+				RetireTime:   metav1.NewTime(now.Add(-time.Minute)),
+			},
+		},
+		&auth.APIKey{
+			ObjectMeta: metav1.ObjectMeta{Name: "rotating"},
+			Spec:       auth.APIKeySpec{IssueAt: issued},
+			Status: auth.APIKeyStatus{
+				LastUsedTime: metav1.NewTime(now.Add(-time.Hour)),
+				RotatedTo:    "used",
+				RetireTime:   metav1.NewTime(now.Add(time.Hour)),
+			},
+		},
+	)
+
+	h := &APIKeyAuthenticator{authClient: client, unusedDisableAfter: 7 * 24 * time.Hour}
+	h.disableUnused()
+
+	expected := map[string]bool{"used": false, "unused": true, "retired": true, "rotating": false}
+	for name, disabled := range expected {
+		apiKey, err := client.APIKeys().Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if apiKey.Status.Disabled != disabled {
+			t.Errorf("expected api key %s disabled %v, got %v", name, disabled, apiKey.Status.Disabled)
+		}
+	}
+}
@@ -23,13 +23,16 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"io"
+	"time"
 
 	"tkestack.io/tke/pkg/auth/util"
 
 	genericapiserver "k8s.io/apiserver/pkg/server"
 
+	"github.com/dgrijalva/jwt-go"
 	"gopkg.in/square/go-jose.v2"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apiserver/pkg/server"
 	"tkestack.io/tke/api/auth"
 	"tkestack.io/tke/pkg/util/log"
@@ -39,14 +42,23 @@ import (
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
 )
 
+// rotationCheckPeriod is the interval the signing key is checked for rotation.
+const rotationCheckPeriod = time.Minute
+
 type apiSigningKeysHookHandler struct {
-	authClient authinternalclient.AuthInterface
+	authClient     authinternalclient.AuthInterface
+	rotationPeriod time.Duration
+	overlap        time.Duration
 }
 
 // NewAPISigningKeyHookHandler creates a new apiSigningKeysHookHandler object.
-func NewAPISigningKeyHookHandler(authClient authinternalclient.AuthInterface) genericapiserver.PostStartHookProvider {
+// The signing key is rotated every rotation period if it is positive, and the
+// retired public key is kept for verification for at least the overlap.
+func NewAPISigningKeyHookHandler(authClient authinternalclient.AuthInterface, rotationPeriod, overlap time.Duration) genericapiserver.PostStartHookProvider {
 	return &apiSigningKeysHookHandler{
-		authClient: authClient,
+		authClient:     authClient,
+		rotationPeriod: rotationPeriod,
+		overlap:        overlap,
 	}
 }
 
@@ -54,7 +66,7 @@ func (d *apiSigningKeysHookHandler) PostStartHook() (string, server.PostStartHoo
 	return "generate-default-api-signing-keys", func(ctx server.PostStartHookContext) error {
 		_, err := d.authClient.APISigningKeys().Get(context.Background(), util.DefaultAPISigningKey, metav1.GetOptions{})
 		if errors.IsNotFound(err) {
-			privateKey, pubKey, err := generateKey()
+			keyID, privateKey, pubKey, err := generateKey()
 			if err != nil {
 				return err
 			}
@@ -65,24 +77,125 @@ func (d *apiSigningKeysHookHandler) PostStartHook() (string, server.PostStartHoo
 				},
 				SigningKey:    privateKey,
 				SigningKeyPub: pubKey,
+				KeyID:         keyID,
+				RotateTime:    metav1.Now(),
 			}
 
 			if _, err := d.authClient.APISigningKeys().Create(context.Background(), signingKey, metav1.CreateOptions{}); err != nil {
 				log.Error("Failed to create the api signing key", log.Err(err))
 				return err
 			}
+		} else if err != nil {
+			return err
+		}
 
-			return nil
+		if d.rotationPeriod > 0 {
+			go wait.Until(d.rotate, rotationCheckPeriod, ctx.StopCh)
 		}
-		return err
+		return nil
 	}, nil
 }
 
-func generateKey() ([]byte, []byte, error) {
+// rotate replaces the signing key once the rotation period has passed, and
+// drops the retired keys every api key signed with has expired.
+func (d *apiSigningKeysHookHandler) rotate() {
+	keys, err := d.authClient.APISigningKeys().Get(context.Background(), util.DefaultAPISigningKey, metav1.GetOptions{})
+	if err != nil {
+		log.Error("Failed to get the api signing key", log.Err(err))
+		return
+	}
+
+	now := time.Now()
+	var verificationKeys []auth.VerificationKey
+	for _, key := range keys.VerificationKeys {
+		if key.Expiry.Time.After(now) {
+			verificationKeys = append(verificationKeys, key)
+		}
+	}
+	pruned := len(verificationKeys) != len(keys.VerificationKeys)
+
+	rotateTime := keys.RotateTime
+	if rotateTime.IsZero() {
+		rotateTime = keys.CreationTimestamp
+	}
+	if now.Before(rotateTime.Add(d.rotationPeriod)) {
+		if !pruned {
+			return
+		}
+		keys.VerificationKeys = verificationKeys
+		if _, err := d.authClient.APISigningKeys().Update(context.Background(), keys, metav1.UpdateOptions{}); err != nil {
+			log.Error("Failed to prune the retired api signing keys", log.Err(err))
+		}
+		return
+	}
+
+	var privKey jose.JSONWebKey
+	if err := privKey.UnmarshalJSON(keys.SigningKey); err != nil {
+		log.Error("Failed to unmarshal signing priv key", log.Err(err))
+		return
+	}
+	retiredKeyID := util.SigningKeyID(keys, privKey)
+	expiry, err := d.retiredKeyExpiry(retiredKeyID, now)
+	if err != nil {
+		log.Error("Failed to get the expiry of the retired api signing key", log.Err(err))
+		return
+	}
+
+	keyID, privateKey, pubKey, err := generateKey()
+	if err != nil {
+		return
+	}
+	keys.VerificationKeys = append(verificationKeys, auth.VerificationKey{
+		KeyID:     retiredKeyID,
+		PublicKey: keys.SigningKeyPub,
+		Expiry:    metav1.NewTime(expiry),
+	})
+	keys.SigningKey = privateKey
+	keys.SigningKeyPub = pubKey
+	keys.KeyID = keyID
+	keys.RotateTime = metav1.NewTime(now)
+
+	// The resource version of the key makes only one of the api servers
+	// rotate it.
+	if _, err := d.authClient.APISigningKeys().Update(context.Background(), keys, metav1.UpdateOptions{}); err != nil {
+		if !errors.IsConflict(err) {
+			log.Error("Failed to rotate the api signing key", log.Err(err))
+		}
+		return
+	}
+	log.Info("Rotated the api signing key", log.String("keyID", keyID), log.String("retiredKeyID", retiredKeyID), log.Time("retiredKeyExpiry", expiry))
+}
+
+// retiredKeyExpiry returns the time the last api key signed with the retired
+// key expires, not earlier than the overlap from now.
+func (d *apiSigningKeysHookHandler) retiredKeyExpiry(keyID string, now time.Time) (time.Time, error) {
+	expiry := now.Add(d.overlap)
+	apiKeys, err := d.authClient.APIKeys().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return expiry, err
+	}
+	for _, apiKey := range apiKeys.Items {
+		if !apiKey.Spec.ExpireAt.Time.After(expiry) {
+			continue
+		}
+		token, _, err := new(jwt.Parser).ParseUnverified(apiKey.Spec.APIkey, &util.APIClaims{})
+		if err != nil {
+			continue
+		}
+		// Api keys without key id are signed with the legacy key.
+		if kid, _ := token.Header[util.KeyIDHeader].(string); kid != "" && kid != keyID {
+			continue
+		}
+		expiry = apiKey.Spec.ExpireAt.Time
+	}
+	return expiry, nil
+}
+
+func generateKey() (string, []byte, []byte, error) {
 	key, err := pkiutil.NewPrivateKey()
 	if err != nil {
 		log.Error("Failed generate signing key", log.Err(err))
-		return nil, nil, err
+		return "", nil, nil, err
 	}
 
 	b := make([]byte, 20)
@@ -105,13 +218,13 @@ func generateKey() ([]byte, []byte, error) {
 
 	privateKeyBytes, err := privateKey.MarshalJSON()
 	if err != nil {
-		return nil, nil, err
+		return "", nil, nil, err
 	}
 
 	pubKeyBytes, err := pubKey.MarshalJSON()
 	if err != nil {
-		return nil, nil, err
+		return "", nil, nil, err
 	}
 
-	return privateKeyBytes, pubKeyBytes, nil
+	return keyID, privateKeyBytes, pubKeyBytes, nil
 }
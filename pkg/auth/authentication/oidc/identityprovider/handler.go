@@ -19,6 +19,7 @@
 package identityprovider
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -27,8 +28,25 @@ import (
 	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
 	genericrequest "k8s.io/apiserver/pkg/endpoints/request"
 	"tkestack.io/tke/api/auth"
+	utilnet "tkestack.io/tke/pkg/util/net"
 )
 
+type contextKey int
+
+// clientIPKey is the context key of the source IP of the login request.
+const clientIPKey contextKey = iota
+
+// WithClientIP returns a copy of parent in which the client IP value is set.
+func WithClientIP(parent context.Context, clientIP string) context.Context {
+	return genericrequest.WithValue(parent, clientIPKey, clientIP)
+}
+
+// ClientIPFrom returns the source IP of the login request.
+func ClientIPFrom(ctx context.Context) (string, bool) {
+	clientIP, ok := ctx.Value(clientIPKey).(string)
+	return clientIP, ok
+}
+
 type DexHander struct {
 	handler http.Handler
 }
@@ -44,6 +62,9 @@ func (t *DexHander) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		for k, v := range r.Header {
 			r = r.WithContext(genericrequest.WithValue(r.Context(), k, v))
 		}
+		if ip := utilnet.ClientIP(r); ip != nil {
+			r = r.WithContext(WithClientIP(r.Context(), ip.String()))
+		}
 		err := r.ParseForm()
 		if err == nil {
 			for k, v := range r.Form {
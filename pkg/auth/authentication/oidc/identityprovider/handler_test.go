@@ -0,0 +1,43 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package identityprovider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"tkestack.io/tke/api/auth"
+)
+
+func TestDexHandlerClientIP(t *testing.T) {
+	var got string
+	handler := &DexHander{handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, _ = ClientIPFrom(r.Context())
+	})}
+
+	req := httptest.NewRequest(http.MethodPost, "/"+auth.IssuerName+"/auth/local", nil)
+	req.RemoteAddr = "10.0.0.1:34567"
+	req.Header.Set("X-Forwarded-For", "1.2.3.4")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if got != "10.0.0.1" {
+		t.Errorf("client IP = %q, want the remote address 10.0.0.1 instead of the forwarded one", got)
+	}
+}
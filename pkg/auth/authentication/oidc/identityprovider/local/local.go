@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dexidp/dex/connector"
 	dexlog "github.com/dexidp/dex/pkg/log"
@@ -35,6 +36,7 @@ import (
 	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/audit"
 	"tkestack.io/tke/api/auth"
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
 	"tkestack.io/tke/pkg/apiserver/authentication"
@@ -129,16 +131,55 @@ func (p *localConnector) Login(ctx context.Context, scopes connector.Scopes, use
 		return ident, false, nil
 	}
 
+	policy, err := util.GetPasswordPolicy(ctx, authClient, p.tenantID)
+	if err != nil {
+		log.Error("Get password policy failed", log.String("tenantID", p.tenantID), log.Err(err))
+		return ident, false, err
+	}
+
+	now := time.Now()
+	clientIP, _ := identityprovider.ClientIPFrom(ctx)
+	if clientIP != "" {
+		if until, locked := loginIPLimiter.lockedUntil(p.tenantID, clientIP, now); locked {
+			audit.AddAuditAnnotation(ctx, lockoutAnnotationKey, "source ip locked until "+until.UTC().Format(time.RFC3339))
+			return ident, false, fmt.Errorf("too many failed logins from %s, try again after %s", clientIP, until.Format(time.RFC3339))
+		}
+	}
+	if now.Before(localIdentity.Status.LockedUntil.Time) {
+		audit.AddAuditAnnotation(ctx, lockoutAnnotationKey, "user locked until "+localIdentity.Status.LockedUntil.UTC().Format(time.RFC3339))
+		return ident, false, fmt.Errorf("too many failed logins, try again after %s", localIdentity.Status.LockedUntil.Format(time.RFC3339))
+	}
+
 	hashBytes, err := base64.StdEncoding.DecodeString(localIdentity.Spec.HashedPassword)
 	if err != nil {
 		log.Error("Parse hash password failed", log.String("hashedPassword", localIdentity.Spec.HashedPassword), log.Err(err))
 		return ident, false, nil
 	}
 	if err := bcrypt.CompareHashAndPassword(hashBytes, []byte(password)); err != nil {
-		log.Error("Invalid password", log.ByteString("input password", []byte(password)), log.ByteString("store password", hashBytes))
+		log.Error("Invalid password", log.String("username", username), log.String("clientIP", clientIP))
+		recordLoginFailure(ctx, authClient, policy, localIdentity.Name)
+		if clientIP != "" {
+			if until, locked := loginIPLimiter.fail(policy, p.tenantID, clientIP, now); locked {
+				audit.AddAuditAnnotation(ctx, lockoutAnnotationKey, "source ip locked until "+until.UTC().Format(time.RFC3339))
+				log.Warn("Source ip locked because of failed logins", log.String("tenantID", p.tenantID), log.String("clientIP", clientIP))
+			}
+		}
 		return ident, false, nil
 	}
 
+	if clientIP != "" {
+		loginIPLimiter.succeed(p.tenantID, clientIP)
+	}
+	resetLoginFailures(ctx, authClient, &localIdentity)
+
+	passwordUpdateTime := localIdentity.Status.PasswordUpdateTime.Time
+	if passwordUpdateTime.IsZero() {
+		passwordUpdateTime = localIdentity.CreationTimestamp.Time
+	}
+	if policy.Expired(passwordUpdateTime, now) {
+		return ident, false, fmt.Errorf("password expired, please contact the administrator to reset it")
+	}
+
 	extra := map[string]string{
 		oidc.TenantIDKey: localIdentity.Spec.TenantID,
 	}
@@ -0,0 +1,160 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/audit"
+	"k8s.io/client-go/util/retry"
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// lockoutAnnotationKey is the audit annotation recorded when a login is
+	// rejected or a lockout starts because of failed logins.
+	lockoutAnnotationKey = "authentication.auth.tke.com/lockout"
+
+	// ipRecordExpiry is the idle time after which the failures of a source
+	// IP are forgotten.
+	ipRecordExpiry = 24 * time.Hour
+)
+
+type ipRecord struct {
+	failures    int32
+	lastFailure time.Time
+	lockedUntil time.Time
+}
+
+// ipLimiter counts consecutive failed logins per tenant and source IP in
+// memory.
+type ipLimiter struct {
+	lock    sync.Mutex
+	records map[string]*ipRecord
+}
+
+var loginIPLimiter = &ipLimiter{records: map[string]*ipRecord{}}
+
+func ipLimiterKey(tenantID, ip string) string {
+	return tenantID + "/" + ip
+}
+
+// lockedUntil returns the end of the lockout of the source IP.
+func (l *ipLimiter) lockedUntil(tenantID, ip string, now time.Time) (time.Time, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	record, ok := l.records[ipLimiterKey(tenantID, ip)]
+	if !ok || !now.Before(record.lockedUntil) {
+		return time.Time{}, false
+	}
+	return record.lockedUntil, true
+}
+
+// fail records a failed login from the source IP and returns the end of the
+// lockout if one is started.
+func (l *ipLimiter) fail(policy *util.PasswordPolicy, tenantID, ip string, now time.Time) (time.Time, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.gc(now)
+	key := ipLimiterKey(tenantID, ip)
+	record, ok := l.records[key]
+	if !ok {
+		record = &ipRecord{}
+		l.records[key] = record
+	}
+	record.failures++
+	record.lastFailure = now
+	if d := policy.LockoutDurationFor(record.failures, policy.MaxLoginAttemptsPerIP); d > 0 {
+		record.lockedUntil = now.Add(d)
+		return record.lockedUntil, true
+	}
+	return time.Time{}, false
+}
+
+// succeed forgets the failures of the source IP.
+func (l *ipLimiter) succeed(tenantID, ip string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	delete(l.records, ipLimiterKey(tenantID, ip))
+}
+
+func (l *ipLimiter) gc(now time.Time) {
+	for key, record := range l.records {
+		if now.Sub(record.lastFailure) > ipRecordExpiry && !now.Before(record.lockedUntil) {
+			delete(l.records, key)
+		}
+	}
+}
+
+// recordLoginFailure increases the failed login counter of the local identity
+// and locks it temporarily once the policy limit is reached.
+func recordLoginFailure(ctx context.Context, authClient authinternalclient.AuthInterface, policy *util.PasswordPolicy, name string) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		localIdentity, err := authClient.LocalIdentities().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		now := metav1.Now()
+		localIdentity.Status.FailedLoginAttempts++
+		localIdentity.Status.LastFailedLoginTime = now
+		if d := policy.LockoutDurationFor(localIdentity.Status.FailedLoginAttempts, policy.MaxLoginAttempts); d > 0 {
+			localIdentity.Status.LockedUntil = metav1.NewTime(now.Add(d))
+			audit.AddAuditAnnotation(ctx, lockoutAnnotationKey, "user locked until "+localIdentity.Status.LockedUntil.UTC().Format(time.RFC3339))
+			log.Warn("Local identity locked because of failed logins",
+				log.String("name", name),
+				log.Int32("failures", localIdentity.Status.FailedLoginAttempts),
+				log.Time("lockedUntil", localIdentity.Status.LockedUntil.Time))
+		}
+		_, err = authClient.LocalIdentities().UpdateStatus(ctx, localIdentity, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		log.Error("Record failed login failed", log.String("name", name), log.Err(err))
+	}
+}
+
+// resetLoginFailures clears the failed login counter after a successful login.
+func resetLoginFailures(ctx context.Context, authClient authinternalclient.AuthInterface, localIdentity *auth.LocalIdentity) {
+	if localIdentity.Status.FailedLoginAttempts == 0 && localIdentity.Status.LockedUntil.IsZero() {
+		return
+	}
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := authClient.LocalIdentities().Get(ctx, localIdentity.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		current.Status.FailedLoginAttempts = 0
+		current.Status.LockedUntil = metav1.Time{}
+		_, err = authClient.LocalIdentities().UpdateStatus(ctx, current, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		log.Error("Reset failed logins failed", log.String("name", localIdentity.Name), log.Err(err))
+	}
+}
@@ -24,13 +24,14 @@ import (
 	"k8s.io/apiserver/pkg/authorization/union"
 	"k8s.io/apiserver/plugin/pkg/authorizer/webhook"
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	authv1informer "tkestack.io/tke/api/client/informers/externalversions/auth/v1"
 	"tkestack.io/tke/cmd/tke-auth-api/app/options"
 	"tkestack.io/tke/pkg/apiserver/authorization/abac"
 	"tkestack.io/tke/pkg/auth/authorization/local"
 )
 
 // NewAuthorizer creates a authorizer for subject access review and returns it.
-func NewAuthorizer(authClient authinternalclient.AuthInterface, authorizationOpts *options.AuthorizationOptions, authOpts *options.AuthOptions, enforcer *casbin.SyncedEnforcer, privilegedUsername string) (authorizer.Authorizer, error) {
+func NewAuthorizer(authClient authinternalclient.AuthInterface, tenantInformer authv1informer.TenantInformer, tenantUsageClients *local.TenantUsageClients, authorizationOpts *options.AuthorizationOptions, authOpts *options.AuthOptions, enforcer *casbin.SyncedEnforcer, privilegedUsername string) (authorizer.Authorizer, error) {
 	var (
 		authorizers []authorizer.Authorizer
 	)
@@ -56,7 +57,7 @@ func NewAuthorizer(authClient authinternalclient.AuthInterface, authorizationOpt
 		authorizers = append(authorizers, abacAuthorizer)
 	}
 
-	authorizers = append(authorizers, local.NewAuthorizer(authClient, enforcer, privilegedUsername))
+	authorizers = append(authorizers, local.NewAuthorizer(authClient, tenantInformer, tenantUsageClients, enforcer, privilegedUsername, authorizationOpts.DecisionCacheTTL))
 
 	return union.New(authorizers...), nil
 }
@@ -0,0 +1,89 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/apikey"
+	authutil "tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util"
+)
+
+// apiKeyScopeAllowed checks the request against the scope of the api key used
+// to authenticate it. The user permissions are checked afterwards, so that an
+// api key can only use the intersection of both.
+func apiKeyScopeAllowed(attr authorizer.Attributes, projectID string) (bool, string) {
+	extra := attr.GetUser().GetExtra()
+
+	if projects, ok := apikey.ScopeProjects(extra); ok {
+		target := projectID
+		if strings.HasPrefix(attr.GetResource(), "project:") && attr.GetName() != "" && attr.GetName() != "*" {
+			target = attr.GetName()
+		}
+		if !util.InStringSlice(projects, target) {
+			return false, fmt.Sprintf("api key is restricted to projects %v", projects)
+		}
+	}
+
+	statements, ok, err := apikey.ScopeStatements(extra)
+	if err != nil {
+		return false, fmt.Sprintf("invalid api key scope: %v", err)
+	}
+	if !ok {
+		return true, ""
+	}
+
+	allowed := false
+	for _, st := range statements {
+		if !statementMatches(st, attr.GetVerb(), attr.GetResource()) {
+			continue
+		}
+		if st.Effect == auth.Deny {
+			return false, fmt.Sprintf("api key scope denies %s on %s", attr.GetVerb(), attr.GetResource())
+		}
+		allowed = true
+	}
+	if !allowed {
+		return false, fmt.Sprintf("api key scope does not allow %s on %s", attr.GetVerb(), attr.GetResource())
+	}
+	return true, ""
+}
+
+func statementMatches(st auth.Statement, action, resource string) bool {
+	actionMatched := false
+	for _, act := range st.Actions {
+		if authutil.KeyMatchCustom(action, act) {
+			actionMatched = true
+			break
+		}
+	}
+	if !actionMatched {
+		return false
+	}
+	for _, res := range st.Resources {
+		if authutil.KeyMatchCustom(resource, res) {
+			return true
+		}
+	}
+	return false
+}
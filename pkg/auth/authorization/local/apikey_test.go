@@ -0,0 +1,140 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"strings"
+	"testing"
+
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/apikey"
+)
+
+func TestAPIKeyScopeAllowed(t *testing.T) {
+	tests := []struct {
+		name      string
+		scope     *auth.APIKeyScope
+		verb      string
+		resource  string
+		objName   string
+		projectID string
+		allowed   bool
+		reason    string
+	}{
+		{
+			name:     "no scope",
+			verb:     "deleteCluster",
+			resource: "cluster:c1",
+			allowed:  true,
+		},
+		{
+			name:      "project allowed",
+			scope:     &auth.APIKeyScope{Projects: []string{"p1"}},
+			verb:      "getNamespace",
+			resource:  "namespace:n1",
+			projectID: "p1",
+			allowed:   true,
+		},
+		{
+			name:      "project denied",
+			scope:     &auth.APIKeyScope{Projects: []string{"p1"}},
+			verb:      "getNamespace",
+			resource:  "namespace:n1",
+			projectID: "p2",
+			reason:    "restricted to projects",
+		},
+		{
+			name:     "project resource",
+			scope:    &auth.APIKeyScope{Projects: []string{"p1"}},
+			verb:     "getProject",
+			resource: "project:p2",
+			objName:  "p2",
+			reason:   "restricted to projects",
+		},
+		{
+			name: "allow statement",
+			scope: &auth.APIKeyScope{Statements: []auth.Statement{
+				{Actions: []string{"get*"}, Resources: []string{"cluster:*"}, Effect: auth.Allow},
+			}},
+			verb:     "getCluster",
+			resource: "cluster:c1",
+			allowed:  true,
+		},
+		{
+			name: "not allowed",
+			scope: &auth.APIKeyScope{Statements: []auth.Statement{
+				{Actions: []string{"get*"}, Resources: []string{"cluster:*"}, Effect: auth.Allow},
+			}},
+			verb:     "deleteCluster",
+			resource: "cluster:c1",
+			reason:   "does not allow",
+		},
+		{
+			name: "deny wins",
+			scope: &auth.APIKeyScope{Statements: []auth.Statement{
+				{Actions: []string{"*"}, Resources: []string{"*"}, Effect: auth.Allow},
+				{Actions: []string{"deleteCluster"}, Resources: []string{"cluster:c1"}, Effect: auth.Deny},
+			}},
+			verb:     "deleteCluster",
+			resource: "cluster:c1",
+			reason:   "denies",
+		},
+		{
+			name: "unreplaceable name",
+			scope: &auth.APIKeyScope{Statements: []auth.Statement{
+				{Actions: []string{"*"}, Resources: []string{"a/:", "x/:/y"}, Effect: auth.Allow},
+			}},
+			verb:     "getCluster",
+			resource: "cluster:c1",
+			reason:   "does not allow",
+		},
+		{
+			name: "invalid regular expression",
+			scope: &auth.APIKeyScope{Statements: []auth.Statement{
+				{Actions: []string{"("}, Resources: []string{"*"}, Effect: auth.Allow},
+			}},
+			verb:     "getCluster",
+			resource: "cluster:c1",
+			reason:   "does not allow",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			extra := map[string][]string{}
+			if err := apikey.SetScope(extra, "key", tt.scope); err != nil {
+				t.Fatal(err)
+			}
+			attr := authorizer.AttributesRecord{
+				User:     &user.DefaultInfo{Name: "alice", Extra: extra},
+				Verb:     tt.verb,
+				Resource: tt.resource,
+				Name:     tt.objName,
+			}
+			allowed, reason := apiKeyScopeAllowed(attr, tt.projectID)
+			if allowed != tt.allowed {
+				t.Fatalf("expected allowed %v, got %v (%s)", tt.allowed, allowed, reason)
+			}
+			if !strings.Contains(reason, tt.reason) {
+				t.Errorf("expected reason containing %q, got %q", tt.reason, reason)
+			}
+		})
+	}
+}
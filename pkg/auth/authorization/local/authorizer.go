@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	genericfilter "tkestack.io/tke/pkg/apiserver/filter"
 
@@ -31,7 +32,8 @@ import (
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
-	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	authv1informer "tkestack.io/tke/api/client/informers/externalversions/auth/v1"
+	authzutil "tkestack.io/tke/pkg/auth/authorization/util"
 	"tkestack.io/tke/pkg/auth/filter"
 	authutil "tkestack.io/tke/pkg/auth/util"
 	"tkestack.io/tke/pkg/util"
@@ -49,19 +51,38 @@ type Authorizer struct {
 
 	authClient authinternalclient.AuthInterface
 	enforcer   *casbin.SyncedEnforcer
+	// decisions caches the decisions of the enforcer, nil if disabled.
+	decisions *decisionCache
+
+	tenantInformer     authv1informer.TenantInformer
+	tenantUsageClients *TenantUsageClients
 }
 
-// NewAuthorizer creates a local repository authorizer and returns it.
-func NewAuthorizer(authClient authinternalclient.AuthInterface, enforcer *casbin.SyncedEnforcer, privilegedUsername string) *Authorizer {
+// NewAuthorizer creates a local repository authorizer and returns it. The
+// decisions of the enforcer are cached for decisionCacheTTL, zero disables
+// the cache. The tenants are read from the tenant informer, and their objects
+// are counted by the tenant usage clients.
+func NewAuthorizer(authClient authinternalclient.AuthInterface, tenantInformer authv1informer.TenantInformer, tenantUsageClients *TenantUsageClients, enforcer *casbin.SyncedEnforcer, privilegedUsername string, decisionCacheTTL time.Duration) *Authorizer {
+	if tenantInformer != nil {
+		// registers the informer before the informer factory is started.
+		tenantInformer.Informer()
+	}
 	return &Authorizer{
 		privilegedUsername: privilegedUsername,
 		authClient:         authClient,
 		enforcer:           enforcer,
+		decisions:          newDecisionCache(decisionCacheTTL),
+		tenantInformer:     tenantInformer,
+		tenantUsageClients: tenantUsageClients,
 	}
 }
 
 // Authorize to determine the subject access.
 func (a *Authorizer) Authorize(ctx context.Context, attr authorizer.Attributes) (authorized authorizer.Decision, reason string, err error) {
+	return a.authorize(ctx, attr, nil)
+}
+
+func (a *Authorizer) authorize(ctx context.Context, attr authorizer.Attributes, exp *explanation) (authorized authorizer.Decision, reason string, err error) {
 	subject := attr.GetUser().GetName()
 	action := attr.GetVerb()
 	resource := attr.GetResource()
@@ -72,40 +93,54 @@ func (a *Authorizer) Authorize(ctx context.Context, attr authorizer.Attributes)
 	)
 	extra := attr.GetUser().GetExtra()
 	if len(extra) > 0 {
-		if tenantIDs, ok := extra[genericoidc.TenantIDKey]; ok {
-			if len(tenantIDs) > 0 {
-				tenantID = tenantIDs[0]
-			}
-		}
-
 		if debugs, ok := extra[debugKey]; ok {
 			if len(debugs) > 0 && debugs[0] == "true" {
 				debug = true
 			}
 		}
 	}
-	if tenantID == "" {
-		tenantID = genericfilter.GetValueFromGroups(attr.GetUser().GetGroups(), "tenant")
-	}
+	tenantID = authzutil.TenantIDFrom(attr.GetUser())
 	projectID = genericfilter.GetValueFromGroups(attr.GetUser().GetGroups(), "project")
 	log.Debug("Authorize", log.String("subject", subject), log.String("action", action),
 		log.String("resource", resource), log.String("project", projectID), log.String("tenant", tenantID))
+	exp.setRequest(authutil.UserKey(tenantID, subject), tenantID, projectID, action, resource)
 
 	// First check if user is privileged
 	if subject == a.privilegedUsername {
 		log.Debug("privileged user", log.String("subject", subject))
+		exp.setStage(StagePrivileged)
 		return authorizer.DecisionAllow, "", nil
 	}
 
+	// Requests authenticated by a scoped api key never exceed the scope.
+	if allowed, reason := apiKeyScopeAllowed(attr, projectID); !allowed {
+		log.Debug("Denied by api key scope", log.String("subject", subject), log.String("reason", reason))
+		exp.setStage(StageAPIKeyScope)
+		return authorizer.DecisionDeny, reason, nil
+	}
+
+	// Suspended tenants and the quota of tenants restrict the tenant
+	// administrators as well.
+	if tenantID != "" {
+		allowed, reason, err := a.tenantAllowed(ctx, tenantID, attr.GetAPIGroup(), action)
+		if !allowed {
+			log.Debug("Denied by tenant", log.String("tenant", tenantID), log.String("reason", reason), log.Err(err))
+			exp.setStage(StageTenant)
+			return authorizer.DecisionDeny, reason, err
+		}
+	}
+
 	// Second check if user is a admin of the identity provider for tenant.
 	if tenantID != "" {
 		idp, err := a.authClient.IdentityProviders().Get(ctx, tenantID, metav1.GetOptions{})
 		if err != nil {
 			log.Error("Get identity provider for tenant failed", log.String("tenant", tenantID), log.Err(err))
+			exp.setStage(StageTenantAdministrator)
 			return authorizer.DecisionDeny, "", err
 		}
 
 		if util.InStringSlice(idp.Spec.Administrators, subject) {
+			exp.setStage(StageTenantAdministrator)
 			return authorizer.DecisionAllow, "", nil
 		}
 	}
@@ -113,6 +148,7 @@ func (a *Authorizer) Authorize(ctx context.Context, attr authorizer.Attributes)
 	authorized = filter.UnprotectedAuthorized(attr)
 	if authorized == authorizer.DecisionAllow {
 		log.Debug("UnprotectedAuthorized", log.String("action", action))
+		exp.setStage(StageUnprotected)
 		return authorizer.DecisionAllow, "", nil
 	}
 
@@ -128,6 +164,7 @@ func (a *Authorizer) Authorize(ctx context.Context, attr authorizer.Attributes)
 	}
 
 	if projectID != "" && attr.GetName() != "*" && resource == fmt.Sprintf("project:%s", attr.GetName()) && attr.GetName() != projectID {
+		exp.setStage(StageProject)
 		return authorizer.DecisionDeny, fmt.Sprintf("unmatched projectIDs: %v %v", attr.GetName(), projectID), nil
 	}
 
@@ -135,27 +172,95 @@ func (a *Authorizer) Authorize(ctx context.Context, attr authorizer.Attributes)
 		ns := genericfilter.GetValueFromGroups(attr.GetUser().GetGroups(), "namespace")
 		if ns != "" && ns != attr.GetNamespace() {
 			log.Errorf("want to access namespace '%s', but only allowed to access %s, attr: %v", attr.GetNamespace(), ns, attr)
+			exp.setStage(StageNamespace)
 			return authorizer.DecisionDeny, fmt.Sprintf("can NOT access namespace other than %v", ns), nil
 		}
 	}
 
-	allow, err := a.enforcer.Enforce(authutil.UserKey(tenantID, subject), projectID, resource, action)
+	// The conditions of the policies are evaluated by the enforcer on the
+	// policies it matches.
+	request := &enforceRequest{explain: exp != nil}
+	if request.explain || policyConditions.enabled() {
+		request.conditions = a.conditionContextFrom(ctx, attr, projectID)
+	}
+	subjects := []string{authutil.UserKey(tenantID, subject)}
+	if exp != nil {
+		for _, group := range exp.simulatedGroups {
+			subjects = append(subjects, authutil.GroupKey(tenantID, group))
+		}
+	}
+	allow, err := a.enforce(tenantID, subjects, projectID, resource, action, request, !debug && request.conditions == nil)
+	if exp != nil {
+		a.explainRules(ctx, exp, request, subjects, allow)
+	}
 	if err != nil {
 		log.Error("Casbin enforcer failed", log.Any("att", attr), log.String("projectID", projectID), log.String("subj", subject), log.String("act", action), log.String("res", resource), log.Err(err))
 		return authorizer.DecisionDeny, "", err
 	}
 	if !allow {
-		allowAll, err := a.enforcer.Enforce(authutil.UserKey(tenantID, authutil.DefaultAll), projectID, resource, action)
-		if err == nil && allowAll {
-			return authorizer.DecisionAllow, reason, nil
-		}
 		log.Info("Casbin enforcer: ", log.Any("att", attr), log.String("projectID", projectID), log.String("subj", subject), log.String("act", action), log.String("res", resource), log.String("allow", "false"))
 		if debug {
 			return authorizer.DecisionDeny, reason, nil
 		}
+		if failed := failedCondition(request.matched); failed != "" {
+			return authorizer.DecisionDeny, failed, nil
+		}
 		return authorizer.DecisionDeny, fmt.Sprintf("permission for %s on %s not verify", action, resource), nil
 	}
 	log.Debug("Casbin enforcer: ", log.Any("att", attr), log.String("projectID", projectID), log.String("subj", subject), log.String("act", action), log.String("res", resource), log.String("allow", "true"))
 
 	return authorizer.DecisionAllow, reason, nil
 }
+
+// enforce evaluates the rules of the subjects and of all users of the tenant
+// by the enforcer, the decisions are cached if useCache is true.
+func (a *Authorizer) enforce(tenantID string, subjects []string, projectID, resource, action string, request *enforceRequest, useCache bool) (bool, error) {
+	key := decisionKey{subject: strings.Join(subjects, ","), project: projectID, resource: resource, action: action}
+	if useCache {
+		if allowed, ok := a.decisions.get(key); ok {
+			return allowed, nil
+		}
+	}
+
+	generation := currentPolicyGeneration()
+	startTime := time.Now()
+	enforcing.Lock()
+	enforcing.request = request
+	allow, err := a.enforceSubjects(append(subjects, authutil.UserKey(tenantID, authutil.DefaultAll)), projectID, resource, action)
+	enforcing.request = nil
+	enforcing.Unlock()
+	if err != nil {
+		return false, err
+	}
+	policyEvaluationDuration.Observe(time.Since(startTime).Seconds())
+
+	if useCache {
+		a.decisions.set(key, allow, generation)
+	}
+	return allow, nil
+}
+
+// enforceSubjects returns true if any of the subjects is allowed. The rules of
+// all subjects are evaluated while explaining, so that all of them are
+// recorded.
+func (a *Authorizer) enforceSubjects(subjects []string, projectID, resource, action string) (bool, error) {
+	allow := false
+	for i, subject := range subjects {
+		allowed, err := a.enforcer.Enforce(subject, projectID, resource, action)
+		if err != nil {
+			// The rules of all users of the tenant are evaluated at
+			// last, an error of them is not an error of the subject.
+			if i < len(subjects)-1 {
+				return false, err
+			}
+			break
+		}
+		if allowed {
+			allow = true
+			if !enforcing.request.explain {
+				break
+			}
+		}
+	}
+	return allow, nil
+}
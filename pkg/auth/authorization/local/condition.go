@@ -0,0 +1,234 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/client-go/tools/cache"
+	"tkestack.io/tke/api/auth"
+	authv1 "tkestack.io/tke/api/auth/v1"
+	genericfilter "tkestack.io/tke/pkg/apiserver/filter"
+	authutil "tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// conditionFunctionName is the name of the matcher function evaluating the
+// conditions of the policies matched by the enforcer.
+const conditionFunctionName = "policyConditionsMet"
+
+// conditionCache holds the parsed conditions of the policies having any,
+// keyed by the policy name.
+type conditionCache struct {
+	lock       sync.RWMutex
+	conditions map[string]*authutil.PolicyConditions
+}
+
+var policyConditions = &conditionCache{conditions: map[string]*authutil.PolicyConditions{}}
+
+func (c *conditionCache) get(name string) *authutil.PolicyConditions {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.conditions[name]
+}
+
+// usesResourceLabels returns true if any policy has conditions on the labels
+// of the requested resource.
+func (c *conditionCache) usesResourceLabels() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	for _, conditions := range c.conditions {
+		if conditions.UsesResourceLabels() {
+			return true
+		}
+	}
+	return false
+}
+
+// enabled returns true if any policy has conditions.
+func (c *conditionCache) enabled() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return len(c.conditions) > 0
+}
+
+func (c *conditionCache) set(policy *authv1.Policy) {
+	conditions, err := authutil.ParsePolicyConditions(policy.Spec.Conditions)
+	if err != nil {
+		log.Warn("Invalid conditions of policy", log.String("policy", policy.Name), log.Err(err))
+		conditions = authutil.InvalidPolicyConditions(err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if conditions == nil {
+		delete(c.conditions, policy.Name)
+		return
+	}
+	c.conditions[policy.Name] = conditions
+}
+
+func (c *conditionCache) delete(name string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.conditions, name)
+}
+
+// conditionEventHandler keeps the condition cache in sync with the policies.
+func conditionEventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if policy, ok := obj.(*authv1.Policy); ok {
+				policyConditions.set(policy)
+			}
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			if policy, ok := obj.(*authv1.Policy); ok {
+				policyConditions.set(policy)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if policy, ok := obj.(*authv1.Policy); ok {
+				policyConditions.delete(policy.Name)
+			}
+		},
+	}
+}
+
+// enforceRequest holds the properties of the request being evaluated by the
+// enforcer, which are read by the condition function of the matcher.
+type enforceRequest struct {
+	conditions *authutil.ConditionContext
+	// explain evaluates the rules of all subjects if true, instead of
+	// stopping at the first allowed one.
+	explain bool
+	// matched are the policies matched by the enforcer.
+	matched []matchedPolicy
+}
+
+// matchedPolicy is a policy line matched by the enforcer for the subject.
+type matchedPolicy struct {
+	subject string
+	line    []string
+	rule    auth.MatchedRule
+}
+
+// enforcing is the request being evaluated. The synced enforcer evaluates one
+// request at a time anyway, so holding the lock across the evaluation does
+// not add any contention.
+var enforcing struct {
+	sync.Mutex
+	request *enforceRequest
+}
+
+// EnableConditions registers the condition function to the enforcer and
+// appends it to the matcher, so that the conditions are evaluated on exactly
+// the policies the enforcer matches, and a policy whose conditions are not met
+// takes no effect.
+func EnableConditions(enforcer *casbin.SyncedEnforcer) error {
+	m := enforcer.GetModel()
+	if _, ok := m["m"]["m"]; !ok {
+		return fmt.Errorf("casbin model has no matcher")
+	}
+	tokens := map[string]bool{}
+	for _, token := range append(m["r"]["r"].Tokens, m["p"]["p"].Tokens...) {
+		tokens[token] = true
+	}
+	for _, token := range []string{"r_sub", "p_sub", "p_dom", "p_obj", "p_act"} {
+		if !tokens[token] {
+			return fmt.Errorf("casbin model has no %s, policy conditions are unsupported", token)
+		}
+	}
+	effect := `"` + string(auth.Allow) + `"`
+	if tokens["p_eft"] {
+		effect = "p.eft"
+	}
+
+	enforcer.AddFunction(conditionFunctionName, conditionFunction)
+	// The condition function comes last so that it is only called once the
+	// rest of the matcher matches the policy.
+	m.AddDef("m", "m", fmt.Sprintf("(%s) && %s(r.sub, p.sub, p.dom, p.obj, p.act, %s)", m["m"]["m"].Value, conditionFunctionName, effect))
+	return nil
+}
+
+// conditionFunction is called by the enforcer with the subject of the request
+// and the policy line it matched.
+func conditionFunction(args ...interface{}) (interface{}, error) {
+	if len(args) != 6 {
+		return false, fmt.Errorf("%s expects 6 arguments, got %d", conditionFunctionName, len(args))
+	}
+	values := make([]string, len(args))
+	for i, arg := range args {
+		values[i], _ = arg.(string)
+	}
+	subject, name, effect := values[0], values[1], auth.Effect(values[5])
+
+	met, failed := true, ""
+	request := enforcing.request
+	if conditions := policyConditions.get(name); conditions != nil {
+		ctx := &authutil.ConditionContext{}
+		if request != nil && request.conditions != nil {
+			ctx = request.conditions
+		}
+		met, failed = conditions.Evaluate(effect, ctx)
+	}
+	if request != nil {
+		request.matched = append(request.matched, matchedPolicy{
+			subject: subject,
+			line:    values[1:],
+			rule: auth.MatchedRule{
+				Name:            name,
+				Resource:        values[3],
+				Action:          values[4],
+				Effect:          effect,
+				FailedCondition: failed,
+			},
+		})
+	}
+	return met, nil
+}
+
+// conditionContextFrom collects the properties of the request the conditions
+// are evaluated on. The labels of the requested resource are only read if
+// any policy has conditions on them.
+func (a *Authorizer) conditionContextFrom(ctx context.Context, attr authorizer.Attributes, projectID string) *authutil.ConditionContext {
+	groups := attr.GetUser().GetGroups()
+	conditionCtx := &authutil.ConditionContext{
+		Time:      time.Now(),
+		SourceIP:  net.ParseIP(genericfilter.GetValueFromGroups(groups, "sourceip")),
+		Project:   projectID,
+		Namespace: attr.GetNamespace(),
+	}
+	if conditionCtx.Namespace == "" {
+		conditionCtx.Namespace = genericfilter.GetValueFromGroups(groups, "namespace")
+	}
+	if policyConditions.usesResourceLabels() {
+		conditionCtx.ResourceLabels = a.resourceLabels(ctx, attr)
+	}
+	return conditionCtx
+}
@@ -0,0 +1,122 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+	authv1 "tkestack.io/tke/api/auth/v1"
+	authutil "tkestack.io/tke/pkg/auth/util"
+)
+
+func newConditionEnforcer(t *testing.T) *casbin.SyncedEnforcer {
+	m, err := model.NewModelFromString(auth.DefaultRuleModel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enforcer, err := casbin.NewSyncedEnforcer(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enforcer.SetRoleManager(authutil.NewRoleManager(10))
+	enforcer.AddFunction("keyMatchCustom", func(args ...interface{}) (interface{}, error) {
+		return authutil.KeyMatchCustom(args[0].(string), args[1].(string)), nil
+	})
+	if err := EnableConditions(enforcer); err != nil {
+		t.Fatal(err)
+	}
+	return enforcer
+}
+
+func setPolicyConditions(t *testing.T, name, conditions string) {
+	policyConditions.set(&authv1.Policy{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       authv1.PolicySpec{Conditions: []byte(conditions)},
+	})
+	t.Cleanup(func() { policyConditions.delete(name) })
+}
+
+func TestEnforceConditions(t *testing.T) {
+	enforcer := newConditionEnforcer(t)
+	user := authutil.UserKey("default", "alice")
+	_, _ = enforcer.AddPolicy("pol-office", authutil.DefaultDomain, "cluster:*", "get", "allow")
+	_, _ = enforcer.AddPolicy("pol-night", authutil.DefaultDomain, "cluster:*", "*", "deny")
+	_, _ = enforcer.AddGroupingPolicy(user, "pol-office", authutil.DefaultDomain)
+	_, _ = enforcer.AddGroupingPolicy(user, "pol-night", authutil.DefaultDomain)
+	setPolicyConditions(t, "pol-office", `{"sourceCIDRs":["10.0.0.0/8"]}`)
+	setPolicyConditions(t, "pol-night", `{"timeWindows":[{"start":"22:00","end":"06:00"}]}`)
+
+	a := &Authorizer{enforcer: enforcer}
+	noon := time.Date(2021, 1, 4, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		ip       string
+		time     time.Time
+		resource string
+		allow    bool
+		failed   bool
+	}{
+		{"conditions met", "10.1.2.3", noon, "cluster:cls-1", true, false},
+		{"source address not allowed", "192.168.0.1", noon, "cluster:cls-1", false, true},
+		{"source address unknown", "", noon, "cluster:cls-1", false, true},
+		{"deny statement takes effect", "10.1.2.3", noon.Add(11 * time.Hour), "cluster:cls-1", false, false},
+		{"policy not matched", "10.1.2.3", noon, "project:prj-1", false, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := &enforceRequest{conditions: &authutil.ConditionContext{Time: tc.time, SourceIP: net.ParseIP(tc.ip)}}
+			allow, err := a.enforce("default", []string{user}, "", tc.resource, "get", request, false)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if allow != tc.allow {
+				t.Errorf("allow = %v, want %v", allow, tc.allow)
+			}
+			if failed := failedCondition(request.matched) != ""; failed != tc.failed {
+				t.Errorf("failed condition = %v, want %v, matched %v", failed, tc.failed, request.matched)
+			}
+		})
+	}
+}
+
+func TestEnforceRecordsMatchedPolicies(t *testing.T) {
+	enforcer := newConditionEnforcer(t)
+	user := authutil.UserKey("default", "bob")
+	_, _ = enforcer.AddPolicy("pol-view", authutil.DefaultDomain, "cluster:*", "get", "allow")
+	_, _ = enforcer.AddPolicy("pol-edit", authutil.DefaultDomain, "cluster:*", "update", "allow")
+	_, _ = enforcer.AddPolicy("pol-other", authutil.DefaultDomain, "cluster:*", "get", "allow")
+	_, _ = enforcer.AddGroupingPolicy(user, "pol-view", authutil.DefaultDomain)
+	_, _ = enforcer.AddGroupingPolicy(user, "pol-edit", authutil.DefaultDomain)
+
+	a := &Authorizer{enforcer: enforcer}
+	request := &enforceRequest{explain: true, conditions: &authutil.ConditionContext{Time: time.Now()}}
+	allow, err := a.enforce("default", []string{user}, "", "cluster:cls-1", "get", request, false)
+	if err != nil || !allow {
+		t.Fatalf("expected allowed, got %v %v", allow, err)
+	}
+	if len(request.matched) != 1 || request.matched[0].rule.Name != "pol-view" || request.matched[0].subject != user {
+		t.Errorf("expected only pol-view matched for %s, got %v", user, request.matched)
+	}
+}
@@ -0,0 +1,174 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+const (
+	// maxCachedDecisions bounds the memory of the decision cache, the cache
+	// is emptied once it is reached.
+	maxCachedDecisions = 100000
+
+	hitLabel  = "hit"
+	missLabel = "miss"
+)
+
+var (
+	decisionCacheRequestsCounter = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "tke_auth_decision_cache_requests",
+			Help:           "Counter of authorization decision cache lookups broken out by result.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"result"},
+	)
+
+	policyEvaluationDuration = metrics.NewHistogram(
+		&metrics.HistogramOpts{
+			Name:           "tke_auth_policy_evaluation_duration_seconds",
+			Help:           "Latency of the casbin policy evaluation of the authorizer in seconds.",
+			Buckets:        metrics.ExponentialBuckets(0.0001, 2, 15),
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+)
+
+func init() {
+	legacyregistry.MustRegister(decisionCacheRequestsCounter)
+	legacyregistry.MustRegister(policyEvaluationDuration)
+}
+
+// policyGeneration is increased whenever the rules, policies or bindings
+// change, the decisions cached before are discarded then.
+var policyGeneration uint64
+
+func invalidateDecisions() {
+	atomic.AddUint64(&policyGeneration, 1)
+}
+
+func currentPolicyGeneration() uint64 {
+	return atomic.LoadUint64(&policyGeneration)
+}
+
+// decisionKey identifies a decision of the enforcer.
+type decisionKey struct {
+	subject  string
+	project  string
+	resource string
+	action   string
+}
+
+type decisionEntry struct {
+	allowed  bool
+	expireAt time.Time
+}
+
+// decisionCache holds the decisions of the enforcer for the ttl, or until the
+// policies change. A nil cache caches nothing.
+type decisionCache struct {
+	lock       sync.Mutex
+	ttl        time.Duration
+	generation uint64
+	entries    map[decisionKey]decisionEntry
+}
+
+func newDecisionCache(ttl time.Duration) *decisionCache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &decisionCache{
+		ttl:     ttl,
+		entries: map[decisionKey]decisionEntry{},
+	}
+}
+
+// reset empties the cache if the policies changed since it was filled, the
+// lock must be held.
+func (c *decisionCache) reset() {
+	generation := currentPolicyGeneration()
+	if c.generation != generation || len(c.entries) >= maxCachedDecisions {
+		c.entries = map[decisionKey]decisionEntry{}
+		c.generation = generation
+	}
+}
+
+func (c *decisionCache) get(key decisionKey) (allowed bool, ok bool) {
+	if c == nil {
+		return false, false
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.reset()
+	entry, ok := c.entries[key]
+	if ok && time.Now().After(entry.expireAt) {
+		delete(c.entries, key)
+		ok = false
+	}
+	if ok {
+		decisionCacheRequestsCounter.WithLabelValues(hitLabel).Inc()
+	} else {
+		decisionCacheRequestsCounter.WithLabelValues(missLabel).Inc()
+	}
+	return entry.allowed, ok
+}
+
+// set caches the decision evaluated under the given policy generation, it is
+// dropped if the policies changed during the evaluation.
+func (c *decisionCache) set(key decisionKey, allowed bool, generation uint64) {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.reset()
+	if c.generation != generation {
+		return
+	}
+	c.entries[key] = decisionEntry{allowed: allowed, expireAt: time.Now().Add(c.ttl)}
+}
+
+// invalidateEventHandler discards the cached decisions whenever the objects
+// the decisions depend on change.
+func invalidateEventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(interface{}) {
+			invalidateDecisions()
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldAccessor, err1 := meta.Accessor(oldObj)
+			newAccessor, err2 := meta.Accessor(newObj)
+			if err1 == nil && err2 == nil && oldAccessor.GetResourceVersion() == newAccessor.GetResourceVersion() {
+				return
+			}
+			invalidateDecisions()
+		},
+		DeleteFunc: func(interface{}) {
+			invalidateDecisions()
+		},
+	}
+}
@@ -0,0 +1,71 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDecisionCache(t *testing.T) {
+	key := decisionKey{subject: "alice", project: "p1", resource: "clusters", action: "list"}
+
+	c := newDecisionCache(time.Minute)
+	c.set(key, true, currentPolicyGeneration())
+	if allowed, ok := c.get(key); !ok || !allowed {
+		t.Fatalf("get() = %v, %v, want cached allow", allowed, ok)
+	}
+
+	invalidateDecisions()
+	if _, ok := c.get(key); ok {
+		t.Error("decision is cached after the policies changed")
+	}
+
+	// a decision evaluated under the previous policies is not cached.
+	generation := currentPolicyGeneration()
+	invalidateDecisions()
+	c.set(key, true, generation)
+	if _, ok := c.get(key); ok {
+		t.Error("decision evaluated under the previous policies is cached")
+	}
+}
+
+func TestDecisionCacheExpire(t *testing.T) {
+	key := decisionKey{subject: "alice", action: "list"}
+
+	c := newDecisionCache(time.Millisecond)
+	c.set(key, false, currentPolicyGeneration())
+	time.Sleep(10 * time.Millisecond)
+	if _, ok := c.get(key); ok {
+		t.Error("expired decision is cached")
+	}
+}
+
+func TestDecisionCacheDisabled(t *testing.T) {
+	key := decisionKey{subject: "alice", action: "list"}
+
+	c := newDecisionCache(0)
+	if c != nil {
+		t.Fatal("cache with zero ttl is not disabled")
+	}
+	c.set(key, true, currentPolicyGeneration())
+	if _, ok := c.get(key); ok {
+		t.Error("disabled cache returns a decision")
+	}
+}
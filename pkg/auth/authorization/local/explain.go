@@ -0,0 +1,184 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"tkestack.io/tke/api/auth"
+	authutil "tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Stages of the authorization recorded in the explanation.
+const (
+	StagePrivileged          = "Privileged"
+	StageAPIKeyScope         = "APIKeyScope"
+	StageTenant              = "Tenant"
+	StageTenantAdministrator = "TenantAdministrator"
+	StageUnprotected         = "Unprotected"
+	StageProject             = "Project"
+	StageNamespace           = "Namespace"
+	StageRule                = "Rule"
+	StageDefaultRule         = "DefaultRule"
+)
+
+// Kinds of the objects the matched rules belong to.
+const (
+	KindPolicy = "Policy"
+	KindRole   = "Role"
+)
+
+// explanation collects how the decision of a request is made.
+type explanation struct {
+	auth.AuthorizationExplanation
+
+	simulatedGroups []string
+}
+
+func (e *explanation) setStage(stage string) {
+	if e != nil {
+		e.Stage = stage
+	}
+}
+
+func (e *explanation) setRequest(subject, tenantID, projectID, action, resource string) {
+	if e != nil {
+		e.Subject = subject
+		e.Tenant = tenantID
+		e.Project = projectID
+		e.Action = action
+		e.Resource = resource
+	}
+}
+
+// Explain authorizes the request like Authorize does, and returns every rule
+// matched by the request with the policy or role it belongs to. The user is
+// considered a member of the simulated groups, so that the decision can be
+// checked before the membership is changed.
+func (a *Authorizer) Explain(ctx context.Context, attr authorizer.Attributes, simulatedGroups []string) (*auth.AuthorizationExplanation, string, error) {
+	exp := &explanation{simulatedGroups: simulatedGroups}
+	decision, reason, err := a.authorize(ctx, attr, exp)
+	switch decision {
+	case authorizer.DecisionAllow:
+		exp.Decision = "Allow"
+	case authorizer.DecisionDeny:
+		exp.Decision = "Deny"
+	default:
+		exp.Decision = "NoOpinion"
+	}
+	return &exp.AuthorizationExplanation, reason, err
+}
+
+// explainRules records the policies matched by the enforcer with the subjects
+// they are granted to.
+func (a *Authorizer) explainRules(ctx context.Context, exp *explanation, request *enforceRequest, subjects []string, allow bool) {
+	exp.Stage = StageRule
+
+	allowedBySubjects := false
+	for _, matched := range request.matched {
+		m := matched.rule
+		candidates := []string{matched.subject}
+		if util.InStringSlice(subjects, matched.subject) {
+			for _, role := range a.enforcer.GetRolesForUserInDomain(matched.subject, authutil.DefaultDomain) {
+				if strings.HasPrefix(role, authutil.GroupPrefix(exp.Tenant)) {
+					candidates = append(candidates, role)
+				}
+			}
+			if m.Effect == auth.Allow && m.FailedCondition == "" {
+				allowedBySubjects = true
+			}
+		}
+		m.Via, m.Project, _ = a.grantedVia(candidates, m.Name, exp.Project)
+		if m.Via == "" {
+			m.Via = matched.subject
+		}
+		a.describeRule(ctx, &m, matched.line)
+		exp.MatchedRules = append(exp.MatchedRules, m)
+	}
+	if allow && !allowedBySubjects {
+		exp.Stage = StageDefaultRule
+	}
+}
+
+// failedCondition returns the reason of the denial if an allow rule matched
+// by the enforcer takes no effect because of its conditions.
+func failedCondition(matched []matchedPolicy) string {
+	for _, m := range matched {
+		if m.rule.Effect == auth.Allow && m.rule.FailedCondition != "" {
+			return fmt.Sprintf("condition of policy %s not met, %s", m.rule.Name, m.rule.FailedCondition)
+		}
+	}
+	return ""
+}
+
+// grantedVia returns the candidate the casbin subject is granted to, and the
+// project it is granted in. Direct grants are preferred so that a rule bound
+// to a group is reported as such rather than through the user.
+func (a *Authorizer) grantedVia(candidates []string, sub, projectID string) (string, string, bool) {
+	for _, c := range candidates {
+		if projectID != "" && util.InStringSlice(a.enforcer.GetRolesForUserInDomain(c, projectID), sub) {
+			return c, projectID, true
+		}
+		if util.InStringSlice(a.enforcer.GetRolesForUserInDomain(c, authutil.DefaultDomain), sub) {
+			return c, "", true
+		}
+	}
+
+	rm := a.enforcer.GetRoleManager()
+	for _, c := range candidates {
+		if ok, _ := rm.HasLink(c, sub, authutil.DefaultDomain); ok {
+			return c, "", true
+		}
+		if projectID == "" {
+			continue
+		}
+		if ok, _ := rm.HasLink(c, sub, projectID); ok {
+			return c, projectID, true
+		}
+	}
+	return "", "", false
+}
+
+// describeRule fills in the objects the matched rule comes from.
+func (a *Authorizer) describeRule(ctx context.Context, m *auth.MatchedRule, rule []string) {
+	if _, err := a.authClient.Policies().Get(ctx, m.Name, metav1.GetOptions{}); err == nil {
+		m.Kind = KindPolicy
+		if m.Project != "" {
+			m.Binding = authutil.ProjectPolicyName(m.Project, m.Name)
+		}
+	} else if _, err := a.authClient.Roles().Get(ctx, m.Name, metav1.GetOptions{}); err == nil {
+		m.Kind = KindRole
+	}
+
+	selector := authutil.RuleFieldSelector(authutil.ConvertRule(authutil.PRule, rule))
+	rules, err := a.authClient.Rules().List(ctx, metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		log.Warn("List rules failed", log.String("selector", selector), log.Err(err))
+		return
+	}
+	if len(rules.Items) > 0 {
+		m.Rule = rules.Items[0].Name
+	}
+}
@@ -19,8 +19,12 @@
 package local
 
 import (
+	"reflect"
+	"strings"
+	"sync"
 	"time"
 
+	authv1 "tkestack.io/tke/api/auth/v1"
 	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	authv1informer "tkestack.io/tke/api/client/informers/externalversions/auth/v1"
@@ -28,6 +32,8 @@ import (
 	"tkestack.io/tke/pkg/util/log"
 
 	"github.com/casbin/casbin/v2"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/client-go/tools/cache"
 )
@@ -38,15 +44,38 @@ type adapterHookHandler struct {
 	enforcer       *casbin.SyncedEnforcer
 	ruleInformer   authv1informer.RuleInformer
 	reloadInterval time.Duration
+	rules          *ruleEventHandler
 }
 
 // NewAdapterHookHandler creates a new adapterHookHandler object.
 func NewAdapterHookHandler(authClient versionedclientset.Interface, enforcer *casbin.SyncedEnforcer, versionedInformers versionedinformers.SharedInformerFactory, reloadInterval time.Duration) genericapiserver.PostStartHookProvider {
+	// The policy informer is started with the shared informer factory, and
+	// keeps the conditions of the policies evaluated by the authorizer.
+	versionedInformers.Auth().V1().Policies().Informer().AddEventHandler(conditionEventHandler())
+
+	// The cached decisions are discarded whenever the policies or bindings
+	// change.
+	versionedInformers.Auth().V1().Policies().Informer().AddEventHandler(invalidateEventHandler())
+	versionedInformers.Auth().V1().ProjectPolicyBindings().Informer().AddEventHandler(invalidateEventHandler())
+	versionedInformers.Auth().V1().CustomPolicyBindings().Informer().AddEventHandler(invalidateEventHandler())
+
+	ruleInformer := versionedInformers.Auth().V1().Rules()
+	if err := ruleInformer.Informer().AddIndexers(cache.Indexers{util.RuleIndex: util.RuleIndexFunc}); err != nil {
+		log.Error("Failed to add the rule index", log.Err(err))
+	}
+	rules := &ruleEventHandler{
+		enforcer: enforcer,
+		indexer:  ruleInformer.Informer().GetIndexer(),
+		loaded:   map[types.UID]bool{},
+	}
+	ruleInformer.Informer().AddEventHandler(rules)
+
 	return &adapterHookHandler{
 		authClient:     authClient,
 		enforcer:       enforcer,
 		reloadInterval: reloadInterval,
-		ruleInformer:   versionedInformers.Auth().V1().Rules(),
+		ruleInformer:   ruleInformer,
+		rules:          rules,
 	}
 }
 
@@ -58,15 +87,139 @@ func (d *adapterHookHandler) PostStartHook() (string, genericapiserver.PostStart
 			log.Error("Failed to wait for project caches to sync")
 		}
 
-		adpt := util.NewAdapter(d.authClient.AuthV1().Rules(), d.ruleInformer.Lister())
+		adpt := util.NewIndexedAdapter(d.authClient.AuthV1().Rules(), d.ruleInformer)
 		d.enforcer.SetAdapter(adpt)
 
 		rm := util.NewRoleManager(10)
 		d.enforcer.SetRoleManager(rm)
-		_ = d.enforcer.LoadPolicy()
+		_ = d.rules.loadPolicy()
 
-		d.enforcer.StartAutoLoadPolicy(d.reloadInterval)
+		// The rules are updated incrementally by the rule informer, the whole
+		// policy is only reloaded periodically in case of any drift.
+		if d.reloadInterval > 0 {
+			go wait.Until(d.rules.reloadPolicy, d.reloadInterval, context.StopCh)
+		}
 		log.Info("finish start create casbin server")
 		return nil
 	}, nil
 }
+
+// ruleEventHandler applies the changes of the rules to the enforcer one by
+// one, instead of reloading all the rules.
+type ruleEventHandler struct {
+	enforcer *casbin.SyncedEnforcer
+	indexer  cache.Indexer
+
+	lock sync.Mutex
+	// loaded holds the rules loaded by loadPolicy whose add events are not
+	// received yet.
+	loaded map[types.UID]bool
+}
+
+var _ cache.ResourceEventHandler = &ruleEventHandler{}
+
+// loadPolicy loads all the rules into the enforcer, the add events of them
+// are skipped afterwards.
+func (h *ruleEventHandler) loadPolicy() error {
+	h.lock.Lock()
+	for _, obj := range h.indexer.List() {
+		if rule, ok := obj.(*authv1.Rule); ok {
+			h.loaded[rule.UID] = true
+		}
+	}
+	h.lock.Unlock()
+
+	defer invalidateDecisions()
+	return h.enforcer.LoadPolicy()
+}
+
+// reloadPolicy reloads all the rules into the enforcer, and discards the
+// cached decisions made with the previous policy.
+func (h *ruleEventHandler) reloadPolicy() {
+	defer invalidateDecisions()
+	if err := h.enforcer.LoadPolicy(); err != nil {
+		log.Error("Failed to reload casbin policy", log.Err(err))
+	}
+}
+
+func (h *ruleEventHandler) OnAdd(obj interface{}) {
+	rule, ok := obj.(*authv1.Rule)
+	if !ok {
+		return
+	}
+
+	h.lock.Lock()
+	loaded := h.loaded[rule.UID]
+	delete(h.loaded, rule.UID)
+	h.lock.Unlock()
+	if loaded {
+		return
+	}
+
+	h.addRule(rule)
+}
+
+func (h *ruleEventHandler) OnUpdate(oldObj, newObj interface{}) {
+	oldRule, ok1 := oldObj.(*authv1.Rule)
+	newRule, ok2 := newObj.(*authv1.Rule)
+	if !ok1 || !ok2 || reflect.DeepEqual(oldRule.Spec, newRule.Spec) {
+		return
+	}
+	h.removeRule(oldRule)
+	h.addRule(newRule)
+}
+
+func (h *ruleEventHandler) OnDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	rule, ok := obj.(*authv1.Rule)
+	if !ok {
+		return
+	}
+
+	h.lock.Lock()
+	delete(h.loaded, rule.UID)
+	h.lock.Unlock()
+
+	h.removeRule(rule)
+}
+
+// addRule adds the policy line of the rule to the enforcer, the adapter does
+// not store it again since the rule is stored already.
+func (h *ruleEventHandler) addRule(rule *authv1.Rule) {
+	ptype, line := rule.Spec.PType, util.RuleLine(rule)
+	var err error
+	if strings.HasPrefix(ptype, util.GRule) {
+		if !h.enforcer.HasNamedGroupingPolicy(ptype, line) {
+			_, err = h.enforcer.AddNamedGroupingPolicy(ptype, line)
+		}
+	} else if !h.enforcer.HasNamedPolicy(ptype, line) {
+		_, err = h.enforcer.AddNamedPolicy(ptype, line)
+	}
+	if err != nil {
+		log.Error("Failed to add rule to casbin enforcer", log.String("rule", rule.Name), log.Err(err))
+	}
+	invalidateDecisions()
+}
+
+// removeRule removes the policy line of the rule from the enforcer, unless
+// another stored rule has the same policy line.
+func (h *ruleEventHandler) removeRule(rule *authv1.Rule) {
+	ptype, line := rule.Spec.PType, util.RuleLine(rule)
+	if rules, err := h.indexer.ByIndex(util.RuleIndex, util.RuleKey(ptype, line)); err == nil && len(rules) > 0 {
+		return
+	}
+	var err error
+	if strings.HasPrefix(ptype, util.GRule) {
+		if h.enforcer.HasNamedGroupingPolicy(ptype, line) {
+			_, err = h.enforcer.RemoveNamedGroupingPolicy(ptype, line)
+		}
+	} else if h.enforcer.HasNamedPolicy(ptype, line) {
+		_, err = h.enforcer.RemoveNamedPolicy(ptype, line)
+	}
+	if err != nil {
+		log.Error("Failed to remove rule from casbin enforcer", log.String("rule", rule.Name), log.Err(err))
+	}
+	invalidateDecisions()
+}
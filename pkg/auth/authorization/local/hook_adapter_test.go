@@ -0,0 +1,131 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	authv1 "tkestack.io/tke/api/auth/v1"
+	versionedfake "tkestack.io/tke/api/client/clientset/versioned/fake"
+	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
+	"tkestack.io/tke/pkg/auth/util"
+)
+
+func newRule(name string, line ...string) *authv1.Rule {
+	rule := &authv1.Rule{
+		ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(name)},
+		Spec:       authv1.RuleSpec{PType: util.PRule},
+	}
+	fields := []*string{&rule.Spec.V0, &rule.Spec.V1, &rule.Spec.V2, &rule.Spec.V3, &rule.Spec.V4}
+	for i := range line {
+		*fields[i] = line[i]
+	}
+	return rule
+}
+
+func newRuleEventHandler(t *testing.T) *ruleEventHandler {
+	client := versionedfake.NewSimpleClientset()
+	ruleInformer := versionedinformers.NewSharedInformerFactory(client, 0).Auth().V1().Rules()
+	if err := ruleInformer.Informer().AddIndexers(cache.Indexers{util.RuleIndex: util.RuleIndexFunc}); err != nil {
+		t.Fatal(err)
+	}
+	enforcer := newConditionEnforcer(t)
+	enforcer.SetAdapter(util.NewIndexedAdapter(client.AuthV1().Rules(), ruleInformer))
+	return &ruleEventHandler{
+		enforcer: enforcer,
+		indexer:  ruleInformer.Informer().GetIndexer(),
+		loaded:   map[types.UID]bool{},
+	}
+}
+
+func TestRuleEventHandler(t *testing.T) {
+	h := newRuleEventHandler(t)
+	rule := newRule("r1", "alice", "*", "clusters", "list", "allow")
+	line := util.RuleLine(rule)
+
+	generation := currentPolicyGeneration()
+	_ = h.indexer.Add(rule)
+	h.OnAdd(rule)
+	if !h.enforcer.HasNamedPolicy(util.PRule, line) {
+		t.Fatal("added rule is not in the enforcer")
+	}
+	if currentPolicyGeneration() == generation {
+		t.Error("cached decisions are not invalidated on add")
+	}
+
+	updated := newRule("r1", "alice", "*", "clusters", "get", "allow")
+	_ = h.indexer.Update(updated)
+	h.OnUpdate(rule, updated)
+	if h.enforcer.HasNamedPolicy(util.PRule, line) || !h.enforcer.HasNamedPolicy(util.PRule, util.RuleLine(updated)) {
+		t.Error("updated rule is not replaced in the enforcer")
+	}
+
+	// the policy line is kept while another rule has the same line.
+	duplicate := newRule("r2", "alice", "*", "clusters", "get", "allow")
+	_ = h.indexer.Add(duplicate)
+	h.OnAdd(duplicate)
+	_ = h.indexer.Delete(updated)
+	h.OnDelete(cache.DeletedFinalStateUnknown{Key: "r1", Obj: updated})
+	if !h.enforcer.HasNamedPolicy(util.PRule, util.RuleLine(updated)) {
+		t.Error("policy line of a stored rule is removed")
+	}
+
+	generation = currentPolicyGeneration()
+	_ = h.indexer.Delete(duplicate)
+	h.OnDelete(duplicate)
+	if h.enforcer.HasNamedPolicy(util.PRule, util.RuleLine(duplicate)) {
+		t.Error("deleted rule is still in the enforcer")
+	}
+	if currentPolicyGeneration() == generation {
+		t.Error("cached decisions are not invalidated on delete")
+	}
+}
+
+func TestRuleEventHandlerLoadPolicy(t *testing.T) {
+	h := newRuleEventHandler(t)
+	rule := newRule("r1", "alice", "*", "clusters", "list", "allow")
+	_ = h.indexer.Add(rule)
+
+	if err := h.loadPolicy(); err != nil {
+		t.Fatal(err)
+	}
+	if !h.enforcer.HasNamedPolicy(util.PRule, util.RuleLine(rule)) {
+		t.Fatal("loaded rule is not in the enforcer")
+	}
+
+	// the add event of a loaded rule is skipped, a removed policy line is
+	// not added back.
+	_, _ = h.enforcer.RemoveNamedPolicy(util.PRule, util.RuleLine(rule))
+	h.OnAdd(rule)
+	if h.enforcer.HasNamedPolicy(util.PRule, util.RuleLine(rule)) {
+		t.Error("add event of a loaded rule is not skipped")
+	}
+
+	generation := currentPolicyGeneration()
+	h.reloadPolicy()
+	if !h.enforcer.HasNamedPolicy(util.PRule, util.RuleLine(rule)) {
+		t.Error("reloaded rule is not in the enforcer")
+	}
+	if currentPolicyGeneration() == generation {
+		t.Error("cached decisions are not invalidated on reload")
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"tkestack.io/tke/api/business"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/api/registry"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// labelGetter reads the labels of a stored object. The returned bool is false
+// if the api server storing the object is not configured.
+type labelGetter func(ctx context.Context, c *TenantUsageClients, namespace, name string) (map[string]string, bool, error)
+
+// resourceLabelGetters are the objects whose labels can be checked by the
+// policy conditions, keyed by the api group and the resource type.
+var resourceLabelGetters = map[string]map[string]labelGetter{
+	platform.GroupName: {
+		"cluster": func(ctx context.Context, c *TenantUsageClients, _, name string) (map[string]string, bool, error) {
+			if c.Platform == nil {
+				return nil, false, nil
+			}
+			obj, err := c.Platform.Clusters().Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return nil, true, err
+			}
+			return obj.Labels, true, nil
+		},
+	},
+	business.GroupName: {
+		"project": func(ctx context.Context, c *TenantUsageClients, _, name string) (map[string]string, bool, error) {
+			if c.Business == nil {
+				return nil, false, nil
+			}
+			obj, err := c.Business.Projects().Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return nil, true, err
+			}
+			return obj.Labels, true, nil
+		},
+		"namespace": func(ctx context.Context, c *TenantUsageClients, namespace, name string) (map[string]string, bool, error) {
+			if c.Business == nil || namespace == "" {
+				return nil, false, nil
+			}
+			obj, err := c.Business.Namespaces(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return nil, true, err
+			}
+			return obj.Labels, true, nil
+		},
+	},
+	registry.GroupName: {
+		"registrynamespace": func(ctx context.Context, c *TenantUsageClients, _, name string) (map[string]string, bool, error) {
+			if c.Registry == nil {
+				return nil, false, nil
+			}
+			obj, err := c.Registry.Namespaces().Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return nil, true, err
+			}
+			return obj.Labels, true, nil
+		},
+	},
+}
+
+// resourceLabels returns the labels of the stored object of the requested
+// resource, or nil if they are unknown. The labels are never taken from the
+// request, so that the caller cannot claim them.
+func (a *Authorizer) resourceLabels(ctx context.Context, attr authorizer.Attributes) map[string]string {
+	if a.tenantUsageClients == nil || attr.GetName() == "" || attr.GetName() == "*" {
+		return nil
+	}
+	// The requested object is the last part of the resource, such as
+	// cluster:cls-1 of cluster:cls-1/namespace:default.
+	resource := attr.GetResource()
+	if i := strings.LastIndex(resource, "/"); i >= 0 {
+		resource = resource[i+1:]
+	}
+	kv := strings.SplitN(resource, ":", 2)
+	if len(kv) != 2 || kv[1] != attr.GetName() {
+		return nil
+	}
+	get, ok := resourceLabelGetters[attr.GetAPIGroup()][kv[0]]
+	if !ok {
+		return nil
+	}
+	labels, ok, err := get(ctx, a.tenantUsageClients, attr.GetNamespace(), attr.GetName())
+	if err != nil {
+		log.Warn("Failed to get the labels of the resource", log.String("resource", attr.GetResource()), log.Err(err))
+		return nil
+	}
+	if !ok {
+		return nil
+	}
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	return labels
+}
@@ -0,0 +1,123 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	businessv1 "tkestack.io/tke/api/business/v1"
+	versionedfake "tkestack.io/tke/api/client/clientset/versioned/fake"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	authutil "tkestack.io/tke/pkg/auth/util"
+)
+
+func TestResourceLabelConditions(t *testing.T) {
+	enforcer := newConditionEnforcer(t)
+	alice := authutil.UserKey("default", "alice")
+	_, _ = enforcer.AddPolicy("pol-prod", authutil.DefaultDomain, "cluster:*", "getCluster", "allow")
+	_, _ = enforcer.AddGroupingPolicy(alice, "pol-prod", authutil.DefaultDomain)
+	setPolicyConditions(t, "pol-prod", `{"resourceLabels":{"env":"prod"}}`)
+
+	clientset := versionedfake.NewSimpleClientset(
+		&platformv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cls-prod", Labels: map[string]string{"env": "prod"}}},
+		&platformv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "cls-dev", Labels: map[string]string{"env": "dev"}}},
+	)
+	a := &Authorizer{
+		enforcer:           enforcer,
+		tenantUsageClients: &TenantUsageClients{Platform: clientset.PlatformV1()},
+	}
+	testCases := []struct {
+		name    string
+		cluster string
+		labels  map[string]string
+		allow   bool
+		failed  bool
+	}{
+		{name: "labels match", cluster: "cls-prod", labels: map[string]string{"env": "prod"}, allow: true},
+		{name: "labels differ", cluster: "cls-dev", labels: map[string]string{"env": "dev"}, failed: true},
+		{name: "cluster not found", cluster: "cls-none", failed: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			attr := authorizer.AttributesRecord{
+				// labels supplied by the caller are ignored
+				User:     &user.DefaultInfo{Name: "alice", Extra: map[string][]string{"authorization.auth.tke.com/resourceLabels": {"env=prod"}}},
+				Verb:     "getCluster",
+				APIGroup: platformv1.GroupName,
+				Resource: "cluster:" + tc.cluster,
+				Name:     tc.cluster,
+			}
+			conditions := a.conditionContextFrom(context.Background(), attr, "")
+			if len(conditions.ResourceLabels) != len(tc.labels) || conditions.ResourceLabels["env"] != tc.labels["env"] {
+				t.Errorf("expected labels %v, got %v", tc.labels, conditions.ResourceLabels)
+			}
+
+			request := &enforceRequest{conditions: conditions}
+			allow, err := a.enforce("default", []string{alice}, "", attr.Resource, attr.Verb, request, false)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if allow != tc.allow {
+				t.Errorf("allow = %v, want %v", allow, tc.allow)
+			}
+			if failed := failedCondition(request.matched) != ""; failed != tc.failed {
+				t.Errorf("failed condition = %v, want %v, matched %v", failed, tc.failed, request.matched)
+			}
+		})
+	}
+}
+
+func TestResourceLabels(t *testing.T) {
+	clientset := versionedfake.NewSimpleClientset(
+		&businessv1.Project{ObjectMeta: metav1.ObjectMeta{Name: "prj-1", Labels: map[string]string{"env": "prod"}}},
+		&businessv1.Namespace{ObjectMeta: metav1.ObjectMeta{Namespace: "prj-1", Name: "ns-1"}},
+	)
+	a := &Authorizer{tenantUsageClients: &TenantUsageClients{Business: clientset.BusinessV1()}}
+	testCases := []struct {
+		name      string
+		apiGroup  string
+		resource  string
+		namespace string
+		objName   string
+		labels    map[string]string
+	}{
+		{name: "project", apiGroup: businessv1.GroupName, resource: "project:prj-1", objName: "prj-1", labels: map[string]string{"env": "prod"}},
+		{name: "namespace without labels", apiGroup: businessv1.GroupName, resource: "project:prj-1/namespace:ns-1", namespace: "prj-1", objName: "ns-1", labels: map[string]string{}},
+		{name: "all projects", apiGroup: businessv1.GroupName, resource: "project:*", objName: "*"},
+		{name: "subresource", apiGroup: businessv1.GroupName, resource: "project:prj-1/user:*", objName: "*"},
+		{name: "api server not configured", apiGroup: platformv1.GroupName, resource: "cluster:cls-1", objName: "cls-1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			labels := a.resourceLabels(context.Background(), authorizer.AttributesRecord{
+				APIGroup:  tc.apiGroup,
+				Resource:  tc.resource,
+				Namespace: tc.namespace,
+				Name:      tc.objName,
+			})
+			if (labels == nil) != (tc.labels == nil) || len(labels) != len(tc.labels) || labels["env"] != tc.labels["env"] {
+				t.Errorf("expected labels %v, got %v", tc.labels, labels)
+			}
+		})
+	}
+}
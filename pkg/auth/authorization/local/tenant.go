@@ -0,0 +1,190 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the “License”); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an “AS IS” BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"tkestack.io/tke/api/application"
+	authv1 "tkestack.io/tke/api/auth/v1"
+	"tkestack.io/tke/api/business"
+	applicationv1 "tkestack.io/tke/api/client/clientset/versioned/typed/application/v1"
+	businessv1 "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	platformv1 "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	registryv1 "tkestack.io/tke/api/client/clientset/versioned/typed/registry/v1"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/api/registry"
+)
+
+// TenantUsageClients are the clients of the api servers storing the objects
+// limited by the tenant quota. The objects of a group without client are
+// counted by the usage in the tenant status, which is refreshed periodically.
+// The labels of the objects checked by the policy conditions are read with
+// the same clients.
+type TenantUsageClients struct {
+	Business    businessv1.BusinessV1Interface
+	Platform    platformv1.PlatformV1Interface
+	Registry    registryv1.RegistryV1Interface
+	Application applicationv1.ApplicationV1Interface
+}
+
+// tenantQuota is an object count limited by the tenant quota, keyed by the
+// api group and the create action of the object.
+type tenantQuota struct {
+	name  string
+	limit func(quota *authv1.TenantQuota) *int32
+	used  func(usage *authv1.TenantUsage) int32
+	// count counts the objects of the tenant, it returns false if the group
+	// has no client.
+	count func(ctx context.Context, clients *TenantUsageClients, options metav1.ListOptions) (int32, bool, error)
+}
+
+var tenantQuotas = map[string]map[string]tenantQuota{
+	platform.GroupName: {
+		"createCluster": {
+			name:  "clusters",
+			limit: func(q *authv1.TenantQuota) *int32 { return q.Clusters },
+			used:  func(u *authv1.TenantUsage) int32 { return u.Clusters },
+			count: func(ctx context.Context, c *TenantUsageClients, options metav1.ListOptions) (int32, bool, error) {
+				if c.Platform == nil {
+					return 0, false, nil
+				}
+				list, err := c.Platform.Clusters().List(ctx, options)
+				if err != nil {
+					return 0, true, err
+				}
+				return int32(len(list.Items)), true, nil
+			},
+		},
+	},
+	business.GroupName: {
+		"createProject": {
+			name:  "projects",
+			limit: func(q *authv1.TenantQuota) *int32 { return q.Projects },
+			used:  func(u *authv1.TenantUsage) int32 { return u.Projects },
+			count: func(ctx context.Context, c *TenantUsageClients, options metav1.ListOptions) (int32, bool, error) {
+				if c.Business == nil {
+					return 0, false, nil
+				}
+				list, err := c.Business.Projects().List(ctx, options)
+				if err != nil {
+					return 0, true, err
+				}
+				return int32(len(list.Items)), true, nil
+			},
+		},
+	},
+	registry.GroupName: {
+		"createRegistrynamespace": {
+			name:  "registries",
+			limit: func(q *authv1.TenantQuota) *int32 { return q.Registries },
+			used:  func(u *authv1.TenantUsage) int32 { return u.Registries },
+			count: func(ctx context.Context, c *TenantUsageClients, options metav1.ListOptions) (int32, bool, error) {
+				if c.Registry == nil {
+					return 0, false, nil
+				}
+				list, err := c.Registry.Namespaces().List(ctx, options)
+				if err != nil {
+					return 0, true, err
+				}
+				return int32(len(list.Items)), true, nil
+			},
+		},
+	},
+	application.GroupName: {
+		"createApp": {
+			name:  "apps",
+			limit: func(q *authv1.TenantQuota) *int32 { return q.Apps },
+			used:  func(u *authv1.TenantUsage) int32 { return u.Apps },
+			count: func(ctx context.Context, c *TenantUsageClients, options metav1.ListOptions) (int32, bool, error) {
+				if c.Application == nil {
+					return 0, false, nil
+				}
+				list, err := c.Application.Apps(metav1.NamespaceAll).List(ctx, options)
+				if err != nil {
+					return 0, true, err
+				}
+				return int32(len(list.Items)), true, nil
+			},
+		},
+	},
+}
+
+// tenantAllowed denies the requests of suspended tenants, and the creations
+// exceeding the quota of the tenant. Tenants without the tenant object are
+// not restricted. The objects of the tenant are counted when the creation is
+// authorized, unless the api server storing them is not configured.
+func (a *Authorizer) tenantAllowed(ctx context.Context, tenantID, apiGroup, action string) (bool, string, error) {
+	tenant, err := a.getTenant(ctx, tenantID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return true, "", nil
+		}
+		return false, "", err
+	}
+
+	if tenant.Spec.Suspended {
+		return false, fmt.Sprintf("tenant %s is suspended", tenantID), nil
+	}
+
+	quota, ok := tenantQuotas[apiGroup][action]
+	if !ok {
+		return true, "", nil
+	}
+	limit := quota.limit(&tenant.Spec.Quota)
+	if limit == nil {
+		return true, "", nil
+	}
+	used := quota.used(&tenant.Status.Used)
+	if a.tenantUsageClients != nil {
+		options := metav1.ListOptions{FieldSelector: fields.OneTermEqualSelector("spec.tenantID", tenantID).String()}
+		count, counted, err := quota.count(ctx, a.tenantUsageClients, options)
+		if err != nil {
+			return false, fmt.Sprintf("failed to count %s of tenant %s", quota.name, tenantID), err
+		}
+		if counted {
+			used = count
+		}
+	}
+	if used >= *limit {
+		return false, fmt.Sprintf("quota of %s of tenant %s exceeded, used %d, limit %d", quota.name, tenantID, used, *limit), nil
+	}
+	return true, "", nil
+}
+
+// getTenant returns the tenant from the informer cache, the tenant is got
+// from the api server until the cache is synced.
+func (a *Authorizer) getTenant(ctx context.Context, tenantID string) (*authv1.Tenant, error) {
+	if a.tenantInformer != nil && a.tenantInformer.Informer().HasSynced() {
+		return a.tenantInformer.Lister().Get(tenantID)
+	}
+	tenant, err := a.authClient.Tenants().Get(ctx, tenantID, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	out := &authv1.Tenant{}
+	if err := authv1.Convert_auth_Tenant_To_v1_Tenant(tenant, out, nil); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,126 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package local
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/api/business"
+	businessv1 "tkestack.io/tke/api/business/v1"
+	internalfake "tkestack.io/tke/api/client/clientset/internalversion/fake"
+	versionedfake "tkestack.io/tke/api/client/clientset/versioned/fake"
+	"tkestack.io/tke/api/platform"
+)
+
+func newTenantAuthorizer(tenant *auth.Tenant, projects ...string) *Authorizer {
+	var objects []runtime.Object
+	for _, name := range projects {
+		objects = append(objects, &businessv1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       businessv1.ProjectSpec{TenantID: tenant.Name},
+		})
+	}
+	return &Authorizer{
+		authClient: internalfake.NewSimpleClientset(tenant).Auth(),
+		tenantUsageClients: &TenantUsageClients{
+			Business: versionedfake.NewSimpleClientset(objects...).BusinessV1(),
+		},
+	}
+}
+
+func TestTenantAllowed(t *testing.T) {
+	limit := int32(2)
+	tests := []struct {
+		name     string
+		tenant   *auth.Tenant
+		projects []string
+		apiGroup string
+		action   string
+		allowed  bool
+		reason   string
+	}{
+		{
+			name:     "suspended",
+			tenant:   &auth.Tenant{ObjectMeta: metav1.ObjectMeta{Name: "t1"}, Spec: auth.TenantSpec{Suspended: true}},
+			apiGroup: business.GroupName,
+			action:   "getProject",
+			reason:   "suspended",
+		},
+		{
+			name: "below quota",
+			tenant: &auth.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "t1"},
+				Spec:       auth.TenantSpec{Quota: auth.TenantQuota{Projects: &limit}},
+			},
+			projects: []string{"p1"},
+			apiGroup: business.GroupName,
+			action:   "createProject",
+			allowed:  true,
+		},
+		{
+			name: "quota counted at admission",
+			tenant: &auth.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "t1"},
+				Spec:       auth.TenantSpec{Quota: auth.TenantQuota{Projects: &limit}},
+				// the usage in the status is stale.
+				Status: auth.TenantStatus{Used: auth.TenantUsage{Projects: 0}},
+			},
+			projects: []string{"p1", "p2"},
+			apiGroup: business.GroupName,
+			action:   "createProject",
+			reason:   "quota of projects",
+		},
+		{
+			name: "usage of group without client from status",
+			tenant: &auth.Tenant{
+				ObjectMeta: metav1.ObjectMeta{Name: "t1"},
+				Spec:       auth.TenantSpec{Quota: auth.TenantQuota{Clusters: &limit}},
+				Status:     auth.TenantStatus{Used: auth.TenantUsage{Clusters: 2}},
+			},
+			apiGroup: platform.GroupName,
+			action:   "createCluster",
+			reason:   "quota of clusters",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newTenantAuthorizer(tt.tenant, tt.projects...)
+			allowed, reason, err := a.tenantAllowed(context.Background(), "t1", tt.apiGroup, tt.action)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if allowed != tt.allowed || !strings.Contains(reason, tt.reason) {
+				t.Errorf("tenantAllowed() = %v, %q, want %v, %q", allowed, reason, tt.allowed, tt.reason)
+			}
+		})
+	}
+}
+
+func TestTenantAllowedWithoutTenant(t *testing.T) {
+	a := &Authorizer{authClient: internalfake.NewSimpleClientset().Auth()}
+	allowed, _, err := a.tenantAllowed(context.Background(), "t1", business.GroupName, "createProject")
+	if err != nil || !allowed {
+		t.Errorf("tenantAllowed() = %v, %v, want allowed", allowed, err)
+	}
+}
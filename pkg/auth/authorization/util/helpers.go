@@ -22,8 +22,19 @@ import (
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 	authv1 "tkestack.io/tke/api/auth/v1"
+	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	genericfilter "tkestack.io/tke/pkg/apiserver/filter"
 )
 
+// TenantIDFrom returns the tenant of the user, from the user extra or from the
+// groups of the user.
+func TenantIDFrom(u user.Info) string {
+	if tenantIDs := u.GetExtra()[genericoidc.TenantIDKey]; len(tenantIDs) > 0 && tenantIDs[0] != "" {
+		return tenantIDs[0]
+	}
+	return genericfilter.GetValueFromGroups(u.GetGroups(), "tenant")
+}
+
 // ResourceAttributesFrom combines the API object information and the user.Info from the context to build a full authorizer.AttributesRecord for resource access.
 func ResourceAttributesFrom(user user.Info, in authv1.ResourceAttributes) authorizer.AttributesRecord {
 	return authorizer.AttributesRecord{
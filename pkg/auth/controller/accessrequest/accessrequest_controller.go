@@ -0,0 +1,570 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "tkestack.io/tke/api/auth/v1"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	authv1informer "tkestack.io/tke/api/client/informers/externalversions/auth/v1"
+	authv1lister "tkestack.io/tke/api/client/listers/auth/v1"
+	notifyv1 "tkestack.io/tke/api/notify/v1"
+	authutil "tkestack.io/tke/pkg/auth/util"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/util"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	controllerName = "accessrequest-controller"
+
+	// Kinds of the bindings granting the requested permissions.
+	kindProjectPolicyBinding = "ProjectPolicyBinding"
+	kindCustomPolicyBinding  = "CustomPolicyBinding"
+)
+
+// Controller is responsible for granting the permissions of the approved
+// access requests and revoking them at expiry.
+type Controller struct {
+	client                    clientset.Interface
+	notifyClient              notifyversionedclient.NotifyV1Interface
+	queue                     workqueue.RateLimitingInterface
+	accessRequestLister       authv1lister.AccessRequestLister
+	accessRequestListerSynced cache.InformerSynced
+}
+
+// NewController creates a new access request controller object. The
+// approvers are not alerted if the notify client is nil.
+func NewController(client clientset.Interface, notifyClient notifyversionedclient.NotifyV1Interface, accessRequestInformer authv1informer.AccessRequestInformer, resyncPeriod time.Duration) *Controller {
+	controller := &Controller{
+		client:       client,
+		notifyClient: notifyClient,
+		queue:        workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.AuthV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage(controllerName, client.AuthV1().RESTClient().GetRateLimiter())
+	}
+
+	accessRequestInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				old, ok1 := oldObj.(*v1.AccessRequest)
+				cur, ok2 := newObj.(*v1.AccessRequest)
+				if ok1 && ok2 && old.ResourceVersion != cur.ResourceVersion {
+					controller.enqueue(newObj)
+				}
+			},
+		},
+		resyncPeriod,
+	)
+	controller.accessRequestLister = accessRequestInformer.Lister()
+	controller.accessRequestListerSynced = accessRequestInformer.Informer().HasSynced
+
+	return controller
+}
+
+// obj could be an *v1.AccessRequest, or a DeletionFinalStateUnknown marker item.
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting access request controller")
+	defer log.Info("Shutting down access request controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.accessRequestListerSynced); !ok {
+		log.Error("Failed to wait for access request caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of access request objects.
+// Each access request can be in the queue at most once.
+// The system ensures that no two workers can process
+// the same access request at the same time.
+func (c *Controller) worker() {
+	workFunc := func() bool {
+		key, quit := c.queue.Get()
+		if quit {
+			return true
+		}
+		defer c.queue.Done(key)
+
+		err := c.syncItem(key.(string))
+		if err == nil {
+			// no error, forget this entry and return
+			c.queue.Forget(key)
+			return false
+		}
+
+		// rather than wait for a full resync, re-add the access request to the queue to be processed
+		c.queue.AddRateLimited(key)
+		runtime.HandleError(err)
+		return false
+	}
+
+	for {
+		quit := workFunc()
+
+		if quit {
+			return
+		}
+	}
+}
+
+// syncItem will sync the access request with the given key.
+func (c *Controller) syncItem(key string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Debug("Finished syncing access request", log.String("accessRequest", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	cached, err := c.accessRequestLister.Get(name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		log.Warn("Unable to retrieve access request from store", log.String("accessRequest", key), log.Err(err))
+		return err
+	}
+	accessRequest := cached.DeepCopy()
+	ctx := context.Background()
+
+	if accessRequest.DeletionTimestamp != nil {
+		return c.finalize(ctx, accessRequest)
+	}
+
+	switch accessRequest.Status.Phase {
+	case v1.AccessRequestPending:
+		return c.notifyApprovers(ctx, accessRequest)
+	case v1.AccessRequestApproved:
+		if remaining := time.Until(accessRequest.Status.ExpireTime.Time); remaining > 0 {
+			err := c.grant(ctx, accessRequest)
+			c.queue.AddAfter(key, remaining)
+			return err
+		}
+		return c.expire(ctx, accessRequest)
+	}
+	return nil
+}
+
+// grant adds the user to the bindings of the requested policies, and records
+// the bindings in the status so that only those are revoked at expiry.
+func (c *Controller) grant(ctx context.Context, accessRequest *v1.AccessRequest) error {
+	policies, err := c.requestedPolicies(ctx, accessRequest)
+	if err != nil {
+		return c.updateMessage(ctx, accessRequest, err)
+	}
+
+	before := len(accessRequest.Status.Bindings)
+	var errs []error
+	for _, policyID := range policies {
+		var err error
+		if accessRequest.Spec.ProjectID != "" {
+			err = c.grantProjectPolicy(ctx, accessRequest, policyID)
+		} else {
+			err = c.grantPlatformPolicy(ctx, accessRequest, policyID)
+		}
+		if err != nil {
+			log.Error("Grant policy of access request failed", log.String("accessRequest", accessRequest.Name), log.String("policy", policyID), log.Err(err))
+			errs = append(errs, err)
+		}
+	}
+
+	err = utilerrors.NewAggregate(errs)
+	if len(accessRequest.Status.Bindings) == before && err == nil && accessRequest.Status.Message == "" {
+		return nil
+	}
+	if err == nil {
+		log.Info("Access request granted", log.String("accessRequest", accessRequest.Name), log.String("user", accessRequest.Spec.Username),
+			log.String("project", accessRequest.Spec.ProjectID), log.Strings("policies", policies))
+	}
+	return c.updateMessage(ctx, accessRequest, err)
+}
+
+func (c *Controller) requestedPolicies(ctx context.Context, accessRequest *v1.AccessRequest) ([]string, error) {
+	policies := append([]string{}, accessRequest.Spec.Policies...)
+	if accessRequest.Spec.RoleID != "" {
+		role, err := c.client.AuthV1().Roles().Get(ctx, accessRequest.Spec.RoleID, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, policyID := range role.Spec.Policies {
+			if !util.InStringSlice(policies, policyID) {
+				policies = append(policies, policyID)
+			}
+		}
+	}
+	return policies, nil
+}
+
+// grantProjectPolicy adds the user to the project policy binding. The binding
+// is recorded in the status before it is changed, so that the grant is
+// revoked at expiry even if the controller fails right after changing it.
+func (c *Controller) grantProjectPolicy(ctx context.Context, accessRequest *v1.AccessRequest, policyID string) error {
+	name := authutil.ProjectPolicyName(accessRequest.Spec.ProjectID, policyID)
+
+	user, err := c.getUser(ctx, accessRequest.Spec.TenantID, accessRequest.Spec.Username)
+	if err != nil {
+		return err
+	}
+	subject := v1.Subject{ID: user.Spec.ID, Name: user.Spec.Name}
+
+	binding, err := c.client.AuthV1().ProjectPolicyBindings().Get(ctx, name, metav1.GetOptions{})
+	notFound := errors.IsNotFound(err)
+	if err != nil && !notFound {
+		return err
+	}
+	if !recorded(accessRequest, kindProjectPolicyBinding, "", name) {
+		// The user already has the policy, nothing is granted by the request.
+		if !notFound && hasSubject(binding.Spec.Users, subject) {
+			return nil
+		}
+		record(accessRequest, kindProjectPolicyBinding, "", name, notFound)
+		if err := c.updateStatus(ctx, accessRequest); err != nil {
+			return err
+		}
+	}
+
+	if notFound {
+		binding = &v1.ProjectPolicyBinding{
+			Spec: v1.ProjectPolicyBindingSpec{
+				TenantID:  accessRequest.Spec.TenantID,
+				ProjectID: accessRequest.Spec.ProjectID,
+				PolicyID:  policyID,
+				Users:     []v1.Subject{subject},
+			},
+		}
+		_, err := c.client.AuthV1().ProjectPolicyBindings().Create(ctx, binding, metav1.CreateOptions{})
+		return err
+	}
+	if hasSubject(binding.Spec.Users, subject) {
+		return nil
+	}
+	binding.Spec.Users = append(binding.Spec.Users, subject)
+	_, err = c.client.AuthV1().ProjectPolicyBindings().Update(ctx, binding, metav1.UpdateOptions{})
+	return err
+}
+
+// grantPlatformPolicy creates a custom policy binding owned by the access
+// request in the default domain, so that revoking it never touches the
+// policies the user has been bound to otherwise. Like project policies, the
+// binding is recorded in the status before it is created.
+func (c *Controller) grantPlatformPolicy(ctx context.Context, accessRequest *v1.AccessRequest, policyID string) error {
+	if !recorded(accessRequest, kindCustomPolicyBinding, accessRequest.Name, policyID) {
+		record(accessRequest, kindCustomPolicyBinding, accessRequest.Name, policyID, true)
+		if err := c.updateStatus(ctx, accessRequest); err != nil {
+			return err
+		}
+	}
+
+	_, err := c.client.AuthV1().CustomPolicyBindings(accessRequest.Name).Get(ctx, policyID, metav1.GetOptions{})
+	if err == nil || !errors.IsNotFound(err) {
+		return err
+	}
+	policy, err := c.client.AuthV1().Policies().Get(ctx, policyID, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	binding := &v1.CustomPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: accessRequest.Name,
+		},
+		Spec: v1.CustomPolicyBindingSpec{
+			TenantID:   accessRequest.Spec.TenantID,
+			Domain:     authutil.DefaultDomain,
+			PolicyID:   policyID,
+			Resources:  policy.Spec.Statement.Resources,
+			RulePrefix: accessRequest.Name,
+			Users:      []v1.Subject{{Name: accessRequest.Spec.Username}},
+		},
+	}
+	if _, err := c.client.AuthV1().CustomPolicyBindings(accessRequest.Name).Create(ctx, binding, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// expire revokes the granted permissions and finishes the access request.
+func (c *Controller) expire(ctx context.Context, accessRequest *v1.AccessRequest) error {
+	if err := c.revoke(ctx, accessRequest); err != nil {
+		return c.updateMessage(ctx, accessRequest, err)
+	}
+	accessRequest.Status.Phase = v1.AccessRequestExpired
+	accessRequest.Status.Message = ""
+	if _, err := c.client.AuthV1().AccessRequests().UpdateStatus(ctx, accessRequest, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	log.Info("Access request expired", log.String("accessRequest", accessRequest.Name), log.String("user", accessRequest.Spec.Username))
+	return nil
+}
+
+// finalize revokes the granted permissions of the deleted access request and
+// removes its finalizer.
+func (c *Controller) finalize(ctx context.Context, accessRequest *v1.AccessRequest) error {
+	if !util.InStringSlice(accessRequest.Finalizers, string(v1.AccessRequestFinalize)) {
+		return nil
+	}
+	if err := c.revoke(ctx, accessRequest); err != nil {
+		return c.updateMessage(ctx, accessRequest, err)
+	}
+
+	var finalizers []string
+	for _, finalizer := range accessRequest.Finalizers {
+		if finalizer != string(v1.AccessRequestFinalize) {
+			finalizers = append(finalizers, finalizer)
+		}
+	}
+	accessRequest.Finalizers = finalizers
+	_, err := c.client.AuthV1().AccessRequests().Update(ctx, accessRequest, metav1.UpdateOptions{})
+	return err
+}
+
+// revoke removes the user from the recorded bindings. The bindings revoked
+// successfully are dropped from the status.
+func (c *Controller) revoke(ctx context.Context, accessRequest *v1.AccessRequest) error {
+	var (
+		errs      []error
+		remaining []v1.AccessRequestBinding
+	)
+	for _, binding := range accessRequest.Status.Bindings {
+		var err error
+		switch binding.Kind {
+		case kindProjectPolicyBinding:
+			err = c.revokeProjectPolicy(ctx, accessRequest, binding)
+		case kindCustomPolicyBinding:
+			err = c.client.AuthV1().CustomPolicyBindings(binding.Namespace).Delete(ctx, binding.Name, metav1.DeleteOptions{})
+		}
+		if err != nil && !errors.IsNotFound(err) {
+			log.Error("Revoke binding of access request failed", log.String("accessRequest", accessRequest.Name), log.String("kind", binding.Kind), log.String("binding", binding.Name), log.Err(err))
+			errs = append(errs, err)
+			remaining = append(remaining, binding)
+			continue
+		}
+		log.Info("Access request binding revoked", log.String("accessRequest", accessRequest.Name), log.String("user", accessRequest.Spec.Username),
+			log.String("kind", binding.Kind), log.String("binding", binding.Name))
+	}
+	accessRequest.Status.Bindings = remaining
+	return utilerrors.NewAggregate(errs)
+}
+
+func (c *Controller) revokeProjectPolicy(ctx context.Context, accessRequest *v1.AccessRequest, record v1.AccessRequestBinding) error {
+	binding, err := c.client.AuthV1().ProjectPolicyBindings().Get(ctx, record.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	var users []v1.Subject
+	for _, user := range binding.Spec.Users {
+		if user.Name != accessRequest.Spec.Username {
+			users = append(users, user)
+		}
+	}
+	if record.Created && len(users) == 0 && len(binding.Spec.Groups) == 0 {
+		return c.client.AuthV1().ProjectPolicyBindings().Delete(ctx, record.Name, metav1.DeleteOptions{})
+	}
+	if len(users) == len(binding.Spec.Users) {
+		return nil
+	}
+	binding.Spec.Users = users
+	_, err = c.client.AuthV1().ProjectPolicyBindings().Update(ctx, binding, metav1.UpdateOptions{})
+	return err
+}
+
+// notifyApprovers alerts the approvers of the pending access request through
+// the notify channel configured for the tenant.
+func (c *Controller) notifyApprovers(ctx context.Context, accessRequest *v1.AccessRequest) error {
+	if c.notifyClient == nil || !accessRequest.Status.NotifyTime.IsZero() {
+		return nil
+	}
+
+	idp, err := c.client.AuthV1().IdentityProviders().Get(ctx, accessRequest.Spec.TenantID, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	arConfig, err := authutil.ParseAccessRequestConfig(idp.Spec.Config)
+	if err != nil {
+		return err
+	}
+	if arConfig.NotifyChannel == "" {
+		return nil
+	}
+
+	var receivers []string
+	for _, approver := range accessRequest.Status.Approvers {
+		selector := fields.AndSelectors(
+			fields.OneTermEqualSelector("spec.tenantID", accessRequest.Spec.TenantID),
+			fields.OneTermEqualSelector("spec.username", approver))
+		receiverList, err := c.notifyClient.Receivers().List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+		if err != nil {
+			return err
+		}
+		for _, receiver := range receiverList.Items {
+			receivers = append(receivers, receiver.Name)
+		}
+	}
+
+	if len(receivers) == 0 {
+		log.Warn("No receiver found for the approvers of access request", log.String("accessRequest", accessRequest.Name), log.Strings("approvers", accessRequest.Status.Approvers))
+	} else {
+		messageRequest := &notifyv1.MessageRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: arConfig.NotifyChannel,
+			},
+			Spec: notifyv1.MessageRequestSpec{
+				TenantID:     accessRequest.Spec.TenantID,
+				TemplateName: arConfig.NotifyTemplate,
+				Receivers:    receivers,
+				Variables:    messageVariables(accessRequest),
+			},
+		}
+		if _, err := c.notifyClient.MessageRequests(arConfig.NotifyChannel).Create(ctx, messageRequest, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		log.Info("Approvers of access request notified", log.String("accessRequest", accessRequest.Name), log.Strings("receivers", receivers))
+	}
+
+	accessRequest.Status.NotifyTime = metav1.Now()
+	_, err = c.client.AuthV1().AccessRequests().UpdateStatus(ctx, accessRequest, metav1.UpdateOptions{})
+	return err
+}
+
+func messageVariables(accessRequest *v1.AccessRequest) map[string]string {
+	scope := "platform"
+	if accessRequest.Spec.ProjectID != "" {
+		scope = "project " + accessRequest.Spec.ProjectID
+	}
+	permissions := strings.Join(accessRequest.Spec.Policies, ",")
+	if accessRequest.Spec.RoleID != "" {
+		permissions = strings.TrimPrefix(permissions+",role "+accessRequest.Spec.RoleID, ",")
+	}
+	return map[string]string{
+		"summary": fmt.Sprintf("[TKEStack access request]\n%s requests %s on %s for %s: %s",
+			accessRequest.Spec.Username, permissions, scope, accessRequest.Spec.Duration.Duration, accessRequest.Spec.Reason),
+		"accessRequest": accessRequest.Name,
+		"username":      accessRequest.Spec.Username,
+		"projectID":     accessRequest.Spec.ProjectID,
+		"policies":      strings.Join(accessRequest.Spec.Policies, ","),
+		"roleID":        accessRequest.Spec.RoleID,
+		"duration":      accessRequest.Spec.Duration.Duration.String(),
+		"reason":        accessRequest.Spec.Reason,
+	}
+}
+
+// updateMessage records the bindings granted or revoked so far together with
+// the failure, if any, in the status.
+func (c *Controller) updateMessage(ctx context.Context, accessRequest *v1.AccessRequest, err error) error {
+	accessRequest.Status.Message = ""
+	if err != nil {
+		accessRequest.Status.Message = err.Error()
+	}
+	if updateErr := c.updateStatus(ctx, accessRequest); updateErr != nil {
+		return updateErr
+	}
+	return err
+}
+
+// updateStatus writes the status of the access request, and keeps the
+// updated object so that the following writes do not conflict.
+func (c *Controller) updateStatus(ctx context.Context, accessRequest *v1.AccessRequest) error {
+	updated, err := c.client.AuthV1().AccessRequests().UpdateStatus(ctx, accessRequest, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	*accessRequest = *updated
+	return nil
+}
+
+func (c *Controller) getUser(ctx context.Context, tenantID, username string) (*v1.User, error) {
+	selector := fields.AndSelectors(
+		fields.OneTermEqualSelector("spec.tenantID", tenantID),
+		fields.OneTermEqualSelector("spec.username", username))
+	userList, err := c.client.AuthV1().Users().List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	if len(userList.Items) == 0 {
+		return nil, errors.NewNotFound(v1.Resource("users"), username)
+	}
+	return &userList.Items[0], nil
+}
+
+func recorded(accessRequest *v1.AccessRequest, kind, namespace, name string) bool {
+	for _, binding := range accessRequest.Status.Bindings {
+		if binding.Kind == kind && binding.Namespace == namespace && binding.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func record(accessRequest *v1.AccessRequest, kind, namespace, name string, created bool) {
+	accessRequest.Status.Bindings = append(accessRequest.Status.Bindings, v1.AccessRequestBinding{
+		Kind:      kind,
+		Namespace: namespace,
+		Name:      name,
+		Created:   created,
+	})
+}
+
+func hasSubject(subjects []v1.Subject, subject v1.Subject) bool {
+	for _, s := range subjects {
+		if (s.ID != "" && s.ID == subject.ID) || (s.Name != "" && s.Name == subject.Name) {
+			return true
+		}
+	}
+	return false
+}
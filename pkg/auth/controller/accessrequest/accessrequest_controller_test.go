@@ -0,0 +1,144 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clienttesting "k8s.io/client-go/testing"
+	v1 "tkestack.io/tke/api/auth/v1"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	authutil "tkestack.io/tke/pkg/auth/util"
+)
+
+// failStatusUpdates makes the status updates of access requests fail while
+// *fail is true.
+func failStatusUpdates(client *fake.Clientset, fail *bool) {
+	client.PrependReactor("update", "accessrequests", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if *fail && action.GetSubresource() == "status" {
+			return true, nil, fmt.Errorf("status update failed")
+		}
+		return false, nil, nil
+	})
+}
+
+func newAccessRequest(projectID string) *v1.AccessRequest {
+	return &v1.AccessRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "ar-1"},
+		Spec: v1.AccessRequestSpec{
+			TenantID:  "default",
+			Username:  "alice",
+			ProjectID: projectID,
+			Policies:  []string{"pol-1"},
+			Duration:  metav1.Duration{Duration: time.Hour},
+		},
+		Status: v1.AccessRequestStatus{
+			Phase:      v1.AccessRequestApproved,
+			ExpireTime: metav1.NewTime(time.Now().Add(time.Hour)),
+		},
+	}
+}
+
+func TestGrantProjectPolicyStatusUpdateFailed(t *testing.T) {
+	ctx := context.Background()
+	bindingName := authutil.ProjectPolicyName("prj-1", "pol-1")
+	client := fake.NewSimpleClientset(
+		&v1.User{ObjectMeta: metav1.ObjectMeta{Name: "usr-alice"}, Spec: v1.UserSpec{ID: "usr-alice", Name: "alice", TenantID: "default"}},
+		&v1.ProjectPolicyBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: bindingName},
+			Spec: v1.ProjectPolicyBindingSpec{
+				TenantID:  "default",
+				ProjectID: "prj-1",
+				PolicyID:  "pol-1",
+				Users:     []v1.Subject{{ID: "usr-bob", Name: "bob"}},
+			},
+		},
+		newAccessRequest("prj-1"),
+	)
+	fail := true
+	failStatusUpdates(client, &fail)
+	c := &Controller{client: client}
+
+	accessRequest := newAccessRequest("prj-1")
+	if err := c.grant(ctx, accessRequest); err == nil {
+		t.Fatal("expected the grant to fail")
+	}
+	binding, err := client.AuthV1().ProjectPolicyBindings().Get(ctx, bindingName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(binding.Spec.Users) != 1 {
+		t.Fatalf("expected the binding unchanged before the grant is recorded, got %v", binding.Spec.Users)
+	}
+
+	fail = false
+	accessRequest, _ = client.AuthV1().AccessRequests().Get(ctx, "ar-1", metav1.GetOptions{})
+	if err := c.grant(ctx, accessRequest); err != nil {
+		t.Fatal(err)
+	}
+	binding, _ = client.AuthV1().ProjectPolicyBindings().Get(ctx, bindingName, metav1.GetOptions{})
+	if !hasSubject(binding.Spec.Users, v1.Subject{ID: "usr-alice"}) {
+		t.Fatalf("expected alice granted, got %v", binding.Spec.Users)
+	}
+	stored, _ := client.AuthV1().AccessRequests().Get(ctx, "ar-1", metav1.GetOptions{})
+	if !recorded(stored, kindProjectPolicyBinding, "", bindingName) {
+		t.Fatalf("expected the binding recorded, got %v", stored.Status.Bindings)
+	}
+
+	if err := c.expire(ctx, stored); err != nil {
+		t.Fatal(err)
+	}
+	binding, _ = client.AuthV1().ProjectPolicyBindings().Get(ctx, bindingName, metav1.GetOptions{})
+	if len(binding.Spec.Users) != 1 || binding.Spec.Users[0].Name != "bob" {
+		t.Errorf("expected alice revoked, got %v", binding.Spec.Users)
+	}
+}
+
+func TestGrantRecordedBeforeChange(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset(
+		&v1.User{ObjectMeta: metav1.ObjectMeta{Name: "usr-alice"}, Spec: v1.UserSpec{ID: "usr-alice", Name: "alice", TenantID: "default"}},
+		&v1.Policy{ObjectMeta: metav1.ObjectMeta{Name: "pol-1"}, Spec: v1.PolicySpec{Statement: v1.Statement{Resources: []string{"*"}}}},
+		newAccessRequest("prj-1"),
+	)
+	// Fail the changes of the bindings, the grants must still be recorded
+	// so that they are revoked whatever happened to the bindings.
+	client.PrependReactor("create", "*", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("create failed")
+	})
+	c := &Controller{client: client}
+
+	for _, projectID := range []string{"prj-1", ""} {
+		accessRequest := newAccessRequest(projectID)
+		if err := c.grant(ctx, accessRequest); err == nil {
+			t.Fatal("expected the grant to fail")
+		}
+		stored, _ := client.AuthV1().AccessRequests().Get(ctx, "ar-1", metav1.GetOptions{})
+		if len(stored.Status.Bindings) != 1 {
+			t.Errorf("expected the binding of project %q recorded, got %v", projectID, stored.Status.Bindings)
+		}
+		stored.Status.Bindings = nil
+		_, _ = client.AuthV1().AccessRequests().UpdateStatus(ctx, stored, metav1.UpdateOptions{})
+	}
+}
@@ -0,0 +1,495 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the “License”); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an “AS IS” BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package deletion
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	v1 "tkestack.io/tke/api/auth/v1"
+	businessv1 "tkestack.io/tke/api/business/v1"
+	applicationv1clientset "tkestack.io/tke/api/client/clientset/versioned/typed/application/v1"
+	v1clientset "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	businessv1clientset "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	monitorv1clientset "tkestack.io/tke/api/client/clientset/versioned/typed/monitor/v1"
+	notifyv1clientset "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	platformv1clientset "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	registryv1clientset "tkestack.io/tke/api/client/clientset/versioned/typed/registry/v1"
+	monitorv1 "tkestack.io/tke/api/monitor/v1"
+	notifyv1 "tkestack.io/tke/api/notify/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	registryv1 "tkestack.io/tke/api/registry/v1"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// GroupClients holds the clients of the api groups the objects of tenants
+// live in, the groups without client are skipped. The apps are deleted
+// together with their clusters, so Application is only used for usage.
+type GroupClients struct {
+	Business    businessv1clientset.BusinessV1Interface
+	Platform    platformv1clientset.PlatformV1Interface
+	Registry    registryv1clientset.RegistryV1Interface
+	Notify      notifyv1clientset.NotifyV1Interface
+	Monitor     monitorv1clientset.MonitorV1Interface
+	Application applicationv1clientset.ApplicationV1Interface
+}
+
+// TenantedResourcesDeleterInterface to delete a tenant with all objects of
+// it in every api group.
+type TenantedResourcesDeleterInterface interface {
+	Delete(ctx context.Context, tenantName string) error
+}
+
+// NewTenantedResourcesDeleter to create the tenantedResourcesDeleter that
+// implement the TenantedResourcesDeleterInterface by given client and
+// configure.
+func NewTenantedResourcesDeleter(tenantClient v1clientset.TenantInterface,
+	authClient v1clientset.AuthV1Interface,
+	clients GroupClients,
+	finalizerToken v1.FinalizerName,
+	deleteTenantWhenDone bool) TenantedResourcesDeleterInterface {
+	d := &tenantedResourcesDeleter{
+		tenantClient:         tenantClient,
+		authClient:           authClient,
+		clients:              clients,
+		finalizerToken:       finalizerToken,
+		deleteTenantWhenDone: deleteTenantWhenDone,
+	}
+	return d
+}
+
+var _ TenantedResourcesDeleterInterface = &tenantedResourcesDeleter{}
+
+// tenantedResourcesDeleter is used to delete all objects of a given tenant.
+type tenantedResourcesDeleter struct {
+	// Client to manipulate the tenant.
+	tenantClient v1clientset.TenantInterface
+	authClient   v1clientset.AuthV1Interface
+	clients      GroupClients
+	// The finalizer token that should be removed from the tenant
+	// when all objects of that tenant have been deleted.
+	finalizerToken v1.FinalizerName
+	// Also delete the tenant when all objects of the tenant have been deleted.
+	deleteTenantWhenDone bool
+}
+
+// Delete deletes all objects of the given tenant.
+// Before deleting objects:
+//   - It ensures that deletion timestamp is set on the
+//     tenant (does nothing if deletion timestamp is missing).
+//   - Verifies that the tenant is in the "terminating" phase
+//     (updates the tenant phase if it is not yet marked terminating)
+//
+// After deleting the objects:
+// * It removes finalizer token from the given tenant.
+// * Deletes the tenant if deleteTenantWhenDone is true.
+//
+// The progress of every api group is recorded in the status of the tenant.
+// Returns an error if some objects remain, caller is expected to keep
+// calling this until it succeeds.
+func (d *tenantedResourcesDeleter) Delete(ctx context.Context, tenantName string) error {
+	// Multiple controllers may edit a tenant during termination
+	// first get the latest state of the tenant before proceeding
+	// if the tenant was deleted already, don't do anything
+	tenant, err := d.tenantClient.Get(ctx, tenantName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if tenant.DeletionTimestamp == nil {
+		return nil
+	}
+
+	log.Infof("tenant controller - syncTenant - tenant: %s, finalizerToken: %s", tenant.Name, d.finalizerToken)
+
+	// ensure that the status is up to date on the tenant
+	// if we get a not found error, we assume the tenant is truly gone
+	tenant, err = d.retryOnConflictError(ctx, tenant, d.updateTenantStatusFunc)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	// the latest view of the tenant asserts that tenant is no longer deleting..
+	if tenant.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	// Delete the tenant if it is already finalized.
+	if d.deleteTenantWhenDone && finalized(tenant) {
+		return d.deleteTenant(ctx, tenant)
+	}
+
+	// there may still be content for us to remove
+	tenant, err = d.deleteAllContent(ctx, tenant)
+	if err != nil {
+		return err
+	}
+
+	// we have removed content, so mark it finalized by us
+	tenant, err = d.retryOnConflictError(ctx, tenant, d.finalizeTenant)
+	if err != nil {
+		// in normal practice, this should not be possible, but if a deployment is running
+		// two controllers to do tenant deletion that share a common finalizer token it's
+		// possible that a not found could occur since the other controller would have finished the delete.
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	// Check if we can delete now.
+	if d.deleteTenantWhenDone && finalized(tenant) {
+		return d.deleteTenant(ctx, tenant)
+	}
+
+	return nil
+}
+
+// Deletes the given tenant.
+func (d *tenantedResourcesDeleter) deleteTenant(ctx context.Context, tenant *v1.Tenant) error {
+	var opts metav1.DeleteOptions
+	uid := tenant.UID
+	if len(uid) > 0 {
+		opts = metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &uid}}
+	}
+	err := d.tenantClient.Delete(ctx, tenant.Name, opts)
+	if err != nil && !errors.IsNotFound(err) {
+		log.Error("error", log.Err(err))
+		return err
+	}
+	return nil
+}
+
+// updateTenantFunc is a function that makes an update to a tenant
+type updateTenantFunc func(ctx context.Context, tenant *v1.Tenant) (*v1.Tenant, error)
+
+// retryOnConflictError retries the specified fn if there was a conflict error
+// it will return an error if the UID for an object changes across retry operations.
+func (d *tenantedResourcesDeleter) retryOnConflictError(ctx context.Context, tenant *v1.Tenant, fn updateTenantFunc) (result *v1.Tenant, err error) {
+	latestTenant := tenant
+	for {
+		result, err = fn(ctx, latestTenant)
+		if err == nil {
+			return result, nil
+		}
+		if !errors.IsConflict(err) {
+			return nil, err
+		}
+		prevTenant := latestTenant
+		latestTenant, err = d.tenantClient.Get(ctx, latestTenant.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if prevTenant.UID != latestTenant.UID {
+			return nil, fmt.Errorf("tenant uid has changed across retries")
+		}
+	}
+}
+
+// updateTenantStatusFunc will verify that the status of the tenant is correct
+func (d *tenantedResourcesDeleter) updateTenantStatusFunc(ctx context.Context, tenant *v1.Tenant) (*v1.Tenant, error) {
+	if tenant.DeletionTimestamp.IsZero() || tenant.Status.Phase == v1.TenantTerminating {
+		return tenant, nil
+	}
+	newTenant := v1.Tenant{}
+	newTenant.ObjectMeta = tenant.ObjectMeta
+	newTenant.Status = tenant.Status
+	newTenant.Status.Phase = v1.TenantTerminating
+	return d.tenantClient.UpdateStatus(ctx, &newTenant, metav1.UpdateOptions{})
+}
+
+// finalized returns true if the tenant.Spec.Finalizers is an empty list
+func finalized(tenant *v1.Tenant) bool {
+	return len(tenant.Spec.Finalizers) == 0
+}
+
+// finalizeTenant removes the specified finalizerToken and finalizes the tenant
+func (d *tenantedResourcesDeleter) finalizeTenant(ctx context.Context, tenant *v1.Tenant) (*v1.Tenant, error) {
+	tenantFinalize := v1.Tenant{}
+	tenantFinalize.ObjectMeta = tenant.ObjectMeta
+	tenantFinalize.Spec = tenant.Spec
+	finalizerSet := sets.NewString()
+	for i := range tenant.Spec.Finalizers {
+		if tenant.Spec.Finalizers[i] != d.finalizerToken {
+			finalizerSet.Insert(string(tenant.Spec.Finalizers[i]))
+		}
+	}
+	tenantFinalize.Spec.Finalizers = make([]v1.FinalizerName, 0, len(finalizerSet))
+	for _, value := range finalizerSet.List() {
+		tenantFinalize.Spec.Finalizers = append(tenantFinalize.Spec.Finalizers, v1.FinalizerName(value))
+	}
+
+	updated := &v1.Tenant{}
+	err := d.authClient.RESTClient().Put().
+		Resource("tenants").
+		Name(tenantFinalize.Name).
+		SubResource("finalize").
+		Body(&tenantFinalize).
+		Do(ctx).
+		Into(updated)
+
+	if err != nil {
+		return nil, err
+	}
+	return updated, err
+}
+
+// tenantResource lists and deletes the objects of a resource belonging to a
+// tenant.
+type tenantResource struct {
+	name          string
+	fieldSelector string
+	list          func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error)
+	delete        func(ctx context.Context, name string, options metav1.DeleteOptions) error
+	// last is true if the objects are deleted after all other objects of
+	// the group are gone.
+	last bool
+}
+
+// tenantGroup is an api group the objects of tenants are deleted from. The
+// resources are nil if the group is not available.
+type tenantGroup struct {
+	name      string
+	resources func(d *tenantedResourcesDeleter, tenantID string) []tenantResource
+}
+
+// tenantGroups are the api groups in deletion order, the objects of auth are
+// deleted at last since the other groups rely on them during deletion.
+var tenantGroups = []tenantGroup{
+	{businessv1.GroupName, businessResources},
+	{platformv1.GroupName, platformResources},
+	{registryv1.GroupName, registryResources},
+	{notifyv1.GroupName, notifyResources},
+	{monitorv1.GroupName, monitorResources},
+	{v1.GroupName, authResources},
+}
+
+// deleteAllContent deletes the objects of the tenant in every api group, and
+// records the progress in the status of the tenant. It returns an error if
+// any object remains.
+func (d *tenantedResourcesDeleter) deleteAllContent(ctx context.Context, tenant *v1.Tenant) (*v1.Tenant, error) {
+	log.Debug("Tenant controller - deleteAllContent", log.String("tenantName", tenant.Name))
+
+	var (
+		statuses  []v1.TenantDeletionStatus
+		remaining int32
+	)
+	for _, group := range tenantGroups {
+		status := v1.TenantDeletionStatus{Group: group.name}
+		resources := group.resources(d, tenant.Name)
+		switch {
+		case resources == nil:
+			status.Phase = v1.TenantDeletionSkipped
+		case group.name == v1.GroupName && remaining > 0:
+			status.Phase = v1.TenantDeletionDeleting
+			status.Message = "waiting for the objects of the other api groups to be deleted"
+		default:
+			count, err := d.deleteGroup(ctx, resources)
+			status.Remaining = count
+			switch {
+			case err != nil:
+				status.Phase = v1.TenantDeletionFailed
+				status.Message = err.Error()
+			case count > 0:
+				status.Phase = v1.TenantDeletionDeleting
+			default:
+				status.Phase = v1.TenantDeletionCompleted
+			}
+		}
+		if status.Phase == v1.TenantDeletionDeleting || status.Phase == v1.TenantDeletionFailed {
+			remaining += status.Remaining + 1
+		}
+		statuses = append(statuses, status)
+	}
+
+	if !reflect.DeepEqual(tenant.Status.Deletion, statuses) {
+		newTenant := tenant.DeepCopy()
+		newTenant.Status.Deletion = statuses
+		updated, err := d.tenantClient.UpdateStatus(ctx, newTenant, metav1.UpdateOptions{})
+		if err != nil {
+			return nil, err
+		}
+		tenant = updated
+	}
+
+	if remaining > 0 {
+		return nil, fmt.Errorf("objects of tenant %s are not deleted yet", tenant.Name)
+	}
+	return tenant, nil
+}
+
+// deleteGroup deletes the objects of the resources, and returns the number of
+// objects not gone yet.
+func (d *tenantedResourcesDeleter) deleteGroup(ctx context.Context, resources []tenantResource) (int32, error) {
+	var remaining int32
+	for _, resource := range resources {
+		if resource.last && remaining > 0 {
+			continue
+		}
+		listObj, err := resource.list(ctx, metav1.ListOptions{FieldSelector: resource.fieldSelector})
+		if err != nil {
+			return remaining, fmt.Errorf("list %s: %v", resource.name, err)
+		}
+		items, err := meta.ExtractList(listObj)
+		if err != nil {
+			return remaining, err
+		}
+		for _, item := range items {
+			accessor, err := meta.Accessor(item)
+			if err != nil {
+				return remaining, err
+			}
+			remaining++
+			if accessor.GetDeletionTimestamp() != nil {
+				continue
+			}
+			if err := resource.delete(ctx, accessor.GetName(), metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+				return remaining, fmt.Errorf("delete %s %s: %v", resource.name, accessor.GetName(), err)
+			}
+		}
+	}
+	return remaining, nil
+}
+
+func tenantSelector(tenantID string) string {
+	return fields.OneTermEqualSelector("spec.tenantID", tenantID).String()
+}
+
+func businessResources(d *tenantedResourcesDeleter, tenantID string) []tenantResource {
+	c := d.clients.Business
+	if c == nil {
+		return nil
+	}
+	return []tenantResource{
+		{name: "projects", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.Projects().List(ctx, options)
+		}, delete: c.Projects().Delete},
+		{name: "platforms", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.Platforms().List(ctx, options)
+		}, delete: c.Platforms().Delete},
+	}
+}
+
+func platformResources(d *tenantedResourcesDeleter, tenantID string) []tenantResource {
+	c := d.clients.Platform
+	if c == nil {
+		return nil
+	}
+	return []tenantResource{
+		{name: "clusters", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.Clusters().List(ctx, options)
+		}, delete: c.Clusters().Delete},
+		{name: "registries", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.Registries().List(ctx, options)
+		}, delete: c.Registries().Delete},
+	}
+}
+
+func registryResources(d *tenantedResourcesDeleter, tenantID string) []tenantResource {
+	c := d.clients.Registry
+	if c == nil {
+		return nil
+	}
+	return []tenantResource{
+		{name: "namespaces", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.Namespaces().List(ctx, options)
+		}, delete: c.Namespaces().Delete},
+		{name: "chartgroups", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.ChartGroups().List(ctx, options)
+		}, delete: c.ChartGroups().Delete},
+	}
+}
+
+func notifyResources(d *tenantedResourcesDeleter, tenantID string) []tenantResource {
+	c := d.clients.Notify
+	if c == nil {
+		return nil
+	}
+	return []tenantResource{
+		{name: "channels", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.Channels().List(ctx, options)
+		}, delete: c.Channels().Delete},
+		{name: "receivers", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.Receivers().List(ctx, options)
+		}, delete: c.Receivers().Delete},
+		{name: "receivergroups", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.ReceiverGroups().List(ctx, options)
+		}, delete: c.ReceiverGroups().Delete},
+		{name: "messages", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.Messages().List(ctx, options)
+		}, delete: c.Messages().Delete},
+	}
+}
+
+func monitorResources(d *tenantedResourcesDeleter, tenantID string) []tenantResource {
+	c := d.clients.Monitor
+	if c == nil {
+		return nil
+	}
+	return []tenantResource{
+		{name: "prometheuses", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.Prometheuses().List(ctx, options)
+		}, delete: c.Prometheuses().Delete},
+	}
+}
+
+func authResources(d *tenantedResourcesDeleter, tenantID string) []tenantResource {
+	c := d.authClient
+	return []tenantResource{
+		{name: "localidentities", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.LocalIdentities().List(ctx, options)
+		}, delete: c.LocalIdentities().Delete},
+		{name: "localgroups", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.LocalGroups().List(ctx, options)
+		}, delete: c.LocalGroups().Delete},
+		{name: "roles", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.Roles().List(ctx, options)
+		}, delete: c.Roles().Delete},
+		{name: "policies", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.Policies().List(ctx, options)
+		}, delete: c.Policies().Delete},
+		{name: "projectpolicybindings", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.ProjectPolicyBindings().List(ctx, options)
+		}, delete: c.ProjectPolicyBindings().Delete},
+		{name: "apikeys", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.APIKeys().List(ctx, options)
+		}, delete: c.APIKeys().Delete},
+		{name: "accessrequests", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.AccessRequests().List(ctx, options)
+		}, delete: c.AccessRequests().Delete},
+		{name: "accessreviews", fieldSelector: tenantSelector(tenantID), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.AccessReviews().List(ctx, options)
+		}, delete: c.AccessReviews().Delete},
+		{name: "identityproviders", fieldSelector: fields.OneTermEqualSelector("metadata.name", tenantID).String(), list: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return c.IdentityProviders().List(ctx, options)
+		}, delete: c.IdentityProviders().Delete, last: true},
+	}
+}
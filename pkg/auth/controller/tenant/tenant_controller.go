@@ -0,0 +1,312 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the “License”); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an “AS IS” BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	v1 "tkestack.io/tke/api/auth/v1"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	authv1informer "tkestack.io/tke/api/client/informers/externalversions/auth/v1"
+	authv1lister "tkestack.io/tke/api/client/listers/auth/v1"
+	"tkestack.io/tke/pkg/auth/controller/tenant/deletion"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	// tenantDeletionGracePeriod is the time period to wait before processing a received tenant event.
+	// This allows time for the following to occur:
+	// * lifecycle admission plugins on HA apiservers to also observe a tenant
+	//   deletion and prevent new objects from being created in the terminating tenant
+	// * non-leader etcd servers to observe last-minute object creations in a tenant
+	//   so this controller's cleanup can actually clean up all objects
+	tenantDeletionGracePeriod = 5 * time.Second
+
+	// tenantUsagePeriod is the time period the usage of a tenant is refreshed.
+	tenantUsagePeriod = 5 * time.Minute
+
+	controllerName = "tenant-controller"
+)
+
+// Controller is responsible for performing actions dependent upon a tenant phase.
+type Controller struct {
+	client             clientset.Interface
+	clients            deletion.GroupClients
+	queue              workqueue.RateLimitingInterface
+	tenantLister       authv1lister.TenantLister
+	tenantListerSynced cache.InformerSynced
+	// helper to delete all resources in the tenant when the tenant is deleted.
+	tenantedResourcesDeleter deletion.TenantedResourcesDeleterInterface
+}
+
+// NewController creates a new tenant controller.
+func NewController(client clientset.Interface, clients deletion.GroupClients, tenantInformer authv1informer.TenantInformer, resyncPeriod time.Duration, finalizerToken v1.FinalizerName) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client:                   client,
+		clients:                  clients,
+		queue:                    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+		tenantedResourcesDeleter: deletion.NewTenantedResourcesDeleter(client.AuthV1().Tenants(), client.AuthV1(), clients, finalizerToken, true),
+	}
+
+	if client != nil && client.AuthV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("tenant_controller", client.AuthV1().RESTClient().GetRateLimiter())
+	}
+
+	tenantInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				old, ok1 := oldObj.(*v1.Tenant)
+				cur, ok2 := newObj.(*v1.Tenant)
+				// the resync delivers the same object, which refreshes the usage
+				if ok1 && ok2 && (old.ResourceVersion == cur.ResourceVersion || controller.needsUpdate(old, cur)) {
+					controller.enqueue(newObj)
+				}
+			},
+			DeleteFunc: controller.enqueue,
+		},
+		resyncPeriod,
+	)
+	controller.tenantLister = tenantInformer.Lister()
+	controller.tenantListerSynced = tenantInformer.Informer().HasSynced
+
+	return controller
+}
+
+// obj could be an *v1.Tenant, or a DeletionFinalStateUnknown marker item.
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	c.queue.AddAfter(key, tenantDeletionGracePeriod)
+}
+
+func (c *Controller) needsUpdate(old *v1.Tenant, new *v1.Tenant) bool {
+	if old.UID != new.UID {
+		return true
+	}
+
+	if !reflect.DeepEqual(old.Spec, new.Spec) {
+		return true
+	}
+
+	if old.DeletionTimestamp.IsZero() != new.DeletionTimestamp.IsZero() {
+		return true
+	}
+
+	return false
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Start the informer factories to begin populating the informer caches
+	log.Info("Starting tenant controller")
+	defer log.Info("Shutting down tenant controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.tenantListerSynced); !ok {
+		log.Error("Failed to wait for tenant caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of tenant objects.
+// Each tenant can be in the queue at most once.
+// The system ensures that no two workers can process
+// the same tenant at the same time.
+func (c *Controller) worker() {
+	workFunc := func() bool {
+		key, quit := c.queue.Get()
+		if quit {
+			return true
+		}
+		defer c.queue.Done(key)
+
+		err := c.syncItem(key.(string))
+		if err == nil {
+			// no error, forget this entry and return
+			c.queue.Forget(key)
+			return false
+		}
+
+		// rather than wait for a full resync, re-add the tenant to the queue to be processed
+		c.queue.AddRateLimited(key)
+		runtime.HandleError(err)
+		return false
+	}
+
+	for {
+		quit := workFunc()
+
+		if quit {
+			return
+		}
+	}
+}
+
+// syncItem will sync the tenant with the given key.
+func (c *Controller) syncItem(key string) error {
+	startTime := time.Now()
+
+	defer func() {
+		log.Info("Finished syncing tenant", log.String("tenant", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	tenant, err := c.tenantLister.Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		log.Infof("Tenant has been deleted %v", key)
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve tenant from store", log.String("tenant name", key), log.Err(err))
+	default:
+		if tenant.DeletionTimestamp != nil || tenant.Status.Phase == v1.TenantTerminating {
+			err = c.tenantedResourcesDeleter.Delete(context.Background(), key)
+		} else {
+			err = c.sync(context.Background(), tenant.DeepCopy())
+		}
+	}
+	return err
+}
+
+// sync creates the default identity provider of the tenant, and refreshes
+// the phase and usage of it.
+func (c *Controller) sync(ctx context.Context, tenant *v1.Tenant) error {
+	if err := c.ensureIdentityProvider(ctx, tenant); err != nil {
+		return err
+	}
+
+	status := tenant.Status.DeepCopy()
+	status.Phase = v1.TenantActive
+	if tenant.Spec.Suspended {
+		status.Phase = v1.TenantSuspended
+	}
+	status.Message = ""
+
+	used, err := c.usage(ctx, tenant.Name)
+	if err != nil {
+		log.Warn("Failed to count the usage of tenant", log.String("tenant", tenant.Name), log.Err(err))
+		status.Message = err.Error()
+	} else {
+		status.Used = used
+	}
+
+	expired := time.Since(tenant.Status.LastUsageTime.Time) >= tenantUsagePeriod
+	if !expired && reflect.DeepEqual(tenant.Status.Phase, status.Phase) &&
+		reflect.DeepEqual(tenant.Status.Used, status.Used) && tenant.Status.Message == status.Message {
+		return nil
+	}
+	if err == nil {
+		status.LastUsageTime = metav1.Now()
+	}
+
+	tenant.Status = *status
+	_, err = c.client.AuthV1().Tenants().UpdateStatus(ctx, tenant, metav1.UpdateOptions{})
+	return err
+}
+
+// ensureIdentityProvider creates the default identity provider of the tenant
+// if it does not exist, an existing one is left untouched.
+func (c *Controller) ensureIdentityProvider(ctx context.Context, tenant *v1.Tenant) error {
+	_, err := c.client.AuthV1().IdentityProviders().Get(ctx, tenant.Name, metav1.GetOptions{})
+	if err == nil || !errors.IsNotFound(err) {
+		return err
+	}
+
+	idp := &v1.IdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: tenant.Name},
+		Spec: v1.IdentityProviderSpec{
+			Name:           tenant.Spec.DisplayName,
+			Type:           tenant.Spec.IdentityProviderType,
+			Administrators: tenant.Spec.Administrators,
+			Config:         tenant.Spec.IdentityProviderConfig,
+		},
+	}
+	_, err = c.client.AuthV1().IdentityProviders().Create(ctx, idp, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	log.Info("Created the identity provider of tenant", log.String("tenant", tenant.Name))
+	return nil
+}
+
+// usage counts the objects the tenant owns, the groups without client are
+// counted as zero.
+func (c *Controller) usage(ctx context.Context, tenantID string) (v1.TenantUsage, error) {
+	var used v1.TenantUsage
+	options := metav1.ListOptions{FieldSelector: fields.OneTermEqualSelector("spec.tenantID", tenantID).String()}
+
+	if c.clients.Platform != nil {
+		clusters, err := c.clients.Platform.Clusters().List(ctx, options)
+		if err != nil {
+			return used, err
+		}
+		used.Clusters = int32(len(clusters.Items))
+	}
+	if c.clients.Business != nil {
+		projects, err := c.clients.Business.Projects().List(ctx, options)
+		if err != nil {
+			return used, err
+		}
+		used.Projects = int32(len(projects.Items))
+	}
+	if c.clients.Registry != nil {
+		namespaces, err := c.clients.Registry.Namespaces().List(ctx, options)
+		if err != nil {
+			return used, err
+		}
+		used.Registries = int32(len(namespaces.Items))
+	}
+	if c.clients.Application != nil {
+		apps, err := c.clients.Application.Apps(metav1.NamespaceAll).List(ctx, options)
+		if err != nil {
+			return used, err
+		}
+		used.Apps = int32(len(apps.Items))
+	}
+	return used, nil
+}
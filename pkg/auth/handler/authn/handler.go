@@ -19,7 +19,9 @@
 package authn
 
 import (
+	"net"
 	"net/http"
+	"strings"
 
 	"k8s.io/apiserver/pkg/authentication/token/union"
 
@@ -30,6 +32,7 @@ import (
 	"k8s.io/apiserver/pkg/authentication/authenticator"
 	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
 
+	"tkestack.io/tke/pkg/apiserver/filter"
 	"tkestack.io/tke/pkg/util/log"
 )
 
@@ -52,7 +55,11 @@ func (h *Handler) AuthenticateToken(request *restful.Request, response *restful.
 		return
 	}
 
-	authResp, valid, err := h.tokenAuthenticator.AuthenticateToken(request.Request.Context(), tokenReview.Spec.Token)
+	ctx := request.Request.Context()
+	if ip := forwardedClientIP(request.Request); ip != nil {
+		ctx = filter.WithClientIPValue(ctx, ip.String())
+	}
+	authResp, valid, err := h.tokenAuthenticator.AuthenticateToken(ctx, tokenReview.Spec.Token)
 	if !valid || err != nil {
 		log.Error("Failed to authenticate token", log.String("token", tokenReview.Spec.Token), log.Bool("valid", valid), log.Err(err))
 		tokenReview.Status = authv1.TokenReviewStatus{Authenticated: false}
@@ -80,3 +87,14 @@ func (h *Handler) AuthenticateToken(request *restful.Request, response *restful.
 
 	responsewriters.WriteRawJSON(http.StatusOK, tokenResponse, response.ResponseWriter)
 }
+
+// forwardedClientIP returns the source address of the request the token is
+// reviewed for. The reviewing apiserver sets it as the first address of the
+// X-Forwarded-For header, the proxies on the way append their own.
+func forwardedClientIP(req *http.Request) net.IP {
+	forwarded := req.Header.Get("X-Forwarded-For")
+	if forwarded == "" {
+		return nil
+	}
+	return net.ParseIP(strings.TrimSpace(strings.Split(forwarded, ",")[0]))
+}
@@ -0,0 +1,69 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package authn
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"tkestack.io/tke/pkg/apiserver/filter"
+)
+
+func TestAuthenticateTokenClientIP(t *testing.T) {
+	tests := []struct {
+		name      string
+		forwarded string
+		clientIP  string
+	}{
+		{name: "not forwarded"},
+		{name: "forwarded", forwarded: "10.0.0.1", clientIP: "10.0.0.1"},
+		{name: "through proxies", forwarded: "10.0.0.1, 192.168.0.1", clientIP: "10.0.0.1"},
+		{name: "invalid", forwarded: "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var clientIP string
+			h := &Handler{authenticator.TokenFunc(func(ctx context.Context, token string) (*authenticator.Response, bool, error) {
+				clientIP = filter.ClientIPFrom(ctx)
+				return &authenticator.Response{User: &user.DefaultInfo{Name: "alice"}}, true, nil
+			})}
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/authn", bytes.NewBufferString(`{"spec":{"token":"token"}}`))
+			req.Header.Set("Content-Type", restful.MIME_JSON)
+			if tt.forwarded != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			w := httptest.NewRecorder()
+			h.AuthenticateToken(restful.NewRequest(req), restful.NewResponse(w))
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+			}
+			if clientIP != tt.clientIP {
+				t.Errorf("expected client ip %q, got %q", tt.clientIP, clientIP)
+			}
+		})
+	}
+}
@@ -19,6 +19,8 @@
 package authz
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 
 	"tkestack.io/tke/pkg/auth/filter"
@@ -27,21 +29,31 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"tkestack.io/tke/api/auth"
 	authv1 "tkestack.io/tke/api/auth/v1"
+	"tkestack.io/tke/pkg/apiserver/authentication"
 	"tkestack.io/tke/pkg/auth/authorization/util"
 	apiserverfilter "tkestack.io/tke/pkg/platform/apiserver/filter"
 
 	"tkestack.io/tke/pkg/util/log"
 )
 
+// Explainer explains how the decision of a subject access review is made.
+type Explainer interface {
+	Explain(ctx context.Context, attr authorizer.Attributes, simulatedGroups []string) (*auth.AuthorizationExplanation, string, error)
+}
+
 // Handler handle permission authorization http request.
 type Handler struct {
-	authorizer authorizer.Authorizer
+	authorizer         authorizer.Authorizer
+	explainer          Explainer
+	privilegedUsername string
 }
 
-// NewHandler creates new authorizer handler object.
-func NewHandler(authz authorizer.Authorizer) *Handler {
-	return &Handler{authz}
+// NewHandler creates new authorizer handler object. Only the platform
+// administrator can explain the decisions of users of other tenants.
+func NewHandler(authz authorizer.Authorizer, explainer Explainer, privilegedUsername string) *Handler {
+	return &Handler{authz, explainer, privilegedUsername}
 }
 
 // Authorize receive a subject access review request and determine the subject access.
@@ -135,3 +147,48 @@ func (h *Handler) BatchAuthorize(request *restful.Request, response *restful.Res
 	log.Info("Receive rest authz request", log.Any("attribute", attributesList), log.Any("response", accessReview.Status))
 	responsewriters.WriteRawJSON(http.StatusOK, accessReview, response.ResponseWriter)
 }
+
+// Explain receive a subject access review request and return the policies and
+// rules matched by the request together with the decision.
+func (h *Handler) Explain(request *restful.Request, response *restful.Response) {
+	accessReview := &authv1.SubjectAccessReview{}
+	if err := request.ReadEntity(accessReview); err != nil {
+		log.Error("read entity failed", log.Err(err))
+		responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest(err.Error()).Status(), response.ResponseWriter)
+		return
+	}
+
+	if errs := util.ValidateSubjectAccessReview(accessReview); len(errs) > 0 {
+		responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest(errs.ToAggregate().Error()).Status(), response.ResponseWriter)
+		return
+	}
+
+	authorizationAttributes := util.AuthorizationAttributesFrom(accessReview.Spec)
+	ctx := request.Request.Context()
+	if !authentication.IsAdministrator(ctx, h.privilegedUsername) {
+		_, tenantID := authentication.UsernameAndTenantID(ctx)
+		if reviewedTenantID := util.TenantIDFrom(authorizationAttributes.User); reviewedTenantID != tenantID {
+			err := fmt.Errorf("forbid to explain the decisions of tenant %q", reviewedTenantID)
+			responsewriters.WriteRawJSON(http.StatusForbidden, errors.NewForbidden(auth.Resource("subjectaccessreviews"), accessReview.Spec.User, err).Status(), response.ResponseWriter)
+			return
+		}
+	}
+	explanation, reason, evaluationErr := h.explainer.Explain(ctx, authorizationAttributes, accessReview.Spec.SimulatedGroups)
+
+	accessReview.Status = authv1.SubjectAccessReviewStatus{
+		Allowed:     explanation.Decision == "Allow",
+		Denied:      explanation.Decision == "Deny",
+		Reason:      reason,
+		Explanation: &authv1.AuthorizationExplanation{},
+	}
+	if err := authv1.Convert_auth_AuthorizationExplanation_To_v1_AuthorizationExplanation(explanation, accessReview.Status.Explanation, nil); err != nil {
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err).Status(), response.ResponseWriter)
+		return
+	}
+	if evaluationErr != nil {
+		accessReview.Status.EvaluationError = evaluationErr.Error()
+	}
+
+	log.Info("Receive authz explain request", log.Any("access review", accessReview))
+	responsewriters.WriteRawJSON(http.StatusOK, accessReview, response.ResponseWriter)
+}
@@ -0,0 +1,96 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package authz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emicklei/go-restful"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"tkestack.io/tke/api/auth"
+	authv1 "tkestack.io/tke/api/auth/v1"
+	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+)
+
+type fakeExplainer struct {
+	explained bool
+}
+
+func (e *fakeExplainer) Explain(ctx context.Context, attr authorizer.Attributes, simulatedGroups []string) (*auth.AuthorizationExplanation, string, error) {
+	e.explained = true
+	return &auth.AuthorizationExplanation{Decision: "Allow"}, "", nil
+}
+
+func TestExplainTenant(t *testing.T) {
+	tenantUser := func(name, tenantID string) user.Info {
+		return &user.DefaultInfo{Name: name, Extra: map[string][]string{genericoidc.TenantIDKey: {tenantID}}}
+	}
+	tests := []struct {
+		name     string
+		caller   user.Info
+		tenantID string
+		groups   []string
+		status   int
+	}{
+		{name: "same tenant", caller: tenantUser("alice", "t1"), tenantID: "t1", status: http.StatusOK},
+		{name: "other tenant", caller: tenantUser("alice", "t1"), tenantID: "t2", status: http.StatusForbidden},
+		{name: "other tenant in groups", caller: tenantUser("alice", "t1"), groups: []string{"tenant:t2"}, status: http.StatusForbidden},
+		{name: "no tenant", caller: tenantUser("alice", "t1"), status: http.StatusForbidden},
+		{name: "tenant administrator named like the platform administrator", caller: tenantUser("admin", "t1"), tenantID: "t2", status: http.StatusForbidden},
+		{name: "platform administrator", caller: &user.DefaultInfo{Name: "admin"}, tenantID: "t2", status: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			explainer := &fakeExplainer{}
+			h := NewHandler(nil, explainer, "admin")
+
+			review := &authv1.SubjectAccessReview{Spec: authv1.SubjectAccessReviewSpec{
+				User:               "bob",
+				Groups:             tt.groups,
+				ResourceAttributes: &authv1.ResourceAttributes{Verb: "getCluster", Resource: "cluster:c1"},
+			}}
+			if tt.tenantID != "" {
+				review.Spec.Extra = map[string]authv1.ExtraValue{genericoidc.TenantIDKey: {tt.tenantID}}
+			}
+			body, err := json.Marshal(review)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req := httptest.NewRequest(http.MethodPost, "/authz/explain", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", restful.MIME_JSON)
+			req = req.WithContext(request.WithUser(req.Context(), tt.caller))
+			w := httptest.NewRecorder()
+			h.Explain(restful.NewRequest(req), restful.NewResponse(w))
+
+			if w.Code != tt.status {
+				t.Fatalf("expected status %d, got %d: %s", tt.status, w.Code, w.Body.String())
+			}
+			if explainer.explained != (tt.status == http.StatusOK) {
+				t.Errorf("unexpected explanation %v", explainer.explained)
+			}
+		})
+	}
+}
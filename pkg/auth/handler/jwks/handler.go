@@ -0,0 +1,60 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package jwks
+
+import (
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+	"gopkg.in/square/go-jose.v2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Handler publishes the public keys api keys are verified with.
+type Handler struct {
+	authClient authinternalclient.AuthInterface
+}
+
+// NewHandler creates new jwks handler object.
+func NewHandler(authClient authinternalclient.AuthInterface) *Handler {
+	return &Handler{authClient}
+}
+
+// ServeJWKS responds the current and the retired public keys not expired yet
+// as a json web key set.
+func (h *Handler) ServeJWKS(request *restful.Request, response *restful.Response) {
+	keys, err := h.authClient.APISigningKeys().Get(request.Request.Context(), util.DefaultAPISigningKey, metav1.GetOptions{})
+	if err != nil {
+		log.Error("Failed to get signing keys", log.Err(err))
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err).Status(), response.ResponseWriter)
+		return
+	}
+
+	pubKeys, err := util.PublicKeys(keys)
+	if err != nil {
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err).Status(), response.ResponseWriter)
+		return
+	}
+	responsewriters.WriteRawJSON(http.StatusOK, jose.JSONWebKeySet{Keys: pubKeys}, response.ResponseWriter)
+}
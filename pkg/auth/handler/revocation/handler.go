@@ -0,0 +1,69 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package revocation
+
+import (
+	"net/http"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	authv1lister "tkestack.io/tke/api/client/listers/auth/v1"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Handler publishes the revoked ID tokens to the OIDC authenticators of the
+// API servers.
+type Handler struct {
+	lister authv1lister.SessionRevocationLister
+}
+
+// NewHandler creates new revocation handler object.
+func NewHandler(lister authv1lister.SessionRevocationLister) *Handler {
+	return &Handler{lister}
+}
+
+// ServeHTTP responds the revocation list of the ID tokens not expired yet.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	revocations, err := h.lister.List(labels.Everything())
+	if err != nil {
+		log.Error("List session revocations failed", log.Err(err))
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err).Status(), w)
+		return
+	}
+
+	now := time.Now()
+	list := oidc.RevocationList{Users: make(map[string]int64)}
+	for _, revocation := range revocations {
+		if revocation.Spec.ExpireTime.Time.Before(now) {
+			continue
+		}
+		if revocation.Spec.SessionID != "" {
+			list.Sessions = append(list.Sessions, oidc.SessionHash(revocation.Spec.SessionID))
+			continue
+		}
+		key := oidc.UserHash(revocation.Spec.TenantID, revocation.Spec.Username)
+		if revokeTime := revocation.Spec.RevokeTime.Unix(); revokeTime > list.Users[key] {
+			list.Users[key] = revokeTime
+		}
+	}
+	responsewriters.WriteRawJSON(http.StatusOK, list, w)
+}
@@ -0,0 +1,98 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/audit"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// reviewAnnotationKey is the audit annotation recorded when an access request
+// is approved or rejected.
+const reviewAnnotationKey = "authorization.auth.tke.com/accessrequest-review"
+
+// ReviewREST implements the REST endpoint for approving or rejecting an
+// access request.
+type ReviewREST struct {
+	store       *registry.Store
+	reviewStore *registry.Store
+	phase       auth.AccessRequestPhase
+}
+
+var _ = rest.NamedCreater(&ReviewREST{})
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *ReviewREST) New() runtime.Object {
+	return &auth.AccessRequestReview{}
+}
+
+// Create approves or rejects the pending access request on behalf of one of
+// its approvers. The permissions of an approved request expire after the
+// requested duration.
+func (r *ReviewREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	accessRequestObj, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	accessRequest := accessRequestObj.(*auth.AccessRequest)
+
+	username, _ := authentication.UsernameAndTenantID(ctx)
+	if !util.InStringSlice(accessRequest.Status.Approvers, username) {
+		return nil, apierrors.NewForbidden(auth.Resource("accessrequests"), name, fmt.Errorf("%s is not an approver of the access request", username))
+	}
+	if username == accessRequest.Spec.Username {
+		return nil, apierrors.NewForbidden(auth.Resource("accessrequests"), name, fmt.Errorf("forbid to review own access request"))
+	}
+	if accessRequest.Status.Phase != auth.AccessRequestPending {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("access request is %s", accessRequest.Status.Phase))
+	}
+
+	review := obj.(*auth.AccessRequestReview)
+	now := time.Now()
+	accessRequest.Status.Phase = r.phase
+	accessRequest.Status.Reviewer = username
+	accessRequest.Status.ReviewMessage = review.Message
+	accessRequest.Status.ReviewTime = metav1.NewTime(now)
+	if r.phase == auth.AccessRequestApproved {
+		accessRequest.Status.ExpireTime = metav1.NewTime(now.Add(accessRequest.Spec.Duration.Duration))
+	}
+
+	updated, _, err := r.reviewStore.Update(ctx, name, rest.DefaultUpdatedObjectInfo(accessRequest), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	audit.AddAuditAnnotation(ctx, reviewAnnotationKey, fmt.Sprintf("%s by %s", r.phase, username))
+	log.Info("Access request reviewed", log.String("accessRequest", name), log.String("user", accessRequest.Spec.Username),
+		log.String("phase", string(r.phase)), log.String("reviewer", username))
+	return updated, nil
+}
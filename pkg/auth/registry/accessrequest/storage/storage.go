@@ -0,0 +1,186 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
+	"tkestack.io/tke/pkg/auth/registry/accessrequest"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Storage includes storage for access requests and all sub resources.
+type Storage struct {
+	AccessRequest *REST
+	Approve       *ReviewREST
+	Reject        *ReviewREST
+	Status        *StatusREST
+}
+
+// NewStorage returns a Storage object that will work against access requests.
+func NewStorage(optsGetter generic.RESTOptionsGetter, authClient authinternalclient.AuthInterface) *Storage {
+	strategy := accessrequest.NewStrategy(authClient)
+	store := &registry.Store{
+		NewFunc:                  func() runtime.Object { return &auth.AccessRequest{} },
+		NewListFunc:              func() runtime.Object { return &auth.AccessRequestList{} },
+		DefaultQualifiedResource: auth.Resource("accessrequests"),
+		PredicateFunc:            accessrequest.MatchAccessRequest,
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+	}
+	store.TableConvertor = rest.NewDefaultTableConvertor(store.DefaultQualifiedResource)
+	options := &generic.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    accessrequest.GetAttrs,
+	}
+
+	if err := store.CompleteWithOptions(options); err != nil {
+		log.Panic("Failed to create access request etcd rest storage", log.Err(err))
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = accessrequest.NewStatusStrategy(strategy)
+
+	// The review subresources are the only way to approve or reject.
+	reviewStore := *store
+	reviewStore.UpdateStrategy = accessrequest.NewReviewStrategy(strategy)
+
+	return &Storage{
+		AccessRequest: &REST{store},
+		Approve: &ReviewREST{
+			store:       store,
+			reviewStore: &reviewStore,
+			phase:       auth.AccessRequestApproved,
+		},
+		Reject: &ReviewREST{
+			store:       store,
+			reviewStore: &reviewStore,
+			phase:       auth.AccessRequestRejected,
+		},
+		Status: &StatusREST{&statusStore},
+	}
+}
+
+// ValidateGetObjectAndTenantID validate name and tenantID, if success return AccessRequest
+func ValidateGetObjectAndTenantID(ctx context.Context, store *registry.Store, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	o := obj.(*auth.AccessRequest)
+	if err := util.FilterAccessRequest(ctx, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// REST implements a RESTStorage for access requests against etcd.
+type REST struct {
+	*registry.Store
+}
+
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"ar"}
+}
+
+// List selects resources in the storage which match to the selector. 'options' can be nil.
+func (r *REST) List(ctx context.Context, options *metainternal.ListOptions) (runtime.Object, error) {
+	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
+	return r.Store.List(ctx, wrappedOptions)
+}
+
+// Get finds a resource in the storage by name and returns it.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.Store, name, options)
+}
+
+// Update alters the object subset of an object.
+func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// Delete enforces life-cycle rules for access request termination, the
+// granted permissions are revoked before the access request is purged.
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Delete(ctx, name, deleteValidation, options)
+}
+
+// StatusREST implements the REST endpoint for changing the status of an
+// access request.
+type StatusREST struct {
+	store *registry.Store
+}
+
+// StatusREST implements Patcher.
+var _ = rest.Patcher(&StatusREST{})
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *StatusREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+}
+
+// Update alters the status subset of an object. The status is maintained by
+// the system, tenant users approve or reject through the review subresources.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	if _, tenantID := authentication.UsernameAndTenantID(ctx); tenantID != "" {
+		return nil, false, apierrors.NewForbidden(auth.Resource("accessrequests/status"), name, fmt.Errorf("status of access request can not be changed by tenant users"))
+	}
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
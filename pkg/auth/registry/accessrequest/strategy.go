@@ -0,0 +1,222 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/util/log"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for access request.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+
+	authClient authinternalclient.AuthInterface
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating access request objects.
+func NewStrategy(authClient authinternalclient.AuthInterface) *Strategy {
+	return &Strategy{auth.Scheme, namesutil.Generator, authClient}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object.
+func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	oldAccessRequest := old.(*auth.AccessRequest)
+	accessRequest := obj.(*auth.AccessRequest)
+	if len(tenantID) != 0 {
+		if oldAccessRequest.Spec.TenantID != tenantID {
+			log.Panic("Unauthorized update access request information", log.String("oldTenantID", oldAccessRequest.Spec.TenantID), log.String("newTenantID", accessRequest.Spec.TenantID), log.String("userTenantID", tenantID))
+		}
+		accessRequest.Spec.TenantID = tenantID
+	}
+	accessRequest.Status = oldAccessRequest.Status
+}
+
+// NamespaceScoped is false for access requests.
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	accessRequest, _ := obj.(*auth.AccessRequest)
+	username, tenantID := authentication.UsernameAndTenantID(ctx)
+	if len(tenantID) != 0 {
+		accessRequest.Spec.TenantID = tenantID
+	}
+	if len(username) != 0 {
+		accessRequest.Spec.Username = username
+	}
+
+	accessRequest.ObjectMeta.GenerateName = "ar-"
+	accessRequest.ObjectMeta.Name = ""
+	accessRequest.ObjectMeta.Finalizers = []string{string(auth.AccessRequestFinalize)}
+
+	accessRequest.Status = auth.AccessRequestStatus{
+		Phase: auth.AccessRequestPending,
+	}
+}
+
+// Validate validates a new access request.
+func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ValidateAccessRequest(ctx, obj.(*auth.AccessRequest), s.authClient)
+}
+
+// AllowCreateOnUpdate is false for access requests.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end access request.
+func (Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateAccessRequestUpdate(obj.(*auth.AccessRequest), old.(*auth.AccessRequest))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	accessRequest, ok := obj.(*auth.AccessRequest)
+	if !ok {
+		return nil, nil, fmt.Errorf("not an access request")
+	}
+	return accessRequest.ObjectMeta.Labels, ToSelectableFields(accessRequest), nil
+}
+
+// MatchAccessRequest returns a generic matcher for a given label and field selector.
+func MatchAccessRequest(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"spec.tenantID",
+			"spec.username",
+		},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(accessRequest *auth.AccessRequest) fields.Set {
+	objectMetaFieldsSet := generic.ObjectMetaFieldsSet(&accessRequest.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID":  accessRequest.Spec.TenantID,
+		"spec.username":  accessRequest.Spec.Username,
+		"spec.projectID": accessRequest.Spec.ProjectID,
+		"status.phase":   string(accessRequest.Status.Phase),
+	}
+	return generic.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
+
+// StatusStrategy implements verification logic for status of access request.
+type StatusStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &StatusStrategy{}
+
+// NewStatusStrategy create the StatusStrategy object by given strategy.
+func NewStatusStrategy(strategy *Strategy) *StatusStrategy {
+	return &StatusStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object.  For example: remove fields that are not to be persisted,
+// sort order-insensitive list fields, etc.  This should not remove fields
+// whose presence would be considered a validation error.
+func (StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newAccessRequest := obj.(*auth.AccessRequest)
+	oldAccessRequest := old.(*auth.AccessRequest)
+	newAccessRequest.Spec = oldAccessRequest.Spec
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (StatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateAccessRequestStatusUpdate(obj.(*auth.AccessRequest), old.(*auth.AccessRequest))
+}
+
+// ReviewStrategy implements verification logic for approving or rejecting an
+// access request.
+type ReviewStrategy struct {
+	*StatusStrategy
+}
+
+var _ rest.RESTUpdateStrategy = &ReviewStrategy{}
+
+// NewReviewStrategy create the ReviewStrategy object by given strategy.
+func NewReviewStrategy(strategy *Strategy) *ReviewStrategy {
+	return &ReviewStrategy{NewStatusStrategy(strategy)}
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (ReviewStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateAccessRequestReview(obj.(*auth.AccessRequest), old.(*auth.AccessRequest))
+}
@@ -0,0 +1,184 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package accessrequest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/auth/util"
+	genericutil "tkestack.io/tke/pkg/util"
+)
+
+// ValidateAccessRequest tests if required fields in the access request are
+// set, and fills in the approvers of the tenant.
+func ValidateAccessRequest(ctx context.Context, accessRequest *auth.AccessRequest, authClient authinternalclient.AuthInterface) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&accessRequest.ObjectMeta, false, apiMachineryValidation.NameIsDNSLabel, field.NewPath("metadata"))
+
+	fldSpecPath := field.NewPath("spec")
+	if accessRequest.Spec.TenantID == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("tenantID"), "must specify tenantID"))
+	}
+	if accessRequest.Spec.Username == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("username"), "must specify username"))
+	}
+	if accessRequest.Spec.Reason == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("reason"), "must specify the reason"))
+	}
+	if len(accessRequest.Spec.Policies) == 0 && accessRequest.Spec.RoleID == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("policies"), "must specify policies or roleID"))
+	}
+
+	for i, policyID := range accessRequest.Spec.Policies {
+		fldPath := fldSpecPath.Child("policies").Index(i)
+		policy, err := authClient.Policies().Get(ctx, policyID, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				allErrs = append(allErrs, field.NotFound(fldPath, policyID))
+			} else {
+				allErrs = append(allErrs, field.InternalError(fldPath, err))
+			}
+			continue
+		}
+		if policy.Spec.TenantID != accessRequest.Spec.TenantID {
+			allErrs = append(allErrs, field.Invalid(fldPath, policyID, "must in the same tenant with the access request"))
+		}
+		if accessRequest.Spec.ProjectID != "" && policy.Spec.Scope != auth.PolicyProject {
+			allErrs = append(allErrs, field.Invalid(fldPath, policyID, "policy must be project scope"))
+		}
+		if accessRequest.Spec.ProjectID == "" && policy.Spec.Scope == auth.PolicyProject {
+			allErrs = append(allErrs, field.Invalid(fldPath, policyID, "policy must be platform scope"))
+		}
+	}
+
+	if accessRequest.Spec.RoleID != "" {
+		role, err := authClient.Roles().Get(ctx, accessRequest.Spec.RoleID, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				allErrs = append(allErrs, field.NotFound(fldSpecPath.Child("roleID"), accessRequest.Spec.RoleID))
+			} else {
+				allErrs = append(allErrs, field.InternalError(fldSpecPath.Child("roleID"), err))
+			}
+		} else if role.Spec.TenantID != accessRequest.Spec.TenantID {
+			allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("roleID"), accessRequest.Spec.RoleID, "must in the same tenant with the access request"))
+		}
+	}
+
+	arConfig, err := util.GetAccessRequestConfig(ctx, authClient, accessRequest.Spec.TenantID)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(fldSpecPath.Child("tenantID"), err))
+		return allErrs
+	}
+	if accessRequest.Spec.Duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("duration"), accessRequest.Spec.Duration.Duration.String(), "must be positive"))
+	} else if accessRequest.Spec.Duration.Duration > arConfig.MaxDuration.Duration {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("duration"), accessRequest.Spec.Duration.Duration.String(), fmt.Sprintf("must not exceed %s", arConfig.MaxDuration.Duration)))
+	}
+
+	var approvers []string
+	for _, approver := range arConfig.Approvers {
+		if approver != accessRequest.Spec.Username {
+			approvers = append(approvers, approver)
+		}
+	}
+	if len(approvers) == 0 {
+		allErrs = append(allErrs, field.Forbidden(fldSpecPath.Child("username"), "no approver other than the requester is configured for the tenant"))
+	}
+	accessRequest.Status.Approvers = approvers
+
+	return allErrs
+}
+
+// ValidateAccessRequestUpdate tests if the spec of the access request is
+// unchanged during an update.
+func ValidateAccessRequestUpdate(accessRequest *auth.AccessRequest, old *auth.AccessRequest) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&accessRequest.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	if !reflect.DeepEqual(accessRequest.Spec, old.Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "disallowed change the access request"))
+	}
+	return allErrs
+}
+
+// ValidateAccessRequestStatusUpdate tests if only the fields maintained by
+// the controller are changed during a status update. Access requests are
+// approved or rejected through the review subresources only, and the
+// approved ones can only expire.
+func ValidateAccessRequestStatusUpdate(accessRequest *auth.AccessRequest, old *auth.AccessRequest) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&accessRequest.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+
+	fldStatusPath := field.NewPath("status")
+	if accessRequest.Status.Phase != old.Status.Phase &&
+		(old.Status.Phase != auth.AccessRequestApproved || accessRequest.Status.Phase != auth.AccessRequestExpired) {
+		allErrs = append(allErrs, field.Forbidden(fldStatusPath.Child("phase"), fmt.Sprintf("%s access request can not be changed to %s, approve or reject it through the review subresources", old.Status.Phase, accessRequest.Status.Phase)))
+	}
+	allErrs = append(allErrs, validateReviewUnchanged(accessRequest, old, fldStatusPath)...)
+	if !accessRequest.Status.ExpireTime.Equal(&old.Status.ExpireTime) {
+		allErrs = append(allErrs, field.Forbidden(fldStatusPath.Child("expireTime"), "disallowed change the expire time"))
+	}
+	return allErrs
+}
+
+// ValidateAccessRequestReview tests if the pending access request is approved
+// or rejected by one of its approvers.
+func ValidateAccessRequestReview(accessRequest *auth.AccessRequest, old *auth.AccessRequest) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&accessRequest.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+
+	fldStatusPath := field.NewPath("status")
+	if old.Status.Phase != auth.AccessRequestPending {
+		allErrs = append(allErrs, field.Forbidden(fldStatusPath.Child("phase"), fmt.Sprintf("%s access request can not be reviewed", old.Status.Phase)))
+	}
+	switch accessRequest.Status.Phase {
+	case auth.AccessRequestApproved:
+		if !accessRequest.Status.ExpireTime.After(accessRequest.Status.ReviewTime.Time) {
+			allErrs = append(allErrs, field.Invalid(fldStatusPath.Child("expireTime"), accessRequest.Status.ExpireTime, "must be after the review time"))
+		}
+	case auth.AccessRequestRejected:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldStatusPath.Child("phase"), accessRequest.Status.Phase, []string{string(auth.AccessRequestApproved), string(auth.AccessRequestRejected)}))
+	}
+	if !genericutil.InStringSlice(old.Status.Approvers, accessRequest.Status.Reviewer) {
+		allErrs = append(allErrs, field.Forbidden(fldStatusPath.Child("reviewer"), "must be an approver of the access request"))
+	}
+	if !reflect.DeepEqual(accessRequest.Status.Approvers, old.Status.Approvers) {
+		allErrs = append(allErrs, field.Forbidden(fldStatusPath.Child("approvers"), "disallowed change the approvers"))
+	}
+	return allErrs
+}
+
+// validateReviewUnchanged tests if the approvers and the review result are
+// unchanged.
+func validateReviewUnchanged(accessRequest *auth.AccessRequest, old *auth.AccessRequest, fldStatusPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !reflect.DeepEqual(accessRequest.Status.Approvers, old.Status.Approvers) {
+		allErrs = append(allErrs, field.Forbidden(fldStatusPath.Child("approvers"), "disallowed change the approvers"))
+	}
+	if accessRequest.Status.Reviewer != old.Status.Reviewer ||
+		accessRequest.Status.ReviewMessage != old.Status.ReviewMessage ||
+		!accessRequest.Status.ReviewTime.Equal(&old.Status.ReviewTime) {
+		allErrs = append(allErrs, field.Forbidden(fldStatusPath.Child("reviewer"), "disallowed change the review result"))
+	}
+	return allErrs
+}
@@ -0,0 +1,130 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package accessrequest
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+)
+
+func pendingAccessRequest() *auth.AccessRequest {
+	return &auth.AccessRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "ar-test", ResourceVersion: "1"},
+		Spec: auth.AccessRequestSpec{
+			TenantID: "default",
+			Username: "alice",
+			Duration: metav1.Duration{Duration: time.Hour},
+		},
+		Status: auth.AccessRequestStatus{
+			Phase:     auth.AccessRequestPending,
+			Approvers: []string{"bob"},
+		},
+	}
+}
+
+func TestValidateAccessRequestStatusUpdate(t *testing.T) {
+	now := time.Now()
+	testCases := []struct {
+		name   string
+		old    func(*auth.AccessRequest)
+		update func(*auth.AccessRequest)
+		valid  bool
+	}{
+		{
+			name:   "approve through status",
+			update: func(ar *auth.AccessRequest) { ar.Status.Phase = auth.AccessRequestApproved },
+		},
+		{
+			name: "extend expire time",
+			old: func(ar *auth.AccessRequest) {
+				ar.Status.Phase = auth.AccessRequestApproved
+				ar.Status.ExpireTime = metav1.NewTime(now.Add(time.Hour))
+			},
+			update: func(ar *auth.AccessRequest) { ar.Status.ExpireTime = metav1.NewTime(now.Add(24 * time.Hour)) },
+		},
+		{
+			name:   "add approver",
+			update: func(ar *auth.AccessRequest) { ar.Status.Approvers = append(ar.Status.Approvers, "alice") },
+		},
+		{
+			name:   "set reviewer",
+			update: func(ar *auth.AccessRequest) { ar.Status.Reviewer = "bob" },
+		},
+		{
+			name:   "expire approved",
+			old:    func(ar *auth.AccessRequest) { ar.Status.Phase = auth.AccessRequestApproved },
+			update: func(ar *auth.AccessRequest) { ar.Status.Phase = auth.AccessRequestExpired },
+			valid:  true,
+		},
+		{
+			name:   "record failure message",
+			update: func(ar *auth.AccessRequest) { ar.Status.Message = "grant failed" },
+			valid:  true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			old := pendingAccessRequest()
+			if tc.old != nil {
+				tc.old(old)
+			}
+			ar := old.DeepCopy()
+			tc.update(ar)
+			errs := ValidateAccessRequestStatusUpdate(ar, old)
+			if valid := len(errs) == 0; valid != tc.valid {
+				t.Errorf("valid = %v, want %v, errors: %v", valid, tc.valid, errs)
+			}
+		})
+	}
+}
+
+func TestValidateAccessRequestReview(t *testing.T) {
+	now := time.Now()
+	review := func(ar *auth.AccessRequest, phase auth.AccessRequestPhase, reviewer string) {
+		ar.Status.Phase = phase
+		ar.Status.Reviewer = reviewer
+		ar.Status.ReviewTime = metav1.NewTime(now)
+		if phase == auth.AccessRequestApproved {
+			ar.Status.ExpireTime = metav1.NewTime(now.Add(ar.Spec.Duration.Duration))
+		}
+	}
+
+	old := pendingAccessRequest()
+	ar := old.DeepCopy()
+	review(ar, auth.AccessRequestApproved, "bob")
+	if errs := ValidateAccessRequestReview(ar, old); len(errs) != 0 {
+		t.Errorf("expected approval by an approver valid, got %v", errs)
+	}
+
+	ar = old.DeepCopy()
+	review(ar, auth.AccessRequestApproved, "alice")
+	if errs := ValidateAccessRequestReview(ar, old); len(errs) == 0 {
+		t.Errorf("expected approval by a non approver invalid")
+	}
+
+	old.Status.Phase = auth.AccessRequestRejected
+	ar = old.DeepCopy()
+	review(ar, auth.AccessRequestApproved, "bob")
+	if errs := ValidateAccessRequestReview(ar, old); len(errs) == 0 {
+		t.Errorf("expected approval of a rejected access request invalid")
+	}
+}
@@ -0,0 +1,302 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package accessreview
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/casbin/casbin/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Kinds of the subjects of an access review.
+const (
+	SubjectUser  = "User"
+	SubjectGroup = "Group"
+)
+
+// Resolver resolves the effective permissions of the users and groups from
+// the casbin rules.
+type Resolver struct {
+	authClient authinternalclient.AuthInterface
+	enforcer   *casbin.SyncedEnforcer
+}
+
+// NewResolver creates a resolver and returns it.
+func NewResolver(authClient authinternalclient.AuthInterface, enforcer *casbin.SyncedEnforcer) *Resolver {
+	return &Resolver{
+		authClient: authClient,
+		enforcer:   enforcer,
+	}
+}
+
+// resolution holds the state of resolving a single access review.
+type resolution struct {
+	*Resolver
+
+	tenantID    string
+	projectID   string
+	policyNames map[string]string
+}
+
+// Resolve fills in the status of the access review with every user and group
+// of the tenant. Users inherit the permissions of the groups they belong to,
+// either as listed members or through the casbin links of the group.
+func (r *Resolver) Resolve(ctx context.Context, accessReview *auth.AccessReview) error {
+	res := &resolution{
+		Resolver:    r,
+		tenantID:    accessReview.Spec.TenantID,
+		projectID:   accessReview.Spec.ProjectID,
+		policyNames: make(map[string]string),
+	}
+
+	selector := fields.OneTermEqualSelector("spec.tenantID", res.tenantID).String()
+	users, err := r.authClient.Users().List(ctx, metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return err
+	}
+	groups, err := r.authClient.Groups().List(ctx, metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return err
+	}
+
+	var subjects []auth.AccessReviewSubject
+	groupPermissions := make(map[string][]auth.AccessReviewPermission)
+	memberOf := make(map[string]sets.String)
+	for _, group := range groups.Items {
+		members := sets.NewString()
+		for _, user := range group.Status.Users {
+			members.Insert(user.Name)
+			for _, key := range []string{user.ID, user.Name} {
+				if memberOf[key] == nil {
+					memberOf[key] = sets.NewString()
+				}
+				memberOf[key].Insert(group.Spec.ID)
+			}
+		}
+		permissions := res.permissionsOf(ctx, util.GroupKey(res.tenantID, group.Spec.ID), util.GroupKey(res.tenantID, group.Name))
+		groupPermissions[group.Spec.ID] = permissions
+		subjects = append(subjects, auth.AccessReviewSubject{
+			Kind:        SubjectGroup,
+			ID:          group.Spec.ID,
+			Name:        group.Spec.DisplayName,
+			Members:     members.List(),
+			Permissions: permissions,
+		})
+	}
+
+	allUsers := res.permissionsOf(ctx, util.UserKey(res.tenantID, util.DefaultAll))
+	groupPrefix := util.GroupPrefix(res.tenantID)
+	for _, user := range users.Items {
+		userKey := util.UserKey(res.tenantID, user.Spec.Name)
+		userGroups := sets.NewString()
+		for _, key := range []string{user.Spec.ID, user.Spec.Name} {
+			if memberOf[key] != nil {
+				userGroups = userGroups.Union(memberOf[key])
+			}
+		}
+		for _, role := range r.enforcer.GetRolesForUserInDomain(userKey, util.DefaultDomain) {
+			if strings.HasPrefix(role, groupPrefix) {
+				userGroups.Insert(strings.TrimPrefix(role, groupPrefix))
+			}
+		}
+
+		permissions := res.permissionsOf(ctx, userKey)
+		for _, groupID := range userGroups.List() {
+			inherited, ok := groupPermissions[groupID]
+			if !ok {
+				inherited = res.permissionsOf(ctx, util.GroupKey(res.tenantID, groupID))
+				groupPermissions[groupID] = inherited
+			}
+			permissions = append(permissions, withGroup(inherited, groupID)...)
+		}
+		permissions = append(permissions, withGroup(allUsers, util.DefaultAll)...)
+
+		subjects = append(subjects, auth.AccessReviewSubject{
+			Kind:        SubjectUser,
+			ID:          user.Spec.ID,
+			Name:        user.Spec.Name,
+			Groups:      userGroups.List(),
+			Permissions: permissions,
+		})
+	}
+
+	accessReview.Status.Subjects = nil
+	for _, subject := range subjects {
+		// A project review only lists the subjects having access to the project.
+		if res.projectID != "" && len(subject.Permissions) == 0 {
+			continue
+		}
+		accessReview.Status.Subjects = append(accessReview.Status.Subjects, subject)
+	}
+	return nil
+}
+
+// permissionsOf returns the permissions granted to the casbin subjects in the
+// scope of the review, roles are expanded to the policies they contain.
+func (r *resolution) permissionsOf(ctx context.Context, keys ...string) []auth.AccessReviewPermission {
+	var permissions []auth.AccessReviewPermission
+	seen := sets.NewString()
+	add := func(project, policy, role string) {
+		key := strings.Join([]string{project, policy, role}, "/")
+		if seen.Has(key) {
+			return
+		}
+		seen.Insert(key)
+		permissions = append(permissions, r.permission(ctx, project, policy, role)...)
+	}
+
+	groupPrefix := util.GroupPrefix(r.tenantID)
+	for _, key := range sets.NewString(keys...).List() {
+		for _, grouping := range r.enforcer.GetFilteredGroupingPolicy(0, key) {
+			if len(grouping) < 3 || strings.HasPrefix(grouping[1], groupPrefix) || !r.inScope(grouping[2]) {
+				continue
+			}
+			target, project := grouping[1], projectOf(grouping[2])
+			if len(r.enforcer.GetFilteredPolicy(0, target)) > 0 {
+				add(project, target, "")
+				continue
+			}
+			for _, roleGrouping := range r.enforcer.GetFilteredGroupingPolicy(0, target) {
+				if len(roleGrouping) < 3 || !r.inScope(roleGrouping[2]) {
+					continue
+				}
+				add(project, roleGrouping[1], target)
+			}
+		}
+	}
+
+	sort.SliceStable(permissions, func(i, j int) bool {
+		if permissions[i].Project != permissions[j].Project {
+			return permissions[i].Project < permissions[j].Project
+		}
+		if permissions[i].Policy != permissions[j].Policy {
+			return permissions[i].Policy < permissions[j].Policy
+		}
+		return permissions[i].Role < permissions[j].Role
+	})
+	return permissions
+}
+
+// permission collects the resources and actions of the rules of a policy,
+// one permission for each effect.
+func (r *resolution) permission(ctx context.Context, project, policy, role string) []auth.AccessReviewPermission {
+	resources := make(map[auth.Effect]sets.String)
+	actions := make(map[auth.Effect]sets.String)
+	for _, rule := range r.enforcer.GetFilteredPolicy(0, policy) {
+		if len(rule) < 5 {
+			continue
+		}
+		effect := auth.Effect(rule[4])
+		if resources[effect] == nil {
+			resources[effect] = sets.NewString()
+			actions[effect] = sets.NewString()
+		}
+		resources[effect].Insert(rule[2])
+		actions[effect].Insert(rule[3])
+	}
+
+	var permissions []auth.AccessReviewPermission
+	for _, effect := range []auth.Effect{auth.Allow, auth.Deny} {
+		if resources[effect] == nil {
+			continue
+		}
+		permissions = append(permissions, auth.AccessReviewPermission{
+			Project:    project,
+			Policy:     policy,
+			PolicyName: r.policyName(ctx, policy),
+			Role:       role,
+			Effect:     effect,
+			Resources:  resources[effect].List(),
+			Actions:    actions[effect].List(),
+		})
+	}
+	return permissions
+}
+
+// policyName returns the display name of the policy, it is empty for the
+// rules of custom policy bindings.
+func (r *resolution) policyName(ctx context.Context, policyID string) string {
+	if name, ok := r.policyNames[policyID]; ok {
+		return name
+	}
+	var name string
+	policy, err := r.authClient.Policies().Get(ctx, policyID, metav1.GetOptions{})
+	if err == nil {
+		name = policy.Spec.DisplayName
+	} else {
+		log.Debug("Get policy for access review failed", log.String("policy", policyID), log.Err(err))
+	}
+	r.policyNames[policyID] = name
+	return name
+}
+
+func (r *resolution) inScope(domain string) bool {
+	return r.projectID == "" || domain == r.projectID || domain == util.DefaultDomain
+}
+
+func projectOf(domain string) string {
+	if domain == util.DefaultDomain {
+		return ""
+	}
+	return domain
+}
+
+func withGroup(permissions []auth.AccessReviewPermission, group string) []auth.AccessReviewPermission {
+	inherited := make([]auth.AccessReviewPermission, 0, len(permissions))
+	for _, permission := range permissions {
+		permission.Group = group
+		inherited = append(inherited, permission)
+	}
+	return inherited
+}
+
+// Entries flattens the access review into a permission per subject, in the
+// order of the subjects.
+func Entries(accessReview *auth.AccessReview) []auth.AccessReviewEntry {
+	var entries []auth.AccessReviewEntry
+	for _, subject := range accessReview.Status.Subjects {
+		name := subject.Name
+		if subject.Kind == SubjectGroup {
+			name = subject.ID
+		}
+		for _, permission := range subject.Permissions {
+			entries = append(entries, auth.AccessReviewEntry{
+				SubjectKind: subject.Kind,
+				Subject:     name,
+				Permission:  permission,
+			})
+		}
+	}
+	return entries
+}
+
+// EntryKey identifies a permission of a subject when comparing access reviews.
+func EntryKey(entry *auth.AccessReviewEntry) string {
+	p := entry.Permission
+	return strings.Join([]string{entry.SubjectKind, entry.Subject, p.Project, p.Policy, p.Role, p.Group, string(p.Effect)}, "/")
+}
@@ -0,0 +1,136 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package accessreview
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/api/client/clientset/internalversion/fake"
+	"tkestack.io/tke/pkg/auth/util"
+)
+
+func newTestResolver(t *testing.T) *Resolver {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset().Auth()
+	for _, user := range []string{"alice", "bob", "carol"} {
+		if _, err := client.Users().Create(ctx, &auth.User{
+			ObjectMeta: metav1.ObjectMeta{Name: "usr-" + user},
+			Spec:       auth.UserSpec{ID: "usr-" + user, Name: user, TenantID: "default"},
+		}, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := client.Groups().Create(ctx, &auth.Group{
+		ObjectMeta: metav1.ObjectMeta{Name: "grp-dev"},
+		Spec:       auth.GroupSpec{ID: "grp-dev", DisplayName: "dev", TenantID: "default"},
+		Status:     auth.GroupStatus{Users: []auth.Subject{{ID: "usr-alice", Name: "alice"}}},
+	}, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Policies().Create(ctx, &auth.Policy{
+		ObjectMeta: metav1.ObjectMeta{Name: "pol-view"},
+		Spec:       auth.PolicySpec{DisplayName: "View", TenantID: "default"},
+	}, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := model.NewModelFromString(auth.DefaultRuleModel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enforcer, err := casbin.NewSyncedEnforcer(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enforcer.SetRoleManager(util.NewRoleManager(10))
+	_, _ = enforcer.AddPolicy("pol-view", util.DefaultDomain, "cluster:*", "get*", "allow")
+	_, _ = enforcer.AddPolicy("pol-view", util.DefaultDomain, "cluster:cls-prod", "get*", "deny")
+	_, _ = enforcer.AddPolicy("pol-edit", util.DefaultDomain, "namespace:*", "*", "allow")
+	_, _ = enforcer.AddGroupingPolicy(util.UserKey("default", "alice"), "pol-view", util.DefaultDomain)
+	_, _ = enforcer.AddGroupingPolicy(util.GroupKey("default", "grp-dev"), "pol-edit", "prj-1")
+	_, _ = enforcer.AddGroupingPolicy(util.UserKey("default", "bob"), "rol-viewer", util.DefaultDomain)
+	_, _ = enforcer.AddGroupingPolicy("rol-viewer", "pol-view", util.DefaultDomain)
+	_, _ = enforcer.AddGroupingPolicy(util.UserKey("default", "carol"), "pol-edit", "prj-2")
+	return NewResolver(client, enforcer)
+}
+
+func subjectPermissions(accessReview *auth.AccessReview) map[string][]string {
+	permissions := map[string][]string{}
+	for _, subject := range accessReview.Status.Subjects {
+		name := subject.Kind + ":" + subject.Name
+		permissions[name] = []string{}
+		for _, p := range subject.Permissions {
+			permissions[name] = append(permissions[name], p.Project+"/"+p.Policy+"/"+p.Role+"/"+p.Group+"/"+string(p.Effect))
+		}
+	}
+	return permissions
+}
+
+func TestResolve(t *testing.T) {
+	resolver := newTestResolver(t)
+	accessReview := &auth.AccessReview{Spec: auth.AccessReviewSpec{TenantID: "default"}}
+	if err := resolver.Resolve(context.Background(), accessReview); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string][]string{
+		"Group:dev":  {"prj-1/pol-edit///allow"},
+		"User:alice": {"/pol-view///allow", "/pol-view///deny", "prj-1/pol-edit//grp-dev/allow"},
+		"User:bob":   {"/pol-view/rol-viewer//allow", "/pol-view/rol-viewer//deny"},
+		"User:carol": {"prj-2/pol-edit///allow"},
+	}
+	if permissions := subjectPermissions(accessReview); !reflect.DeepEqual(permissions, expected) {
+		t.Errorf("expected permissions %v, got %v", expected, permissions)
+	}
+	for _, subject := range accessReview.Status.Subjects {
+		if subject.Name == "alice" {
+			if !reflect.DeepEqual(subject.Groups, []string{"grp-dev"}) {
+				t.Errorf("expected alice in grp-dev, got %v", subject.Groups)
+			}
+			if subject.Permissions[0].PolicyName != "View" || !reflect.DeepEqual(subject.Permissions[0].Resources, []string{"cluster:*"}) {
+				t.Errorf("unexpected permission %+v", subject.Permissions[0])
+			}
+		}
+	}
+}
+
+func TestResolveProject(t *testing.T) {
+	resolver := newTestResolver(t)
+	accessReview := &auth.AccessReview{Spec: auth.AccessReviewSpec{TenantID: "default", ProjectID: "prj-2"}}
+	if err := resolver.Resolve(context.Background(), accessReview); err != nil {
+		t.Fatal(err)
+	}
+
+	// the group has no permission in the project, and the permissions
+	// granted in other projects are left out
+	expected := map[string][]string{
+		"User:alice": {"/pol-view///allow", "/pol-view///deny"},
+		"User:bob":   {"/pol-view/rol-viewer//allow", "/pol-view/rol-viewer//deny"},
+		"User:carol": {"prj-2/pol-edit///allow"},
+	}
+	if permissions := subjectPermissions(accessReview); !reflect.DeepEqual(permissions, expected) {
+		t.Errorf("expected permissions %v, got %v", expected, permissions)
+	}
+}
@@ -0,0 +1,110 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/auth/registry/accessreview"
+)
+
+// DiffREST implements the REST endpoint for comparing an access review with
+// an earlier one.
+type DiffREST struct {
+	rest.Storage
+	store *registry.Store
+}
+
+var _ = rest.GetterWithOptions(&DiffREST{})
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *DiffREST) New() runtime.Object {
+	return &auth.AccessReviewDiff{}
+}
+
+// NewGetOptions returns versioned resource that represents proxy parameters
+func (r *DiffREST) NewGetOptions() (runtime.Object, bool, string) {
+	return &auth.AccessReviewDiffOptions{}, false, ""
+}
+
+// Get returns the permissions added and removed since the base access review.
+func (r *DiffREST) Get(ctx context.Context, name string, options runtime.Object) (runtime.Object, error) {
+	opts := options.(*auth.AccessReviewDiffOptions)
+	if opts.Base == "" {
+		return nil, errors.NewBadRequest("must specify the base access review")
+	}
+
+	target, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	base, err := ValidateGetObjectAndTenantID(ctx, r.store, opts.Base, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if base.Spec.TenantID != target.Spec.TenantID || base.Spec.ProjectID != target.Spec.ProjectID {
+		return nil, errors.NewBadRequest("access reviews of different scopes can not be compared")
+	}
+
+	return diffAccessReviews(base, target), nil
+}
+
+// diffAccessReviews returns the permissions of the target access review not
+// in the base one as added, and those of the base one not in the target one
+// as removed. A changed permission is both removed and added.
+func diffAccessReviews(base, target *auth.AccessReview) *auth.AccessReviewDiff {
+	baseEntries := entryMap(accessreview.Entries(base))
+	targetEntries := accessreview.Entries(target)
+	diff := &auth.AccessReviewDiff{
+		Base:   base.Name,
+		Target: target.Name,
+	}
+	for _, entry := range targetEntries {
+		key := accessreview.EntryKey(&entry)
+		if old, ok := baseEntries[key]; ok && reflect.DeepEqual(old, entry) {
+			continue
+		}
+		diff.Added = append(diff.Added, entry)
+	}
+	targetMap := entryMap(targetEntries)
+	for _, entry := range accessreview.Entries(base) {
+		key := accessreview.EntryKey(&entry)
+		if current, ok := targetMap[key]; ok && reflect.DeepEqual(current, entry) {
+			continue
+		}
+		diff.Removed = append(diff.Removed, entry)
+	}
+	return diff
+}
+
+func entryMap(entries []auth.AccessReviewEntry) map[string]auth.AccessReviewEntry {
+	m := make(map[string]auth.AccessReviewEntry, len(entries))
+	for _, entry := range entries {
+		m[accessreview.EntryKey(&entry)] = entry
+	}
+	return m
+}
@@ -0,0 +1,73 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+)
+
+func TestDiffAccessReviews(t *testing.T) {
+	view := auth.AccessReviewPermission{Policy: "pol-view", Effect: auth.Allow, Resources: []string{"cluster:*"}, Actions: []string{"get*"}}
+	edit := auth.AccessReviewPermission{Project: "prj-1", Policy: "pol-edit", Effect: auth.Allow, Resources: []string{"namespace:*"}, Actions: []string{"*"}}
+	widened := view
+	widened.Actions = []string{"*"}
+
+	base := &auth.AccessReview{
+		ObjectMeta: metav1.ObjectMeta{Name: "acr-base"},
+		Status: auth.AccessReviewStatus{Subjects: []auth.AccessReviewSubject{
+			{Kind: "User", Name: "alice", Permissions: []auth.AccessReviewPermission{view}},
+			{Kind: "User", Name: "bob", Permissions: []auth.AccessReviewPermission{view, edit}},
+		}},
+	}
+	target := &auth.AccessReview{
+		ObjectMeta: metav1.ObjectMeta{Name: "acr-target"},
+		Status: auth.AccessReviewStatus{Subjects: []auth.AccessReviewSubject{
+			{Kind: "User", Name: "alice", Permissions: []auth.AccessReviewPermission{widened}},
+			{Kind: "User", Name: "bob", Permissions: []auth.AccessReviewPermission{view}},
+			{Kind: "Group", ID: "grp-dev", Name: "dev", Permissions: []auth.AccessReviewPermission{edit}},
+		}},
+	}
+
+	diff := diffAccessReviews(base, target)
+	if diff.Base != "acr-base" || diff.Target != "acr-target" {
+		t.Errorf("unexpected access reviews %s and %s", diff.Base, diff.Target)
+	}
+	var added, removed []string
+	for _, entry := range diff.Added {
+		added = append(added, entry.Subject+"/"+entry.Permission.Policy)
+	}
+	for _, entry := range diff.Removed {
+		removed = append(removed, entry.Subject+"/"+entry.Permission.Policy)
+	}
+	// the changed permission of alice is both removed and added, and groups
+	// are identified by their id
+	if len(added) != 2 || added[0] != "alice/pol-view" || added[1] != "grp-dev/pol-edit" {
+		t.Errorf("unexpected added permissions %v", added)
+	}
+	if len(removed) != 2 || removed[0] != "alice/pol-view" || removed[1] != "bob/pol-edit" {
+		t.Errorf("unexpected removed permissions %v", removed)
+	}
+
+	if diff := diffAccessReviews(base, base); len(diff.Added) != 0 || len(diff.Removed) != 0 {
+		t.Errorf("expected no difference, got %+v", diff)
+	}
+}
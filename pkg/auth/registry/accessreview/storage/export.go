@@ -0,0 +1,140 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/auth/registry/accessreview"
+)
+
+// Formats the access review can be exported as.
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+var csvHeader = []string{"subjectKind", "subject", "project", "policy", "policyName", "role", "group", "effect", "resources", "actions"}
+
+// ExportREST implements the REST endpoint for exporting an access review as
+// a table of permissions.
+type ExportREST struct {
+	rest.Storage
+	store *registry.Store
+}
+
+var _ = rest.GetterWithOptions(&ExportREST{})
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *ExportREST) New() runtime.Object {
+	return &auth.AccessReview{}
+}
+
+// NewGetOptions returns versioned resource that represents proxy parameters
+func (r *ExportREST) NewGetOptions() (runtime.Object, bool, string) {
+	return &auth.AccessReviewExportOptions{}, false, ""
+}
+
+// Get retrieves the access review and streams its permissions in the given format.
+func (r *ExportREST) Get(ctx context.Context, name string, options runtime.Object) (runtime.Object, error) {
+	opts := options.(*auth.AccessReviewExportOptions)
+	if opts.Format == "" {
+		opts.Format = FormatJSON
+	}
+	if opts.Format != FormatJSON && opts.Format != FormatCSV {
+		return nil, errors.NewBadRequest(fmt.Sprintf("unsupported format %q, must be %s or %s", opts.Format, FormatJSON, FormatCSV))
+	}
+
+	accessReview, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &entryStreamer{
+		format:  opts.Format,
+		entries: accessreview.Entries(accessReview),
+	}, nil
+}
+
+// entryStreamer streams the permissions of an access review.
+type entryStreamer struct {
+	format  string
+	entries []auth.AccessReviewEntry
+}
+
+// an entryStreamer must implement a rest.ResourceStreamer
+var _ rest.ResourceStreamer = &entryStreamer{}
+
+// GetObjectKind return an Object must provide to the Scheme allows serializers
+// to set the kind, version, and group the object is represented as.
+func (s *entryStreamer) GetObjectKind() schema.ObjectKind {
+	return schema.EmptyObjectKind
+}
+
+// DeepCopyObject return an Object may choose to return a no-op
+// ObjectKindAccessor in cases where it is not expected to be serialized.
+func (s *entryStreamer) DeepCopyObject() runtime.Object {
+	panic("entryStreamer does not implement DeepCopyObject")
+}
+
+// InputStream returns a stream with the permissions encoded in the format.
+func (s *entryStreamer) InputStream(ctx context.Context, apiVersion, acceptHeader string) (stream io.ReadCloser, flush bool, contentType string, err error) {
+	buf := &bytes.Buffer{}
+	if s.format == FormatCSV {
+		w := csv.NewWriter(buf)
+		if err := w.Write(csvHeader); err != nil {
+			return nil, false, "", err
+		}
+		for _, entry := range s.entries {
+			p := entry.Permission
+			record := []string{entry.SubjectKind, entry.Subject, p.Project, p.Policy, p.PolicyName, p.Role, p.Group, string(p.Effect), strings.Join(p.Resources, ";"), strings.Join(p.Actions, ";")}
+			if err := w.Write(record); err != nil {
+				return nil, false, "", err
+			}
+		}
+		w.Flush()
+		if err := w.Error(); err != nil {
+			return nil, false, "", err
+		}
+		return ioutil.NopCloser(buf), false, "text/csv", nil
+	}
+
+	entries := s.entries
+	if entries == nil {
+		entries = []auth.AccessReviewEntry{}
+	}
+	if err := json.NewEncoder(buf).Encode(entries); err != nil {
+		return nil, false, "", err
+	}
+	return ioutil.NopCloser(buf), false, "application/json", nil
+}
@@ -0,0 +1,60 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"tkestack.io/tke/api/auth"
+)
+
+func TestExportEntries(t *testing.T) {
+	entries := []auth.AccessReviewEntry{{
+		SubjectKind: "User",
+		Subject:     "alice",
+		Permission: auth.AccessReviewPermission{
+			Policy:    "pol-view",
+			Effect:    auth.Allow,
+			Resources: []string{"cluster:a", "cluster:b"},
+			Actions:   []string{"get*"},
+		},
+	}}
+	tests := []struct {
+		format      string
+		entries     []auth.AccessReviewEntry
+		contentType string
+		body        string
+	}{
+		{FormatCSV, entries, "text/csv", "subjectKind,subject,project,policy,policyName,role,group,effect,resources,actions\nUser,alice,,pol-view,,,,allow,cluster:a;cluster:b,get*\n"},
+		{FormatJSON, nil, "application/json", "[]\n"},
+	}
+	for _, tt := range tests {
+		s := &entryStreamer{format: tt.format, entries: tt.entries}
+		stream, _, contentType, err := s.InputStream(context.Background(), "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(stream)
+		if contentType != tt.contentType || string(body) != tt.body {
+			t.Errorf("unexpected %s export %q: %q", tt.format, contentType, body)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/casbin/casbin/v2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
+	"tkestack.io/tke/pkg/auth/registry/accessreview"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// maxSnapshotSize is the largest encoded size of the permissions of an access
+// review, below the request size etcd accepts by default.
+var maxSnapshotSize = 1 << 20
+
+// Storage includes storage for access reviews and all sub resources.
+type Storage struct {
+	AccessReview *REST
+	Export       *ExportREST
+	Diff         *DiffREST
+}
+
+// NewStorage returns a Storage object that will work against access reviews.
+func NewStorage(optsGetter generic.RESTOptionsGetter, authClient authinternalclient.AuthInterface, enforcer *casbin.SyncedEnforcer) *Storage {
+	strategy := accessreview.NewStrategy()
+	store := &registry.Store{
+		NewFunc:                  func() runtime.Object { return &auth.AccessReview{} },
+		NewListFunc:              func() runtime.Object { return &auth.AccessReviewList{} },
+		DefaultQualifiedResource: auth.Resource("accessreviews"),
+		PredicateFunc:            accessreview.MatchAccessReview,
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+	}
+	store.TableConvertor = rest.NewDefaultTableConvertor(store.DefaultQualifiedResource)
+	options := &generic.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    accessreview.GetAttrs,
+	}
+
+	if err := store.CompleteWithOptions(options); err != nil {
+		log.Panic("Failed to create access review etcd rest storage", log.Err(err))
+	}
+
+	return &Storage{
+		AccessReview: &REST{
+			Store:      store,
+			authClient: authClient,
+			resolver:   accessreview.NewResolver(authClient, enforcer),
+		},
+		Export: &ExportREST{store: store},
+		Diff:   &DiffREST{store: store},
+	}
+}
+
+// ValidateGetObjectAndTenantID validate name and tenantID, if success return AccessReview
+func ValidateGetObjectAndTenantID(ctx context.Context, store *registry.Store, name string, options *metav1.GetOptions) (*auth.AccessReview, error) {
+	obj, err := store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	o := obj.(*auth.AccessReview)
+	if err := util.FilterAccessReview(ctx, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// REST implements a RESTStorage for access reviews against etcd.
+type REST struct {
+	*registry.Store
+
+	authClient authinternalclient.AuthInterface
+	resolver   *accessreview.Resolver
+}
+
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"acr"}
+}
+
+// Create resolves the effective permissions in the scope of the access review
+// and stores them as a snapshot.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	accessReview := obj.(*auth.AccessReview)
+	if _, tenantID := authentication.UsernameAndTenantID(ctx); tenantID != "" {
+		accessReview.Spec.TenantID = tenantID
+	}
+	if accessReview.Spec.TenantID == "" {
+		return nil, errors.NewBadRequest("must specify tenantID")
+	}
+	if accessReview.Spec.ProjectID != "" {
+		if err := r.validateProject(ctx, accessReview.Spec.TenantID, accessReview.Spec.ProjectID); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.resolver.Resolve(ctx, accessReview); err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	if err := checkSnapshotSize(accessReview); err != nil {
+		return nil, err
+	}
+	return r.Store.Create(ctx, accessReview, createValidation, options)
+}
+
+// validateProject returns an error if the project belongs to another tenant,
+// the tenant of a project is the one of its policy bindings.
+func (r *REST) validateProject(ctx context.Context, tenantID, projectID string) error {
+	bindings, err := r.authClient.ProjectPolicyBindings().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.projectID", projectID).String(),
+	})
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	for _, binding := range bindings.Items {
+		if binding.Spec.ProjectID == projectID && binding.Spec.TenantID != tenantID {
+			return errors.NewForbidden(auth.Resource("accessreviews"), "", fmt.Errorf("project %s does not belong to tenant %s", projectID, tenantID))
+		}
+	}
+	return nil
+}
+
+// checkSnapshotSize rejects the access reviews too large to be stored, the
+// permissions of large tenants should be reviewed project by project.
+func checkSnapshotSize(accessReview *auth.AccessReview) error {
+	data, err := json.Marshal(accessReview.Status)
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	if len(data) > maxSnapshotSize {
+		return errors.NewRequestEntityTooLargeError(fmt.Sprintf("the permissions of %d subjects exceed %d bytes, review a project instead",
+			len(accessReview.Status.Subjects), maxSnapshotSize))
+	}
+	return nil
+}
+
+// List selects resources in the storage which match to the selector. 'options' can be nil.
+func (r *REST) List(ctx context.Context, options *metainternal.ListOptions) (runtime.Object, error) {
+	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
+	return r.Store.List(ctx, wrappedOptions)
+}
+
+// Get finds a resource in the storage by name and returns it.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.Store, name, options)
+}
+
+// Update alters the object subset of an object.
+func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// Delete enforces life-cycle rules for access review termination.
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Delete(ctx, name, deleteValidation, options)
+}
@@ -0,0 +1,80 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/api/client/clientset/internalversion/fake"
+	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+)
+
+func TestCreateProjectOfOtherTenant(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset().Auth()
+	if _, err := client.ProjectPolicyBindings().Create(ctx, &auth.ProjectPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "prj-b-pol-1"},
+		Spec:       auth.ProjectPolicyBindingSpec{TenantID: "tenant-b", ProjectID: "prj-b", PolicyID: "pol-1"},
+	}, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	r := &REST{authClient: client}
+
+	ctx = request.WithUser(ctx, &user.DefaultInfo{Name: "alice", Extra: map[string][]string{genericoidc.TenantIDKey: {"tenant-a"}}})
+	accessReview := &auth.AccessReview{Spec: auth.AccessReviewSpec{TenantID: "tenant-b", ProjectID: "prj-b"}}
+	if _, err := r.Create(ctx, accessReview, nil, &metav1.CreateOptions{}); !apierrors.IsForbidden(err) {
+		t.Errorf("expected forbidden, got %v", err)
+	}
+	if accessReview.Spec.TenantID != "tenant-a" {
+		t.Errorf("expected the tenant of the user, got %s", accessReview.Spec.TenantID)
+	}
+
+	if err := r.validateProject(context.Background(), "tenant-b", "prj-b"); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err := r.validateProject(context.Background(), "tenant-a", "prj-a"); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestCheckSnapshotSize(t *testing.T) {
+	accessReview := &auth.AccessReview{}
+	for i := 0; i < 100; i++ {
+		accessReview.Status.Subjects = append(accessReview.Status.Subjects, auth.AccessReviewSubject{
+			Kind: "User",
+			Name: strings.Repeat("u", 100),
+		})
+	}
+	if err := checkSnapshotSize(accessReview); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	defer func(size int) { maxSnapshotSize = size }(maxSnapshotSize)
+	maxSnapshotSize = 1000
+	if err := checkSnapshotSize(accessReview); !apierrors.IsRequestEntityTooLargeError(err) {
+		t.Errorf("expected request entity too large, got %v", err)
+	}
+}
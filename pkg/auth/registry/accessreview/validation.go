@@ -0,0 +1,52 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package accessreview
+
+import (
+	"reflect"
+
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/auth"
+)
+
+// ValidateAccessReview tests if required fields in the access review are set.
+func ValidateAccessReview(accessReview *auth.AccessReview) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&accessReview.ObjectMeta, false, apiMachineryValidation.NameIsDNSLabel, field.NewPath("metadata"))
+
+	if accessReview.Spec.TenantID == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "tenantID"), "must specify tenantID"))
+	}
+
+	return allErrs
+}
+
+// ValidateAccessReviewUpdate tests if the access review is unchanged during
+// an update, the snapshot is never modified once taken.
+func ValidateAccessReviewUpdate(accessReview *auth.AccessReview, old *auth.AccessReview) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&accessReview.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	if !reflect.DeepEqual(accessReview.Spec, old.Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "disallowed change the access review"))
+	}
+	if !reflect.DeepEqual(accessReview.Status, old.Status) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("status"), "disallowed change the access review"))
+	}
+
+	return allErrs
+}
@@ -61,6 +61,7 @@ func (r *PasswordREST) Create(ctx context.Context, obj runtime.Object, createVal
 		return nil, apierrors.NewBadRequest(err.Error())
 	}
 	apiKey.Spec.Description = apikeyReq.Description
+	apiKey.Spec.Scope = apikeyReq.Scope
 
 	return r.apiKeyStore.Create(ctx, apiKey, createValidation, options)
 }
@@ -0,0 +1,121 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the “License”); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an “AS IS” BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/auth/registry/apikey"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// RotateREST implements the REST endpoint.
+type RotateREST struct {
+	apiKeyStore *registry.Store
+	statusStore *registry.Store
+	keySigner   util.KeySigner
+}
+
+var _ = rest.NamedCreater(&RotateREST{})
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *RotateREST) New() runtime.Object {
+	return &auth.APIKeyRotateReq{}
+}
+
+// Create issues a new api key with the same owner and scope of the given one,
+// and retires the given api key after the overlap period.
+func (r *RotateREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	apiKeyObj, err := ValidateGetObjectAndTenantID(ctx, r.apiKeyStore, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	oldAPIKey := apiKeyObj.(*auth.APIKey)
+
+	if err := checkRotatable(ctx, oldAPIKey); err != nil {
+		return nil, err
+	}
+
+	rotateReq := obj.(*auth.APIKeyRotateReq)
+	if rotateReq.Expire.Duration == 0 {
+		rotateReq.Expire.Duration = oldAPIKey.Spec.ExpireAt.Sub(oldAPIKey.Spec.IssueAt.Time)
+	}
+	if err := apikey.ValidateAPIKeyRotateReq(rotateReq); err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+
+	newAPIKey, err := r.keySigner.Generate(ctx, oldAPIKey.Spec.Username, oldAPIKey.Spec.TenantID, rotateReq.Expire.Duration)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	newAPIKey.Spec.Description = oldAPIKey.Spec.Description
+	newAPIKey.Spec.Scope = oldAPIKey.Spec.Scope
+
+	created, err := r.apiKeyStore.Create(ctx, newAPIKey, createValidation, options)
+	if err != nil {
+		return nil, err
+	}
+	createdAPIKey := created.(*auth.APIKey)
+
+	oldAPIKey.Status.RotatedTo = createdAPIKey.Name
+	oldAPIKey.Status.RetireTime = metav1.NewTime(retireTime(oldAPIKey, rotateReq.Overlap.Duration, time.Now()))
+	if _, _, err := r.statusStore.Update(ctx, name, rest.DefaultUpdatedObjectInfo(oldAPIKey), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{}); err != nil {
+		log.Error("Failed to retire the rotated api key", log.String("apiKey", name), log.String("rotatedTo", createdAPIKey.Name), log.Err(err))
+		return nil, err
+	}
+
+	return createdAPIKey, nil
+}
+
+// checkRotatable returns an error if the api key cannot be rotated by the
+// user of the request.
+func checkRotatable(ctx context.Context, apiKey *auth.APIKey) error {
+	userName, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID != "" && apiKey.Spec.Username != userName {
+		return apierrors.NewForbidden(auth.Resource("apiKeys"), apiKey.Name, fmt.Errorf("forbid to rotate"))
+	}
+	if apiKey.Status.Disabled || apiKey.Status.Expired {
+		return apierrors.NewBadRequest("api key has been disabled or expired")
+	}
+	if apiKey.Status.RotatedTo != "" {
+		return apierrors.NewBadRequest(fmt.Sprintf("api key has been rotated to %s", apiKey.Status.RotatedTo))
+	}
+	return nil
+}
+
+// retireTime returns the time the rotated api key stops working, which is
+// never after its expiration.
+func retireTime(apiKey *auth.APIKey, overlap time.Duration, now time.Time) time.Time {
+	retire := now.Add(overlap)
+	if retire.After(apiKey.Spec.ExpireAt.Time) {
+		retire = apiKey.Spec.ExpireAt.Time
+	}
+	return retire
+}
@@ -0,0 +1,79 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"tkestack.io/tke/api/auth"
+	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+)
+
+func TestCheckRotatable(t *testing.T) {
+	tenantUser := func(name string) context.Context {
+		return request.WithUser(context.Background(), &user.DefaultInfo{
+			Name:  name,
+			Extra: map[string][]string{genericoidc.TenantIDKey: {"t1"}},
+		})
+	}
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		status     auth.APIKeyStatus
+		forbidden  bool
+		badRequest bool
+	}{
+		{name: "owner", ctx: tenantUser("alice")},
+		{name: "platform administrator", ctx: request.WithUser(context.Background(), &user.DefaultInfo{Name: "admin"})},
+		{name: "other user", ctx: tenantUser("bob"), forbidden: true},
+		{name: "disabled", ctx: tenantUser("alice"), status: auth.APIKeyStatus{Disabled: true}, badRequest: true},
+		{name: "expired", ctx: tenantUser("alice"), status: auth.APIKeyStatus{Expired: true}, badRequest: true},
+		{name: "rotated", ctx: tenantUser("alice"), status: auth.APIKeyStatus{RotatedTo: "key2"}, badRequest: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKey := &auth.APIKey{
+				ObjectMeta: metav1.ObjectMeta{Name: "key1"},
+				Spec:       auth.APIKeySpec{Username: "alice", TenantID: "t1"},
+				Status:     tt.status,
+			}
+			err := checkRotatable(tt.ctx, apiKey)
+			if apierrors.IsForbidden(err) != tt.forbidden || apierrors.IsBadRequest(err) != tt.badRequest {
+				t.Errorf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestRetireTime(t *testing.T) {
+	now := time.Now()
+	apiKey := &auth.APIKey{Spec: auth.APIKeySpec{ExpireAt: metav1.NewTime(now.Add(time.Hour))}}
+	if retire := retireTime(apiKey, time.Minute, now); !retire.Equal(now.Add(time.Minute)) {
+		t.Errorf("expected the api key to retire after the overlap, got %v", retire)
+	}
+	if retire := retireTime(apiKey, 2*time.Hour, now); !retire.Equal(apiKey.Spec.ExpireAt.Time) {
+		t.Errorf("expected the api key to retire when it expires, got %v", retire)
+	}
+}
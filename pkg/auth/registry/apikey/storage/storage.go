@@ -21,6 +21,8 @@ package storage
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"tkestack.io/tke/pkg/apiserver/authentication"
 
@@ -45,6 +47,7 @@ type Storage struct {
 	APIKey   *REST
 	Password *PasswordREST
 	Token    *TokenREST
+	Rotate   *RotateREST
 	Status   *StatusREST
 }
 
@@ -86,6 +89,11 @@ func NewStorage(optsGetter generic.RESTOptionsGetter, authClient authinternalcli
 			apiKeyStore: store,
 			keySigner:   keySigner,
 		},
+		Rotate: &RotateREST{
+			apiKeyStore: store,
+			statusStore: &statusStore,
+			keySigner:   keySigner,
+		},
 		Status: &StatusREST{&statusStore},
 	}
 }
@@ -106,6 +114,7 @@ func ValidateGetObjectAndTenantID(ctx context.Context, store *registry.Store, na
 
 // ValidateListObject validate if list by admin, if false, filter deleted apikey.
 func ValidateListObjectAndTenantID(ctx context.Context, store *registry.Store, options *metainternal.ListOptions) (runtime.Object, error) {
+	unusedDays := util.InterceptParam(options, auth.UnusedDaysQueryTag)
 	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
 	wrappedOptions = util.PredicateUserNameListOptions(ctx, wrappedOptions)
 
@@ -114,9 +123,32 @@ func ValidateListObjectAndTenantID(ctx context.Context, store *registry.Store, o
 		return obj, err
 	}
 
+	if unusedDays != "" {
+		if err := filterUnusedAPIKeys(obj.(*auth.APIKeyList), unusedDays, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
 	return obj, nil
 }
 
+// filterUnusedAPIKeys keeps the api keys not used for the given days.
+func filterUnusedAPIKeys(apiKeyList *auth.APIKeyList, unusedDays string, now time.Time) error {
+	days, err := strconv.Atoi(unusedDays)
+	if err != nil || days < 0 {
+		return apierrors.NewBadRequest(fmt.Sprintf("invalid %s: %s", auth.UnusedDaysQueryTag, unusedDays))
+	}
+	since := now.Add(-time.Duration(days) * 24 * time.Hour)
+	var unused []auth.APIKey
+	for _, item := range apiKeyList.Items {
+		if util.APIKeyUnusedSince(&item, since) {
+			unused = append(unused, item)
+		}
+	}
+	apiKeyList.Items = unused
+	return nil
+}
+
 // REST implements a RESTStorage for identities against etcd.
 type REST struct {
 	*registry.Store
@@ -0,0 +1,68 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+)
+
+func TestFilterUnusedAPIKeys(t *testing.T) {
+	now := time.Now()
+	apiKeyList := &auth.APIKeyList{Items: []auth.APIKey{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "used"},
+			Spec:       auth.APIKeySpec{IssueAt: metav1.NewTime(now.Add(-30 * 24 * time.Hour))},
+			Status:     auth.APIKeyStatus{LastUsedTime: metav1.NewTime(now.Add(-time.Hour))},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "unused"},
+			Spec:       auth.APIKeySpec{IssueAt: metav1.NewTime(now.Add(-30 * 24 * time.Hour))},
+			Status:     auth.APIKeyStatus{LastUsedTime: metav1.NewTime(now.Add(-10 * 24 * time.Hour))},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "never used"},
+			Spec:       auth.APIKeySpec{IssueAt: metav1.NewTime(now.Add(-10 * 24 * time.Hour))},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "new"},
+			Spec:       auth.APIKeySpec{IssueAt: metav1.NewTime(now.Add(-time.Hour))},
+		},
+	}}
+	if err := filterUnusedAPIKeys(apiKeyList, "7", now); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, item := range apiKeyList.Items {
+		names = append(names, item.Name)
+	}
+	if len(names) != 2 || names[0] != "unused" || names[1] != "never used" {
+		t.Errorf("unexpected unused api keys %v", names)
+	}
+
+	for _, days := range []string{"-1", "week"} {
+		if err := filterUnusedAPIKeys(&auth.APIKeyList{}, days, now); !apierrors.IsBadRequest(err) {
+			t.Errorf("expected bad request for %q, got %v", days, err)
+		}
+	}
+}
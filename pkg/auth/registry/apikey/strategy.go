@@ -62,10 +62,18 @@ func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.Garbage
 // object.
 func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	newAPIKey, _ := obj.(*auth.APIKey)
+	oldAPIKey, _ := old.(*auth.APIKey)
 	_, tenantID := authentication.UsernameAndTenantID(ctx)
 	if len(tenantID) != 0 {
 		newAPIKey.Spec.TenantID = tenantID
 	}
+
+	// Usage and rotation are maintained by the server only.
+	newAPIKey.Status.LastUsedTime = oldAPIKey.Status.LastUsedTime
+	newAPIKey.Status.LastSourceIP = oldAPIKey.Status.LastSourceIP
+	newAPIKey.Status.RequestCount = oldAPIKey.Status.RequestCount
+	newAPIKey.Status.RotatedTo = oldAPIKey.Status.RotatedTo
+	newAPIKey.Status.RetireTime = oldAPIKey.Status.RetireTime
 }
 
 // NamespaceScoped is false for projects.
@@ -109,24 +117,24 @@ func (Strategy) AllowCreateOnUpdate() bool {
 func Decorator(obj runtime.Object) {
 	now := metav1.Now()
 	if apiKey, ok := obj.(*auth.APIKey); ok {
-		if apiKey.Spec.ExpireAt.Before(&now) {
-			apiKey.Status.Expired = true
-		} else {
-			apiKey.Status.Expired = false
-		}
+		apiKey.Status.Expired = expired(apiKey, now)
 	}
 
 	if apiKeyList, ok := obj.(*auth.APIKeyList); ok {
 		for i := range apiKeyList.Items {
-			if apiKeyList.Items[i].Spec.ExpireAt.Before(&now) {
-				apiKeyList.Items[i].Status.Expired = true
-			} else {
-				apiKeyList.Items[i].Status.Expired = false
-			}
+			apiKeyList.Items[i].Status.Expired = expired(&apiKeyList.Items[i], now)
 		}
 	}
 }
 
+// expired returns true if the api key is expired or retired after rotation.
+func expired(apiKey *auth.APIKey, now metav1.Time) bool {
+	if apiKey.Spec.ExpireAt.Before(&now) {
+		return true
+	}
+	return !apiKey.Status.RetireTime.IsZero() && apiKey.Status.RetireTime.Before(&now)
+}
+
 // AllowUnconditionalUpdate returns true if the object can be updated
 // unconditionally (irrespective of the latest resource version), when there is
 // no resource version specified in the object.
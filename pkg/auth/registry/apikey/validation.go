@@ -21,6 +21,7 @@ package apikey
 import (
 	"context"
 	"fmt"
+	"net"
 	"time"
 
 	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
@@ -38,6 +39,7 @@ var (
 	maxExpire = 100 * 365 * 24 * time.Hour
 
 	defaultAPIKeyTimeout = metav1.Duration{Duration: 7 * 24 * time.Hour}
+	defaultRotateOverlap = metav1.Duration{Duration: 24 * time.Hour}
 )
 
 // ValidateAPIkey tests if required fields in the signing key are set.
@@ -71,6 +73,53 @@ func ValidateAPIkey(ctx context.Context, apiKey *auth.APIKey, keySigner util.Key
 		}
 	}
 
+	allErrs = append(allErrs, ValidateAPIKeyScope(apiKey.Spec.Scope, fldSpecPath.Child("scope"))...)
+
+	return allErrs
+}
+
+// ValidateAPIKeyScope tests if the restrictions of the api key are valid.
+func ValidateAPIKeyScope(scope *auth.APIKeyScope, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if scope == nil {
+		return allErrs
+	}
+
+	for i, st := range scope.Statements {
+		fldStmtPath := fldPath.Child("statements").Index(i)
+		if len(st.Actions) == 0 {
+			allErrs = append(allErrs, field.Required(fldStmtPath.Child("actions"), "must specify actions"))
+		}
+		if len(st.Resources) == 0 {
+			allErrs = append(allErrs, field.Required(fldStmtPath.Child("resources"), "must specify resources"))
+		}
+		for j, action := range st.Actions {
+			if err := util.ValidateKeyMatchPattern(action); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldStmtPath.Child("actions").Index(j), action, err.Error()))
+			}
+		}
+		for j, resource := range st.Resources {
+			if err := util.ValidateKeyMatchPattern(resource); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldStmtPath.Child("resources").Index(j), resource, err.Error()))
+			}
+		}
+		if st.Effect != auth.Allow && st.Effect != auth.Deny {
+			allErrs = append(allErrs, field.Invalid(fldStmtPath.Child("effect"), st.Effect, "must specify one of: `allow` or `deny`"))
+		}
+	}
+
+	for i, project := range scope.Projects {
+		if project == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("projects").Index(i), "must specify project"))
+		}
+	}
+
+	for i, cidr := range scope.SourceCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sourceCIDRs").Index(i), cidr, err.Error()))
+		}
+	}
+
 	return allErrs
 }
 
@@ -102,6 +151,8 @@ func ValidateAPIKeyUpdate(ctx context.Context, apiKey *auth.APIKey, oldAPIKey *a
 		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("username"), apiKey.Spec.ExpireAt, "disallowed change the username"))
 	}
 
+	allErrs = append(allErrs, ValidateAPIKeyScope(apiKey.Spec.Scope, fldSpecPath.Child("scope"))...)
+
 	return allErrs
 }
 
@@ -122,7 +173,20 @@ func ValidateAPIKeyReq(apiKeyReq *auth.APIKeyReq) error {
 		return err
 	}
 
-	return nil
+	return ValidateAPIKeyScope(apiKeyReq.Scope, field.NewPath("scope")).ToAggregate()
+}
+
+// ValidateAPIKeyRotateReq tests if the parameters of the rotation are valid.
+func ValidateAPIKeyRotateReq(rotateReq *auth.APIKeyRotateReq) error {
+	if rotateReq.Overlap.Duration == 0 {
+		rotateReq.Overlap = defaultRotateOverlap
+	}
+
+	if rotateReq.Overlap.Duration < 0 || rotateReq.Overlap.Duration > maxExpire {
+		return fmt.Errorf("overlap %v must not be negative or longer than %v", rotateReq.Overlap, maxExpire)
+	}
+
+	return validateAPIKeyExpire(rotateReq.Expire)
 }
 
 // ValidateAPIkeyPassword tests if required fields in the signing key are set.
@@ -137,6 +201,7 @@ func ValidateAPIkeyPassword(ctx context.Context, apiKeyPass *auth.APIKeyReqPassw
 	if err := validateAPIKeyExpire(apiKeyPass.Expire); err != nil {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("expire"), apiKeyPass.Expire, err.Error()))
 	}
+	allErrs = append(allErrs, ValidateAPIKeyScope(apiKeyPass.Scope, fldPath.Child("scope"))...)
 
 	localIdentity, err := util.GetLocalIdentity(ctx, authClient, apiKeyPass.TenantID, apiKeyPass.Username)
 	if err != nil {
@@ -25,6 +25,8 @@ import (
 	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/local"
+	"tkestack.io/tke/pkg/auth/util"
 	"tkestack.io/tke/pkg/util/validation"
 )
 
@@ -50,6 +52,20 @@ func ValidateIdentityProvider(idp *auth.IdentityProvider) field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("type"), idp.Spec.Type, fmt.Sprintf("only support %v", suppportIDPTypes())))
 	}
 
+	if idp.Spec.Type == local.ConnectorType {
+		if policy, err := util.ParsePasswordPolicy(idp.Spec.Config); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("config"), idp.Spec.Config, err.Error()))
+		} else if err := policy.Validate(); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("config"), idp.Spec.Config, err.Error()))
+		}
+	}
+
+	if arConfig, err := util.ParseAccessRequestConfig(idp.Spec.Config); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("config"), idp.Spec.Config, err.Error()))
+	} else if err := arConfig.Validate(); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("config"), idp.Spec.Config, err.Error()))
+	}
+
 	return allErrs
 }
 
@@ -78,7 +78,12 @@ func (r *PasswordREST) Create(ctx context.Context, obj runtime.Object, createVal
 
 	passwordReq := obj.(*auth.PasswordReq)
 
-	if err := localidentity.ValidateLocalIdentityPasswordUpdate(localIdentity, passwordReq); err != nil {
+	policy, err := util.GetPasswordPolicy(ctx, r.authClient, localIdentity.Spec.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := localidentity.ValidateLocalIdentityPasswordUpdate(policy, localIdentity, passwordReq); err != nil {
 		log.Error("Update password for localIdentity failed", log.String("localIdentity", userID), log.Err(err))
 		return nil, apierrors.NewBadRequest(err.Error())
 	}
@@ -142,6 +142,7 @@ func ValidateListObjectAndTenantID(ctx context.Context, store *registry.Store, o
 
 	for i := range identityList.Items {
 		identityList.Items[i].Spec.HashedPassword = ""
+		identityList.Items[i].Status.PasswordHistory = nil
 	}
 
 	return identityList, nil
@@ -76,6 +76,13 @@ func (s *Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object
 		localIdentity.Spec.TenantID = tenantID
 	}
 
+	// Login failures and password history are maintained by the server only.
+	localIdentity.Status.FailedLoginAttempts = oldLocalIdentity.Status.FailedLoginAttempts
+	localIdentity.Status.LastFailedLoginTime = oldLocalIdentity.Status.LastFailedLoginTime
+	localIdentity.Status.LockedUntil = oldLocalIdentity.Status.LockedUntil
+	localIdentity.Status.PasswordUpdateTime = oldLocalIdentity.Status.PasswordUpdateTime
+	localIdentity.Status.PasswordHistory = oldLocalIdentity.Status.PasswordHistory
+
 	localIdentity.Status.LastUpdateTime = metav1.Now()
 	_ = util.HandleUserPoliciesUpdate(ctx, s.authClient, s.enforcer, localIdentity)
 }
@@ -106,6 +113,11 @@ func (Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 	localIdentity.Spec.Finalizers = []auth.FinalizerName{
 		auth.LocalIdentityFinalize,
 	}
+	localIdentity.Status = auth.LocalIdentityStatus{
+		Locked:             localIdentity.Status.Locked,
+		Phase:              localIdentity.Status.Phase,
+		PasswordUpdateTime: metav1.Now(),
+	}
 }
 
 // Validate validates a new identity.
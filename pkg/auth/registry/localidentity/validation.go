@@ -65,6 +65,14 @@ func ValidateLocalIdentity(ctx context.Context, authClient authinternalclient.Au
 		}
 	}
 
+	if localIdentity.Spec.HashedPassword != "" {
+		if policy, err := util.GetPasswordPolicy(ctx, authClient, localIdentity.Spec.TenantID); err != nil {
+			allErrs = append(allErrs, field.InternalError(fldSpecPath.Child("hashedPassword"), err))
+		} else if err := policy.ValidateBase64Password(localIdentity.Spec.HashedPassword); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("hashedPassword"), "", err.Error()))
+		}
+	}
+
 	if !updateCheck {
 		if localIdentity.Spec.HashedPassword == "" {
 			allErrs = append(allErrs, field.Required(fldSpecPath.Child("hashedPassword"), "must specify hashedPassword"))
@@ -107,6 +115,7 @@ func ValidateLocalIdentity(ctx context.Context, authClient authinternalclient.Au
 func ValidateLocalIdentityUpdate(ctx context.Context, authClient authinternalclient.AuthInterface, localIdentity *auth.LocalIdentity, oldLocalIdentity *auth.LocalIdentity) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	passwordChanged := localIdentity.Spec.HashedPassword != ""
 	allErrs = append(allErrs, ValidateLocalIdentity(ctx, authClient, localIdentity, true)...)
 	allErrs = append(allErrs, apiMachineryValidation.ValidateObjectMetaUpdate(&localIdentity.ObjectMeta, &oldLocalIdentity.ObjectMeta, field.NewPath("metadata"))...)
 
@@ -119,16 +128,23 @@ func ValidateLocalIdentityUpdate(ctx context.Context, authClient authinternalcli
 		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("username"), localIdentity.Spec.Username, "disallowed change the username"))
 	}
 
-	if localIdentity.Spec.HashedPassword == "" {
+	if !passwordChanged {
 		localIdentity.Spec.HashedPassword = oldLocalIdentity.Spec.HashedPassword
+	} else if len(allErrs) == 0 {
+		depth := 0
+		if policy, err := util.GetPasswordPolicy(ctx, authClient, localIdentity.Spec.TenantID); err == nil {
+			depth = policy.HistoryDepth
+		}
+		localIdentity.Status.PasswordHistory = util.PushPasswordHistory(oldLocalIdentity.Status.PasswordHistory, oldLocalIdentity.Spec.HashedPassword, depth)
+		localIdentity.Status.PasswordUpdateTime = v1.Now()
 	}
 
 	return allErrs
 }
 
 // ValidateLocalIdentityPasswordUpdate tests if required fields in the passwordReq are set
-// during an update.
-func ValidateLocalIdentityPasswordUpdate(localIdentity *auth.LocalIdentity, passwordReq *auth.PasswordReq) error {
+// during an update, and if the new password satisfies the password policy.
+func ValidateLocalIdentityPasswordUpdate(policy *util.PasswordPolicy, localIdentity *auth.LocalIdentity, passwordReq *auth.PasswordReq) error {
 	err := util.VerifyDecodedPassword(passwordReq.OriginalPassword, localIdentity.Spec.HashedPassword)
 	if err != nil {
 		log.Error("Invalid original password", log.String("original password", passwordReq.OriginalPassword), log.Err(err))
@@ -140,10 +156,25 @@ func ValidateLocalIdentityPasswordUpdate(localIdentity *auth.LocalIdentity, pass
 		return fmt.Errorf("must specify hashedPassword")
 	}
 
-	_, err = base64.StdEncoding.DecodeString(passwordReq.HashedPassword)
+	decoded, err := base64.StdEncoding.DecodeString(passwordReq.HashedPassword)
 	if err != nil {
 		return err
 	}
+
+	if err := policy.ValidatePassword(string(decoded)); err != nil {
+		return err
+	}
+
+	if policy.HistoryDepth > 0 {
+		history := append([]string{localIdentity.Spec.HashedPassword}, localIdentity.Status.PasswordHistory...)
+		if len(history) > policy.HistoryDepth {
+			history = history[:policy.HistoryDepth]
+		}
+		if util.UsedPassword(string(decoded), history...) {
+			return fmt.Errorf("password must not be one of the last %d passwords", policy.HistoryDepth)
+		}
+	}
+
 	localIdentity.Spec.HashedPassword = passwordReq.HashedPassword
 	return nil
 }
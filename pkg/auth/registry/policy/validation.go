@@ -70,6 +70,10 @@ func ValidatePolicy(ctx context.Context, policy *auth.Policy, authClient authint
 		allErrs = append(allErrs, field.Invalid(fldStmtPath.Child("effect"), policy.Spec.Statement.Effect, "must specify one of: `allow` or `deny`"))
 	}
 
+	if _, err := util.ParsePolicyConditions(policy.Spec.Conditions); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("conditions"), string(policy.Spec.Conditions), err.Error()))
+	}
+
 	var validUsers []auth.Subject
 	fldUserPath := field.NewPath("status", "users")
 	for i, subj := range policy.Status.Users {
@@ -19,6 +19,8 @@
 package rest
 
 import (
+	"time"
+
 	"github.com/casbin/casbin/v2"
 	dexstorage "github.com/dexidp/dex/storage"
 	"k8s.io/apiserver/pkg/registry/generic"
@@ -31,6 +33,8 @@ import (
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	"tkestack.io/tke/pkg/apiserver/storage"
+	accessrequeststorage "tkestack.io/tke/pkg/auth/registry/accessrequest/storage"
+	accessreviewstorage "tkestack.io/tke/pkg/auth/registry/accessreview/storage"
 	apikeystorage "tkestack.io/tke/pkg/auth/registry/apikey/storage"
 	apisignstorage "tkestack.io/tke/pkg/auth/registry/apisigningkey/storage"
 	categorystorage "tkestack.io/tke/pkg/auth/registry/category/storage"
@@ -49,6 +53,9 @@ import (
 
 	rolestorage "tkestack.io/tke/pkg/auth/registry/role/storage"
 	rulestorage "tkestack.io/tke/pkg/auth/registry/rule/storage"
+	sessionstorage "tkestack.io/tke/pkg/auth/registry/session/storage"
+	sessionrevocationstorage "tkestack.io/tke/pkg/auth/registry/sessionrevocation/storage"
+	tenantstorage "tkestack.io/tke/pkg/auth/registry/tenant/storage"
 	userstorage "tkestack.io/tke/pkg/auth/registry/user/storage"
 	"tkestack.io/tke/pkg/auth/util"
 )
@@ -63,6 +70,7 @@ type StorageProvider struct {
 	VersionedInformers versionedinformers.SharedInformerFactory
 
 	PrivilegedUsername string
+	IDTokenTimeout     time.Duration
 }
 
 // Implement RESTStorageProvider
@@ -107,6 +115,7 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		storageMap["apikeys"] = apiKeyRest.APIKey
 		storageMap["apikeys/password"] = apiKeyRest.Password
 		storageMap["apikeys/token"] = apiKeyRest.Token
+		storageMap["apikeys/rotate"] = apiKeyRest.Rotate
 		storageMap["apikeys/status"] = apiKeyRest.Status
 
 		apiSignRest := apisignstorage.NewStorage(restOptionsGetter)
@@ -188,6 +197,28 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 
 		cliRest := clistorage.NewStorage(restOptionsGetter, s.DexStorage)
 		storageMap["clients"] = cliRest.Client
+
+		accessRequestRest := accessrequeststorage.NewStorage(restOptionsGetter, authClient)
+		storageMap["accessrequests"] = accessRequestRest.AccessRequest
+		storageMap["accessrequests/approve"] = accessRequestRest.Approve
+		storageMap["accessrequests/reject"] = accessRequestRest.Reject
+		storageMap["accessrequests/status"] = accessRequestRest.Status
+
+		accessReviewRest := accessreviewstorage.NewStorage(restOptionsGetter, authClient, s.Enforcer)
+		storageMap["accessreviews"] = accessReviewRest.AccessReview
+		storageMap["accessreviews/export"] = accessReviewRest.Export
+		storageMap["accessreviews/diff"] = accessReviewRest.Diff
+
+		sessionRevocationRest := sessionrevocationstorage.NewStorage(restOptionsGetter, s.IDTokenTimeout)
+		storageMap["sessionrevocations"] = sessionRevocationRest.SessionRevocation
+
+		sessionRest := sessionstorage.NewStorage(restOptionsGetter, authClient, s.DexStorage, s.IDTokenTimeout)
+		storageMap["sessions"] = sessionRest.Session
+
+		tenantRest := tenantstorage.NewStorage(restOptionsGetter)
+		storageMap["tenants"] = tenantRest.Tenant
+		storageMap["tenants/status"] = tenantRest.Status
+		storageMap["tenants/finalize"] = tenantRest.Finalize
 	}
 
 	return storageMap
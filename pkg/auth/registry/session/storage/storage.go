@@ -0,0 +1,321 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	dexstorage "github.com/dexidp/dex/storage"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Storage includes storage for sessions and all sub resources.
+type Storage struct {
+	Session *REST
+}
+
+// NewStorage returns a Storage object that will work against sessions, which
+// are the refresh tokens in the dex storage.
+func NewStorage(_ genericregistry.RESTOptionsGetter, authClient authinternalclient.AuthInterface, storage dexstorage.Storage, idTokenTimeout time.Duration) *Storage {
+	return &Storage{
+		Session: &REST{
+			dexStorage:     storage,
+			authClient:     authClient,
+			idTokenTimeout: idTokenTimeout,
+		},
+	}
+}
+
+// REST implements a RESTStorage for sessions against the dex storage.
+type REST struct {
+	rest.Storage
+	dexStorage     dexstorage.Storage
+	authClient     authinternalclient.AuthInterface
+	idTokenTimeout time.Duration
+}
+
+var _ rest.Scoper = &REST{}
+var _ rest.Lister = &REST{}
+var _ rest.Getter = &REST{}
+var _ rest.GracefulDeleter = &REST{}
+var _ rest.CollectionDeleter = &REST{}
+
+// NamespaceScoped is false for sessions.
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
+
+// New returns an empty object that can be used with Create and Update after request data has been put into it.
+func (r *REST) New() runtime.Object {
+	return &auth.Session{}
+}
+
+// NewList returns an empty object that can be used with the List call.
+func (r *REST) NewList() runtime.Object {
+	return &auth.SessionList{}
+}
+
+// ConvertToTable converts objects to metav1.Table objects using default table
+// convertor.
+func (r *REST) ConvertToTable(ctx context.Context, object runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	tableConvertor := rest.NewDefaultTableConvertor(auth.Resource("sessions"))
+	return tableConvertor.ConvertToTable(ctx, object, tableOptions)
+}
+
+// Get finds a resource in the storage by name and returns it.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	refresh, err := r.getRefresh(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return fromDexRefresh(refresh), nil
+}
+
+// List selects resources in the storage which match to the selector. 'options' can be nil.
+func (r *REST) List(ctx context.Context, options *metainternal.ListOptions) (runtime.Object, error) {
+	refreshes, err := r.listRefreshes(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionList := &auth.SessionList{}
+	for _, refresh := range refreshes {
+		sessionList.Items = append(sessionList.Items, *fromDexRefresh(refresh))
+	}
+	return sessionList, nil
+}
+
+// Delete revokes the session, it can not be refreshed any more and the ID
+// tokens issued for it are rejected by the API servers.
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	refresh, err := r.getRefresh(ctx, name)
+	if err != nil {
+		return nil, false, err
+	}
+	session := fromDexRefresh(refresh)
+	if deleteValidation != nil {
+		if err := deleteValidation(ctx, session); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if err := r.deleteRefresh(refresh); err != nil {
+		return nil, false, err
+	}
+	if session.Spec.SessionID != "" {
+		if err := r.revoke(ctx, session.Spec.TenantID, session.Spec.Username, session.Spec.SessionID); err != nil {
+			return nil, false, err
+		}
+	}
+	log.Info("Session revoked", log.String("session", name), log.String("tenantID", session.Spec.TenantID), log.String("username", session.Spec.Username))
+	return session, true, nil
+}
+
+// DeleteCollection revokes all sessions of a user, the user must be selected
+// by the spec.username field. The ID tokens issued before are rejected
+// whether they belong to a listed session or not.
+func (r *REST) DeleteCollection(ctx context.Context, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions, listOptions *metainternal.ListOptions) (runtime.Object, error) {
+	var username, tenantID string
+	if listOptions != nil && listOptions.FieldSelector != nil {
+		username, _ = listOptions.FieldSelector.RequiresExactMatch("spec.username")
+		tenantID, _ = listOptions.FieldSelector.RequiresExactMatch("spec.tenantID")
+	}
+	if _, userTenantID := authentication.UsernameAndTenantID(ctx); userTenantID != "" {
+		tenantID = userTenantID
+	}
+	if username == "" || tenantID == "" {
+		return nil, apierrors.NewBadRequest("must select the sessions to revoke by spec.username and spec.tenantID")
+	}
+
+	refreshes, err := r.listRefreshes(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+	sessionList := &auth.SessionList{}
+	for _, refresh := range refreshes {
+		session := fromDexRefresh(refresh)
+		if deleteValidation != nil {
+			if err := deleteValidation(ctx, session); err != nil {
+				return nil, err
+			}
+		}
+		if err := r.deleteRefresh(refresh); err != nil {
+			return nil, err
+		}
+		sessionList.Items = append(sessionList.Items, *session)
+	}
+
+	if err := r.revoke(ctx, tenantID, username, ""); err != nil {
+		return nil, err
+	}
+	log.Info("All sessions of user revoked", log.String("tenantID", tenantID), log.String("username", username), log.Int("sessions", len(sessionList.Items)))
+	return sessionList, nil
+}
+
+func (r *REST) getRefresh(ctx context.Context, name string) (dexstorage.RefreshToken, error) {
+	refresh, err := r.dexStorage.GetRefresh(name)
+	if err != nil {
+		if err == dexstorage.ErrNotFound {
+			return refresh, apierrors.NewNotFound(auth.Resource("sessions"), name)
+		}
+		return refresh, apierrors.NewInternalError(err)
+	}
+	if _, tenantID := authentication.UsernameAndTenantID(ctx); tenantID != "" && refresh.ConnectorID != tenantID {
+		return refresh, apierrors.NewNotFound(auth.Resource("sessions"), name)
+	}
+	return refresh, nil
+}
+
+func (r *REST) listRefreshes(ctx context.Context, options *metainternal.ListOptions) ([]dexstorage.RefreshToken, error) {
+	refreshes, err := r.dexStorage.ListRefreshTokens()
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	label := labels.Everything()
+	field := fields.Everything()
+	if options != nil {
+		if options.LabelSelector != nil {
+			label = options.LabelSelector
+		}
+		if options.FieldSelector != nil {
+			field = options.FieldSelector
+		}
+	}
+
+	var matched []dexstorage.RefreshToken
+	for _, refresh := range refreshes {
+		if tenantID != "" && refresh.ConnectorID != tenantID {
+			continue
+		}
+		session := fromDexRefresh(refresh)
+		if !label.Matches(labels.Set(session.Labels)) || !field.Matches(toSelectableFields(session)) {
+			continue
+		}
+		matched = append(matched, refresh)
+	}
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i].CreatedAt.Before(matched[j].CreatedAt)
+	})
+	return matched, nil
+}
+
+// deleteRefresh deletes the refresh token and its reference in the offline
+// session of the user.
+func (r *REST) deleteRefresh(refresh dexstorage.RefreshToken) error {
+	if err := r.dexStorage.DeleteRefresh(refresh.ID); err != nil && err != dexstorage.ErrNotFound {
+		return apierrors.NewInternalError(err)
+	}
+	err := r.dexStorage.UpdateOfflineSessions(refresh.Claims.UserID, refresh.ConnectorID, func(s dexstorage.OfflineSessions) (dexstorage.OfflineSessions, error) {
+		if ref, ok := s.Refresh[refresh.ClientID]; ok && ref.ID == refresh.ID {
+			delete(s.Refresh, refresh.ClientID)
+		}
+		return s, nil
+	})
+	if err != nil && err != dexstorage.ErrNotFound {
+		log.Warn("Failed to update offline session of revoked refresh token", log.String("refresh", refresh.ID), log.Err(err))
+	}
+	return nil
+}
+
+// revoke records the revocation of the ID tokens, so that the API servers
+// reject them before they expire. All ID tokens of the user are revoked if
+// sessionID is empty.
+func (r *REST) revoke(ctx context.Context, tenantID, username, sessionID string) error {
+	now := metav1.Now()
+	revocation := &auth.SessionRevocation{
+		Spec: auth.SessionRevocationSpec{
+			TenantID:   tenantID,
+			Username:   username,
+			SessionID:  sessionID,
+			RevokeTime: now,
+			ExpireTime: metav1.NewTime(now.Add(r.idTokenTimeout)),
+		},
+	}
+	if _, err := r.authClient.SessionRevocations().Create(ctx, revocation, metav1.CreateOptions{}); err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("failed to record the revocation: %v", err))
+	}
+	r.pruneRevocations(ctx)
+	return nil
+}
+
+// pruneRevocations deletes the revocations of the ID tokens all expired.
+func (r *REST) pruneRevocations(ctx context.Context) {
+	revocations, err := r.authClient.SessionRevocations().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Warn("List session revocations failed", log.Err(err))
+		return
+	}
+	now := time.Now()
+	for _, revocation := range revocations.Items {
+		if revocation.Spec.ExpireTime.Time.After(now) {
+			continue
+		}
+		if err := r.authClient.SessionRevocations().Delete(ctx, revocation.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			log.Warn("Delete expired session revocation failed", log.String("name", revocation.Name), log.Err(err))
+		}
+	}
+}
+
+func toSelectableFields(session *auth.Session) fields.Set {
+	return fields.Set{
+		"metadata.name": session.Name,
+		"spec.tenantID": session.Spec.TenantID,
+		"spec.username": session.Spec.Username,
+		"spec.clientID": session.Spec.ClientID,
+	}
+}
+
+func fromDexRefresh(refresh dexstorage.RefreshToken) *auth.Session {
+	sessionID, clientIP := oidc.ParseSessionNonce(refresh.Nonce)
+	return &auth.Session{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              refresh.ID,
+			CreationTimestamp: metav1.NewTime(refresh.CreatedAt),
+		},
+		Spec: auth.SessionSpec{
+			TenantID:  refresh.ConnectorID,
+			Username:  refresh.Claims.Username,
+			UserID:    refresh.Claims.UserID,
+			ClientID:  refresh.ClientID,
+			SessionID: sessionID,
+			ClientIP:  clientIP,
+		},
+		Status: auth.SessionStatus{
+			IssuedTime:   metav1.NewTime(refresh.CreatedAt),
+			LastUsedTime: metav1.NewTime(refresh.LastUsed),
+		},
+	}
+}
@@ -0,0 +1,161 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sessionrevocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for session revocation.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+
+	idTokenTimeout time.Duration
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating session revocation objects.
+func NewStrategy(idTokenTimeout time.Duration) *Strategy {
+	return &Strategy{auth.Scheme, namesutil.Generator, idTokenTimeout}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object.
+func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+}
+
+// NamespaceScoped is false for session revocations.
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object. The revocation lasts until the last ID token issued before it
+// expires.
+func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	revocation, _ := obj.(*auth.SessionRevocation)
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if len(tenantID) != 0 {
+		revocation.Spec.TenantID = tenantID
+	}
+
+	revocation.ObjectMeta.GenerateName = "sr-"
+	revocation.ObjectMeta.Name = ""
+
+	if revocation.Spec.RevokeTime.IsZero() {
+		revocation.Spec.RevokeTime = metav1.Now()
+	}
+	if revocation.Spec.ExpireTime.IsZero() {
+		revocation.Spec.ExpireTime = metav1.NewTime(revocation.Spec.RevokeTime.Add(s.idTokenTimeout))
+	}
+}
+
+// Validate validates a new session revocation.
+func (Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ValidateSessionRevocation(obj.(*auth.SessionRevocation))
+}
+
+// AllowCreateOnUpdate is false for session revocations.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end session revocation.
+func (Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateSessionRevocationUpdate(obj.(*auth.SessionRevocation), old.(*auth.SessionRevocation))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	revocation, ok := obj.(*auth.SessionRevocation)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a session revocation")
+	}
+	return revocation.ObjectMeta.Labels, ToSelectableFields(revocation), nil
+}
+
+// MatchSessionRevocation returns a generic matcher for a given label and field selector.
+func MatchSessionRevocation(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"spec.tenantID",
+			"spec.username",
+		},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(revocation *auth.SessionRevocation) fields.Set {
+	objectMetaFieldsSet := generic.ObjectMetaFieldsSet(&revocation.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID": revocation.Spec.TenantID,
+		"spec.username": revocation.Spec.Username,
+	}
+	return generic.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
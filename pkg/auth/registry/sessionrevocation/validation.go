@@ -0,0 +1,57 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sessionrevocation
+
+import (
+	"reflect"
+
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/auth"
+)
+
+// ValidateSessionRevocation tests if required fields in the session
+// revocation are set.
+func ValidateSessionRevocation(revocation *auth.SessionRevocation) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&revocation.ObjectMeta, false, apiMachineryValidation.NameIsDNSLabel, field.NewPath("metadata"))
+
+	fldSpecPath := field.NewPath("spec")
+	if revocation.Spec.TenantID == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("tenantID"), "must specify tenantID"))
+	}
+	if revocation.Spec.Username == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("username"), "must specify username"))
+	}
+	if !revocation.Spec.ExpireTime.After(revocation.Spec.RevokeTime.Time) {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("expireTime"), revocation.Spec.ExpireTime, "must be after the revoke time"))
+	}
+
+	return allErrs
+}
+
+// ValidateSessionRevocationUpdate tests if the session revocation is
+// unchanged during an update.
+func ValidateSessionRevocationUpdate(revocation *auth.SessionRevocation, old *auth.SessionRevocation) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&revocation.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	if !reflect.DeepEqual(revocation.Spec, old.Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "disallowed change the session revocation"))
+	}
+
+	return allErrs
+}
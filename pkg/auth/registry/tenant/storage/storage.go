@@ -0,0 +1,287 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the “License”); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an “AS IS” BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	storageerr "k8s.io/apiserver/pkg/storage/errors"
+	"k8s.io/apiserver/pkg/util/dryrun"
+
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
+	"tkestack.io/tke/pkg/auth/registry/tenant"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Storage includes storage for tenants and all sub resources.
+type Storage struct {
+	Tenant   *REST
+	Status   *StatusREST
+	Finalize *FinalizeREST
+}
+
+// NewStorage returns a Storage object that will work against tenants.
+func NewStorage(optsGetter generic.RESTOptionsGetter) *Storage {
+	strategy := tenant.NewStrategy()
+	store := &registry.Store{
+		NewFunc:                  func() runtime.Object { return &auth.Tenant{} },
+		NewListFunc:              func() runtime.Object { return &auth.TenantList{} },
+		DefaultQualifiedResource: auth.Resource("tenants"),
+		PredicateFunc:            tenant.MatchTenant,
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+
+		ShouldDeleteDuringUpdate: tenant.ShouldDeleteDuringUpdate,
+	}
+	store.TableConvertor = rest.NewDefaultTableConvertor(store.DefaultQualifiedResource)
+	options := &generic.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    tenant.GetAttrs,
+	}
+
+	if err := store.CompleteWithOptions(options); err != nil {
+		log.Panic("Failed to create tenant etcd rest storage", log.Err(err))
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = tenant.NewStatusStrategy(strategy)
+
+	finalizeStore := *store
+	finalizeStore.UpdateStrategy = tenant.NewFinalizerStrategy(strategy)
+
+	return &Storage{
+		Tenant:   &REST{store},
+		Status:   &StatusREST{&statusStore},
+		Finalize: &FinalizeREST{&finalizeStore},
+	}
+}
+
+// ValidateGetObjectAndTenantID validate name and tenantID, if success return Tenant
+func ValidateGetObjectAndTenantID(ctx context.Context, store *registry.Store, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	o := obj.(*auth.Tenant)
+	if err := util.FilterTenant(ctx, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// REST implements a RESTStorage for tenants against etcd.
+type REST struct {
+	*registry.Store
+}
+
+var _ rest.ShortNamesProvider = &REST{}
+var _ rest.Lister = &REST{}
+var _ rest.Getter = &REST{}
+var _ rest.Updater = &REST{}
+var _ rest.GracefulDeleter = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"tnt"}
+}
+
+// List selects resources in the storage which match to the selector. 'options' can be nil.
+func (r *REST) List(ctx context.Context, options *metainternal.ListOptions) (runtime.Object, error) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID != "" {
+		options = apiserverutil.FullListOptionsFieldSelector(options, fields.OneTermEqualSelector("metadata.name", tenantID))
+	}
+	return r.Store.List(ctx, options)
+}
+
+// DeleteCollection is not supported, tenants are deleted one by one.
+func (r *REST) DeleteCollection(ctx context.Context, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions, listOptions *metainternal.ListOptions) (runtime.Object, error) {
+	return nil, apierrors.NewMethodNotSupported(auth.Resource("tenants"), "deletecollection")
+}
+
+// Get finds a resource in the storage by name and returns it.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.Store, name, options)
+}
+
+// Update alters the object subset of an object.
+func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// Delete enforces life-cycle rules for tenant termination
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	object, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	tenant := object.(*auth.Tenant)
+
+	// Ensure we have a UID precondition
+	if options == nil {
+		options = metav1.NewDeleteOptions(0)
+	}
+	if options.Preconditions == nil {
+		options.Preconditions = &metav1.Preconditions{}
+	}
+	if options.Preconditions.UID == nil {
+		options.Preconditions.UID = &tenant.UID
+	} else if *options.Preconditions.UID != tenant.UID {
+		err = apierrors.NewConflict(
+			auth.Resource("tenants"),
+			name,
+			fmt.Errorf("precondition failed: UID in precondition: %v, UID in object meta: %v", *options.Preconditions.UID, tenant.UID),
+		)
+		return nil, false, err
+	}
+
+	// upon first request to delete, we switch the phase to start tenant termination
+	if tenant.DeletionTimestamp.IsZero() {
+		key, err := r.Store.KeyFunc(ctx, name)
+		if err != nil {
+			return nil, false, err
+		}
+
+		preconditions := storage.Preconditions{UID: options.Preconditions.UID}
+
+		out := r.Store.NewFunc()
+		err = r.Store.Storage.GuaranteedUpdate(
+			ctx, key, out, false, &preconditions,
+			storage.SimpleUpdate(func(existing runtime.Object) (runtime.Object, error) {
+				existingTenant, ok := existing.(*auth.Tenant)
+				if !ok {
+					// wrong type
+					return nil, fmt.Errorf("expected *auth.Tenant, got %v", existing)
+				}
+				if err := deleteValidation(ctx, existingTenant); err != nil {
+					return nil, err
+				}
+				// Set the deletion timestamp if needed
+				if existingTenant.DeletionTimestamp.IsZero() {
+					now := metav1.Now()
+					existingTenant.DeletionTimestamp = &now
+				}
+				// Set the tenant phase to terminating, if needed
+				if existingTenant.Status.Phase != auth.TenantTerminating {
+					existingTenant.Status.Phase = auth.TenantTerminating
+				}
+				return existingTenant, nil
+			}),
+			dryrun.IsDryRun(options.DryRun),
+			nil,
+		)
+
+		if err != nil {
+			err = storageerr.InterpretGetError(err, auth.Resource("tenants"), name)
+			err = storageerr.InterpretUpdateError(err, auth.Resource("tenants"), name)
+			if _, ok := err.(*apierrors.StatusError); !ok {
+				err = apierrors.NewInternalError(err)
+			}
+			return nil, false, err
+		}
+
+		return out, false, nil
+	}
+
+	// prior to final deletion, we must ensure that finalizers is empty
+	if len(tenant.Spec.Finalizers) != 0 {
+		err = apierrors.NewConflict(auth.Resource("tenants"), tenant.Name, fmt.Errorf("the system is ensuring all content is removed from this tenant.  Upon completion, this tenant will automatically be purged by the system"))
+		return nil, false, err
+	}
+	return r.Store.Delete(ctx, name, deleteValidation, options)
+}
+
+// StatusREST implements the REST endpoint for changing the status of a
+// tenant.
+type StatusREST struct {
+	store *registry.Store
+}
+
+// StatusREST implements Patcher.
+var _ = rest.Patcher(&StatusREST{})
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *StatusREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// FinalizeREST implements the REST endpoint for finalizing a tenant.
+type FinalizeREST struct {
+	store *registry.Store
+}
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *FinalizeREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *FinalizeREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+}
+
+// Update alters the status finalizers subset of an object.
+func (r *FinalizeREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	_, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
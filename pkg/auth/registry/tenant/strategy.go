@@ -0,0 +1,224 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the “License”); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an “AS IS” BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/local"
+	"tkestack.io/tke/pkg/util/log"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for tenant.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating tenant objects.
+func NewStrategy() *Strategy {
+	return &Strategy{auth.Scheme, namesutil.Generator}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object.
+func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	tenant, _ := obj.(*auth.Tenant)
+	oldTenant, _ := old.(*auth.Tenant)
+	tenant.Status = oldTenant.Status
+}
+
+// NamespaceScoped is false for tenants.
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	tenant, _ := obj.(*auth.Tenant)
+	if tenant.Spec.IdentityProviderType == "" {
+		tenant.Spec.IdentityProviderType = local.ConnectorType
+	}
+
+	tenant.Spec.Finalizers = []auth.FinalizerName{
+		auth.TenantFinalize,
+	}
+
+	tenant.Status = auth.TenantStatus{
+		Phase: auth.TenantInitializing,
+	}
+}
+
+// Validate validates a new tenant.
+func (Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ValidateTenant(obj.(*auth.Tenant))
+}
+
+// AllowCreateOnUpdate is false for tenants.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end tenant.
+func (Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateTenantUpdate(obj.(*auth.Tenant), old.(*auth.Tenant))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	tenant, ok := obj.(*auth.Tenant)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a tenant")
+	}
+	return tenant.ObjectMeta.Labels, ToSelectableFields(tenant), nil
+}
+
+// MatchTenant returns a generic matcher for a given label and field selector.
+func MatchTenant(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"status.phase",
+		},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(tenant *auth.Tenant) fields.Set {
+	objectMetaFieldsSet := generic.ObjectMetaFieldsSet(&tenant.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"status.phase": string(tenant.Status.Phase),
+	}
+	return generic.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
+
+// ShouldDeleteDuringUpdate returns true if the tenant is finalized.
+func ShouldDeleteDuringUpdate(ctx context.Context, key string, obj, existing runtime.Object) bool {
+	tenant, ok := obj.(*auth.Tenant)
+	if !ok {
+		log.Errorf("unexpected object, key:%s", key)
+		return false
+	}
+	return len(tenant.Spec.Finalizers) == 0 && registry.ShouldDeleteDuringUpdate(ctx, key, obj, existing)
+}
+
+// StatusStrategy implements verification logic for status of tenant.
+type StatusStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &StatusStrategy{}
+
+// NewStatusStrategy create the StatusStrategy object by given strategy.
+func NewStatusStrategy(strategy *Strategy) *StatusStrategy {
+	return &StatusStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object.  For example: remove fields that are not to be persisted,
+// sort order-insensitive list fields, etc.  This should not remove fields
+// whose presence would be considered a validation error.
+func (StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newTenant := obj.(*auth.Tenant)
+	oldTenant := old.(*auth.Tenant)
+	newTenant.Spec = oldTenant.Spec
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (StatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateTenantUpdate(obj.(*auth.Tenant), old.(*auth.Tenant))
+}
+
+// FinalizeStrategy implements finalizer logic for tenant.
+type FinalizeStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &FinalizeStrategy{}
+
+// NewFinalizerStrategy create the FinalizeStrategy object by given strategy.
+func NewFinalizerStrategy(strategy *Strategy) *FinalizeStrategy {
+	return &FinalizeStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object.  For example: remove fields that are not to be persisted,
+// sort order-insensitive list fields, etc.  This should not remove fields
+// whose presence would be considered a validation error.
+func (FinalizeStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newTenant := obj.(*auth.Tenant)
+	oldTenant := old.(*auth.Tenant)
+	newTenant.Status = oldTenant.Status
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (FinalizeStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateTenantUpdate(obj.(*auth.Tenant), old.(*auth.Tenant))
+}
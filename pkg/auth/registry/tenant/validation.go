@@ -0,0 +1,86 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the “License”); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an “AS IS” BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package tenant
+
+import (
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/auth/registry/identityprovider"
+	"tkestack.io/tke/pkg/util/validation"
+)
+
+// ValidateTenantName is a ValidateNameFunc for names that must be a DNS
+// label, the name is used as the tenant id.
+var ValidateTenantName = apiMachineryValidation.NameIsDNSLabel
+
+// ValidateTenant tests if required fields in the tenant are set.
+func ValidateTenant(tenant *auth.Tenant) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&tenant.ObjectMeta, false, ValidateTenantName, field.NewPath("metadata"))
+
+	fldSpecPath := field.NewPath("spec")
+	if err := validation.IsDisplayName(tenant.Spec.DisplayName); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("displayName"), tenant.Spec.DisplayName, err.Error()))
+	}
+
+	// The default identity provider is created from the spec, so it must be
+	// valid as well.
+	idp := &auth.IdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: tenant.Name},
+		Spec: auth.IdentityProviderSpec{
+			Name:           tenant.Spec.DisplayName,
+			Type:           tenant.Spec.IdentityProviderType,
+			Administrators: tenant.Spec.Administrators,
+			Config:         tenant.Spec.IdentityProviderConfig,
+		},
+	}
+	if errs := identityprovider.ValidateIdentityProvider(idp); len(errs) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("identityProviderConfig"), tenant.Spec.IdentityProviderType, errs.ToAggregate().Error()))
+	}
+
+	fldQuotaPath := fldSpecPath.Child("quota")
+	quotas := map[string]*int32{
+		"clusters":   tenant.Spec.Quota.Clusters,
+		"projects":   tenant.Spec.Quota.Projects,
+		"registries": tenant.Spec.Quota.Registries,
+		"apps":       tenant.Spec.Quota.Apps,
+	}
+	for name, quota := range quotas {
+		if quota != nil && *quota < 0 {
+			allErrs = append(allErrs, field.Invalid(fldQuotaPath.Child(name), *quota, "must not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateTenantUpdate tests if required fields in the tenant are set during
+// an update.
+func ValidateTenantUpdate(tenant *auth.Tenant, old *auth.Tenant) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&tenant.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateTenant(tenant)...)
+
+	fldSpecPath := field.NewPath("spec")
+	if tenant.Spec.IdentityProviderType != old.Spec.IdentityProviderType {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("identityProviderType"), tenant.Spec.IdentityProviderType, "disallowed change the identity provider type"))
+	}
+
+	return allErrs
+}
@@ -26,12 +26,13 @@ import (
 	"github.com/emicklei/go-restful"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"tkestack.io/tke/pkg/auth/handler/authz"
+	"tkestack.io/tke/pkg/auth/handler/jwks"
 
 	authenticationapi "k8s.io/api/authentication/v1"
 )
 
 // RegisterAuthRoute registers the http handlers of authz webhook for kubernetes.
-func RegisterAuthRoute(container *restful.Container, authnHandler *authn.Handler, authzHandler *authz.Handler) {
+func RegisterAuthRoute(container *restful.Container, authnHandler *authn.Handler, authzHandler *authz.Handler, jwksHandler *jwks.Handler) {
 	ws := new(restful.WebService)
 	ws.Path("/auth")
 	ws.Produces(restful.MIME_JSON)
@@ -74,5 +75,31 @@ func RegisterAuthRoute(container *restful.Container, authnHandler *authn.Handler
 		Returns(http.StatusBadRequest, "BadRequest", v1.Status{}).
 		To(authzHandler.BatchAuthorize))
 
+	ws.Route(ws.
+		GET("/jwks").
+		Doc("return the public keys api keys are verified with as a json web key set.").
+		Operation("getJWKS").
+		Returns(http.StatusOK, "Ok", nil).
+		To(jwksHandler.ServeJWKS))
+
 	container.Add(ws)
+
+	// The explain route lives outside of the auth path so that requests are
+	// authenticated and authorized like other api requests.
+	explainWS := new(restful.WebService)
+	explainWS.Path("/authz")
+	explainWS.Produces(restful.MIME_JSON)
+	explainWS.Consumes(restful.MIME_JSON)
+
+	explainWS.Route(explainWS.
+		POST("/explain").
+		Doc("receive a subject access review request and return the matched policies and rules with the decision.").
+		Operation("explainAuthz").
+		Reads(authapi.SubjectAccessReview{}).
+		Returns(http.StatusOK, "Ok", authapi.SubjectAccessReview{}).
+		Returns(http.StatusBadRequest, "BadRequest", v1.Status{}).
+		Returns(http.StatusForbidden, "Forbidden", v1.Status{}).
+		To(authzHandler.Explain))
+
+	container.Add(explainWS)
 }
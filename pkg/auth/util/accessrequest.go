@@ -0,0 +1,105 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+)
+
+const (
+	// AccessRequestConfigKey is the key of the access request config in the
+	// config of the tenant identity provider.
+	AccessRequestConfigKey = "accessRequest"
+
+	defaultMaxAccessDuration = 24 * time.Hour
+)
+
+// AccessRequestConfig describes who approves the access requests of a tenant
+// and how long the access may last.
+type AccessRequestConfig struct {
+	// Approvers are the users allowed to approve or reject the access
+	// requests, the administrators of the tenant if empty.
+	Approvers []string `json:"approvers,omitempty"`
+	// MaxDuration is the longest duration an access can be requested for.
+	MaxDuration metav1.Duration `json:"maxDuration,omitempty"`
+	// NotifyChannel is the notify channel the approvers are alerted through,
+	// no alert is sent if empty.
+	NotifyChannel string `json:"notifyChannel,omitempty"`
+	// NotifyTemplate is the template of the alert message.
+	NotifyTemplate string `json:"notifyTemplate,omitempty"`
+}
+
+// ParseAccessRequestConfig parses the access request config from the config
+// of an identity provider.
+func ParseAccessRequestConfig(config string) (*AccessRequestConfig, error) {
+	arConfig := &AccessRequestConfig{}
+	if config != "" {
+		raw := map[string]json.RawMessage{}
+		if err := json.Unmarshal([]byte(config), &raw); err != nil {
+			return nil, err
+		}
+		if data, ok := raw[AccessRequestConfigKey]; ok {
+			if err := json.Unmarshal(data, arConfig); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if arConfig.MaxDuration.Duration == 0 {
+		arConfig.MaxDuration.Duration = defaultMaxAccessDuration
+	}
+	return arConfig, nil
+}
+
+// GetAccessRequestConfig returns the access request config of the tenant,
+// the administrators of the tenant approve if no approver is configured.
+func GetAccessRequestConfig(ctx context.Context, authClient authinternalclient.AuthInterface, tenantID string) (*AccessRequestConfig, error) {
+	idp, err := authClient.IdentityProviders().Get(ctx, tenantID, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ParseAccessRequestConfig("")
+		}
+		return nil, err
+	}
+	arConfig, err := ParseAccessRequestConfig(idp.Spec.Config)
+	if err != nil {
+		return nil, err
+	}
+	if len(arConfig.Approvers) == 0 {
+		arConfig.Approvers = idp.Spec.Administrators
+	}
+	return arConfig, nil
+}
+
+// Validate checks the config itself.
+func (c *AccessRequestConfig) Validate() error {
+	if c.MaxDuration.Duration < 0 {
+		return fmt.Errorf("maxDuration must not be negative")
+	}
+	if (c.NotifyChannel == "") != (c.NotifyTemplate == "") {
+		return fmt.Errorf("notifyChannel and notifyTemplate must be specified together")
+	}
+	return nil
+}
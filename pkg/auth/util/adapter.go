@@ -21,7 +21,10 @@ package util
 import (
 	"context"
 	"fmt"
+	"strings"
+
 	authv1client "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	authv1informer "tkestack.io/tke/api/client/informers/externalversions/auth/v1"
 	authv1lister "tkestack.io/tke/api/client/listers/auth/v1"
 	"tkestack.io/tke/pkg/util/log"
 
@@ -31,6 +34,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
 	authv1 "tkestack.io/tke/api/auth/v1"
 )
 
@@ -47,6 +51,9 @@ const (
 	PRuleFieldNumber = 5
 	// GRuleFieldNumber represents the maximum number of valid value fields in the Rule object: V0, V1, V2
 	GRuleFieldNumber = 3
+
+	// RuleIndex is the name of the rule informer index keyed by the policy line of the rules.
+	RuleIndex = "rule"
 )
 
 // RestAdapter is the policy storage adapter for Casbin. With this library, Casbin can load policy
@@ -56,6 +63,9 @@ const (
 type RestAdapter struct {
 	ruleClient authv1client.RuleInterface
 	lister     authv1lister.RuleLister
+	// indexer holds the stored rules indexed by RuleIndex, the rules already
+	// stored are not written again if set.
+	indexer cache.Indexer
 }
 
 // NewAdapter creates a new adaptor instance.
@@ -68,6 +78,50 @@ func NewAdapter(ruleClient authv1client.RuleInterface, ruleLister authv1lister.R
 	return adapter
 }
 
+// NewIndexedAdapter creates a new adaptor instance that does not write the
+// rules already stored, which lets the enforcer be updated incrementally by
+// the events of the rule informer. The informer must be indexed by RuleIndex.
+func NewIndexedAdapter(ruleClient authv1client.RuleInterface, ruleInformer authv1informer.RuleInformer) *RestAdapter {
+	return &RestAdapter{
+		ruleClient: ruleClient,
+		lister:     ruleInformer.Lister(),
+		indexer:    ruleInformer.Informer().GetIndexer(),
+	}
+}
+
+// RuleIndexFunc indexes the rules by the policy line of them.
+func RuleIndexFunc(obj interface{}) ([]string, error) {
+	rule, ok := obj.(*authv1.Rule)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type: %T", obj)
+	}
+	return []string{RuleKey(rule.Spec.PType, RuleLine(rule))}, nil
+}
+
+// RuleLine returns the policy line of the rule as loaded into the model.
+func RuleLine(rule *authv1.Rule) []string {
+	casRule := rule.Spec
+	if casRule.PType == PRule {
+		return []string{casRule.V0, casRule.V1, casRule.V2, casRule.V3, casRule.V4}
+	}
+	return []string{casRule.V0, casRule.V1, casRule.V2}
+}
+
+// RuleKey returns the key of the policy line in RuleIndex.
+func RuleKey(ptype string, line []string) string {
+	return strings.Join(append([]string{ptype}, line...), ", ")
+}
+
+// stored returns true if a rule with the policy line is stored, it is always
+// false without indexer.
+func (a *RestAdapter) stored(ptype string, line []string) bool {
+	if a.indexer == nil {
+		return false
+	}
+	rules, err := a.indexer.ByIndex(RuleIndex, RuleKey(ptype, line))
+	return err == nil && len(rules) > 0
+}
+
 // LoadPolicy loads all of policys from backend
 func (a *RestAdapter) LoadPolicy(model model.Model) error {
 	rules, err := a.lister.List(labels.Everything())
@@ -86,21 +140,7 @@ func (a *RestAdapter) LoadPolicy(model model.Model) error {
 }
 
 func (a *RestAdapter) loadPolicy(rule *authv1.Rule, model model.Model) {
-	casRule := rule.Spec
-	lineText := casRule.PType
-	if casRule.PType == PRule {
-		lineText += ", " + casRule.V0
-		lineText += ", " + casRule.V1
-		lineText += ", " + casRule.V2
-		lineText += ", " + casRule.V3
-		lineText += ", " + casRule.V4
-	} else {
-		lineText += ", " + casRule.V0
-		lineText += ", " + casRule.V1
-		lineText += ", " + casRule.V2
-	}
-
-	persist.LoadPolicyLine(lineText, model)
+	persist.LoadPolicyLine(RuleKey(rule.Spec.PType, RuleLine(rule)), model)
 }
 
 // SavePolicy will rewrite all of policies in ETCD with the current data in Casbin
@@ -175,6 +215,9 @@ func (a *RestAdapter) savePolicy(ctx context.Context, rules []authv1.Rule) error
 // AddPolicy adds a policy rule to the storage.
 // Part of the Auto-Save feature.
 func (a *RestAdapter) AddPolicy(sec string, ptype string, line []string) error {
+	if a.stored(ptype, line) {
+		return nil
+	}
 	rule := ConvertRule(ptype, line)
 	if _, err := a.ruleClient.Create(context.Background(), &rule, metav1.CreateOptions{}); !apierrors.IsAlreadyExists(err) {
 		return err
@@ -186,6 +229,9 @@ func (a *RestAdapter) AddPolicy(sec string, ptype string, line []string) error {
 // RemovePolicy removes a policy rule from the storage.
 // Part of the Auto-Save feature.
 func (a *RestAdapter) RemovePolicy(sec string, ptype string, line []string) error {
+	if a.indexer != nil && !a.stored(ptype, line) {
+		return nil
+	}
 	rule := ConvertRule(ptype, line)
 	filter := a.constructRemoveSelector(rule)
 
@@ -193,6 +239,11 @@ func (a *RestAdapter) RemovePolicy(sec string, ptype string, line []string) erro
 }
 
 func (a *RestAdapter) constructRemoveSelector(rule authv1.Rule) string {
+	return RuleFieldSelector(rule)
+}
+
+// RuleFieldSelector returns the field selector matching exactly the rule.
+func RuleFieldSelector(rule authv1.Rule) string {
 	ruleFieldSet := fields.Set{}
 	ruleFieldSet["spec.ptype"] = rule.Spec.PType
 	ruleFieldSet["spec.v0"] = rule.Spec.V0
@@ -0,0 +1,40 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the “License”); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an “AS IS” BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"time"
+
+	"tkestack.io/tke/api/auth"
+)
+
+// APIKeyLastUsed returns the last time the api key was used, or the issue
+// time if it has never been used.
+func APIKeyLastUsed(apiKey *auth.APIKey) time.Time {
+	if !apiKey.Status.LastUsedTime.IsZero() {
+		return apiKey.Status.LastUsedTime.Time
+	}
+	return apiKey.Spec.IssueAt.Time
+}
+
+// APIKeyUnusedSince returns true if the api key has not been used since the
+// given time.
+func APIKeyUnusedSince(apiKey *auth.APIKey, since time.Time) bool {
+	return APIKeyLastUsed(apiKey).Before(since)
+}
@@ -0,0 +1,275 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/util"
+)
+
+// timeOfDayLayout is the layout of the start and end of a time window.
+const timeOfDayLayout = "15:04"
+
+var weekdays = map[string]time.Weekday{
+	"Sun": time.Sunday,
+	"Mon": time.Monday,
+	"Tue": time.Tuesday,
+	"Wed": time.Wednesday,
+	"Thu": time.Thursday,
+	"Fri": time.Friday,
+	"Sat": time.Saturday,
+}
+
+// PolicyConditions restricts when the statement of a policy takes effect. It
+// is stored as json in the conditions of the policy spec, and all of the
+// given conditions must be met.
+type PolicyConditions struct {
+	// TimeWindows are the periods the statement takes effect in, any of them
+	// must contain the request time.
+	TimeWindows []TimeWindow `json:"timeWindows,omitempty"`
+	// SourceCIDRs are the addresses the request must come from.
+	SourceCIDRs []string `json:"sourceCIDRs,omitempty"`
+	// ResourceLabels are the labels the requested resource must have.
+	ResourceLabels map[string]string `json:"resourceLabels,omitempty"`
+	// Projects are the projects the request must be made in.
+	Projects []string `json:"projects,omitempty"`
+	// Namespaces are the namespaces the request must be made in.
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	cidrs []*net.IPNet
+	// invalid is the parse error of conditions stored before they were
+	// validated, such conditions are never met by allow statements.
+	invalid string
+}
+
+// TimeWindow is a daily period of time, such as 09:00 to 18:00 on weekdays.
+type TimeWindow struct {
+	// Days are the days of week the window applies to, such as Mon or Sat.
+	// Every day if empty.
+	Days []string `json:"days,omitempty"`
+	// Start is the start of the window in 15:04 format.
+	Start string `json:"start"`
+	// End is the end of the window in 15:04 format, the window crosses
+	// midnight if it is before the start.
+	End string `json:"end"`
+	// TimeZone is the location name of the window, UTC if empty.
+	TimeZone string `json:"timeZone,omitempty"`
+
+	start    time.Duration
+	end      time.Duration
+	location *time.Location
+}
+
+// ConditionContext holds the properties of a request conditions are
+// evaluated on. Empty properties are unknown.
+type ConditionContext struct {
+	Time      time.Time
+	SourceIP  net.IP
+	Project   string
+	Namespace string
+	// ResourceLabels are the labels of the stored object of the requested
+	// resource, nil if unknown.
+	ResourceLabels map[string]string
+}
+
+// ParsePolicyConditions parses and validates the conditions of a policy. It
+// returns nil if the policy has no conditions.
+func ParsePolicyConditions(data []byte) (*PolicyConditions, error) {
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return nil, nil
+	}
+	conditions := &PolicyConditions{}
+	// Unknown conditions are rejected rather than ignored, ignoring them
+	// would widen the permissions of allow statements.
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(conditions); err != nil {
+		return nil, fmt.Errorf("invalid conditions: %v", err)
+	}
+
+	for i := range conditions.TimeWindows {
+		if err := conditions.TimeWindows[i].complete(); err != nil {
+			return nil, fmt.Errorf("invalid time window %d: %v", i, err)
+		}
+	}
+	for _, cidr := range conditions.SourceCIDRs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source cidr %q: %v", cidr, err)
+		}
+		conditions.cidrs = append(conditions.cidrs, ipNet)
+	}
+	for key := range conditions.ResourceLabels {
+		if key == "" {
+			return nil, fmt.Errorf("resource label key must not be empty")
+		}
+	}
+	return conditions, nil
+}
+
+// UsesResourceLabels returns true if the conditions need the labels of the
+// requested resource.
+func (c *PolicyConditions) UsesResourceLabels() bool {
+	return len(c.ResourceLabels) > 0
+}
+
+// InvalidPolicyConditions returns the conditions of a policy whose conditions
+// can not be parsed. They are never met by allow statements and always met by
+// deny statements.
+func InvalidPolicyConditions(err error) *PolicyConditions {
+	return &PolicyConditions{invalid: err.Error()}
+}
+
+func (w *TimeWindow) complete() error {
+	var err error
+	if w.start, err = parseTimeOfDay(w.Start); err != nil {
+		return fmt.Errorf("start: %v", err)
+	}
+	if w.end, err = parseTimeOfDay(w.End); err != nil {
+		return fmt.Errorf("end: %v", err)
+	}
+	if w.start == w.end {
+		return fmt.Errorf("start and end must differ")
+	}
+	for _, day := range w.Days {
+		if _, ok := weekdays[day]; !ok {
+			return fmt.Errorf("unknown day %q", day)
+		}
+	}
+	w.location = time.UTC
+	if w.TimeZone != "" {
+		if w.location, err = time.LoadLocation(w.TimeZone); err != nil {
+			return fmt.Errorf("time zone: %v", err)
+		}
+	}
+	return nil
+}
+
+func parseTimeOfDay(value string) (time.Duration, error) {
+	t, err := time.Parse(timeOfDayLayout, value)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// contains returns true if the time is in the window.
+func (w *TimeWindow) contains(t time.Time) bool {
+	t = t.In(w.location)
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	day := t.Weekday()
+	if w.start > w.end {
+		// The window crosses midnight, the part after midnight belongs to
+		// the window started the day before.
+		if offset < w.end {
+			day = (day + 6) % 7
+		} else if offset < w.start {
+			return false
+		}
+	} else if offset < w.start || offset >= w.end {
+		return false
+	}
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, d := range w.Days {
+		if weekdays[d] == day {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate checks the conditions against the request. It returns a
+// description of the first condition not met. Conditions on unknown
+// properties are only considered met for deny statements, so that they never
+// widen the permissions.
+func (c *PolicyConditions) Evaluate(effect auth.Effect, ctx *ConditionContext) (bool, string) {
+	unknown := effect == auth.Deny
+	if c.invalid != "" {
+		return unknown, c.invalid
+	}
+
+	if len(c.TimeWindows) > 0 {
+		met := false
+		for i := range c.TimeWindows {
+			if c.TimeWindows[i].contains(ctx.Time) {
+				met = true
+				break
+			}
+		}
+		if !met {
+			return false, fmt.Sprintf("timeWindows: %s is out of the time windows", ctx.Time.UTC().Format(time.RFC3339))
+		}
+	}
+
+	if len(c.cidrs) > 0 {
+		if ctx.SourceIP == nil {
+			if !unknown {
+				return false, "sourceCIDRs: source address is unknown"
+			}
+		} else {
+			met := false
+			for _, ipNet := range c.cidrs {
+				if ipNet.Contains(ctx.SourceIP) {
+					met = true
+					break
+				}
+			}
+			if !met {
+				return false, fmt.Sprintf("sourceCIDRs: %s is not in %v", ctx.SourceIP, c.SourceCIDRs)
+			}
+		}
+	}
+
+	if len(c.ResourceLabels) > 0 {
+		if ctx.ResourceLabels == nil {
+			if !unknown {
+				return false, "resourceLabels: labels of the resource are unknown"
+			}
+		} else {
+			for key, value := range c.ResourceLabels {
+				if actual, ok := ctx.ResourceLabels[key]; !ok || actual != value {
+					return false, fmt.Sprintf("resourceLabels: label %s=%s is not present", key, value)
+				}
+			}
+		}
+	}
+
+	if len(c.Projects) > 0 && !util.InStringSlice(c.Projects, ctx.Project) {
+		if ctx.Project != "" || !unknown {
+			return false, fmt.Sprintf("projects: project %q is not in %v", ctx.Project, c.Projects)
+		}
+	}
+
+	if len(c.Namespaces) > 0 && !util.InStringSlice(c.Namespaces, ctx.Namespace) {
+		if ctx.Namespace != "" || !unknown {
+			return false, fmt.Sprintf("namespaces: namespace %q is not in %v", ctx.Namespace, c.Namespaces)
+		}
+	}
+
+	return true, ""
+}
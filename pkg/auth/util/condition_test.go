@@ -0,0 +1,124 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"tkestack.io/tke/api/auth"
+)
+
+func TestParsePolicyConditions(t *testing.T) {
+	conditions, err := ParsePolicyConditions(nil)
+	if err != nil || conditions != nil {
+		t.Errorf("expected no conditions, got %v, %v", conditions, err)
+	}
+
+	invalid := []string{
+		`not json`,
+		`{"sourceCIDRs":["10.0.0.0"]}`,
+		`{"timeWindows":[{"start":"25:00","end":"18:00"}]}`,
+		`{"timeWindows":[{"start":"09:00","end":"09:00"}]}`,
+		`{"timeWindows":[{"start":"09:00","end":"18:00","days":["Monday"]}]}`,
+		`{"timeWindows":[{"start":"09:00","end":"18:00","timeZone":"Nowhere/City"}]}`,
+		`{"resourceLabels":{"":"prod"}}`,
+	}
+	for _, data := range invalid {
+		if _, err := ParsePolicyConditions([]byte(data)); err == nil {
+			t.Errorf("expected error for %s", data)
+		}
+	}
+}
+
+func TestTimeWindow(t *testing.T) {
+	conditions, err := ParsePolicyConditions([]byte(`{"timeWindows":[{"start":"22:00","end":"06:00","days":["Fri"]}]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	testCases := []struct {
+		time string
+		met  bool
+	}{
+		{"2021-01-01T23:00:00Z", true},  // Friday night
+		{"2021-01-02T05:59:00Z", true},  // Saturday morning, window started on Friday
+		{"2021-01-02T06:00:00Z", false}, // Saturday, window ended
+		{"2021-01-02T23:00:00Z", false}, // Saturday night
+		{"2021-01-01T12:00:00Z", false}, // Friday noon
+	}
+	for _, tc := range testCases {
+		now, _ := time.Parse(time.RFC3339, tc.time)
+		if met, _ := conditions.Evaluate(auth.Allow, &ConditionContext{Time: now}); met != tc.met {
+			t.Errorf("time %s: expected %v, got %v", tc.time, tc.met, met)
+		}
+	}
+}
+
+func TestEvaluateUnknownProperties(t *testing.T) {
+	conditions, err := ParsePolicyConditions([]byte(`{"sourceCIDRs":["10.0.0.0/8"],"projects":["prj-a"],"resourceLabels":{"env":"prod"}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	unknown := &ConditionContext{Time: time.Now()}
+	if met, _ := conditions.Evaluate(auth.Allow, unknown); met {
+		t.Errorf("allow statement must not be met on unknown properties")
+	}
+	if met, _ := conditions.Evaluate(auth.Deny, unknown); !met {
+		t.Errorf("deny statement must be met on unknown properties")
+	}
+
+	known := &ConditionContext{
+		Time:           time.Now(),
+		SourceIP:       net.ParseIP("10.1.2.3"),
+		Project:        "prj-a",
+		ResourceLabels: map[string]string{"env": "prod"},
+	}
+	if met, failed := conditions.Evaluate(auth.Allow, known); !met {
+		t.Errorf("expected conditions met, failed: %s", failed)
+	}
+	known.ResourceLabels = map[string]string{"env": "dev"}
+	if met, _ := conditions.Evaluate(auth.Allow, known); met {
+		t.Errorf("expected resource label condition failed")
+	}
+	known.ResourceLabels = map[string]string{}
+	if met, _ := conditions.Evaluate(auth.Deny, known); met {
+		t.Errorf("expected resource label condition failed on a resource without labels")
+	}
+	known.ResourceLabels = map[string]string{"env": "prod"}
+	known.SourceIP = net.ParseIP("192.168.0.1")
+	if met, _ := conditions.Evaluate(auth.Allow, known); met {
+		t.Errorf("expected source cidr condition failed")
+	}
+}
+
+func TestParseUnknownConditions(t *testing.T) {
+	if _, err := ParsePolicyConditions([]byte(`{"owner":"bob"}`)); err == nil {
+		t.Errorf("expected unknown conditions rejected")
+	}
+
+	conditions := InvalidPolicyConditions(fmt.Errorf("invalid conditions"))
+	if met, _ := conditions.Evaluate(auth.Allow, &ConditionContext{Time: time.Now()}); met {
+		t.Errorf("allow statement with invalid conditions must not be met")
+	}
+	if met, _ := conditions.Evaluate(auth.Deny, &ConditionContext{Time: time.Now()}); !met {
+		t.Errorf("deny statement with invalid conditions must be met")
+	}
+}
@@ -43,6 +43,7 @@ func FilterLocalIdentity(ctx context.Context, localIdentity *auth.LocalIdentity)
 	}
 
 	localIdentity.Spec.HashedPassword = ""
+	localIdentity.Status.PasswordHistory = nil
 	return nil
 }
 
@@ -219,3 +220,55 @@ func PredicateV1ListOptions(tenantID string, options *metainternal.ListOptions)
 	v1ops.FieldSelector = fields.AndSelectors(options.FieldSelector, fields.OneTermEqualSelector("spec.tenantID", tenantID)).String()
 	return v1ops
 }
+
+// FilterAccessRequest is used to filter access request that do not belong to the tenant.
+func FilterAccessRequest(ctx context.Context, accessRequest *auth.AccessRequest) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if accessRequest.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("accessRequests"), accessRequest.ObjectMeta.Name)
+	}
+
+	return nil
+}
+
+// FilterAccessReview is used to filter access review that do not belong to the tenant.
+func FilterAccessReview(ctx context.Context, accessReview *auth.AccessReview) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if accessReview.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("accessReviews"), accessReview.ObjectMeta.Name)
+	}
+
+	return nil
+}
+
+// FilterSessionRevocation is used to filter session revocation that do not belong to the tenant.
+func FilterSessionRevocation(ctx context.Context, revocation *auth.SessionRevocation) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if revocation.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("sessionRevocations"), revocation.ObjectMeta.Name)
+	}
+
+	return nil
+}
+
+// FilterTenant is used to filter tenant that do not belong to the tenant.
+func FilterTenant(ctx context.Context, tenant *auth.Tenant) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if tenant.Name != tenantID {
+		return errors.NewNotFound(v1.Resource("tenants"), tenant.ObjectMeta.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the “License”); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an “AS IS” BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// keyMatchNameRegexp matches the last ":name" of a pattern.
+var keyMatchNameRegexp = regexp.MustCompile(`(.*):[^/]+(.*)`)
+
+// KeyMatchCustom determines whether key1 matches the pattern of key2 , key2 can contain a * and :*.
+// For example, "/project:123/cluster:456" matches "/project:*/cluster:456", "registry:123/*" matches "registry:123/456"
+func KeyMatchCustom(key1 string, key2 string) bool {
+	re, err := regexp.Compile(keyMatchRegexp(key2))
+	if err != nil {
+		return false
+	}
+	// case insensitive
+	return re.MatchString(strings.ToLower(key1))
+}
+
+// ValidateKeyMatchPattern tests if the pattern can be matched by
+// KeyMatchCustom.
+func ValidateKeyMatchPattern(pattern string) error {
+	for i := 0; ; {
+		j := strings.Index(pattern[i:], "/:")
+		if j < 0 {
+			break
+		}
+		i += j + 2
+		if i == len(pattern) || pattern[i] == '/' {
+			return fmt.Errorf("\"/:\" must be followed by a name")
+		}
+	}
+	_, err := regexp.Compile(keyMatchRegexp(pattern))
+	return err
+}
+
+// keyMatchRegexp converts the pattern of KeyMatchCustom to a regular
+// expression.
+func keyMatchRegexp(key2 string) string {
+	key2 = strings.ToLower(key2)
+	key2 = strings.Replace(key2, "*", ".*", -1)
+	for strings.Contains(key2, "/:") {
+		replaced := keyMatchNameRegexp.ReplaceAllString(key2, "$1[^/]+$2")
+		// a ":" followed by "/" or by nothing is never replaced
+		if replaced == key2 {
+			break
+		}
+		key2 = replaced
+	}
+	return "^" + key2 + "$"
+}
@@ -0,0 +1,55 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import "testing"
+
+func TestKeyMatchCustom(t *testing.T) {
+	tests := []struct {
+		key     string
+		pattern string
+		matched bool
+	}{
+		{"/project:123/cluster:456", "/project:*/cluster:456", true},
+		{"registry:123/456", "registry:123/*", true},
+		{"/a/b", "/a/:name", true},
+		{"/a/b/c", "/a/:name", false},
+		{"a/", "a/:", false},
+		{"x/y", "x/:/y", false},
+		{"a", "(", false},
+	}
+	for _, tt := range tests {
+		if matched := KeyMatchCustom(tt.key, tt.pattern); matched != tt.matched {
+			t.Errorf("KeyMatchCustom(%q, %q) = %v, expected %v", tt.key, tt.pattern, matched, tt.matched)
+		}
+	}
+}
+
+func TestValidateKeyMatchPattern(t *testing.T) {
+	for _, pattern := range []string{"*", "get*", "cluster:*", "/project:*/cluster:456", "/a/:name/b"} {
+		if err := ValidateKeyMatchPattern(pattern); err != nil {
+			t.Errorf("expected %q to be valid, got %v", pattern, err)
+		}
+	}
+	for _, pattern := range []string{"a/:", "x/:/y", "/a/:b/:", "("} {
+		if err := ValidateKeyMatchPattern(pattern); err == nil {
+			t.Errorf("expected %q to be invalid", pattern)
+		}
+	}
+}
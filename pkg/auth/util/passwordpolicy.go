@@ -0,0 +1,241 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+	"unicode"
+
+	"golang.org/x/crypto/bcrypt"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+)
+
+const (
+	// PasswordPolicyConfigKey is the key of the password policy in the config
+	// of the tenant identity provider.
+	PasswordPolicyConfigKey = "passwordPolicy"
+
+	defaultMaxLoginAttempts      = 5
+	defaultMaxLoginAttemptsPerIP = 20
+	defaultLockoutDuration       = 5 * time.Minute
+	defaultMaxLockoutDuration    = time.Hour
+)
+
+// PasswordPolicy describes the password and login restrictions of the local
+// identities in a tenant.
+type PasswordPolicy struct {
+	// MinLength is the minimum length of the password, 0 means no limit.
+	MinLength int `json:"minLength,omitempty"`
+	// MinCharacterClasses is the minimum number of character classes
+	// (lower case, upper case, digit and symbol) the password must contain.
+	MinCharacterClasses int `json:"minCharacterClasses,omitempty"`
+	// MaxAge is the duration after which the password expires, 0 means never.
+	MaxAge metav1.Duration `json:"maxAge,omitempty"`
+	// HistoryDepth is the number of previous passwords that can not be reused.
+	HistoryDepth int `json:"historyDepth,omitempty"`
+	// MaxLoginAttempts is the number of consecutive failed logins of a user
+	// before it is locked temporarily.
+	MaxLoginAttempts int32 `json:"maxLoginAttempts,omitempty"`
+	// MaxLoginAttemptsPerIP is the number of consecutive failed logins from a
+	// source IP before it is locked temporarily.
+	MaxLoginAttemptsPerIP int32 `json:"maxLoginAttemptsPerIP,omitempty"`
+	// LockoutDuration is the first lockout duration, doubled on each
+	// further failure.
+	LockoutDuration metav1.Duration `json:"lockoutDuration,omitempty"`
+	// MaxLockoutDuration caps the exponential backoff of the lockout.
+	MaxLockoutDuration metav1.Duration `json:"maxLockoutDuration,omitempty"`
+}
+
+// DefaultPasswordPolicy returns the policy used when the tenant does not
+// configure one.
+func DefaultPasswordPolicy() *PasswordPolicy {
+	policy := &PasswordPolicy{}
+	policy.setDefaults()
+	return policy
+}
+
+func (p *PasswordPolicy) setDefaults() {
+	if p.MaxLoginAttempts == 0 {
+		p.MaxLoginAttempts = defaultMaxLoginAttempts
+	}
+	if p.MaxLoginAttemptsPerIP == 0 {
+		p.MaxLoginAttemptsPerIP = defaultMaxLoginAttemptsPerIP
+	}
+	if p.LockoutDuration.Duration == 0 {
+		p.LockoutDuration.Duration = defaultLockoutDuration
+	}
+	if p.MaxLockoutDuration.Duration == 0 {
+		p.MaxLockoutDuration.Duration = defaultMaxLockoutDuration
+	}
+}
+
+// ParsePasswordPolicy parses the password policy from the config of an
+// identity provider. An empty config results in the default policy.
+func ParsePasswordPolicy(config string) (*PasswordPolicy, error) {
+	policy := &PasswordPolicy{}
+	if config != "" {
+		raw := map[string]json.RawMessage{}
+		if err := json.Unmarshal([]byte(config), &raw); err != nil {
+			return nil, err
+		}
+		if data, ok := raw[PasswordPolicyConfigKey]; ok {
+			if err := json.Unmarshal(data, policy); err != nil {
+				return nil, err
+			}
+		}
+	}
+	policy.setDefaults()
+	return policy, nil
+}
+
+// GetPasswordPolicy returns the password policy of the tenant.
+func GetPasswordPolicy(ctx context.Context, authClient authinternalclient.AuthInterface, tenantID string) (*PasswordPolicy, error) {
+	idp, err := authClient.IdentityProviders().Get(ctx, tenantID, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return DefaultPasswordPolicy(), nil
+		}
+		return nil, err
+	}
+	return ParsePasswordPolicy(idp.Spec.Config)
+}
+
+// Validate checks the policy itself.
+func (p *PasswordPolicy) Validate() error {
+	if p.MinLength < 0 || p.HistoryDepth < 0 || p.MaxLoginAttempts < 0 || p.MaxLoginAttemptsPerIP < 0 {
+		return fmt.Errorf("password policy values must not be negative")
+	}
+	if p.MinCharacterClasses < 0 || p.MinCharacterClasses > 4 {
+		return fmt.Errorf("minCharacterClasses must be between 0 and 4")
+	}
+	if p.MaxAge.Duration < 0 || p.LockoutDuration.Duration < 0 || p.MaxLockoutDuration.Duration < 0 {
+		return fmt.Errorf("password policy durations must not be negative")
+	}
+	if p.MaxLockoutDuration.Duration < p.LockoutDuration.Duration {
+		return fmt.Errorf("maxLockoutDuration must not be less than lockoutDuration")
+	}
+	return nil
+}
+
+// ValidatePassword checks the plain text password against the complexity
+// requirements of the policy.
+func (p *PasswordPolicy) ValidatePassword(password string) error {
+	if len([]rune(password)) < p.MinLength {
+		return fmt.Errorf("password must be at least %d characters", p.MinLength)
+	}
+
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+	classes := 0
+	for _, has := range []bool{lower, upper, digit, symbol} {
+		if has {
+			classes++
+		}
+	}
+	if classes < p.MinCharacterClasses {
+		return fmt.Errorf("password must contain at least %d of lower case letters, upper case letters, digits and symbols", p.MinCharacterClasses)
+	}
+	return nil
+}
+
+// ValidateBase64Password decodes the base64 encoded password and checks it
+// against the policy.
+func (p *PasswordPolicy) ValidateBase64Password(password string) error {
+	decoded, err := base64.StdEncoding.DecodeString(password)
+	if err != nil {
+		return err
+	}
+	return p.ValidatePassword(string(decoded))
+}
+
+// Expired returns true if a password changed at the given time is expired.
+func (p *PasswordPolicy) Expired(updateTime time.Time, now time.Time) bool {
+	if p.MaxAge.Duration == 0 || updateTime.IsZero() {
+		return false
+	}
+	return now.Sub(updateTime) > p.MaxAge.Duration
+}
+
+// LockoutDurationFor returns the lockout duration after the given number of
+// consecutive failures, or zero if the limit is not reached yet. The duration
+// doubles with each failure beyond the limit, up to MaxLockoutDuration.
+func (p *PasswordPolicy) LockoutDurationFor(failures, limit int32) time.Duration {
+	if limit <= 0 || failures < limit {
+		return 0
+	}
+	duration := p.LockoutDuration.Duration
+	for i := limit; i < failures; i++ {
+		duration *= 2
+		if duration >= p.MaxLockoutDuration.Duration {
+			return p.MaxLockoutDuration.Duration
+		}
+	}
+	if duration > p.MaxLockoutDuration.Duration {
+		return p.MaxLockoutDuration.Duration
+	}
+	return duration
+}
+
+// UsedPassword returns true if the plain text password matches one of the
+// bcrypted and base64 encoded hashes.
+func UsedPassword(password string, hashes ...string) bool {
+	for _, hash := range hashes {
+		if hash == "" {
+			continue
+		}
+		hashBytes, err := base64.StdEncoding.DecodeString(hash)
+		if err != nil {
+			continue
+		}
+		if bcrypt.CompareHashAndPassword(hashBytes, []byte(password)) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// PushPasswordHistory prepends the hash to the history, keeping at most
+// depth entries.
+func PushPasswordHistory(history []string, hash string, depth int) []string {
+	if depth <= 0 || hash == "" {
+		return nil
+	}
+	result := append([]string{hash}, history...)
+	if len(result) > depth {
+		result = result[:depth]
+	}
+	return result
+}
@@ -0,0 +1,104 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+func TestParsePasswordPolicy(t *testing.T) {
+	policy, err := ParsePasswordPolicy(`{"passwordPolicy":{"minLength":8,"minCharacterClasses":3,"maxAge":"720h","historyDepth":3}}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if policy.MinLength != 8 || policy.MinCharacterClasses != 3 || policy.HistoryDepth != 3 || policy.MaxAge.Duration != 720*time.Hour {
+		t.Errorf("unexpected policy: %+v", policy)
+	}
+	if policy.MaxLoginAttempts != defaultMaxLoginAttempts || policy.LockoutDuration.Duration != defaultLockoutDuration {
+		t.Errorf("defaults not applied: %+v", policy)
+	}
+
+	if _, err := ParsePasswordPolicy("{}"); err != nil {
+		t.Errorf("unexpected error for empty config: %v", err)
+	}
+	if _, err := ParsePasswordPolicy("not json"); err == nil {
+		t.Errorf("expected error for invalid config")
+	}
+}
+
+func TestValidatePassword(t *testing.T) {
+	policy := &PasswordPolicy{MinLength: 8, MinCharacterClasses: 3}
+	testCases := []struct {
+		password string
+		valid    bool
+	}{
+		{"Ab1!", false},
+		{"abcdefgh", false},
+		{"abcdefg1", false},
+		{"Abcdefg1", true},
+		{"abcdef1!", true},
+	}
+	for _, tc := range testCases {
+		if err := policy.ValidatePassword(tc.password); (err == nil) != tc.valid {
+			t.Errorf("password %q: expected valid=%v, got %v", tc.password, tc.valid, err)
+		}
+	}
+}
+
+func TestLockoutDurationFor(t *testing.T) {
+	policy := DefaultPasswordPolicy()
+	testCases := []struct {
+		failures int32
+		expect   time.Duration
+	}{
+		{4, 0},
+		{5, 5 * time.Minute},
+		{6, 10 * time.Minute},
+		{8, 40 * time.Minute},
+		{9, time.Hour},
+		{30, time.Hour},
+	}
+	for _, tc := range testCases {
+		if d := policy.LockoutDurationFor(tc.failures, policy.MaxLoginAttempts); d != tc.expect {
+			t.Errorf("failures %d: expected %v, got %v", tc.failures, tc.expect, d)
+		}
+	}
+}
+
+func TestPasswordHistory(t *testing.T) {
+	var history []string
+	for _, password := range []string{"first", "second", "third"} {
+		hash, err := BcryptPassword(base64.StdEncoding.EncodeToString([]byte(password)))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		history = PushPasswordHistory(history, hash, 2)
+	}
+	if len(history) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(history))
+	}
+	if UsedPassword("first", history...) {
+		t.Errorf("expected oldest password to be dropped")
+	}
+	if !UsedPassword("third", history...) || !UsedPassword("second", history...) {
+		t.Errorf("expected recent passwords to be found")
+	}
+}
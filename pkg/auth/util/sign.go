@@ -38,6 +38,9 @@ var (
 	DefaultAPISigningKey = "default-api-signing-key"
 )
 
+// KeyIDHeader is the header of the api key holding the id of the signing key.
+const KeyIDHeader = "kid"
+
 // APIClaims is the claims section of jwt token.
 type APIClaims struct {
 	*jwt.StandardClaims
@@ -104,6 +107,9 @@ func (g genericKeySigner) Generate(ctx context.Context, username string, tenantI
 		log.Error("Failed to unmarshal signing priv key", log.ByteString("signingKey", keys.SigningKey), log.Err(err))
 		return nil, err
 	}
+	if keyID := SigningKeyID(keys, privKey); keyID != "" {
+		claims.Header[KeyIDHeader] = keyID
+	}
 
 	apiKeyStr, err := claims.SignedString(privKey.Key)
 	if err != nil {
@@ -131,15 +137,25 @@ func (g genericKeySigner) Verify(ctx context.Context, apiKey string) (*APIClaims
 		return nil, err
 	}
 
-	var pubKey jose.JSONWebKey
-	if err := pubKey.UnmarshalJSON(keys.SigningKeyPub); err != nil {
-		log.Error("Failed to unmarshal signing pub key", log.ByteString("signingPubKey", keys.SigningKeyPub), log.Err(err))
+	candidates, err := verificationKeys(keys, apiKey)
+	if err != nil {
 		return nil, err
 	}
 
-	result, err := jwt.ParseWithClaims(apiKey, &APIClaims{}, func(token *jwt.Token) (interface{}, error) {
-		return pubKey.Key, nil
-	})
+	var (
+		result *jwt.Token
+		i      int
+	)
+	// Api keys signed before the key id was introduced have no kid header,
+	// every key is tried for them.
+	for i = range candidates {
+		result, err = jwt.ParseWithClaims(apiKey, &APIClaims{}, func(token *jwt.Token) (interface{}, error) {
+			return candidates[i].Key, nil
+		})
+		if ve, ok := err.(*jwt.ValidationError); !ok || ve.Errors&jwt.ValidationErrorSignatureInvalid == 0 {
+			break
+		}
+	}
 
 	var (
 		claims *APIClaims
@@ -174,3 +190,64 @@ func (g genericKeySigner) Verify(ctx context.Context, apiKey string) (*APIClaims
 
 	return claims, fmt.Errorf("token is invalid")
 }
+
+// SigningKeyID returns the key ID of the signing key, the signing keys
+// generated before the key id field was introduced keep it in the jwk.
+func SigningKeyID(keys *auth.APISigningKey, privKey jose.JSONWebKey) string {
+	if keys.KeyID != "" {
+		return keys.KeyID
+	}
+	return privKey.KeyID
+}
+
+// PublicKeys returns the public keys api keys are verified with, the current
+// signing key first and then the retired keys not expired yet.
+func PublicKeys(keys *auth.APISigningKey) ([]jose.JSONWebKey, error) {
+	var pubKey jose.JSONWebKey
+	if err := pubKey.UnmarshalJSON(keys.SigningKeyPub); err != nil {
+		log.Error("Failed to unmarshal signing pub key", log.ByteString("signingPubKey", keys.SigningKeyPub), log.Err(err))
+		return nil, err
+	}
+	if keys.KeyID != "" {
+		pubKey.KeyID = keys.KeyID
+	}
+	pubKeys := []jose.JSONWebKey{pubKey}
+
+	now := time.Now()
+	for _, verificationKey := range keys.VerificationKeys {
+		if verificationKey.Expiry.Time.Before(now) {
+			continue
+		}
+		var key jose.JSONWebKey
+		if err := key.UnmarshalJSON(verificationKey.PublicKey); err != nil {
+			log.Warn("Failed to unmarshal verification key", log.String("keyID", verificationKey.KeyID), log.Err(err))
+			continue
+		}
+		key.KeyID = verificationKey.KeyID
+		pubKeys = append(pubKeys, key)
+	}
+	return pubKeys, nil
+}
+
+// verificationKeys returns the public keys the api key may be signed with.
+func verificationKeys(keys *auth.APISigningKey, apiKey string) ([]jose.JSONWebKey, error) {
+	pubKeys, err := PublicKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	token, _, err := new(jwt.Parser).ParseUnverified(apiKey, &APIClaims{})
+	if err != nil {
+		return nil, fmt.Errorf("not valid jwt token format")
+	}
+	keyID, _ := token.Header[KeyIDHeader].(string)
+	if keyID == "" {
+		return pubKeys, nil
+	}
+	for _, key := range pubKeys {
+		if key.KeyID == keyID {
+			return []jose.JSONWebKey{key}, nil
+		}
+	}
+	return nil, fmt.Errorf("token is signed with unknown key %s", keyID)
+}
@@ -0,0 +1,88 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gopkg.in/square/go-jose.v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/api/client/clientset/internalversion/fake"
+	"tkestack.io/tke/pkg/util/pkiutil"
+)
+
+func newTestSigningKey(t *testing.T, keyID string) ([]byte, []byte) {
+	key, err := pkiutil.NewPrivateKey()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	privKey, _ := (&jose.JSONWebKey{Key: key, KeyID: keyID, Algorithm: "RS256", Use: "sig"}).MarshalJSON()
+	pubKey, _ := (&jose.JSONWebKey{Key: key.Public(), KeyID: keyID, Algorithm: "RS256", Use: "sig"}).MarshalJSON()
+	return privKey, pubKey
+}
+
+func TestVerifyRotatedKey(t *testing.T) {
+	ctx := context.Background()
+	oldPriv, oldPub := newTestSigningKey(t, "old")
+	client := fake.NewSimpleClientset().Auth()
+	if _, err := client.APISigningKeys().Create(ctx, &auth.APISigningKey{
+		ObjectMeta:    metav1.ObjectMeta{Name: DefaultAPISigningKey},
+		SigningKey:    oldPriv,
+		SigningKeyPub: oldPub,
+		KeyID:         "old",
+	}, metav1.CreateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	signer := NewGenericKeySigner(client)
+
+	apiKey, err := signer.Generate(ctx, "admin", "default", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	keys, _ := client.APISigningKeys().Get(ctx, DefaultAPISigningKey, metav1.GetOptions{})
+	keys.SigningKey, keys.SigningKeyPub = newTestSigningKey(t, "new")
+	keys.KeyID = "new"
+	keys.VerificationKeys = []auth.VerificationKey{{KeyID: "old", PublicKey: oldPub, Expiry: metav1.NewTime(time.Now().Add(time.Hour))}}
+	if _, err := client.APISigningKeys().Update(ctx, keys, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := signer.Verify(ctx, apiKey.Spec.APIkey); err != nil {
+		t.Errorf("api key signed with the retired key must be verified: %v", err)
+	}
+
+	newKey, err := signer.Generate(ctx, "admin", "default", time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := signer.Verify(ctx, newKey.Spec.APIkey); err != nil {
+		t.Errorf("api key signed with the current key must be verified: %v", err)
+	}
+
+	keys.VerificationKeys[0].Expiry = metav1.NewTime(time.Now().Add(-time.Minute))
+	if _, err := client.APISigningKeys().Update(ctx, keys, metav1.UpdateOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := signer.Verify(ctx, apiKey.Spec.APIkey); err == nil {
+		t.Errorf("api key signed with the expired key must not be verified")
+	}
+}
@@ -0,0 +1,295 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package emigration
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	v1 "tkestack.io/tke/api/business/v1"
+	businessns "tkestack.io/tke/pkg/business/controller/namespace"
+	cls "tkestack.io/tke/pkg/business/controller/namespace/cluster"
+	businessutil "tkestack.io/tke/pkg/business/util"
+	"tkestack.io/tke/pkg/platform/util"
+)
+
+// processClusterMigration moves the workloads of a namespace to another
+// cluster in the same project. The phases are:
+// Pending -> NewOneCreated -> ResourcesMigrated -> Finished, and with cut-over
+// ResourcesMigrated -> OldOneScaledDown -> OldOneTerminating -> Finished.
+func (c *Controller) processClusterMigration(ctx context.Context, emigration *v1.NsEmigration) error {
+	switch emigration.Status.Phase {
+	case v1.NsEmigrationPending:
+		return c.processClusterPending(ctx, emigration)
+	case v1.NsEmigrationNewOneCreated:
+		return c.processClusterNewOneCreated(ctx, emigration)
+	case v1.NsEmigrationResourcesMigrated:
+		return c.processResourcesMigrated(ctx, emigration)
+	case v1.NsEmigrationOldOneScaledDown:
+		return c.processOldOneScaledDown(ctx, emigration)
+	case v1.NsEmigrationOldOneTerminating:
+		return c.processOldOneTerminating(ctx, emigration)
+	case v1.NsEmigrationFinished:
+		return c.processFinished(ctx, emigration)
+	default:
+		return c.processOthers(ctx, emigration)
+	}
+}
+
+func (c *Controller) failEmigration(ctx context.Context, emigration *v1.NsEmigration, message string, err error) error {
+	emigration.Status.Message = fmt.Sprintf("%s, %s", emigration.Status.Phase, message)
+	emigration.Status.Phase = v1.NsEmigrationFailed
+	if err != nil {
+		emigration.Status.Reason = err.Error()
+	}
+	emigration.Status.LastTransitionTime = metav1.Now()
+	return c.persistUpdateEmigration(ctx, emigration)
+}
+
+func (c *Controller) transitEmigration(ctx context.Context, emigration *v1.NsEmigration, phase v1.NsEmigrationPhase, message string) error {
+	emigration.Status.Phase = phase
+	emigration.Status.Message = message
+	emigration.Status.Reason = ""
+	emigration.Status.LastTransitionTime = metav1.Now()
+	return c.persistUpdateEmigration(ctx, emigration)
+}
+
+// newNamespaceName returns the name of the namespace in the destination cluster.
+func newNamespaceName(emigration *v1.NsEmigration, oldNS *v1.Namespace) string {
+	return fmt.Sprintf("%s-%s", emigration.Spec.DestinationCluster, oldNS.Spec.Namespace)
+}
+
+func (c *Controller) processClusterPending(ctx context.Context, emigration *v1.NsEmigration) error {
+	oldNS, err := c.getOldNamespace(ctx, emigration)
+	if err != nil {
+		return c.persistUpdateEmigration(ctx, emigration)
+	}
+	if oldNS.Status.Phase != v1.NamespaceAvailable {
+		return c.failEmigration(ctx, emigration, fmt.Sprintf("namespace %s/%s is NOT in phase %s",
+			oldNS.Namespace, oldNS.Spec.Namespace, oldNS.Status.Phase), nil)
+	}
+
+	if emigration.Spec.TransferQuota {
+		// Without cut-over the source namespace keeps its quota, so the quota
+		// would never be released.
+		if !emigration.Spec.CutOver {
+			return c.failEmigration(ctx, emigration, "transferring the quota requires cut-over", nil)
+		}
+		// The quota is recorded before it is added to the destination cluster,
+		// so that it is released or rolled back whatever happens next.
+		if emigration.Status.TransferredQuota == nil {
+			emigration.Status.SourceCluster = oldNS.Spec.ClusterName
+			emigration.Status.TransferredQuota = v1.ResourceList{}
+			for k, v := range oldNS.Spec.Hard {
+				emigration.Status.TransferredQuota[k] = v.DeepCopy()
+			}
+			return c.persistUpdateEmigration(ctx, emigration)
+		}
+		if err := c.updateTransferredQuota(ctx, emigration, quotaTransferAdd); err != nil {
+			return c.failEmigration(ctx, emigration, fmt.Sprintf("failed to transfer quota of namespace %s/%s to cluster %s",
+				oldNS.Namespace, oldNS.Spec.Namespace, emigration.Spec.DestinationCluster), err)
+		}
+	}
+
+	emigration.Status.SourceCluster = oldNS.Spec.ClusterName
+
+	newNS := v1.Namespace{}
+	newNS.Namespace = emigration.Namespace
+	newNS.Spec.TenantID = oldNS.Spec.TenantID
+	newNS.Spec.ClusterName = emigration.Spec.DestinationCluster
+	newNS.Spec.Namespace = oldNS.Spec.Namespace
+	newNS.Spec.Hard = oldNS.Spec.Hard
+	if _, err := c.client.BusinessV1().Namespaces(newNS.Namespace).Create(ctx, &newNS, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return c.failEmigration(ctx, emigration, fmt.Sprintf("failed to create namespace %s in cluster %s",
+			oldNS.Spec.Namespace, emigration.Spec.DestinationCluster), err)
+	}
+	return c.transitEmigration(ctx, emigration, v1.NsEmigrationNewOneCreated, "")
+}
+
+func (c *Controller) processClusterNewOneCreated(ctx context.Context, emigration *v1.NsEmigration) error {
+	oldNS, err := c.getOldNamespace(ctx, emigration)
+	if err != nil {
+		return c.persistUpdateEmigration(ctx, emigration)
+	}
+	newNS, err := c.client.BusinessV1().Namespaces(emigration.Namespace).Get(ctx, newNamespaceName(emigration, oldNS), metav1.GetOptions{})
+	if err != nil {
+		return c.failEmigration(ctx, emigration, fmt.Sprintf("failed to check status of namespace %s in cluster %s",
+			oldNS.Spec.Namespace, emigration.Spec.DestinationCluster), err)
+	}
+	// waiting for newNS to be NamespaceAvailable
+	if newNS.Status.Phase != v1.NamespaceAvailable {
+		if newNS.Status.Phase != v1.NamespacePending {
+			return c.failEmigration(ctx, emigration, fmt.Sprintf("status of namespace %s in cluster %s is %s",
+				oldNS.Spec.Namespace, emigration.Spec.DestinationCluster, newNS.Status.Phase), nil)
+		}
+		emigration.Status.LastTransitionTime = metav1.Now()
+		return c.persistUpdateEmigration(ctx, emigration)
+	}
+
+	source, err := util.BuildExternalClientSetWithName(ctx, c.platformClient, oldNS.Spec.ClusterName)
+	if err != nil {
+		return c.failEmigration(ctx, emigration, fmt.Sprintf("failed to connect cluster %s", oldNS.Spec.ClusterName), err)
+	}
+	target, err := util.BuildExternalClientSetWithName(ctx, c.platformClient, emigration.Spec.DestinationCluster)
+	if err != nil {
+		return c.failEmigration(ctx, emigration, fmt.Sprintf("failed to connect cluster %s", emigration.Spec.DestinationCluster), err)
+	}
+	created, err := cls.MigrateNamespaceResources(ctx, source, target, oldNS.Spec.Namespace)
+	if err != nil {
+		return c.failEmigration(ctx, emigration, fmt.Sprintf("failed to migrate resources of namespace %s to cluster %s, %d resources migrated",
+			oldNS.Spec.Namespace, emigration.Spec.DestinationCluster, created), err)
+	}
+	return c.transitEmigration(ctx, emigration, v1.NsEmigrationResourcesMigrated,
+		fmt.Sprintf("%d resources migrated to cluster %s", created, emigration.Spec.DestinationCluster))
+}
+
+func (c *Controller) processResourcesMigrated(ctx context.Context, emigration *v1.NsEmigration) error {
+	if !emigration.Spec.CutOver {
+		return c.transitEmigration(ctx, emigration, v1.NsEmigrationFinished, emigration.Status.Message)
+	}
+
+	oldNS, err := c.getOldNamespace(ctx, emigration)
+	if err != nil {
+		return c.persistUpdateEmigration(ctx, emigration)
+	}
+	if oldNS.Status.Phase != v1.NamespaceLocked {
+		oldNS.Status.Phase = v1.NamespaceLocked
+		if err := businessns.PersistUpdateNamesapce(ctx, c.client, oldNS); err != nil {
+			return c.failEmigration(ctx, emigration, fmt.Sprintf("failed to lock namespace %s/%s",
+				oldNS.Namespace, oldNS.Spec.Namespace), err)
+		}
+	}
+	kubeClient, err := util.BuildExternalClientSetWithName(ctx, c.platformClient, oldNS.Spec.ClusterName)
+	if err != nil {
+		return c.failEmigration(ctx, emigration, fmt.Sprintf("failed to connect cluster %s", oldNS.Spec.ClusterName), err)
+	}
+	if err := cls.ScaleDownNamespaceWorkloads(ctx, kubeClient, oldNS.Spec.Namespace); err != nil {
+		return c.failEmigration(ctx, emigration, fmt.Sprintf("failed to scale down workloads of namespace %s in cluster %s",
+			oldNS.Spec.Namespace, oldNS.Spec.ClusterName), err)
+	}
+	return c.transitEmigration(ctx, emigration, v1.NsEmigrationOldOneScaledDown, "")
+}
+
+func (c *Controller) processOldOneScaledDown(ctx context.Context, emigration *v1.NsEmigration) error {
+	oldNS, err := c.client.BusinessV1().Namespaces(emigration.Namespace).Get(ctx, emigration.Spec.Namespace, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return c.transitEmigration(ctx, emigration, v1.NsEmigrationOldOneTerminating, "")
+	}
+	if err != nil {
+		return c.failEmigration(ctx, emigration, fmt.Sprintf("failed to check status of namespace %s/%s",
+			emigration.Namespace, emigration.Spec.NsShowName), err)
+	}
+	// The namespace in the source cluster is detached rather than deleted,
+	// so that the data of its volumes is kept.
+	if err := c.detachFromClusterNamespace(ctx, oldNS); err != nil {
+		return c.failEmigration(ctx, emigration, fmt.Sprintf("failed to detach namespace %s/%s from cluster",
+			oldNS.Namespace, oldNS.Spec.Namespace), err)
+	}
+	background := metav1.DeletePropagationBackground
+	deleteOpt := metav1.DeleteOptions{PropagationPolicy: &background}
+	if err := c.client.BusinessV1().Namespaces(oldNS.Namespace).Delete(ctx, oldNS.Name, deleteOpt); err != nil && !errors.IsNotFound(err) {
+		return c.failEmigration(ctx, emigration, fmt.Sprintf("failed to delete namespace %s/%s",
+			oldNS.Namespace, oldNS.Spec.Namespace), err)
+	}
+	return c.transitEmigration(ctx, emigration, v1.NsEmigrationOldOneTerminating, "")
+}
+
+// quotaTransferOp is a step of the quota transfer of an emigration.
+type quotaTransferOp int
+
+const (
+	// quotaTransferAdd adds the transferred quota to the destination cluster.
+	quotaTransferAdd quotaTransferOp = iota
+	// quotaTransferRelease releases the transferred quota from the source
+	// cluster once the emigration finishes.
+	quotaTransferRelease
+	// quotaTransferRollback removes the transferred quota from the destination
+	// cluster once the emigration fails.
+	quotaTransferRollback
+)
+
+// quotaTransferAnnotation returns the annotation of the project marking the
+// quota of the emigration added to the destination cluster and not settled.
+func quotaTransferAnnotation(emigration *v1.NsEmigration) string {
+	return businessutil.AnnotationQuotaTransferPrefix + string(emigration.UID)
+}
+
+// updateTransferredQuota applies a step of the quota transfer to the clusters
+// of the project. The step and the annotation marking the transfer are updated
+// together, so every step takes effect exactly once however often it is
+// retried.
+func (c *Controller) updateTransferredQuota(ctx context.Context, emigration *v1.NsEmigration, op quotaTransferOp) error {
+	annotation := quotaTransferAnnotation(emigration)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		project, err := c.client.BusinessV1().Projects().Get(ctx, emigration.Namespace, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		_, added := project.Annotations[annotation]
+		switch {
+		case op == quotaTransferAdd && !added:
+			project.Spec.Clusters = transferQuota(project.Spec.Clusters, emigration.Spec.DestinationCluster, emigration.Status.TransferredQuota, true)
+			if project.Annotations == nil {
+				project.Annotations = map[string]string{}
+			}
+			project.Annotations[annotation] = emigration.Name
+		case op == quotaTransferRelease && added:
+			project.Spec.Clusters = transferQuota(project.Spec.Clusters, emigration.Status.SourceCluster, emigration.Status.TransferredQuota, false)
+			delete(project.Annotations, annotation)
+		case op == quotaTransferRollback && added:
+			project.Spec.Clusters = transferQuota(project.Spec.Clusters, emigration.Spec.DestinationCluster, emigration.Status.TransferredQuota, false)
+			delete(project.Annotations, annotation)
+		default:
+			return nil
+		}
+		_, err = c.client.BusinessV1().Projects().Update(ctx, project, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// transferQuota adds the quota to the cluster of the project, or subtracts
+// the quota from the cluster of the project.
+func transferQuota(clusters v1.ClusterHard, clusterName string, hard v1.ResourceList, add bool) v1.ClusterHard {
+	if clusters == nil {
+		clusters = v1.ClusterHard{}
+	}
+	clusterHard := v1.ResourceList{}
+	for k, v := range clusters[clusterName].Hard {
+		clusterHard[k] = v.DeepCopy()
+	}
+	for k, v := range hard {
+		quantity := clusterHard[k]
+		if add {
+			quantity.Add(v)
+		} else if _, ok := clusterHard[k]; ok {
+			quantity.Sub(v)
+			if quantity.Sign() < 0 {
+				quantity.Set(0)
+			}
+		} else {
+			continue
+		}
+		clusterHard[k] = quantity
+	}
+	clusters[clusterName] = v1.HardQuantity{Hard: clusterHard}
+	return clusters
+}
@@ -0,0 +1,146 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package emigration
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/business/v1"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+)
+
+func newQuotaTransferController(t *testing.T) (*Controller, *v1.NsEmigration) {
+	project := &v1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "prj"},
+		Spec: v1.ProjectSpec{Clusters: v1.ClusterHard{
+			"src": {Hard: v1.ResourceList{"requests.cpu": resource.MustParse("4")}},
+			"dst": {Hard: v1.ResourceList{"requests.cpu": resource.MustParse("2")}},
+		}},
+	}
+	emigration := &v1.NsEmigration{
+		ObjectMeta: metav1.ObjectMeta{Name: "nsem", Namespace: "prj", UID: "uid"},
+		Spec: v1.NsEmigrationSpec{
+			Mode:               v1.NsEmigrationModeCluster,
+			DestinationCluster: "dst",
+			TransferQuota:      true,
+			CutOver:            true,
+		},
+		Status: v1.NsEmigrationStatus{
+			Phase:            v1.NsEmigrationFailed,
+			SourceCluster:    "src",
+			TransferredQuota: v1.ResourceList{"requests.cpu": resource.MustParse("1")},
+		},
+	}
+	return &Controller{client: fake.NewSimpleClientset(project, emigration)}, emigration
+}
+
+func (c *Controller) clusterQuota(t *testing.T, clusterName string) string {
+	project, err := c.client.BusinessV1().Projects().Get(context.Background(), "prj", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	quantity := project.Spec.Clusters[clusterName].Hard["requests.cpu"]
+	return quantity.String()
+}
+
+func TestUpdateTransferredQuota(t *testing.T) {
+	ctx := context.Background()
+	c, emigration := newQuotaTransferController(t)
+
+	// every step takes effect once however often it is retried.
+	for i := 0; i < 2; i++ {
+		if err := c.updateTransferredQuota(ctx, emigration, quotaTransferAdd); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := c.clusterQuota(t, "dst"); got != "3" {
+		t.Errorf("destination quota after add = %s, want 3", got)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := c.updateTransferredQuota(ctx, emigration, quotaTransferRelease); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := c.clusterQuota(t, "src"); got != "3" {
+		t.Errorf("source quota after release = %s, want 3", got)
+	}
+
+	// the released transfer is not rolled back.
+	if err := c.updateTransferredQuota(ctx, emigration, quotaTransferRollback); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.clusterQuota(t, "dst"); got != "3" {
+		t.Errorf("destination quota after rollback of released transfer = %s, want 3", got)
+	}
+}
+
+func TestFailedEmigrationRollsBackQuota(t *testing.T) {
+	ctx := context.Background()
+	c, emigration := newQuotaTransferController(t)
+	if err := c.updateTransferredQuota(ctx, emigration, quotaTransferAdd); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.processOthers(ctx, emigration.DeepCopy()); err != nil {
+		t.Fatal(err)
+	}
+	if got := c.clusterQuota(t, "dst"); got != "2" {
+		t.Errorf("destination quota after failure = %s, want 2", got)
+	}
+	if got := c.clusterQuota(t, "src"); got != "4" {
+		t.Errorf("source quota after failure = %s, want 4", got)
+	}
+	updated, err := c.client.BusinessV1().NsEmigrations("prj").Get(ctx, "nsem", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Status.TransferredQuota != nil {
+		t.Errorf("transferred quota is kept after the rollback: %v", updated.Status.TransferredQuota)
+	}
+}
+
+func TestProcessClusterPendingRequiresCutOver(t *testing.T) {
+	ctx := context.Background()
+	c, emigration := newQuotaTransferController(t)
+	emigration.Spec.CutOver = false
+	emigration.Status = v1.NsEmigrationStatus{Phase: v1.NsEmigrationPending}
+	ns := &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "src-ns", Namespace: "prj"},
+		Spec:       v1.NamespaceSpec{ClusterName: "src", Namespace: "ns"},
+		Status:     v1.NamespaceStatus{Phase: v1.NamespaceAvailable},
+	}
+	emigration.Spec.Namespace = ns.Name
+	if _, err := c.client.BusinessV1().Namespaces("prj").Create(ctx, ns, metav1.CreateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.processClusterPending(ctx, emigration); err != nil {
+		t.Fatal(err)
+	}
+	if emigration.Status.Phase != v1.NsEmigrationFailed {
+		t.Errorf("emigration transferring quota without cut-over is %s, want failed", emigration.Status.Phase)
+	}
+	if got := c.clusterQuota(t, "dst"); got != "2" {
+		t.Errorf("destination quota = %s, want 2", got)
+	}
+}
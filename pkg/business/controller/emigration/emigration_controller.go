@@ -217,6 +217,9 @@ func (c *Controller) syncItem(key string) error {
 }
 
 func (c *Controller) processUpdate(ctx context.Context, emigration *v1.NsEmigration) error {
+	if emigration.Spec.Mode == v1.NsEmigrationModeCluster {
+		return c.processClusterMigration(ctx, emigration)
+	}
 	switch emigration.Status.Phase {
 	case v1.NsEmigrationPending:
 		return c.processPending(ctx, emigration)
@@ -407,6 +410,13 @@ func (c *Controller) processOldOneTerminating(ctx context.Context, emigration *v
 		emigration.Status.LastTransitionTime = metav1.Now()
 		return c.persistUpdateEmigration(ctx, emigration)
 	}
+	if emigration.Status.TransferredQuota != nil {
+		// The quota of the old namespace is released after it has been
+		// deleted, retry until the project no longer allocates it.
+		if err := c.updateTransferredQuota(ctx, emigration, quotaTransferRelease); err != nil {
+			return err
+		}
+	}
 	emigration.Status.Phase = v1.NsEmigrationFinished
 	emigration.Status.LastTransitionTime = metav1.Now()
 	return c.persistUpdateEmigration(ctx, emigration)
@@ -421,7 +431,16 @@ func (c *Controller) processFinished(ctx context.Context, emigration *v1.NsEmigr
 
 func (c *Controller) processOthers(ctx context.Context, emigration *v1.NsEmigration) error {
 	if emigration.Status.Phase == v1.NsEmigrationFailed {
-		return nil
+		if emigration.Status.TransferredQuota == nil {
+			return nil
+		}
+		// The quota added to the destination cluster is rolled back whichever
+		// step failed, retry until the project no longer allocates it.
+		if err := c.updateTransferredQuota(ctx, emigration, quotaTransferRollback); err != nil {
+			return err
+		}
+		emigration.Status.TransferredQuota = nil
+		return c.persistUpdateEmigration(ctx, emigration)
 	}
 	emigration.Status.Message = fmt.Sprintf("invalid emigration phase %s", emigration.Status.Phase)
 	emigration.Status.Phase = v1.NsEmigrationFailed
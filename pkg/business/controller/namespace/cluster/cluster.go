@@ -25,6 +25,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	v1 "tkestack.io/tke/api/business/v1"
@@ -35,23 +36,52 @@ import (
 
 func CalculateNamespaceUsed(ctx context.Context, kubeClient *kubernetes.Clientset, namespace *v1.Namespace) (message, reason string, list v1.ResourceList) {
 	list = make(v1.ResourceList)
-	resourceQuotaList, err := kubeClient.CoreV1().ResourceQuotas(namespace.Spec.Namespace).List(ctx, metav1.ListOptions{Limit: 1})
-	if err != nil {
+	resourceQuota, err := kubeClient.CoreV1().ResourceQuotas(namespace.Spec.Namespace).Get(ctx, namespace.Spec.Namespace, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
 		message = "ConnectClusterError"
 		reason = err.Error()
 		return
 	}
-	if len(resourceQuotaList.Items) == 0 {
-		message = "ResourceQuotaNotFound"
-		reason = "ResourceQuota in the business cluster did not find"
-		return
+	if err != nil {
+		// For historic namespaces whose resource quota is not named after the namespace
+		resourceQuotaList, err := kubeClient.CoreV1().ResourceQuotas(namespace.Spec.Namespace).List(ctx, metav1.ListOptions{Limit: 1})
+		if err != nil {
+			message = "ConnectClusterError"
+			reason = err.Error()
+			return
+		}
+		if len(resourceQuotaList.Items) == 0 {
+			message = "ResourceQuotaNotFound"
+			reason = "ResourceQuota in the business cluster did not find"
+			return
+		}
+		resourceQuota = &resourceQuotaList.Items[0]
 	}
 	message = ""
 	reason = ""
-	list = resource.ConvertFromCoreV1ResourceList(resourceQuotaList.Items[0].Status.Used)
+	list = resource.ConvertFromCoreV1ResourceList(resourceQuota.Status.Used)
+	// The extended resources, object counts and storage classes that nothing
+	// has been used yet are not reported by the resource quota.
+	for k := range quotaResourceList(namespace.Spec.Hard) {
+		if _, ok := list[string(k)]; !ok {
+			list[string(k)] = apiresource.Quantity{}
+		}
+	}
 	return
 }
 
+// quotaResourceList converts the hard of the namespace to the resource list
+// of the resource quota, in which the extended resources can only be limited
+// by requests.
+func quotaResourceList(hard v1.ResourceList) corev1.ResourceList {
+	resourceList := make(map[string]apiresource.Quantity, len(hard))
+	for k, v := range hard {
+		resourceList[k] = v
+	}
+	resource.NormalizeQuotaResourceNames(resourceList)
+	return resource.ConvertToCoreV1ResourceList(resourceList)
+}
+
 func CheckNamespaceOnCluster(ctx context.Context, kubeClient *kubernetes.Clientset, namespace *v1.Namespace) (message, reason string) {
 	ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, namespace.Spec.Namespace, metav1.GetOptions{})
 	if err != nil && errors.IsNotFound(err) {
@@ -134,7 +164,7 @@ func EnsureResourceQuotaOnCluster(ctx context.Context, kubeClient *kubernetes.Cl
 	resourceQuota, err := kubeClient.CoreV1().ResourceQuotas(namespace.Spec.Namespace).Get(ctx, namespace.Spec.Namespace, metav1.GetOptions{})
 	if err != nil && errors.IsNotFound(err) {
 		// create resource quota
-		resourceList := resource.ConvertToCoreV1ResourceList(namespace.Spec.Hard)
+		resourceList := quotaResourceList(namespace.Spec.Hard)
 		rq := &corev1.ResourceQuota{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      namespace.Spec.Namespace,
@@ -155,7 +185,7 @@ func EnsureResourceQuotaOnCluster(ctx context.Context, kubeClient *kubernetes.Cl
 		log.Error("Failed to get the resource quota on cluster", log.String("namespace", namespace.Spec.Namespace), log.String("namespaceName", namespace.ObjectMeta.Name), log.String("clusterName", namespace.Spec.ClusterName), log.Err(err))
 		return err
 	}
-	resourceList := resource.ConvertToCoreV1ResourceList(namespace.Spec.Hard)
+	resourceList := quotaResourceList(namespace.Spec.Hard)
 	if !reflect.DeepEqual(resourceQuota.Spec.Hard, resourceList) {
 		resourceQuota.Spec.Hard = resourceList
 		_, err := kubeClient.CoreV1().ResourceQuotas(namespace.Spec.Namespace).Update(ctx, resourceQuota, metav1.UpdateOptions{})
@@ -0,0 +1,328 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	v1 "tkestack.io/tke/api/business/v1"
+)
+
+const (
+	// FreezeResourceQuotaName is the resource quota stopping new pods from
+	// running in the namespaces of a frozen project.
+	FreezeResourceQuotaName = "tke-project-freeze"
+
+	// WorkloadKindDeployment is the kind of the Deployments scaled by freezing.
+	WorkloadKindDeployment = "Deployment"
+	// WorkloadKindStatefulSet is the kind of the StatefulSets scaled by freezing.
+	WorkloadKindStatefulSet = "StatefulSet"
+	// WorkloadKindDaemonSet is the kind of the DaemonSets moved off all nodes
+	// by freezing.
+	WorkloadKindDaemonSet = "DaemonSet"
+	// WorkloadKindJob is the kind of the Jobs whose parallelism is set to zero
+	// by freezing.
+	WorkloadKindJob = "Job"
+	// WorkloadKindCronJob is the kind of the CronJobs suspended by freezing.
+	WorkloadKindCronJob = "CronJob"
+
+	// FrozenNodeSelectorKey is the node selector of the frozen DaemonSets,
+	// which matches no node.
+	FrozenNodeSelectorKey = "tkestack.io/project-frozen"
+	// AnnotationFrozenNodeSelector records the node selector of a frozen
+	// DaemonSet to restore when it is unfrozen.
+	AnnotationFrozenNodeSelector = "tkestack.io/frozen-node-selector"
+)
+
+// ListNamespaceWorkloadReplicas returns the replicas of the workloads of the
+// namespace that are not scaled down. The DaemonSets and CronJobs running are
+// returned with one replica, and the Jobs with their parallelism.
+func ListNamespaceWorkloadReplicas(ctx context.Context, kubeClient kubernetes.Interface, namespace string) ([]v1.FrozenWorkload, error) {
+	var workloads []v1.FrozenWorkload
+	deployments, err := kubeClient.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range deployments.Items {
+		if item.Spec.Replicas == nil || *item.Spec.Replicas != 0 {
+			replicas := int32(1)
+			if item.Spec.Replicas != nil {
+				replicas = *item.Spec.Replicas
+			}
+			workloads = append(workloads, v1.FrozenWorkload{Kind: WorkloadKindDeployment, Name: item.Name, Replicas: replicas})
+		}
+	}
+
+	statefulSets, err := kubeClient.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range statefulSets.Items {
+		if item.Spec.Replicas == nil || *item.Spec.Replicas != 0 {
+			replicas := int32(1)
+			if item.Spec.Replicas != nil {
+				replicas = *item.Spec.Replicas
+			}
+			workloads = append(workloads, v1.FrozenWorkload{Kind: WorkloadKindStatefulSet, Name: item.Name, Replicas: replicas})
+		}
+	}
+
+	daemonSets, err := kubeClient.AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range daemonSets.Items {
+		if _, frozen := item.Annotations[AnnotationFrozenNodeSelector]; !frozen {
+			workloads = append(workloads, v1.FrozenWorkload{Kind: WorkloadKindDaemonSet, Name: item.Name, Replicas: 1})
+		}
+	}
+
+	jobs, err := kubeClient.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range jobs.Items {
+		if jobFinished(&item) {
+			continue
+		}
+		if item.Spec.Parallelism == nil || *item.Spec.Parallelism != 0 {
+			replicas := int32(1)
+			if item.Spec.Parallelism != nil {
+				replicas = *item.Spec.Parallelism
+			}
+			workloads = append(workloads, v1.FrozenWorkload{Kind: WorkloadKindJob, Name: item.Name, Replicas: replicas})
+		}
+	}
+
+	cronJobs, err := kubeClient.BatchV1beta1().CronJobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range cronJobs.Items {
+		if item.Spec.Suspend == nil || !*item.Spec.Suspend {
+			workloads = append(workloads, v1.FrozenWorkload{Kind: WorkloadKindCronJob, Name: item.Name, Replicas: 1})
+		}
+	}
+	return workloads, nil
+}
+
+func jobFinished(job *batchv1.Job) bool {
+	for _, c := range job.Status.Conditions {
+		if (c.Type == batchv1.JobComplete || c.Type == batchv1.JobFailed) && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// ScaleNamespaceWorkload sets the replicas of a workload of the namespace,
+// the workloads deleted meanwhile are ignored. A DaemonSet scaled to zero
+// runs on no node and a CronJob scaled to zero is suspended.
+func ScaleNamespaceWorkload(ctx context.Context, kubeClient kubernetes.Interface, namespace string, workload v1.FrozenWorkload, replicas int32) error {
+	var err error
+	switch workload.Kind {
+	case WorkloadKindDeployment:
+		err = scaleDeployment(ctx, kubeClient, namespace, workload.Name, replicas)
+	case WorkloadKindStatefulSet:
+		err = scaleStatefulSet(ctx, kubeClient, namespace, workload.Name, replicas)
+	case WorkloadKindDaemonSet:
+		err = scaleDaemonSet(ctx, kubeClient, namespace, workload.Name, replicas)
+	case WorkloadKindJob:
+		err = scaleJob(ctx, kubeClient, namespace, workload.Name, replicas)
+	case WorkloadKindCronJob:
+		err = scaleCronJob(ctx, kubeClient, namespace, workload.Name, replicas)
+	default:
+		return fmt.Errorf("unsupported workload kind %s", workload.Kind)
+	}
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func scaleDeployment(ctx context.Context, kubeClient kubernetes.Interface, namespace, name string, replicas int32) error {
+	scale, err := kubeClient.AppsV1().Deployments(namespace).GetScale(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if scale.Spec.Replicas == replicas {
+		return nil
+	}
+	scale.Spec.Replicas = replicas
+	_, err = kubeClient.AppsV1().Deployments(namespace).UpdateScale(ctx, name, scale, metav1.UpdateOptions{})
+	return err
+}
+
+func scaleStatefulSet(ctx context.Context, kubeClient kubernetes.Interface, namespace, name string, replicas int32) error {
+	scale, err := kubeClient.AppsV1().StatefulSets(namespace).GetScale(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if scale.Spec.Replicas == replicas {
+		return nil
+	}
+	scale.Spec.Replicas = replicas
+	_, err = kubeClient.AppsV1().StatefulSets(namespace).UpdateScale(ctx, name, scale, metav1.UpdateOptions{})
+	return err
+}
+
+// scaleDaemonSet moves the DaemonSet off all nodes by a node selector that
+// matches no node, recording the node selector to restore.
+func scaleDaemonSet(ctx context.Context, kubeClient kubernetes.Interface, namespace, name string, replicas int32) error {
+	daemonSet, err := kubeClient.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	recorded, frozen := daemonSet.Annotations[AnnotationFrozenNodeSelector]
+	if replicas == 0 {
+		if frozen {
+			return nil
+		}
+		nodeSelector, err := json.Marshal(daemonSet.Spec.Template.Spec.NodeSelector)
+		if err != nil {
+			return err
+		}
+		if daemonSet.Annotations == nil {
+			daemonSet.Annotations = make(map[string]string)
+		}
+		daemonSet.Annotations[AnnotationFrozenNodeSelector] = string(nodeSelector)
+		daemonSet.Spec.Template.Spec.NodeSelector = map[string]string{FrozenNodeSelectorKey: "true"}
+	} else {
+		if !frozen {
+			return nil
+		}
+		var nodeSelector map[string]string
+		if err := json.Unmarshal([]byte(recorded), &nodeSelector); err != nil {
+			return fmt.Errorf("invalid annotation %s of daemonset %s: %v", AnnotationFrozenNodeSelector, name, err)
+		}
+		delete(daemonSet.Annotations, AnnotationFrozenNodeSelector)
+		daemonSet.Spec.Template.Spec.NodeSelector = nodeSelector
+	}
+	_, err = kubeClient.AppsV1().DaemonSets(namespace).Update(ctx, daemonSet, metav1.UpdateOptions{})
+	return err
+}
+
+func scaleJob(ctx context.Context, kubeClient kubernetes.Interface, namespace, name string, replicas int32) error {
+	job, err := kubeClient.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if job.Spec.Parallelism != nil && *job.Spec.Parallelism == replicas {
+		return nil
+	}
+	job.Spec.Parallelism = &replicas
+	_, err = kubeClient.BatchV1().Jobs(namespace).Update(ctx, job, metav1.UpdateOptions{})
+	return err
+}
+
+func scaleCronJob(ctx context.Context, kubeClient kubernetes.Interface, namespace, name string, replicas int32) error {
+	cronJob, err := kubeClient.BatchV1beta1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	suspend := replicas == 0
+	if cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend == suspend {
+		return nil
+	}
+	cronJob.Spec.Suspend = &suspend
+	_, err = kubeClient.BatchV1beta1().CronJobs(namespace).Update(ctx, cronJob, metav1.UpdateOptions{})
+	return err
+}
+
+// EvictNamespacePods deletes the running pods of the namespace that are not
+// removed by scaling down the workloads, such as the bare pods and the pods
+// of the other controllers. The pods deleted can not be restored, and the
+// controllers can not create them again because of the freeze quota.
+func EvictNamespacePods(ctx context.Context, kubeClient kubernetes.Interface, namespace string) error {
+	replicaSets, err := kubeClient.AppsV1().ReplicaSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	scaled := sets.NewString()
+	for _, item := range replicaSets.Items {
+		if ref := metav1.GetControllerOf(&item); ref != nil && ref.Kind == WorkloadKindDeployment {
+			scaled.Insert(item.Name)
+		}
+	}
+
+	pods, err := kubeClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp != nil || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if ref := metav1.GetControllerOf(&pod); ref != nil {
+			switch ref.Kind {
+			case WorkloadKindStatefulSet, WorkloadKindDaemonSet, WorkloadKindJob:
+				continue
+			case "ReplicaSet":
+				if scaled.Has(ref.Name) {
+					continue
+				}
+			}
+		}
+		err := kubeClient.CoreV1().Pods(namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// EnsureFreezeQuotaOnCluster creates the resource quota that allows no pod
+// in the namespace, alongside the resource quota of the namespace.
+func EnsureFreezeQuotaOnCluster(ctx context.Context, kubeClient kubernetes.Interface, namespace string) error {
+	rq := &corev1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FreezeResourceQuotaName,
+			Namespace: namespace,
+		},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: corev1.ResourceList{
+				corev1.ResourcePods: resource.MustParse("0"),
+			},
+		},
+	}
+	_, err := kubeClient.CoreV1().ResourceQuotas(namespace).Create(ctx, rq, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// DeleteFreezeQuotaFromCluster deletes the resource quota created by
+// EnsureFreezeQuotaOnCluster.
+func DeleteFreezeQuotaFromCluster(ctx context.Context, kubeClient kubernetes.Interface, namespace string) error {
+	err := kubeClient.CoreV1().ResourceQuotas(namespace).Delete(ctx, FreezeResourceQuotaName, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
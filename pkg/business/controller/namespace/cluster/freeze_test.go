@@ -0,0 +1,154 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespace
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	v1 "tkestack.io/tke/api/business/v1"
+)
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func TestFreezeDaemonSetsJobsAndCronJobs(t *testing.T) {
+	ctx := context.Background()
+	kubeClient := fake.NewSimpleClientset(
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "ds"},
+			Spec: appsv1.DaemonSetSpec{Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{NodeSelector: map[string]string{"disk": "ssd"}},
+			}},
+		},
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "job"},
+			Spec:       batchv1.JobSpec{Parallelism: int32Ptr(3)},
+		},
+		&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "finished"},
+			Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			}},
+		},
+		&batchv1beta1.CronJob{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "cron"},
+		},
+	)
+
+	workloads, err := ListNamespaceWorkloadReplicas(ctx, kubeClient, "ns")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]int32{WorkloadKindDaemonSet: 1, WorkloadKindJob: 3, WorkloadKindCronJob: 1}
+	if len(workloads) != len(want) {
+		t.Fatalf("workloads = %+v, want %v", workloads, want)
+	}
+	for _, workload := range workloads {
+		if replicas, ok := want[workload.Kind]; !ok || replicas != workload.Replicas {
+			t.Errorf("unexpected workload %+v", workload)
+		}
+		if err := ScaleNamespaceWorkload(ctx, kubeClient, "ns", workload, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the frozen workloads are not listed again by an interrupted freeze.
+	frozen, err := ListNamespaceWorkloadReplicas(ctx, kubeClient, "ns")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frozen) != 0 {
+		t.Errorf("frozen workloads listed: %+v", frozen)
+	}
+	daemonSet, _ := kubeClient.AppsV1().DaemonSets("ns").Get(ctx, "ds", metav1.GetOptions{})
+	if daemonSet.Spec.Template.Spec.NodeSelector[FrozenNodeSelectorKey] != "true" {
+		t.Errorf("daemonset node selector = %v", daemonSet.Spec.Template.Spec.NodeSelector)
+	}
+	cronJob, _ := kubeClient.BatchV1beta1().CronJobs("ns").Get(ctx, "cron", metav1.GetOptions{})
+	if cronJob.Spec.Suspend == nil || !*cronJob.Spec.Suspend {
+		t.Error("cronjob is not suspended")
+	}
+
+	for _, workload := range workloads {
+		if err := ScaleNamespaceWorkload(ctx, kubeClient, "ns", workload, workload.Replicas); err != nil {
+			t.Fatal(err)
+		}
+	}
+	daemonSet, _ = kubeClient.AppsV1().DaemonSets("ns").Get(ctx, "ds", metav1.GetOptions{})
+	if len(daemonSet.Spec.Template.Spec.NodeSelector) != 1 || daemonSet.Spec.Template.Spec.NodeSelector["disk"] != "ssd" {
+		t.Errorf("daemonset node selector = %v, want restored", daemonSet.Spec.Template.Spec.NodeSelector)
+	}
+	if _, ok := daemonSet.Annotations[AnnotationFrozenNodeSelector]; ok {
+		t.Error("daemonset annotation is not removed")
+	}
+	job, _ := kubeClient.BatchV1().Jobs("ns").Get(ctx, "job", metav1.GetOptions{})
+	if *job.Spec.Parallelism != 3 {
+		t.Errorf("job parallelism = %d, want 3", *job.Spec.Parallelism)
+	}
+	cronJob, _ = kubeClient.BatchV1beta1().CronJobs("ns").Get(ctx, "cron", metav1.GetOptions{})
+	if *cronJob.Spec.Suspend {
+		t.Error("cronjob is still suspended")
+	}
+}
+
+func TestEvictNamespacePods(t *testing.T) {
+	ctx := context.Background()
+	controller := func(kind, name string) []metav1.OwnerReference {
+		isController := true
+		return []metav1.OwnerReference{{Kind: kind, Name: name, Controller: &isController}}
+	}
+	kubeClient := fake.NewSimpleClientset(
+		&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "deploy-rs", OwnerReferences: controller("Deployment", "deploy")}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "deploy-pod", OwnerReferences: controller("ReplicaSet", "deploy-rs")}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "sts-pod", OwnerReferences: controller("StatefulSet", "sts")}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "bare-pod"}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "rs-pod", OwnerReferences: controller("ReplicaSet", "standalone")}},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "operator-pod", OwnerReferences: controller("Database", "db")}},
+	)
+
+	if err := EvictNamespacePods(ctx, kubeClient, "ns"); err != nil {
+		t.Fatal(err)
+	}
+	pods, err := kubeClient.CoreV1().Pods("ns").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	left := map[string]bool{}
+	for _, pod := range pods.Items {
+		left[pod.Name] = true
+	}
+	if len(left) != 2 || !left["deploy-pod"] || !left["sts-pod"] {
+		t.Errorf("pods left = %v, want the pods of the scaled workloads", left)
+	}
+}
+
+func TestScaleUnsupportedWorkload(t *testing.T) {
+	err := ScaleNamespaceWorkload(context.Background(), fake.NewSimpleClientset(), "ns", v1.FrozenWorkload{Kind: "Rollout", Name: "r"}, 0)
+	if err == nil {
+		t.Error("expect an error of the unsupported kind")
+	}
+}
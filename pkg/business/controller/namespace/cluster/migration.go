@@ -0,0 +1,215 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/kubernetes"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// rootCAConfigMapName is the config map published into every namespace by
+// the cluster, which must not be copied.
+const rootCAConfigMapName = "kube-root-ca.crt"
+
+// MigrateNamespaceResources exports the Deployments, StatefulSets, Services,
+// ConfigMaps, Secrets and PersistentVolumeClaims of the namespace from the
+// source cluster, and applies them to the namespace in the target cluster.
+// The objects already existing in the target cluster are kept, so that the
+// migration can be retried. It returns the number of objects created.
+func MigrateNamespaceResources(ctx context.Context, source, target *kubernetes.Clientset, namespace string) (int, error) {
+	created := 0
+	var errs []error
+	apply := func(kind, name string, err error) {
+		switch {
+		case err == nil:
+			created++
+		case errors.IsAlreadyExists(err):
+		default:
+			log.Error("Failed to migrate the object to cluster", log.String("namespace", namespace), log.String("kind", kind), log.String("name", name), log.Err(err))
+			errs = append(errs, fmt.Errorf("failed to migrate %s %s, for %s", kind, name, err))
+		}
+	}
+
+	// The objects that workloads refer to are applied first.
+	configMaps, err := source.CoreV1().ConfigMaps(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return created, err
+	}
+	for i := range configMaps.Items {
+		item := &configMaps.Items[i]
+		if item.Name == rootCAConfigMapName {
+			continue
+		}
+		resetObjectMeta(&item.ObjectMeta)
+		_, err := target.CoreV1().ConfigMaps(namespace).Create(ctx, item, metav1.CreateOptions{})
+		apply("ConfigMap", item.Name, err)
+	}
+
+	secrets, err := source.CoreV1().Secrets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return created, err
+	}
+	for i := range secrets.Items {
+		item := &secrets.Items[i]
+		if item.Type == corev1.SecretTypeServiceAccountToken {
+			continue
+		}
+		resetObjectMeta(&item.ObjectMeta)
+		_, err := target.CoreV1().Secrets(namespace).Create(ctx, item, metav1.CreateOptions{})
+		apply("Secret", item.Name, err)
+	}
+
+	claims, err := source.CoreV1().PersistentVolumeClaims(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return created, err
+	}
+	for i := range claims.Items {
+		item := &claims.Items[i]
+		resetObjectMeta(&item.ObjectMeta)
+		// Only the definition of the claim is migrated, the claim is bound
+		// to a new volume in the target cluster.
+		for k := range item.Annotations {
+			if strings.HasPrefix(k, "pv.kubernetes.io/") || strings.HasPrefix(k, "volume.beta.kubernetes.io/storage-provisioner") ||
+				strings.HasPrefix(k, "volume.kubernetes.io/") {
+				delete(item.Annotations, k)
+			}
+		}
+		item.Spec.VolumeName = ""
+		item.Status = corev1.PersistentVolumeClaimStatus{}
+		_, err := target.CoreV1().PersistentVolumeClaims(namespace).Create(ctx, item, metav1.CreateOptions{})
+		apply("PersistentVolumeClaim", item.Name, err)
+	}
+
+	services, err := source.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return created, err
+	}
+	for i := range services.Items {
+		item := &services.Items[i]
+		resetObjectMeta(&item.ObjectMeta)
+		// The addresses and ports allocated by the source cluster are
+		// allocated again by the target cluster.
+		if item.Spec.ClusterIP != corev1.ClusterIPNone {
+			item.Spec.ClusterIP = ""
+			item.Spec.ClusterIPs = nil
+		}
+		for j := range item.Spec.Ports {
+			item.Spec.Ports[j].NodePort = 0
+		}
+		item.Spec.HealthCheckNodePort = 0
+		item.Status = corev1.ServiceStatus{}
+		_, err := target.CoreV1().Services(namespace).Create(ctx, item, metav1.CreateOptions{})
+		apply("Service", item.Name, err)
+	}
+
+	deployments, err := source.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return created, err
+	}
+	for i := range deployments.Items {
+		item := &deployments.Items[i]
+		resetObjectMeta(&item.ObjectMeta)
+		item.Status = appsv1.DeploymentStatus{}
+		_, err := target.AppsV1().Deployments(namespace).Create(ctx, item, metav1.CreateOptions{})
+		apply("Deployment", item.Name, err)
+	}
+
+	statefulSets, err := source.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return created, err
+	}
+	for i := range statefulSets.Items {
+		item := &statefulSets.Items[i]
+		resetObjectMeta(&item.ObjectMeta)
+		item.Status = appsv1.StatefulSetStatus{}
+		_, err := target.AppsV1().StatefulSets(namespace).Create(ctx, item, metav1.CreateOptions{})
+		apply("StatefulSet", item.Name, err)
+	}
+
+	return created, utilerrors.NewAggregate(errs)
+}
+
+// ScaleDownNamespaceWorkloads scales the Deployments and StatefulSets of the
+// namespace to zero replicas.
+func ScaleDownNamespaceWorkloads(ctx context.Context, kubeClient *kubernetes.Clientset, namespace string) error {
+	var errs []error
+	deployments, err := kubeClient.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, item := range deployments.Items {
+		scale, err := kubeClient.AppsV1().Deployments(namespace).GetScale(ctx, item.Name, metav1.GetOptions{})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if scale.Spec.Replicas == 0 {
+			continue
+		}
+		scale.Spec.Replicas = 0
+		if _, err := kubeClient.AppsV1().Deployments(namespace).UpdateScale(ctx, item.Name, scale, metav1.UpdateOptions{}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	statefulSets, err := kubeClient.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, item := range statefulSets.Items {
+		scale, err := kubeClient.AppsV1().StatefulSets(namespace).GetScale(ctx, item.Name, metav1.GetOptions{})
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if scale.Spec.Replicas == 0 {
+			continue
+		}
+		scale.Spec.Replicas = 0
+		if _, err := kubeClient.AppsV1().StatefulSets(namespace).UpdateScale(ctx, item.Name, scale, metav1.UpdateOptions{}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// resetObjectMeta clears the fields of an object set by the source cluster.
+func resetObjectMeta(meta *metav1.ObjectMeta) {
+	meta.ResourceVersion = ""
+	meta.UID = ""
+	meta.SelfLink = ""
+	meta.Generation = 0
+	meta.CreationTimestamp = metav1.Time{}
+	meta.DeletionTimestamp = nil
+	meta.DeletionGracePeriodSeconds = nil
+	meta.OwnerReferences = nil
+	meta.ManagedFields = nil
+	meta.Finalizers = nil
+	delete(meta.Annotations, corev1.LastAppliedConfigAnnotation)
+	delete(meta.Annotations, "deployment.kubernetes.io/revision")
+}
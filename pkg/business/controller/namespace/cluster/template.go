@@ -0,0 +1,398 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	v1 "tkestack.io/tke/api/business/v1"
+	"tkestack.io/tke/pkg/business/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	podSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+	podSecurityAuditLabel   = "pod-security.kubernetes.io/audit"
+	podSecurityWarnLabel    = "pod-security.kubernetes.io/warn"
+)
+
+// templateKeys records the labels and annotations of a namespace on the
+// cluster which are set by namespace templates, so that they can be removed
+// when the templates no longer set them.
+type templateKeys struct {
+	Labels      []string `json:"labels,omitempty"`
+	Annotations []string `json:"annotations,omitempty"`
+}
+
+// EnsureNamespaceTemplatesOnCluster renders the namespace templates of the
+// project into the namespace on the cluster, removes the objects rendered from
+// the templates that no longer exist, and returns the sync status of every
+// template.
+func EnsureNamespaceTemplatesOnCluster(ctx context.Context, kubeClient *kubernetes.Clientset, namespace *v1.Namespace, templates []*v1.NamespaceTemplate) ([]v1.NamespaceTemplateSync, error) {
+	sort.Slice(templates, func(i, j int) bool { return templates[i].Name < templates[j].Name })
+
+	var errs []error
+	if err := ensureNamespaceMetadata(ctx, kubeClient, namespace, templates); err != nil {
+		errs = append(errs, err)
+	}
+
+	syncs := make([]v1.NamespaceTemplateSync, 0, len(templates))
+	for _, template := range templates {
+		sync := v1.NamespaceTemplateSync{
+			Name:               template.Name,
+			ObservedGeneration: template.Generation,
+			Phase:              v1.NamespaceTemplateSynced,
+			LastSyncTime:       metav1.Now(),
+		}
+		if err := ensureNamespaceTemplate(ctx, kubeClient, namespace.Spec.Namespace, template); err != nil {
+			log.Error("Failed to apply the namespace template on cluster", log.String("namespaceName", namespace.ObjectMeta.Name), log.String("clusterName", namespace.Spec.ClusterName), log.String("templateName", template.Name), log.Err(err))
+			sync.Phase = v1.NamespaceTemplateSyncFailed
+			sync.Message = err.Error()
+		}
+		if len(errs) != 0 {
+			sync.Phase = v1.NamespaceTemplateSyncFailed
+			sync.Message = utilerrors.NewAggregate(errs).Error()
+		}
+		syncs = append(syncs, sync)
+	}
+
+	if err := pruneNamespaceTemplates(ctx, kubeClient, namespace.Spec.Namespace, templates); err != nil {
+		errs = append(errs, err)
+	}
+	return syncs, utilerrors.NewAggregate(errs)
+}
+
+// ensureNamespaceMetadata sets the labels, annotations and Pod Security levels
+// of the templates on the namespace, the later templates by name take
+// precedence.
+func ensureNamespaceMetadata(ctx context.Context, kubeClient *kubernetes.Clientset, namespace *v1.Namespace, templates []*v1.NamespaceTemplate) error {
+	labels := map[string]string{}
+	annotations := map[string]string{}
+	for _, template := range templates {
+		for k, v := range template.Spec.Labels {
+			// The pod security is only set by the pod security of the template,
+			// which is validated against the privileges of the user.
+			if util.IsPodSecurityLabel(k) {
+				continue
+			}
+			labels[k] = v
+		}
+		for k, v := range template.Spec.Annotations {
+			annotations[k] = v
+		}
+		if podSecurity := template.Spec.PodSecurity; podSecurity != nil {
+			for k, v := range map[string]string{
+				podSecurityEnforceLabel: podSecurity.Enforce,
+				podSecurityAuditLabel:   podSecurity.Audit,
+				podSecurityWarnLabel:    podSecurity.Warn,
+			} {
+				if v != "" {
+					labels[k] = v
+				}
+			}
+		}
+	}
+	// The labels of the project must not be overridden by the templates.
+	delete(labels, util.LabelProjectName)
+	delete(labels, util.LabelNamespaceName)
+	delete(annotations, util.AnnotationNamespaceTemplateKeys)
+
+	ns, err := kubeClient.CoreV1().Namespaces().Get(ctx, namespace.Spec.Namespace, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	var old templateKeys
+	if data, ok := ns.Annotations[util.AnnotationNamespaceTemplateKeys]; ok {
+		if err := json.Unmarshal([]byte(data), &old); err != nil {
+			log.Warn("Failed to parse the keys set by namespace templates", log.String("namespace", ns.Name), log.Err(err))
+		}
+	}
+
+	updated := ns.DeepCopy()
+	if updated.Labels == nil {
+		updated.Labels = map[string]string{}
+	}
+	if updated.Annotations == nil {
+		updated.Annotations = map[string]string{}
+	}
+	for _, k := range old.Labels {
+		if _, ok := labels[k]; !ok {
+			delete(updated.Labels, k)
+		}
+	}
+	for _, k := range old.Annotations {
+		if _, ok := annotations[k]; !ok {
+			delete(updated.Annotations, k)
+		}
+	}
+	for k, v := range labels {
+		updated.Labels[k] = v
+	}
+	for k, v := range annotations {
+		updated.Annotations[k] = v
+	}
+	keys := templateKeys{Labels: sets.StringKeySet(labels).List(), Annotations: sets.StringKeySet(annotations).List()}
+	if len(keys.Labels) == 0 && len(keys.Annotations) == 0 {
+		delete(updated.Annotations, util.AnnotationNamespaceTemplateKeys)
+	} else {
+		data, err := json.Marshal(keys)
+		if err != nil {
+			return err
+		}
+		updated.Annotations[util.AnnotationNamespaceTemplateKeys] = string(data)
+	}
+
+	if reflect.DeepEqual(ns.Labels, updated.Labels) && reflect.DeepEqual(ns.Annotations, updated.Annotations) {
+		return nil
+	}
+	_, err = kubeClient.CoreV1().Namespaces().Update(ctx, updated, metav1.UpdateOptions{})
+	return err
+}
+
+// ensureNamespaceTemplate creates or updates the objects rendered from the
+// template in the namespace.
+func ensureNamespaceTemplate(ctx context.Context, kubeClient *kubernetes.Clientset, namespace string, template *v1.NamespaceTemplate) error {
+	var errs []error
+	if len(template.Spec.LimitRanges) != 0 {
+		if err := ensureLimitRange(ctx, kubeClient, renderLimitRange(namespace, template)); err != nil {
+			errs = append(errs, fmt.Errorf("failed to apply limit range, for %s", err))
+		}
+	}
+	if policy := renderNetworkPolicy(namespace, template); policy != nil {
+		if err := ensureNetworkPolicy(ctx, kubeClient, policy); err != nil {
+			errs = append(errs, fmt.Errorf("failed to apply network policy, for %s", err))
+		}
+	}
+	for _, roleBinding := range template.Spec.RoleBindings {
+		if !util.NamespaceTemplateClusterRoles.Has(roleBinding.ClusterRole) {
+			errs = append(errs, fmt.Errorf("failed to apply role binding %s, cluster role %s is not allowed", roleBinding.Name, roleBinding.ClusterRole))
+			continue
+		}
+		if err := ensureRoleBinding(ctx, kubeClient, renderRoleBinding(namespace, template, roleBinding)); err != nil {
+			errs = append(errs, fmt.Errorf("failed to apply role binding %s, for %s", roleBinding.Name, err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func templateObjectMeta(namespace, name string, template *v1.NamespaceTemplate) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+		Labels: map[string]string{
+			util.LabelNamespaceTemplateName: template.Name,
+		},
+	}
+}
+
+func renderLimitRange(namespace string, template *v1.NamespaceTemplate) *corev1.LimitRange {
+	return &corev1.LimitRange{
+		ObjectMeta: templateObjectMeta(namespace, template.Name, template),
+		Spec: corev1.LimitRangeSpec{
+			Limits: template.Spec.LimitRanges,
+		},
+	}
+}
+
+func renderNetworkPolicy(namespace string, template *v1.NamespaceTemplate) *networkingv1.NetworkPolicy {
+	if template.Spec.NetworkPolicy == nil {
+		return nil
+	}
+	var policyTypes []networkingv1.PolicyType
+	if template.Spec.NetworkPolicy.DenyIngress {
+		policyTypes = append(policyTypes, networkingv1.PolicyTypeIngress)
+	}
+	if template.Spec.NetworkPolicy.DenyEgress {
+		policyTypes = append(policyTypes, networkingv1.PolicyTypeEgress)
+	}
+	if len(policyTypes) == 0 {
+		return nil
+	}
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: templateObjectMeta(namespace, template.Name, template),
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{},
+			PolicyTypes: policyTypes,
+		},
+	}
+}
+
+func renderRoleBinding(namespace string, template *v1.NamespaceTemplate, roleBinding v1.NamespaceTemplateRoleBinding) *rbacv1.RoleBinding {
+	subjects := make([]rbacv1.Subject, 0, len(roleBinding.Subjects))
+	for _, subject := range roleBinding.Subjects {
+		s := rbacv1.Subject{Kind: subject.Kind, Name: subject.Name}
+		if subject.Kind == rbacv1.ServiceAccountKind {
+			// The service accounts of other namespaces are never bound.
+			s.Namespace = namespace
+		} else {
+			s.APIGroup = rbacv1.GroupName
+		}
+		subjects = append(subjects, s)
+	}
+	return &rbacv1.RoleBinding{
+		ObjectMeta: templateObjectMeta(namespace, roleBindingName(template.Name, roleBinding.Name), template),
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     roleBinding.ClusterRole,
+		},
+		Subjects: subjects,
+	}
+}
+
+func roleBindingName(templateName, name string) string {
+	return fmt.Sprintf("%s-%s", templateName, name)
+}
+
+func ensureLimitRange(ctx context.Context, kubeClient *kubernetes.Clientset, limitRange *corev1.LimitRange) error {
+	client := kubeClient.CoreV1().LimitRanges(limitRange.Namespace)
+	current, err := client.Get(ctx, limitRange.Name, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		_, err = client.Create(ctx, limitRange, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(current.Spec, limitRange.Spec) && reflect.DeepEqual(current.Labels, limitRange.Labels) {
+		return nil
+	}
+	current.Labels = limitRange.Labels
+	current.Spec = limitRange.Spec
+	_, err = client.Update(ctx, current, metav1.UpdateOptions{})
+	return err
+}
+
+func ensureNetworkPolicy(ctx context.Context, kubeClient *kubernetes.Clientset, policy *networkingv1.NetworkPolicy) error {
+	client := kubeClient.NetworkingV1().NetworkPolicies(policy.Namespace)
+	current, err := client.Get(ctx, policy.Name, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		_, err = client.Create(ctx, policy, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(current.Spec, policy.Spec) && reflect.DeepEqual(current.Labels, policy.Labels) {
+		return nil
+	}
+	current.Labels = policy.Labels
+	current.Spec = policy.Spec
+	_, err = client.Update(ctx, current, metav1.UpdateOptions{})
+	return err
+}
+
+func ensureRoleBinding(ctx context.Context, kubeClient *kubernetes.Clientset, roleBinding *rbacv1.RoleBinding) error {
+	client := kubeClient.RbacV1().RoleBindings(roleBinding.Namespace)
+	current, err := client.Get(ctx, roleBinding.Name, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		_, err = client.Create(ctx, roleBinding, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(current.RoleRef, roleBinding.RoleRef) && reflect.DeepEqual(current.Subjects, roleBinding.Subjects) &&
+		reflect.DeepEqual(current.Labels, roleBinding.Labels) {
+		return nil
+	}
+	if current.RoleRef != roleBinding.RoleRef {
+		// The role of a role binding is immutable.
+		if err := client.Delete(ctx, current.Name, metav1.DeleteOptions{}); err != nil {
+			return err
+		}
+		_, err = client.Create(ctx, roleBinding, metav1.CreateOptions{})
+		return err
+	}
+	current.Labels = roleBinding.Labels
+	current.Subjects = roleBinding.Subjects
+	_, err = client.Update(ctx, current, metav1.UpdateOptions{})
+	return err
+}
+
+// pruneNamespaceTemplates deletes the objects rendered from namespace
+// templates in the namespace that are no longer desired.
+func pruneNamespaceTemplates(ctx context.Context, kubeClient *kubernetes.Clientset, namespace string, templates []*v1.NamespaceTemplate) error {
+	limitRanges := sets.NewString()
+	networkPolicies := sets.NewString()
+	roleBindings := sets.NewString()
+	for _, template := range templates {
+		if len(template.Spec.LimitRanges) != 0 {
+			limitRanges.Insert(template.Name)
+		}
+		if renderNetworkPolicy(namespace, template) != nil {
+			networkPolicies.Insert(template.Name)
+		}
+		for _, roleBinding := range template.Spec.RoleBindings {
+			roleBindings.Insert(roleBindingName(template.Name, roleBinding.Name))
+		}
+	}
+
+	listOptions := metav1.ListOptions{LabelSelector: util.LabelNamespaceTemplateName}
+	var errs []error
+	limitRangeList, err := kubeClient.CoreV1().LimitRanges(namespace).List(ctx, listOptions)
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		for _, item := range limitRangeList.Items {
+			if !limitRanges.Has(item.Name) {
+				if err := kubeClient.CoreV1().LimitRanges(namespace).Delete(ctx, item.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+	networkPolicyList, err := kubeClient.NetworkingV1().NetworkPolicies(namespace).List(ctx, listOptions)
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		for _, item := range networkPolicyList.Items {
+			if !networkPolicies.Has(item.Name) {
+				if err := kubeClient.NetworkingV1().NetworkPolicies(namespace).Delete(ctx, item.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+	roleBindingList, err := kubeClient.RbacV1().RoleBindings(namespace).List(ctx, listOptions)
+	if err != nil {
+		errs = append(errs, err)
+	} else {
+		for _, item := range roleBindingList.Items {
+			if !roleBindings.Has(item.Name) {
+				if err := kubeClient.RbacV1().RoleBindings(namespace).Delete(ctx, item.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
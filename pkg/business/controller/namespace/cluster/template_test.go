@@ -0,0 +1,48 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespace
+
+import (
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/business/v1"
+)
+
+func TestRenderRoleBinding(t *testing.T) {
+	template := &v1.NamespaceTemplate{ObjectMeta: metav1.ObjectMeta{Name: "nst"}}
+	roleBinding := renderRoleBinding("ns1", template, v1.NamespaceTemplateRoleBinding{
+		Name:        "ci",
+		ClusterRole: "edit",
+		Subjects: []v1.NamespaceTemplateSubject{
+			{Kind: rbacv1.ServiceAccountKind, Name: "default", Namespace: "kube-system"},
+			{Kind: rbacv1.UserKind, Name: "alice"},
+		},
+	})
+	if roleBinding.Name != "nst-ci" || roleBinding.Namespace != "ns1" {
+		t.Errorf("role binding is rendered as %s/%s", roleBinding.Namespace, roleBinding.Name)
+	}
+	if s := roleBinding.Subjects[0]; s.Namespace != "ns1" {
+		t.Errorf("service account is bound in namespace %s, want ns1", s.Namespace)
+	}
+	if s := roleBinding.Subjects[1]; s.APIGroup != rbacv1.GroupName || s.Namespace != "" {
+		t.Errorf("user subject is rendered as %+v", s)
+	}
+}
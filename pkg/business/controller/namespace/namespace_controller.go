@@ -26,6 +26,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -72,13 +73,15 @@ type Controller struct {
 	queue          workqueue.RateLimitingInterface
 	lister         businessv1lister.NamespaceLister
 	listerSynced   cache.InformerSynced
+	templateLister businessv1lister.NamespaceTemplateLister
+	templateSynced cache.InformerSynced
 	stopCh         <-chan struct{}
 	// helper to delete all resources in the namespace when the namespace is deleted.
 	namespacedResourcesDeleter deletion.NamespacedResourcesDeleterInterface
 }
 
 // NewController creates a new Controller object.
-func NewController(platformClient platformversionedclient.PlatformV1Interface, client clientset.Interface, namespaceInformer businessv1informer.NamespaceInformer, resyncPeriod time.Duration, finalizerToken v1.FinalizerName) *Controller {
+func NewController(platformClient platformversionedclient.PlatformV1Interface, client clientset.Interface, namespaceInformer businessv1informer.NamespaceInformer, namespaceTemplateInformer businessv1informer.NamespaceTemplateInformer, resyncPeriod time.Duration, finalizerToken v1.FinalizerName) *Controller {
 	// create the controller so we can inject the enqueue function
 	controller := &Controller{
 		client:                     client,
@@ -110,6 +113,24 @@ func NewController(platformClient platformversionedclient.PlatformV1Interface, c
 	controller.lister = namespaceInformer.Lister()
 	controller.listerSynced = namespaceInformer.Informer().HasSynced
 
+	// The namespaces of the project are synced again when a namespace
+	// template of the project changes.
+	namespaceTemplateInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueueProjectNamespaces,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				old, ok1 := oldObj.(*v1.NamespaceTemplate)
+				cur, ok2 := newObj.(*v1.NamespaceTemplate)
+				if ok1 && ok2 && old.Generation != cur.Generation {
+					controller.enqueueProjectNamespaces(newObj)
+				}
+			},
+			DeleteFunc: controller.enqueueProjectNamespaces,
+		},
+	)
+	controller.templateLister = namespaceTemplateInformer.Lister()
+	controller.templateSynced = namespaceTemplateInformer.Informer().HasSynced
+
 	return controller
 }
 
@@ -150,6 +171,28 @@ func (c *Controller) enqueue(obj interface{}) {
 	c.queue.AddAfter(key, namespaceDeletionGracePeriod)
 }
 
+// obj could be an *v1.NamespaceTemplate, or a DeletionFinalStateUnknown marker item.
+func (c *Controller) enqueueProjectNamespaces(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		log.Error("Couldn't get key for object", log.Any("object", obj), log.Err(err))
+		return
+	}
+	projectName, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error("Couldn't split key of namespace template", log.String("key", key), log.Err(err))
+		return
+	}
+	namespaces, err := c.lister.Namespaces(projectName).List(labels.Everything())
+	if err != nil {
+		log.Error("Failed to list namespaces of project", log.String("projectName", projectName), log.Err(err))
+		return
+	}
+	for _, namespace := range namespaces {
+		c.enqueue(namespace)
+	}
+}
+
 func (c *Controller) needsUpdate(old *v1.Namespace, new *v1.Namespace) bool {
 	if old.UID != new.UID {
 		return true
@@ -176,7 +219,7 @@ func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
 	log.Info("Starting namespace controller")
 	defer log.Info("Shutting down namespace controller")
 
-	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced, c.templateSynced); !ok {
 		log.Error("Failed to wait for namespace caches to sync")
 		return
 	}
@@ -338,6 +381,7 @@ func (c *Controller) handlePhase(ctx context.Context, key string, cachedNamespac
 		namespace.Status.Message = ""
 		namespace.Status.Reason = ""
 		namespace.Status.LastTransitionTime = metav1.Now()
+		_ = c.ensureNamespaceTemplatesOnCluster(ctx, namespace)
 		return c.persistUpdateNamespace(ctx, namespace)
 	case v1.NamespaceAvailable:
 		// Since project has been updated, try our best update namespace CachedSpecHard.
@@ -350,7 +394,8 @@ func (c *Controller) handlePhase(ctx context.Context, key string, cachedNamespac
 			namespace.Status.LastTransitionTime = metav1.Now()
 			return c.persistUpdateNamespace(ctx, namespace)
 		}
-		if !reflect.DeepEqual(namespace.Spec.Hard, cachedHard) {
+		templatesChanged := c.ensureNamespaceTemplatesOnCluster(ctx, namespace)
+		if !reflect.DeepEqual(namespace.Spec.Hard, cachedHard) || templatesChanged {
 			_ = c.persistUpdateNamespace(ctx, namespace)
 		}
 		c.startNamespaceHealthCheck(key)
@@ -414,6 +459,50 @@ func (c *Controller) ensureNamespaceOnCluster(ctx context.Context, namespace *v1
 	return cls.EnsureResourceQuotaOnCluster(ctx, kubeClient, namespace)
 }
 
+// ensureNamespaceTemplatesOnCluster applies the namespace templates of the
+// project to the namespace, and returns whether the sync status of the
+// templates has changed.
+func (c *Controller) ensureNamespaceTemplatesOnCluster(ctx context.Context, namespace *v1.Namespace) bool {
+	templates, err := c.templateLister.NamespaceTemplates(namespace.ObjectMeta.Namespace).List(labels.Everything())
+	if err != nil {
+		log.Error("Failed to list the namespace templates", log.String("projectName", namespace.ObjectMeta.Namespace), log.Err(err))
+		return false
+	}
+	if len(templates) == 0 && len(namespace.Status.Templates) == 0 {
+		return false
+	}
+	kubeClient, err := util.BuildExternalClientSetWithName(ctx, c.platformClient, namespace.Spec.ClusterName)
+	if err != nil {
+		log.Error("Failed to create the kubernetes client", log.String("namespaceName", namespace.ObjectMeta.Name), log.String("clusterName", namespace.Spec.ClusterName), log.Err(err))
+		return false
+	}
+	syncs, err := cls.EnsureNamespaceTemplatesOnCluster(ctx, kubeClient, namespace, templates)
+	if err != nil {
+		log.Error("Failed to ensure the namespace templates on cluster", log.String("namespaceName", namespace.ObjectMeta.Name), log.String("clusterName", namespace.Spec.ClusterName), log.Err(err))
+	}
+
+	// The sync time is kept unless the status changes, to avoid updating
+	// the namespace on every resync.
+	old := make(map[string]v1.NamespaceTemplateSync, len(namespace.Status.Templates))
+	for _, sync := range namespace.Status.Templates {
+		old[sync.Name] = sync
+	}
+	for i := range syncs {
+		if o, ok := old[syncs[i].Name]; ok && o.ObservedGeneration == syncs[i].ObservedGeneration &&
+			o.Phase == syncs[i].Phase && o.Message == syncs[i].Message {
+			syncs[i].LastSyncTime = o.LastSyncTime
+		}
+	}
+	if len(syncs) == 0 {
+		syncs = nil
+	}
+	if reflect.DeepEqual(namespace.Status.Templates, syncs) {
+		return false
+	}
+	namespace.Status.Templates = syncs
+	return true
+}
+
 func (c *Controller) persistUpdateNamespace(ctx context.Context, namespace *v1.Namespace) error {
 	return PersistUpdateNamesapce(ctx, c.client, namespace)
 }
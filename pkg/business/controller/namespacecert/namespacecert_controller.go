@@ -0,0 +1,419 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the “License”); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an “AS IS” BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespacecert
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"reflect"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	v1 "tkestack.io/tke/api/business/v1"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	businessv1informer "tkestack.io/tke/api/client/informers/externalversions/business/v1"
+	businessv1lister "tkestack.io/tke/api/client/listers/business/v1"
+	notifyv1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/business/util"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	controllerName = "namespacecert-controller"
+)
+
+// Controller is responsible for renewing the short-lived certificates of the
+// namespaces before they expire, and revoking the certificates listed in the
+// namespaces.
+type Controller struct {
+	client                clientset.Interface
+	platformClient        platformversionedclient.PlatformV1Interface
+	notifyClient          notifyversionedclient.NotifyV1Interface
+	queue                 workqueue.RateLimitingInterface
+	namespaceLister       businessv1lister.NamespaceLister
+	namespaceListerSynced cache.InformerSynced
+	projectLister         businessv1lister.ProjectLister
+	projectListerSynced   cache.InformerSynced
+}
+
+// NewController creates a new namespace certificate controller.
+func NewController(client clientset.Interface, platformClient platformversionedclient.PlatformV1Interface,
+	notifyClient notifyversionedclient.NotifyV1Interface, namespaceInformer businessv1informer.NamespaceInformer,
+	projectInformer businessv1informer.ProjectInformer, resyncPeriod time.Duration) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client:         client,
+		platformClient: platformClient,
+		notifyClient:   notifyClient,
+		queue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.BusinessV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("namespacecert_controller", client.BusinessV1().RESTClient().GetRateLimiter())
+	}
+
+	namespaceInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				old, ok1 := oldObj.(*v1.Namespace)
+				cur, ok2 := newObj.(*v1.Namespace)
+				if ok1 && ok2 && controller.needsUpdate(old, cur) {
+					controller.enqueue(newObj)
+				}
+			},
+		},
+		resyncPeriod,
+	)
+	controller.namespaceLister = namespaceInformer.Lister()
+	controller.namespaceListerSynced = namespaceInformer.Informer().HasSynced
+	controller.projectLister = projectInformer.Lister()
+	controller.projectListerSynced = projectInformer.Informer().HasSynced
+	return controller
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+func (c *Controller) needsUpdate(old *v1.Namespace, new *v1.Namespace) bool {
+	if old.UID != new.UID {
+		return true
+	}
+
+	if !reflect.DeepEqual(old.Spec.RevokedCertificates, new.Spec.RevokedCertificates) {
+		return true
+	}
+
+	// Resync
+	if old.ResourceVersion == new.ResourceVersion {
+		return true
+	}
+
+	return false
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Start the informer factories to begin populating the informer caches
+	log.Info("Starting namespace certificate controller")
+	defer log.Info("Shutting down namespace certificate controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.namespaceListerSynced, c.projectListerSynced); !ok {
+		log.Error("Failed to wait for namespace certificate caches to sync")
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of namespace objects.
+// Each namespace can be in the queue at most once.
+// The system ensures that no two workers can process
+// the same namespace at the same time.
+func (c *Controller) worker() {
+	workFunc := func() bool {
+		key, quit := c.queue.Get()
+		if quit {
+			return true
+		}
+		defer c.queue.Done(key)
+
+		err := c.syncItem(key.(string))
+		if err == nil {
+			// no error, forget this entry and return
+			c.queue.Forget(key)
+			return false
+		}
+
+		// rather than wait for a full resync, re-add the namespace to the queue to be processed
+		c.queue.AddRateLimited(key)
+		runtime.HandleError(err)
+		return false
+	}
+
+	for {
+		quit := workFunc()
+
+		if quit {
+			return
+		}
+	}
+}
+
+// syncItem renews and revokes the certificates of the namespace with the
+// given key. This function is not meant to be invoked concurrently with the
+// same key.
+func (c *Controller) syncItem(key string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Debug("Finished syncing namespace certificates", log.String("namespace", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	projectName, namespaceName, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	namespace, err := c.namespaceLister.Namespaces(projectName).Get(namespaceName)
+	switch {
+	case errors.IsNotFound(err):
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve namespace from store", log.String("namespace", key), log.Err(err))
+		return err
+	}
+	if namespace.Status.Phase != v1.NamespaceAvailable || len(namespace.Status.Certificates) == 0 {
+		return nil
+	}
+	return c.process(context.Background(), namespace.DeepCopy())
+}
+
+func (c *Controller) process(ctx context.Context, namespace *v1.Namespace) error {
+	revoked := revokedCertificates(namespace)
+	now := time.Now()
+
+	var (
+		certificates []v1.NamespaceCertStatus
+		renewals     []int
+		errs         []error
+	)
+	for _, cert := range namespace.Status.Certificates {
+		if revoked(cert) && cert.Phase != v1.NamespaceCertRevoked {
+			log.Info("Namespace certificate revoked", log.String("namespace", namespace.Name),
+				log.String("username", cert.Username), log.String("serialNumber", cert.SerialNumber))
+			// The revoked certificate is no longer renewed and expires soon
+			// as the certificates are short-lived.
+			cert.Phase = v1.NamespaceCertRevoked
+			cert.CertPem = nil
+		}
+		// The certificates are forgotten once they expire.
+		if now.After(cert.NotAfter.Time) {
+			continue
+		}
+		if cert.Phase == v1.NamespaceCertActive && cert.NotAfter.Time.Before(cert.RenewUntil.Time) &&
+			cert.NotAfter.Time.Sub(now) <= util.NamespaceCertificateRenewBefore {
+			renewals = append(renewals, len(certificates))
+		}
+		certificates = append(certificates, cert)
+	}
+
+	for _, idx := range renewals {
+		cert := &certificates[idx]
+		issued, err := util.RenewNamespaceCertificate(ctx, c.platformClient, util.NamespaceCertificateSubject{
+			Username:    cert.Username,
+			TenantID:    namespace.Spec.TenantID,
+			ProjectName: namespace.Namespace,
+			ClusterName: namespace.Spec.ClusterName,
+			Namespace:   namespace.Spec.Namespace,
+		}, cert.CertPem, cert.RenewUntil.Time)
+		if err != nil {
+			log.Error("Failed to renew namespace certificate", log.String("namespace", namespace.Name),
+				log.String("username", cert.Username), log.String("serialNumber", cert.SerialNumber), log.Err(err))
+			cert.Message = err.Error()
+			if cert.LastNotifyTime.IsZero() {
+				if err := c.notify(ctx, namespace, cert); err != nil {
+					errs = append(errs, err)
+				} else {
+					cert.LastNotifyTime = metav1.Now()
+				}
+			}
+			errs = append(errs, err)
+			continue
+		}
+		log.Info("Namespace certificate renewed", log.String("namespace", namespace.Name),
+			log.String("username", cert.Username), log.String("serialNumber", issued.SerialNumber))
+		cert.Phase = v1.NamespaceCertSuperseded
+		cert.Message = ""
+		cert.LastNotifyTime = metav1.Time{}
+		certificates = append(certificates, v1.NamespaceCertStatus{
+			Username:     cert.Username,
+			SerialNumber: issued.SerialNumber,
+			ValidDays:    cert.ValidDays,
+			NotBefore:    metav1.NewTime(issued.NotBefore),
+			NotAfter:     metav1.NewTime(issued.NotAfter),
+			RenewUntil:   cert.RenewUntil,
+			Phase:        v1.NamespaceCertActive,
+			CertPem:      issued.CertPem,
+		})
+	}
+
+	if !reflect.DeepEqual(certificates, namespace.Status.Certificates) {
+		if err := c.persistCertificates(ctx, namespace, certificates); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("failed to renew certificates of namespace %s: %v", namespace.Name, errs)
+	}
+	return nil
+}
+
+// revokedCertificates returns whether a certificate of the namespace is
+// revoked, which is listed in the revoked certificates of the namespace or is
+// a renewal of the same key of a certificate listed.
+func revokedCertificates(namespace *v1.Namespace) func(cert v1.NamespaceCertStatus) bool {
+	serialNumbers := sets.NewString(namespace.Spec.RevokedCertificates...)
+	keys := sets.NewString()
+	for _, cert := range namespace.Status.Certificates {
+		if serialNumbers.Has(cert.SerialNumber) {
+			if key, ok := certificatePublicKey(cert); ok {
+				keys.Insert(key)
+			}
+		}
+	}
+	return func(cert v1.NamespaceCertStatus) bool {
+		if serialNumbers.Has(cert.SerialNumber) {
+			return true
+		}
+		key, ok := certificatePublicKey(cert)
+		return ok && keys.Has(key)
+	}
+}
+
+func certificatePublicKey(cert v1.NamespaceCertStatus) (string, bool) {
+	if len(cert.CertPem) == 0 {
+		return "", false
+	}
+	parsed, err := util.ParseNamespaceCertificate(cert.CertPem)
+	if err != nil {
+		return "", false
+	}
+	key, err := x509.MarshalPKIXPublicKey(parsed.PublicKey)
+	if err != nil {
+		return "", false
+	}
+	return string(key), true
+}
+
+// persistCertificates updates the certificates of the namespace, keeping the
+// certificates issued since the namespace was read.
+func (c *Controller) persistCertificates(ctx context.Context, namespace *v1.Namespace, certificates []v1.NamespaceCertStatus) error {
+	read := sets.NewString()
+	for _, cert := range namespace.Status.Certificates {
+		read.Insert(cert.SerialNumber)
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := c.client.BusinessV1().Namespaces(namespace.Namespace).Get(ctx, namespace.Name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		merged := append([]v1.NamespaceCertStatus{}, certificates...)
+		for _, cert := range current.Status.Certificates {
+			if !read.Has(cert.SerialNumber) {
+				merged = append(merged, cert)
+			}
+		}
+		current.Status.Certificates = merged
+		_, err = c.client.BusinessV1().Namespaces(namespace.Namespace).UpdateStatus(ctx, current, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// notify alerts the members of the project that the certificate of the
+// namespace failed to be renewed.
+func (c *Controller) notify(ctx context.Context, namespace *v1.Namespace, cert *v1.NamespaceCertStatus) error {
+	if c.notifyClient == nil {
+		return nil
+	}
+	project, err := c.projectLister.Get(namespace.Namespace)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	alert := project.Spec.CertificateAlert
+	if alert == nil {
+		return nil
+	}
+
+	var receivers []string
+	for _, member := range project.Spec.Members {
+		selector := fields.AndSelectors(
+			fields.OneTermEqualSelector("spec.tenantID", project.Spec.TenantID),
+			fields.OneTermEqualSelector("spec.username", member))
+		receiverList, err := c.notifyClient.Receivers().List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+		if err != nil {
+			return err
+		}
+		for _, receiver := range receiverList.Items {
+			receivers = append(receivers, receiver.Name)
+		}
+	}
+	if len(receivers) == 0 {
+		log.Warn("No receiver found for the members of project", log.String("projectName", project.Name), log.Strings("members", project.Spec.Members))
+		return nil
+	}
+
+	messageRequest := &notifyv1.MessageRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: alert.Channel,
+		},
+		Spec: notifyv1.MessageRequestSpec{
+			TenantID:     project.Spec.TenantID,
+			TemplateName: alert.Template,
+			Receivers:    receivers,
+			Variables: map[string]string{
+				"projectName":        project.Name,
+				"projectDisplayName": project.Spec.DisplayName,
+				"clusterName":        namespace.Spec.ClusterName,
+				"namespace":          namespace.Spec.Namespace,
+				"username":           cert.Username,
+				"serialNumber":       cert.SerialNumber,
+				"notAfter":           cert.NotAfter.Format(time.RFC3339),
+				"message":            cert.Message,
+			},
+		},
+	}
+	if _, err := c.notifyClient.MessageRequests(alert.Channel).Create(ctx, messageRequest, metav1.CreateOptions{}); err != nil {
+		return err
+	}
+	log.Info("Members of project notified of namespace certificate renewal failure", log.String("projectName", project.Name),
+		log.String("namespace", namespace.Name), log.String("serialNumber", cert.SerialNumber))
+	return nil
+}
@@ -0,0 +1,205 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespacecert
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/business/v1"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/business/util"
+)
+
+// newCA returns a self-signed certificate and its key in pem.
+func newCA(t *testing.T, commonName string) ([]byte, []byte) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: commonName},
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+func newTestController(t *testing.T, namespace *v1.Namespace) *Controller {
+	caCert, caKey := newCA(t, "cluster-ca")
+	client := fake.NewSimpleClientset(namespace,
+		&platformv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "cls"},
+			Status: platformv1.ClusterStatus{
+				Addresses: []platformv1.ClusterAddress{{Type: "Advertise", Host: "127.0.0.1", Port: 6443}},
+			},
+		},
+		&platformv1.ClusterCredential{
+			ObjectMeta:  metav1.ObjectMeta{Name: "cc-cls"},
+			ClusterName: "cls",
+			CACert:      caCert,
+			CAKey:       caKey,
+		})
+	return &Controller{client: client, platformClient: client.PlatformV1()}
+}
+
+func newNamespace() *v1.Namespace {
+	return &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "prj", Name: "cls-ns"},
+		Spec: v1.NamespaceSpec{
+			TenantID:    "default",
+			ClusterName: "cls",
+			Namespace:   "ns",
+		},
+		Status: v1.NamespaceStatus{Phase: v1.NamespaceAvailable},
+	}
+}
+
+func issue(t *testing.T, c *Controller, namespace *v1.Namespace, username string) v1.NamespaceCertStatus {
+	renewUntil := time.Now().AddDate(0, 0, 30)
+	issued, err := util.IssueNamespaceCertificate(context.Background(), c.platformClient, util.NamespaceCertificateSubject{
+		Username:    username,
+		TenantID:    namespace.Spec.TenantID,
+		ProjectName: namespace.Namespace,
+		ClusterName: namespace.Spec.ClusterName,
+		Namespace:   namespace.Spec.Namespace,
+	}, renewUntil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issued.NotAfter.After(time.Now().Add(util.NamespaceCertificateValidity)) {
+		t.Errorf("certificate expires at %s, want short-lived", issued.NotAfter)
+	}
+	return v1.NamespaceCertStatus{
+		Username:     username,
+		SerialNumber: issued.SerialNumber,
+		ValidDays:    30,
+		NotAfter:     metav1.NewTime(issued.NotAfter),
+		RenewUntil:   metav1.NewTime(renewUntil),
+		Phase:        v1.NamespaceCertActive,
+		CertPem:      issued.CertPem,
+	}
+}
+
+func getCertificates(t *testing.T, c *Controller, namespace *v1.Namespace) []v1.NamespaceCertStatus {
+	current, err := c.client.BusinessV1().Namespaces(namespace.Namespace).Get(context.Background(), namespace.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return current.Status.Certificates
+}
+
+func TestRenewKeepsTheKey(t *testing.T) {
+	namespace := newNamespace()
+	c := newTestController(t, namespace)
+	cert := issue(t, c, namespace, "alice")
+	// the certificate is due to be renewed.
+	cert.NotAfter = metav1.NewTime(time.Now().Add(time.Hour))
+	namespace.Status.Certificates = []v1.NamespaceCertStatus{cert}
+
+	if err := c.process(context.Background(), namespace.DeepCopy()); err != nil {
+		t.Fatal(err)
+	}
+	certificates := getCertificates(t, c, namespace)
+	if len(certificates) != 2 {
+		t.Fatalf("certificates = %d, want 2", len(certificates))
+	}
+	if certificates[0].Phase != v1.NamespaceCertSuperseded || certificates[1].Phase != v1.NamespaceCertActive {
+		t.Errorf("phases = %s, %s, want Superseded, Active", certificates[0].Phase, certificates[1].Phase)
+	}
+	oldKey, _ := certificatePublicKey(certificates[0])
+	newKey, ok := certificatePublicKey(certificates[1])
+	if !ok || oldKey != newKey {
+		t.Error("the renewal should be issued for the same key")
+	}
+	if certificates[1].SerialNumber == cert.SerialNumber || !certificates[1].RenewUntil.Equal(&cert.RenewUntil) {
+		t.Errorf("unexpected renewal %+v", certificates[1])
+	}
+}
+
+func TestRevokeStopsRenewingTheKey(t *testing.T) {
+	namespace := newNamespace()
+	c := newTestController(t, namespace)
+	superseded := issue(t, c, namespace, "alice")
+	superseded.Phase = v1.NamespaceCertSuperseded
+	// the active certificate is a renewal of the revoked one.
+	active := superseded
+	active.SerialNumber = "renewal"
+	active.Phase = v1.NamespaceCertActive
+	active.NotAfter = metav1.NewTime(time.Now().Add(time.Hour))
+	expired := issue(t, c, namespace, "bob")
+	expired.Phase = v1.NamespaceCertSuperseded
+	expired.NotAfter = metav1.NewTime(time.Now().Add(-time.Hour))
+	namespace.Spec.RevokedCertificates = []string{superseded.SerialNumber}
+	namespace.Status.Certificates = []v1.NamespaceCertStatus{superseded, active, expired}
+
+	if err := c.process(context.Background(), namespace.DeepCopy()); err != nil {
+		t.Fatal(err)
+	}
+	certificates := getCertificates(t, c, namespace)
+	if len(certificates) != 2 {
+		t.Fatalf("certificates = %d, want 2", len(certificates))
+	}
+	for _, cert := range certificates {
+		if cert.Phase != v1.NamespaceCertRevoked || len(cert.CertPem) != 0 {
+			t.Errorf("certificate %s phase = %s, want Revoked", cert.SerialNumber, cert.Phase)
+		}
+	}
+}
+
+func TestRenewRejectsForgedCertificate(t *testing.T) {
+	namespace := newNamespace()
+	c := newTestController(t, namespace)
+	// a certificate not signed by the cluster is written to the status.
+	forged, _ := newCA(t, "admin")
+	namespace.Status.Certificates = []v1.NamespaceCertStatus{{
+		Username:     "admin",
+		SerialNumber: "forged",
+		NotAfter:     metav1.NewTime(time.Now().Add(time.Hour)),
+		RenewUntil:   metav1.NewTime(time.Now().AddDate(0, 0, 30)),
+		Phase:        v1.NamespaceCertActive,
+		CertPem:      forged,
+	}}
+
+	if err := c.process(context.Background(), namespace.DeepCopy()); err == nil {
+		t.Fatal("expect the forged certificate not to be renewed")
+	}
+	certificates := getCertificates(t, c, namespace)
+	if len(certificates) != 1 || certificates[0].Message == "" {
+		t.Errorf("certificates = %+v, want the failure recorded", certificates)
+	}
+}
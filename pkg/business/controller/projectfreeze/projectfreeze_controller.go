@@ -0,0 +1,426 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the “License”); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an “AS IS” BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package projectfreeze
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	v1 "tkestack.io/tke/api/business/v1"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	businessv1informer "tkestack.io/tke/api/client/informers/externalversions/business/v1"
+	businessv1lister "tkestack.io/tke/api/client/listers/business/v1"
+	cls "tkestack.io/tke/pkg/business/controller/namespace/cluster"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	controllerName = "projectfreeze-controller"
+)
+
+// Controller is responsible for freezing the projects, scaling the workloads
+// of their namespaces down to zero, and restoring them once unfrozen.
+type Controller struct {
+	client                clientset.Interface
+	platformClient        platformversionedclient.PlatformV1Interface
+	queue                 workqueue.RateLimitingInterface
+	projectLister         businessv1lister.ProjectLister
+	projectListerSynced   cache.InformerSynced
+	namespaceLister       businessv1lister.NamespaceLister
+	namespaceListerSynced cache.InformerSynced
+}
+
+// NewController creates a new project freeze controller.
+func NewController(client clientset.Interface, platformClient platformversionedclient.PlatformV1Interface,
+	projectInformer businessv1informer.ProjectInformer, namespaceInformer businessv1informer.NamespaceInformer,
+	resyncPeriod time.Duration) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client:         client,
+		platformClient: platformClient,
+		queue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.BusinessV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("projectfreeze_controller", client.BusinessV1().RESTClient().GetRateLimiter())
+	}
+
+	projectInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				old, ok1 := oldObj.(*v1.Project)
+				cur, ok2 := newObj.(*v1.Project)
+				if ok1 && ok2 && controller.needsUpdate(old, cur) {
+					controller.enqueue(newObj)
+				}
+			},
+		},
+		resyncPeriod,
+	)
+	controller.projectLister = projectInformer.Lister()
+	controller.projectListerSynced = projectInformer.Informer().HasSynced
+	controller.namespaceLister = namespaceInformer.Lister()
+	controller.namespaceListerSynced = namespaceInformer.Informer().HasSynced
+	return controller
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+func (c *Controller) needsUpdate(old *v1.Project, new *v1.Project) bool {
+	if old.UID != new.UID {
+		return true
+	}
+
+	if old.Spec.Frozen != new.Spec.Frozen {
+		return true
+	}
+
+	if !reflect.DeepEqual(old.Status.Freeze, new.Status.Freeze) {
+		return true
+	}
+
+	// Resync
+	if old.ResourceVersion == new.ResourceVersion {
+		return true
+	}
+
+	return false
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Start the informer factories to begin populating the informer caches
+	log.Info("Starting project freeze controller")
+	defer log.Info("Shutting down project freeze controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.projectListerSynced, c.namespaceListerSynced); !ok {
+		log.Error("Failed to wait for project freeze caches to sync")
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of project objects.
+// Each project can be in the queue at most once.
+// The system ensures that no two workers can process
+// the same project at the same time.
+func (c *Controller) worker() {
+	workFunc := func() bool {
+		key, quit := c.queue.Get()
+		if quit {
+			return true
+		}
+		defer c.queue.Done(key)
+
+		err := c.syncItem(key.(string))
+		if err == nil {
+			// no error, forget this entry and return
+			c.queue.Forget(key)
+			return false
+		}
+
+		// rather than wait for a full resync, re-add the project to the queue to be processed
+		c.queue.AddRateLimited(key)
+		runtime.HandleError(err)
+		return false
+	}
+
+	for {
+		quit := workFunc()
+
+		if quit {
+			return
+		}
+	}
+}
+
+// syncItem freezes or unfreezes the project with the given key. This
+// function is not meant to be invoked concurrently with the same key.
+func (c *Controller) syncItem(key string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Debug("Finished syncing project freeze", log.String("projectName", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	project, err := c.projectLister.Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve project from store", log.String("projectName", key), log.Err(err))
+		return err
+	}
+	if project.Status.Phase != v1.ProjectActive {
+		return nil
+	}
+	return c.process(context.Background(), project.DeepCopy())
+}
+
+// process moves the freeze of the project through the phases:
+// Freezing -> Frozen -> Unfreezing, and the freeze is removed from the
+// status once the project is unfrozen.
+func (c *Controller) process(ctx context.Context, project *v1.Project) error {
+	freeze := project.Status.Freeze
+	switch {
+	case project.Spec.Frozen && freeze == nil:
+		log.Info("Freezing project", log.String("projectName", project.Name))
+		locked := true
+		project.Status.Freeze = &v1.ProjectFreezeStatus{
+			Phase:              v1.ProjectFreezing,
+			Locked:             project.Status.Locked,
+			LastTransitionTime: metav1.Now(),
+		}
+		project.Status.Locked = &locked
+		return c.persist(ctx, project)
+	case project.Spec.Frozen && freeze.Phase == v1.ProjectUnfreezing:
+		log.Info("Freezing project again before it is unfrozen", log.String("projectName", project.Name))
+		// The namespaces partially restored are frozen again, keeping the
+		// replicas recorded when the project was first frozen.
+		for i := range freeze.Namespaces {
+			freeze.Namespaces[i].Frozen = false
+		}
+		return c.transit(ctx, project, v1.ProjectFreezing)
+	case project.Spec.Frozen && freeze.Phase == v1.ProjectFreezing:
+		return c.freeze(ctx, project)
+	case !project.Spec.Frozen && freeze != nil && freeze.Phase != v1.ProjectUnfreezing:
+		log.Info("Unfreezing project", log.String("projectName", project.Name))
+		return c.transit(ctx, project, v1.ProjectUnfreezing)
+	case !project.Spec.Frozen && freeze != nil:
+		return c.unfreeze(ctx, project)
+	}
+	return nil
+}
+
+func (c *Controller) freeze(ctx context.Context, project *v1.Project) error {
+	namespaces, err := c.namespaceLister.Namespaces(project.Name).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, namespace := range namespaces {
+		if namespace.Status.Phase != v1.NamespaceAvailable {
+			continue
+		}
+		if err := c.freezeNamespace(ctx, project, namespace); err != nil {
+			log.Error("Failed to freeze namespace", log.String("projectName", project.Name),
+				log.String("namespaceName", namespace.Name), log.Err(err))
+			errs = append(errs, fmt.Errorf("namespace %s: %v", namespace.Name, err))
+		}
+	}
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		project.Status.Freeze.Message = err.Error()
+		if persistErr := c.persist(ctx, project); persistErr != nil {
+			return persistErr
+		}
+		return err
+	}
+
+	log.Info("Project frozen", log.String("projectName", project.Name))
+	return c.transit(ctx, project, v1.ProjectFrozen)
+}
+
+// freezeNamespace stops new pods from running in the namespace, records the
+// replicas of its workloads and scales them down to zero, and evicts the pods
+// left such as the bare pods.
+func (c *Controller) freezeNamespace(ctx context.Context, project *v1.Project, namespace *v1.Namespace) error {
+	idx := -1
+	for i, frozen := range project.Status.Freeze.Namespaces {
+		if frozen.Name == namespace.Name {
+			idx = i
+			break
+		}
+	}
+	if idx >= 0 && project.Status.Freeze.Namespaces[idx].Frozen {
+		return nil
+	}
+
+	kubeClient, err := util.BuildExternalClientSetWithName(ctx, c.platformClient, namespace.Spec.ClusterName)
+	if err != nil {
+		return err
+	}
+	if err := cls.EnsureFreezeQuotaOnCluster(ctx, kubeClient, namespace.Spec.Namespace); err != nil {
+		return err
+	}
+	workloads, err := cls.ListNamespaceWorkloadReplicas(ctx, kubeClient, namespace.Spec.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if idx < 0 {
+		project.Status.Freeze.Namespaces = append(project.Status.Freeze.Namespaces, v1.FrozenNamespace{
+			Name:        namespace.Name,
+			ClusterName: namespace.Spec.ClusterName,
+			Namespace:   namespace.Spec.Namespace,
+		})
+		idx = len(project.Status.Freeze.Namespaces) - 1
+	}
+	frozen := &project.Status.Freeze.Namespaces[idx]
+	// The replicas recorded before are the ones to restore, a workload may
+	// have been scaled down already by an interrupted freeze.
+	for _, workload := range workloads {
+		recorded := false
+		for _, one := range frozen.Workloads {
+			if one.Kind == workload.Kind && one.Name == workload.Name {
+				recorded = true
+				break
+			}
+		}
+		if !recorded {
+			frozen.Workloads = append(frozen.Workloads, workload)
+		}
+	}
+	// The replicas are saved before scaling down, so that they are never lost.
+	if err := c.persist(ctx, project); err != nil {
+		return err
+	}
+
+	frozen = &project.Status.Freeze.Namespaces[idx]
+	var errs []error
+	for _, workload := range frozen.Workloads {
+		if err := cls.ScaleNamespaceWorkload(ctx, kubeClient, frozen.Namespace, workload, 0); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) != 0 {
+		return utilerrors.NewAggregate(errs)
+	}
+	if err := cls.EvictNamespacePods(ctx, kubeClient, frozen.Namespace); err != nil {
+		return err
+	}
+	frozen.Frozen = true
+	return c.persist(ctx, project)
+}
+
+func (c *Controller) unfreeze(ctx context.Context, project *v1.Project) error {
+	var (
+		remaining []v1.FrozenNamespace
+		errs      []error
+	)
+	for _, frozen := range project.Status.Freeze.Namespaces {
+		if err := c.unfreezeNamespace(ctx, project, frozen); err != nil {
+			log.Error("Failed to unfreeze namespace", log.String("projectName", project.Name),
+				log.String("namespaceName", frozen.Name), log.Err(err))
+			errs = append(errs, fmt.Errorf("namespace %s: %v", frozen.Name, err))
+			remaining = append(remaining, frozen)
+		}
+	}
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		project.Status.Freeze.Namespaces = remaining
+		project.Status.Freeze.Message = err.Error()
+		if persistErr := c.persist(ctx, project); persistErr != nil {
+			return persistErr
+		}
+		return err
+	}
+
+	log.Info("Project unfrozen", log.String("projectName", project.Name))
+	project.Status.Locked = project.Status.Freeze.Locked
+	project.Status.Freeze = nil
+	return c.persist(ctx, project)
+}
+
+// unfreezeNamespace allows pods to run in the namespace again, and restores
+// the replicas of its workloads.
+func (c *Controller) unfreezeNamespace(ctx context.Context, project *v1.Project, frozen v1.FrozenNamespace) error {
+	if _, err := c.namespaceLister.Namespaces(project.Name).Get(frozen.Name); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	kubeClient, err := util.BuildExternalClientSetWithName(ctx, c.platformClient, frozen.ClusterName)
+	if err != nil {
+		return err
+	}
+	if err := cls.DeleteFreezeQuotaFromCluster(ctx, kubeClient, frozen.Namespace); err != nil {
+		return err
+	}
+	var errs []error
+	for _, workload := range frozen.Workloads {
+		if err := cls.ScaleNamespaceWorkload(ctx, kubeClient, frozen.Namespace, workload, workload.Replicas); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+func (c *Controller) transit(ctx context.Context, project *v1.Project, phase v1.ProjectFreezePhase) error {
+	project.Status.Freeze.Phase = phase
+	project.Status.Freeze.LastTransitionTime = metav1.Now()
+	project.Status.Freeze.Message = ""
+	return c.persist(ctx, project)
+}
+
+// persist updates the freeze and the lock of the project, and refreshes the
+// project with the one updated.
+func (c *Controller) persist(ctx context.Context, project *v1.Project) error {
+	var updated *v1.Project
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := c.client.BusinessV1().Projects().Get(ctx, project.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		current.Status.Locked = project.Status.Locked
+		current.Status.Freeze = project.Status.Freeze
+		updated, err = c.client.BusinessV1().Projects().UpdateStatus(ctx, current, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	*project = *updated
+	return nil
+}
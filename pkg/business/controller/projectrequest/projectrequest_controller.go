@@ -0,0 +1,450 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the “License”); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an “AS IS” BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package projectrequest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	av1 "tkestack.io/tke/api/auth/v1"
+	v1 "tkestack.io/tke/api/business/v1"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	authversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	businessv1informer "tkestack.io/tke/api/client/informers/externalversions/business/v1"
+	businessv1lister "tkestack.io/tke/api/client/listers/business/v1"
+	notifyv1 "tkestack.io/tke/api/notify/v1"
+	authutil "tkestack.io/tke/pkg/auth/util"
+	businessutil "tkestack.io/tke/pkg/business/util"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	clientRetryCount    = 5
+	clientRetryInterval = 5 * time.Second
+)
+
+const (
+	controllerName = "projectrequest-controller"
+)
+
+// Controller is responsible for carrying out the approved project requests,
+// and informing the requesters and the administrators of the requests.
+type Controller struct {
+	client                   clientset.Interface
+	authClient               authversionedclient.AuthV1Interface
+	notifyClient             notifyversionedclient.NotifyV1Interface
+	queue                    workqueue.RateLimitingInterface
+	projectRequestLister     businessv1lister.ProjectRequestLister
+	projectRequestListSynced cache.InformerSynced
+}
+
+// NewController creates a new project request controller.
+func NewController(client clientset.Interface, authClient authversionedclient.AuthV1Interface,
+	notifyClient notifyversionedclient.NotifyV1Interface,
+	projectRequestInformer businessv1informer.ProjectRequestInformer, resyncPeriod time.Duration) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client:       client,
+		authClient:   authClient,
+		notifyClient: notifyClient,
+		queue:        workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.BusinessV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("projectrequest_controller", client.BusinessV1().RESTClient().GetRateLimiter())
+	}
+
+	projectRequestInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				old, ok1 := oldObj.(*v1.ProjectRequest)
+				cur, ok2 := newObj.(*v1.ProjectRequest)
+				if ok1 && ok2 && controller.needsUpdate(old, cur) {
+					controller.enqueue(newObj)
+				}
+			},
+		},
+		resyncPeriod,
+	)
+	controller.projectRequestLister = projectRequestInformer.Lister()
+	controller.projectRequestListSynced = projectRequestInformer.Informer().HasSynced
+	return controller
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+func (c *Controller) needsUpdate(old *v1.ProjectRequest, new *v1.ProjectRequest) bool {
+	if old.UID != new.UID {
+		return true
+	}
+
+	if !reflect.DeepEqual(old.Spec, new.Spec) {
+		return true
+	}
+
+	if !reflect.DeepEqual(old.Status, new.Status) {
+		return true
+	}
+
+	return false
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Start the informer factories to begin populating the informer caches
+	log.Info("Starting project request controller")
+	defer log.Info("Shutting down project request controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.projectRequestListSynced); !ok {
+		log.Error("Failed to wait for project request caches to sync")
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of project request objects.
+// Each project request can be in the queue at most once.
+// The system ensures that no two workers can process
+// the same project request at the same time.
+func (c *Controller) worker() {
+	workFunc := func() bool {
+		key, quit := c.queue.Get()
+		if quit {
+			return true
+		}
+		defer c.queue.Done(key)
+
+		err := c.syncItem(key.(string))
+		if err == nil {
+			// no error, forget this entry and return
+			c.queue.Forget(key)
+			return false
+		}
+
+		// rather than wait for a full resync, re-add the project request to the queue to be processed
+		c.queue.AddRateLimited(key)
+		runtime.HandleError(err)
+		return false
+	}
+
+	for {
+		quit := workFunc()
+
+		if quit {
+			return
+		}
+	}
+}
+
+// syncItem will sync the project request with the given key.
+// This function is not meant to be invoked concurrently with the same key.
+func (c *Controller) syncItem(key string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Debug("Finished syncing project request", log.String("requestName", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	request, err := c.projectRequestLister.Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve project request from store", log.String("requestName", key), log.Err(err))
+		return err
+	}
+	return c.process(context.Background(), request.DeepCopy())
+}
+
+func (c *Controller) process(ctx context.Context, request *v1.ProjectRequest) error {
+	if request.Status.Phase == v1.ProjectRequestApproved {
+		return c.processApproved(ctx, request)
+	}
+	if request.Spec.Channel == "" || c.notifyClient == nil || request.Status.NotifiedPhase == request.Status.Phase {
+		return nil
+	}
+	if err := c.notify(ctx, request); err != nil {
+		return err
+	}
+	request.Status.NotifiedPhase = request.Status.Phase
+	return c.persistUpdate(ctx, request)
+}
+
+// processApproved creates or updates the project of the request, and binds the
+// requester as an owner of the project.
+func (c *Controller) processApproved(ctx context.Context, request *v1.ProjectRequest) error {
+	var (
+		project *v1.Project
+		err     error
+	)
+	switch request.Spec.Type {
+	case v1.ProjectRequestCreate:
+		project, err = c.createProject(ctx, request)
+	case v1.ProjectRequestQuota:
+		project, err = c.addProjectQuota(ctx, request)
+	default:
+		err = fmt.Errorf("unknown request type %s", request.Spec.Type)
+	}
+	if err == nil && request.Spec.Type == v1.ProjectRequestQuota {
+		err = c.bindOwner(ctx, request, project)
+	}
+
+	request.Status.LastTransitionTime = metav1.Now()
+	if err != nil {
+		log.Error("Failed to carry out project request", log.String("requestName", request.Name), log.Err(err))
+		request.Status.Phase = v1.ProjectRequestFailed
+		request.Status.Reason = err.Error()
+		request.Status.Message = "failed to create or update project"
+		return c.persistUpdate(ctx, request)
+	}
+	request.Status.Phase = v1.ProjectRequestCompleted
+	request.Status.ProjectName = project.Name
+	return c.persistUpdate(ctx, request)
+}
+
+// createProject creates the project of the request, the requester becomes an
+// owner of the project when the project controller binds the members.
+func (c *Controller) createProject(ctx context.Context, request *v1.ProjectRequest) (*v1.Project, error) {
+	// The project may have been created before the status was persisted.
+	selector := labels.SelectorFromSet(labels.Set{businessutil.LabelProjectRequestName: request.Name})
+	projectList, err := c.client.BusinessV1().Projects().List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	if len(projectList.Items) > 0 {
+		return &projectList.Items[0], nil
+	}
+
+	project := &v1.Project{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{businessutil.LabelProjectRequestName: request.Name},
+		},
+		Spec: v1.ProjectSpec{
+			TenantID:          request.Spec.TenantID,
+			DisplayName:       request.Spec.DisplayName,
+			Members:           []string{request.Spec.Requester},
+			ParentProjectName: request.Spec.ParentProjectName,
+			Clusters:          request.Spec.Clusters,
+		},
+	}
+	return c.client.BusinessV1().Projects().Create(ctx, project, metav1.CreateOptions{})
+}
+
+// addProjectQuota adds the quota of the request to the project.
+func (c *Controller) addProjectQuota(ctx context.Context, request *v1.ProjectRequest) (*v1.Project, error) {
+	// The quota may have been added before the status was persisted, the
+	// project is annotated with the request in the same update.
+	annotation := businessutil.AnnotationProjectRequestPrefix + string(request.UID)
+	var project *v1.Project
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := c.client.BusinessV1().Projects().Get(ctx, request.Spec.ProjectName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if _, ok := current.Annotations[annotation]; ok {
+			project = current
+			return nil
+		}
+		if current.Annotations == nil {
+			current.Annotations = map[string]string{}
+		}
+		current.Annotations[annotation] = request.Name
+		if current.Spec.Clusters == nil {
+			current.Spec.Clusters = v1.ClusterHard{}
+		}
+		for clusterName, clusterHard := range request.Spec.Clusters {
+			hard := v1.ResourceList{}
+			for k, v := range current.Spec.Clusters[clusterName].Hard {
+				hard[k] = v.DeepCopy()
+			}
+			for k, v := range clusterHard.Hard {
+				quantity := hard[k]
+				quantity.Add(v)
+				hard[k] = quantity
+			}
+			current.Spec.Clusters[clusterName] = v1.HardQuantity{Hard: hard}
+		}
+		if !sets.NewString(current.Spec.Members...).Has(request.Spec.Requester) {
+			current.Spec.Members = append(current.Spec.Members, request.Spec.Requester)
+		}
+		project, err = c.client.BusinessV1().Projects().Update(ctx, current, metav1.UpdateOptions{})
+		return err
+	})
+	return project, err
+}
+
+// bindOwner binds the requester as an owner of the project through the auth api.
+func (c *Controller) bindOwner(ctx context.Context, request *v1.ProjectRequest, project *v1.Project) error {
+	if c.authClient == nil {
+		return nil
+	}
+	bindingRequest := av1.ProjectPolicyBindingRequest{
+		TenantID: project.Spec.TenantID,
+		Policies: []string{authutil.ProjectOwnerPolicyID(project.Spec.TenantID)},
+		Users:    []av1.Subject{{Name: request.Spec.Requester}},
+	}
+	result := &av1.ProjectPolicyBindingList{}
+	return c.authClient.RESTClient().Post().
+		Resource("projects").
+		Name(project.Name).
+		SubResource("binding").
+		Body(&bindingRequest).
+		Do(ctx).
+		Into(result)
+}
+
+// administrators returns the platform administrators of the tenant.
+func (c *Controller) administrators(ctx context.Context, tenantID string) ([]string, error) {
+	platformList, err := c.client.BusinessV1().Platforms().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.tenantID", tenantID).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	administrators := sets.NewString()
+	for _, platform := range platformList.Items {
+		administrators.Insert(platform.Spec.Administrators...)
+	}
+	return administrators.List(), nil
+}
+
+// receivers returns the notify receivers of the users.
+func (c *Controller) receivers(ctx context.Context, tenantID string, usernames []string) ([]string, error) {
+	var receivers []string
+	for _, username := range usernames {
+		selector := fields.AndSelectors(
+			fields.OneTermEqualSelector("spec.tenantID", tenantID),
+			fields.OneTermEqualSelector("spec.username", username))
+		receiverList, err := c.notifyClient.Receivers().List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+		if err != nil {
+			return nil, err
+		}
+		for _, receiver := range receiverList.Items {
+			receivers = append(receivers, receiver.Name)
+		}
+	}
+	return receivers, nil
+}
+
+// notify informs the administrators of new requests, and both the requester
+// and the administrators of the decisions and the results.
+func (c *Controller) notify(ctx context.Context, request *v1.ProjectRequest) error {
+	usernames, err := c.administrators(ctx, request.Spec.TenantID)
+	if err != nil {
+		return err
+	}
+	if request.Status.Phase != v1.ProjectRequestPending {
+		usernames = append(usernames, request.Spec.Requester)
+	}
+	receivers, err := c.receivers(ctx, request.Spec.TenantID, usernames)
+	if err != nil {
+		return err
+	}
+	if len(receivers) == 0 {
+		log.Warn("No receiver found for project request", log.String("requestName", request.Name), log.Strings("users", usernames))
+		return nil
+	}
+
+	projectName := request.Spec.ProjectName
+	if request.Status.ProjectName != "" {
+		projectName = request.Status.ProjectName
+	}
+	messageRequest := &notifyv1.MessageRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: request.Spec.Channel,
+		},
+		Spec: notifyv1.MessageRequestSpec{
+			TenantID:     request.Spec.TenantID,
+			TemplateName: request.Spec.Template,
+			Receivers:    receivers,
+			Variables: map[string]string{
+				"requestName":        request.Name,
+				"type":               string(request.Spec.Type),
+				"requester":          request.Spec.Requester,
+				"projectName":        projectName,
+				"projectDisplayName": request.Spec.DisplayName,
+				"justification":      request.Spec.Justification,
+				"phase":              string(request.Status.Phase),
+				"approver":           request.Status.Approver,
+				"reason":             request.Status.Reason,
+			},
+		},
+	}
+	if _, err := c.notifyClient.MessageRequests(request.Spec.Channel).Create(ctx, messageRequest, metav1.CreateOptions{}); err != nil {
+		return err
+	}
+	log.Info("Project request notified", log.String("requestName", request.Name), log.String("phase", string(request.Status.Phase)))
+	return nil
+}
+
+func (c *Controller) persistUpdate(ctx context.Context, request *v1.ProjectRequest) error {
+	var err error
+	for i := 0; i < clientRetryCount; i++ {
+		_, err = c.client.BusinessV1().ProjectRequests().UpdateStatus(ctx, request, metav1.UpdateOptions{})
+		if err == nil {
+			return nil
+		}
+		if errors.IsNotFound(err) {
+			log.Info("Not persisting update to project request that no longer exists", log.String("requestName", request.Name), log.Err(err))
+			return nil
+		}
+		if errors.IsConflict(err) {
+			return fmt.Errorf("not persisting update to project request '%s' that has been changed since we received it: %v", request.Name, err)
+		}
+		log.Warn(fmt.Sprintf("Failed to persist updated status of project request '%s/%s'", request.Name, request.Status.Phase),
+			log.String("requestName", request.Name), log.Err(err))
+		time.Sleep(clientRetryInterval)
+	}
+	return err
+}
@@ -0,0 +1,104 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package projectrequest
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/business/v1"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+)
+
+func newApprovedRequest(requestType v1.ProjectRequestType) *v1.ProjectRequest {
+	return &v1.ProjectRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: "prq", UID: "uid"},
+		Spec: v1.ProjectRequestSpec{
+			TenantID:    "default",
+			Requester:   "alice",
+			Type:        requestType,
+			ProjectName: "prj",
+			Clusters: v1.ClusterHard{
+				"cls": {Hard: v1.ResourceList{"requests.cpu": resource.MustParse("1")}},
+			},
+		},
+		Status: v1.ProjectRequestStatus{Phase: v1.ProjectRequestApproved},
+	}
+}
+
+func TestProcessApprovedQuotaIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	request := newApprovedRequest(v1.ProjectRequestQuota)
+	project := &v1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "prj"},
+		Spec: v1.ProjectSpec{Clusters: v1.ClusterHard{
+			"cls": {Hard: v1.ResourceList{"requests.cpu": resource.MustParse("2")}},
+		}},
+	}
+	c := &Controller{client: fake.NewSimpleClientset(request, project)}
+
+	// the request is processed again if its status failed to be persisted.
+	for i := 0; i < 2; i++ {
+		if err := c.processApproved(ctx, request.DeepCopy()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	project, err := c.client.BusinessV1().Projects().Get(ctx, "prj", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	quantity := project.Spec.Clusters["cls"].Hard["requests.cpu"]
+	if quantity.String() != "3" {
+		t.Errorf("project quota = %s, want 3", quantity.String())
+	}
+	if len(project.Spec.Members) != 1 || project.Spec.Members[0] != "alice" {
+		t.Errorf("project members = %v, want [alice]", project.Spec.Members)
+	}
+	updated, err := c.client.BusinessV1().ProjectRequests().Get(ctx, "prq", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Status.Phase != v1.ProjectRequestCompleted || updated.Status.ProjectName != "prj" {
+		t.Errorf("request status = %s, %s, want completed, prj", updated.Status.Phase, updated.Status.ProjectName)
+	}
+}
+
+func TestProcessApprovedCreateIsIdempotent(t *testing.T) {
+	ctx := context.Background()
+	request := newApprovedRequest(v1.ProjectRequestCreate)
+	request.Spec.ProjectName = ""
+	c := &Controller{client: fake.NewSimpleClientset(request)}
+
+	for i := 0; i < 2; i++ {
+		if err := c.processApproved(ctx, request.DeepCopy()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	projects, err := c.client.BusinessV1().Projects().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(projects.Items) != 1 {
+		t.Errorf("%d projects are created, want 1", len(projects.Items))
+	}
+}
@@ -0,0 +1,465 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the “License”); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an “AS IS” BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package quotaalert
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimachineryresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	v1 "tkestack.io/tke/api/business/v1"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	businessv1informer "tkestack.io/tke/api/client/informers/externalversions/business/v1"
+	businessv1lister "tkestack.io/tke/api/client/listers/business/v1"
+	notifyv1 "tkestack.io/tke/api/notify/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	quotaAlertEnqueueGracePeriod = 5 * time.Second
+
+	// quotaAlertHysteresis is the percentage the usage must fall below an
+	// alerted threshold before the threshold can be alerted again.
+	quotaAlertHysteresis = 5
+)
+
+const (
+	controllerName = "quotaalert-controller"
+)
+
+// Controller is responsible for alerting the members of projects when the
+// quota usage of the projects or their namespaces crosses the thresholds.
+type Controller struct {
+	client                clientset.Interface
+	notifyClient          notifyversionedclient.NotifyV1Interface
+	queue                 workqueue.RateLimitingInterface
+	projectLister         businessv1lister.ProjectLister
+	projectListerSynced   cache.InformerSynced
+	namespaceLister       businessv1lister.NamespaceLister
+	namespaceListerSynced cache.InformerSynced
+}
+
+// NewController creates a new quota alert controller.
+func NewController(client clientset.Interface, notifyClient notifyversionedclient.NotifyV1Interface,
+	projectInformer businessv1informer.ProjectInformer, namespaceInformer businessv1informer.NamespaceInformer,
+	resyncPeriod time.Duration) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client:       client,
+		notifyClient: notifyClient,
+		queue:        workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.BusinessV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("quotaalert_controller", client.BusinessV1().RESTClient().GetRateLimiter())
+	}
+
+	projectInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				old, ok1 := oldObj.(*v1.Project)
+				cur, ok2 := newObj.(*v1.Project)
+				if ok1 && ok2 && controller.needsUpdate(old, cur) {
+					controller.enqueue(newObj)
+				}
+			},
+		},
+		resyncPeriod,
+	)
+	controller.projectLister = projectInformer.Lister()
+	controller.projectListerSynced = projectInformer.Informer().HasSynced
+
+	namespaceInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				old, ok1 := oldObj.(*v1.Namespace)
+				cur, ok2 := newObj.(*v1.Namespace)
+				if ok1 && ok2 && !reflect.DeepEqual(old.Status.Used, cur.Status.Used) {
+					controller.queue.AddAfter(cur.ObjectMeta.Namespace, quotaAlertEnqueueGracePeriod)
+				}
+			},
+		},
+	)
+	controller.namespaceLister = namespaceInformer.Lister()
+	controller.namespaceListerSynced = namespaceInformer.Informer().HasSynced
+	return controller
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		runtime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	c.queue.AddAfter(key, quotaAlertEnqueueGracePeriod)
+}
+
+func (c *Controller) needsUpdate(old *v1.Project, new *v1.Project) bool {
+	if old.UID != new.UID {
+		return true
+	}
+
+	if !reflect.DeepEqual(old.Spec.QuotaAlert, new.Spec.QuotaAlert) ||
+		!reflect.DeepEqual(old.Spec.Clusters, new.Spec.Clusters) {
+		return true
+	}
+
+	if !reflect.DeepEqual(old.Status.Clusters, new.Status.Clusters) {
+		return true
+	}
+
+	return false
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Start the informer factories to begin populating the informer caches
+	log.Info("Starting quota alert controller")
+	defer log.Info("Shutting down quota alert controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.projectListerSynced, c.namespaceListerSynced); !ok {
+		log.Error("Failed to wait for quota alert caches to sync")
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of project objects.
+// Each project can be in the queue at most once.
+// The system ensures that no two workers can process
+// the same project at the same time.
+func (c *Controller) worker() {
+	workFunc := func() bool {
+		key, quit := c.queue.Get()
+		if quit {
+			return true
+		}
+		defer c.queue.Done(key)
+
+		err := c.syncItem(key.(string))
+		if err == nil {
+			// no error, forget this entry and return
+			c.queue.Forget(key)
+			return false
+		}
+
+		// rather than wait for a full resync, re-add the project to the queue to be processed
+		c.queue.AddRateLimited(key)
+		runtime.HandleError(err)
+		return false
+	}
+
+	for {
+		quit := workFunc()
+
+		if quit {
+			return
+		}
+	}
+}
+
+// syncItem evaluates the quota alert rules of the project with the given key.
+// This function is not meant to be invoked concurrently with the same key.
+func (c *Controller) syncItem(key string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Debug("Finished syncing quota alert", log.String("projectName", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	project, err := c.projectLister.Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve project from store", log.String("projectName", key), log.Err(err))
+		return err
+	}
+	if project.Status.Phase == v1.ProjectTerminating {
+		return nil
+	}
+	return c.process(context.Background(), project)
+}
+
+// quotaUsage is the usage of a resource in a cluster of a project, or in a
+// namespace of a project.
+type quotaUsage struct {
+	clusterName string
+	namespace   string
+	resource    string
+	// used is the quota allocated to the child projects and namespaces for a
+	// project, and the resources used for a namespace.
+	used    apimachineryresource.Quantity
+	hard    apimachineryresource.Quantity
+	percent float64
+}
+
+// allocated returns true if the usage is the quota allocated by the project
+// rather than the resources used.
+func (u quotaUsage) allocated() bool {
+	return u.namespace == ""
+}
+
+func (u quotaUsage) key() string {
+	return u.clusterName + "/" + u.namespace + "/" + u.resource
+}
+
+func stateKey(state v1.QuotaAlertState) string {
+	return state.ClusterName + "/" + state.Namespace + "/" + state.Resource
+}
+
+func (c *Controller) process(ctx context.Context, project *v1.Project) error {
+	if project.Spec.QuotaAlert == nil {
+		if len(project.Status.QuotaAlerts) == 0 {
+			return nil
+		}
+		return c.persistQuotaAlerts(ctx, project.Name, nil)
+	}
+
+	usages, err := c.quotaUsages(project)
+	if err != nil {
+		return err
+	}
+	thresholds := make(map[string][]int32, len(project.Spec.QuotaAlert.Rules))
+	for _, rule := range project.Spec.QuotaAlert.Rules {
+		thresholds[rule.Resource] = rule.Thresholds
+	}
+	previous := make(map[string]v1.QuotaAlertState, len(project.Status.QuotaAlerts))
+	for _, state := range project.Status.QuotaAlerts {
+		previous[stateKey(state)] = state
+	}
+
+	var (
+		states    []v1.QuotaAlertState
+		receivers []string
+		resolved  bool
+		errs      []error
+	)
+	for _, usage := range usages {
+		state := previous[usage.key()]
+		threshold, notify := evaluate(usage.percent, thresholds[usage.resource], state.Threshold)
+		if notify {
+			if !resolved {
+				receivers, err = c.receivers(ctx, project)
+				if err != nil {
+					return err
+				}
+				resolved = true
+			}
+			if err := c.notify(ctx, project, usage, threshold, receivers); err != nil {
+				// The threshold is alerted again on the next sync.
+				errs = append(errs, err)
+				threshold = state.Threshold
+			} else {
+				state.LastNotifyTime = metav1.Now()
+			}
+		}
+		if threshold == 0 {
+			continue
+		}
+		states = append(states, v1.QuotaAlertState{
+			ClusterName:    usage.clusterName,
+			Namespace:      usage.namespace,
+			Resource:       usage.resource,
+			Threshold:      threshold,
+			LastNotifyTime: state.LastNotifyTime,
+		})
+	}
+
+	if !reflect.DeepEqual(states, project.Status.QuotaAlerts) {
+		if err := c.persistQuotaAlerts(ctx, project.Name, states); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("failed to alert quota usage of project %s: %v", project.Name, errs)
+	}
+	return nil
+}
+
+// quotaUsages returns the usage of the resources with alert rules, in the
+// clusters of the project and in the namespaces of the project. The usage of
+// a project is the quota allocated to its child projects and namespaces.
+func (c *Controller) quotaUsages(project *v1.Project) ([]quotaUsage, error) {
+	var usages []quotaUsage
+	for _, rule := range project.Spec.QuotaAlert.Rules {
+		for clusterName, clusterHard := range project.Spec.Clusters {
+			hard, ok := clusterHard.Hard[rule.Resource]
+			if !ok {
+				continue
+			}
+			used := project.Status.Clusters[clusterName].Used[rule.Resource]
+			usages = append(usages, newQuotaUsage(clusterName, "", rule.Resource, used, hard))
+		}
+	}
+
+	namespaces, err := c.namespaceLister.Namespaces(project.Name).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, namespace := range namespaces {
+		if namespace.Status.Phase != v1.NamespaceAvailable {
+			continue
+		}
+		for _, rule := range project.Spec.QuotaAlert.Rules {
+			hard, ok := namespace.Spec.Hard[rule.Resource]
+			if !ok {
+				continue
+			}
+			used := namespace.Status.Used[rule.Resource]
+			usages = append(usages, newQuotaUsage(namespace.Spec.ClusterName, namespace.Name, rule.Resource, used, hard))
+		}
+	}
+
+	sort.Slice(usages, func(i, j int) bool { return usages[i].key() < usages[j].key() })
+	return usages, nil
+}
+
+func newQuotaUsage(clusterName, namespace, resource string, used, hard apimachineryresource.Quantity) quotaUsage {
+	usage := quotaUsage{
+		clusterName: clusterName,
+		namespace:   namespace,
+		resource:    resource,
+		used:        used,
+		hard:        hard,
+	}
+	if hard.MilliValue() > 0 {
+		usage.percent = float64(used.MilliValue()) * 100 / float64(hard.MilliValue())
+	}
+	return usage
+}
+
+// evaluate returns the highest threshold alerted for the usage percentage,
+// and whether the threshold has just been crossed. An alerted threshold is
+// kept until the usage falls below it by the hysteresis, so that the alerts
+// do not repeat when the usage fluctuates around the threshold.
+func evaluate(percent float64, thresholds []int32, previous int32) (int32, bool) {
+	var crossed int32
+	for _, threshold := range thresholds {
+		if percent >= float64(threshold) && threshold > crossed {
+			crossed = threshold
+		}
+	}
+	switch {
+	case crossed > previous:
+		return crossed, true
+	case crossed < previous && percent < float64(previous-quotaAlertHysteresis):
+		return crossed, false
+	default:
+		return previous, false
+	}
+}
+
+// receivers returns the notify receivers of the members of the project.
+func (c *Controller) receivers(ctx context.Context, project *v1.Project) ([]string, error) {
+	var receivers []string
+	for _, member := range project.Spec.Members {
+		selector := fields.AndSelectors(
+			fields.OneTermEqualSelector("spec.tenantID", project.Spec.TenantID),
+			fields.OneTermEqualSelector("spec.username", member))
+		receiverList, err := c.notifyClient.Receivers().List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+		if err != nil {
+			return nil, err
+		}
+		for _, receiver := range receiverList.Items {
+			receivers = append(receivers, receiver.Name)
+		}
+	}
+	return receivers, nil
+}
+
+func (c *Controller) notify(ctx context.Context, project *v1.Project, usage quotaUsage, threshold int32, receivers []string) error {
+	if len(receivers) == 0 {
+		log.Warn("No receiver found for the members of project", log.String("projectName", project.Name), log.Strings("members", project.Spec.Members))
+		return nil
+	}
+	quotaAlert := project.Spec.QuotaAlert
+	messageRequest := &notifyv1.MessageRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: quotaAlert.Channel,
+		},
+		Spec: notifyv1.MessageRequestSpec{
+			TenantID:     project.Spec.TenantID,
+			TemplateName: quotaAlert.Template,
+			Receivers:    receivers,
+			Variables: map[string]string{
+				"projectName":        project.Name,
+				"projectDisplayName": project.Spec.DisplayName,
+				"clusterName":        usage.clusterName,
+				"namespace":          usage.namespace,
+				"resource":           usage.resource,
+				"used":               usage.used.String(),
+				"allocated":          strconv.FormatBool(usage.allocated()),
+				"hard":               usage.hard.String(),
+				"percent":            strconv.FormatFloat(usage.percent, 'f', 1, 64),
+				"threshold":          strconv.Itoa(int(threshold)),
+			},
+		},
+	}
+	if _, err := c.notifyClient.MessageRequests(quotaAlert.Channel).Create(ctx, messageRequest, metav1.CreateOptions{}); err != nil {
+		return err
+	}
+	log.Info("Members of project notified of quota usage", log.String("projectName", project.Name), log.String("clusterName", usage.clusterName),
+		log.String("namespace", usage.namespace), log.String("resource", usage.resource), log.Int32("threshold", threshold))
+	return nil
+}
+
+func (c *Controller) persistQuotaAlerts(ctx context.Context, projectName string, states []v1.QuotaAlertState) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		project, err := c.client.BusinessV1().Projects().Get(ctx, projectName, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		project.Status.QuotaAlerts = states
+		_, err = c.client.BusinessV1().Projects().UpdateStatus(ctx, project, metav1.UpdateOptions{})
+		return err
+	})
+}
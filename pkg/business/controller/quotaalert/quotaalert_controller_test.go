@@ -0,0 +1,67 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package quotaalert
+
+import (
+	"testing"
+
+	apimachineryresource "k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestEvaluate(t *testing.T) {
+	thresholds := []int32{80, 95}
+	tests := []struct {
+		name          string
+		percent       float64
+		previous      int32
+		wantThreshold int32
+		wantNotify    bool
+	}{
+		{"below thresholds", 50, 0, 0, false},
+		{"crosses threshold", 80, 0, 80, true},
+		{"crosses higher threshold", 96, 80, 95, true},
+		{"stays above threshold", 85, 80, 80, false},
+		{"fluctuates below threshold", 78, 80, 80, false},
+		{"crosses threshold again within hysteresis", 81, 80, 80, false},
+		{"falls below hysteresis", 74, 80, 0, false},
+		{"fluctuates below higher threshold", 92, 95, 95, false},
+		{"falls below higher hysteresis", 89, 95, 80, false},
+		{"crosses threshold after reset", 80, 0, 80, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			threshold, notify := evaluate(tt.percent, thresholds, tt.previous)
+			if threshold != tt.wantThreshold || notify != tt.wantNotify {
+				t.Errorf("evaluate(%v, %v) = %v, %v, want %v, %v", tt.percent, tt.previous, threshold, notify, tt.wantThreshold, tt.wantNotify)
+			}
+		})
+	}
+}
+
+func TestNewQuotaUsage(t *testing.T) {
+	usage := newQuotaUsage("cls", "", "requests.cpu", apimachineryresource.MustParse("1500m"), apimachineryresource.MustParse("2"))
+	if usage.percent != 75 || !usage.allocated() {
+		t.Errorf("project usage = %v%%, allocated %v, want 75%%, allocated", usage.percent, usage.allocated())
+	}
+
+	usage = newQuotaUsage("cls", "ns", "pods", apimachineryresource.MustParse("3"), apimachineryresource.Quantity{})
+	if usage.percent != 0 || usage.allocated() {
+		t.Errorf("namespace usage without hard = %v%%, allocated %v, want 0%%, used", usage.percent, usage.allocated())
+	}
+}
@@ -102,6 +102,10 @@ func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 		emigration.ObjectMeta.GenerateName = "nse-"
 	}
 
+	if emigration.Spec.Mode == business.NsEmigrationModeCluster && emigration.Spec.Destination == "" {
+		emigration.Spec.Destination = emigration.Namespace
+	}
+
 	emigration.Status = business.NsEmigrationStatus{}
 }
 
@@ -113,7 +117,7 @@ func (s *Strategy) AfterCreate(obj runtime.Object) error {
 
 // Validate validates a new emigration.
 func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	return ValidateNsEmigrationCreate(ctx, obj.(*business.NsEmigration), s.businessClient)
+	return ValidateNsEmigrationCreate(ctx, obj.(*business.NsEmigration), s.businessClient, s.platformClient)
 }
 
 // AllowCreateOnUpdate is false for emigrations.
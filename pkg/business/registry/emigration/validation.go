@@ -28,7 +28,9 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/api/business"
 	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	"tkestack.io/tke/pkg/business/registry/namespace"
+	"tkestack.io/tke/pkg/platform/util/validation"
 )
 
 // _validateNsEmigrationName is a ValidateNameFunc for names that must be a DNS
@@ -36,7 +38,8 @@ import (
 var _validateNsEmigrationName = apimachineryvalidation.NameIsDNSLabel
 
 // ValidateNsEmigrationCreate tests if required fields in the NsEmigration are set correctly.
-func ValidateNsEmigrationCreate(ctx context.Context, emigration *business.NsEmigration, businessClient *businessinternalclient.BusinessClient) field.ErrorList {
+func ValidateNsEmigrationCreate(ctx context.Context, emigration *business.NsEmigration, businessClient *businessinternalclient.BusinessClient,
+	platformClient platformversionedclient.PlatformV1Interface) field.ErrorList {
 	allErrs := validateNsEmigration(emigration, businessClient)
 
 	fldNamespace := field.NewPath("spec", "namespace")
@@ -53,6 +56,18 @@ func ValidateNsEmigrationCreate(ctx context.Context, emigration *business.NsEmig
 				fmt.Sprintf("in phase %s, can NOT emigrate", ns.Status.Phase)))
 	}
 
+	switch emigration.Spec.Mode {
+	case "", business.NsEmigrationModeProject:
+	case business.NsEmigrationModeCluster:
+		if err != nil {
+			return allErrs
+		}
+		return append(allErrs, validateClusterEmigration(ctx, emigration, ns, businessClient, platformClient)...)
+	default:
+		return append(allErrs, field.NotSupported(field.NewPath("spec", "mode"), emigration.Spec.Mode,
+			[]string{string(business.NsEmigrationModeProject), string(business.NsEmigrationModeCluster)}))
+	}
+
 	fldDestPrj := field.NewPath("spec", "destination")
 	if emigration.Spec.Destination == "" {
 		allErrs = append(allErrs, field.Invalid(fldDestPrj, emigration.Spec.Destination, "empty project name"))
@@ -66,7 +81,7 @@ func ValidateNsEmigrationCreate(ctx context.Context, emigration *business.NsEmig
 	} else {
 		fldProject := field.NewPath(fmt.Sprintf("destination(%s)", emigration.Spec.Destination))
 		fldHard := field.NewPath(fmt.Sprintf("namespace(%s)", emigration.Spec.Namespace), "spec", "hard")
-		allErrs = append(allErrs, namespace.ValidateAgainstProject(ns, nil, project, fldProject, fldHard)...)
+		allErrs = append(allErrs, namespace.ValidateAgainstProject(ctx, ns, nil, project, validation.NewObjectGetter(businessClient), fldProject, fldHard)...)
 	}
 	_, err = businessClient.Namespaces(emigration.Spec.Destination).Get(ctx, emigration.Spec.Namespace, v1.GetOptions{})
 	if err == nil {
@@ -80,6 +95,69 @@ func ValidateNsEmigrationCreate(ctx context.Context, emigration *business.NsEmig
 	return allErrs
 }
 
+// validateClusterEmigration tests if the namespace can be moved to the
+// destination cluster in the same project.
+func validateClusterEmigration(ctx context.Context, emigration *business.NsEmigration, ns *business.Namespace,
+	businessClient *businessinternalclient.BusinessClient, platformClient platformversionedclient.PlatformV1Interface) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	fldDestPrj := field.NewPath("spec", "destination")
+	if emigration.Spec.Destination != emigration.Namespace {
+		allErrs = append(allErrs, field.Invalid(fldDestPrj, emigration.Spec.Destination, "must be the current project"))
+	}
+
+	// The source namespace keeps its quota without cut-over, so the quota
+	// transferred would never be released.
+	if emigration.Spec.TransferQuota && !emigration.Spec.CutOver {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "transferQuota"), emigration.Spec.TransferQuota, "requires cut-over"))
+	}
+
+	fldDestCls := field.NewPath("spec", "destinationCluster")
+	if emigration.Spec.DestinationCluster == "" {
+		return append(allErrs, field.Required(fldDestCls, "must specify the destination cluster"))
+	}
+	if emigration.Spec.DestinationCluster == ns.Spec.ClusterName {
+		return append(allErrs, field.Invalid(fldDestCls, emigration.Spec.DestinationCluster, "is still the current cluster"))
+	}
+	if errs := validation.ValidateClusterVersioned(ctx, validation.NewClusterGetter(platformClient),
+		emigration.Spec.DestinationCluster, ns.Spec.TenantID); len(errs) != 0 {
+		return append(allErrs, errs...)
+	}
+
+	newNS := &business.Namespace{}
+	newNS.Name = fmt.Sprintf("%s-%s", emigration.Spec.DestinationCluster, ns.Spec.Namespace)
+	newNS.Namespace = emigration.Namespace
+	newNS.Spec = business.NamespaceSpec{
+		ClusterName: emigration.Spec.DestinationCluster,
+		Namespace:   ns.Spec.Namespace,
+		TenantID:    ns.Spec.TenantID,
+		Hard:        ns.Spec.Hard,
+	}
+	_, err := businessClient.Namespaces(emigration.Namespace).Get(ctx, newNS.Name, v1.GetOptions{})
+	if err == nil {
+		allErrs = append(allErrs, field.Invalid(fldDestCls, emigration.Spec.DestinationCluster,
+			fmt.Sprintf("already has a namespace with the name %s", ns.Spec.Namespace)))
+	} else if !errors.IsNotFound(err) {
+		allErrs = append(allErrs, field.Invalid(fldDestCls, emigration.Spec.DestinationCluster,
+			fmt.Sprintf("failed to check whether there is a namespace with the name %s, %s", ns.Spec.Namespace, err)))
+	}
+
+	// The quota moves along with the namespace when it is transferred,
+	// otherwise the destination cluster of the project must have room for it.
+	if !emigration.Spec.TransferQuota {
+		project, err := businessClient.Projects().Get(ctx, emigration.Namespace, v1.GetOptions{})
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldDestPrj, err))
+		} else {
+			fldProject := field.NewPath(fmt.Sprintf("project(%s)", emigration.Namespace))
+			fldHard := field.NewPath(fmt.Sprintf("namespace(%s)", emigration.Spec.Namespace), "spec", "hard")
+			allErrs = append(allErrs, namespace.ValidateAgainstProject(ctx, newNS, nil, project, validation.NewObjectGetter(businessClient), fldProject, fldHard)...)
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateNsEmigrationUpdate tests if required fields in the NsEmigration are set during
 // an update.
 func ValidateNsEmigrationUpdate(ctx context.Context, emigration, old *business.NsEmigration, businessClient *businessinternalclient.BusinessClient) field.ErrorList {
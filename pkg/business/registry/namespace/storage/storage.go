@@ -20,13 +20,7 @@ package storage
 
 import (
 	"context"
-	"crypto/rand"
-	"crypto/rsa"
-	"crypto/x509"
-	"crypto/x509/pkix"
-	"encoding/pem"
 	"fmt"
-	"math/big"
 	"reflect"
 	"strconv"
 	"time"
@@ -34,7 +28,6 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/generic/registry"
@@ -53,7 +46,6 @@ import (
 	"tkestack.io/tke/pkg/util/log"
 )
 
-const _rsaKeyBits = 2048
 const _defaultCertValidDays = 365
 
 // Storage includes storage for namespace and all sub resources.
@@ -72,6 +64,7 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter, businessClient *bu
 		NewListFunc:              func() runtime.Object { return &business.NamespaceList{} },
 		DefaultQualifiedResource: business.Resource("namespaces"),
 		PredicateFunc:            namespace.MatchNamespace,
+		Decorator:                namespace.Decorator,
 
 		CreateStrategy: strategy,
 		UpdateStrategy: strategy,
@@ -96,6 +89,7 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter, businessClient *bu
 	finalizeStore.UpdateStrategy = namespace.NewFinalizeStrategy(strategy)
 
 	certificateStore := *store
+	certificateStore.UpdateStrategy = namespace.NewStatusStrategy(strategy)
 
 	return &Storage{
 		Namespace:   newREST(store, platformClient, privilegedUsername),
@@ -394,127 +388,149 @@ func (r *FinalizeREST) Update(ctx context.Context, name string, objInfo rest.Upd
 	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
 }
 
-// CertificateREST implements the REST endpoint for getting a x509 certificate for namespaces.
+// CertificateREST implements the REST endpoint for issuing a x509 certificate
+// for namespaces and getting the certificate renewed.
 type CertificateREST struct {
 	store              *registry.Store
 	platformClient     platformversionedclient.PlatformV1Interface
 	privilegedUsername string
 }
 
-// New returns an empty object that can be used with Create and Update after
-// request data has been put into it.
+var _ = rest.NamedCreater(&CertificateREST{})
+var _ = rest.StorageMetadata(&CertificateREST{})
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
 func (r *CertificateREST) New() runtime.Object {
+	return &business.NamespaceCertOptions{}
+}
+
+// ProducesMIMETypes returns a list of the MIME types the specified HTTP verb
+// (GET, POST, DELETE, PATCH) can respond with.
+func (r *CertificateREST) ProducesMIMETypes(verb string) []string {
+	return nil
+}
+
+// ProducesObject returns an object the specified HTTP verb respond with,
+// which is the namespace with the certificate.
+func (r *CertificateREST) ProducesObject(verb string) interface{} {
 	return r.store.New()
 }
 
 func (r *CertificateREST) NewGetOptions() (runtime.Object, bool, string) {
-	return &business.NamespaceCertOptions{ValidDays: strconv.Itoa(_defaultCertValidDays)}, false, ""
+	return &business.NamespaceCertOptions{}, false, ""
 }
 
-// Get retrieves the namespace from the storage and patch a x509 certificate.
+// Get retrieves the namespace from the storage and patch the latest renewal
+// of the certificate of the user, without the private key which is only
+// returned when the certificate is issued.
 func (r *CertificateREST) Get(ctx context.Context, name string, options runtime.Object) (runtime.Object, error) {
 	obj, err := newREST(r.store, r.platformClient, r.privilegedUsername).Get(ctx, name, &metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
 	ns := obj.(*business.Namespace)
+	user, _ := authentication.UsernameAndTenantID(ctx)
 
-	cluster, err := r.platformClient.Clusters().Get(ctx, ns.Spec.ClusterName, metav1.GetOptions{})
-	if err != nil {
-		return nil, fmt.Errorf("prj:%s, ns:%s, get cluster %s, %s", ns.Namespace, ns.Name, ns.Spec.ClusterName, err)
-	}
-	if cluster.Spec.Type == "Imported" {
-		return nil, fmt.Errorf("prj:%s, ns:%s, cluster %s is Imported, NOT support generating certificate", ns.Namespace, ns.Name, ns.Spec.ClusterName)
-	}
-	if len(cluster.Status.Addresses) == 0 {
-		return nil, fmt.Errorf("prj:%s, ns:%s, cluster %s has NO valid addresses", ns.Namespace, ns.Name, ns.Spec.ClusterName)
-	}
-	fieldSelector := fields.OneTermEqualSelector("clusterName", ns.Spec.ClusterName).String()
-	list, err := r.platformClient.ClusterCredentials().List(ctx, metav1.ListOptions{FieldSelector: fieldSelector})
-	if err != nil {
-		return nil, fmt.Errorf("prj:%s, ns:%s, get cluster credential, %s", ns.Namespace, ns.Name, err)
-	} else if len(list.Items) == 0 {
-		return nil, fmt.Errorf("prj:%s, ns:%s, no cluster credential", ns.Namespace, ns.Name)
-	}
-	credential := list.Items[0]
-	certBlock, _ := pem.Decode(credential.CACert)
-	if certBlock == nil {
-		return nil, fmt.Errorf("prj:%s, ns:%s, pem decode root cert error, bytes:%v", ns.Namespace, ns.Name, credential.CACert)
-	}
-	if certBlock.Type != "CERTIFICATE" {
-		return nil, fmt.Errorf("prj:%s, ns:%s, pem decode root cert, invalid type %s", ns.Namespace, ns.Name, certBlock.Type)
-	}
-	rootCert, err := x509.ParseCertificate(certBlock.Bytes)
-	if err != nil {
-		return nil, fmt.Errorf("prj:%s, ns:%s, parse root cert, %s, bytes:%v", ns.Namespace, ns.Name, err, certBlock.Bytes)
+	for _, cert := range ns.Status.Certificates {
+		if cert.Username != user || cert.Phase != business.NamespaceCertActive || !time.Now().Before(cert.NotAfter.Time) {
+			continue
+		}
+		caCertPem, apiServer, err := util.NamespaceCertificateEndpoint(ctx, r.platformClient, certificateSubject(ns, user))
+		if err != nil {
+			return nil, err
+		}
+		ns.Status.Certificate = &business.NamespaceCert{
+			CertPem:      cert.CertPem,
+			CACertPem:    caCertPem,
+			APIServer:    apiServer,
+			SerialNumber: cert.SerialNumber,
+			NotAfter:     cert.NotAfter,
+		}
+		return ns, nil
 	}
-	keyBlock, _ := pem.Decode(credential.CAKey)
-	if keyBlock == nil {
-		return nil, fmt.Errorf("prj:%s, ns:%s, pem decode root key error, bytes:%v", ns.Namespace, ns.Name, credential.CAKey)
+	return nil, errors.NewNotFound(business.Resource("namespaces/certificate"), name)
+}
+
+// Create issues a x509 certificate with a new key for the user, which is
+// recorded in the status of the namespace without the key and renewed until
+// the valid days after. The certificate issued for the user before is kept
+// valid until it expires.
+func (r *CertificateREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj); err != nil {
+			return nil, err
+		}
 	}
-	if keyBlock.Type != "RSA PRIVATE KEY" {
-		return nil, fmt.Errorf("prj:%s, ns:%s, pem decode root key, invalid type %s", ns.Namespace, ns.Name, keyBlock.Type)
+	if dryrun.IsDryRun(options.DryRun) {
+		return nil, errors.NewBadRequest("dry run is not supported to issue a certificate")
 	}
-	rootKey, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
-	if err != nil {
-		return nil, fmt.Errorf("prj:%s, ns:%s, parse root key, %s, bytes:%v", ns.Namespace, ns.Name, err, keyBlock.Bytes)
+	validDays := _defaultCertValidDays
+	if certOptions := obj.(*business.NamespaceCertOptions); certOptions.ValidDays != "" {
+		days, err := strconv.Atoi(certOptions.ValidDays)
+		if err != nil || days <= 0 {
+			return nil, errors.NewBadRequest(fmt.Sprintf("invalid %s '%s'", business.CertOptionValidDays, certOptions.ValidDays))
+		}
+		validDays = days
 	}
 
-	private, err := rsa.GenerateKey(rand.Reader, _rsaKeyBits)
-	if err != nil {
-		return nil, fmt.Errorf("prj:%s, ns:%s, generate prive key, %s", ns.Namespace, ns.Name, err)
-	}
-	validDays, err := strconv.Atoi(options.(*business.NamespaceCertOptions).ValidDays)
+	existing, err := newREST(r.store, r.platformClient, r.privilegedUsername).Get(ctx, name, &metav1.GetOptions{})
 	if err != nil {
-		return nil, fmt.Errorf("prj:%s, ns:%s, query string '%s', %s", ns.Namespace, ns.Name, business.CertOptionValidDays, err)
+		return nil, err
 	}
 	user, _ := authentication.UsernameAndTenantID(ctx)
-	template := x509.Certificate{
-		Subject: pkix.Name{
-			CommonName: user,
-			Organization: []string{
-				fmt.Sprintf("cluster:%s", ns.Spec.ClusterName),
-				fmt.Sprintf("project:%s", ns.Namespace),
-				fmt.Sprintf("namespace:%s", ns.Spec.Namespace),
-				fmt.Sprintf("tenant:%s", ns.Spec.TenantID),
-			},
-		},
-		SerialNumber:          big.NewInt(rootCert.SerialNumber.Int64() + 1),
-		NotBefore:             rootCert.NotBefore,
-		NotAfter:              time.Now().AddDate(0, 0, validDays),
-		BasicConstraintsValid: true,
-		IsCA:                  false,
-		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageDataEncipherment,
-	}
-	certBytes, err := x509.CreateCertificate(rand.Reader, &template, rootCert, &private.PublicKey, rootKey)
+	renewUntil := time.Now().AddDate(0, 0, validDays)
+	issued, err := util.IssueNamespaceCertificate(ctx, r.platformClient, certificateSubject(existing.(*business.Namespace), user), renewUntil)
 	if err != nil {
-		return nil, fmt.Errorf("CreateCertificate(%+v), %s", template.Subject, err)
+		return nil, err
 	}
-	keyBytes := x509.MarshalPKCS1PrivateKey(private)
 
-	address := cluster.Status.Addresses[0]
-	for _, one := range cluster.Status.Addresses {
-		if one.Type == "Advertise" {
-			address = one
-			break
+	updated, _, err := r.store.Update(ctx, name, rest.DefaultUpdatedObjectInfo(nil, func(ctx context.Context, newObj, oldObj runtime.Object) (runtime.Object, error) {
+		ns := newObj.(*business.Namespace)
+		for i := range ns.Status.Certificates {
+			if ns.Status.Certificates[i].Username == user && ns.Status.Certificates[i].Phase == business.NamespaceCertActive {
+				ns.Status.Certificates[i].Phase = business.NamespaceCertSuperseded
+			}
 		}
+		ns.Status.Certificates = append(ns.Status.Certificates, business.NamespaceCertStatus{
+			Username:     user,
+			SerialNumber: issued.SerialNumber,
+			ValidDays:    int32(validDays),
+			NotBefore:    metav1.NewTime(issued.NotBefore),
+			NotAfter:     metav1.NewTime(issued.NotAfter),
+			RenewUntil:   metav1.NewTime(renewUntil),
+			Phase:        business.NamespaceCertActive,
+			CertPem:      issued.CertPem,
+		})
+		return ns, nil
+	}), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
 	}
+
+	ns := updated.(*business.Namespace)
 	ns.Status.Certificate = &business.NamespaceCert{
-		CertPem: pem.EncodeToMemory(&pem.Block{
-			Type:  "CERTIFICATE",
-			Bytes: certBytes,
-		}),
-		KeyPem: pem.EncodeToMemory(&pem.Block{
-			Type:  "PRIVATE KEY",
-			Bytes: keyBytes,
-		}),
-		CACertPem: credential.CACert,
-		APIServer: fmt.Sprintf("https://%s:%d", address.Host, address.Port),
+		CertPem:      issued.CertPem,
+		KeyPem:       issued.KeyPem,
+		CACertPem:    issued.CACertPem,
+		APIServer:    issued.APIServer,
+		SerialNumber: issued.SerialNumber,
+		NotAfter:     metav1.NewTime(issued.NotAfter),
 	}
 	return ns, nil
 }
 
+// certificateSubject returns whom the certificate of the namespace is issued for.
+func certificateSubject(ns *business.Namespace, user string) util.NamespaceCertificateSubject {
+	return util.NamespaceCertificateSubject{
+		Username:    user,
+		TenantID:    ns.Spec.TenantID,
+		ProjectName: ns.Namespace,
+		ClusterName: ns.Spec.ClusterName,
+		Namespace:   ns.Spec.Namespace,
+	}
+}
+
 // EmigrateREST implements the REST endpoint for moving a namespace.
 type EmigrateREST struct {
 	store *registry.Store
@@ -34,6 +34,7 @@ import (
 	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
 	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	"tkestack.io/tke/pkg/apiserver/authentication"
+	businessutil "tkestack.io/tke/pkg/business/util"
 	"tkestack.io/tke/pkg/platform/util/validation"
 	"tkestack.io/tke/pkg/util/log"
 	namesutil "tkestack.io/tke/pkg/util/names"
@@ -64,6 +65,7 @@ func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.Garbage
 func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	oldNamespace := old.(*business.Namespace)
 	namespace, _ := obj.(*business.Namespace)
+	businessutil.NormalizeNamespaceQuota(oldNamespace)
 	_, tenantID := authentication.UsernameAndTenantID(ctx)
 	if len(tenantID) != 0 {
 		if oldNamespace.Spec.TenantID != tenantID {
@@ -76,6 +78,8 @@ func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	} else { // For historic data that has no CachedSpecHard
 		namespace.Status.CachedSpecHard = oldNamespace.Spec.Hard
 	}
+	businessutil.NormalizeNamespaceQuota(namespace)
+	namespace.Status.Certificates = oldNamespace.Status.Certificates
 }
 
 // NamespaceScoped is false for namespaces.
@@ -108,6 +112,7 @@ func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 	namespace.Spec.Finalizers = []business.FinalizerName{
 		business.NamespaceFinalize,
 	}
+	businessutil.NormalizeNamespaceQuota(namespace)
 }
 
 // AfterCreate implements a further operation to run after a resource is
@@ -154,6 +159,21 @@ func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) [
 	return nil
 }
 
+// Decorator normalizes the quota of the namespaces returned from the
+// underlying storage, which may be stored before the extended resources were
+// tracked by requests.
+func Decorator(obj runtime.Object) {
+	if namespace, ok := obj.(*business.Namespace); ok {
+		businessutil.NormalizeNamespaceQuota(namespace)
+	}
+
+	if namespaceList, ok := obj.(*business.NamespaceList); ok {
+		for i := range namespaceList.Items {
+			businessutil.NormalizeNamespaceQuota(&namespaceList.Items[i])
+		}
+	}
+}
+
 // GetAttrs returns labels and fields of a given object for filtering purposes.
 func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
 	namespace, ok := obj.(*business.Namespace)
@@ -206,7 +226,9 @@ func NewStatusStrategy(strategy *Strategy) *StatusStrategy {
 func (StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	newNamespace := obj.(*business.Namespace)
 	oldNamespace := old.(*business.Namespace)
+	businessutil.NormalizeNamespaceQuota(oldNamespace)
 	newNamespace.Spec = oldNamespace.Spec
+	businessutil.NormalizeNamespaceQuota(newNamespace)
 }
 
 // ValidateUpdate is invoked after default fields in the object have been
@@ -236,7 +258,9 @@ func NewFinalizeStrategy(strategy *Strategy) *FinalizeStrategy {
 func (FinalizeStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	newNamespace := obj.(*business.Namespace)
 	oldNamespace := old.(*business.Namespace)
+	businessutil.NormalizeNamespaceQuota(oldNamespace)
 	newNamespace.Status = oldNamespace.Status
+	businessutil.NormalizeNamespaceQuota(newNamespace)
 }
 
 // ValidateUpdate is invoked after default fields in the object have been
@@ -26,9 +26,9 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/api/business"
+	businessutil "tkestack.io/tke/pkg/business/util"
 	"tkestack.io/tke/pkg/platform/util/validation"
 	"tkestack.io/tke/pkg/util/resource"
 )
@@ -50,7 +50,8 @@ var (
 var ValidateNamespaceName = apimachineryvalidation.NameIsDNSLabel
 
 // ValidateAgainstProject validates namespace against project.
-func ValidateAgainstProject(namespace, old *business.Namespace, project *business.Project, fldProject, fldHard *field.Path) field.ErrorList {
+func ValidateAgainstProject(ctx context.Context, namespace, old *business.Namespace, project *business.Project,
+	objectGetter validation.BusinessObjectGetter, fldProject, fldHard *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if project.Spec.TenantID != namespace.Spec.TenantID {
 		return append(allErrs, field.NotFound(fldProject, namespace.ObjectMeta.Namespace))
@@ -65,21 +66,19 @@ func ValidateAgainstProject(namespace, old *business.Namespace, project *busines
 				namespace.ObjectMeta.Namespace,
 				fmt.Sprintf("%s %s", _clusterLimitErrorInfo, namespace.Spec.ClusterName)))
 	} else {
-		clusterUsed, clusterUsedExist := project.Status.Clusters[namespace.Spec.ClusterName]
-		if !clusterUsedExist {
-			clusterUsed = business.UsedQuantity{
-				Used: make(business.ResourceList),
-			}
-		}
+		allErrs = append(allErrs,
+			resource.ValidateAllocatableResourceNames(namespace.Spec.Hard, clusterHard.Hard, fldHard)...)
 
-		var oldSpecHard business.ResourceList
-		childNamespaceNameSet := sets.NewString(project.Status.CalculatedNamespaces...)
-		if childNamespaceNameSet.Has(namespace.ObjectMeta.Name) && old != nil {
-			oldSpecHard = old.Spec.Hard
+		// The namespaces and child projects never allocate more than the
+		// quota of the project, or of the ancestor the project borrows from.
+		child := businessutil.QuotaChild{
+			Namespace: namespace.ObjectMeta.Name,
+			Want:      business.ClusterHard{namespace.Spec.ClusterName: {Hard: namespace.Spec.Hard}},
 		}
-		allErrs = append(allErrs,
-			resource.ValidateAllocatableResources(namespace.Spec.Hard, oldSpecHard,
-				clusterHard.Hard, clusterUsed.Used, fldHard)...)
+		if old != nil {
+			child.Allocated = business.ClusterHard{old.Spec.ClusterName: {Hard: old.Spec.Hard}}
+		}
+		allErrs = append(allErrs, businessutil.ValidateAllocation(ctx, project, child, objectGetter, fldHard)...)
 	}
 	return allErrs
 }
@@ -116,7 +115,7 @@ func ValidateNamespace(ctx context.Context, namespace *business.Namespace, old *
 		return append(allErrs, field.InternalError(fldProject, err))
 	}
 
-	allErrs = append(allErrs, ValidateAgainstProject(namespace, old, project, fldProject, fldHardPath)...)
+	allErrs = append(allErrs, ValidateAgainstProject(ctx, namespace, old, project, objectGetter, fldProject, fldHardPath)...)
 
 	fldNamespacePath := fldSpecPath.Child("namespace")
 	if namespace.Spec.Namespace == "" {
@@ -26,6 +26,7 @@ import (
 	apimachinerymetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"tkestack.io/tke/api/business"
 	platformv1 "tkestack.io/tke/api/platform/v1"
+	businessutil "tkestack.io/tke/pkg/business/util"
 	"tkestack.io/tke/pkg/platform/util/validation"
 	"tkestack.io/tke/pkg/util/resource"
 )
@@ -244,6 +245,79 @@ func TestUpdateAllocatable(t *testing.T) {
 	}
 }
 
+func TestQuotaResourceNames(t *testing.T) {
+	quota, _ := apimachineryresource.ParseQuantity("1")
+	invalid := []string{
+		"limits.nvidia.com/gpu",
+		"gold.storageclass.storage.k8s.io/limits.storage",
+	}
+	for _, name := range invalid {
+		_testNamespace.Spec.Hard = business.ResourceList{name: quota}
+		errors := ValidateNamespaceUpdate(context.Background(), &_testNamespace, &_oldNamespace, newObjectGetter(), newClusterGetter())
+		if len(errors) == 0 {
+			t.Errorf("Expect an error of resource %s", name)
+		}
+	}
+
+	hard := business.ResourceList{
+		"nvidia.com/gpu":                                    quota,
+		"tencent.com/vcuda-core":                            quota,
+		"requests.tencent.com/vcuda-memory":                 quota,
+		"count/deployments.apps":                            quota,
+		"gold.storageclass.storage.k8s.io/requests.storage": quota,
+	}
+	resource.NormalizeQuotaResourceNames(hard)
+	for _, name := range []string{
+		"requests.nvidia.com/gpu",
+		"requests.tencent.com/vcuda-core",
+		"requests.tencent.com/vcuda-memory",
+		"count/deployments.apps",
+		"gold.storageclass.storage.k8s.io/requests.storage",
+	} {
+		if _, ok := hard[name]; !ok {
+			t.Errorf("Expect resource %s in %v", name, hard)
+		}
+	}
+	if len(hard) != 5 {
+		t.Errorf("Unexpected: %v", hard)
+	}
+}
+
+func TestLegacyQuotaResourceNames(t *testing.T) {
+	/* the project and the old namespace are stored before the extended
+	   resources were tracked by requests */
+	quota, _ := apimachineryresource.ParseQuantity("2")
+	_testProject.Spec.Clusters = business.ClusterHard{
+		ClusterName: {Hard: business.ResourceList{"nvidia.com/gpu": quota}},
+	}
+	quota, _ = apimachineryresource.ParseQuantity("1")
+	_testProject.Status.Clusters = business.ClusterUsed{
+		ClusterName: {Used: business.ResourceList{"nvidia.com/gpu": quota}},
+	}
+	_testProject.Status.CalculatedNamespaces = []string{_testNamespace.Name}
+	businessutil.NormalizeProjectQuota(&_testProject)
+
+	old := _oldNamespace.DeepCopy()
+	old.Spec.Hard = business.ResourceList{"nvidia.com/gpu": quota}
+	list := &business.NamespaceList{Items: []business.Namespace{*old}}
+	Decorator(list)
+	if _, ok := list.Items[0].Spec.Hard["requests.nvidia.com/gpu"]; !ok {
+		t.Errorf("Expect the quota read to be normalized: %v", list.Items[0].Spec.Hard)
+	}
+
+	namespace := _testNamespace.DeepCopy()
+	quota, _ = apimachineryresource.ParseQuantity("2")
+	namespace.Spec.Hard = business.ResourceList{"nvidia.com/gpu": quota}
+	Strategy{}.PrepareForUpdate(context.Background(), namespace, old)
+	if _, ok := old.Spec.Hard["requests.nvidia.com/gpu"]; !ok {
+		t.Errorf("Expect the old quota to be normalized: %v", old.Spec.Hard)
+	}
+	errors := ValidateNamespaceUpdate(context.Background(), namespace, old, newObjectGetter(), newClusterGetter())
+	for _, err := range errors {
+		t.Errorf("Unexpected: %s", err.Error())
+	}
+}
+
 func newObjectGetter() validation.BusinessObjectGetter {
 	return testObjectGetter{}
 }
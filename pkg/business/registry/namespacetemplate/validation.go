@@ -0,0 +1,164 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespacetemplate
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/business"
+	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
+	"tkestack.io/tke/pkg/business/util"
+)
+
+// _validateNamespaceTemplateName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var _validateNamespaceTemplateName = apimachineryvalidation.NameIsDNSLabel
+
+var (
+	_limitTypes = sets.NewString(string(corev1.LimitTypePod), string(corev1.LimitTypeContainer),
+		string(corev1.LimitTypePersistentVolumeClaim))
+	_subjectKinds      = sets.NewString("User", "Group", "ServiceAccount")
+	_podSecurityLevels = sets.NewString("privileged", "baseline", "restricted")
+)
+
+// IsAdministratorFunc tells whether the user of the request is a platform
+// administrator.
+type IsAdministratorFunc func(ctx context.Context) (bool, error)
+
+// ValidateNamespaceTemplateCreate tests if required fields in the NamespaceTemplate are set correctly.
+func ValidateNamespaceTemplateCreate(ctx context.Context, template *business.NamespaceTemplate, businessClient *businessinternalclient.BusinessClient, isAdministrator IsAdministratorFunc) field.ErrorList {
+	allErrs := validateNamespaceTemplate(template)
+	allErrs = append(allErrs, validatePodSecurityPrivileges(ctx, template, nil, isAdministrator)...)
+
+	fldProject := field.NewPath("metadata", "namespace")
+	project, err := businessClient.Projects().Get(ctx, template.Namespace, metav1.GetOptions{})
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldProject, template.Namespace, fmt.Sprintf("failed to get this project, %s", err)))
+	} else if project.Spec.TenantID != template.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(fldProject, template.Namespace, "the project does not belong to the tenant"))
+	}
+
+	return allErrs
+}
+
+// ValidateNamespaceTemplateUpdate tests if required fields in the NamespaceTemplate are set during
+// an update.
+func ValidateNamespaceTemplateUpdate(ctx context.Context, template, old *business.NamespaceTemplate, businessClient *businessinternalclient.BusinessClient, isAdministrator IsAdministratorFunc) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&template.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, validateNamespaceTemplate(template)...)
+	allErrs = append(allErrs, validatePodSecurityPrivileges(ctx, template, old, isAdministrator)...)
+
+	if template.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs,
+			field.Invalid(field.NewPath("spec", "tenantID"),
+				template.Spec.TenantID, "disallowed change the tenant"))
+	}
+
+	return allErrs
+}
+
+// validateNamespaceTemplate tests if required fields in the NamespaceTemplate are set.
+func validateNamespaceTemplate(template *business.NamespaceTemplate) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&template.ObjectMeta,
+		true, _validateNamespaceTemplateName, field.NewPath("metadata"))
+
+	fldSpec := field.NewPath("spec")
+	allErrs = append(allErrs, metav1validation.ValidateLabels(template.Spec.Labels, fldSpec.Child("labels"))...)
+	for k := range template.Spec.Labels {
+		if util.IsPodSecurityLabel(k) {
+			allErrs = append(allErrs, field.Forbidden(fldSpec.Child("labels").Key(k), "the pod security must be set by spec.podSecurity"))
+		}
+	}
+	allErrs = append(allErrs, apimachineryvalidation.ValidateAnnotations(template.Spec.Annotations, fldSpec.Child("annotations"))...)
+
+	for i, limit := range template.Spec.LimitRanges {
+		if !_limitTypes.Has(string(limit.Type)) {
+			allErrs = append(allErrs, field.NotSupported(fldSpec.Child("limitRanges").Index(i).Child("type"), limit.Type, _limitTypes.List()))
+		}
+	}
+
+	fldRoleBindings := fldSpec.Child("roleBindings")
+	roleBindingNames := sets.NewString()
+	for i, roleBinding := range template.Spec.RoleBindings {
+		fldRoleBinding := fldRoleBindings.Index(i)
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(roleBinding.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldRoleBinding.Child("name"), roleBinding.Name, msg))
+		}
+		if roleBindingNames.Has(roleBinding.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldRoleBinding.Child("name"), roleBinding.Name))
+		}
+		roleBindingNames.Insert(roleBinding.Name)
+		if roleBinding.ClusterRole == "" {
+			allErrs = append(allErrs, field.Required(fldRoleBinding.Child("clusterRole"), "must specify a cluster role"))
+		} else if !util.NamespaceTemplateClusterRoles.Has(roleBinding.ClusterRole) {
+			allErrs = append(allErrs, field.NotSupported(fldRoleBinding.Child("clusterRole"), roleBinding.ClusterRole, util.NamespaceTemplateClusterRoles.List()))
+		}
+		for j, subject := range roleBinding.Subjects {
+			fldSubject := fldRoleBinding.Child("subjects").Index(j)
+			if !_subjectKinds.Has(subject.Kind) {
+				allErrs = append(allErrs, field.NotSupported(fldSubject.Child("kind"), subject.Kind, _subjectKinds.List()))
+			}
+			if subject.Name == "" {
+				allErrs = append(allErrs, field.Required(fldSubject.Child("name"), "must specify a subject name"))
+			}
+			if subject.Namespace != "" {
+				allErrs = append(allErrs, field.Forbidden(fldSubject.Child("namespace"), "service accounts are bound in the namespace of the role binding"))
+			}
+		}
+	}
+
+	if podSecurity := template.Spec.PodSecurity; podSecurity != nil {
+		fldPodSecurity := fldSpec.Child("podSecurity")
+		for mode, level := range map[string]string{"enforce": podSecurity.Enforce, "audit": podSecurity.Audit, "warn": podSecurity.Warn} {
+			if level != "" && !_podSecurityLevels.Has(level) {
+				allErrs = append(allErrs, field.NotSupported(fldPodSecurity.Child(mode), level, _podSecurityLevels.List()))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validatePodSecurityPrivileges tests if the user is allowed to set the
+// privileged pod security, which disables the Pod Security admission.
+func validatePodSecurityPrivileges(ctx context.Context, template, old *business.NamespaceTemplate, isAdministrator IsAdministratorFunc) field.ErrorList {
+	if !privilegedPodSecurity(template) || (old != nil && privilegedPodSecurity(old)) {
+		return nil
+	}
+	fldEnforce := field.NewPath("spec", "podSecurity", "enforce")
+	isAdmin, err := isAdministrator(ctx)
+	if err != nil {
+		return field.ErrorList{field.InternalError(fldEnforce, err)}
+	}
+	if !isAdmin {
+		return field.ErrorList{field.Forbidden(fldEnforce, "only the platform administrators may set the privileged pod security")}
+	}
+	return nil
+}
+
+func privilegedPodSecurity(template *business.NamespaceTemplate) bool {
+	return template.Spec.PodSecurity != nil && template.Spec.PodSecurity.Enforce == util.PodSecurityLevelPrivileged
+}
@@ -0,0 +1,108 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespacetemplate
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/business"
+)
+
+func newTemplate(spec business.NamespaceTemplateSpec) *business.NamespaceTemplate {
+	return &business.NamespaceTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "nst", Namespace: "prj"},
+		Spec:       spec,
+	}
+}
+
+func TestValidateNamespaceTemplate(t *testing.T) {
+	tests := []struct {
+		name  string
+		spec  business.NamespaceTemplateSpec
+		valid bool
+	}{
+		{
+			name: "allowed cluster role",
+			spec: business.NamespaceTemplateSpec{RoleBindings: []business.NamespaceTemplateRoleBinding{{
+				Name:        "devs",
+				ClusterRole: "edit",
+				Subjects:    []business.NamespaceTemplateSubject{{Kind: "ServiceAccount", Name: "ci"}},
+			}}},
+			valid: true,
+		},
+		{
+			name: "cluster admin",
+			spec: business.NamespaceTemplateSpec{RoleBindings: []business.NamespaceTemplateRoleBinding{{
+				Name:        "admins",
+				ClusterRole: "cluster-admin",
+				Subjects:    []business.NamespaceTemplateSubject{{Kind: "User", Name: "alice"}},
+			}}},
+		},
+		{
+			name: "service account of another namespace",
+			spec: business.NamespaceTemplateSpec{RoleBindings: []business.NamespaceTemplateRoleBinding{{
+				Name:        "controllers",
+				ClusterRole: "view",
+				Subjects:    []business.NamespaceTemplateSubject{{Kind: "ServiceAccount", Name: "default", Namespace: "kube-system"}},
+			}}},
+		},
+		{
+			name: "pod security label",
+			spec: business.NamespaceTemplateSpec{Labels: map[string]string{"pod-security.kubernetes.io/enforce": "privileged"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateNamespaceTemplate(newTemplate(tt.spec))
+			if valid := len(errs) == 0; valid != tt.valid {
+				t.Errorf("validateNamespaceTemplate() = %v, want valid %v", errs, tt.valid)
+			}
+		})
+	}
+}
+
+func TestValidatePodSecurityPrivileges(t *testing.T) {
+	privileged := newTemplate(business.NamespaceTemplateSpec{PodSecurity: &business.NamespaceTemplatePodSecurity{Enforce: "privileged"}})
+	baseline := newTemplate(business.NamespaceTemplateSpec{PodSecurity: &business.NamespaceTemplatePodSecurity{Enforce: "baseline"}})
+	admin := func(context.Context) (bool, error) { return true, nil }
+	user := func(context.Context) (bool, error) { return false, nil }
+
+	tests := []struct {
+		name            string
+		template, old   *business.NamespaceTemplate
+		isAdministrator IsAdministratorFunc
+		valid           bool
+	}{
+		{"user creates baseline", baseline, nil, user, true},
+		{"user creates privileged", privileged, nil, user, false},
+		{"admin creates privileged", privileged, nil, admin, true},
+		{"user raises to privileged", privileged, baseline, user, false},
+		{"user keeps privileged", privileged, privileged, user, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validatePodSecurityPrivileges(context.Background(), tt.template, tt.old, tt.isAdministrator)
+			if valid := len(errs) == 0; valid != tt.valid {
+				t.Errorf("validatePodSecurityPrivileges() = %v, want valid %v", errs, tt.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/business"
+	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
+	"tkestack.io/tke/pkg/business/util"
+	"tkestack.io/tke/pkg/platform/util/validation"
+)
+
+// QuotaTreeREST implements the REST endpoint for getting the quota allocated
+// and used at every level of the tree of a project.
+type QuotaTreeREST struct {
+	store          *registry.Store
+	businessClient *businessinternalclient.BusinessClient
+}
+
+var _ rest.Getter = &QuotaTreeREST{}
+
+// New returns an empty object that can be used with Create after request data
+// has been put into it.
+func (r *QuotaTreeREST) New() runtime.Object {
+	return &business.ProjectQuotaTree{}
+}
+
+// Get retrieves the quota tree of the project.
+func (r *QuotaTreeREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+	if err != nil {
+		return nil, err
+	}
+	project := obj.(*business.Project)
+
+	root, err := util.BuildProjectQuotaNode(ctx, project, validation.NewObjectGetter(r.businessClient))
+	if err != nil {
+		return nil, err
+	}
+	return &business.ProjectQuotaTree{
+		ObjectMeta: metav1.ObjectMeta{Name: project.Name},
+		Root:       root,
+	}, nil
+}
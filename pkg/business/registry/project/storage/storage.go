@@ -45,9 +45,10 @@ import (
 
 // Storage includes storage for projects and all sub resources.
 type Storage struct {
-	Project  *REST
-	Status   *StatusREST
-	Finalize *FinalizeREST
+	Project   *REST
+	Status    *StatusREST
+	Finalize  *FinalizeREST
+	QuotaTree *QuotaTreeREST
 }
 
 // NewStorage returns a Storage object that will work against projects.
@@ -62,6 +63,7 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter,
 		NewListFunc:              func() runtime.Object { return &business.ProjectList{} },
 		DefaultQualifiedResource: business.Resource("projects"),
 		PredicateFunc:            projectstrategy.MatchProject,
+		Decorator:                projectstrategy.Decorator,
 		ReturnDeletedObject:      true,
 
 		CreateStrategy: strategy,
@@ -88,9 +90,10 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter,
 	finalizeStore.UpdateStrategy = projectstrategy.NewFinalizerStrategy(strategy)
 
 	return &Storage{
-		Project:  &REST{store, authClient, businessClient, privilegedUsername},
-		Status:   &StatusREST{&statusStore},
-		Finalize: &FinalizeREST{&finalizeStore},
+		Project:   &REST{store, authClient, businessClient, privilegedUsername},
+		Status:    &StatusREST{&statusStore},
+		Finalize:  &FinalizeREST{&finalizeStore},
+		QuotaTree: &QuotaTreeREST{store, businessClient},
 	}
 }
 
@@ -28,10 +28,10 @@ import (
 	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	"tkestack.io/tke/cmd/tke-business-api/app/options"
 	"tkestack.io/tke/pkg/apiserver/authentication"
+	businessutil "tkestack.io/tke/pkg/business/util"
 	"tkestack.io/tke/pkg/platform/util/validation"
 	"tkestack.io/tke/pkg/util/log"
 
-	//businessUtil "tkestack.io/tke/pkg/business/util"
 	//platformUtil "tkestack.io/tke/pkg/platform/util"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -83,6 +83,7 @@ func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.Garbage
 func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	oldProject := old.(*business.Project)
 	project, _ := obj.(*business.Project)
+	businessutil.NormalizeProjectQuota(oldProject)
 	_, tenantID := authentication.UsernameAndTenantID(ctx)
 	if len(tenantID) != 0 {
 		if oldProject.Spec.TenantID != tenantID {
@@ -105,7 +106,15 @@ func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 		project.Status.CachedParent = &oldProject.Spec.ParentProjectName
 	}
 
+	// The freeze is driven by the controller, and the project stays locked
+	// until it is unfrozen.
+	project.Status.Freeze = oldProject.Status.Freeze
+	if oldProject.Status.Freeze != nil {
+		project.Status.Locked = oldProject.Status.Locked
+	}
+
 	project.Spec.Members = oldProject.Spec.Members
+	businessutil.NormalizeProjectQuota(project)
 }
 
 // NamespaceScoped is false for projects.
@@ -130,6 +139,7 @@ func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 	if project.Name == "" && project.GenerateName == "" {
 		project.GenerateName = "prj-"
 	}
+	businessutil.NormalizeProjectQuota(project)
 
 	project.Spec.Finalizers = []business.FinalizerName{
 		business.ProjectFinalize,
@@ -231,6 +241,21 @@ func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) [
 	return nil
 }
 
+// Decorator normalizes the quota of the projects returned from the
+// underlying storage, which may be stored before the extended resources were
+// tracked by requests.
+func Decorator(obj runtime.Object) {
+	if project, ok := obj.(*business.Project); ok {
+		businessutil.NormalizeProjectQuota(project)
+	}
+
+	if projectList, ok := obj.(*business.ProjectList); ok {
+		for i := range projectList.Items {
+			businessutil.NormalizeProjectQuota(&projectList.Items[i])
+		}
+	}
+}
+
 // GetAttrs returns labels and fields of a given object for filtering purposes.
 func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
 	project, ok := obj.(*business.Project)
@@ -285,7 +310,9 @@ func NewStatusStrategy(strategy *Strategy) *StatusStrategy {
 func (StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	newProject := obj.(*business.Project)
 	oldProject := old.(*business.Project)
+	businessutil.NormalizeProjectQuota(oldProject)
 	newProject.Spec = oldProject.Spec
+	businessutil.NormalizeProjectQuota(newProject)
 }
 
 // ValidateUpdate is invoked after default fields in the object have been
@@ -315,6 +342,8 @@ func NewFinalizerStrategy(strategy *Strategy) *FinalizeStrategy {
 func (FinalizeStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	newProject := obj.(*business.Project)
 	oldProject := old.(*business.Project)
+	businessutil.NormalizeProjectQuota(oldProject)
+	businessutil.NormalizeProjectQuota(newProject)
 
 	childProjects := newProject.Status.CalculatedChildProjects
 	childNamespaces := newProject.Status.CalculatedNamespaces
@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/api/business"
+	businessutil "tkestack.io/tke/pkg/business/util"
 	"tkestack.io/tke/pkg/platform/util/validation"
 	"tkestack.io/tke/pkg/util/resource"
 )
@@ -36,6 +37,8 @@ const (
 	_addNewQuotaErrorInfo   = "Please at first set quota"
 	_removeClusterErrorInfo = "can NOT remove cluster"
 	_clusterLimitErrorInfo  = "parent project forbids this project to use cluster"
+
+	_borrowingClusterErrorInfo = "can NOT borrow"
 )
 
 // ValidateProjectName is a ValidateNameFunc for names that must be a DNS
@@ -80,6 +83,14 @@ func ValidateProject(ctx context.Context, project *business.Project, old *busine
 		allErrs = append(allErrs, validateAgainstParent(ctx, project, old, objectGetter)...) /* need hardErrs == nil */
 	}
 
+	if project.Spec.QuotaBorrowing != nil {
+		allErrs = append(allErrs, validateQuotaBorrowing(project)...)
+	}
+
+	if project.Spec.QuotaAlert != nil {
+		allErrs = append(allErrs, validateQuotaAlert(project.Spec.QuotaAlert)...)
+	}
+
 	return allErrs
 }
 
@@ -111,8 +122,12 @@ func ValidateProjectUpdate(ctx context.Context, project *business.Project, old *
 				Used: make(business.ResourceList),
 			}
 		}
+		capacity := clusterHard.Hard
+		if project.Spec.QuotaBorrowing != nil {
+			capacity = businessutil.BorrowingCapacity(project, clusterName)
+		}
 		allErrs = append(allErrs,
-			resource.ValidateUpdateResource(clusterHard.Hard, clusterUsed.Used,
+			resource.ValidateUpdateResource(capacity, clusterUsed.Used,
 				field.NewPath("spec", "hard"))...)
 	}
 
@@ -224,25 +239,20 @@ func validateAgainstParent(ctx context.Context, project *business.Project, old *
 					fmt.Sprintf("%s %s", _clusterLimitErrorInfo, clusterName)))
 			continue
 		}
-		clusterUsed, clusterUsedExist := parentProject.Status.Clusters[clusterName]
-		if !clusterUsedExist {
-			clusterUsed = business.UsedQuantity{
-				Used: make(business.ResourceList),
-			}
-		}
-
-		var oldNeededHard business.ResourceList
-		childProjectNameSet := sets.NewString(parentProject.Status.CalculatedChildProjects...)
-		if childProjectNameSet.Has(project.ObjectMeta.Name) && old != nil {
-			if oldNeed, hasOld := old.Spec.Clusters[clusterName]; hasOld {
-				oldNeededHard = oldNeed.Hard
-			}
-		}
 		allErrs = append(allErrs,
-			resource.ValidateAllocatableResources(needed.Hard, oldNeededHard,
-				clusterHard.Hard, clusterUsed.Used, fldHardPath)...)
+			resource.ValidateAllocatableResourceNames(needed.Hard, clusterHard.Hard, fldHardPath)...)
+	}
 
+	// The sum of the allocations of all children never exceeds the quota of
+	// the parent project, or of the ancestor the parent borrows from.
+	child := businessutil.QuotaChild{
+		Project: project.Name,
+		Want:    businessutil.ProjectAllocation(project),
 	}
+	if old != nil {
+		child.Allocated = old.Spec.Clusters
+	}
+	allErrs = append(allErrs, businessutil.ValidateAllocation(ctx, parentProject, child, getter, fldHardPath)...)
 
 	parentSet := map[string]bool{project.Name: true}
 	parentList := []string{project.Name}
@@ -271,3 +281,59 @@ func validateAgainstParent(ctx context.Context, project *business.Project, old *
 
 	return allErrs
 }
+
+func validateQuotaBorrowing(project *business.Project) (allErrs field.ErrorList) {
+	fldBorrowingPath := field.NewPath("spec", "quotaBorrowing")
+	if project.Spec.ParentProjectName == "" {
+		allErrs = append(allErrs, field.Invalid(fldBorrowingPath, "", "only the projects having parent project can borrow quota"))
+	}
+
+	fldLimitsPath := fldBorrowingPath.Child("limits")
+	for clusterName, clusterLimits := range project.Spec.QuotaBorrowing.Limits {
+		clusterHard, ok := project.Spec.Clusters[clusterName]
+		if !ok {
+			allErrs = append(allErrs, field.Invalid(fldLimitsPath.Key(clusterName), "",
+				fmt.Sprintf("%s '%s', for the project can NOT use it", _borrowingClusterErrorInfo, clusterName)))
+			continue
+		}
+		for k, v := range clusterLimits.Hard {
+			resPath := fldLimitsPath.Key(clusterName).Key(k)
+			allErrs = append(allErrs, resource.ValidateResourceQuotaResourceName(k, resPath)...)
+			allErrs = append(allErrs, resource.ValidateResourceQuantityValue(k, v, resPath)...)
+			if _, ok := clusterHard.Hard[k]; !ok {
+				allErrs = append(allErrs, field.Invalid(resPath, v.String(),
+					fmt.Sprintf("%s '%s', for the project has NOT set this kind of quota", _borrowingClusterErrorInfo, k)))
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateQuotaAlert(quotaAlert *business.QuotaAlert) (allErrs field.ErrorList) {
+	fldAlertPath := field.NewPath("spec", "quotaAlert")
+	if quotaAlert.Channel == "" {
+		allErrs = append(allErrs, field.Required(fldAlertPath.Child("channel"), "must specify a notify channel"))
+	}
+	if quotaAlert.Template == "" {
+		allErrs = append(allErrs, field.Required(fldAlertPath.Child("template"), "must specify a message template"))
+	}
+
+	resources := sets.NewString()
+	for i, rule := range quotaAlert.Rules {
+		fldRulePath := fldAlertPath.Child("rules").Index(i)
+		allErrs = append(allErrs, resource.ValidateResourceQuotaResourceName(rule.Resource, fldRulePath.Child("resource"))...)
+		if resources.Has(rule.Resource) {
+			allErrs = append(allErrs, field.Duplicate(fldRulePath.Child("resource"), rule.Resource))
+		}
+		resources.Insert(rule.Resource)
+		if len(rule.Thresholds) == 0 {
+			allErrs = append(allErrs, field.Required(fldRulePath.Child("thresholds"), "must specify at least one threshold"))
+		}
+		for j, threshold := range rule.Thresholds {
+			if threshold <= 0 || threshold > 100 {
+				allErrs = append(allErrs, field.Invalid(fldRulePath.Child("thresholds").Index(j), threshold, "must be a percentage between 1 and 100"))
+			}
+		}
+	}
+	return allErrs
+}
@@ -392,6 +392,52 @@ func TestNewQuota(t *testing.T) {
 	}
 }
 
+func TestQuotaBorrowing(t *testing.T) {
+	quota, _ := apimachineryresource.ParseQuantity("1")
+	project := _childProject.DeepCopy()
+	project.Spec.Clusters = business.ClusterHard{
+		ClusterName: {
+			Hard: business.ResourceList{
+				"requests.cpu": quota,
+			},
+		},
+	}
+	project.Spec.QuotaBorrowing = &business.QuotaBorrowing{
+		Limits: business.ClusterHard{
+			InvalidCluster: {
+				Hard: business.ResourceList{
+					"requests.cpu": quota,
+				},
+			},
+		},
+	}
+
+	hasError := false
+	errors := validateQuotaBorrowing(project)
+	for _, err := range errors {
+		if strings.Contains(err.Error(), _borrowingClusterErrorInfo) {
+			hasError = true
+		} else {
+			t.Errorf("Unexpected: %s", err.Error())
+		}
+	}
+	if !hasError {
+		t.Errorf("Expect: %s", _borrowingClusterErrorInfo)
+	}
+
+	project.Spec.QuotaBorrowing.Limits = business.ClusterHard{
+		ClusterName: {
+			Hard: business.ResourceList{
+				"requests.cpu": quota,
+			},
+		},
+	}
+	errors = validateQuotaBorrowing(project)
+	for _, err := range errors {
+		t.Errorf("Unexpected: %s", err.Error())
+	}
+}
+
 func newObjectGetter() validation.BusinessObjectGetter {
 	return testObjectGetter{}
 }
@@ -0,0 +1,206 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/business"
+	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
+	authversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
+	"tkestack.io/tke/pkg/business/registry/projectrequest"
+	"tkestack.io/tke/pkg/business/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Storage includes storage for project requests and all sub resources.
+type Storage struct {
+	ProjectRequest *REST
+	Status         *StatusREST
+}
+
+// NewStorage returns a Storage object that will work against project requests.
+func NewStorage(optsGetter genericregistry.RESTOptionsGetter, businessClient *businessinternalclient.BusinessClient,
+	platformClient platformversionedclient.PlatformV1Interface, authClient authversionedclient.AuthV1Interface, privilegedUsername string) *Storage {
+	strategy := projectrequest.NewStrategy(businessClient, platformClient, authClient, privilegedUsername)
+	store := &registry.Store{
+		NewFunc:                  func() runtime.Object { return &business.ProjectRequest{} },
+		NewListFunc:              func() runtime.Object { return &business.ProjectRequestList{} },
+		DefaultQualifiedResource: business.Resource("projectrequests"),
+		PredicateFunc:            projectrequest.MatchProjectRequest,
+		Decorator:                projectrequest.Decorator,
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+
+		ShouldDeleteDuringUpdate: registry.ShouldDeleteDuringUpdate,
+	}
+	store.TableConvertor = rest.NewDefaultTableConvertor(store.DefaultQualifiedResource)
+	options := &genericregistry.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    projectrequest.GetAttrs,
+	}
+
+	if err := store.CompleteWithOptions(options); err != nil {
+		log.Panic("Failed to create project request etcd rest storage", log.Err(err))
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = projectrequest.NewStatusStrategy(strategy)
+
+	return &Storage{
+		ProjectRequest: &REST{store, strategy},
+		Status:         &StatusREST{&statusStore, strategy},
+	}
+}
+
+// ValidateGetObjectAndTenantID validate name and tenantID, if success return
+// ProjectRequest. The users who are not administrators only get their own requests.
+func ValidateGetObjectAndTenantID(ctx context.Context, store *registry.Store, strategy *projectrequest.Strategy,
+	name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	o := obj.(*business.ProjectRequest)
+	if err := util.FilterProjectRequest(ctx, o); err != nil {
+		return nil, err
+	}
+	username, _ := authentication.UsernameAndTenantID(ctx)
+	if username != o.Spec.Requester {
+		isAdmin, err := strategy.IsAdministrator(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !isAdmin {
+			return nil, errors.NewNotFound(business.Resource("projectrequests"), name)
+		}
+	}
+	return o, nil
+}
+
+// REST implements a RESTStorage for project requests against etcd.
+type REST struct {
+	*registry.Store
+
+	strategy *projectrequest.Strategy
+}
+
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"prq"}
+}
+
+// DeleteCollection selects all resources in the storage matching given 'listOptions'
+// and deletes them.
+func (r *REST) DeleteCollection(ctx context.Context, deleteValidation rest.ValidateObjectFunc,
+	options *metav1.DeleteOptions, listOptions *metainternal.ListOptions) (runtime.Object, error) {
+	return r.Store.DeleteCollection(ctx, deleteValidation, options, listOptions)
+}
+
+// List selects resources in the storage which match to the selector. 'options' can be nil.
+func (r *REST) List(ctx context.Context, options *metainternal.ListOptions) (runtime.Object, error) {
+	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
+	isAdmin, err := r.strategy.IsAdministrator(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		username, _ := authentication.UsernameAndTenantID(ctx)
+		wrappedOptions = apiserverutil.FullListOptionsFieldSelector(wrappedOptions, fields.OneTermEqualSelector("spec.requester", username))
+	}
+	return r.Store.List(ctx, wrappedOptions)
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.Store, r.strategy, name, options)
+}
+
+// Update alters the object subset of an object.
+func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo,
+	createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc,
+	forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, r.strategy, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// Delete enforces life-cycle rules for project request termination
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc,
+	options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, r.strategy, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Delete(ctx, name, deleteValidation, options)
+}
+
+// StatusREST implements the REST endpoint for approving or rejecting a
+// project request.
+type StatusREST struct {
+	store    *registry.Store
+	strategy *projectrequest.Strategy
+}
+
+// StatusREST implements Patcher.
+var _ = rest.Patcher(&StatusREST{})
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *StatusREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.store, r.strategy, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo,
+	createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc,
+	forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.store, r.strategy, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
@@ -0,0 +1,269 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package projectrequest
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"tkestack.io/tke/api/business"
+	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
+	authversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	registryutil "tkestack.io/tke/pkg/business/registry/util"
+	businessutil "tkestack.io/tke/pkg/business/util"
+	"tkestack.io/tke/pkg/platform/util/validation"
+	"tkestack.io/tke/pkg/util/log"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for project request.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+
+	businessClient     *businessinternalclient.BusinessClient
+	platformClient     platformversionedclient.PlatformV1Interface
+	authClient         authversionedclient.AuthV1Interface
+	privilegedUsername string
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating project request objects.
+func NewStrategy(businessClient *businessinternalclient.BusinessClient, platformClient platformversionedclient.PlatformV1Interface,
+	authClient authversionedclient.AuthV1Interface, privilegedUsername string) *Strategy {
+	return &Strategy{business.Scheme, namesutil.Generator, businessClient, platformClient, authClient, privilegedUsername}
+}
+
+// IsAdministrator tells whether the user of the request can approve or reject
+// project requests.
+func (s *Strategy) IsAdministrator(ctx context.Context) (bool, error) {
+	username, _ := authentication.UsernameAndTenantID(ctx)
+	if username == s.privilegedUsername {
+		return true, nil
+	}
+	isAdmin, _, err := registryutil.FilterWithUser(ctx, nil, s.authClient, s.businessClient)
+	return isAdmin, err
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object.
+func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	oldRequest := old.(*business.ProjectRequest)
+	request, _ := obj.(*business.ProjectRequest)
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if len(tenantID) != 0 {
+		if oldRequest.Spec.TenantID != tenantID {
+			log.Panic("Unauthorized update project request information",
+				log.String("oldTenantID", oldRequest.Spec.TenantID),
+				log.String("newTenantID", request.Spec.TenantID),
+				log.String("userTenantID", tenantID))
+		}
+		request.Spec.TenantID = tenantID
+	}
+	request.Spec.Requester = oldRequest.Spec.Requester
+	request.Status = oldRequest.Status
+	businessutil.NormalizeClusterHard(request.Spec.Clusters)
+}
+
+// NamespaceScoped is false for project requests.
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	username, tenantID := authentication.UsernameAndTenantID(ctx)
+	request, _ := obj.(*business.ProjectRequest)
+	if len(tenantID) != 0 {
+		request.Spec.TenantID = tenantID
+	}
+	request.Spec.Requester = username
+	if request.Name == "" && request.GenerateName == "" {
+		request.GenerateName = "prq-"
+	}
+	businessutil.NormalizeClusterHard(request.Spec.Clusters)
+	request.Status = business.ProjectRequestStatus{
+		Phase:              business.ProjectRequestPending,
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// AfterCreate implements a further operation to run after a resource is
+// created and before it is decorated, optional.
+func (s *Strategy) AfterCreate(obj runtime.Object) error {
+	return nil
+}
+
+// Validate validates a new project request.
+func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ValidateProjectRequestCreate(ctx, obj.(*business.ProjectRequest), s.businessClient, validation.NewClusterGetter(s.platformClient))
+}
+
+// AllowCreateOnUpdate is false for project requests.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end project request.
+func (s *Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateProjectRequestUpdate(ctx, obj.(*business.ProjectRequest), old.(*business.ProjectRequest))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// Decorator normalizes the quota of the project requests returned from the
+// underlying storage, which may be stored before the extended resources were
+// tracked by requests.
+func Decorator(obj runtime.Object) {
+	if request, ok := obj.(*business.ProjectRequest); ok {
+		businessutil.NormalizeClusterHard(request.Spec.Clusters)
+	}
+
+	if requestList, ok := obj.(*business.ProjectRequestList); ok {
+		for i := range requestList.Items {
+			businessutil.NormalizeClusterHard(requestList.Items[i].Spec.Clusters)
+		}
+	}
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	request, ok := obj.(*business.ProjectRequest)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a project request")
+	}
+	return request.ObjectMeta.Labels, ToSelectableFields(request), nil
+}
+
+// MatchProjectRequest returns a generic matcher for a given label and field selector.
+func MatchProjectRequest(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"spec.tenantID",
+			"spec.requester",
+			"metadata.name",
+		},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(request *business.ProjectRequest) fields.Set {
+	objectMetaFieldsSet := genericregistry.ObjectMetaFieldsSet(&request.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID":  request.Spec.TenantID,
+		"spec.requester": request.Spec.Requester,
+		"spec.type":      string(request.Spec.Type),
+		"status.phase":   string(request.Status.Phase),
+	}
+	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
+
+// StatusStrategy implements verification logic for status of project request.
+type StatusStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &StatusStrategy{}
+
+// NewStatusStrategy create the StatusStrategy object by given strategy.
+func NewStatusStrategy(strategy *Strategy) *StatusStrategy {
+	return &StatusStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object.  For example: remove fields that are not to be persisted,
+// sort order-insensitive list fields, etc.  This should not remove fields
+// whose presence would be considered a validation error.
+func (StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newRequest := obj.(*business.ProjectRequest)
+	oldRequest := old.(*business.ProjectRequest)
+	businessutil.NormalizeClusterHard(oldRequest.Spec.Clusters)
+	newRequest.Spec = oldRequest.Spec
+
+	// The administrator who approves or rejects the request is recorded.
+	if oldRequest.Status.Phase == business.ProjectRequestPending && newRequest.Status.Phase != oldRequest.Status.Phase {
+		username, _ := authentication.UsernameAndTenantID(ctx)
+		newRequest.Status.Approver = username
+		newRequest.Status.LastTransitionTime = metav1.Now()
+	}
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (s *StatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	newRequest := obj.(*business.ProjectRequest)
+	oldRequest := old.(*business.ProjectRequest)
+	if !apiequality.Semantic.DeepEqual(newRequest.Status, oldRequest.Status) {
+		fldStatus := field.NewPath("status")
+		isAdmin, err := s.IsAdministrator(ctx)
+		if err != nil {
+			return field.ErrorList{field.InternalError(fldStatus, err)}
+		}
+		if !isAdmin {
+			return field.ErrorList{field.Forbidden(fldStatus, "only the administrators can approve or reject project requests")}
+		}
+	}
+	return ValidateProjectRequestStatusUpdate(ctx, newRequest, oldRequest)
+}
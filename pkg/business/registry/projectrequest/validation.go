@@ -0,0 +1,165 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package projectrequest
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/business"
+	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
+	"tkestack.io/tke/pkg/platform/util/validation"
+	"tkestack.io/tke/pkg/util/resource"
+)
+
+// _validateProjectRequestName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var _validateProjectRequestName = apimachineryvalidation.NameIsDNSLabel
+
+// _phaseTransitions lists the phases each phase of a project request can move to.
+var _phaseTransitions = map[business.ProjectRequestPhase][]business.ProjectRequestPhase{
+	business.ProjectRequestPending:  {business.ProjectRequestApproved, business.ProjectRequestRejected},
+	business.ProjectRequestApproved: {business.ProjectRequestCompleted, business.ProjectRequestFailed},
+}
+
+// ValidateProjectRequestCreate tests if required fields in the ProjectRequest are set correctly.
+func ValidateProjectRequestCreate(ctx context.Context, request *business.ProjectRequest,
+	businessClient *businessinternalclient.BusinessClient, clusterGetter validation.ClusterGetter) field.ErrorList {
+	allErrs := validateProjectRequest(request)
+
+	fldSpec := field.NewPath("spec")
+	for clusterName := range request.Spec.Clusters {
+		allErrs = append(allErrs, validation.ValidateClusterVersioned(ctx, clusterGetter, clusterName, request.Spec.TenantID)...)
+	}
+
+	var projectName string
+	fldProjectName := fldSpec.Child("projectName")
+	switch request.Spec.Type {
+	case business.ProjectRequestCreate:
+		projectName = request.Spec.ParentProjectName
+		fldProjectName = fldSpec.Child("parentProjectName")
+	case business.ProjectRequestQuota:
+		projectName = request.Spec.ProjectName
+	}
+	if projectName != "" {
+		project, err := businessClient.Projects().Get(ctx, projectName, metav1.GetOptions{})
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldProjectName, projectName, fmt.Sprintf("failed to get this project, %s", err)))
+		} else if project.Spec.TenantID != request.Spec.TenantID {
+			allErrs = append(allErrs, field.Invalid(fldProjectName, projectName, "the project does not belong to the tenant"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateProjectRequestUpdate tests if required fields in the ProjectRequest are set during
+// an update.
+func ValidateProjectRequestUpdate(ctx context.Context, request, old *business.ProjectRequest) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&request.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, validateProjectRequest(request)...)
+
+	fldSpec := field.NewPath("spec")
+	if request.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(fldSpec.Child("tenantID"), request.Spec.TenantID, "disallowed change the tenant"))
+	}
+	if request.Spec.Requester != old.Spec.Requester {
+		allErrs = append(allErrs, field.Invalid(fldSpec.Child("requester"), request.Spec.Requester, "disallowed change the requester"))
+	}
+	if old.Status.Phase != business.ProjectRequestPending && !apiequality.Semantic.DeepEqual(request.Spec, old.Spec) {
+		allErrs = append(allErrs, field.Forbidden(fldSpec, fmt.Sprintf("can NOT change a request in phase %s", old.Status.Phase)))
+	}
+
+	return allErrs
+}
+
+// ValidateProjectRequestStatusUpdate tests if the phase of the ProjectRequest
+// moves forward during an update of the status.
+func ValidateProjectRequestStatusUpdate(ctx context.Context, request, old *business.ProjectRequest) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&request.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+
+	if request.Status.Phase == old.Status.Phase {
+		return allErrs
+	}
+	fldPhase := field.NewPath("status", "phase")
+	var allowed []string
+	for _, phase := range _phaseTransitions[old.Status.Phase] {
+		if request.Status.Phase == phase {
+			return allErrs
+		}
+		allowed = append(allowed, string(phase))
+	}
+	if len(allowed) == 0 {
+		return append(allErrs, field.Forbidden(fldPhase, fmt.Sprintf("can NOT change a request in phase %s", old.Status.Phase)))
+	}
+	return append(allErrs, field.NotSupported(fldPhase, request.Status.Phase, allowed))
+}
+
+// validateProjectRequest tests if required fields in the ProjectRequest are set.
+func validateProjectRequest(request *business.ProjectRequest) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&request.ObjectMeta,
+		false, _validateProjectRequestName, field.NewPath("metadata"))
+
+	fldSpec := field.NewPath("spec")
+	if request.Spec.Requester == "" {
+		allErrs = append(allErrs, field.Required(fldSpec.Child("requester"), "must specify the requester"))
+	}
+	if request.Spec.Justification == "" {
+		allErrs = append(allErrs, field.Required(fldSpec.Child("justification"), "must specify the justification"))
+	}
+
+	switch request.Spec.Type {
+	case business.ProjectRequestCreate:
+		if request.Spec.DisplayName == "" {
+			allErrs = append(allErrs, field.Required(fldSpec.Child("displayName"), "must specify display name"))
+		}
+		if request.Spec.ProjectName != "" {
+			allErrs = append(allErrs, field.Forbidden(fldSpec.Child("projectName"), "the name of a new project is generated"))
+		}
+	case business.ProjectRequestQuota:
+		if request.Spec.ProjectName == "" {
+			allErrs = append(allErrs, field.Required(fldSpec.Child("projectName"), "must specify the project"))
+		}
+		if len(request.Spec.Clusters) == 0 {
+			allErrs = append(allErrs, field.Required(fldSpec.Child("clusters"), "must specify the quota"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldSpec.Child("type"), request.Spec.Type,
+			[]string{string(business.ProjectRequestCreate), string(business.ProjectRequestQuota)}))
+	}
+
+	fldHardPath := fldSpec.Child("clusters")
+	for clusterName, clusterHard := range request.Spec.Clusters {
+		for k, v := range clusterHard.Hard {
+			resPath := fldHardPath.Key(clusterName + k)
+			allErrs = append(allErrs, resource.ValidateResourceQuotaResourceName(k, resPath)...)
+			allErrs = append(allErrs, resource.ValidateResourceQuantityValue(k, v, resPath)...)
+		}
+	}
+
+	if request.Spec.Channel != "" && request.Spec.Template == "" {
+		allErrs = append(allErrs, field.Required(fldSpec.Child("template"), "must specify the message template of the channel"))
+	}
+
+	return allErrs
+}
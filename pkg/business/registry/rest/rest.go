@@ -37,9 +37,11 @@ import (
 	emigrationstorage "tkestack.io/tke/pkg/business/registry/emigration/storage"
 	imagenamespacestorage "tkestack.io/tke/pkg/business/registry/imagenamespace/storage"
 	namespacestorage "tkestack.io/tke/pkg/business/registry/namespace/storage"
+	namespacetemplatestorage "tkestack.io/tke/pkg/business/registry/namespacetemplate/storage"
 	platformstorage "tkestack.io/tke/pkg/business/registry/platform/storage"
 	portalstorage "tkestack.io/tke/pkg/business/registry/portal/storage"
 	projectstorage "tkestack.io/tke/pkg/business/registry/project/storage"
+	projectrequeststorage "tkestack.io/tke/pkg/business/registry/projectrequest/storage"
 )
 
 // StorageProvider is a REST type for core resources storage that implement
@@ -84,6 +86,7 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		storageMap["projects"] = projectREST.Project
 		storageMap["projects/status"] = projectREST.Status
 		storageMap["projects/finalize"] = projectREST.Finalize
+		storageMap["projects/quotatree"] = projectREST.QuotaTree
 
 		namespaceREST := namespacestorage.NewStorage(restOptionsGetter, businessClient, s.PlatformClient, s.PrivilegedUsername)
 		storageMap["namespaces"] = namespaceREST.Namespace
@@ -103,6 +106,13 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		emigrationREST := emigrationstorage.NewStorage(restOptionsGetter, businessClient, s.PlatformClient, s.PrivilegedUsername)
 		storageMap["nsemigrations"] = emigrationREST.Emigration
 
+		namespaceTemplateREST := namespacetemplatestorage.NewStorage(restOptionsGetter, businessClient, s.AuthClient, s.PrivilegedUsername)
+		storageMap["namespacetemplates"] = namespaceTemplateREST.NamespaceTemplate
+
+		projectRequestREST := projectrequeststorage.NewStorage(restOptionsGetter, businessClient, s.PlatformClient, s.AuthClient, s.PrivilegedUsername)
+		storageMap["projectrequests"] = projectRequestREST.ProjectRequest
+		storageMap["projectrequests/status"] = projectRequestREST.Status
+
 		if s.RegistryClient != nil {
 			imageNamespaceREST := imagenamespacestorage.NewStorage(restOptionsGetter, businessClient, s.RegistryClient, s.PrivilegedUsername)
 			storageMap["imagenamespaces"] = imageNamespaceREST.ImageNamespace
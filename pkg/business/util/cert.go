@@ -0,0 +1,246 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"reflect"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+const certRSAKeyBits = 2048
+
+const (
+	// NamespaceCertificateValidity is how long a certificate of a namespace is
+	// valid, which bounds how long a revoked certificate stays valid.
+	NamespaceCertificateValidity = 24 * time.Hour
+	// NamespaceCertificateRenewBefore is how long before a certificate expires
+	// it is renewed, which is the overlap window in which both the certificate
+	// and its renewal are valid.
+	NamespaceCertificateRenewBefore = NamespaceCertificateValidity / 3
+)
+
+// NamespaceCertificate is a x509 client certificate issued for a user of a
+// namespace, signed by the CA of the cluster of the namespace. The KeyPem is
+// only set when the certificate is issued with a new key.
+type NamespaceCertificate struct {
+	CertPem      []byte
+	KeyPem       []byte
+	CACertPem    []byte
+	APIServer    string
+	SerialNumber string
+	NotBefore    time.Time
+	NotAfter     time.Time
+}
+
+// NamespaceCertificateSubject describes whom a namespace certificate is issued for.
+type NamespaceCertificateSubject struct {
+	Username    string
+	TenantID    string
+	ProjectName string
+	ClusterName string
+	Namespace   string
+}
+
+// NamespaceCertificateEndpoint returns the CA certificate and the address of
+// the API server of the cluster the certificates of a namespace are used with.
+func NamespaceCertificateEndpoint(ctx context.Context, platformClient platformversionedclient.PlatformV1Interface, subject NamespaceCertificateSubject) ([]byte, string, error) {
+	credential, apiServer, err := clusterEndpoint(ctx, platformClient, subject)
+	if err != nil {
+		return nil, "", err
+	}
+	return credential.CACert, apiServer, nil
+}
+
+func clusterEndpoint(ctx context.Context, platformClient platformversionedclient.PlatformV1Interface, subject NamespaceCertificateSubject) (*platformv1.ClusterCredential, string, error) {
+	prefix := fmt.Sprintf("prj:%s, ns:%s", subject.ProjectName, subject.Namespace)
+	cluster, err := platformClient.Clusters().Get(ctx, subject.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, "", fmt.Errorf("%s, get cluster %s, %s", prefix, subject.ClusterName, err)
+	}
+	if cluster.Spec.Type == "Imported" {
+		return nil, "", fmt.Errorf("%s, cluster %s is Imported, NOT support generating certificate", prefix, subject.ClusterName)
+	}
+	if len(cluster.Status.Addresses) == 0 {
+		return nil, "", fmt.Errorf("%s, cluster %s has NO valid addresses", prefix, subject.ClusterName)
+	}
+	fieldSelector := fields.OneTermEqualSelector("clusterName", subject.ClusterName).String()
+	list, err := platformClient.ClusterCredentials().List(ctx, metav1.ListOptions{FieldSelector: fieldSelector})
+	if err != nil {
+		return nil, "", fmt.Errorf("%s, get cluster credential, %s", prefix, err)
+	} else if len(list.Items) == 0 {
+		return nil, "", fmt.Errorf("%s, no cluster credential", prefix)
+	}
+
+	address := cluster.Status.Addresses[0]
+	for _, one := range cluster.Status.Addresses {
+		if one.Type == "Advertise" {
+			address = one
+			break
+		}
+	}
+	return &list.Items[0], fmt.Sprintf("https://%s:%d", address.Host, address.Port), nil
+}
+
+// IssueNamespaceCertificate issues a x509 client certificate with a new key
+// for the subject, with a random serial number so that the certificate can be
+// told apart when it is renewed or revoked. The certificate is short-lived
+// and is renewed until renewUntil.
+func IssueNamespaceCertificate(ctx context.Context, platformClient platformversionedclient.PlatformV1Interface, subject NamespaceCertificateSubject, renewUntil time.Time) (*NamespaceCertificate, error) {
+	private, err := rsa.GenerateKey(rand.Reader, certRSAKeyBits)
+	if err != nil {
+		return nil, fmt.Errorf("prj:%s, ns:%s, generate prive key, %s", subject.ProjectName, subject.Namespace, err)
+	}
+	issued, err := signNamespaceCertificate(ctx, platformClient, subject, &private.PublicKey, nil, renewUntil)
+	if err != nil {
+		return nil, err
+	}
+	issued.KeyPem = pem.EncodeToMemory(&pem.Block{
+		Type:  "PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(private),
+	})
+	return issued, nil
+}
+
+// RenewNamespaceCertificate issues a new certificate for the key of the given
+// certificate, so that the user renews the certificate without a new key,
+// which is never stored. Only the certificates signed by the CA of the
+// cluster for the same subject are renewed.
+func RenewNamespaceCertificate(ctx context.Context, platformClient platformversionedclient.PlatformV1Interface, subject NamespaceCertificateSubject, certPem []byte, renewUntil time.Time) (*NamespaceCertificate, error) {
+	cert, err := ParseNamespaceCertificate(certPem)
+	if err != nil {
+		return nil, fmt.Errorf("prj:%s, ns:%s, %s", subject.ProjectName, subject.Namespace, err)
+	}
+	return signNamespaceCertificate(ctx, platformClient, subject, cert.PublicKey, cert, renewUntil)
+}
+
+// ParseNamespaceCertificate parses the pem encoded certificate of a namespace.
+func ParseNamespaceCertificate(certPem []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPem)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("pem decode cert error")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse cert, %s", err)
+	}
+	return cert, nil
+}
+
+func signNamespaceCertificate(ctx context.Context, platformClient platformversionedclient.PlatformV1Interface, subject NamespaceCertificateSubject, publicKey interface{}, previous *x509.Certificate, renewUntil time.Time) (*NamespaceCertificate, error) {
+	prefix := fmt.Sprintf("prj:%s, ns:%s", subject.ProjectName, subject.Namespace)
+	credential, apiServer, err := clusterEndpoint(ctx, platformClient, subject)
+	if err != nil {
+		return nil, err
+	}
+	certBlock, _ := pem.Decode(credential.CACert)
+	if certBlock == nil {
+		return nil, fmt.Errorf("%s, pem decode root cert error, bytes:%v", prefix, credential.CACert)
+	}
+	if certBlock.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("%s, pem decode root cert, invalid type %s", prefix, certBlock.Type)
+	}
+	rootCert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s, parse root cert, %s, bytes:%v", prefix, err, certBlock.Bytes)
+	}
+	keyBlock, _ := pem.Decode(credential.CAKey)
+	if keyBlock == nil {
+		return nil, fmt.Errorf("%s, pem decode root key error, bytes:%v", prefix, credential.CAKey)
+	}
+	if keyBlock.Type != "RSA PRIVATE KEY" {
+		return nil, fmt.Errorf("%s, pem decode root key, invalid type %s", prefix, keyBlock.Type)
+	}
+	rootKey, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s, parse root key, %s, bytes:%v", prefix, err, keyBlock.Bytes)
+	}
+
+	organization := []string{
+		fmt.Sprintf("cluster:%s", subject.ClusterName),
+		fmt.Sprintf("project:%s", subject.ProjectName),
+		fmt.Sprintf("namespace:%s", subject.Namespace),
+		fmt.Sprintf("tenant:%s", subject.TenantID),
+	}
+	if previous != nil {
+		if err := previous.CheckSignatureFrom(rootCert); err != nil {
+			return nil, fmt.Errorf("%s, cert %s not signed by the cluster, %s", prefix, previous.SerialNumber.Text(16), err)
+		}
+		if previous.Subject.CommonName != subject.Username || !reflect.DeepEqual(previous.Subject.Organization, organization) {
+			return nil, fmt.Errorf("%s, cert %s not issued for %s", prefix, previous.SerialNumber.Text(16), subject.Username)
+		}
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("%s, generate serial number, %s", prefix, err)
+	}
+	template := x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:   subject.Username,
+			Organization: organization,
+		},
+		SerialNumber:          serialNumber,
+		NotBefore:             rootCert.NotBefore,
+		NotAfter:              NamespaceCertificateNotAfter(time.Now(), renewUntil),
+		BasicConstraintsValid: true,
+		IsCA:                  false,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageDataEncipherment,
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, &template, rootCert, publicKey, rootKey)
+	if err != nil {
+		return nil, fmt.Errorf("CreateCertificate(%+v), %s", template.Subject, err)
+	}
+
+	return &NamespaceCertificate{
+		CertPem: pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: certBytes,
+		}),
+		CACertPem:    credential.CACert,
+		APIServer:    apiServer,
+		SerialNumber: serialNumber.Text(16),
+		NotBefore:    template.NotBefore,
+		NotAfter:     template.NotAfter,
+	}, nil
+}
+
+// NamespaceCertificateNotAfter returns the time a certificate issued at now
+// expires, the certificates are short-lived so that a revoked certificate,
+// which is no longer renewed, expires soon.
+func NamespaceCertificateNotAfter(now, renewUntil time.Time) time.Time {
+	notAfter := now.Add(NamespaceCertificateValidity)
+	if renewUntil.Before(notAfter) {
+		return renewUntil
+	}
+	return notAfter
+}
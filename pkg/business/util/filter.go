@@ -98,3 +98,29 @@ func FilterNsEmigration(ctx context.Context, emigration *business.NsEmigration)
 	}
 	return nil
 }
+
+// FilterNamespaceTemplate is used to filter namespace template that do not
+// belong to the tenant.
+func FilterNamespaceTemplate(ctx context.Context, template *business.NamespaceTemplate) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if template.Spec.TenantID != tenantID {
+		return errors.NewNotFound(businessv1.Resource("namespacetemplate"), template.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterProjectRequest is used to filter project request that do not
+// belong to the tenant.
+func FilterProjectRequest(ctx context.Context, request *business.ProjectRequest) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if request.Spec.TenantID != tenantID {
+		return errors.NewNotFound(businessv1.Resource("projectrequest"), request.ObjectMeta.Name)
+	}
+	return nil
+}
@@ -23,4 +23,19 @@ const (
 	LabelProjectName = "tkestack.io/projectName"
 	// LabelNamespaceName is the label name for namespace
 	LabelNamespaceName = "tkestack.io/namespaceName"
+	// LabelNamespaceTemplateName is the label name for the namespace template
+	// that the object on the cluster is rendered from
+	LabelNamespaceTemplateName = "tkestack.io/namespaceTemplateName"
+	// LabelProjectRequestName is the label name for the project request that
+	// the project is created by
+	LabelProjectRequestName = "tkestack.io/projectRequestName"
+	// AnnotationNamespaceTemplateKeys is the annotation name for the keys of the
+	// labels and annotations of a namespace that are set by namespace templates
+	AnnotationNamespaceTemplateKeys = "tkestack.io/namespaceTemplateKeys"
+	// AnnotationQuotaTransferPrefix is the prefix of the annotations of a project
+	// marking the quota transferred by namespace emigrations and not settled yet
+	AnnotationQuotaTransferPrefix = "quota-transfer.business.tkestack.io/"
+	// AnnotationProjectRequestPrefix is the prefix of the annotations of a project
+	// marking the project requests whose quota has been added to the project
+	AnnotationProjectRequestPrefix = "project-request.business.tkestack.io/"
 )
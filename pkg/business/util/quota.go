@@ -0,0 +1,367 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimachineryresource "k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/business"
+	"tkestack.io/tke/pkg/platform/util/validation"
+	"tkestack.io/tke/pkg/util/resource"
+)
+
+// QuotaChild is a child project or namespace allocating quota from a project.
+type QuotaChild struct {
+	// Project is the name of the child project, empty for a namespace.
+	Project string
+	// Namespace is the name of the child namespace, empty for a project.
+	Namespace string
+	// Want is the quota the child wants to allocate per cluster.
+	Want business.ClusterHard
+	// Allocated is the quota the child has allocated per cluster before.
+	Allocated business.ClusterHard
+}
+
+// ProjectAllocation returns the quota the project takes from its parent per
+// cluster, which is its quota plus the quota borrowed from the parent.
+func ProjectAllocation(project *business.Project) business.ClusterHard {
+	allocation := business.ClusterHard{}
+	for clusterName, clusterHard := range project.Spec.Clusters {
+		hard := business.ResourceList{}
+		for k, v := range clusterHard.Hard {
+			hard[k] = v.DeepCopy()
+		}
+		for k, v := range project.Status.Clusters[clusterName].Used {
+			if quantity, ok := hard[k]; !ok || quantity.Cmp(v) < 0 {
+				hard[k] = v.DeepCopy()
+			}
+		}
+		allocation[clusterName] = business.HardQuantity{Hard: hard}
+	}
+	return allocation
+}
+
+// ProjectAllocated returns the quota the project has allocated to its child
+// projects and namespaces per cluster, except the given child. The larger of
+// the allocation recorded in the status and the allocation of the children is
+// taken, since the status is calculated asynchronously.
+func ProjectAllocated(ctx context.Context, project *business.Project, except QuotaChild, getter validation.BusinessObjectGetter) (business.ClusterUsed, error) {
+	children := business.ClusterUsed{}
+	for _, name := range project.Status.CalculatedChildProjects {
+		if name == except.Project {
+			continue
+		}
+		child, err := getter.Project(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get child project '%s', for %s", name, err)
+		}
+		addClusterHard(children, ProjectAllocation(child))
+	}
+	for _, name := range project.Status.CalculatedNamespaces {
+		if name == except.Namespace {
+			continue
+		}
+		child, err := getter.Namespace(ctx, project.Name, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to get child namespace '%s', for %s", name, err)
+		}
+		addClusterHard(children, business.ClusterHard{child.Spec.ClusterName: {Hard: child.Spec.Hard}})
+	}
+
+	// The allocation recorded in the status includes the child, so the
+	// allocation of the child before is subtracted.
+	recorded := business.ClusterUsed{}
+	for clusterName, clusterUsed := range project.Status.Clusters {
+		used := business.ResourceList{}
+		for k, v := range clusterUsed.Used {
+			used[k] = v.DeepCopy()
+		}
+		recorded[clusterName] = business.UsedQuantity{Used: used}
+	}
+	if sets.NewString(project.Status.CalculatedChildProjects...).Has(except.Project) ||
+		sets.NewString(project.Status.CalculatedNamespaces...).Has(except.Namespace) {
+		for clusterName, clusterHard := range except.Allocated {
+			for k, v := range clusterHard.Hard {
+				if used, ok := recorded[clusterName].Used[k]; ok {
+					used.Sub(v)
+					recorded[clusterName].Used[k] = used
+				}
+			}
+		}
+	}
+
+	for clusterName, clusterUsed := range recorded {
+		for k, v := range clusterUsed.Used {
+			if _, ok := children[clusterName]; !ok {
+				children[clusterName] = business.UsedQuantity{Used: business.ResourceList{}}
+			}
+			if quantity, ok := children[clusterName].Used[k]; !ok || quantity.Cmp(v) < 0 {
+				children[clusterName].Used[k] = v
+			}
+		}
+	}
+	return children, nil
+}
+
+// ValidateAllocation validates that the project has enough quota for the
+// child. The quota beyond the quota of the project is borrowed from the
+// parent project if the borrowing of the project allows, and validated
+// against the ancestors in turn. The errors name the conflicting ancestor.
+func ValidateAllocation(ctx context.Context, project *business.Project, child QuotaChild, getter validation.BusinessObjectGetter, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	visited := sets.NewString()
+	for project != nil && !visited.Has(project.Name) {
+		visited.Insert(project.Name)
+
+		allocated, err := ProjectAllocated(ctx, project, child, getter)
+		if err != nil {
+			return append(allErrs, field.InternalError(fldPath, err))
+		}
+
+		borrowing := false
+		for clusterName, wanted := range child.Want {
+			clusterHard, ok := project.Spec.Clusters[clusterName]
+			if !ok {
+				continue
+			}
+			for k, want := range wanted.Hard {
+				capacity, ok := clusterHard.Hard[k]
+				if !ok {
+					continue
+				}
+				total := want.DeepCopy()
+				if used, ok := allocated[clusterName].Used[k]; ok {
+					total.Add(used)
+				}
+				if total.Cmp(capacity) <= 0 {
+					continue
+				}
+
+				remaining := capacity.DeepCopy()
+				remaining.Sub(total)
+				remaining.Add(want)
+				borrowed := total.DeepCopy()
+				borrowed.Sub(capacity)
+				limit, limited := borrowingLimit(project, clusterName, k)
+				switch {
+				case project.Spec.ParentProjectName == "" || !limited:
+					allErrs = append(allErrs, field.Invalid(fldPath.Key(clusterName).Key(k), want.String(),
+						fmt.Sprintf("%s(%s) of ancestor project %s", resource.AllocatableErrorInfo, remaining.String(), project.Name)))
+				case borrowed.Cmp(limit) > 0:
+					allErrs = append(allErrs, field.Invalid(fldPath.Key(clusterName).Key(k), want.String(),
+						fmt.Sprintf("%s(%s) of ancestor project %s, which borrows %s beyond the borrowing limit %s", resource.AllocatableErrorInfo, remaining.String(), project.Name, borrowed.String(), limit.String())))
+				default:
+					borrowing = true
+				}
+			}
+		}
+		if len(allErrs) != 0 || !borrowing {
+			return allErrs
+		}
+
+		// The project borrows from its parent, so the parent must have
+		// enough quota for the new allocation of the project.
+		want := ProjectAllocation(project)
+		for clusterName, wanted := range child.Want {
+			hard := want[clusterName].Hard
+			if hard == nil {
+				continue
+			}
+			for k, v := range wanted.Hard {
+				total := v.DeepCopy()
+				if used, ok := allocated[clusterName].Used[k]; ok {
+					total.Add(used)
+				}
+				if quantity, ok := hard[k]; !ok || quantity.Cmp(total) < 0 {
+					hard[k] = total
+				}
+			}
+		}
+		child = QuotaChild{
+			Project:   project.Name,
+			Want:      want,
+			Allocated: project.Spec.Clusters,
+		}
+
+		parent, err := getter.Project(ctx, project.Spec.ParentProjectName, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return allErrs
+			}
+			return append(allErrs, field.InternalError(fldPath, err))
+		}
+		project = parent
+	}
+	return allErrs
+}
+
+// borrowingLimit returns the quantity of the resource the project is allowed
+// to borrow from its parent in the cluster.
+func borrowingLimit(project *business.Project, clusterName, resourceName string) (apimachineryresource.Quantity, bool) {
+	if project.Spec.QuotaBorrowing == nil {
+		return apimachineryresource.Quantity{}, false
+	}
+	limit, ok := project.Spec.QuotaBorrowing.Limits[clusterName].Hard[resourceName]
+	return limit, ok
+}
+
+// BorrowingCapacity returns the quota of the project in the cluster plus the
+// quota it is allowed to borrow from its parent.
+func BorrowingCapacity(project *business.Project, clusterName string) business.ResourceList {
+	capacity := business.ResourceList{}
+	for k, v := range project.Spec.Clusters[clusterName].Hard {
+		quantity := v.DeepCopy()
+		if limit, ok := borrowingLimit(project, clusterName, k); ok && project.Spec.ParentProjectName != "" {
+			quantity.Add(limit)
+		}
+		capacity[k] = quantity
+	}
+	return capacity
+}
+
+func addClusterHard(sum business.ClusterUsed, delta business.ClusterHard) {
+	for clusterName, clusterHard := range delta {
+		clusterUsed, ok := sum[clusterName]
+		if !ok {
+			clusterUsed = business.UsedQuantity{Used: business.ResourceList{}}
+			sum[clusterName] = clusterUsed
+		}
+		for k, v := range clusterHard.Hard {
+			quantity := v.DeepCopy()
+			if used, ok := clusterUsed.Used[k]; ok {
+				quantity.Add(used)
+			}
+			clusterUsed.Used[k] = quantity
+		}
+	}
+}
+
+// BuildProjectQuotaNode returns the quota allocated and used at every level of
+// the tree of the project.
+func BuildProjectQuotaNode(ctx context.Context, project *business.Project, getter validation.BusinessObjectGetter) (business.ProjectQuotaNode, error) {
+	return buildProjectQuotaNode(ctx, project, getter, sets.NewString())
+}
+
+func buildProjectQuotaNode(ctx context.Context, project *business.Project, getter validation.BusinessObjectGetter, visited sets.String) (business.ProjectQuotaNode, error) {
+	visited.Insert(project.Name)
+	node := business.ProjectQuotaNode{
+		Name:        project.Name,
+		DisplayName: project.Spec.DisplayName,
+		Hard:        project.Spec.Clusters,
+		Borrowed:    business.ClusterUsed{},
+		Used:        business.ClusterUsed{},
+	}
+
+	allocated, err := ProjectAllocated(ctx, project, QuotaChild{}, getter)
+	if err != nil {
+		return node, err
+	}
+	node.Allocated = allocated
+	for clusterName, clusterHard := range project.Spec.Clusters {
+		for k, v := range clusterHard.Hard {
+			used, ok := allocated[clusterName].Used[k]
+			if !ok || used.Cmp(v) <= 0 {
+				continue
+			}
+			used.Sub(v)
+			addClusterHard(node.Borrowed, business.ClusterHard{clusterName: {Hard: business.ResourceList{k: used}}})
+		}
+	}
+
+	for _, name := range project.Status.CalculatedNamespaces {
+		namespace, err := getter.Namespace(ctx, project.Name, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return node, err
+		}
+		node.Namespaces = append(node.Namespaces, business.NamespaceQuotaNode{
+			Name:        namespace.Name,
+			ClusterName: namespace.Spec.ClusterName,
+			Hard:        namespace.Spec.Hard,
+			Used:        namespace.Status.Used,
+		})
+		addClusterHard(node.Used, business.ClusterHard{namespace.Spec.ClusterName: {Hard: namespace.Status.Used}})
+	}
+
+	for _, name := range project.Status.CalculatedChildProjects {
+		if visited.Has(name) {
+			continue
+		}
+		child, err := getter.Project(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return node, err
+		}
+		childNode, err := buildProjectQuotaNode(ctx, child, getter, visited)
+		if err != nil {
+			return node, err
+		}
+		for clusterName, clusterUsed := range childNode.Used {
+			addClusterHard(node.Used, business.ClusterHard{clusterName: {Hard: clusterUsed.Used}})
+		}
+		node.Children = append(node.Children, childNode)
+	}
+	return node, nil
+}
+
+// NormalizeClusterHard replaces the extended resources with the names they
+// are tracked by in the resource quota of the clusters.
+func NormalizeClusterHard(clusters business.ClusterHard) {
+	for _, clusterHard := range clusters {
+		resource.NormalizeQuotaResourceNames(clusterHard.Hard)
+	}
+}
+
+// NormalizeProjectQuota normalizes the resource names of the quota of the
+// project, the projects stored before the extended resources were tracked by
+// requests are normalized when they are read.
+func NormalizeProjectQuota(project *business.Project) {
+	NormalizeClusterHard(project.Spec.Clusters)
+	if project.Spec.QuotaBorrowing != nil {
+		NormalizeClusterHard(project.Spec.QuotaBorrowing.Limits)
+	}
+	NormalizeClusterHard(project.Status.CachedSpecClusters)
+	for _, clusterUsed := range project.Status.Clusters {
+		resource.NormalizeQuotaResourceNames(clusterUsed.Used)
+	}
+}
+
+// NormalizeNamespaceQuota normalizes the resource names of the quota of the
+// namespace, the namespaces stored before the extended resources were
+// tracked by requests are normalized when they are read.
+func NormalizeNamespaceQuota(namespace *business.Namespace) {
+	resource.NormalizeQuotaResourceNames(namespace.Spec.Hard)
+	resource.NormalizeQuotaResourceNames(namespace.Status.CachedSpecHard)
+}
@@ -0,0 +1,43 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// PodSecurityLabelPrefix is the prefix of the Pod Security admission
+	// labels of namespaces
+	PodSecurityLabelPrefix = "pod-security.kubernetes.io/"
+	// PodSecurityLevelPrivileged is the unrestricted Pod Security level
+	PodSecurityLevelPrivileged = "privileged"
+)
+
+// NamespaceTemplateClusterRoles are the cluster roles that namespace templates
+// are allowed to bind in the namespaces of projects.
+var NamespaceTemplateClusterRoles = sets.NewString("admin", "edit", "view")
+
+// IsPodSecurityLabel returns true if the label is a Pod Security admission
+// label, which is only set by the pod security of namespace templates.
+func IsPodSecurityLabel(key string) bool {
+	return strings.HasPrefix(key, PodSecurityLabelPrefix)
+}
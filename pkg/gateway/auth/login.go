@@ -24,13 +24,25 @@ import (
 	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
 	"net/http"
 	"net/url"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	utilnet "tkestack.io/tke/pkg/util/net"
 )
 
 const RedirectURIKey = "redirect_uri"
 
-// RedirectLogin to redirect the http request to login page.
+// RedirectLogin to redirect the http request to login page. The login starts
+// a session identified by the nonce, so that it can be revoked.
 func RedirectLogin(w http.ResponseWriter, r *http.Request, oauthConfig *oauth2.Config, disableOIDCProxy bool) {
-	oauthURL := oauthConfig.AuthCodeURL(r.URL.String(), oauth2.AccessTypeOffline)
+	var clientIP string
+	if ip := utilnet.ClientIP(r); ip != nil {
+		clientIP = ip.String()
+	}
+	nonce, err := oidc.NewSessionNonce(clientIP)
+	if err != nil {
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err), w)
+		return
+	}
+	oauthURL := oauthConfig.AuthCodeURL(r.URL.String(), oauth2.AccessTypeOffline, oauth2.SetAuthURLParam(oidc.NonceParam, nonce))
 	if !disableOIDCProxy {
 		originOAuthURL, err := url.Parse(oauthURL)
 		if err != nil {
@@ -94,6 +94,10 @@ func componentPrefix() map[moduleName][]modulePath {
 				prefix:    authapiserver.AuthPath,
 				protected: false,
 			},
+			modulePath{
+				prefix:    authapiserver.AuthzPath,
+				protected: true,
+			},
 			modulePath{
 				prefix:    fmt.Sprintf("%s/", authapiserver.APIKeyPasswordPath),
 				protected: false,
@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"time"
 	"tkestack.io/tke/pkg/monitor/storage"
+	"tkestack.io/tke/pkg/monitor/storage/project"
 	"tkestack.io/tke/pkg/util/log"
 )
 
@@ -50,6 +51,32 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 	c.stopCh = stopCh
 
 	go wait.Until(c.storage.Collect, 1*time.Minute, stopCh)
+	go untilAligned(c.storage.Snapshot, project.SnapshotPeriod, stopCh)
 
 	<-stopCh
 }
+
+// untilAligned runs f once, and then at every boundary of the period until
+// stopCh is closed, so that the runs do not drift from the boundaries.
+func untilAligned(f func(), period time.Duration, stopCh <-chan struct{}) {
+	for {
+		func() {
+			defer runtime.HandleCrash()
+			f()
+		}()
+
+		now := time.Now()
+		timer := time.NewTimer(nextBoundary(now, period).Sub(now))
+		select {
+		case <-stopCh:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// nextBoundary returns the first boundary of the period after now.
+func nextBoundary(now time.Time, period time.Duration) time.Time {
+	return now.Truncate(period).Add(period)
+}
@@ -0,0 +1,35 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package metric
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextBoundary(t *testing.T) {
+	now := time.Date(2021, 6, 1, 10, 59, 30, 0, time.UTC)
+	if next := nextBoundary(now, time.Hour); !next.Equal(time.Date(2021, 6, 1, 11, 0, 0, 0, time.UTC)) {
+		t.Errorf("nextBoundary() = %s, want 11:00", next)
+	}
+	now = time.Date(2021, 6, 1, 11, 0, 0, 0, time.UTC)
+	if next := nextBoundary(now, time.Hour); !next.Equal(time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("nextBoundary() on a boundary = %s, want 12:00", next)
+	}
+}
@@ -0,0 +1,115 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+
+	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/monitor"
+	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
+	"tkestack.io/tke/pkg/monitor/registry/projectusage"
+	"tkestack.io/tke/pkg/monitor/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Storage includes storage for project usage.
+type Storage struct {
+	ProjectUsage *REST
+}
+
+// NewStorage returns a Storage object that will work against project usage.
+func NewStorage(optsGetter genericregistry.RESTOptionsGetter) *Storage {
+	strategy := projectusage.NewStrategy()
+	store := &registry.Store{
+		NewFunc:                  func() runtime.Object { return &monitor.ProjectUsage{} },
+		NewListFunc:              func() runtime.Object { return &monitor.ProjectUsageList{} },
+		DefaultQualifiedResource: monitor.Resource("projectusages"),
+		PredicateFunc:            projectusage.MatchProjectUsage,
+
+		CreateStrategy: strategy,
+		DeleteStrategy: strategy,
+	}
+	store.TableConvertor = rest.NewDefaultTableConvertor(store.DefaultQualifiedResource)
+	options := &genericregistry.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    projectusage.GetAttrs,
+	}
+
+	if err := store.CompleteWithOptions(options); err != nil {
+		log.Panic("Failed to create project usage etcd rest storage", log.Err(err))
+	}
+
+	return &Storage{
+		ProjectUsage: &REST{store},
+	}
+}
+
+// ValidateGetObjectAndTenantID validate name and tenantID, if success return ProjectUsage
+func ValidateGetObjectAndTenantID(ctx context.Context, store *registry.Store, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := obj.(*monitor.ProjectUsage)
+	if err := util.FilterProjectUsage(ctx, usage); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+// REST implements a RESTStorage for project usage against etcd. The
+// snapshots are immutable once taken.
+type REST struct {
+	*registry.Store
+}
+
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of
+// short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"pu"}
+}
+
+// List selects resources in the storage which match to the selector. 'options' can be nil.
+func (r *REST) List(ctx context.Context, options *metainternal.ListOptions) (runtime.Object, error) {
+	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
+	return r.Store.List(ctx, wrappedOptions)
+}
+
+// Get finds a resource in the storage by name and returns it.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.Store, name, options)
+}
+
+// Delete enforces life-cycle rules for project usage termination
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Delete(ctx, name, deleteValidation, options)
+}
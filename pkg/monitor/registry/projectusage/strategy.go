@@ -0,0 +1,122 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package projectusage
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"tkestack.io/tke/api/monitor"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for project usage.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+var _ rest.RESTCreateStrategy = &Strategy{}
+var _ rest.RESTDeleteStrategy = &Strategy{}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating project usage objects.
+func NewStrategy() *Strategy {
+	return &Strategy{monitor.Scheme, namesutil.Generator}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// NamespaceScoped is false for project usages
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	usage, _ := obj.(*monitor.ProjectUsage)
+
+	if len(tenantID) != 0 {
+		usage.Spec.TenantID = tenantID
+	}
+
+	if usage.Name == "" && usage.GenerateName == "" {
+		usage.GenerateName = "pu-"
+	}
+}
+
+// Validate validates a new project usage.
+func (Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ValidateProjectUsage(obj.(*monitor.ProjectUsage))
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	usage, _ := obj.(*monitor.ProjectUsage)
+	return labels.Set(usage.ObjectMeta.Labels), ToSelectableFields(usage), nil
+}
+
+// MatchProjectUsage returns a generic matcher for a given label and field selector.
+func MatchProjectUsage(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"spec.tenantID",
+			"spec.projectName"},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(usage *monitor.ProjectUsage) fields.Set {
+	objectMetaFieldsSet := generic.ObjectMetaFieldsSet(&usage.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID":    usage.Spec.TenantID,
+		"spec.projectName": usage.Spec.ProjectName,
+	}
+	return generic.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
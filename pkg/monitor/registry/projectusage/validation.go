@@ -0,0 +1,47 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package projectusage
+
+import (
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/monitor"
+)
+
+// ValidateName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateName = apiMachineryValidation.NameIsDNSSubdomain
+
+// ValidateProjectUsage tests if required fields in the project usage are set.
+func ValidateProjectUsage(usage *monitor.ProjectUsage) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&usage.ObjectMeta, false, ValidateName, field.NewPath("metadata"))
+
+	fldSpec := field.NewPath("spec")
+	if len(usage.Spec.ProjectName) == 0 {
+		allErrs = append(allErrs, field.Required(fldSpec.Child("projectName"), "must specify a project name"))
+	}
+	if usage.Spec.Timestamp.IsZero() {
+		allErrs = append(allErrs, field.Required(fldSpec.Child("timestamp"), "must specify the time of the snapshot"))
+	}
+	if usage.Spec.Period.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldSpec.Child("period"), usage.Spec.Period.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
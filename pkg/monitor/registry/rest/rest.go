@@ -32,7 +32,9 @@ import (
 	configmapstorage "tkestack.io/tke/pkg/monitor/registry/configmap/storage"
 	metricstorage "tkestack.io/tke/pkg/monitor/registry/metric/storage"
 	clusteroverview "tkestack.io/tke/pkg/monitor/registry/overview/cluster/storage"
+	projectusagestorage "tkestack.io/tke/pkg/monitor/registry/projectusage/storage"
 	promstorage "tkestack.io/tke/pkg/monitor/registry/prometheus/storage"
+	usagereportstorage "tkestack.io/tke/pkg/monitor/registry/usagereport/storage"
 	monitorstorage "tkestack.io/tke/pkg/monitor/storage"
 	"tkestack.io/tke/pkg/monitor/util/cache"
 )
@@ -83,6 +85,12 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		promREST := promstorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
 		storageMap["prometheuses"] = promREST.Prometheus
 		storageMap["prometheuses/status"] = promREST.Status
+
+		projectUsageREST := projectusagestorage.NewStorage(restOptionsGetter)
+		storageMap["projectusages"] = projectUsageREST.ProjectUsage
+
+		usageReportREST := usagereportstorage.NewStorage(restOptionsGetter, projectUsageREST.ProjectUsage, configMapREST.ConfigMap)
+		storageMap["usagereports"] = usageReportREST.UsageReport
 	}
 
 	return storageMap
@@ -0,0 +1,335 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/monitor"
+)
+
+// PriceConfigMapName is the name of the monitor config map holding the unit
+// prices of the resources per hour, keyed by the resource name.
+const PriceConfigMapName = "chargeback"
+
+// Storage includes storage for usage reports and all sub resources.
+type Storage struct {
+	UsageReport *REST
+}
+
+// NewStorage returns a Storage object that will work against usage reports.
+func NewStorage(_ genericregistry.RESTOptionsGetter, projectUsageLister rest.Lister, configMapGetter rest.Getter) *Storage {
+	return &Storage{
+		UsageReport: &REST{
+			projectUsageLister: projectUsageLister,
+			configMapGetter:    configMapGetter,
+		},
+	}
+}
+
+// REST implements a RESTStorage for usage reports aggregated from the project
+// usage snapshots.
+type REST struct {
+	rest.Storage
+	projectUsageLister rest.Lister
+	configMapGetter    rest.Getter
+}
+
+var _ rest.ShortNamesProvider = &REST{}
+var _ rest.Creater = &REST{}
+var _ rest.Scoper = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"ur"}
+}
+
+// NamespaceScoped returns true if the storage is namespaced
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
+
+// New returns an empty object that can be used with Create and Update after request data has been put into it.
+func (r *REST) New() runtime.Object {
+	return &monitor.UsageReport{}
+}
+
+// NewList returns an empty object that can be used with the List call.
+func (r *REST) NewList() runtime.Object {
+	return &monitor.UsageReportList{}
+}
+
+// Create aggregates the project usage snapshots in the time range of the
+// query and returns the report.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	report, ok := obj.(*monitor.UsageReport)
+	if !ok {
+		return nil, errors.NewBadRequest("failed to processed request body")
+	}
+	if allErrs := validateUsageReportQuery(&report.Query); len(allErrs) > 0 {
+		return nil, errors.NewInvalid(monitor.Kind("UsageReport"), report.Name, allErrs)
+	}
+
+	prices, err := r.prices(ctx, report.Query.Prices)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := fields.Everything()
+	if report.Query.ProjectName != "" {
+		selector = fields.OneTermEqualSelector("spec.projectName", report.Query.ProjectName)
+	}
+	obj, err = r.projectUsageLister.List(ctx, &metainternal.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	usages := obj.(*monitor.ProjectUsageList)
+
+	result := aggregate(&report.Query, usages.Items, prices)
+	if report.Query.Format == monitor.UsageReportFormatCSV {
+		result.CSV, err = exportCSV(result)
+		if err != nil {
+			return nil, errors.NewInternalError(err)
+		}
+	}
+
+	return &monitor.UsageReport{
+		Query:  report.Query,
+		Result: result,
+	}, nil
+}
+
+// List selects resources in the storage which match to the selector. 'options' can be nil.
+func (r *REST) List(ctx context.Context, options *metainternal.ListOptions) (runtime.Object, error) {
+	return &monitor.UsageReportList{
+		Items: make([]monitor.UsageReport, 0),
+	}, nil
+}
+
+// Get finds a resource in the storage by name and returns it.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return nil, errors.NewNotFound(monitor.Resource("usagereport"), name)
+}
+
+// prices returns the configured unit prices overridden by the prices of the query.
+func (r *REST) prices(ctx context.Context, overrides map[string]string) (map[string]float64, error) {
+	raw := make(map[string]string)
+	obj, err := r.configMapGetter.Get(ctx, PriceConfigMapName, &metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		for k, v := range obj.(*monitor.ConfigMap).Data {
+			raw[k] = v
+		}
+	}
+	for k, v := range overrides {
+		raw[k] = v
+	}
+
+	prices := make(map[string]float64, len(raw))
+	for k, v := range raw {
+		price, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil || price < 0 {
+			return nil, errors.NewBadRequest(fmt.Sprintf("invalid price %q of resource %s", v, k))
+		}
+		prices[k] = price
+	}
+	return prices, nil
+}
+
+func validateUsageReportQuery(query *monitor.UsageReportQuery) field.ErrorList {
+	var allErrs field.ErrorList
+	fldPath := field.NewPath("query")
+
+	if query.StartTime.IsZero() {
+		allErrs = append(allErrs, field.Required(fldPath.Child("startTime"), "must specify the start time"))
+	}
+	if query.EndTime.IsZero() {
+		allErrs = append(allErrs, field.Required(fldPath.Child("endTime"), "must specify the end time"))
+	} else if !query.EndTime.After(query.StartTime.Time) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("endTime"), query.EndTime, "must be after the start time"))
+	}
+
+	switch query.GroupBy {
+	case monitor.UsageReportGroupByProject, monitor.UsageReportGroupByCluster, monitor.UsageReportGroupByTenant:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("groupBy"), query.GroupBy, []string{
+			string(monitor.UsageReportGroupByProject),
+			string(monitor.UsageReportGroupByCluster),
+			string(monitor.UsageReportGroupByTenant),
+		}))
+	}
+	switch query.ChargeBy {
+	case monitor.UsageReportChargeByAllocated, monitor.UsageReportChargeByUsed:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("chargeBy"), query.ChargeBy, []string{
+			string(monitor.UsageReportChargeByAllocated),
+			string(monitor.UsageReportChargeByUsed),
+		}))
+	}
+	switch query.Format {
+	case monitor.UsageReportFormatJSON, monitor.UsageReportFormatCSV:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("format"), query.Format, []string{
+			string(monitor.UsageReportFormatJSON),
+			string(monitor.UsageReportFormatCSV),
+		}))
+	}
+
+	return allErrs
+}
+
+type unitHours struct {
+	allocated float64
+	used      float64
+}
+
+// aggregate sums up the unit hours of the snapshots in the time range by the
+// group of the query, every snapshot stands for its period.
+func aggregate(query *monitor.UsageReportQuery, usages []monitor.ProjectUsage, prices map[string]float64) *monitor.UsageReportResult {
+	groups := make(map[string]map[string]*unitHours)
+	for _, usage := range usages {
+		if usage.Spec.Timestamp.Before(&query.StartTime) || !usage.Spec.Timestamp.Before(&query.EndTime) {
+			continue
+		}
+		hours := usage.Spec.Period.Hours()
+		for _, cluster := range usage.Spec.Clusters {
+			if query.ClusterName != "" && cluster.ClusterName != query.ClusterName {
+				continue
+			}
+
+			var name string
+			switch query.GroupBy {
+			case monitor.UsageReportGroupByCluster:
+				name = cluster.ClusterName
+			case monitor.UsageReportGroupByTenant:
+				name = usage.Spec.TenantID
+			default:
+				name = usage.Spec.ProjectName
+			}
+			group, ok := groups[name]
+			if !ok {
+				group = make(map[string]*unitHours)
+				groups[name] = group
+			}
+
+			for k, v := range cluster.Allocated {
+				resourceHours(group, k).allocated += unitValue(k, v) * hours
+			}
+			for k, v := range cluster.Used {
+				resourceHours(group, k).used += unitValue(k, v) * hours
+			}
+		}
+	}
+
+	result := &monitor.UsageReportResult{}
+	for name, group := range groups {
+		item := monitor.UsageReportItem{Name: name}
+		for k, v := range group {
+			res := monitor.UsageReportResource{
+				Resource:       k,
+				AllocatedHours: v.allocated,
+				UsedHours:      v.used,
+				Price:          prices[k],
+			}
+			if query.ChargeBy == monitor.UsageReportChargeByUsed {
+				res.Cost = res.UsedHours * res.Price
+			} else {
+				res.Cost = res.AllocatedHours * res.Price
+			}
+			item.Cost += res.Cost
+			item.Resources = append(item.Resources, res)
+		}
+		sort.Slice(item.Resources, func(i, j int) bool {
+			return item.Resources[i].Resource < item.Resources[j].Resource
+		})
+		result.TotalCost += item.Cost
+		result.Items = append(result.Items, item)
+	}
+	sort.Slice(result.Items, func(i, j int) bool {
+		return result.Items[i].Name < result.Items[j].Name
+	})
+
+	return result
+}
+
+func resourceHours(group map[string]*unitHours, name string) *unitHours {
+	h, ok := group[name]
+	if !ok {
+		h = &unitHours{}
+		group[name] = h
+	}
+	return h
+}
+
+// unitValue returns the value of the quantity in the unit the resource is
+// priced in, a core for cpu, a GiB for memory and storage.
+func unitValue(name string, q resource.Quantity) float64 {
+	switch {
+	case strings.Contains(name, "cpu"):
+		return float64(q.MilliValue()) / 1000
+	case strings.Contains(name, "memory"), strings.Contains(name, "storage"):
+		return float64(q.Value()) / (1 << 30)
+	default:
+		return q.AsApproximateFloat64()
+	}
+}
+
+func exportCSV(result *monitor.UsageReportResult) (string, error) {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	if err := w.Write([]string{"name", "resource", "allocatedHours", "usedHours", "price", "cost"}); err != nil {
+		return "", err
+	}
+	for _, item := range result.Items {
+		for _, res := range item.Resources {
+			if err := w.Write([]string{
+				item.Name,
+				res.Resource,
+				strconv.FormatFloat(res.AllocatedHours, 'f', 4, 64),
+				strconv.FormatFloat(res.UsedHours, 'f', 4, 64),
+				strconv.FormatFloat(res.Price, 'f', 4, 64),
+				strconv.FormatFloat(res.Cost, 'f', 4, 64),
+			}); err != nil {
+				return "", err
+			}
+		}
+	}
+	if err := w.Write([]string{"total", "", "", "", "", strconv.FormatFloat(result.TotalCost, 'f', 4, 64)}); err != nil {
+		return "", err
+	}
+	w.Flush()
+	return buf.String(), w.Error()
+}
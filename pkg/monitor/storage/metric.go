@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	businessclient "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	monitorclient "tkestack.io/tke/api/client/clientset/versioned/typed/monitor/v1"
 	"tkestack.io/tke/api/monitor"
 	monitorconfig "tkestack.io/tke/pkg/monitor/apis/config"
 	esmetric "tkestack.io/tke/pkg/monitor/storage/es/metric"
@@ -37,6 +38,7 @@ type MetricStorage interface {
 
 type ProjectStorage interface {
 	Collect()
+	Snapshot()
 }
 
 func NewMetricStorage(storageConfig *monitorconfig.Storage) (MetricStorage, error) {
@@ -50,6 +52,6 @@ func NewMetricStorage(storageConfig *monitorconfig.Storage) (MetricStorage, erro
 	return nil, fmt.Errorf("unregistered metric data storage type")
 }
 
-func NewProjectStorage(storageConfig *monitorconfig.Storage, businessClient businessclient.BusinessV1Interface) (ProjectStorage, error) {
-	return project.NewStorage(businessClient)
+func NewProjectStorage(storageConfig *monitorconfig.Storage, businessClient businessclient.BusinessV1Interface, monitorClient monitorclient.MonitorV1Interface) (ProjectStorage, error) {
+	return project.NewStorage(businessClient, monitorClient)
 }
@@ -31,6 +31,7 @@ var (
 		"memory",
 		"configmaps",
 		"ephemeral_storage",
+		"storage",
 		"persistentvolumeclaims",
 		"pods",
 		"resourcequotas",
@@ -41,6 +42,22 @@ var (
 	}
 )
 
+var (
+	// projectExtendedMetricsMap holds the metrics of the resources whose
+	// names are not known in advance, such as the extended resources, the
+	// object counts and the storage classes, labeled by the resource name.
+	projectExtendedMetricsMap = map[string]*prometheus.GaugeVec{}
+	extendedMetricLabelNames  = map[string][]string{
+		"project_capacity":            {"project_name"},
+		"project_capacity_cluster":    {"project_name"},
+		"project_allocated":           {"project_name"},
+		"project_cluster_capacity":    {"project_name", "cluster_name"},
+		"project_cluster_allocated":   {"project_name", "cluster_name"},
+		"project_namespace_capacity":  {"project_name", "cluster_name", "namespace", "namespace_name"},
+		"project_namespace_allocated": {"project_name", "cluster_name", "namespace", "namespace_name"},
+	}
+)
+
 func init() {
 	for _, resource := range resources {
 		prefix := "project_capacity"
@@ -99,4 +116,12 @@ func init() {
 		prometheus.MustRegister(metric)
 		projectMetricsMap[name] = metric
 	}
+
+	for prefix, labelNames := range extendedMetricLabelNames {
+		name := fmt.Sprintf("%s_extended_resource", prefix)
+		help := fmt.Sprintf("%s of the extended resources, object counts and storage classes.", prefix)
+		metric := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: name, Help: help}, append(labelNames, "resource"))
+		prometheus.MustRegister(metric)
+		projectExtendedMetricsMap[prefix] = metric
+	}
 }
@@ -130,8 +130,13 @@ func (s *Storage) Collect() {
 
 func updateMetrics(tags map[string]string, resourcePrefix string, resources businessv1.ResourceList) {
 	fullNameResources := businessv1.ResourceList{}
+	extendedResources := businessv1.ResourceList{}
 	for r, v := range resources {
 		metricName := fmt.Sprintf("%s_%s", resourcePrefix, resourceutil.ResourceNameTranslate(r))
+		if _, ok := projectMetricsMap[metricName]; !ok {
+			extendedResources[r] = v
+			continue
+		}
 		if old, ok := fullNameResources[metricName]; ok {
 			if v.Cmp(old) == 1 {
 				fullNameResources[metricName] = v
@@ -147,4 +152,17 @@ func updateMetrics(tags map[string]string, resourcePrefix string, resources busi
 			metric.With(tags).Set(float64(v.MilliValue()) / 1000)
 		}
 	}
+
+	metric, ok := projectExtendedMetricsMap[resourcePrefix]
+	if !ok {
+		return
+	}
+	for r, v := range extendedResources {
+		resourceTags := map[string]string{"resource": r}
+		for k, t := range tags {
+			resourceTags[k] = t
+		}
+		log.Debugf("metricName: %s_extended_resource, tags: %s", resourcePrefix, resourceTags)
+		metric.With(resourceTags).Set(float64(v.MilliValue()) / 1000)
+	}
 }
@@ -0,0 +1,301 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	businessv1 "tkestack.io/tke/api/business/v1"
+	monitorv1 "tkestack.io/tke/api/monitor/v1"
+	resourceutil "tkestack.io/tke/pkg/monitor/storage/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// SnapshotPeriod is the interval between two project usage snapshots.
+	SnapshotPeriod = time.Hour
+	// SnapshotRetention is how long the project usage snapshots are kept.
+	SnapshotRetention = 180 * 24 * time.Hour
+	// RollupAge is the age after which the hourly snapshots of a day are
+	// rolled up into a daily snapshot, so that a project keeps a week of
+	// hourly snapshots and daily snapshots for the rest of the retention.
+	RollupAge = 7 * 24 * time.Hour
+	// RollupPeriod is the period of the rolled up snapshots.
+	RollupPeriod = 24 * time.Hour
+
+	// listPageSize is the number of snapshots listed per request.
+	listPageSize = 500
+)
+
+// Snapshot persists the allocation and usage of every project per cluster
+// as project usage, and prunes the snapshots out of retention.
+func (s *Storage) Snapshot() {
+	if s.monitorClient == nil {
+		return
+	}
+
+	ctx := context.Background()
+	projectList, err := s.businessClient.Projects().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Errorf("List project failed: %v", err)
+		return
+	}
+
+	now := time.Now().Truncate(SnapshotPeriod)
+	for _, pro := range projectList.Items {
+		namespaceList, err := s.businessClient.Namespaces(pro.Name).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			log.Errorf("Get(%s) namespace list failed: %v", pro.Name, err)
+			continue
+		}
+
+		usage := &monitorv1.ProjectUsage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("%s-%d", pro.Name, now.Unix()),
+			},
+			Spec: monitorv1.ProjectUsageSpec{
+				TenantID:    pro.Spec.TenantID,
+				ProjectName: pro.Name,
+				Timestamp:   metav1.NewTime(now),
+				Period:      metav1.Duration{Duration: SnapshotPeriod},
+				Clusters:    clusterUsages(&pro, namespaceList.Items),
+			},
+		}
+		if _, err := s.monitorClient.ProjectUsages().Create(ctx, usage, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+			log.Errorf("Create project usage of project(%s) failed: %v", pro.Name, err)
+		}
+	}
+
+	s.compact(ctx, now)
+}
+
+// compact deletes the snapshots out of retention, and rolls up the hourly
+// snapshots older than RollupAge into daily snapshots. The snapshots of all
+// the projects are listed page by page once per run.
+func (s *Storage) compact(ctx context.Context, now time.Time) {
+	expireBefore := now.Add(-SnapshotRetention)
+	rollupBefore := now.Add(-RollupAge).Truncate(RollupPeriod)
+	days := map[string][]monitorv1.ProjectUsage{}
+
+	options := metav1.ListOptions{Limit: listPageSize}
+	for {
+		usageList, err := s.monitorClient.ProjectUsages().List(ctx, options)
+		if err != nil {
+			log.Errorf("List project usage failed: %v", err)
+			return
+		}
+		for _, usage := range usageList.Items {
+			timestamp := usage.Spec.Timestamp.Time
+			switch {
+			case timestamp.Before(expireBefore):
+				s.deleteUsage(ctx, usage.Name)
+			case timestamp.Before(rollupBefore) && usage.Spec.Period.Duration < RollupPeriod:
+				key := rollupName(usage.Spec.ProjectName, timestamp.Truncate(RollupPeriod))
+				days[key] = append(days[key], usage)
+			}
+		}
+		if usageList.Continue == "" {
+			break
+		}
+		options.Continue = usageList.Continue
+	}
+
+	for name, usages := range days {
+		// The hourly snapshots are only deleted once the daily snapshot
+		// exists, so that a rollup interrupted is completed by the next run.
+		rollup := rollupUsages(name, usages)
+		if _, err := s.monitorClient.ProjectUsages().Create(ctx, rollup, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+			log.Errorf("Create project usage(%s) failed: %v", name, err)
+			continue
+		}
+		for _, usage := range usages {
+			s.deleteUsage(ctx, usage.Name)
+		}
+	}
+}
+
+func (s *Storage) deleteUsage(ctx context.Context, name string) {
+	if err := s.monitorClient.ProjectUsages().Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		log.Errorf("Delete project usage(%s) failed: %v", name, err)
+	}
+}
+
+// rollupName returns the name of the daily snapshot of the project, which does
+// not collide with the hourly snapshot at the start of the day.
+func rollupName(projectName string, day time.Time) string {
+	return fmt.Sprintf("%s-%d-daily", projectName, day.Unix())
+}
+
+// rollupUsages rolls up the snapshots of a day of a project into a daily
+// snapshot. The quantities are averaged over the day weighted by the periods
+// of the snapshots, so the unit hours of the daily snapshot equal the sum of
+// the unit hours of the snapshots.
+func rollupUsages(name string, usages []monitorv1.ProjectUsage) *monitorv1.ProjectUsage {
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].Spec.Timestamp.Before(&usages[j].Spec.Timestamp)
+	})
+	last := usages[len(usages)-1]
+	day := usages[0].Spec.Timestamp.Time.Truncate(RollupPeriod)
+
+	var clusterNames []string
+	clusters := map[string]*clusterRollup{}
+	for _, usage := range usages {
+		weight := usage.Spec.Period.Seconds() / RollupPeriod.Seconds()
+		for _, cluster := range usage.Spec.Clusters {
+			c, ok := clusters[cluster.ClusterName]
+			if !ok {
+				c = &clusterRollup{namespaces: map[string]*namespaceRollup{}}
+				clusters[cluster.ClusterName] = c
+				clusterNames = append(clusterNames, cluster.ClusterName)
+			}
+			c.hard.add(cluster.Hard, weight)
+			c.allocated.add(cluster.Allocated, weight)
+			c.used.add(cluster.Used, weight)
+			for _, ns := range cluster.Namespaces {
+				n, ok := c.namespaces[ns.Namespace]
+				if !ok {
+					n = &namespaceRollup{}
+					c.namespaces[ns.Namespace] = n
+					c.namespaceNames = append(c.namespaceNames, ns.Namespace)
+				}
+				n.allocated.add(ns.Allocated, weight)
+				n.used.add(ns.Used, weight)
+			}
+		}
+	}
+
+	sort.Strings(clusterNames)
+	var clusterUsages []monitorv1.ClusterUsage
+	for _, clusterName := range clusterNames {
+		c := clusters[clusterName]
+		clusterUsage := monitorv1.ClusterUsage{
+			ClusterName: clusterName,
+			Hard:        c.hard.list(),
+			Allocated:   c.allocated.list(),
+			Used:        c.used.list(),
+		}
+		sort.Strings(c.namespaceNames)
+		for _, namespace := range c.namespaceNames {
+			n := c.namespaces[namespace]
+			clusterUsage.Namespaces = append(clusterUsage.Namespaces, monitorv1.NamespaceUsage{
+				Namespace: namespace,
+				Allocated: n.allocated.list(),
+				Used:      n.used.list(),
+			})
+		}
+		clusterUsages = append(clusterUsages, clusterUsage)
+	}
+
+	return &monitorv1.ProjectUsage{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: monitorv1.ProjectUsageSpec{
+			TenantID:    last.Spec.TenantID,
+			ProjectName: last.Spec.ProjectName,
+			Timestamp:   metav1.NewTime(day),
+			Period:      metav1.Duration{Duration: RollupPeriod},
+			Clusters:    clusterUsages,
+		},
+	}
+}
+
+type clusterRollup struct {
+	hard, allocated, used weightedResources
+	namespaceNames        []string
+	namespaces            map[string]*namespaceRollup
+}
+
+type namespaceRollup struct {
+	allocated, used weightedResources
+}
+
+// weightedResources sums up the milli values of the resources multiplied by
+// their weights.
+type weightedResources map[string]*weightedQuantity
+
+type weightedQuantity struct {
+	milli  float64
+	format resource.Format
+}
+
+func (w *weightedResources) add(resources monitorv1.ResourceList, weight float64) {
+	if *w == nil {
+		*w = weightedResources{}
+	}
+	for k, v := range resources {
+		q, ok := (*w)[k]
+		if !ok {
+			q = &weightedQuantity{format: v.Format}
+			(*w)[k] = q
+		}
+		q.milli += float64(v.MilliValue()) * weight
+	}
+}
+
+func (w weightedResources) list() monitorv1.ResourceList {
+	if len(w) == 0 {
+		return nil
+	}
+	resources := monitorv1.ResourceList{}
+	for k, q := range w {
+		resources[k] = *resource.NewMilliQuantity(int64(math.Round(q.milli)), q.format)
+	}
+	return resources
+}
+
+func clusterUsages(pro *businessv1.Project, namespaces []businessv1.Namespace) []monitorv1.ClusterUsage {
+	var clusterNames []string
+	clusters := map[string]*monitorv1.ClusterUsage{}
+	cluster := func(name string) *monitorv1.ClusterUsage {
+		c, ok := clusters[name]
+		if !ok {
+			c = &monitorv1.ClusterUsage{ClusterName: name}
+			clusters[name] = c
+			clusterNames = append(clusterNames, name)
+		}
+		return c
+	}
+
+	for name, clusterHard := range pro.Spec.Clusters {
+		cluster(name).Hard = monitorv1.ResourceList(clusterHard.Hard)
+	}
+	for _, ns := range namespaces {
+		c := cluster(ns.Spec.ClusterName)
+		c.Allocated = monitorv1.ResourceList(resourceutil.ResourceAdd(businessv1.ResourceList(c.Allocated), ns.Spec.Hard))
+		c.Used = monitorv1.ResourceList(resourceutil.ResourceAdd(businessv1.ResourceList(c.Used), ns.Status.Used))
+		c.Namespaces = append(c.Namespaces, monitorv1.NamespaceUsage{
+			Namespace: ns.Spec.Namespace,
+			Allocated: monitorv1.ResourceList(ns.Spec.Hard),
+			Used:      monitorv1.ResourceList(ns.Status.Used),
+		})
+	}
+
+	sort.Strings(clusterNames)
+	var usages []monitorv1.ClusterUsage
+	for _, name := range clusterNames {
+		usages = append(usages, *clusters[name])
+	}
+	return usages
+}
@@ -0,0 +1,112 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package project
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	monitorv1 "tkestack.io/tke/api/monitor/v1"
+)
+
+func newUsage(timestamp time.Time, period time.Duration, cpu string) *monitorv1.ProjectUsage {
+	return &monitorv1.ProjectUsage{
+		ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("prj-%d", timestamp.Unix())},
+		Spec: monitorv1.ProjectUsageSpec{
+			ProjectName: "prj",
+			Timestamp:   metav1.NewTime(timestamp),
+			Period:      metav1.Duration{Duration: period},
+			Clusters: []monitorv1.ClusterUsage{{
+				ClusterName: "cls",
+				Allocated:   monitorv1.ResourceList{"requests.cpu": resource.MustParse(cpu)},
+				Namespaces: []monitorv1.NamespaceUsage{{
+					Namespace: "ns",
+					Allocated: monitorv1.ResourceList{"requests.cpu": resource.MustParse(cpu)},
+				}},
+			}},
+		},
+	}
+}
+
+func TestCompact(t *testing.T) {
+	now := time.Now().Truncate(SnapshotPeriod)
+	day := now.Add(-10 * 24 * time.Hour).Truncate(RollupPeriod)
+	var objects []runtime.Object
+	// 12 hours of 2 cores and 12 hours of 4 cores, 72 core hours.
+	for i := 0; i < 24; i++ {
+		cpu := "2"
+		if i >= 12 {
+			cpu = "4"
+		}
+		objects = append(objects, newUsage(day.Add(time.Duration(i)*time.Hour), time.Hour, cpu))
+	}
+	expired := newUsage(now.Add(-SnapshotRetention-time.Hour), time.Hour, "1")
+	recent := newUsage(now.Add(-time.Hour), time.Hour, "1")
+	objects = append(objects, expired, recent)
+
+	client := fake.NewSimpleClientset(objects...)
+	s := &Storage{monitorClient: client.MonitorV1()}
+	s.compact(context.Background(), now)
+
+	usageList, err := client.MonitorV1().ProjectUsages().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := map[string]monitorv1.ProjectUsage{}
+	for _, usage := range usageList.Items {
+		names[usage.Name] = usage
+	}
+	if len(names) != 2 {
+		t.Errorf("%d snapshots are kept, want the recent and the daily one", len(names))
+	}
+	if _, ok := names[recent.Name]; !ok {
+		t.Error("recent snapshot is deleted")
+	}
+	rollup, ok := names[rollupName("prj", day)]
+	if !ok {
+		t.Fatal("daily snapshot is not created")
+	}
+	if rollup.Spec.Period.Duration != RollupPeriod || !rollup.Spec.Timestamp.Time.Equal(day) {
+		t.Errorf("daily snapshot stands for %s from %s", rollup.Spec.Period.Duration, rollup.Spec.Timestamp)
+	}
+	cluster := rollup.Spec.Clusters[0]
+	allocated := cluster.Allocated["requests.cpu"]
+	if hours := float64(allocated.MilliValue()) / 1000 * RollupPeriod.Hours(); hours != 72 {
+		t.Errorf("daily snapshot has %v core hours, want 72", hours)
+	}
+	if ns := cluster.Namespaces[0].Allocated["requests.cpu"]; ns.Cmp(allocated) != 0 {
+		t.Errorf("namespace allocation %s, want %s", ns.String(), allocated.String())
+	}
+
+	// the rollup is not repeated.
+	s.compact(context.Background(), now)
+	usageList, err = client.MonitorV1().ProjectUsages().List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(usageList.Items) != 2 {
+		t.Errorf("%d snapshots are kept after compacting again, want 2", len(usageList.Items))
+	}
+}
@@ -20,12 +20,14 @@ package project
 
 import (
 	businessclient "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	monitorclient "tkestack.io/tke/api/client/clientset/versioned/typed/monitor/v1"
 )
 
 type Storage struct {
 	businessClient businessclient.BusinessV1Interface
+	monitorClient  monitorclient.MonitorV1Interface
 }
 
-func NewStorage(businessClient businessclient.BusinessV1Interface) (*Storage, error) {
-	return &Storage{businessClient: businessClient}, nil
+func NewStorage(businessClient businessclient.BusinessV1Interface, monitorClient monitorclient.MonitorV1Interface) (*Storage, error) {
+	return &Storage{businessClient: businessClient, monitorClient: monitorClient}, nil
 }
@@ -39,3 +39,16 @@ func FilterPrometheus(ctx context.Context, prom *monitor.Prometheus) error {
 	}
 	return nil
 }
+
+// FilterProjectUsage is used to filter project usage that do not belong
+// to the tenant.
+func FilterProjectUsage(ctx context.Context, usage *monitor.ProjectUsage) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if usage.Spec.TenantID != tenantID {
+		return errors.NewNotFound(monitor.Resource("projectusage"), usage.ObjectMeta.Name)
+	}
+	return nil
+}
@@ -18,7 +18,21 @@
 
 package config
 
+import "time"
+
 // RegistryDefaultConfiguration contains options to default set.
 type RegistryDefaultConfiguration struct {
 	DefaultSystemChartGroups []string
+	// VulnerabilityDatabaseDir is the directory of the offline vulnerability
+	// database, images are not scanned if empty.
+	VulnerabilityDatabaseDir string
+	// GarbageCollectionPeriod is the period between two garbage collections
+	// of the unreferenced blobs, blobs are never collected if zero.
+	GarbageCollectionPeriod time.Duration
+	// GarbageCollectionGracePeriod is the minimum age of the blobs and
+	// untagged manifests collected.
+	GarbageCollectionGracePeriod time.Duration
+	// CAFile is the certificate authority that signs the certificate of the
+	// registry, the system roots are used if empty.
+	CAFile string
 }
@@ -0,0 +1,327 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package garbagecollector
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/manifest/manifestlist"
+	"github.com/docker/distribution/reference"
+	"github.com/docker/distribution/registry/storage"
+	storagedriver "github.com/docker/distribution/registry/storage/driver"
+	"github.com/opencontainers/go-digest"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// rootPath is the root of the registry data in the storage driver.
+const rootPath = "/docker/registry/v2"
+
+// GarbageCollector is responsible for deleting the untagged manifests and the
+// blobs referenced by no manifest from the storage of the registry while it
+// keeps serving.
+//
+// The images being pushed are not referenced by a tag yet, so only the
+// manifests and blobs older than the grace period are deleted. Since the
+// images may be tagged or pushed again after they are marked, the manifests
+// and blobs are checked again right before they are deleted.
+type GarbageCollector struct {
+	registry    distribution.Namespace
+	driver      storagedriver.StorageDriver
+	gracePeriod time.Duration
+}
+
+// untaggedManifests are the manifests of a repository referenced by no tag.
+type untaggedManifests struct {
+	repository string
+	digests    []digest.Digest
+}
+
+// NewGarbageCollector creates a new GarbageCollector object.
+func NewGarbageCollector(registry distribution.Namespace, driver storagedriver.StorageDriver, gracePeriod time.Duration) *GarbageCollector {
+	return &GarbageCollector{
+		registry:    registry,
+		driver:      driver,
+		gracePeriod: gracePeriod,
+	}
+}
+
+// Run collects the garbage every period until stopCh is closed.
+func (gc *GarbageCollector) Run(period time.Duration, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+
+	log.Info("Starting registry garbage collector")
+	defer log.Info("Shutting down registry garbage collector")
+
+	wait.Until(func() {
+		if err := gc.Collect(context.Background()); err != nil {
+			log.Error("Failed to collect the garbage of registry", log.Err(err))
+		}
+	}, period, stopCh)
+}
+
+// Collect marks the manifests and blobs referenced by the tags of all
+// repositories, then deletes the unmarked ones. Nothing is deleted if the
+// marking fails.
+func (gc *GarbageCollector) Collect(ctx context.Context) error {
+	startTime := time.Now()
+	marked := make(map[digest.Digest]bool)
+	untagged, err := gc.mark(ctx, marked)
+	if err != nil {
+		return fmt.Errorf("failed to mark: %v", err)
+	}
+	manifests, blobs, err := gc.sweep(ctx, marked, untagged)
+	if err != nil {
+		return err
+	}
+	log.Info("Finished collecting the garbage of registry", log.Int("manifests", manifests),
+		log.Int("blobs", blobs), log.Duration("processTime", time.Since(startTime)))
+	return nil
+}
+
+// sweep deletes the untagged manifests and the unmarked blobs that are still
+// unused, and returns the numbers of the deleted manifests and blobs.
+func (gc *GarbageCollector) sweep(ctx context.Context, marked map[digest.Digest]bool, untagged []untaggedManifests) (int, int, error) {
+	vacuum := storage.NewVacuum(ctx, gc.driver)
+	deletedManifests := 0
+	for _, repository := range untagged {
+		// the manifests tagged or pushed since the repository was marked
+		// are marked now
+		tags, _, err := gc.markRepository(ctx, repository.repository, marked)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to mark repository %s: %v", repository.repository, err)
+		}
+		for _, dgst := range repository.digests {
+			if marked[dgst] || gc.recent(ctx, manifestRevisionPath(repository.repository, dgst)) {
+				continue
+			}
+			if err := vacuum.RemoveManifest(repository.repository, dgst, tags); err != nil {
+				return 0, 0, fmt.Errorf("failed to delete manifest %s@%s: %v", repository.repository, dgst, err)
+			}
+			deletedManifests++
+		}
+	}
+
+	var blobs []digest.Digest
+	err := gc.registry.Blobs().Enumerate(ctx, func(dgst digest.Digest) error {
+		if !marked[dgst] && !gc.recent(ctx, blobDataPath(dgst)) {
+			blobs = append(blobs, dgst)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to enumerate blobs: %v", err)
+	}
+	repositories, err := gc.repositories(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to enumerate repositories: %v", err)
+	}
+	deletedBlobs := 0
+	for _, dgst := range blobs {
+		used, err := gc.blobUsed(ctx, repositories, dgst, marked)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to check blob %s: %v", dgst, err)
+		}
+		if used || gc.recent(ctx, blobDataPath(dgst)) {
+			continue
+		}
+		if err := vacuum.RemoveBlob(dgst.String()); err != nil {
+			return 0, 0, fmt.Errorf("failed to delete blob %s: %v", dgst, err)
+		}
+		deletedBlobs++
+	}
+	return deletedManifests, deletedBlobs, nil
+}
+
+// blobUsed checks the blob again right before it is deleted. The blob is used
+// if a repository linked it within the grace period, or pushed a manifest
+// referencing it since it was marked. A manifest can only reference the
+// blobs linked to its repository.
+func (gc *GarbageCollector) blobUsed(ctx context.Context, repositories []string, dgst digest.Digest, marked map[digest.Digest]bool) (bool, error) {
+	for _, name := range repositories {
+		info, err := gc.driver.Stat(ctx, layerLinkPath(name, dgst))
+		if err != nil {
+			if _, ok := err.(storagedriver.PathNotFoundError); ok {
+				continue
+			}
+			return true, err
+		}
+		if time.Since(info.ModTime()) < gc.gracePeriod {
+			return true, nil
+		}
+		if _, _, err := gc.markRepository(ctx, name, marked); err != nil {
+			return true, err
+		}
+		if marked[dgst] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// repositories returns the names of all repositories.
+func (gc *GarbageCollector) repositories(ctx context.Context) ([]string, error) {
+	enumerator, ok := gc.registry.(distribution.RepositoryEnumerator)
+	if !ok {
+		return nil, fmt.Errorf("unable to convert Namespace to RepositoryEnumerator")
+	}
+	var repositories []string
+	err := enumerator.Enumerate(ctx, func(name string) error {
+		repositories = append(repositories, name)
+		return nil
+	})
+	return repositories, err
+}
+
+// mark marks the manifests referenced by a tag or pushed within the grace
+// period and their blobs, and returns the other manifests.
+func (gc *GarbageCollector) mark(ctx context.Context, marked map[digest.Digest]bool) ([]untaggedManifests, error) {
+	repositories, err := gc.repositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var untagged []untaggedManifests
+	for _, name := range repositories {
+		_, candidates, err := gc.markRepository(ctx, name, marked)
+		if err != nil {
+			return nil, err
+		}
+		repository := untaggedManifests{repository: name}
+		for _, dgst := range candidates {
+			// the manifests of the platforms of a tagged manifest list
+			if !marked[dgst] {
+				repository.digests = append(repository.digests, dgst)
+			}
+		}
+		if len(repository.digests) > 0 {
+			untagged = append(untagged, repository)
+		}
+	}
+	return untagged, nil
+}
+
+// markRepository marks the manifests of the repository referenced by a tag or
+// pushed within the grace period and their blobs, and returns the tags of the
+// repository and its other manifests.
+func (gc *GarbageCollector) markRepository(ctx context.Context, name string, marked map[digest.Digest]bool) ([]string, []digest.Digest, error) {
+	named, err := reference.WithName(name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse repo name %s: %v", name, err)
+	}
+	repository, err := gc.registry.Repository(ctx, named)
+	if err != nil {
+		return nil, nil, err
+	}
+	tagService := repository.Tags(ctx)
+	tags, err := tagService.All(ctx)
+	if err != nil {
+		if _, ok := err.(distribution.ErrRepositoryUnknown); !ok {
+			return nil, nil, err
+		}
+	}
+	tagged := make(map[digest.Digest]bool)
+	for _, tag := range tags {
+		desc, err := tagService.Get(ctx, tag)
+		if err != nil {
+			return nil, nil, err
+		}
+		tagged[desc.Digest] = true
+	}
+
+	manifestService, err := repository.Manifests(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	manifestEnumerator, ok := manifestService.(distribution.ManifestEnumerator)
+	if !ok {
+		return nil, nil, fmt.Errorf("unable to convert ManifestService into ManifestEnumerator")
+	}
+	var candidates []digest.Digest
+	err = manifestEnumerator.Enumerate(ctx, func(dgst digest.Digest) error {
+		if tagged[dgst] || gc.recent(ctx, manifestRevisionPath(name, dgst)) {
+			return markManifest(ctx, manifestService, dgst, marked)
+		}
+		candidates = append(candidates, dgst)
+		return nil
+	})
+	if err != nil {
+		// the repositories without manifests have no _manifests directory
+		if _, ok := err.(storagedriver.PathNotFoundError); !ok {
+			return nil, nil, err
+		}
+	}
+	return tags, candidates, nil
+}
+
+// markManifest marks the manifest and the blobs it references, the manifests
+// of a manifest list are marked recursively.
+func markManifest(ctx context.Context, manifestService distribution.ManifestService, dgst digest.Digest, marked map[digest.Digest]bool) error {
+	if marked[dgst] {
+		return nil
+	}
+	marked[dgst] = true
+	manifest, err := manifestService.Get(ctx, dgst)
+	if err != nil {
+		// the manifests not pushed or lost reference no blob
+		if _, ok := err.(distribution.ErrManifestUnknownRevision); ok {
+			return nil
+		}
+		return fmt.Errorf("failed to retrieve manifest %s: %v", dgst, err)
+	}
+	_, isList := manifest.(*manifestlist.DeserializedManifestList)
+	for _, descriptor := range manifest.References() {
+		if !isList {
+			marked[descriptor.Digest] = true
+			continue
+		}
+		if err := markManifest(ctx, manifestService, descriptor.Digest, marked); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recent returns true if the file at the given path was modified within the
+// grace period, or its modification time is unknown.
+func (gc *GarbageCollector) recent(ctx context.Context, p string) bool {
+	info, err := gc.driver.Stat(ctx, p)
+	if err != nil {
+		return true
+	}
+	return time.Since(info.ModTime()) < gc.gracePeriod
+}
+
+func blobDataPath(dgst digest.Digest) string {
+	hex := dgst.Hex()
+	return path.Join(rootPath, "blobs", dgst.Algorithm().String(), hex[:2], hex, "data")
+}
+
+func layerLinkPath(name string, dgst digest.Digest) string {
+	return path.Join(rootPath, "repositories", name, "_layers", dgst.Algorithm().String(), dgst.Hex(), "link")
+}
+
+func manifestRevisionPath(name string, dgst digest.Digest) string {
+	return path.Join(rootPath, "repositories", name, "_manifests", "revisions", dgst.Algorithm().String(), dgst.Hex(), "link")
+}
@@ -0,0 +1,196 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package garbagecollector
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/manifest"
+	"github.com/docker/distribution/manifest/manifestlist"
+	"github.com/docker/distribution/manifest/ocischema"
+	"github.com/docker/distribution/reference"
+	"github.com/docker/distribution/registry/storage"
+	"github.com/docker/distribution/registry/storage/driver/filesystem"
+	"github.com/opencontainers/go-digest"
+)
+
+const (
+	configMediaType   = "application/vnd.oci.image.config.v1+json"
+	layerMediaType    = "application/vnd.oci.image.layer.v1.tar+gzip"
+	manifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+)
+
+type testRegistry struct {
+	t        *testing.T
+	ctx      context.Context
+	root     string
+	registry distribution.Namespace
+}
+
+func (r *testRegistry) repository(name string) distribution.Repository {
+	named, _ := reference.WithName(name)
+	repo, err := r.registry.Repository(r.ctx, named)
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	return repo
+}
+
+func (r *testRegistry) putBlob(repo distribution.Repository, mediaType, content string) distribution.Descriptor {
+	desc, err := repo.Blobs(r.ctx).Put(r.ctx, mediaType, []byte(content))
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	desc.MediaType = mediaType
+	return desc
+}
+
+func (r *testRegistry) putManifest(repo distribution.Repository, m distribution.Manifest) distribution.Descriptor {
+	manifests, err := repo.Manifests(r.ctx)
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	dgst, err := manifests.Put(r.ctx, m)
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	mediaType, payload, _ := m.Payload()
+	return distribution.Descriptor{MediaType: mediaType, Digest: dgst, Size: int64(len(payload))}
+}
+
+func (r *testRegistry) putImage(repo distribution.Repository, layers ...distribution.Descriptor) distribution.Descriptor {
+	config := r.putBlob(repo, configMediaType, "{}")
+	m, err := ocischema.FromStruct(ocischema.Manifest{
+		Versioned: manifest.Versioned{SchemaVersion: 2, MediaType: manifestMediaType},
+		Config:    config,
+		Layers:    layers,
+	})
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	return r.putManifest(repo, m)
+}
+
+func (r *testRegistry) tag(repo distribution.Repository, tag string, desc distribution.Descriptor) {
+	if err := repo.Tags(r.ctx).Tag(r.ctx, tag, desc); err != nil {
+		r.t.Fatal(err)
+	}
+}
+
+// age makes all the files in the storage older than the grace period.
+func (r *testRegistry) age() {
+	old := time.Now().Add(-2 * time.Hour)
+	err := filepath.Walk(r.root, func(p string, _ os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		return os.Chtimes(p, old, old)
+	})
+	if err != nil {
+		r.t.Fatal(err)
+	}
+}
+
+func (r *testRegistry) manifestExists(repo distribution.Repository, dgst digest.Digest) bool {
+	manifests, err := repo.Manifests(r.ctx)
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	exists, err := manifests.Exists(r.ctx, dgst)
+	if err != nil {
+		r.t.Fatal(err)
+	}
+	return exists
+}
+
+func (r *testRegistry) blobExists(dgst digest.Digest) bool {
+	_, err := r.registry.BlobStatter().Stat(r.ctx, dgst)
+	return err == nil
+}
+
+func TestCollectRechecksBeforeDeleting(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	driver := filesystem.New(filesystem.DriverParameters{RootDirectory: root, MaxThreads: 100})
+	registry, err := storage.NewRegistry(ctx, driver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &testRegistry{t: t, ctx: ctx, root: root, registry: registry}
+
+	app := r.repository("tenant-namespace/app")
+	layerA := r.putBlob(app, layerMediaType, "a")
+	imageA := r.putImage(app, layerA)
+	r.tag(app, "v1", imageA)
+	layerB := r.putBlob(app, layerMediaType, "b")
+	imageB := r.putImage(app, layerB)
+	layerC := r.putBlob(app, layerMediaType, "c")
+	imageC := r.putImage(app, layerC)
+	layerG := r.putBlob(app, layerMediaType, "g")
+	imageG := r.putImage(app, layerG)
+	lib := r.repository("tenant-namespace/lib")
+	layerZ := r.putBlob(lib, layerMediaType, "z")
+	r.age()
+
+	gc := NewGarbageCollector(registry, driver, time.Hour)
+	marked := make(map[digest.Digest]bool)
+	untagged, err := gc.mark(ctx, marked)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the images are tagged and pushed again after they are marked
+	r.tag(app, "v2", imageB)
+	list, err := manifestlist.FromDescriptors([]manifestlist.ManifestDescriptor{{Descriptor: imageC}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.putManifest(app, list)
+	imageZ := r.putImage(lib, layerZ)
+
+	manifests, blobs, err := gc.sweep(ctx, marked, untagged)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the manifest of the untagged image and its layer
+	if manifests != 1 || blobs != 2 {
+		t.Errorf("sweep() deleted %d manifests and %d blobs, want 1 and 2", manifests, blobs)
+	}
+	for name, dgst := range map[string]digest.Digest{"tagged": imageB.Digest, "platform": imageC.Digest} {
+		if !r.manifestExists(app, dgst) {
+			t.Errorf("%s manifest %s deleted", name, dgst)
+		}
+	}
+	if !r.manifestExists(lib, imageZ.Digest) {
+		t.Errorf("pushed manifest %s deleted", imageZ.Digest)
+	}
+	for _, dgst := range []digest.Digest{layerA.Digest, layerB.Digest, layerC.Digest, layerZ.Digest} {
+		if !r.blobExists(dgst) {
+			t.Errorf("blob %s deleted", dgst)
+		}
+	}
+	if r.manifestExists(app, imageG.Digest) || r.blobExists(layerG.Digest) {
+		t.Errorf("untagged image %s not deleted", imageG.Digest)
+	}
+}
@@ -0,0 +1,237 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package replication
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/antihax/optional"
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/reference"
+	"github.com/docker/distribution/registry/client"
+	"github.com/docker/distribution/registry/client/auth"
+	"github.com/docker/distribution/registry/client/auth/challenge"
+	"github.com/docker/distribution/registry/client/transport"
+	registryv1 "tkestack.io/tke/api/registry/v1"
+	harbor "tkestack.io/tke/pkg/registry/harbor/client"
+)
+
+const harborPageSize = 100
+
+// LocalRegistry is the address and the administrator of this registry used
+// to copy the images of its namespaces.
+type LocalRegistry struct {
+	DomainSuffix  string
+	DefaultTenant string
+	Username      string
+	Password      string
+	// Transport verifies the certificate of this registry.
+	Transport http.RoundTripper
+}
+
+// endpoint accesses the repositories of a registry with the docker registry
+// http api v2.
+type endpoint struct {
+	kind      registryv1.ReplicationEndpointType
+	baseURL   string
+	namespace string
+	creds     *credentials
+	transport http.RoundTripper
+	manager   challenge.Manager
+}
+
+func newEndpoint(spec *registryv1.ReplicationEndpoint, tenantID string, local *LocalRegistry) *endpoint {
+	e := &endpoint{
+		kind:      spec.Type,
+		baseURL:   strings.TrimSuffix(spec.URL, "/"),
+		namespace: spec.Namespace,
+		creds:     &credentials{username: spec.Username, password: spec.Password},
+		transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: spec.InsecureSkipVerify},
+		},
+	}
+	if spec.Type == registryv1.ReplicationEndpointTKE {
+		host := local.DomainSuffix
+		if tenantID != local.DefaultTenant {
+			host = fmt.Sprintf("%s.%s", tenantID, local.DomainSuffix)
+		}
+		e.baseURL = "https://" + host
+		e.creds = &credentials{username: local.Username, password: local.Password}
+		e.transport = local.Transport
+	}
+	return e
+}
+
+// path returns the name of the repository in the registry.
+func (e *endpoint) path(repoName string) string {
+	if e.namespace == "" {
+		return repoName
+	}
+	return e.namespace + "/" + repoName
+}
+
+// authorize returns a transport requesting the token of the given scopes.
+func (e *endpoint) authorize(scopes ...auth.Scope) (http.RoundTripper, error) {
+	if e.manager == nil {
+		resp, err := (&http.Client{Transport: e.transport}).Get(e.baseURL + "/v2/")
+		if err != nil {
+			return nil, err
+		}
+		_ = resp.Body.Close()
+		manager := challenge.NewSimpleManager()
+		if err := manager.AddResponse(resp); err != nil {
+			return nil, err
+		}
+		e.manager = manager
+	}
+	tokenHandler := auth.NewTokenHandlerWithOptions(auth.TokenHandlerOptions{
+		Transport:   e.transport,
+		Credentials: e.creds,
+		Scopes:      scopes,
+	})
+	return transport.NewTransport(e.transport, auth.NewAuthorizer(e.manager, tokenHandler, auth.NewBasicHandler(e.creds))), nil
+}
+
+// repository returns the repository with the given name in the namespace of
+// the endpoint.
+func (e *endpoint) repository(repoName string, actions ...string) (distribution.Repository, error) {
+	named, err := reference.WithName(e.path(repoName))
+	if err != nil {
+		return nil, err
+	}
+	tr, err := e.authorize(auth.RepositoryScope{Repository: named.Name(), Actions: actions})
+	if err != nil {
+		return nil, err
+	}
+	return client.NewRepository(named, e.baseURL, tr)
+}
+
+// repositories lists the names of the repositories in the namespace of the
+// endpoint, the names are relative to the namespace.
+func (e *endpoint) repositories(ctx context.Context) ([]string, error) {
+	if e.kind == registryv1.ReplicationEndpointHarbor {
+		return e.harborRepositories(ctx)
+	}
+	tr, err := e.authorize(auth.RegistryScope{Name: "catalog", Actions: []string{"*"}})
+	if err != nil {
+		return nil, err
+	}
+	catalog, err := client.NewRegistry(e.baseURL, tr)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		names []string
+		last  string
+	)
+	entries := make([]string, 100)
+	for {
+		n, err := catalog.Repositories(ctx, entries, last)
+		for _, name := range entries[:n] {
+			if e.namespace == "" {
+				names = append(names, name)
+			} else if strings.HasPrefix(name, e.namespace+"/") {
+				names = append(names, strings.TrimPrefix(name, e.namespace+"/"))
+			}
+		}
+		if err == io.EOF || (err == nil && n == 0) {
+			return names, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		last = entries[n-1]
+	}
+}
+
+func (e *endpoint) harborClient() *harbor.APIClient {
+	headers := make(map[string]string)
+	headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(e.creds.username+":"+e.creds.password))
+	return harbor.NewAPIClient(&harbor.Configuration{
+		BasePath:      e.baseURL + "/api/v2.0",
+		DefaultHeader: headers,
+		UserAgent:     "Swagger-Codegen/1.0.0/go",
+		HTTPClient: &http.Client{
+			Transport: e.transport,
+		},
+	})
+}
+
+func (e *endpoint) harborRepositories(ctx context.Context) ([]string, error) {
+	harborClient := e.harborClient()
+	var names []string
+	for page := int64(1); ; page++ {
+		repos, _, err := harborClient.RepositoryApi.ListRepositories(ctx, e.namespace, &harbor.RepositoryApiListRepositoriesOpts{
+			Page:     optional.NewInt64(page),
+			PageSize: optional.NewInt64(harborPageSize),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, repo := range repos {
+			names = append(names, strings.TrimPrefix(repo.Name, e.namespace+"/"))
+		}
+		if len(repos) < harborPageSize {
+			return names, nil
+		}
+	}
+}
+
+// ensureNamespace creates the project of a harbor destination if it does not
+// exist, the namespaces of the other registries need no creation.
+func (e *endpoint) ensureNamespace(ctx context.Context) error {
+	if e.kind != registryv1.ReplicationEndpointHarbor {
+		return nil
+	}
+	harborClient := e.harborClient()
+	resp, err := harborClient.ProjectApi.HeadProject(ctx, e.namespace, nil)
+	if err == nil {
+		return nil
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		return err
+	}
+	_, err = harborClient.ProjectApi.CreateProject(ctx, harbor.HarborProjectReq{ProjectName: e.namespace}, nil)
+	return err
+}
+
+// credentials implements auth.CredentialStore with a username and password.
+type credentials struct {
+	username string
+	password string
+}
+
+func (c *credentials) Basic(*url.URL) (string, string) {
+	return c.username, c.password
+}
+
+func (c *credentials) RefreshToken(*url.URL, string) string {
+	return ""
+}
+
+func (c *credentials) SetRefreshToken(*url.URL, string, string) {
+}
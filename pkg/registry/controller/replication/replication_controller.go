@@ -0,0 +1,392 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package replication
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	registryv1informer "tkestack.io/tke/api/client/informers/externalversions/registry/v1"
+	registryv1lister "tkestack.io/tke/api/client/listers/registry/v1"
+	registryv1 "tkestack.io/tke/api/registry/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/registry/util"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	controllerName = "replication-controller"
+
+	// maxRuns is the number of the runs recorded in the status of a policy.
+	maxRuns = 10
+)
+
+// Controller is responsible for copying the images of the replication
+// policies when they are pushed or scheduled.
+type Controller struct {
+	client                 clientset.Interface
+	local                  *LocalRegistry
+	queue                  workqueue.RateLimitingInterface
+	lister                 registryv1lister.ReplicationPolicyLister
+	listerSynced           cache.InformerSynced
+	namespaceLister        registryv1lister.NamespaceLister
+	namespaceListerSynced  cache.InformerSynced
+	repositoryLister       registryv1lister.RepositoryLister
+	repositoryListerSynced cache.InformerSynced
+}
+
+// NewController creates a new Controller object.
+func NewController(client clientset.Interface, policyInformer registryv1informer.ReplicationPolicyInformer,
+	namespaceInformer registryv1informer.NamespaceInformer, repositoryInformer registryv1informer.RepositoryInformer,
+	resyncPeriod time.Duration, local *LocalRegistry) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client: client,
+		local:  local,
+		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.RegistryV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("replication_controller", client.RegistryV1().RESTClient().GetRateLimiter())
+	}
+
+	policyInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				controller.enqueue(newObj)
+			},
+		},
+		resyncPeriod,
+	)
+	controller.lister = policyInformer.Lister()
+	controller.listerSynced = policyInformer.Informer().HasSynced
+	controller.namespaceLister = namespaceInformer.Lister()
+	controller.namespaceListerSynced = namespaceInformer.Informer().HasSynced
+	controller.repositoryLister = repositoryInformer.Lister()
+	controller.repositoryListerSynced = repositoryInformer.Informer().HasSynced
+
+	return controller
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	policy, ok := obj.(*registryv1.ReplicationPolicy)
+	if !ok || (!scheduled(policy, time.Now()) && len(policy.Status.Pending) == 0) {
+		return
+	}
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		log.Error("Couldn't get key for object", log.Any("object", obj), log.Err(err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// scheduled returns true if a scheduled run of the policy is due.
+func scheduled(policy *registryv1.ReplicationPolicy, now time.Time) bool {
+	if policy.Spec.Trigger.Type != registryv1.ReplicationTriggerScheduled {
+		return false
+	}
+	interval := time.Duration(policy.Spec.Trigger.IntervalMinutes) * time.Minute
+	return now.Sub(policy.Status.LastScheduleTime.Time) >= interval
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Start the informer factories to begin populating the informer caches
+	log.Info("Starting replication controller")
+	defer log.Info("Shutting down replication controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced, c.namespaceListerSynced, c.repositoryListerSynced); !ok {
+		log.Error("Failed to wait for replication caches to sync")
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of replication policy objects.
+// Each policy can be in the queue at most once.
+// The system ensures that no two workers can process
+// the same policy at the same time.
+func (c *Controller) worker() {
+	workFunc := func() bool {
+		key, quit := c.queue.Get()
+		if quit {
+			return true
+		}
+		defer c.queue.Done(key)
+
+		err := c.syncItem(key.(string))
+		if err == nil {
+			// no error, forget this entry and return
+			c.queue.Forget(key)
+			return false
+		}
+
+		// rather than wait for a full resync, re-add the policy to the queue to be processed
+		c.queue.AddRateLimited(key)
+		runtime.HandleError(err)
+		return false
+	}
+
+	for {
+		quit := workFunc()
+
+		if quit {
+			return
+		}
+	}
+}
+
+// syncItem copies the images of the replication policy with the given key.
+// This function is not meant to be invoked concurrently with the same key.
+func (c *Controller) syncItem(key string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished syncing replication policy", log.String("policy", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	policy, err := c.lister.Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve replication policy from store", log.String("policy", key), log.Err(err))
+		return err
+	}
+	if !scheduled(policy, startTime) && len(policy.Status.Pending) == 0 {
+		return nil
+	}
+	return c.process(context.Background(), policy)
+}
+
+func (c *Controller) process(ctx context.Context, policy *registryv1.ReplicationPolicy) error {
+	run := registryv1.ReplicationRun{
+		StartTime: metav1.Now(),
+		Trigger:   policy.Spec.Trigger.Type,
+		Phase:     registryv1.ReplicationRunSucceeded,
+	}
+	source := newEndpoint(&policy.Spec.Source, policy.Spec.TenantID, c.local)
+	destination := newEndpoint(&policy.Spec.Destination, policy.Spec.TenantID, c.local)
+
+	artifacts := policy.Status.Pending
+	// the namespaces may be locked or turned into a proxy after the policy
+	// is created
+	err := c.checkLocalNamespace(policy.Spec.TenantID, &policy.Spec.Source, false)
+	if err == nil {
+		err = c.checkLocalNamespace(policy.Spec.TenantID, &policy.Spec.Destination, true)
+	}
+	if err == nil && run.Trigger == registryv1.ReplicationTriggerScheduled {
+		artifacts, err = c.listArtifacts(ctx, policy, source)
+	}
+	if err == nil {
+		err = destination.ensureNamespace(ctx)
+	}
+	if err != nil {
+		log.Error("Failed to start replication", log.String("policy", policy.Name), log.Err(err))
+		run.Phase = registryv1.ReplicationRunFailed
+		run.Message = err.Error()
+		// the pending images are recorded as failed rather than retried forever
+		for _, artifact := range policy.Status.Pending {
+			run.Failed++
+			run.Artifacts = append(run.Artifacts, registryv1.ReplicationArtifactStatus{
+				Repository: artifact.Repository,
+				Tag:        artifact.Tag,
+				Digest:     artifact.Digest,
+				Phase:      registryv1.ReplicationArtifactFailed,
+				Message:    err.Error(),
+			})
+		}
+		artifacts = nil
+	}
+
+	maxRetries := int32(0)
+	if policy.Spec.MaxRetries != nil {
+		maxRetries = *policy.Spec.MaxRetries
+	}
+	r := newReplicator(source, destination, policy.Spec.BandwidthLimitKBps, maxRetries)
+	for _, artifact := range artifacts {
+		status, skipped := r.replicate(ctx, artifact)
+		switch {
+		case skipped:
+			run.Skipped++
+			continue
+		case status.Phase == registryv1.ReplicationArtifactFailed:
+			log.Error("Failed to replicate image", log.String("policy", policy.Name),
+				log.String("repository", artifact.Repository), log.String("tag", artifact.Tag), log.String("message", status.Message))
+			run.Failed++
+			run.Phase = registryv1.ReplicationRunFailed
+		default:
+			run.Succeeded++
+		}
+		run.Artifacts = append(run.Artifacts, status)
+	}
+	run.CompletionTime = metav1.Now()
+	log.Info("Replication finished", log.String("policy", policy.Name), log.String("trigger", string(run.Trigger)),
+		log.Int32("succeeded", run.Succeeded), log.Int32("failed", run.Failed), log.Int32("skipped", run.Skipped))
+
+	return c.persistRun(ctx, policy.Name, &run, policy.Status.Pending)
+}
+
+// listArtifacts lists the tags of the source repositories selected by the
+// filters of the policy.
+func (c *Controller) listArtifacts(ctx context.Context, policy *registryv1.ReplicationPolicy, source *endpoint) ([]registryv1.ReplicationArtifact, error) {
+	var artifacts []registryv1.ReplicationArtifact
+	add := func(repoName, tag, digest string) {
+		if util.MatchReplication(policy.Spec.Repositories, policy.Spec.IncludeTags, policy.Spec.ExcludeTags, repoName, tag) {
+			artifacts = append(artifacts, registryv1.ReplicationArtifact{Repository: repoName, Tag: tag, Digest: digest})
+		}
+	}
+
+	if policy.Spec.Source.Type == registryv1.ReplicationEndpointTKE {
+		repositories, err := c.localRepositories(policy.Spec.TenantID, policy.Spec.Source.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		for _, repository := range repositories {
+			for _, tag := range repository.Status.Tags {
+				add(repository.Spec.Name, tag.Name, tag.Digest)
+			}
+		}
+		return artifacts, nil
+	}
+
+	names, err := source.repositories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		if !util.MatchReplication(policy.Spec.Repositories, "", "", name, "") {
+			continue
+		}
+		repo, err := source.repository(name, "pull")
+		if err != nil {
+			return nil, err
+		}
+		tags, err := repo.Tags(ctx).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range tags {
+			add(name, tag, "")
+		}
+	}
+	return artifacts, nil
+}
+
+func (c *Controller) localRepositories(tenantID, namespaceName string) ([]*registryv1.Repository, error) {
+	namespace, err := c.localNamespace(tenantID, namespaceName)
+	if err != nil {
+		return nil, err
+	}
+	return c.repositoryLister.Repositories(namespace.Name).List(labels.Everything())
+}
+
+func (c *Controller) localNamespace(tenantID, namespaceName string) (*registryv1.Namespace, error) {
+	namespaces, err := c.namespaceLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, namespace := range namespaces {
+		if namespace.Spec.TenantID == tenantID && namespace.Spec.Name == namespaceName {
+			return namespace, nil
+		}
+	}
+	return nil, fmt.Errorf("namespace %s in tenant %s not exist", namespaceName, tenantID)
+}
+
+// checkLocalNamespace checks that the namespace of this registry the images
+// are copied from or to belongs to the tenant of the policy, is not locked,
+// and is not a proxy if the images are pushed to it.
+func (c *Controller) checkLocalNamespace(tenantID string, spec *registryv1.ReplicationEndpoint, push bool) error {
+	if spec.Type != registryv1.ReplicationEndpointTKE || spec.Namespace == "" {
+		return nil
+	}
+	namespace, err := c.localNamespace(tenantID, spec.Namespace)
+	if err != nil {
+		return err
+	}
+	if namespace.Status.Locked != nil && *namespace.Status.Locked {
+		return fmt.Errorf("namespace %s is locked", spec.Namespace)
+	}
+	if push && namespace.Spec.Proxy != nil {
+		return fmt.Errorf("images can not be pushed to proxy namespace %s", spec.Namespace)
+	}
+	return nil
+}
+
+// persistRun records the run to the policy and removes the pending images it
+// copied, the images pushed again meanwhile are kept pending.
+func (c *Controller) persistRun(ctx context.Context, name string, run *registryv1.ReplicationRun, pending []registryv1.ReplicationArtifact) error {
+	processed := make(map[registryv1.ReplicationArtifact]bool)
+	if run.Trigger == registryv1.ReplicationTriggerPush {
+		for _, artifact := range pending {
+			processed[artifact] = true
+		}
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := c.client.RegistryV1().ReplicationPolicies().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		var remaining []registryv1.ReplicationArtifact
+		for _, artifact := range current.Status.Pending {
+			if !processed[artifact] {
+				remaining = append(remaining, artifact)
+			}
+		}
+		current.Status.Pending = remaining
+		if run.Trigger == registryv1.ReplicationTriggerScheduled {
+			current.Status.LastScheduleTime = run.StartTime
+		}
+		current.Status.Runs = append([]registryv1.ReplicationRun{*run}, current.Status.Runs...)
+		if len(current.Status.Runs) > maxRuns {
+			current.Status.Runs = current.Status.Runs[:maxRuns]
+		}
+		_, err = c.client.RegistryV1().ReplicationPolicies().UpdateStatus(ctx, current, metav1.UpdateOptions{})
+		return err
+	})
+}
@@ -0,0 +1,208 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package replication
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/manifest/manifestlist"
+	// register the manifest schemas the images are copied with
+	_ "github.com/docker/distribution/manifest/ocischema"
+	_ "github.com/docker/distribution/manifest/schema1"
+	_ "github.com/docker/distribution/manifest/schema2"
+	"github.com/opencontainers/go-digest"
+	"golang.org/x/time/rate"
+	registryv1 "tkestack.io/tke/api/registry/v1"
+)
+
+const maxBurstBytes = 256 * 1024
+
+// retryInterval is multiplied by the retries of an image before it is copied
+// again.
+var retryInterval = 5 * time.Second
+
+// replicator copies the images from the source endpoint to the destination
+// endpoint of a replication policy.
+type replicator struct {
+	source      *endpoint
+	destination *endpoint
+	maxRetries  int32
+	limiter     *rate.Limiter
+}
+
+func newReplicator(source, destination *endpoint, bandwidthLimitKBps int64, maxRetries int32) *replicator {
+	r := &replicator{
+		source:      source,
+		destination: destination,
+		maxRetries:  maxRetries,
+	}
+	if bandwidthLimitKBps > 0 {
+		bytesPerSecond := bandwidthLimitKBps * 1024
+		burst := bytesPerSecond
+		if burst > maxBurstBytes {
+			burst = maxBurstBytes
+		}
+		r.limiter = rate.NewLimiter(rate.Limit(bytesPerSecond), int(burst))
+	}
+	return r
+}
+
+// replicate copies the image of the tag and retries on failure, it returns
+// true if the image is already in the destination.
+func (r *replicator) replicate(ctx context.Context, artifact registryv1.ReplicationArtifact) (registryv1.ReplicationArtifactStatus, bool) {
+	status := registryv1.ReplicationArtifactStatus{
+		Repository: artifact.Repository,
+		Tag:        artifact.Tag,
+		Digest:     artifact.Digest,
+	}
+	for {
+		dgst, skipped, err := r.copy(ctx, artifact.Repository, artifact.Tag)
+		if err == nil {
+			status.Phase = registryv1.ReplicationArtifactSucceeded
+			status.Digest = dgst.String()
+			return status, skipped
+		}
+		status.Message = err.Error()
+		if status.Retries >= r.maxRetries || ctx.Err() != nil {
+			status.Phase = registryv1.ReplicationArtifactFailed
+			return status, false
+		}
+		status.Retries++
+		select {
+		case <-ctx.Done():
+		case <-time.After(retryInterval * time.Duration(status.Retries)):
+		}
+	}
+}
+
+func (r *replicator) copy(ctx context.Context, repoName, tag string) (digest.Digest, bool, error) {
+	src, err := r.source.repository(repoName, "pull")
+	if err != nil {
+		return "", false, err
+	}
+	dst, err := r.destination.repository(repoName, "pull", "push")
+	if err != nil {
+		return "", false, err
+	}
+	desc, err := src.Tags(ctx).Get(ctx, tag)
+	if err != nil {
+		return "", false, err
+	}
+	if current, err := dst.Tags(ctx).Get(ctx, tag); err == nil && current.Digest == desc.Digest {
+		return desc.Digest, true, nil
+	}
+	return desc.Digest, false, r.copyManifest(ctx, src, dst, desc.Digest, tag)
+}
+
+// copyManifest copies the blobs referenced by the manifest then the manifest,
+// the manifests of a manifest list are copied by digest.
+func (r *replicator) copyManifest(ctx context.Context, src, dst distribution.Repository, dgst digest.Digest, tag string) error {
+	srcManifests, err := src.Manifests(ctx)
+	if err != nil {
+		return err
+	}
+	dstManifests, err := dst.Manifests(ctx)
+	if err != nil {
+		return err
+	}
+	manifest, err := srcManifests.Get(ctx, dgst)
+	if err != nil {
+		return err
+	}
+	if _, ok := manifest.(*manifestlist.DeserializedManifestList); ok {
+		for _, desc := range manifest.References() {
+			if exists, err := dstManifests.Exists(ctx, desc.Digest); err == nil && exists {
+				continue
+			}
+			if err := r.copyManifest(ctx, src, dst, desc.Digest, ""); err != nil {
+				return err
+			}
+		}
+	} else {
+		for _, desc := range manifest.References() {
+			if err := r.copyBlob(ctx, src, dst, desc); err != nil {
+				return err
+			}
+		}
+	}
+	var options []distribution.ManifestServiceOption
+	if tag != "" {
+		options = append(options, distribution.WithTag(tag))
+	}
+	_, err = dstManifests.Put(ctx, manifest, options...)
+	return err
+}
+
+func (r *replicator) copyBlob(ctx context.Context, src, dst distribution.Repository, desc distribution.Descriptor) error {
+	// the foreign layers are not distributable
+	if len(desc.URLs) > 0 {
+		return nil
+	}
+	if _, err := dst.Blobs(ctx).Stat(ctx, desc.Digest); err == nil {
+		return nil
+	} else if err != distribution.ErrBlobUnknown {
+		return err
+	}
+	reader, err := src.Blobs(ctx).Open(ctx, desc.Digest)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = reader.Close() }()
+	writer, err := dst.Blobs(ctx).Create(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(writer, r.limit(ctx, reader)); err != nil {
+		_ = writer.Cancel(ctx)
+		return err
+	}
+	_, err = writer.Commit(ctx, desc)
+	return err
+}
+
+func (r *replicator) limit(ctx context.Context, reader io.Reader) io.Reader {
+	if r.limiter == nil {
+		return reader
+	}
+	return &limitedReader{ctx: ctx, reader: reader, limiter: r.limiter}
+}
+
+// limitedReader waits for the bandwidth limit of the run before returning
+// the bytes read.
+type limitedReader struct {
+	ctx     context.Context
+	reader  io.Reader
+	limiter *rate.Limiter
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if len(p) > l.limiter.Burst() {
+		p = p[:l.limiter.Burst()]
+	}
+	n, err := l.reader.Read(p)
+	if n > 0 {
+		if waitErr := l.limiter.WaitN(l.ctx, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
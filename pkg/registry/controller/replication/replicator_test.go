@@ -0,0 +1,160 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package replication
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/configuration"
+	"github.com/docker/distribution/manifest/schema2"
+	"github.com/docker/distribution/registry/handlers"
+	_ "github.com/docker/distribution/registry/storage/driver/inmemory"
+	registryv1 "tkestack.io/tke/api/registry/v1"
+)
+
+// flakyRegistry fails the given number of manifest uploads before serving
+// them.
+type flakyRegistry struct {
+	handler  http.Handler
+	failures int32
+}
+
+func (f *flakyRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodPut && strings.Contains(r.URL.Path, "/manifests/") && atomic.AddInt32(&f.failures, -1) >= 0 {
+		http.Error(w, "registry unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	f.handler.ServeHTTP(w, r)
+}
+
+func newTestRegistry() *flakyRegistry {
+	config := &configuration.Configuration{
+		Storage: configuration.Storage{
+			"inmemory": configuration.Parameters{},
+			"maintenance": configuration.Parameters{"uploadpurging": map[interface{}]interface{}{
+				"enabled": false,
+			}},
+		},
+	}
+	return &flakyRegistry{handler: handlers.NewApp(context.Background(), config)}
+}
+
+func newTestEndpoint(t *testing.T, handler http.Handler, namespace string) *endpoint {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	spec := &registryv1.ReplicationEndpoint{
+		Type:      registryv1.ReplicationEndpointDockerRegistry,
+		URL:       server.URL,
+		Namespace: namespace,
+	}
+	return newEndpoint(spec, "", &LocalRegistry{})
+}
+
+func pushImage(t *testing.T, e *endpoint, repoName, tag string) distribution.Descriptor {
+	ctx := context.Background()
+	repo, err := e.repository(repoName, "pull", "push")
+	if err != nil {
+		t.Fatal(err)
+	}
+	layer, err := repo.Blobs(ctx).Put(ctx, schema2.MediaTypeLayer, []byte(repoName+":"+tag))
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder := schema2.NewManifestBuilder(repo.Blobs(ctx), schema2.MediaTypeImageConfig, []byte(`{"architecture":"amd64","os":"linux"}`))
+	if err := builder.AppendReference(layer); err != nil {
+		t.Fatal(err)
+	}
+	manifest, err := builder.Build(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifests, err := repo.Manifests(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := manifests.Put(ctx, manifest, distribution.WithTag(tag)); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := repo.Tags(ctx).Get(ctx, tag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return desc
+}
+
+func TestReplicate(t *testing.T) {
+	interval := retryInterval
+	retryInterval = time.Millisecond
+	defer func() { retryInterval = interval }()
+
+	source := newTestEndpoint(t, newTestRegistry(), "library")
+	image := pushImage(t, source, "app", "v1")
+	artifact := registryv1.ReplicationArtifact{Repository: "app", Tag: "v1"}
+
+	tests := []struct {
+		name        string
+		failures    int32
+		maxRetries  int32
+		wantPhase   registryv1.ReplicationArtifactPhase
+		wantRetries int32
+	}{
+		{name: "copied", wantPhase: registryv1.ReplicationArtifactSucceeded},
+		{name: "copied after retries", failures: 2, maxRetries: 2, wantPhase: registryv1.ReplicationArtifactSucceeded, wantRetries: 2},
+		{name: "failed after retries", failures: 3, maxRetries: 2, wantPhase: registryv1.ReplicationArtifactFailed, wantRetries: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := newTestRegistry()
+			registry.failures = tt.failures
+			destination := newTestEndpoint(t, registry, "mirror")
+			r := newReplicator(source, destination, 0, tt.maxRetries)
+
+			status, skipped := r.replicate(context.Background(), artifact)
+			if status.Phase != tt.wantPhase || status.Retries != tt.wantRetries || skipped {
+				t.Fatalf("replicate() = %+v, %v, want phase %s and %d retries", status, skipped, tt.wantPhase, tt.wantRetries)
+			}
+			if tt.wantPhase != registryv1.ReplicationArtifactSucceeded {
+				return
+			}
+			if status.Digest != image.Digest.String() {
+				t.Errorf("replicate() digest = %s, want %s", status.Digest, image.Digest)
+			}
+			repo, err := destination.repository("app", "pull")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if desc, err := repo.Tags(context.Background()).Get(context.Background(), "v1"); err != nil || desc.Digest != image.Digest {
+				t.Errorf("destination tag = %v, %v, want %s", desc.Digest, err, image.Digest)
+			}
+
+			// the image in the destination is not copied again
+			status, skipped = r.replicate(context.Background(), artifact)
+			if status.Phase != registryv1.ReplicationArtifactSucceeded || !skipped {
+				t.Errorf("replicate() again = %+v, %v, want skipped", status, skipped)
+			}
+		})
+	}
+}
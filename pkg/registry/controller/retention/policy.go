@@ -0,0 +1,128 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package retention
+
+import (
+	"regexp"
+	"sort"
+	"time"
+
+	registryv1 "tkestack.io/tke/api/registry/v1"
+	"tkestack.io/tke/pkg/registry/util"
+)
+
+const defaultIntervalHours = 24
+
+type rule struct {
+	repositories *regexp.Regexp
+	includeTags  *regexp.Regexp
+	excludeTags  *regexp.Regexp
+	keepLastN    int
+	keepPulled   time.Duration
+}
+
+// Policy evaluates the rules of a retention policy against the tags of the
+// repositories.
+type Policy struct {
+	rules []rule
+}
+
+// NewPolicy compiles the rules of the given retention policy.
+func NewPolicy(policy *registryv1.RetentionPolicy) (*Policy, error) {
+	p := &Policy{}
+	for _, r := range policy.Rules {
+		repositories, err := regexp.Compile(r.Repositories)
+		if err != nil {
+			return nil, err
+		}
+		includeTags, err := regexp.Compile(r.IncludeTags)
+		if err != nil {
+			return nil, err
+		}
+		var excludeTags *regexp.Regexp
+		if r.ExcludeTags != "" {
+			if excludeTags, err = regexp.Compile(r.ExcludeTags); err != nil {
+				return nil, err
+			}
+		}
+		p.rules = append(p.rules, rule{
+			repositories: repositories,
+			includeTags:  includeTags,
+			excludeTags:  excludeTags,
+			keepLastN:    int(r.KeepLastN),
+			keepPulled:   time.Duration(r.KeepPulledWithinDays) * 24 * time.Hour,
+		})
+	}
+	return p, nil
+}
+
+// selects tests if the tag is selected by the rule, the signatures of the
+// images are never selected, nor counted as the tags kept by the rule.
+func (r *rule) selects(tag string) bool {
+	if util.IsSignatureTag(tag) {
+		return false
+	}
+	return r.includeTags.MatchString(tag) && (r.excludeTags == nil || !r.excludeTags.MatchString(tag))
+}
+
+// Expired returns the tags of the repository selected by any rule of the
+// policy but retained by none of the rules selecting them.
+func (p *Policy) Expired(repository *registryv1.Repository, now time.Time) []registryv1.RepositoryTag {
+	selected := make(map[string]bool)
+	retained := make(map[string]bool)
+	for i := range p.rules {
+		r := &p.rules[i]
+		if !r.repositories.MatchString(repository.Spec.Name) {
+			continue
+		}
+		var tags []registryv1.RepositoryTag
+		for _, tag := range repository.Status.Tags {
+			if r.selects(tag.Name) {
+				tags = append(tags, tag)
+			}
+		}
+		// the most recently pushed tags first
+		sort.SliceStable(tags, func(i, j int) bool {
+			return tags[j].TimeCreated.Before(&tags[i].TimeCreated)
+		})
+		for i, tag := range tags {
+			selected[tag.Name] = true
+			if i < r.keepLastN ||
+				(r.keepPulled > 0 && !tag.LastPullTime.IsZero() && now.Sub(tag.LastPullTime.Time) <= r.keepPulled) {
+				retained[tag.Name] = true
+			}
+		}
+	}
+
+	var expired []registryv1.RepositoryTag
+	for _, tag := range repository.Status.Tags {
+		if selected[tag.Name] && !retained[tag.Name] {
+			expired = append(expired, tag)
+		}
+	}
+	return expired
+}
+
+// Interval returns the period between two evaluations of the policy.
+func Interval(policy *registryv1.RetentionPolicy) time.Duration {
+	if policy.IntervalHours > 0 {
+		return time.Duration(policy.IntervalHours) * time.Hour
+	}
+	return defaultIntervalHours * time.Hour
+}
@@ -0,0 +1,99 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package retention
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	registryv1 "tkestack.io/tke/api/registry/v1"
+)
+
+func TestPolicyExpired(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) metav1.Time {
+		return metav1.NewTime(now.Add(-time.Duration(days) * 24 * time.Hour))
+	}
+	repository := &registryv1.Repository{
+		Spec: registryv1.RepositorySpec{Name: "app"},
+		Status: registryv1.RepositoryStatus{
+			Tags: []registryv1.RepositoryTag{
+				{Name: "v1", TimeCreated: daysAgo(40), LastPullTime: daysAgo(2)},
+				{Name: "v2", TimeCreated: daysAgo(30)},
+				{Name: "v3", TimeCreated: daysAgo(20), LastPullTime: daysAgo(15)},
+				{Name: "v4", TimeCreated: daysAgo(10)},
+				{Name: "latest", TimeCreated: daysAgo(50)},
+				{Name: "sha256-1.sig", TimeCreated: daysAgo(1)},
+			},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		policy *registryv1.RetentionPolicy
+		want   []string
+	}{
+		{
+			name: "keep last n",
+			policy: &registryv1.RetentionPolicy{Rules: []registryv1.RetentionRule{
+				{ExcludeTags: "^latest$", KeepLastN: 2},
+			}},
+			want: []string{"v1", "v2"},
+		},
+		{
+			name: "keep pulled within days",
+			policy: &registryv1.RetentionPolicy{Rules: []registryv1.RetentionRule{
+				{IncludeTags: "^v", KeepPulledWithinDays: 7},
+			}},
+			want: []string{"v2", "v3", "v4"},
+		},
+		{
+			name: "any rule retains",
+			policy: &registryv1.RetentionPolicy{Rules: []registryv1.RetentionRule{
+				{IncludeTags: "^v", KeepLastN: 1},
+				{KeepPulledWithinDays: 7},
+			}},
+			want: []string{"v2", "v3", "latest"},
+		},
+		{
+			name: "other repositories",
+			policy: &registryv1.RetentionPolicy{Rules: []registryv1.RetentionRule{
+				{Repositories: "^base$", KeepLastN: 1},
+			}},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := NewPolicy(tt.policy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, tag := range policy.Expired(repository, now) {
+				got = append(got, tag.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
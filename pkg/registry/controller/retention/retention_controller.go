@@ -0,0 +1,345 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/reference"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	registryv1informer "tkestack.io/tke/api/client/informers/externalversions/registry/v1"
+	registryv1lister "tkestack.io/tke/api/client/listers/registry/v1"
+	registryv1 "tkestack.io/tke/api/registry/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	controllerName = "retention-controller"
+)
+
+// Controller is responsible for evaluating the retention policies of the
+// registry namespaces and deleting the tags not retained by them.
+type Controller struct {
+	client                 clientset.Interface
+	registry               distribution.Namespace
+	queue                  workqueue.RateLimitingInterface
+	lister                 registryv1lister.NamespaceLister
+	listerSynced           cache.InformerSynced
+	repositoryLister       registryv1lister.RepositoryLister
+	repositoryListerSynced cache.InformerSynced
+}
+
+// NewController creates a new Controller object.
+func NewController(client clientset.Interface, namespaceInformer registryv1informer.NamespaceInformer,
+	repositoryInformer registryv1informer.RepositoryInformer, resyncPeriod time.Duration, registry distribution.Namespace) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client:   client,
+		registry: registry,
+		queue:    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.RegistryV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("retention_controller", client.RegistryV1().RESTClient().GetRateLimiter())
+	}
+
+	namespaceInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				controller.enqueue(newObj)
+			},
+		},
+		resyncPeriod,
+	)
+	controller.lister = namespaceInformer.Lister()
+	controller.listerSynced = namespaceInformer.Informer().HasSynced
+	controller.repositoryLister = repositoryInformer.Lister()
+	controller.repositoryListerSynced = repositoryInformer.Informer().HasSynced
+
+	return controller
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	namespace, ok := obj.(*registryv1.Namespace)
+	if !ok || namespace.Spec.RetentionPolicy == nil {
+		return
+	}
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		log.Error("Couldn't get key for object", log.Any("object", obj), log.Err(err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// needsRun returns true if the retention policy of the namespace has not been
+// evaluated within its interval, or its dry run setting has changed since.
+func needsRun(namespace *registryv1.Namespace, now time.Time) bool {
+	policy := namespace.Spec.RetentionPolicy
+	if policy == nil {
+		return false
+	}
+	status := namespace.Status.Retention
+	return status == nil || status.DryRun != policy.DryRun || now.Sub(status.LastRunTime.Time) >= Interval(policy)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Start the informer factories to begin populating the informer caches
+	log.Info("Starting retention controller")
+	defer log.Info("Shutting down retention controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced, c.repositoryListerSynced); !ok {
+		log.Error("Failed to wait for retention caches to sync")
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of namespace objects.
+// Each namespace can be in the queue at most once.
+// The system ensures that no two workers can process
+// the same namespace at the same time.
+func (c *Controller) worker() {
+	workFunc := func() bool {
+		key, quit := c.queue.Get()
+		if quit {
+			return true
+		}
+		defer c.queue.Done(key)
+
+		err := c.syncItem(key.(string))
+		if err == nil {
+			// no error, forget this entry and return
+			c.queue.Forget(key)
+			return false
+		}
+
+		// rather than wait for a full resync, re-add the namespace to the queue to be processed
+		c.queue.AddRateLimited(key)
+		runtime.HandleError(err)
+		return false
+	}
+
+	for {
+		quit := workFunc()
+
+		if quit {
+			return
+		}
+	}
+}
+
+// syncItem evaluates the retention policy of the namespace with the given
+// key. This function is not meant to be invoked concurrently with the same
+// key.
+func (c *Controller) syncItem(key string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished syncing namespace retention", log.String("namespace", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	namespace, err := c.lister.Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve namespace from store", log.String("namespace", key), log.Err(err))
+		return err
+	}
+	if !needsRun(namespace, startTime) {
+		return nil
+	}
+	return c.process(context.Background(), namespace)
+}
+
+func (c *Controller) process(ctx context.Context, namespace *registryv1.Namespace) error {
+	now := metav1.Now()
+	status := &registryv1.RetentionStatus{
+		LastRunTime: now,
+		DryRun:      namespace.Spec.RetentionPolicy.DryRun,
+	}
+	policy, err := NewPolicy(namespace.Spec.RetentionPolicy)
+	if err != nil {
+		status.Message = err.Error()
+		return c.persistStatus(ctx, namespace.Name, status, 0)
+	}
+
+	repositories, err := c.repositoryLister.Repositories(namespace.Name).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var (
+		errs    []error
+		emptied int32
+	)
+	for _, repository := range repositories {
+		expired := policy.Expired(repository, now.Time)
+		if len(expired) == 0 {
+			continue
+		}
+		if !status.DryRun {
+			if expired, err = c.deleteTags(ctx, repository, expired); err != nil {
+				log.Error("Failed to delete the expired tags", log.String("namespace", namespace.Spec.Name),
+					log.String("repository", repository.Spec.Name), log.Err(err))
+				errs = append(errs, err)
+			}
+			empty, err := c.removeTags(ctx, repository, expired)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			if empty {
+				emptied++
+			}
+		}
+		for _, tag := range expired {
+			status.Tags = append(status.Tags, registryv1.RetentionTag{
+				Repository: repository.Spec.Name,
+				Tag:        tag.Name,
+				Digest:     tag.Digest,
+			})
+		}
+	}
+	if len(errs) > 0 {
+		status.Message = utilerrors.NewAggregate(errs).Error()
+	}
+	log.Info("Retention policy evaluated", log.String("namespace", namespace.Spec.Name),
+		log.Bool("dryRun", status.DryRun), log.Int("tags", len(status.Tags)))
+	return c.persistStatus(ctx, namespace.Name, status, emptied)
+}
+
+// deleteTags untags the expired tags in the storage of the registry and
+// returns the deleted ones. The tags pushed again since are skipped.
+func (c *Controller) deleteTags(ctx context.Context, repository *registryv1.Repository, tags []registryv1.RepositoryTag) ([]registryv1.RepositoryTag, error) {
+	named, err := reference.WithName(fmt.Sprintf("%s-%s/%s", repository.Spec.TenantID, repository.Spec.NamespaceName, repository.Spec.Name))
+	if err != nil {
+		return nil, err
+	}
+	repo, err := c.registry.Repository(ctx, named)
+	if err != nil {
+		return nil, err
+	}
+	tagService := repo.Tags(ctx)
+	var deleted []registryv1.RepositoryTag
+	for _, tag := range tags {
+		desc, err := tagService.Get(ctx, tag.Name)
+		if err != nil {
+			if _, ok := err.(distribution.ErrTagUnknown); ok {
+				deleted = append(deleted, tag)
+				continue
+			}
+			return deleted, err
+		}
+		if desc.Digest.String() != tag.Digest {
+			continue
+		}
+		if err := tagService.Untag(ctx, tag.Name); err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, tag)
+	}
+	return deleted, nil
+}
+
+// removeTags removes the deleted tags from the status of the repository and
+// returns true if the repository has no tag left.
+func (c *Controller) removeTags(ctx context.Context, repository *registryv1.Repository, tags []registryv1.RepositoryTag) (bool, error) {
+	if len(tags) == 0 {
+		return false, nil
+	}
+	digests := make(map[string]string)
+	for _, tag := range tags {
+		digests[tag.Name] = tag.Digest
+	}
+	empty := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := c.client.RegistryV1().Repositories(repository.Namespace).Get(ctx, repository.Name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		var remaining []registryv1.RepositoryTag
+		for _, tag := range current.Status.Tags {
+			if digest, ok := digests[tag.Name]; !ok || digest != tag.Digest {
+				remaining = append(remaining, tag)
+			}
+		}
+		if len(remaining) == len(current.Status.Tags) {
+			return nil
+		}
+		current.Status.Tags = remaining
+		if _, err = c.client.RegistryV1().Repositories(repository.Namespace).UpdateStatus(ctx, current, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+		empty = len(remaining) == 0
+		return nil
+	})
+	return empty, err
+}
+
+// persistStatus records the result of the evaluation to the namespace. The
+// repositories without tags are not counted, the same as the pushes do.
+func (c *Controller) persistStatus(ctx context.Context, name string, status *registryv1.RetentionStatus, emptied int32) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := c.client.RegistryV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		current.Status.Retention = status
+		current.Status.RepoCount -= emptied
+		if current.Status.RepoCount < 0 {
+			current.Status.RepoCount = 0
+		}
+		_, err = c.client.RegistryV1().Namespaces().UpdateStatus(ctx, current, metav1.UpdateOptions{})
+		return err
+	})
+}
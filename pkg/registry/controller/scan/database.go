@@ -0,0 +1,187 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package scan
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	registryv1 "tkestack.io/tke/api/registry/v1"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+var json = jsoniter.ConfigCompatibleWithStandardLibrary
+
+const (
+	// EcosystemDpkg is the ecosystem of the packages installed by dpkg.
+	EcosystemDpkg = "dpkg"
+	// EcosystemApk is the ecosystem of the packages installed by apk.
+	EcosystemApk = "apk"
+)
+
+// DatabaseFile is a file of the offline vulnerability database, which can be
+// imported by copying it into the database directory of the controller.
+type DatabaseFile struct {
+	// Version identifies the release of the vulnerabilities in the file.
+	Version         string          `json:"version"`
+	Vulnerabilities []DatabaseEntry `json:"vulnerabilities"`
+}
+
+// DatabaseEntry represents the versions of a package affected by a
+// vulnerability.
+type DatabaseEntry struct {
+	ID        string `json:"id"`
+	Ecosystem string `json:"ecosystem"`
+	Package   string `json:"package"`
+	// FixedVersion is the first version not affected, all the versions are
+	// affected if empty.
+	FixedVersion string                           `json:"fixedVersion,omitempty"`
+	Severity     registryv1.VulnerabilitySeverity `json:"severity"`
+	Title        string                           `json:"title,omitempty"`
+}
+
+// Package represents a package installed in an image.
+type Package struct {
+	Ecosystem string
+	Name      string
+	Version   string
+}
+
+// Database is the offline vulnerability database loaded from the json files
+// of a directory, reloaded once the files are changed.
+type Database struct {
+	dir string
+
+	mu       sync.RWMutex
+	modTime  time.Time
+	version  string
+	packages map[string][]DatabaseEntry
+}
+
+// NewDatabase creates the vulnerability database loaded from the directory.
+func NewDatabase(dir string) *Database {
+	return &Database{dir: dir}
+}
+
+// Refresh reloads the database if the files in the directory are changed.
+func (d *Database) Refresh() error {
+	files, err := filepath.Glob(filepath.Join(d.dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no vulnerability database found in %s", d.dir)
+	}
+	sort.Strings(files)
+
+	var modTime time.Time
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		if info.ModTime().After(modTime) {
+			modTime = info.ModTime()
+		}
+	}
+	d.mu.RLock()
+	loaded := d.packages != nil && !modTime.After(d.modTime)
+	d.mu.RUnlock()
+	if loaded {
+		return nil
+	}
+
+	packages := make(map[string][]DatabaseEntry)
+	var versions []string
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		var db DatabaseFile
+		if err := json.Unmarshal(data, &db); err != nil {
+			return fmt.Errorf("invalid vulnerability database %s: %v", file, err)
+		}
+		for _, entry := range db.Vulnerabilities {
+			key := packageKey(entry.Ecosystem, entry.Package)
+			packages[key] = append(packages[key], entry)
+		}
+		versions = append(versions, db.Version)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.modTime = modTime
+	d.version = strings.Join(versions, ",")
+	d.packages = packages
+	log.Info("Vulnerability database loaded", log.String("dir", d.dir), log.String("version", d.version), log.Int("packages", len(packages)))
+	return nil
+}
+
+// Match returns the version of the database and the vulnerabilities of the
+// packages.
+func (d *Database) Match(packages []Package) (string, []registryv1.Vulnerability) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	var vulnerabilities []registryv1.Vulnerability
+	for _, pkg := range packages {
+		for _, entry := range d.packages[packageKey(pkg.Ecosystem, pkg.Name)] {
+			if entry.FixedVersion != "" && compareVersions(pkg.Version, entry.FixedVersion) >= 0 {
+				continue
+			}
+			severity := entry.Severity
+			if _, ok := severityRanks[severity]; !ok {
+				severity = registryv1.VulnerabilityUnknown
+			}
+			vulnerabilities = append(vulnerabilities, registryv1.Vulnerability{
+				ID:           entry.ID,
+				Severity:     severity,
+				Package:      pkg.Name,
+				Version:      pkg.Version,
+				FixedVersion: entry.FixedVersion,
+				Title:        entry.Title,
+			})
+		}
+	}
+	// The most severe vulnerabilities are listed first.
+	sort.SliceStable(vulnerabilities, func(i, j int) bool {
+		return severityRanks[vulnerabilities[i].Severity] > severityRanks[vulnerabilities[j].Severity]
+	})
+	return d.version, vulnerabilities
+}
+
+var severityRanks = map[registryv1.VulnerabilitySeverity]int{
+	registryv1.VulnerabilityUnknown:  0,
+	registryv1.VulnerabilityLow:      1,
+	registryv1.VulnerabilityMedium:   2,
+	registryv1.VulnerabilityHigh:     3,
+	registryv1.VulnerabilityCritical: 4,
+}
+
+func packageKey(ecosystem, name string) string {
+	return ecosystem + "/" + name
+}
@@ -0,0 +1,255 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package scan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/docker/distribution"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	registryv1informer "tkestack.io/tke/api/client/informers/externalversions/registry/v1"
+	registryv1lister "tkestack.io/tke/api/client/listers/registry/v1"
+	registryv1 "tkestack.io/tke/api/registry/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	controllerName = "scan-controller"
+)
+
+// Controller is responsible for scanning the images of the repository tags
+// for vulnerabilities when they are pushed or a scan is requested.
+type Controller struct {
+	client       clientset.Interface
+	scanner      *Scanner
+	database     *Database
+	queue        workqueue.RateLimitingInterface
+	lister       registryv1lister.RepositoryLister
+	listerSynced cache.InformerSynced
+}
+
+// NewController creates a new Controller object.
+func NewController(client clientset.Interface, repositoryInformer registryv1informer.RepositoryInformer,
+	resyncPeriod time.Duration, registry distribution.Namespace, databaseDir string) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client:   client,
+		scanner:  NewScanner(registry),
+		database: NewDatabase(databaseDir),
+		queue:    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.RegistryV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("scan_controller", client.RegistryV1().RESTClient().GetRateLimiter())
+	}
+
+	repositoryInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				controller.enqueue(newObj)
+			},
+		},
+		resyncPeriod,
+	)
+	controller.lister = repositoryInformer.Lister()
+	controller.listerSynced = repositoryInformer.Informer().HasSynced
+
+	return controller
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	repository, ok := obj.(*registryv1.Repository)
+	if !ok || !needsScan(repository) {
+		return
+	}
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		log.Error("Couldn't get key for object", log.Any("object", obj), log.Err(err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// needsScan returns true if the image of any tag of the repository is pushed
+// but not scanned yet, or a scan of it is requested.
+func needsScan(repository *registryv1.Repository) bool {
+	for _, tag := range repository.Status.Tags {
+		if tagNeedsScan(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func tagNeedsScan(tag registryv1.RepositoryTag) bool {
+	return tag.Digest != "" &&
+		(tag.Scan == nil || tag.Scan.Phase == registryv1.TagScanPending || tag.Scan.Digest != tag.Digest)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Start the informer factories to begin populating the informer caches
+	log.Info("Starting scan controller")
+	defer log.Info("Shutting down scan controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		log.Error("Failed to wait for scan caches to sync")
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of repository objects.
+// Each repository can be in the queue at most once.
+// The system ensures that no two workers can process
+// the same repository at the same time.
+func (c *Controller) worker() {
+	workFunc := func() bool {
+		key, quit := c.queue.Get()
+		if quit {
+			return true
+		}
+		defer c.queue.Done(key)
+
+		err := c.syncItem(key.(string))
+		if err == nil {
+			// no error, forget this entry and return
+			c.queue.Forget(key)
+			return false
+		}
+
+		// rather than wait for a full resync, re-add the repository to the queue to be processed
+		c.queue.AddRateLimited(key)
+		runtime.HandleError(err)
+		return false
+	}
+
+	for {
+		quit := workFunc()
+
+		if quit {
+			return
+		}
+	}
+}
+
+// syncItem scans the images of the tags of the repository with the given
+// key. This function is not meant to be invoked concurrently with the same
+// key.
+func (c *Controller) syncItem(key string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished syncing repository scan", log.String("repository", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	repository, err := c.lister.Repositories(namespace).Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve repository from store", log.String("repository", key), log.Err(err))
+		return err
+	}
+	if !needsScan(repository) {
+		return nil
+	}
+	if err := c.database.Refresh(); err != nil {
+		return err
+	}
+	return c.process(context.Background(), repository)
+}
+
+func (c *Controller) process(ctx context.Context, repository *registryv1.Repository) error {
+	name := fmt.Sprintf("%s-%s/%s", repository.Spec.TenantID, repository.Spec.NamespaceName, repository.Spec.Name)
+	scans := make(map[string]*registryv1.TagScan)
+	for _, tag := range repository.Status.Tags {
+		if !tagNeedsScan(tag) {
+			continue
+		}
+		scan := &registryv1.TagScan{
+			Digest:       tag.Digest,
+			LastScanTime: metav1.Now(),
+		}
+		packages, err := c.scanner.Packages(ctx, name, tag.Digest)
+		if err != nil {
+			log.Error("Failed to scan image", log.String("repository", name), log.String("tag", tag.Name), log.Err(err))
+			scan.Phase = registryv1.TagScanFailed
+			scan.Message = err.Error()
+			if tag.Scan != nil {
+				// The findings of the last scan are kept for the policy.
+				scan.Summary = tag.Scan.Summary
+				scan.Vulnerabilities = tag.Scan.Vulnerabilities
+				scan.DatabaseVersion = tag.Scan.DatabaseVersion
+			}
+		} else {
+			scan.Phase = registryv1.TagScanFinished
+			scan.DatabaseVersion, scan.Vulnerabilities = c.database.Match(packages)
+			scan.Summary = make(map[string]int32)
+			for _, vulnerability := range scan.Vulnerabilities {
+				scan.Summary[string(vulnerability.Severity)]++
+			}
+			log.Info("Image scanned", log.String("repository", name), log.String("tag", tag.Name),
+				log.Int("packages", len(packages)), log.Int("vulnerabilities", len(scan.Vulnerabilities)))
+		}
+		scans[tag.Name] = scan
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := c.client.RegistryV1().Repositories(repository.Namespace).Get(ctx, repository.Name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		for i, tag := range current.Status.Tags {
+			// The tags pushed again meanwhile are scanned next time.
+			if scan, ok := scans[tag.Name]; ok && scan.Digest == tag.Digest {
+				current.Status.Tags[i].Scan = scan
+			}
+		}
+		_, err = c.client.RegistryV1().Repositories(repository.Namespace).UpdateStatus(ctx, current, metav1.UpdateOptions{})
+		return err
+	})
+}
@@ -0,0 +1,245 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package scan
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/manifest/manifestlist"
+	"github.com/docker/distribution/manifest/ocischema"
+	"github.com/docker/distribution/manifest/schema2"
+	"github.com/docker/distribution/reference"
+	"github.com/opencontainers/go-digest"
+)
+
+const (
+	dpkgStatusFile     = "var/lib/dpkg/status"
+	dpkgStatusDir      = "var/lib/dpkg/status.d/"
+	apkInstalledFile   = "lib/apk/db/installed"
+	whiteoutPrefix     = ".wh."
+	opaqueWhiteout     = ".wh..wh..opq"
+	maxPackageFileSize = 64 << 20
+)
+
+// Scanner lists the packages installed in the images kept in the storage of
+// the registry.
+type Scanner struct {
+	registry distribution.Namespace
+}
+
+// NewScanner creates a scanner reading the images from the registry.
+func NewScanner(registry distribution.Namespace) *Scanner {
+	return &Scanner{registry: registry}
+}
+
+// Packages returns the packages installed in the image of the repository
+// with the digest. The packages of all the platforms of a manifest list are
+// returned.
+func (s *Scanner) Packages(ctx context.Context, repository, imageDigest string) ([]Package, error) {
+	named, err := reference.WithName(repository)
+	if err != nil {
+		return nil, err
+	}
+	dgst, err := digest.Parse(imageDigest)
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.registry.Repository(ctx, named)
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := repo.Manifests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.manifestPackages(ctx, repo, manifests, dgst)
+}
+
+func (s *Scanner) manifestPackages(ctx context.Context, repo distribution.Repository, manifests distribution.ManifestService, dgst digest.Digest) ([]Package, error) {
+	manifest, err := manifests.Get(ctx, dgst)
+	if err != nil {
+		return nil, err
+	}
+
+	var layers []distribution.Descriptor
+	switch m := manifest.(type) {
+	case *schema2.DeserializedManifest:
+		layers = m.Layers
+	case *ocischema.DeserializedManifest:
+		layers = m.Layers
+	case *manifestlist.DeserializedManifestList:
+		var packages []Package
+		for _, descriptor := range m.Manifests {
+			platformPackages, err := s.manifestPackages(ctx, repo, manifests, descriptor.Digest)
+			if err != nil {
+				return nil, err
+			}
+			packages = append(packages, platformPackages...)
+		}
+		return uniquePackages(packages), nil
+	default:
+		return nil, fmt.Errorf("unsupported manifest type %T", manifest)
+	}
+
+	// The package databases in the upper layers replace the lower ones.
+	files := make(map[string][]byte)
+	for _, layer := range layers {
+		if err := readLayer(ctx, repo.Blobs(ctx), layer.Digest, files); err != nil {
+			return nil, fmt.Errorf("failed to read layer %s: %v", layer.Digest, err)
+		}
+	}
+
+	var packages []Package
+	for name, data := range files {
+		switch {
+		case name == dpkgStatusFile || strings.HasPrefix(name, dpkgStatusDir):
+			packages = append(packages, parsePackages(data, EcosystemDpkg, "Package: ", "Version: ")...)
+		case name == apkInstalledFile:
+			packages = append(packages, parsePackages(data, EcosystemApk, "P:", "V:")...)
+		}
+	}
+	return uniquePackages(packages), nil
+}
+
+// readLayer reads the package databases in the layer into the files. The
+// whiteouts of the layer only delete the files of the lower layers.
+func readLayer(ctx context.Context, blobs distribution.BlobStore, dgst digest.Digest, files map[string][]byte) error {
+	blob, err := blobs.Open(ctx, dgst)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	reader := bufio.NewReader(blob)
+	var r io.Reader = reader
+	if magic, err := reader.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(reader)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	tr := tar.NewReader(r)
+	added := make(map[string][]byte)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			for name, data := range added {
+				files[name] = data
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		name := strings.TrimPrefix(path.Clean("/"+header.Name), "/")
+		dir, base := path.Split(name)
+		if base == opaqueWhiteout {
+			// the directory replaces the one of the lower layers
+			for file := range files {
+				if strings.HasPrefix(file, dir) {
+					delete(files, file)
+				}
+			}
+			continue
+		}
+		if strings.HasPrefix(base, whiteoutPrefix) {
+			deleted := dir + strings.TrimPrefix(base, whiteoutPrefix)
+			for file := range files {
+				if file == deleted || strings.HasPrefix(file, deleted+"/") {
+					delete(files, file)
+				}
+			}
+			continue
+		}
+		if !isPackageFile(name) || header.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := ioutil.ReadAll(io.LimitReader(tr, maxPackageFileSize))
+		if err != nil {
+			return err
+		}
+		added[name] = data
+	}
+}
+
+func isPackageFile(name string) bool {
+	return name == dpkgStatusFile || name == apkInstalledFile ||
+		(strings.HasPrefix(name, dpkgStatusDir) && !strings.HasSuffix(name, ".md5sums"))
+}
+
+// parsePackages parses the stanzas of a dpkg status file or an apk installed
+// file, which are separated by blank lines.
+func parsePackages(data []byte, ecosystem, namePrefix, versionPrefix string) []Package {
+	var (
+		packages []Package
+		current  Package
+		removed  bool
+	)
+	flush := func() {
+		if current.Name != "" && current.Version != "" && !removed {
+			current.Ecosystem = ecosystem
+			packages = append(packages, current)
+		}
+		current = Package{}
+		removed = false
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), maxPackageFileSize)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.TrimSpace(line) == "":
+			flush()
+		case strings.HasPrefix(line, namePrefix):
+			current.Name = strings.TrimSpace(strings.TrimPrefix(line, namePrefix))
+		case strings.HasPrefix(line, versionPrefix):
+			current.Version = strings.TrimSpace(strings.TrimPrefix(line, versionPrefix))
+		case ecosystem == EcosystemDpkg && strings.HasPrefix(line, "Status: "):
+			// The packages removed but not purged are still listed.
+			removed = !strings.HasSuffix(line, " installed")
+		}
+	}
+	flush()
+	return packages
+}
+
+func uniquePackages(packages []Package) []Package {
+	seen := make(map[Package]bool, len(packages))
+	var unique []Package
+	for _, pkg := range packages {
+		if !seen[pkg] {
+			seen[pkg] = true
+			unique = append(unique, pkg)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,89 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package scan
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/docker/distribution/reference"
+	"github.com/docker/distribution/registry/storage"
+	"github.com/docker/distribution/registry/storage/driver/inmemory"
+)
+
+func layerOf(t *testing.T, files ...string) []byte {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, name := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Typeflag: tar.TypeReg, Mode: 0644, Size: int64(len(name))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadLayerWhiteouts(t *testing.T) {
+	ctx := context.Background()
+	registry, err := storage.NewRegistry(ctx, inmemory.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	named, _ := reference.WithName("tenant-namespace/app")
+	repo, err := registry.Repository(ctx, named)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blobs := repo.Blobs(ctx)
+
+	layers := [][]byte{
+		layerOf(t, dpkgStatusDir+"base", dpkgStatusDir+"openssl", apkInstalledFile),
+		// the new file comes before the opaque whiteout of its directory,
+		// which only hides the files of the lower layers
+		layerOf(t, dpkgStatusDir+"app", dpkgStatusDir+opaqueWhiteout, "lib/apk/db/"+whiteoutPrefix+"installed"),
+	}
+	files := make(map[string][]byte)
+	for _, layer := range layers {
+		desc, err := blobs.Put(ctx, "application/vnd.oci.image.layer.v1.tar", layer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := readLayer(ctx, blobs, desc.Digest, files); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if want := []string{dpkgStatusDir + "app"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("readLayer() files = %v, want %v", names, want)
+	}
+}
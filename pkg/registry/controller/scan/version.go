@@ -0,0 +1,125 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package scan
+
+import (
+	"strings"
+	"unicode"
+)
+
+// compareVersions compares two versions of a package following the rules of
+// dpkg, which also orders the versions of the apk packages. It returns a
+// negative number if a is older than b, zero if they are equal, and a
+// positive number otherwise.
+func compareVersions(a, b string) int {
+	aEpoch, aUpstream, aRevision := splitVersion(a)
+	bEpoch, bUpstream, bRevision := splitVersion(b)
+	if r := compareFragment(aEpoch, bEpoch); r != 0 {
+		return r
+	}
+	if r := compareFragment(aUpstream, bUpstream); r != 0 {
+		return r
+	}
+	return compareFragment(aRevision, bRevision)
+}
+
+// splitVersion splits a version into [epoch:]upstream[-revision], the apk
+// revision -rN is treated as the revision as well.
+func splitVersion(version string) (epoch, upstream, revision string) {
+	upstream = version
+	if idx := strings.Index(upstream, ":"); idx >= 0 {
+		epoch, upstream = upstream[:idx], upstream[idx+1:]
+	}
+	if idx := strings.LastIndex(upstream, "-"); idx >= 0 {
+		upstream, revision = upstream[:idx], upstream[idx+1:]
+		revision = strings.TrimPrefix(revision, "r")
+	}
+	return
+}
+
+// compareFragment compares the alternating non-digit and digit parts of two
+// version fragments.
+func compareFragment(a, b string) int {
+	for len(a) != 0 || len(b) != 0 {
+		var aText, bText string
+		aText, a = splitLeading(a, isNotDigit)
+		bText, b = splitLeading(b, isNotDigit)
+		if r := compareText(aText, bText); r != 0 {
+			return r
+		}
+
+		var aNum, bNum string
+		aNum, a = splitLeading(a, unicode.IsDigit)
+		bNum, b = splitLeading(b, unicode.IsDigit)
+		if r := compareNumber(aNum, bNum); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+func isNotDigit(r rune) bool {
+	return !unicode.IsDigit(r)
+}
+
+func splitLeading(s string, f func(rune) bool) (string, string) {
+	idx := strings.IndexFunc(s, func(r rune) bool { return !f(r) })
+	if idx < 0 {
+		return s, ""
+	}
+	return s[:idx], s[idx:]
+}
+
+func compareNumber(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+	return strings.Compare(a, b)
+}
+
+// compareText compares the non-digit parts, in which '~' sorts before
+// anything, even the end of the part, and letters sort before the others.
+func compareText(a, b string) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var ac, bc int
+		if i < len(a) {
+			ac = order(a[i])
+		}
+		if i < len(b) {
+			bc = order(b[i])
+		}
+		if ac != bc {
+			return ac - bc
+		}
+	}
+	return 0
+}
+
+func order(c byte) int {
+	switch {
+	case c == '~':
+		return -1
+	case unicode.IsLetter(rune(c)):
+		return int(c)
+	default:
+		return int(c) + 256
+	}
+}
@@ -0,0 +1,297 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package signature
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/docker/distribution"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	registryv1informer "tkestack.io/tke/api/client/informers/externalversions/registry/v1"
+	registryv1lister "tkestack.io/tke/api/client/listers/registry/v1"
+	registryv1 "tkestack.io/tke/api/registry/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/registry/util"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	controllerName = "signature-controller"
+)
+
+// Controller is responsible for verifying the cosign signatures of the images
+// of the repository tags with the trusted keys of their tenants.
+type Controller struct {
+	client                 clientset.Interface
+	verifier               *Verifier
+	queue                  workqueue.RateLimitingInterface
+	repositoryLister       registryv1lister.RepositoryLister
+	repositoryListerSynced cache.InformerSynced
+	keyLister              registryv1lister.TrustedKeyLister
+	keyListerSynced        cache.InformerSynced
+}
+
+// NewController creates a new Controller object.
+func NewController(client clientset.Interface, repositoryInformer registryv1informer.RepositoryInformer,
+	keyInformer registryv1informer.TrustedKeyInformer, resyncPeriod time.Duration, registry distribution.Namespace) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client:   client,
+		verifier: NewVerifier(registry),
+		queue:    workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.RegistryV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("signature_controller", client.RegistryV1().RESTClient().GetRateLimiter())
+	}
+
+	// the signatures are pushed after the images, the repositories are
+	// verified again periodically
+	repositoryInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				controller.enqueue(newObj)
+			},
+		},
+		resyncPeriod,
+	)
+	controller.repositoryLister = repositoryInformer.Lister()
+	controller.repositoryListerSynced = repositoryInformer.Informer().HasSynced
+
+	keyInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueueTenant,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				controller.enqueueTenant(newObj)
+			},
+			DeleteFunc: controller.enqueueTenant,
+		},
+	)
+	controller.keyLister = keyInformer.Lister()
+	controller.keyListerSynced = keyInformer.Informer().HasSynced
+
+	return controller
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		log.Error("Couldn't get key for object", log.Any("object", obj), log.Err(err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// enqueueTenant verifies the repositories of the tenant of the trusted key
+// again.
+func (c *Controller) enqueueTenant(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	trustedKey, ok := obj.(*registryv1.TrustedKey)
+	if !ok {
+		return
+	}
+	repositories, err := c.repositoryLister.List(labels.Everything())
+	if err != nil {
+		log.Error("Failed to list repositories", log.Err(err))
+		return
+	}
+	for _, repository := range repositories {
+		if repository.Spec.TenantID == trustedKey.Spec.TenantID {
+			c.enqueue(repository)
+		}
+	}
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Start the informer factories to begin populating the informer caches
+	log.Info("Starting signature controller")
+	defer log.Info("Shutting down signature controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.repositoryListerSynced, c.keyListerSynced); !ok {
+		log.Error("Failed to wait for signature caches to sync")
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of repository objects.
+// Each repository can be in the queue at most once.
+// The system ensures that no two workers can process
+// the same repository at the same time.
+func (c *Controller) worker() {
+	workFunc := func() bool {
+		key, quit := c.queue.Get()
+		if quit {
+			return true
+		}
+		defer c.queue.Done(key)
+
+		err := c.syncItem(key.(string))
+		if err == nil {
+			// no error, forget this entry and return
+			c.queue.Forget(key)
+			return false
+		}
+
+		// rather than wait for a full resync, re-add the repository to the queue to be processed
+		c.queue.AddRateLimited(key)
+		runtime.HandleError(err)
+		return false
+	}
+
+	for {
+		quit := workFunc()
+
+		if quit {
+			return
+		}
+	}
+}
+
+// syncItem verifies the signatures of the images of the tags of the
+// repository with the given key. This function is not meant to be invoked
+// concurrently with the same key.
+func (c *Controller) syncItem(key string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Debug("Finished syncing repository signatures", log.String("repository", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	repository, err := c.repositoryLister.Repositories(namespace).Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve repository from store", log.String("repository", key), log.Err(err))
+		return err
+	}
+	if len(repository.Status.Tags) == 0 {
+		return nil
+	}
+	return c.process(context.Background(), repository)
+}
+
+// trustedKeys returns the valid trusted keys of the tenant.
+func (c *Controller) trustedKeys(tenantID string) ([]TrustedKey, error) {
+	trustedKeys, err := c.keyLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var keys []TrustedKey
+	for _, trustedKey := range trustedKeys {
+		if trustedKey.Spec.TenantID != tenantID {
+			continue
+		}
+		key, err := util.ParsePublicKey(trustedKey.Spec.PublicKey)
+		if err != nil {
+			log.Warn("Ignore invalid trusted key", log.String("trustedKey", trustedKey.Name), log.Err(err))
+			continue
+		}
+		keys = append(keys, TrustedKey{Name: trustedKey.Name, Key: key})
+	}
+	return keys, nil
+}
+
+func (c *Controller) process(ctx context.Context, repository *registryv1.Repository) error {
+	keys, err := c.trustedKeys(repository.Spec.TenantID)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s/%s", repository.Spec.TenantID, repository.Spec.NamespaceName, repository.Spec.Name)
+	signatures := make(map[string]*registryv1.TagSignature)
+	var lastErr error
+	for _, tag := range repository.Status.Tags {
+		if tag.Digest == "" || util.IsSignatureTag(tag.Name) {
+			continue
+		}
+		signed, signedBy, err := c.verifier.Verify(ctx, name, tag.Digest, keys)
+		if err != nil {
+			log.Error("Failed to verify image signatures", log.String("repository", name), log.String("tag", tag.Name), log.Err(err))
+			lastErr = err
+			continue
+		}
+		if tag.Signature != nil && tag.Signature.Digest == tag.Digest &&
+			tag.Signature.Signed == signed && reflect.DeepEqual(tag.Signature.Keys, signedBy) {
+			continue
+		}
+		signatures[tag.Name] = &registryv1.TagSignature{
+			Digest:         tag.Digest,
+			Signed:         signed,
+			Keys:           signedBy,
+			LastVerifyTime: metav1.Now(),
+		}
+		log.Info("Image signatures verified", log.String("repository", name), log.String("tag", tag.Name),
+			log.Bool("signed", signed), log.Strings("keys", signedBy))
+	}
+	if len(signatures) == 0 {
+		return lastErr
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := c.client.RegistryV1().Repositories(repository.Namespace).Get(ctx, repository.Name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		for i, tag := range current.Status.Tags {
+			// The tags pushed again meanwhile are verified next time.
+			if signature, ok := signatures[tag.Name]; ok && signature.Digest == tag.Digest {
+				current.Status.Tags[i].Signature = signature
+			}
+		}
+		_, err = c.client.RegistryV1().Repositories(repository.Namespace).UpdateStatus(ctx, current, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return lastErr
+}
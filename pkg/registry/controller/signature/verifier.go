@@ -0,0 +1,162 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/reference"
+	"tkestack.io/tke/pkg/registry/util"
+)
+
+const (
+	// signatureAnnotation is the annotation of the layers cosign stores the
+	// base64 encoded signatures of the payloads in.
+	signatureAnnotation = "dev.cosignproject.cosign/signature"
+)
+
+// TrustedKey is a public key of a tenant the signatures are verified with.
+type TrustedKey struct {
+	Name string
+	Key  crypto.PublicKey
+}
+
+// Verifier verifies the cosign signatures of the images stored in the
+// storage of the registry.
+type Verifier struct {
+	registry distribution.Namespace
+}
+
+// NewVerifier creates a verifier reading the signatures of the given registry.
+func NewVerifier(registry distribution.Namespace) *Verifier {
+	return &Verifier{registry: registry}
+}
+
+// simpleSigning is the payload signed by cosign.
+type simpleSigning struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+// Verify returns whether any signature of the image with the given digest is
+// stored in the repository, and the names of the keys that verify one of
+// them.
+func (v *Verifier) Verify(ctx context.Context, repoName, dgst string, keys []TrustedKey) (bool, []string, error) {
+	named, err := reference.WithName(repoName)
+	if err != nil {
+		return false, nil, err
+	}
+	repo, err := v.registry.Repository(ctx, named)
+	if err != nil {
+		return false, nil, err
+	}
+	desc, err := repo.Tags(ctx).Get(ctx, util.SignatureTag(dgst))
+	if err != nil {
+		if _, ok := err.(distribution.ErrTagUnknown); ok {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	manifests, err := repo.Manifests(ctx)
+	if err != nil {
+		return false, nil, err
+	}
+	manifest, err := manifests.Get(ctx, desc.Digest)
+	if err != nil {
+		return false, nil, err
+	}
+
+	signed := false
+	verified := make(map[string]bool)
+	for _, layer := range manifest.References() {
+		encoded, ok := layer.Annotations[signatureAnnotation]
+		if layer.MediaType != util.SimpleSigningMediaType || !ok {
+			continue
+		}
+		payload, err := repo.Blobs(ctx).Get(ctx, layer.Digest)
+		if err != nil {
+			return false, nil, err
+		}
+		if !signs(payload, dgst) {
+			continue
+		}
+		signed = true
+		signature, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			continue
+		}
+		for _, key := range keys {
+			if verifySignature(key.Key, payload, signature) == nil {
+				verified[key.Name] = true
+			}
+		}
+	}
+
+	var names []string
+	for name := range verified {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return signed, names, nil
+}
+
+// signs returns true if the payload claims to sign the image with the given
+// digest.
+func signs(payload []byte, dgst string) bool {
+	var s simpleSigning
+	if err := json.Unmarshal(payload, &s); err != nil {
+		return false
+	}
+	return s.Critical.Image.DockerManifestDigest == dgst
+}
+
+// verifySignature verifies the signature of the payload the way cosign signs
+// with the key: ecdsa and rsa pkcs1v15 with sha256, or ed25519.
+func verifySignature(key crypto.PublicKey, payload, signature []byte) error {
+	hash := sha256.Sum256(payload)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, hash[:], signature) {
+			return fmt.Errorf("invalid ecdsa signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], signature)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, payload, signature) {
+			return fmt.Errorf("invalid ed25519 signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+}
@@ -0,0 +1,151 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package signature
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/manifest"
+	"github.com/docker/distribution/manifest/ocischema"
+	"github.com/docker/distribution/reference"
+	"github.com/docker/distribution/registry/storage"
+	"github.com/docker/distribution/registry/storage/driver/inmemory"
+	"tkestack.io/tke/pkg/registry/util"
+)
+
+const (
+	imageDigest          = "sha256:2d1b0f4e6c0d7a3e8f6d1e2c3b4a5f6e7d8c9b0a1f2e3d4c5b6a7f8e9d0c1b2a"
+	ociConfigMediaType   = "application/vnd.oci.image.config.v1+json"
+	ociManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+)
+
+func sign(t *testing.T, key crypto.Signer, payload []byte) []byte {
+	var (
+		signature []byte
+		err       error
+	)
+	if _, ok := key.(ed25519.PrivateKey); ok {
+		signature, err = key.Sign(rand.Reader, payload, crypto.Hash(0))
+	} else {
+		hash := sha256.Sum256(payload)
+		signature, err = key.Sign(rand.Reader, hash[:], crypto.SHA256)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signature
+}
+
+func TestVerifySignature(t *testing.T) {
+	ecdsaKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	_, ed25519Key, _ := ed25519.GenerateKey(rand.Reader)
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	payload := []byte("payload")
+
+	for _, key := range []crypto.Signer{ecdsaKey, rsaKey, ed25519Key} {
+		signature := sign(t, key, payload)
+		if err := verifySignature(key.Public(), payload, signature); err != nil {
+			t.Errorf("%T: verifySignature() = %v, want nil", key, err)
+		}
+		if err := verifySignature(key.Public(), []byte("tampered"), signature); err == nil {
+			t.Errorf("%T: verifySignature() of tampered payload = nil, want error", key)
+		}
+		if err := verifySignature(otherKey.Public(), payload, signature); err == nil {
+			t.Errorf("%T: verifySignature() with other key = nil, want error", key)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+	registry, err := storage.NewRegistry(ctx, inmemory.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	named, _ := reference.WithName("tenant-namespace/app")
+	repo, err := registry.Repository(ctx, named)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	trustedKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	keys := []TrustedKey{
+		{Name: "trusted", Key: trustedKey.Public()},
+		{Name: "other", Key: otherKey.Public()},
+	}
+
+	verifier := NewVerifier(registry)
+	signed, signedBy, err := verifier.Verify(ctx, named.Name(), imageDigest, keys)
+	if err != nil || signed || len(signedBy) != 0 {
+		t.Fatalf("Verify() of unsigned image = %v, %v, %v, want false, [], nil", signed, signedBy, err)
+	}
+
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"%s"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`, named.Name(), imageDigest))
+	blobs := repo.Blobs(ctx)
+	config, err := blobs.Put(ctx, ociConfigMediaType, []byte("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	layer, err := blobs.Put(ctx, util.SimpleSigningMediaType, payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	layer.MediaType = util.SimpleSigningMediaType
+	layer.Annotations = map[string]string{
+		signatureAnnotation: base64.StdEncoding.EncodeToString(sign(t, trustedKey, payload)),
+	}
+	m, err := ocischema.FromStruct(ocischema.Manifest{
+		Versioned: manifest.Versioned{SchemaVersion: 2, MediaType: ociManifestMediaType},
+		Config:    distribution.Descriptor{MediaType: ociConfigMediaType, Digest: config.Digest, Size: config.Size},
+		Layers:    []distribution.Descriptor{layer},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifests, err := repo.Manifests(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dgst, err := manifests.Put(ctx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Tags(ctx).Tag(ctx, util.SignatureTag(imageDigest), distribution.Descriptor{Digest: dgst}); err != nil {
+		t.Fatal(err)
+	}
+
+	signed, signedBy, err = verifier.Verify(ctx, named.Name(), imageDigest, keys)
+	if err != nil || !signed || !reflect.DeepEqual(signedBy, []string{"trusted"}) {
+		t.Errorf("Verify() of signed image = %v, %v, %v, want true, [trusted], nil", signed, signedBy, err)
+	}
+}
@@ -102,6 +102,10 @@ func (r *repositoryFilter) filter(ctx context.Context, a *token.ResourceActions,
 		} else if namespace.Spec.Visibility == registry.VisibilityPublic {
 			permission = "R"
 		}
+		if namespace.Spec.Proxy != nil && permission != "" {
+			// the images of a proxy namespace are only cached from its upstream registry
+			permission = "R"
+		}
 	}
 
 	log.Debug("Filtered repository authorization", log.Any("resourceAction", a), log.String("requestTenantID", img.tenantID), log.String("userTenantID", u.userTenantID), log.String("username", u.username), log.String("permission", permission))
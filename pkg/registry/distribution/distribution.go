@@ -31,7 +31,10 @@ import (
 	"tkestack.io/tke/pkg/registry/distribution/auth"
 	rcontext "tkestack.io/tke/pkg/registry/distribution/context"
 	"tkestack.io/tke/pkg/registry/distribution/notification"
+	"tkestack.io/tke/pkg/registry/distribution/proxy"
+	"tkestack.io/tke/pkg/registry/distribution/signature"
 	"tkestack.io/tke/pkg/registry/distribution/tenant"
+	"tkestack.io/tke/pkg/registry/distribution/vulnerability"
 	"tkestack.io/tke/pkg/util/transport"
 
 	// import filesystem driver to store images
@@ -120,6 +123,22 @@ func buildDistributionConfig(opts *Options) (*configuration.Configuration, error
 	dist.Notifications.Endpoints = endpoints
 	dist.HTTP.Secret = opts.RegistryConfig.Security.HTTPSecret
 	dist.Compatibility.Schema1.Enabled = false
+	dist.Middleware = map[string][]configuration.Middleware{
+		"registry": {
+			{
+				Name:    proxy.Name,
+				Options: proxy.Options(opts.LoopbackClientConfig),
+			},
+			{
+				Name:    vulnerability.Name,
+				Options: vulnerability.Options(opts.LoopbackClientConfig),
+			},
+			{
+				Name:    signature.Name,
+				Options: signature.Options(opts.LoopbackClientConfig),
+			},
+		},
+	}
 
 	if opts.RegistryConfig.Redis != nil {
 		redisCfg := opts.RegistryConfig.Redis
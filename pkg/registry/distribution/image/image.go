@@ -0,0 +1,119 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package image resolves the manifests pulled from docker distribution to the
+// tags recorded in the repositories, for the pull policies of the namespaces.
+package image
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/docker/distribution"
+	dcontext "github.com/docker/distribution/context"
+	"github.com/docker/distribution/manifest/manifestlist"
+	"github.com/docker/distribution/manifest/ocischema"
+	"github.com/docker/distribution/manifest/schema2"
+	"github.com/opencontainers/go-digest"
+	"tkestack.io/tke/api/registry"
+	"tkestack.io/tke/pkg/registry/util"
+)
+
+// PulledReference returns the tag or digest of the manifest pulled by the
+// request of the context of docker distribution, false if the request does
+// not pull a manifest.
+func PulledReference(ctx context.Context) (string, bool) {
+	r, err := dcontext.GetRequest(ctx)
+	if err != nil || (r.Method != http.MethodGet && r.Method != http.MethodHead) {
+		return "", false
+	}
+	reference := dcontext.GetStringValue(ctx, "vars.reference")
+	return reference, reference != ""
+}
+
+// Digest returns the digest of the manifest the tag or digest of the
+// repository refers to.
+func Digest(ctx context.Context, repo distribution.Repository, reference string) (digest.Digest, error) {
+	if dgst, err := digest.Parse(reference); err == nil {
+		return dgst, nil
+	}
+	desc, err := repo.Tags(ctx).Get(ctx, reference)
+	if err != nil {
+		return "", err
+	}
+	return desc.Digest, nil
+}
+
+// IsSignature returns true if the manifest is a cosign signature, whose
+// layers are signed payloads rather than file systems.
+func IsSignature(manifest distribution.Manifest) bool {
+	var layers []distribution.Descriptor
+	switch m := manifest.(type) {
+	case *schema2.DeserializedManifest:
+		layers = m.Layers
+	case *ocischema.DeserializedManifest:
+		layers = m.Layers
+	}
+	if len(layers) == 0 {
+		return false
+	}
+	for _, layer := range layers {
+		if layer.MediaType != util.SimpleSigningMediaType {
+			return false
+		}
+	}
+	return true
+}
+
+// ResolveTags returns the tags the digest resolves to: the tags of the
+// manifest with the digest, or else the tags of the manifest lists having
+// the manifest as one of their platforms. The manifest lists that can not
+// be read are skipped, so that the digest resolves to fewer tags.
+func ResolveTags(ctx context.Context, manifests distribution.ManifestService, tags []registry.RepositoryTag, dgst digest.Digest) []*registry.RepositoryTag {
+	var resolved []*registry.RepositoryTag
+	for i := range tags {
+		if tags[i].Digest == dgst.String() {
+			resolved = append(resolved, &tags[i])
+		}
+	}
+	if len(resolved) > 0 {
+		return resolved
+	}
+
+	for i := range tags {
+		parent, err := digest.Parse(tags[i].Digest)
+		if err != nil {
+			continue
+		}
+		manifest, err := manifests.Get(ctx, parent)
+		if err != nil {
+			continue
+		}
+		list, ok := manifest.(*manifestlist.DeserializedManifestList)
+		if !ok {
+			continue
+		}
+		for _, descriptor := range list.Manifests {
+			if descriptor.Digest == dgst {
+				resolved = append(resolved, &tags[i])
+				break
+			}
+		}
+	}
+	return resolved
+}
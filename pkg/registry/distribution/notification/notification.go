@@ -103,7 +103,7 @@ func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 			user = "anonymous"
 		}
 
-		if err := updateRepository(req.Context(), h.registryClient, tenantID, namespace, action, repoName, tag, digest); err != nil {
+		if err := UpdateRepository(req.Context(), h.registryClient, tenantID, namespace, action, repoName, tag, digest); err != nil {
 			log.Error("Failed to handler distribution notification event",
 				log.String("tenantID", tenantID),
 				log.String("namespace", namespace),
@@ -118,7 +118,9 @@ func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
-func updateRepository(ctx context.Context, registryClient *registryinternalclient.RegistryClient, tenantID, namespace, action, repoName, tag, digest string) error {
+// UpdateRepository records the push or pull action of a tag in the repository
+// of the namespace.
+func UpdateRepository(ctx context.Context, registryClient registryinternalclient.RegistryInterface, tenantID, namespace, action, repoName, tag, digest string) error {
 	namespaceList, err := registryClient.Namespaces().List(ctx, metav1.ListOptions{
 		FieldSelector: fmt.Sprintf("spec.tenantID=%s,spec.name=%s", tenantID, namespace),
 	})
@@ -143,9 +145,12 @@ func updateRepository(ctx context.Context, registryClient *registryinternalclien
 
 	switch action {
 	case "push":
-		return util.PushRepository(ctx, registryClient, &namespaceObject, repoObject, repoName, tag, digest)
+		if err := util.PushRepository(ctx, registryClient, &namespaceObject, repoObject, repoName, tag, digest); err != nil {
+			return err
+		}
+		return util.TriggerReplication(ctx, registryClient, tenantID, namespace, repoName, tag, digest)
 	case "pull":
-		return util.PullRepository(ctx, registryClient, &namespaceObject, repoObject, repoName, tag)
+		return util.PullRepository(ctx, registryClient, &namespaceObject, repoObject, repoName, tag, digest)
 	}
 
 	return fmt.Errorf("unknown action in distribution notification event handler")
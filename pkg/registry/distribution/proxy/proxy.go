@@ -0,0 +1,253 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package proxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/reference"
+	middleware "github.com/docker/distribution/registry/middleware/registry"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	restclient "k8s.io/client-go/rest"
+	registryinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/registry/internalversion"
+	"tkestack.io/tke/api/registry"
+	"tkestack.io/tke/pkg/registry/distribution/notification"
+)
+
+// Name is the name of the registry middleware of docker distribution that
+// makes the proxy namespaces pull-through caches of their upstream registries.
+const Name = "tkestack-proxy"
+
+const (
+	loopbackConfigOption = "loopbackconfig"
+	// namespaceCacheTTL is how long the proxy settings of a namespace are
+	// cached to avoid listing the namespace on every request.
+	namespaceCacheTTL = 30 * time.Second
+	// upstreamIdleTTL is how long the client of an upstream registry is kept
+	// after it was last used.
+	upstreamIdleTTL = 10 * time.Minute
+	// sweepInterval is the minimum interval between two evictions of the
+	// expired entries of the caches.
+	sweepInterval = time.Minute
+)
+
+func init() {
+	if err := middleware.Register(Name, newRegistry); err != nil {
+		panic(err)
+	}
+}
+
+// Options returns the options of the registry middleware.
+func Options(loopbackConfig *restclient.Config) map[string]interface{} {
+	return map[string]interface{}{
+		loopbackConfigOption: loopbackConfig,
+	}
+}
+
+func newRegistry(_ context.Context, embedded distribution.Namespace, options map[string]interface{}) (distribution.Namespace, error) {
+	loopbackConfig, ok := options[loopbackConfigOption].(*restclient.Config)
+	if !ok || loopbackConfig == nil {
+		return nil, fmt.Errorf("no loopback config specified for registry middleware %s", Name)
+	}
+	registryClient, err := registryinternalclient.NewForConfig(loopbackConfig)
+	if err != nil {
+		return nil, err
+	}
+	return newProxyRegistry(embedded, registryClient), nil
+}
+
+func newProxyRegistry(embedded distribution.Namespace, registryClient registryinternalclient.RegistryInterface) *proxyRegistry {
+	return &proxyRegistry{
+		Namespace:      embedded,
+		registryClient: registryClient,
+		namespaces:     make(map[string]*cachedNamespace),
+		upstreams:      make(map[string]*cachedUpstream),
+		tags:           make(map[string]time.Time),
+		inflight:       make(map[string]struct{}),
+	}
+}
+
+type cachedNamespace struct {
+	proxy   *registry.NamespaceProxy
+	expires time.Time
+}
+
+type cachedUpstream struct {
+	upstream *upstream
+	lastUsed time.Time
+}
+
+// proxyRegistry serves the repositories of the proxy namespaces from their
+// upstream registries when they are not cached in the local storage, the
+// repositories of the other namespaces are served by the embedded registry.
+type proxyRegistry struct {
+	distribution.Namespace
+	registryClient registryinternalclient.RegistryInterface
+
+	lock       sync.Mutex
+	namespaces map[string]*cachedNamespace
+	upstreams  map[string]*cachedUpstream
+	// tags records when the cached tags are to be checked against the
+	// upstream registries again.
+	tags map[string]time.Time
+	// inflight records the blobs being cached from the upstream registries.
+	inflight map[string]struct{}
+	swept    time.Time
+}
+
+func (r *proxyRegistry) Repository(ctx context.Context, name reference.Named) (distribution.Repository, error) {
+	local, err := r.Namespace.Repository(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	tenantID, namespace, repoName := notification.ParseRepository(name.Name())
+	if tenantID == "" || namespace == "" || repoName == "" {
+		return local, nil
+	}
+	proxy, err := r.proxy(ctx, tenantID, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if proxy == nil {
+		return local, nil
+	}
+	return &repository{
+		Repository: local,
+		registry:   r,
+		upstream:   r.upstream(proxy),
+		proxy:      proxy,
+		tenantID:   tenantID,
+		namespace:  namespace,
+		repoName:   repoName,
+	}, nil
+}
+
+// proxy returns the proxy settings of the namespace, nil if the namespace
+// does not exist or is not a proxy namespace.
+func (r *proxyRegistry) proxy(ctx context.Context, tenantID, namespace string) (*registry.NamespaceProxy, error) {
+	key := fmt.Sprintf("%s/%s", tenantID, namespace)
+	r.lock.Lock()
+	cached, ok := r.namespaces[key]
+	r.lock.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.proxy, nil
+	}
+
+	namespaceList, err := r.registryClient.Namespaces().List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("spec.tenantID=%s,spec.name=%s", tenantID, namespace),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var proxy *registry.NamespaceProxy
+	if len(namespaceList.Items) > 0 {
+		proxy = namespaceList.Items[0].Spec.Proxy
+	}
+	r.lock.Lock()
+	r.namespaces[key] = &cachedNamespace{proxy: proxy, expires: time.Now().Add(namespaceCacheTTL)}
+	r.lock.Unlock()
+	return proxy, nil
+}
+
+// upstream returns the client of the upstream registry of the proxy, the
+// clients are shared by the namespaces proxying the same registry with the
+// same credentials.
+func (r *proxyRegistry) upstream(proxy *registry.NamespaceProxy) *upstream {
+	// the credentials are hashed to be kept out of the keys
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s|%t", proxy.URL, proxy.Username, proxy.Password, proxy.InsecureSkipVerify)))
+	key := hex.EncodeToString(sum[:])
+	now := time.Now()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.sweep(now)
+	cached, ok := r.upstreams[key]
+	if !ok {
+		cached = &cachedUpstream{upstream: newUpstream(proxy)}
+		r.upstreams[key] = cached
+	}
+	cached.lastUsed = now
+	return cached.upstream
+}
+
+// tagFresh tests if the tag was checked against the upstream registry within
+// its ttl.
+func (r *proxyRegistry) tagFresh(key string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	expires, ok := r.tags[key]
+	return ok && time.Now().Before(expires)
+}
+
+func (r *proxyRegistry) tagChecked(key string, ttl time.Duration) {
+	now := time.Now()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.sweep(now)
+	if ttl > 0 {
+		r.tags[key] = now.Add(ttl)
+	}
+}
+
+// sweep evicts the expired namespaces and tags and the idle upstream clients,
+// the caller must hold the lock.
+func (r *proxyRegistry) sweep(now time.Time) {
+	if now.Sub(r.swept) < sweepInterval {
+		return
+	}
+	r.swept = now
+	for key, cached := range r.namespaces {
+		if !now.Before(cached.expires) {
+			delete(r.namespaces, key)
+		}
+	}
+	for key, expires := range r.tags {
+		if !now.Before(expires) {
+			delete(r.tags, key)
+		}
+	}
+	for key, cached := range r.upstreams {
+		if now.Sub(cached.lastUsed) >= upstreamIdleTTL {
+			delete(r.upstreams, key)
+		}
+	}
+}
+
+// startFetch marks the blob as being cached, returns false if it is already
+// being cached by another request.
+func (r *proxyRegistry) startFetch(key string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if _, ok := r.inflight[key]; ok {
+		return false
+	}
+	r.inflight[key] = struct{}{}
+	return true
+}
+
+func (r *proxyRegistry) finishFetch(key string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.inflight, key)
+}
@@ -0,0 +1,227 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/configuration"
+	"github.com/docker/distribution/manifest/schema2"
+	"github.com/docker/distribution/reference"
+	"github.com/docker/distribution/registry/client"
+	"github.com/docker/distribution/registry/handlers"
+	"github.com/docker/distribution/registry/storage"
+	"github.com/docker/distribution/registry/storage/driver/inmemory"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/client/clientset/internalversion/fake"
+	"tkestack.io/tke/api/registry"
+)
+
+// upstreamRegistry serves the upstream registry unless it is down, and counts
+// the manifest requests.
+type upstreamRegistry struct {
+	handler   http.Handler
+	down      int32
+	manifests int32
+}
+
+func (u *upstreamRegistry) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if atomic.LoadInt32(&u.down) == 1 {
+		http.Error(w, "registry unavailable", http.StatusServiceUnavailable)
+		return
+	}
+	if strings.Contains(r.URL.Path, "/manifests/") {
+		atomic.AddInt32(&u.manifests, 1)
+	}
+	u.handler.ServeHTTP(w, r)
+}
+
+func newUpstreamRegistry(t *testing.T) (*upstreamRegistry, string) {
+	config := &configuration.Configuration{
+		Storage: configuration.Storage{
+			"inmemory": configuration.Parameters{},
+			"maintenance": configuration.Parameters{"uploadpurging": map[interface{}]interface{}{
+				"enabled": false,
+			}},
+		},
+	}
+	u := &upstreamRegistry{handler: handlers.NewApp(context.Background(), config)}
+	server := httptest.NewServer(u)
+	t.Cleanup(server.Close)
+	return u, server.URL
+}
+
+// pushImage pushes an image of one layer to the registry and returns the
+// descriptors of its manifest and layer.
+func pushImage(t *testing.T, baseURL, repoName, tag string) (distribution.Descriptor, distribution.Descriptor) {
+	ctx := context.Background()
+	named, _ := reference.WithName(repoName)
+	repo, err := client.NewRepository(named, baseURL, http.DefaultTransport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	layer, err := repo.Blobs(ctx).Put(ctx, schema2.MediaTypeLayer, []byte(repoName+":"+tag))
+	if err != nil {
+		t.Fatal(err)
+	}
+	builder := schema2.NewManifestBuilder(repo.Blobs(ctx), schema2.MediaTypeImageConfig, []byte(`{"architecture":"amd64","os":"linux"}`))
+	if err := builder.AppendReference(layer); err != nil {
+		t.Fatal(err)
+	}
+	manifest, err := builder.Build(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifests, err := repo.Manifests(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := manifests.Put(ctx, manifest, distribution.WithTag(tag)); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := repo.Tags(ctx).Get(ctx, tag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return desc, layer
+}
+
+func newTestRegistry(t *testing.T, upstreamURL string) (*proxyRegistry, distribution.Namespace) {
+	embedded, err := storage.NewRegistry(context.Background(), inmemory.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	registryClient := fake.NewSimpleClientset(&registry.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "rns-1"},
+		Spec: registry.NamespaceSpec{
+			TenantID: "tenant",
+			Name:     "hub",
+			Proxy: &registry.NamespaceProxy{
+				URL:           upstreamURL,
+				Namespace:     "library",
+				TagTTLMinutes: 60,
+			},
+		},
+	}).Registry()
+	return newProxyRegistry(embedded, registryClient), embedded
+}
+
+func TestProxyPull(t *testing.T) {
+	ctx := context.Background()
+	upstream, upstreamURL := newUpstreamRegistry(t)
+	image, layer := pushImage(t, upstreamURL, "library/app", "v1")
+	r, embedded := newTestRegistry(t, upstreamURL)
+
+	named, _ := reference.WithName("tenant-hub/app")
+	repo, err := r.Repository(ctx, named)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := repo.(*repository); !ok {
+		t.Fatalf("Repository() = %T, want the repository of the proxy namespace", repo)
+	}
+	desc, err := repo.Tags(ctx).Get(ctx, "v1")
+	if err != nil || desc.Digest != image.Digest {
+		t.Fatalf("Tags().Get() = %v, %v, want %s", desc.Digest, err, image.Digest)
+	}
+	reader, err := repo.Blobs(ctx).Open(ctx, layer.Digest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = reader.Close()
+
+	// the tag, manifest and blob are cached in the local storage
+	local, err := embedded.Repository(ctx, named)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached, err := local.Tags(ctx).Get(ctx, "v1"); err != nil || cached.Digest != image.Digest {
+		t.Errorf("cached tag = %v, %v, want %s", cached.Digest, err, image.Digest)
+	}
+	if _, err := local.Blobs(ctx).Stat(ctx, layer.Digest); err != nil {
+		t.Errorf("cached blob: %v", err)
+	}
+
+	// the tag is not checked against the upstream registry within its ttl
+	requests := atomic.LoadInt32(&upstream.manifests)
+	if _, err := repo.Tags(ctx).Get(ctx, "v1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := atomic.LoadInt32(&upstream.manifests); got != requests {
+		t.Errorf("fresh tag requested the upstream registry %d times", got-requests)
+	}
+
+	// the cached tag is served while the upstream registry is down
+	r.tags = make(map[string]time.Time)
+	atomic.StoreInt32(&upstream.down, 1)
+	if desc, err := repo.Tags(ctx).Get(ctx, "v1"); err != nil || desc.Digest != image.Digest {
+		t.Errorf("Tags().Get() with upstream down = %v, %v, want %s", desc.Digest, err, image.Digest)
+	}
+
+	manifests, err := repo.Manifests(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := manifests.Put(ctx, nil); err != distribution.ErrUnsupported {
+		t.Errorf("Manifests().Put() = %v, want %v", err, distribution.ErrUnsupported)
+	}
+}
+
+func TestProxyNotProxyNamespace(t *testing.T) {
+	embedded, err := storage.NewRegistry(context.Background(), inmemory.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := newProxyRegistry(embedded, fake.NewSimpleClientset().Registry())
+	named, _ := reference.WithName("tenant-other/app")
+	repo, err := r.Repository(context.Background(), named)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := repo.(*repository); ok {
+		t.Errorf("Repository() of a namespace that is not a proxy = %T", repo)
+	}
+}
+
+func TestProxySweep(t *testing.T) {
+	r, _ := newTestRegistry(t, "https://registry-1.docker.io")
+	proxy := &registry.NamespaceProxy{URL: "https://registry-1.docker.io", Username: "robot", Password: "secret"}
+	if r.upstream(proxy) != r.upstream(proxy.DeepCopy()) {
+		t.Errorf("upstream() is not shared by the same registry and credentials")
+	}
+	for key := range r.upstreams {
+		if strings.Contains(key, "secret") {
+			t.Errorf("upstream key %q contains the password", key)
+		}
+	}
+	r.tagChecked("tenant-hub/app:v1", time.Hour)
+	r.namespaces["tenant/hub"] = &cachedNamespace{expires: time.Now().Add(namespaceCacheTTL)}
+
+	r.sweep(time.Now().Add(2 * time.Hour))
+	if len(r.upstreams) != 0 || len(r.tags) != 0 || len(r.namespaces) != 0 {
+		t.Errorf("sweep() kept %d upstreams, %d tags and %d namespaces", len(r.upstreams), len(r.tags), len(r.namespaces))
+	}
+}
@@ -0,0 +1,366 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/registry/storage"
+	"github.com/opencontainers/go-digest"
+	"tkestack.io/tke/api/registry"
+	"tkestack.io/tke/pkg/registry/distribution/notification"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// repository is a repository of a proxy namespace, the manifests, tags and
+// blobs missing in the local storage are fetched from the upstream registry
+// and cached. Pushing and deleting are not supported.
+type repository struct {
+	distribution.Repository
+	registry  *proxyRegistry
+	upstream  *upstream
+	proxy     *registry.NamespaceProxy
+	tenantID  string
+	namespace string
+	repoName  string
+
+	remoteRepo distribution.Repository
+}
+
+// remote returns the repository of the upstream registry.
+func (r *repository) remote() (distribution.Repository, error) {
+	if r.remoteRepo == nil {
+		remote, err := r.upstream.repository(r.repoName)
+		if err != nil {
+			return nil, err
+		}
+		r.remoteRepo = remote
+	}
+	return r.remoteRepo, nil
+}
+
+func (r *repository) Manifests(ctx context.Context, options ...distribution.ManifestServiceOption) (distribution.ManifestService, error) {
+	local, err := r.Repository.Manifests(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	// the blobs of the manifests are cached when they are pulled
+	cache, err := r.Repository.Manifests(ctx, append(options, storage.SkipLayerVerification())...)
+	if err != nil {
+		return nil, err
+	}
+	return &manifestService{local: local, cache: cache, repo: r}, nil
+}
+
+func (r *repository) Tags(ctx context.Context) distribution.TagService {
+	return &tagService{local: r.Repository.Tags(ctx), repo: r}
+}
+
+func (r *repository) Blobs(ctx context.Context) distribution.BlobStore {
+	return &blobStore{local: r.Repository.Blobs(ctx), repo: r}
+}
+
+type manifestService struct {
+	local distribution.ManifestService
+	cache distribution.ManifestService
+	repo  *repository
+}
+
+func (ms *manifestService) Exists(ctx context.Context, dgst digest.Digest) (bool, error) {
+	exists, err := ms.local.Exists(ctx, dgst)
+	if err != nil || exists {
+		return exists, err
+	}
+	remote, err := ms.repo.remote()
+	if err != nil {
+		return false, err
+	}
+	remoteManifests, err := remote.Manifests(ctx)
+	if err != nil {
+		return false, err
+	}
+	return remoteManifests.Exists(ctx, dgst)
+}
+
+func (ms *manifestService) Get(ctx context.Context, dgst digest.Digest, options ...distribution.ManifestServiceOption) (distribution.Manifest, error) {
+	manifest, err := ms.local.Get(ctx, dgst, options...)
+	if err == nil {
+		return manifest, nil
+	}
+	remote, err := ms.repo.remote()
+	if err != nil {
+		return nil, err
+	}
+	remoteManifests, err := remote.Manifests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the manifest is always fetched by digest, the tag may have been moved
+	// in the upstream registry
+	manifest, err = remoteManifests.Get(ctx, dgst)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := ms.cache.Put(ctx, manifest); err != nil {
+		log.Error("Failed to cache manifest of proxy namespace",
+			log.String("repository", ms.repo.Named().Name()), log.String("digest", dgst.String()), log.Err(err))
+	}
+	return manifest, nil
+}
+
+func (ms *manifestService) Put(context.Context, distribution.Manifest, ...distribution.ManifestServiceOption) (digest.Digest, error) {
+	return "", distribution.ErrUnsupported
+}
+
+func (ms *manifestService) Delete(context.Context, digest.Digest) error {
+	return distribution.ErrUnsupported
+}
+
+type tagService struct {
+	local distribution.TagService
+	repo  *repository
+}
+
+// Get serves the cached tag within its ttl, after that the tag is checked
+// against the upstream registry. The cached tag is served if the upstream
+// registry is unavailable.
+func (ts *tagService) Get(ctx context.Context, tag string) (distribution.Descriptor, error) {
+	key := fmt.Sprintf("%s:%s", ts.repo.Named().Name(), tag)
+	ttl := time.Duration(ts.repo.proxy.TagTTLMinutes) * time.Minute
+	if ts.repo.registry.tagFresh(key) {
+		if desc, err := ts.local.Get(ctx, tag); err == nil {
+			return desc, nil
+		}
+	}
+
+	desc, err := ts.remoteGet(ctx, tag)
+	if err == nil {
+		if err = ts.cache(ctx, tag, desc); err == nil {
+			ts.repo.registry.tagChecked(key, ttl)
+			return desc, nil
+		}
+	}
+	log.Warn("Failed to fetch tag from upstream registry of proxy namespace, serving cached tag",
+		log.String("repository", ts.repo.Named().Name()), log.String("tag", tag), log.Err(err))
+	return ts.local.Get(ctx, tag)
+}
+
+func (ts *tagService) remoteGet(ctx context.Context, tag string) (distribution.Descriptor, error) {
+	remote, err := ts.repo.remote()
+	if err != nil {
+		return distribution.Descriptor{}, err
+	}
+	return remote.Tags(ctx).Get(ctx, tag)
+}
+
+// cache stores the manifest of the tag fetched from the upstream registry
+// and records the tag in the repository of the namespace.
+func (ts *tagService) cache(ctx context.Context, tag string, desc distribution.Descriptor) error {
+	if local, err := ts.local.Get(ctx, tag); err == nil && local.Digest == desc.Digest {
+		return nil
+	}
+	manifests, err := ts.repo.Manifests(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := manifests.Get(ctx, desc.Digest); err != nil {
+		return err
+	}
+	if err := ts.local.Tag(ctx, tag, desc); err != nil {
+		return err
+	}
+	if err := notification.UpdateRepository(ctx, ts.repo.registry.registryClient, ts.repo.tenantID, ts.repo.namespace, "push", ts.repo.repoName, tag, desc.Digest.String()); err != nil {
+		log.Error("Failed to record tag cached from upstream registry of proxy namespace",
+			log.String("repository", ts.repo.Named().Name()), log.String("tag", tag), log.Err(err))
+	}
+	return nil
+}
+
+func (ts *tagService) Tag(context.Context, string, distribution.Descriptor) error {
+	return distribution.ErrUnsupported
+}
+
+func (ts *tagService) Untag(context.Context, string) error {
+	return distribution.ErrUnsupported
+}
+
+func (ts *tagService) All(ctx context.Context) ([]string, error) {
+	return ts.local.All(ctx)
+}
+
+func (ts *tagService) Lookup(ctx context.Context, desc distribution.Descriptor) ([]string, error) {
+	return ts.local.Lookup(ctx, desc)
+}
+
+type blobStore struct {
+	local distribution.BlobStore
+	repo  *repository
+}
+
+func (bs *blobStore) remoteBlobs(ctx context.Context) (distribution.BlobStore, error) {
+	remote, err := bs.repo.remote()
+	if err != nil {
+		return nil, err
+	}
+	return remote.Blobs(ctx), nil
+}
+
+func (bs *blobStore) Stat(ctx context.Context, dgst digest.Digest) (distribution.Descriptor, error) {
+	desc, err := bs.local.Stat(ctx, dgst)
+	if err != distribution.ErrBlobUnknown {
+		return desc, err
+	}
+	remote, err := bs.remoteBlobs(ctx)
+	if err != nil {
+		return distribution.Descriptor{}, err
+	}
+	return remote.Stat(ctx, dgst)
+}
+
+func (bs *blobStore) Get(ctx context.Context, dgst digest.Digest) ([]byte, error) {
+	if err := bs.fetch(ctx, dgst); err != nil {
+		return nil, err
+	}
+	return bs.local.Get(ctx, dgst)
+}
+
+func (bs *blobStore) Open(ctx context.Context, dgst digest.Digest) (distribution.ReadSeekCloser, error) {
+	if err := bs.fetch(ctx, dgst); err != nil {
+		return nil, err
+	}
+	return bs.local.Open(ctx, dgst)
+}
+
+// fetch caches the blob from the upstream registry if it is missing in the
+// local storage.
+func (bs *blobStore) fetch(ctx context.Context, dgst digest.Digest) error {
+	if _, err := bs.local.Stat(ctx, dgst); err != distribution.ErrBlobUnknown {
+		return err
+	}
+	remote, err := bs.remoteBlobs(ctx)
+	if err != nil {
+		return err
+	}
+	desc, err := remote.Stat(ctx, dgst)
+	if err != nil {
+		return err
+	}
+	return bs.copy(ctx, remote, desc, io.Discard)
+}
+
+// copy writes the blob of the upstream registry to the local storage and
+// the given writer.
+func (bs *blobStore) copy(ctx context.Context, remote distribution.BlobStore, desc distribution.Descriptor, w io.Writer) error {
+	reader, err := remote.Open(ctx, desc.Digest)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	writer, err := bs.local.Create(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(io.MultiWriter(w, writer), reader); err != nil {
+		_ = writer.Cancel(ctx)
+		return err
+	}
+	_, err = writer.Commit(ctx, desc)
+	return err
+}
+
+// ServeBlob streams the blob missing in the local storage from the upstream
+// registry to the client while caching it.
+func (bs *blobStore) ServeBlob(ctx context.Context, w http.ResponseWriter, r *http.Request, dgst digest.Digest) error {
+	err := bs.local.ServeBlob(ctx, w, r, dgst)
+	if err != distribution.ErrBlobUnknown {
+		return err
+	}
+	remote, err := bs.remoteBlobs(ctx)
+	if err != nil {
+		return err
+	}
+	desc, err := remote.Stat(ctx, dgst)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Length", strconv.FormatInt(desc.Size, 10))
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Docker-Content-Digest", dgst.String())
+	w.Header().Set("Etag", dgst.String())
+	if r.Method == http.MethodHead {
+		return nil
+	}
+
+	key := fmt.Sprintf("%s@%s", bs.repo.Named().Name(), dgst)
+	if !bs.repo.registry.startFetch(key) {
+		// the blob is being cached by another request
+		reader, err := remote.Open(ctx, dgst)
+		if err != nil {
+			return err
+		}
+		defer reader.Close()
+		_, err = io.Copy(w, reader)
+		return err
+	}
+	defer bs.repo.registry.finishFetch(key)
+	cw := &countingWriter{writer: w}
+	if err := bs.copy(ctx, remote, desc, cw); err != nil {
+		if cw.written == 0 {
+			return err
+		}
+		// the response has been partly written, no error can be served
+		log.Error("Failed to cache blob of proxy namespace",
+			log.String("repository", bs.repo.Named().Name()), log.String("digest", dgst.String()), log.Err(err))
+	}
+	return nil
+}
+
+func (bs *blobStore) Put(context.Context, string, []byte) (distribution.Descriptor, error) {
+	return distribution.Descriptor{}, distribution.ErrUnsupported
+}
+
+func (bs *blobStore) Create(context.Context, ...distribution.BlobCreateOption) (distribution.BlobWriter, error) {
+	return nil, distribution.ErrUnsupported
+}
+
+func (bs *blobStore) Resume(context.Context, string) (distribution.BlobWriter, error) {
+	return nil, distribution.ErrUnsupported
+}
+
+func (bs *blobStore) Delete(context.Context, digest.Digest) error {
+	return distribution.ErrUnsupported
+}
+
+type countingWriter struct {
+	writer  io.Writer
+	written int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.written += int64(n)
+	return n, err
+}
@@ -0,0 +1,123 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package proxy
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/reference"
+	"github.com/docker/distribution/registry/client"
+	"github.com/docker/distribution/registry/client/auth"
+	"github.com/docker/distribution/registry/client/auth/challenge"
+	"github.com/docker/distribution/registry/client/transport"
+	"tkestack.io/tke/api/registry"
+)
+
+// upstream accesses the repositories of the upstream registry of a proxy
+// namespace with the docker registry http api v2.
+type upstream struct {
+	baseURL   string
+	namespace string
+	creds     *credentials
+	transport http.RoundTripper
+
+	lock    sync.Mutex
+	manager challenge.Manager
+}
+
+func newUpstream(proxy *registry.NamespaceProxy) *upstream {
+	return &upstream{
+		baseURL:   strings.TrimSuffix(proxy.URL, "/"),
+		namespace: strings.Trim(proxy.Namespace, "/"),
+		creds:     &credentials{username: proxy.Username, password: proxy.Password},
+		transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: proxy.InsecureSkipVerify},
+		},
+	}
+}
+
+// challengeManager returns the authentication challenges of the upstream
+// registry, they are requested once the registry is reachable.
+func (u *upstream) challengeManager() (challenge.Manager, error) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+	if u.manager != nil {
+		return u.manager, nil
+	}
+	resp, err := (&http.Client{Transport: u.transport}).Get(u.baseURL + "/v2/")
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+	manager := challenge.NewSimpleManager()
+	if err := manager.AddResponse(resp); err != nil {
+		return nil, err
+	}
+	u.manager = manager
+	return manager, nil
+}
+
+// repository returns the repository of the upstream registry the given
+// repository of the proxy namespace caches.
+func (u *upstream) repository(repoName string) (distribution.Repository, error) {
+	path := repoName
+	if u.namespace != "" {
+		path = u.namespace + "/" + repoName
+	}
+	named, err := reference.WithName(path)
+	if err != nil {
+		return nil, err
+	}
+	manager, err := u.challengeManager()
+	if err != nil {
+		return nil, err
+	}
+	tokenHandler := auth.NewTokenHandlerWithOptions(auth.TokenHandlerOptions{
+		Transport:   u.transport,
+		Credentials: u.creds,
+		Scopes: []auth.Scope{
+			auth.RepositoryScope{Repository: named.Name(), Actions: []string{"pull"}},
+		},
+	})
+	tr := transport.NewTransport(u.transport, auth.NewAuthorizer(manager, tokenHandler, auth.NewBasicHandler(u.creds)))
+	return client.NewRepository(named, u.baseURL, tr)
+}
+
+// credentials implements auth.CredentialStore with a username and password.
+type credentials struct {
+	username string
+	password string
+}
+
+func (c *credentials) Basic(*url.URL) (string, string) {
+	return c.username, c.password
+}
+
+func (c *credentials) RefreshToken(*url.URL, string) string {
+	return ""
+}
+
+func (c *credentials) SetRefreshToken(*url.URL, string, string) {
+}
@@ -0,0 +1,38 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package distribution
+
+import (
+	"fmt"
+
+	"github.com/docker/distribution/configuration"
+	storagedriver "github.com/docker/distribution/registry/storage/driver"
+	"github.com/docker/distribution/registry/storage/driver/factory"
+	registryconfig "tkestack.io/tke/pkg/registry/apis/config"
+)
+
+// NewStorageDriver creates the driver of the storage the images are kept in,
+// so that the images can be read outside of the distribution server.
+func NewStorageDriver(registryConfig *registryconfig.RegistryConfiguration) (storagedriver.StorageDriver, error) {
+	storage := configuration.Storage(buildStorageConfiguration(&Options{RegistryConfig: registryConfig}))
+	if storage.Type() == "" {
+		return nil, fmt.Errorf("no storage configured for the registry")
+	}
+	return factory.Create(storage.Type(), storage.Parameters())
+}
@@ -0,0 +1,180 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package vulnerability
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/docker/distribution"
+	"github.com/docker/distribution/reference"
+	"github.com/docker/distribution/registry/api/errcode"
+	middleware "github.com/docker/distribution/registry/middleware/registry"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	restclient "k8s.io/client-go/rest"
+	registryinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/registry/internalversion"
+	"tkestack.io/tke/pkg/registry/distribution/image"
+	"tkestack.io/tke/pkg/registry/distribution/notification"
+	"tkestack.io/tke/pkg/registry/distribution/tenant"
+	"tkestack.io/tke/pkg/registry/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Name is the name of the registry middleware of docker distribution that
+// denies pulling the manifests of the images blocked by the vulnerability
+// policy of their namespace.
+const Name = "tkestack-vulnerability"
+
+const loopbackConfigOption = "loopbackconfig"
+
+func init() {
+	if err := middleware.Register(Name, newRegistry); err != nil {
+		panic(err)
+	}
+}
+
+// Options returns the options of the registry middleware.
+func Options(loopbackConfig *restclient.Config) map[string]interface{} {
+	return map[string]interface{}{
+		loopbackConfigOption: loopbackConfig,
+	}
+}
+
+func newRegistry(_ context.Context, embedded distribution.Namespace, options map[string]interface{}) (distribution.Namespace, error) {
+	loopbackConfig, ok := options[loopbackConfigOption].(*restclient.Config)
+	if !ok || loopbackConfig == nil {
+		return nil, fmt.Errorf("no loopback config specified for registry middleware %s", Name)
+	}
+	registryClient, err := registryinternalclient.NewForConfig(loopbackConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &policyRegistry{Namespace: embedded, registryClient: registryClient}, nil
+}
+
+// policyRegistry checks the manifests pulled from the repositories against
+// the vulnerability policies. The middleware runs after the requests are
+// authorized by docker distribution.
+type policyRegistry struct {
+	distribution.Namespace
+	registryClient registryinternalclient.RegistryInterface
+}
+
+func (r *policyRegistry) Repository(ctx context.Context, name reference.Named) (distribution.Repository, error) {
+	repo, err := r.Namespace.Repository(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(name.Name(), fmt.Sprintf("%s/", tenant.CrossTenantNamespace)) {
+		return repo, nil
+	}
+	return &repository{Repository: repo, registry: r}, nil
+}
+
+type repository struct {
+	distribution.Repository
+	registry *policyRegistry
+}
+
+// Manifests denies the request pulling a manifest blocked by the policy.
+func (r *repository) Manifests(ctx context.Context, options ...distribution.ManifestServiceOption) (distribution.ManifestService, error) {
+	manifests, err := r.Repository.Manifests(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	ref, ok := image.PulledReference(ctx)
+	if !ok {
+		return manifests, nil
+	}
+	name := r.Named().Name()
+	reason, err := r.blocked(ctx, manifests, ref)
+	if err != nil {
+		log.Error("Failed to check the vulnerability policy of image",
+			log.String("repository", name), log.String("reference", ref), log.Err(err))
+		return nil, errcode.ErrorCodeUnknown.WithDetail(err.Error())
+	}
+	if reason != "" {
+		log.Info("Pulling image blocked by vulnerability policy",
+			log.String("repository", name), log.String("reference", ref), log.String("reason", reason))
+		return nil, errcode.ErrorCodeDenied.WithMessage(reason)
+	}
+	return manifests, nil
+}
+
+// blocked returns the reason why the image is blocked, empty if it is allowed.
+// An image pulled by digest is checked against the tags the digest resolves
+// to, it is allowed if any of them is allowed.
+func (r *repository) blocked(ctx context.Context, manifests distribution.ManifestService, ref string) (string, error) {
+	tenantID, namespace, repoName := notification.ParseRepository(r.Named().Name())
+	namespaceList, err := r.registry.registryClient.Namespaces().List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("spec.tenantID=%s,spec.name=%s", tenantID, namespace),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(namespaceList.Items) == 0 || namespaceList.Items[0].Spec.VulnerabilityPolicy == nil {
+		return "", nil
+	}
+	namespaceObject := namespaceList.Items[0]
+	vulnerabilityPolicy := namespaceObject.Spec.VulnerabilityPolicy
+
+	dgst, err := image.Digest(ctx, r.Repository, ref)
+	if err != nil {
+		// the unknown manifests are reported by docker distribution
+		return "", nil
+	}
+	manifest, err := manifests.Get(ctx, dgst)
+	if err != nil {
+		return "", nil
+	}
+	if image.IsSignature(manifest) {
+		// the signatures of the images are not scanned
+		return "", nil
+	}
+
+	repoList, err := r.registry.registryClient.Repositories(namespaceObject.ObjectMeta.Name).List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("spec.tenantID=%s,spec.name=%s,spec.namespaceName=%s", tenantID, repoName, namespace),
+	})
+	if err != nil {
+		return "", err
+	}
+	reason := "image has not been scanned for vulnerabilities"
+	if !vulnerabilityPolicy.BlockUnscanned {
+		reason = ""
+	}
+	if len(repoList.Items) == 0 {
+		return reason, nil
+	}
+	for _, tag := range image.ResolveTags(ctx, manifests, repoList.Items[0].Status.Tags, dgst) {
+		scan := util.ScannedTag(tag)
+		if scan == nil {
+			if !vulnerabilityPolicy.BlockUnscanned {
+				return "", nil
+			}
+			continue
+		}
+		count := util.CountVulnerabilities(scan.Summary, vulnerabilityPolicy.Severity)
+		if count == 0 {
+			return "", nil
+		}
+		reason = fmt.Sprintf("image has %d vulnerabilities of severity %s or higher", count, vulnerabilityPolicy.Severity)
+	}
+	return reason, nil
+}
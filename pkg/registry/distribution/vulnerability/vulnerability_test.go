@@ -0,0 +1,206 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package vulnerability
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/docker/distribution"
+	dcontext "github.com/docker/distribution/context"
+	"github.com/docker/distribution/manifest"
+	"github.com/docker/distribution/manifest/manifestlist"
+	"github.com/docker/distribution/manifest/ocischema"
+	"github.com/docker/distribution/reference"
+	"github.com/docker/distribution/registry/api/errcode"
+	"github.com/docker/distribution/registry/storage"
+	"github.com/docker/distribution/registry/storage/driver/inmemory"
+	"github.com/gorilla/mux"
+	"github.com/opencontainers/go-digest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"tkestack.io/tke/api/client/clientset/internalversion/fake"
+	"tkestack.io/tke/api/registry"
+	"tkestack.io/tke/pkg/registry/util"
+)
+
+const (
+	ociConfigMediaType   = "application/vnd.oci.image.config.v1+json"
+	ociLayerMediaType    = "application/vnd.oci.image.layer.v1.tar+gzip"
+	ociManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	ociIndexMediaType    = "application/vnd.oci.image.index.v1+json"
+)
+
+func putManifest(t *testing.T, ctx context.Context, repo distribution.Repository, layerMediaType, content string) distribution.Descriptor {
+	blobs := repo.Blobs(ctx)
+	config, err := blobs.Put(ctx, ociConfigMediaType, []byte("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	layer, err := blobs.Put(ctx, layerMediaType, []byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	layer.MediaType = layerMediaType
+	m, err := ocischema.FromStruct(ocischema.Manifest{
+		Versioned: manifest.Versioned{SchemaVersion: 2, MediaType: ociManifestMediaType},
+		Config:    distribution.Descriptor{MediaType: ociConfigMediaType, Digest: config.Digest, Size: config.Size},
+		Layers:    []distribution.Descriptor{layer},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return put(t, ctx, repo, m, ociManifestMediaType)
+}
+
+func putList(t *testing.T, ctx context.Context, repo distribution.Repository, platforms ...distribution.Descriptor) distribution.Descriptor {
+	var descriptors []manifestlist.ManifestDescriptor
+	for _, platform := range platforms {
+		descriptors = append(descriptors, manifestlist.ManifestDescriptor{Descriptor: platform})
+	}
+	m, err := manifestlist.FromDescriptors(descriptors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return put(t, ctx, repo, m, ociIndexMediaType)
+}
+
+func put(t *testing.T, ctx context.Context, repo distribution.Repository, m distribution.Manifest, mediaType string) distribution.Descriptor {
+	manifests, err := repo.Manifests(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dgst, err := manifests.Put(ctx, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, payload, _ := m.Payload()
+	return distribution.Descriptor{MediaType: mediaType, Digest: dgst, Size: int64(len(payload))}
+}
+
+func scannedTag(name string, dgst digest.Digest, critical int32) registry.RepositoryTag {
+	return registry.RepositoryTag{
+		Name:   name,
+		Digest: dgst.String(),
+		Scan: &registry.TagScan{
+			Phase:        registry.TagScanFinished,
+			Digest:       dgst.String(),
+			LastScanTime: metav1.NewTime(time.Now()),
+			Summary:      map[string]int32{string(registry.VulnerabilityCritical): critical},
+		},
+	}
+}
+
+// pullContext returns the context of docker distribution pulling the
+// manifest with the reference.
+func pullContext(reference string) context.Context {
+	r, _ := http.NewRequest(http.MethodGet, "/v2/tenant-namespace/app/manifests/"+reference, nil)
+	r = mux.SetURLVars(r, map[string]string{"name": "tenant-namespace/app", "reference": reference})
+	return dcontext.WithVars(dcontext.WithRequest(context.Background(), r), r)
+}
+
+func TestPolicy(t *testing.T) {
+	ctx := context.Background()
+	embedded, err := storage.NewRegistry(ctx, inmemory.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	named, _ := reference.WithName("tenant-namespace/app")
+	repo, err := embedded.Repository(ctx, named)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clean := putManifest(t, ctx, repo, ociLayerMediaType, "clean")
+	vulnerable := putManifest(t, ctx, repo, ociLayerMediaType, "vulnerable")
+	platform := putManifest(t, ctx, repo, ociLayerMediaType, "platform")
+	untagged := putManifest(t, ctx, repo, ociLayerMediaType, "untagged")
+	signature := putManifest(t, ctx, repo, util.SimpleSigningMediaType, "{}")
+	list := putList(t, ctx, repo, platform)
+	for tag, desc := range map[string]distribution.Descriptor{"clean": clean, "vulnerable": vulnerable, "list": list} {
+		if err := repo.Tags(ctx).Tag(ctx, tag, desc); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	objects := []runtime.Object{
+		&registry.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "ns-1"},
+			Spec: registry.NamespaceSpec{
+				TenantID: "tenant",
+				Name:     "namespace",
+				VulnerabilityPolicy: &registry.VulnerabilityPolicy{
+					Severity:       registry.VulnerabilityHigh,
+					BlockUnscanned: true,
+				},
+			},
+		},
+		&registry.Repository{
+			ObjectMeta: metav1.ObjectMeta{Name: "repo-1", Namespace: "ns-1"},
+			Spec:       registry.RepositorySpec{TenantID: "tenant", NamespaceName: "namespace", Name: "app"},
+			Status: registry.RepositoryStatus{
+				Tags: []registry.RepositoryTag{
+					scannedTag("clean", clean.Digest, 0),
+					scannedTag("vulnerable", vulnerable.Digest, 1),
+					scannedTag("list", list.Digest, 1),
+				},
+			},
+		},
+	}
+	r := &policyRegistry{Namespace: embedded, registryClient: fake.NewSimpleClientset(objects...).Registry()}
+	policyRepo, err := r.Repository(ctx, named)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		reference string
+		denied    bool
+	}{
+		{"clean tag", "clean", false},
+		{"vulnerable tag", "vulnerable", true},
+		{"clean digest", clean.Digest.String(), false},
+		{"vulnerable digest", vulnerable.Digest.String(), true},
+		{"platform of vulnerable list", platform.Digest.String(), true},
+		{"unscanned digest", untagged.Digest.String(), true},
+		{"signature", signature.Digest.String(), false},
+		{"unknown tag", "unknown", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := policyRepo.Manifests(pullContext(tt.reference))
+			if !tt.denied {
+				if err != nil {
+					t.Errorf("Manifests() = %v, want nil", err)
+				}
+				return
+			}
+			if e, ok := err.(errcode.Error); !ok || e.Code != errcode.ErrorCodeDenied {
+				t.Errorf("Manifests() = %v, want denied", err)
+			}
+		})
+	}
+
+	if _, err := policyRepo.Manifests(ctx); err != nil {
+		t.Errorf("Manifests() outside of a pull = %v, want nil", err)
+	}
+}
@@ -172,7 +172,7 @@ func rewriteBody(resp *http.Response) (err error) {
 			util.PushRepository(ctx, registryClient, &namespaceObject, repoObject, repoName, tagName, artifact.Digest)
 
 		} else if resp.Request.Method == "GET" {
-			util.PullRepository(ctx, registryClient, &namespaceObject, repoObject, repoName, tagName)
+			util.PullRepository(ctx, registryClient, &namespaceObject, repoObject, repoName, tagName, "")
 		}
 
 	} else if createChartPattern.(string) == "true" && resp.StatusCode < 300 && resp.StatusCode >= 200 {
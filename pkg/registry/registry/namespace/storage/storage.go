@@ -25,6 +25,7 @@ import (
 	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -47,7 +48,7 @@ type Storage struct {
 
 // NewStorage returns a Storage object that will work against namespaces.
 func NewStorage(optsGetter genericregistry.RESTOptionsGetter, registryClient *registryinternalclient.RegistryClient, privilegedUsername string, harborClient *harbor.APIClient) *Storage {
-	strategy := namespacestrategy.NewStrategy(registryClient)
+	strategy := namespacestrategy.NewStrategy(registryClient, privilegedUsername)
 	store := &registry.Store{
 		NewFunc:                  func() runtime.Object { return &registryapi.Namespace{} },
 		NewListFunc:              func() runtime.Object { return &registryapi.NamespaceList{} },
@@ -74,7 +75,7 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter, registryClient *re
 
 	return &Storage{
 		Namespace: &REST{store, privilegedUsername, harborClient},
-		Status:    &StatusREST{&statusStore},
+		Status:    &StatusREST{&statusStore, privilegedUsername},
 	}
 }
 
@@ -92,6 +93,33 @@ func ValidateGetObjectAndTenantID(ctx context.Context, store *registry.Store, na
 	return o, nil
 }
 
+// maskPasswords clears the passwords of the upstream registries of the proxy
+// namespaces unless the request is made by the administrator or by the
+// apiserver itself.
+func maskPasswords(ctx context.Context, obj runtime.Object, privilegedUsername string) runtime.Object {
+	if registryutil.IsPrivileged(ctx, privilegedUsername) {
+		return obj
+	}
+	switch o := obj.(type) {
+	case *registryapi.Namespace:
+		if o.Spec.Proxy == nil {
+			return o
+		}
+		namespace := o.DeepCopy()
+		namespace.Spec.Proxy.Password = ""
+		return namespace
+	case *registryapi.NamespaceList:
+		list := o.DeepCopy()
+		for i := range list.Items {
+			if list.Items[i].Spec.Proxy != nil {
+				list.Items[i].Spec.Proxy.Password = ""
+			}
+		}
+		return list
+	}
+	return obj
+}
+
 // REST implements a RESTStorage for namespaces against etcd.
 type REST struct {
 	*registry.Store
@@ -109,7 +137,26 @@ func (r *REST) ShortNames() []string {
 // List selects resources in the storage which match to the selector. 'options' can be nil.
 func (r *REST) List(ctx context.Context, options *metainternal.ListOptions) (runtime.Object, error) {
 	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
-	return r.Store.List(ctx, wrappedOptions)
+	obj, err := r.Store.List(ctx, wrappedOptions)
+	if err != nil {
+		return nil, err
+	}
+	return maskPasswords(ctx, obj, r.privilegedUsername), nil
+}
+
+// Watch selects resources in the storage which match to the selector.
+func (r *REST) Watch(ctx context.Context, options *metainternal.ListOptions) (watch.Interface, error) {
+	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
+	w, err := r.Store.Watch(ctx, wrappedOptions)
+	if err != nil {
+		return nil, err
+	}
+	return watch.Filter(w, func(event watch.Event) (watch.Event, bool) {
+		if event.Object != nil {
+			event.Object = maskPasswords(ctx, event.Object, r.privilegedUsername)
+		}
+		return event, true
+	}), nil
 }
 
 // DeleteCollection selects all resources in the storage matching given 'listOptions'
@@ -120,7 +167,11 @@ func (r *REST) DeleteCollection(ctx context.Context, deleteValidation rest.Valid
 
 // Get finds a resource in the storage by name and returns it.
 func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
-	return ValidateGetObjectAndTenantID(ctx, r.Store, name, options)
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, options)
+	if err != nil {
+		return nil, err
+	}
+	return maskPasswords(ctx, obj, r.privilegedUsername), nil
 }
 
 func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
@@ -150,7 +201,7 @@ func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation
 		return nil, err
 	}
 
-	return obj, nil
+	return maskPasswords(ctx, obj, r.privilegedUsername), nil
 }
 
 // Update alters the object subset of an object.
@@ -161,7 +212,11 @@ func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObje
 	if err != nil {
 		return nil, false, err
 	}
-	return r.Store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+	obj, created, err := r.Store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+	if err != nil {
+		return nil, false, err
+	}
+	return maskPasswords(ctx, obj, r.privilegedUsername), created, nil
 }
 
 // Delete enforces life-cycle rules for cluster termination
@@ -183,7 +238,8 @@ func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.Va
 
 // StatusREST implements the REST endpoint for changing the status of a namespace.
 type StatusREST struct {
-	store *registry.Store
+	store              *registry.Store
+	privilegedUsername string
 }
 
 // StatusREST implements Patcher.
@@ -197,7 +253,11 @@ func (r *StatusREST) New() runtime.Object {
 
 // Get retrieves the object from the storage. It is required to support Patch.
 func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
-	return ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+	if err != nil {
+		return nil, err
+	}
+	return maskPasswords(ctx, obj, r.privilegedUsername), nil
 }
 
 // Update alters the status subset of an object.
@@ -208,5 +268,9 @@ func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.Updat
 	if err != nil {
 		return nil, false, err
 	}
-	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+	obj, created, err := r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+	if err != nil {
+		return nil, false, err
+	}
+	return maskPasswords(ctx, obj, r.privilegedUsername), created, nil
 }
@@ -42,13 +42,14 @@ type Strategy struct {
 	runtime.ObjectTyper
 	names.NameGenerator
 
-	registryClient *registryinternalclient.RegistryClient
+	registryClient     *registryinternalclient.RegistryClient
+	privilegedUsername string
 }
 
 // NewStrategy creates a strategy that is the default logic that applies when
 // creating and updating namespace objects.
-func NewStrategy(registryClient *registryinternalclient.RegistryClient) *Strategy {
-	return &Strategy{registry.Scheme, namesutil.Generator, registryClient}
+func NewStrategy(registryClient *registryinternalclient.RegistryClient, privilegedUsername string) *Strategy {
+	return &Strategy{registry.Scheme, namesutil.Generator, registryClient, privilegedUsername}
 }
 
 // DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
@@ -68,6 +69,19 @@ func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 		}
 		namespace.Spec.TenantID = tenantID
 	}
+	keepProxyPassword(namespace.Spec.Proxy, oldNamespace.Spec.Proxy)
+}
+
+// keepProxyPassword keeps the password of the upstream registry, which is not
+// returned to the users, if it is updated with an empty password and the same
+// registry and username.
+func keepProxyPassword(proxy, old *registry.NamespaceProxy) {
+	if proxy == nil || old == nil {
+		return
+	}
+	if proxy.Password == "" && proxy.Username != "" && proxy.Username == old.Username && proxy.URL == old.URL {
+		proxy.Password = old.Password
+	}
 }
 
 // NamespaceScoped is false for namespace.
@@ -94,7 +108,9 @@ func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 
 // Validate validates a new namespace.
 func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	return ValidateNamespace(ctx, obj.(*registry.Namespace), s.registryClient)
+	namespace := obj.(*registry.Namespace)
+	allErrs := ValidateNamespace(ctx, namespace, s.registryClient)
+	return append(allErrs, ValidateProxyUser(ctx, namespace.Spec.Proxy, nil, s.privilegedUsername, field.NewPath("spec", "proxy"))...)
 }
 
 // AllowCreateOnUpdate is false for namespaces.
@@ -120,7 +136,10 @@ func (Strategy) Canonicalize(runtime.Object) {
 
 // ValidateUpdate is the default update validation for an end namespace.
 func (s *Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
-	return ValidateNamespaceUpdate(ctx, obj.(*registry.Namespace), old.(*registry.Namespace))
+	namespace := obj.(*registry.Namespace)
+	oldNamespace := old.(*registry.Namespace)
+	allErrs := ValidateNamespaceUpdate(ctx, namespace, oldNamespace)
+	return append(allErrs, ValidateProxyUser(ctx, namespace.Spec.Proxy, oldNamespace.Spec.Proxy, s.privilegedUsername, field.NewPath("spec", "proxy"))...)
 }
 
 // WarningsOnUpdate returns warnings for the given update.
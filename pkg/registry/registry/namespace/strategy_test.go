@@ -0,0 +1,76 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespace
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"tkestack.io/tke/api/registry"
+)
+
+func TestValidateProxyUser(t *testing.T) {
+	proxy := &registry.NamespaceProxy{URL: "https://registry-1.docker.io", Username: "robot", Password: "secret"}
+	internal := &registry.NamespaceProxy{URL: "http://169.254.169.254"}
+	fldPath := field.NewPath("spec", "proxy")
+	userCtx := request.WithUser(context.Background(), &user.DefaultInfo{Name: "user"})
+	adminCtx := request.WithUser(context.Background(), &user.DefaultInfo{Name: "admin"})
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		proxy     *registry.NamespaceProxy
+		old       *registry.NamespaceProxy
+		wantError bool
+	}{
+		{name: "no proxy", ctx: userCtx},
+		{name: "unchanged proxy", ctx: userCtx, proxy: proxy, old: proxy.DeepCopy()},
+		{name: "proxy set by user", ctx: userCtx, proxy: internal, wantError: true},
+		{name: "proxy changed by user", ctx: userCtx, proxy: internal, old: proxy, wantError: true},
+		{name: "proxy set by administrator", ctx: adminCtx, proxy: internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateProxyUser(tt.ctx, tt.proxy, tt.old, "admin", fldPath)
+			if (len(errs) != 0) != tt.wantError {
+				t.Errorf("ValidateProxyUser() = %v, want error %v", errs, tt.wantError)
+			}
+		})
+	}
+}
+
+func TestKeepProxyPassword(t *testing.T) {
+	old := &registry.NamespaceProxy{URL: "https://registry-1.docker.io", Username: "robot", Password: "secret"}
+
+	proxy := &registry.NamespaceProxy{URL: old.URL, Username: old.Username}
+	keepProxyPassword(proxy, old)
+	if proxy.Password != "secret" {
+		t.Errorf("keepProxyPassword() password = %q, want the old one", proxy.Password)
+	}
+
+	// the password is not sent to another registry
+	proxy = &registry.NamespaceProxy{URL: "https://attacker.example.com", Username: old.Username}
+	keepProxyPassword(proxy, old)
+	if proxy.Password != "" {
+		t.Errorf("keepProxyPassword() kept the password for another registry")
+	}
+}
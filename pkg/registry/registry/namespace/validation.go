@@ -21,6 +21,8 @@ package namespace
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"regexp"
 
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	registryinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/registry/internalversion"
 	"tkestack.io/tke/api/registry"
+	"tkestack.io/tke/pkg/registry/util"
 )
 
 // ValidateNamespaceName is a ValidateNameFunc for names that must be a DNS
@@ -56,10 +59,117 @@ func ValidateNamespace(ctx context.Context, namespace *registry.Namespace, regis
 	if !visibilities.Has(string(namespace.Spec.Visibility)) {
 		allErrs = append(allErrs, field.NotSupported(fldSpecPath.Child("visibility"), namespace.Spec.Visibility, visibilities.List()))
 	}
+	allErrs = append(allErrs, ValidateVulnerabilityPolicy(namespace.Spec.VulnerabilityPolicy, fldSpecPath.Child("vulnerabilityPolicy"))...)
+	allErrs = append(allErrs, ValidateRetentionPolicy(namespace.Spec.RetentionPolicy, fldSpecPath.Child("retentionPolicy"))...)
+	allErrs = append(allErrs, ValidateProxy(namespace.Spec.Proxy, fldSpecPath.Child("proxy"))...)
+	allErrs = append(allErrs, ValidateSignaturePolicy(namespace.Spec.SignaturePolicy, fldSpecPath.Child("signaturePolicy"))...)
 
 	return allErrs
 }
 
+// ValidateVulnerabilityPolicy tests if the severity of the policy is known.
+func ValidateVulnerabilityPolicy(policy *registry.VulnerabilityPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy == nil {
+		return allErrs
+	}
+	if !util.IsValidSeverity(policy.Severity) {
+		severities := []string{
+			string(registry.VulnerabilityUnknown), string(registry.VulnerabilityLow), string(registry.VulnerabilityMedium),
+			string(registry.VulnerabilityHigh), string(registry.VulnerabilityCritical),
+		}
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("severity"), policy.Severity, severities))
+	}
+	return allErrs
+}
+
+// ValidateRetentionPolicy tests if the rules of the policy are valid.
+func ValidateRetentionPolicy(policy *registry.RetentionPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy == nil {
+		return allErrs
+	}
+	if len(policy.Rules) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("rules"), "must specify at least one rule"))
+	}
+	if policy.IntervalHours < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("intervalHours"), policy.IntervalHours, "must be greater than or equal to 0"))
+	}
+	for i, rule := range policy.Rules {
+		fldRulePath := fldPath.Child("rules").Index(i)
+		patterns := []struct {
+			name    string
+			pattern string
+		}{
+			{"repositories", rule.Repositories},
+			{"includeTags", rule.IncludeTags},
+			{"excludeTags", rule.ExcludeTags},
+		}
+		for _, p := range patterns {
+			if _, err := regexp.Compile(p.pattern); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldRulePath.Child(p.name), p.pattern, err.Error()))
+			}
+		}
+		if rule.KeepLastN < 0 {
+			allErrs = append(allErrs, field.Invalid(fldRulePath.Child("keepLastN"), rule.KeepLastN, "must be greater than or equal to 0"))
+		}
+		if rule.KeepPulledWithinDays < 0 {
+			allErrs = append(allErrs, field.Invalid(fldRulePath.Child("keepPulledWithinDays"), rule.KeepPulledWithinDays, "must be greater than or equal to 0"))
+		}
+		if rule.KeepLastN == 0 && rule.KeepPulledWithinDays == 0 {
+			allErrs = append(allErrs, field.Required(fldRulePath, "must specify keepLastN or keepPulledWithinDays"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateProxy tests if the upstream registry of the proxy is valid.
+func ValidateProxy(proxy *registry.NamespaceProxy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if proxy == nil {
+		return allErrs
+	}
+	if proxy.URL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "must specify url"))
+	} else if u, err := url.Parse(proxy.URL); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), proxy.URL, err.Error()))
+	} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), proxy.URL, "must be an absolute http or https url"))
+	}
+	if proxy.TagTTLMinutes < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("tagTTLMinutes"), proxy.TagTTLMinutes, "must be greater than or equal to 0"))
+	}
+	return allErrs
+}
+
+// ValidateProxyUser tests if the user can set the upstream registry of the
+// proxy, only the administrator can make this registry request the addresses
+// of its choice.
+func ValidateProxyUser(ctx context.Context, proxy, old *registry.NamespaceProxy, privilegedUsername string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if proxy == nil || (old != nil && *proxy == *old) {
+		return allErrs
+	}
+	if !util.IsPrivileged(ctx, privilegedUsername) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only the administrator can set the upstream registry of a proxy namespace"))
+	}
+	return allErrs
+}
+
+// ValidateSignaturePolicy tests if the trusted keys of the policy are named.
+func ValidateSignaturePolicy(policy *registry.SignaturePolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy == nil {
+		return allErrs
+	}
+	for i, key := range policy.TrustedKeys {
+		if key == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("trustedKeys").Index(i), "must specify the name of trusted key"))
+		}
+	}
+	return allErrs
+}
+
 // ValidateNamespaceUpdate tests if required fields in the namespace are set during
 // an update.
 func ValidateNamespaceUpdate(ctx context.Context, namespace *registry.Namespace, old *registry.Namespace) field.ErrorList {
@@ -72,6 +182,10 @@ func ValidateNamespaceUpdate(ctx context.Context, namespace *registry.Namespace,
 	if namespace.Spec.Name != old.Spec.Name {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "name"), namespace.Spec.Name, "disallowed change the name"))
 	}
+	allErrs = append(allErrs, ValidateVulnerabilityPolicy(namespace.Spec.VulnerabilityPolicy, field.NewPath("spec", "vulnerabilityPolicy"))...)
+	allErrs = append(allErrs, ValidateRetentionPolicy(namespace.Spec.RetentionPolicy, field.NewPath("spec", "retentionPolicy"))...)
+	allErrs = append(allErrs, ValidateProxy(namespace.Spec.Proxy, field.NewPath("spec", "proxy"))...)
+	allErrs = append(allErrs, ValidateSignaturePolicy(namespace.Spec.SignaturePolicy, field.NewPath("spec", "signaturePolicy"))...)
 
 	return allErrs
 }
@@ -0,0 +1,235 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+
+	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	registryinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/registry/internalversion"
+	registryapi "tkestack.io/tke/api/registry"
+	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
+	replicationpolicystrategy "tkestack.io/tke/pkg/registry/registry/replicationpolicy"
+	registryutil "tkestack.io/tke/pkg/registry/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Storage includes storage for replication policies and all sub resources.
+type Storage struct {
+	ReplicationPolicy *REST
+	Status            *StatusREST
+}
+
+// NewStorage returns a Storage object that will work against replication
+// policies.
+func NewStorage(optsGetter genericregistry.RESTOptionsGetter, registryClient registryinternalclient.RegistryInterface, privilegedUsername string) *Storage {
+	strategy := replicationpolicystrategy.NewStrategy(registryClient)
+	store := &registry.Store{
+		NewFunc:                  func() runtime.Object { return &registryapi.ReplicationPolicy{} },
+		NewListFunc:              func() runtime.Object { return &registryapi.ReplicationPolicyList{} },
+		DefaultQualifiedResource: registryapi.Resource("replicationpolicies"),
+		PredicateFunc:            replicationpolicystrategy.MatchReplicationPolicy,
+		ReturnDeletedObject:      true,
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+	}
+	store.TableConvertor = rest.NewDefaultTableConvertor(store.DefaultQualifiedResource)
+	options := &genericregistry.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    replicationpolicystrategy.GetAttrs,
+	}
+
+	if err := store.CompleteWithOptions(options); err != nil {
+		log.Panic("Failed to create replication policy etcd rest storage", log.Err(err))
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = replicationpolicystrategy.NewStatusStrategy(strategy)
+
+	return &Storage{
+		ReplicationPolicy: &REST{store, privilegedUsername},
+		Status:            &StatusREST{&statusStore, privilegedUsername},
+	}
+}
+
+// ValidateGetObjectAndTenantID validate name and tenantID, if success return
+// ReplicationPolicy
+func ValidateGetObjectAndTenantID(ctx context.Context, store *registry.Store, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	o := obj.(*registryapi.ReplicationPolicy)
+	if err := registryutil.FilterReplicationPolicy(ctx, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// maskPasswords clears the passwords of the endpoints of the policies unless
+// the request is made by the administrator or by the apiserver itself.
+func maskPasswords(ctx context.Context, obj runtime.Object, privilegedUsername string) runtime.Object {
+	if registryutil.IsPrivileged(ctx, privilegedUsername) {
+		return obj
+	}
+	switch o := obj.(type) {
+	case *registryapi.ReplicationPolicy:
+		policy := o.DeepCopy()
+		policy.Spec.Source.Password = ""
+		policy.Spec.Destination.Password = ""
+		return policy
+	case *registryapi.ReplicationPolicyList:
+		list := o.DeepCopy()
+		for i := range list.Items {
+			list.Items[i].Spec.Source.Password = ""
+			list.Items[i].Spec.Destination.Password = ""
+		}
+		return list
+	}
+	return obj
+}
+
+// REST implements a RESTStorage for replication policies against etcd.
+type REST struct {
+	*registry.Store
+	privilegedUsername string
+}
+
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"rp"}
+}
+
+// List selects resources in the storage which match to the selector. 'options' can be nil.
+func (r *REST) List(ctx context.Context, options *metainternal.ListOptions) (runtime.Object, error) {
+	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
+	obj, err := r.Store.List(ctx, wrappedOptions)
+	if err != nil {
+		return nil, err
+	}
+	return maskPasswords(ctx, obj, r.privilegedUsername), nil
+}
+
+// Watch selects resources in the storage which match to the selector.
+func (r *REST) Watch(ctx context.Context, options *metainternal.ListOptions) (watch.Interface, error) {
+	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
+	w, err := r.Store.Watch(ctx, wrappedOptions)
+	if err != nil {
+		return nil, err
+	}
+	return watch.Filter(w, func(event watch.Event) (watch.Event, bool) {
+		if event.Object != nil {
+			event.Object = maskPasswords(ctx, event.Object, r.privilegedUsername)
+		}
+		return event, true
+	}), nil
+}
+
+// Get finds a resource in the storage by name and returns it.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, options)
+	if err != nil {
+		return nil, err
+	}
+	return maskPasswords(ctx, obj, r.privilegedUsername), nil
+}
+
+// Create creates a new replication policy.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	obj, err := r.Store.Create(ctx, obj, createValidation, options)
+	if err != nil {
+		return nil, err
+	}
+	return maskPasswords(ctx, obj, r.privilegedUsername), nil
+}
+
+// Update alters the object subset of an object.
+func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	obj, created, err := r.Store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+	if err != nil {
+		return nil, false, err
+	}
+	return maskPasswords(ctx, obj, r.privilegedUsername), created, nil
+}
+
+// Delete enforces life-cycle rules for replication policy termination.
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Delete(ctx, name, deleteValidation, options)
+}
+
+// StatusREST implements the REST endpoint for changing the status of a
+// replication policy.
+type StatusREST struct {
+	store              *registry.Store
+	privilegedUsername string
+}
+
+// StatusREST implements Patcher.
+var _ = rest.Patcher(&StatusREST{})
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *StatusREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+	if err != nil {
+		return nil, err
+	}
+	return maskPasswords(ctx, obj, r.privilegedUsername), nil
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	obj, created, err := r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+	if err != nil {
+		return nil, false, err
+	}
+	return maskPasswords(ctx, obj, r.privilegedUsername), created, nil
+}
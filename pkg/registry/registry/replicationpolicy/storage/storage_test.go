@@ -0,0 +1,56 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	registryapi "tkestack.io/tke/api/registry"
+)
+
+func TestMaskPasswords(t *testing.T) {
+	policy := &registryapi.ReplicationPolicy{
+		Spec: registryapi.ReplicationPolicySpec{
+			Source:      registryapi.ReplicationEndpoint{Username: "robot", Password: "secret"},
+			Destination: registryapi.ReplicationEndpoint{Username: "robot", Password: "secret"},
+		},
+	}
+
+	ctx := request.WithUser(context.Background(), &user.DefaultInfo{Name: "user"})
+	list := maskPasswords(ctx, &registryapi.ReplicationPolicyList{Items: []registryapi.ReplicationPolicy{*policy}}, "admin").(*registryapi.ReplicationPolicyList)
+	if spec := list.Items[0].Spec; spec.Source.Password != "" || spec.Destination.Password != "" {
+		t.Errorf("maskPasswords() by user = %v, %v, want masked", spec.Source, spec.Destination)
+	}
+	if policy.Spec.Source.Password != "secret" {
+		t.Errorf("maskPasswords() changed the stored policy")
+	}
+
+	for _, u := range []user.Info{
+		&user.DefaultInfo{Name: "admin"},
+		&user.DefaultInfo{Name: "system:apiserver", Groups: []string{user.SystemPrivilegedGroup}},
+	} {
+		ctx := request.WithUser(context.Background(), u)
+		if got := maskPasswords(ctx, policy, "admin").(*registryapi.ReplicationPolicy); got.Spec.Source.Password != "secret" {
+			t.Errorf("maskPasswords() by %s masked the password", u.GetName())
+		}
+	}
+}
@@ -0,0 +1,215 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package replicationpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	registryinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/registry/internalversion"
+	"tkestack.io/tke/api/registry"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/util/log"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for replication policy.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+
+	registryClient registryinternalclient.RegistryInterface
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating replication policy objects.
+func NewStrategy(registryClient registryinternalclient.RegistryInterface) *Strategy {
+	return &Strategy{registry.Scheme, namesutil.Generator, registryClient}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object.
+func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	oldPolicy := old.(*registry.ReplicationPolicy)
+	policy, _ := obj.(*registry.ReplicationPolicy)
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if len(tenantID) != 0 {
+		if oldPolicy.Spec.TenantID != tenantID {
+			log.Panic("Unauthorized update replication policy information", log.String("oldTenantID", oldPolicy.Spec.TenantID), log.String("newTenantID", policy.Spec.TenantID), log.String("userTenantID", tenantID))
+		}
+		policy.Spec.TenantID = tenantID
+	}
+	// the pending images and runs are recorded by the status subresource
+	policy.Status = oldPolicy.Status
+	keepPassword(&policy.Spec.Source, &oldPolicy.Spec.Source)
+	keepPassword(&policy.Spec.Destination, &oldPolicy.Spec.Destination)
+}
+
+// keepPassword keeps the password of the endpoint, which is not returned to
+// the users, if it is updated with an empty password and the same registry
+// and username.
+func keepPassword(endpoint, old *registry.ReplicationEndpoint) {
+	if endpoint.Password == "" && endpoint.Username != "" && endpoint.Username == old.Username &&
+		endpoint.Type == old.Type && endpoint.URL == old.URL {
+		endpoint.Password = old.Password
+	}
+}
+
+// NamespaceScoped is false for replication policy.
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(context.Context, runtime.Object, bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	policy, _ := obj.(*registry.ReplicationPolicy)
+	if len(tenantID) != 0 {
+		policy.Spec.TenantID = tenantID
+	}
+	policy.ObjectMeta.GenerateName = "rp-"
+	policy.ObjectMeta.Name = ""
+	policy.Status = registry.ReplicationPolicyStatus{}
+}
+
+// Validate validates a new replication policy.
+func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	policy := obj.(*registry.ReplicationPolicy)
+	allErrs := ValidateReplicationPolicy(policy)
+	return append(allErrs, ValidateReplicationNamespaces(ctx, policy, s.registryClient)...)
+}
+
+// AllowCreateOnUpdate is false for replication policies.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end replication
+// policy.
+func (s *Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	policy := obj.(*registry.ReplicationPolicy)
+	oldPolicy := old.(*registry.ReplicationPolicy)
+	allErrs := ValidateReplicationPolicyUpdate(policy, oldPolicy)
+	if policy.Spec.Source != oldPolicy.Spec.Source || policy.Spec.Destination != oldPolicy.Spec.Destination {
+		allErrs = append(allErrs, ValidateReplicationNamespaces(ctx, policy, s.registryClient)...)
+	}
+	return allErrs
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	policy, ok := obj.(*registry.ReplicationPolicy)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a replication policy")
+	}
+	return policy.ObjectMeta.Labels, ToSelectableFields(policy), nil
+}
+
+// MatchReplicationPolicy returns a generic matcher for a given label and field
+// selector.
+func MatchReplicationPolicy(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"spec.tenantID",
+			"metadata.name",
+		},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(policy *registry.ReplicationPolicy) fields.Set {
+	objectMetaFieldsSet := genericregistry.ObjectMetaFieldsSet(&policy.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID": policy.Spec.TenantID,
+	}
+	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
+
+// StatusStrategy implements verification logic for status of replication
+// policy.
+type StatusStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &StatusStrategy{}
+
+// NewStatusStrategy create the StatusStrategy object by given strategy.
+func NewStatusStrategy(strategy *Strategy) *StatusStrategy {
+	return &StatusStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object.  For example: remove fields that are not to be persisted,
+// sort order-insensitive list fields, etc.  This should not remove fields
+// whose presence would be considered a validation error.
+func (StatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newPolicy := obj.(*registry.ReplicationPolicy)
+	oldPolicy := old.(*registry.ReplicationPolicy)
+	newPolicy.Spec = oldPolicy.Spec
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (StatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateReplicationPolicyUpdate(obj.(*registry.ReplicationPolicy), old.(*registry.ReplicationPolicy))
+}
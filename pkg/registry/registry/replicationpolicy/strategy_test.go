@@ -0,0 +1,116 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package replicationpolicy
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/client/clientset/internalversion/fake"
+	"tkestack.io/tke/api/registry"
+)
+
+func newPolicy() *registry.ReplicationPolicy {
+	return &registry.ReplicationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "rp-1"},
+		Spec: registry.ReplicationPolicySpec{
+			TenantID: "default",
+			Source: registry.ReplicationEndpoint{
+				Type:      registry.ReplicationEndpointDockerRegistry,
+				URL:       "https://hub.example.com",
+				Namespace: "library",
+				Username:  "robot",
+				Password:  "secret",
+			},
+			Destination: registry.ReplicationEndpoint{
+				Type:      registry.ReplicationEndpointTKE,
+				Namespace: "mirror",
+			},
+		},
+	}
+}
+
+func TestValidateReplicationNamespaces(t *testing.T) {
+	locked := true
+	tests := []struct {
+		name      string
+		namespace *registry.Namespace
+		wantType  field.ErrorType
+	}{
+		{
+			name:      "valid",
+			namespace: &registry.Namespace{Spec: registry.NamespaceSpec{TenantID: "default", Name: "mirror"}},
+		},
+		{
+			name:     "not found",
+			wantType: field.ErrorTypeNotFound,
+		},
+		{
+			name:      "locked",
+			namespace: &registry.Namespace{Spec: registry.NamespaceSpec{TenantID: "default", Name: "mirror"}, Status: registry.NamespaceStatus{Locked: &locked}},
+			wantType:  field.ErrorTypeForbidden,
+		},
+		{
+			name: "proxy",
+			namespace: &registry.Namespace{Spec: registry.NamespaceSpec{TenantID: "default", Name: "mirror",
+				Proxy: &registry.NamespaceProxy{URL: "https://registry-1.docker.io"}}},
+			wantType: field.ErrorTypeForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			if tt.namespace != nil {
+				client = fake.NewSimpleClientset(tt.namespace)
+			}
+			errs := ValidateReplicationNamespaces(context.Background(), newPolicy(), client.Registry())
+			if tt.wantType == "" {
+				if len(errs) != 0 {
+					t.Errorf("ValidateReplicationNamespaces() = %v, want no errors", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Type != tt.wantType || errs[0].Field != "spec.destination.namespace" {
+				t.Errorf("ValidateReplicationNamespaces() = %v, want %s on spec.destination.namespace", errs, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestPrepareForUpdateKeepsPassword(t *testing.T) {
+	strategy := NewStrategy(nil)
+
+	policy := newPolicy()
+	policy.Spec.Source.Password = ""
+	strategy.PrepareForUpdate(context.Background(), policy, newPolicy())
+	if policy.Spec.Source.Password != "secret" {
+		t.Errorf("PrepareForUpdate() password = %q, want the old one", policy.Spec.Source.Password)
+	}
+
+	// the password is not sent to another registry
+	policy = newPolicy()
+	policy.Spec.Source.Password = ""
+	policy.Spec.Source.URL = "https://attacker.example.com"
+	strategy.PrepareForUpdate(context.Background(), policy, newPolicy())
+	if policy.Spec.Source.Password != "" {
+		t.Errorf("PrepareForUpdate() kept the password for another registry")
+	}
+}
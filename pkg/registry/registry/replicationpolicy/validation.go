@@ -0,0 +1,159 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package replicationpolicy
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	registryinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/registry/internalversion"
+	"tkestack.io/tke/api/registry"
+)
+
+// ValidateReplicationPolicyName is a ValidateNameFunc for names that must be a
+// DNS subdomain.
+var ValidateReplicationPolicyName = apimachineryvalidation.NameIsDNSLabel
+
+// ValidateReplicationPolicy tests if required fields in the replication policy
+// are set.
+func ValidateReplicationPolicy(policy *registry.ReplicationPolicy) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&policy.ObjectMeta, false, ValidateReplicationPolicyName, field.NewPath("metadata"))
+	allErrs = append(allErrs, validateReplicationPolicySpec(&policy.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateReplicationPolicyUpdate tests if required fields in the replication
+// policy are set during an update.
+func ValidateReplicationPolicyUpdate(policy *registry.ReplicationPolicy, old *registry.ReplicationPolicy) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&policy.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+
+	if policy.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "tenantID"), policy.Spec.TenantID, "disallowed change the tenant"))
+	}
+	allErrs = append(allErrs, validateReplicationPolicySpec(&policy.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateReplicationPolicySpec(spec *registry.ReplicationPolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := validateReplicationEndpoint(&spec.Source, fldPath.Child("source"))
+	allErrs = append(allErrs, validateReplicationEndpoint(&spec.Destination, fldPath.Child("destination"))...)
+	if spec.Source.Type == spec.Destination.Type && spec.Source.URL == spec.Destination.URL &&
+		spec.Source.Namespace == spec.Destination.Namespace {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("destination"), spec.Destination.Namespace, "must be different from the source"))
+	}
+
+	patterns := []struct {
+		name    string
+		pattern string
+	}{
+		{"repositories", spec.Repositories},
+		{"includeTags", spec.IncludeTags},
+		{"excludeTags", spec.ExcludeTags},
+	}
+	for _, p := range patterns {
+		if _, err := regexp.Compile(p.pattern); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(p.name), p.pattern, err.Error()))
+		}
+	}
+
+	fldTriggerPath := fldPath.Child("trigger")
+	switch spec.Trigger.Type {
+	case registry.ReplicationTriggerPush:
+		if spec.Source.Type != registry.ReplicationEndpointTKE {
+			allErrs = append(allErrs, field.Invalid(fldTriggerPath.Child("type"), spec.Trigger.Type, "only the images pushed to this registry trigger replication"))
+		}
+	case registry.ReplicationTriggerScheduled:
+		if spec.Trigger.IntervalMinutes <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldTriggerPath.Child("intervalMinutes"), spec.Trigger.IntervalMinutes, "must be greater than 0"))
+		}
+	default:
+		triggers := []string{string(registry.ReplicationTriggerPush), string(registry.ReplicationTriggerScheduled)}
+		allErrs = append(allErrs, field.NotSupported(fldTriggerPath.Child("type"), spec.Trigger.Type, triggers))
+	}
+
+	if spec.BandwidthLimitKBps < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("bandwidthLimitKBps"), spec.BandwidthLimitKBps, "must be greater than or equal to 0"))
+	}
+	if spec.MaxRetries != nil && *spec.MaxRetries < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxRetries"), *spec.MaxRetries, "must be greater than or equal to 0"))
+	}
+	return allErrs
+}
+
+func validateReplicationEndpoint(endpoint *registry.ReplicationEndpoint, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	types := sets.NewString(string(registry.ReplicationEndpointTKE), string(registry.ReplicationEndpointHarbor), string(registry.ReplicationEndpointDockerRegistry))
+	if !types.Has(string(endpoint.Type)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), endpoint.Type, types.List()))
+	}
+	if endpoint.Type != registry.ReplicationEndpointDockerRegistry && endpoint.Namespace == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), "must specify namespace"))
+	}
+	if endpoint.Type != registry.ReplicationEndpointTKE {
+		if u, err := url.Parse(endpoint.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), endpoint.URL, "must be a http or https url"))
+		}
+	}
+	if (endpoint.Username == "") != (endpoint.Password == "") {
+		allErrs = append(allErrs, field.Required(fldPath.Child("password"), "must specify both username and password"))
+	}
+	return allErrs
+}
+
+// ValidateReplicationNamespaces tests if the images of the namespaces of this
+// registry the policy copies between can be pulled from the source and pushed
+// to the destination by the users of the tenant of the policy, since they are
+// copied with the credential of the administrator.
+func ValidateReplicationNamespaces(ctx context.Context, policy *registry.ReplicationPolicy, registryClient registryinternalclient.RegistryInterface) field.ErrorList {
+	allErrs := validateLocalNamespace(ctx, registryClient, policy.Spec.TenantID, &policy.Spec.Source, false, field.NewPath("spec", "source"))
+	allErrs = append(allErrs, validateLocalNamespace(ctx, registryClient, policy.Spec.TenantID, &policy.Spec.Destination, true, field.NewPath("spec", "destination"))...)
+	return allErrs
+}
+
+func validateLocalNamespace(ctx context.Context, registryClient registryinternalclient.RegistryInterface, tenantID string, endpoint *registry.ReplicationEndpoint, push bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if endpoint.Type != registry.ReplicationEndpointTKE || endpoint.Namespace == "" {
+		return allErrs
+	}
+	fldNamespacePath := fldPath.Child("namespace")
+	namespaceList, err := registryClient.Namespaces().List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("spec.tenantID=%s,spec.name=%s", tenantID, endpoint.Namespace),
+	})
+	if err != nil {
+		return append(allErrs, field.InternalError(fldNamespacePath, err))
+	}
+	if len(namespaceList.Items) == 0 {
+		return append(allErrs, field.NotFound(fldNamespacePath, endpoint.Namespace))
+	}
+	namespace := namespaceList.Items[0]
+	if namespace.Status.Locked != nil && *namespace.Status.Locked {
+		allErrs = append(allErrs, field.Forbidden(fldNamespacePath, "namespace is locked"))
+	}
+	if push && namespace.Spec.Proxy != nil {
+		allErrs = append(allErrs, field.Forbidden(fldNamespacePath, "images can not be pushed to a proxy namespace"))
+	}
+	return allErrs
+}
@@ -0,0 +1,88 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	registryapi "tkestack.io/tke/api/registry"
+)
+
+// ScanREST implements the REST endpoint for requesting the images of the
+// tags of a repository to be scanned for vulnerabilities.
+type ScanREST struct {
+	store *registry.Store
+}
+
+var _ = rest.Connecter(&ScanREST{})
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *ScanREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *ScanREST) ConnectMethods() []string {
+	return []string{"POST"}
+}
+
+// NewConnectOptions returns versioned resource that represents scan parameters
+func (r *ScanREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &registryapi.RepositoryScanOptions{}, false, ""
+}
+
+// Connect returns a handler marking the tags of the repository to be scanned.
+func (r *ScanREST) Connect(ctx context.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	if _, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{}); err != nil {
+		return nil, err
+	}
+	options := opts.(*registryapi.RepositoryScanOptions)
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		obj, _, err := r.store.Update(ctx, name, rest.DefaultUpdatedObjectInfo(nil, func(ctx context.Context, newObj, oldObj runtime.Object) (runtime.Object, error) {
+			repository := oldObj.(*registryapi.Repository).DeepCopy()
+			found := false
+			for i, tag := range repository.Status.Tags {
+				if options.Tag != "" && tag.Name != options.Tag {
+					continue
+				}
+				found = true
+				if tag.Scan == nil {
+					repository.Status.Tags[i].Scan = &registryapi.TagScan{}
+				}
+				repository.Status.Tags[i].Scan.Phase = registryapi.TagScanPending
+			}
+			if !found {
+				return nil, errors.NewNotFound(registryapi.Resource("repositories/scan"), options.Tag)
+			}
+			return repository, nil
+		}), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{})
+		if err != nil {
+			responder.Error(err)
+			return
+		}
+		responder.Object(http.StatusOK, obj)
+	}), nil
+}
@@ -42,11 +42,12 @@ import (
 type Storage struct {
 	Repository *REST
 	Status     *StatusREST
+	Scan       *ScanREST
 }
 
 // NewStorage returns a Storage object that will work against repositories.
 func NewStorage(optsGetter genericregistry.RESTOptionsGetter, registryClient *registryinternalclient.RegistryClient, privilegedUsername string, harborClient *harbor.APIClient) *Storage {
-	strategy := repositorystrategy.NewStrategy(registryClient)
+	strategy := repositorystrategy.NewStrategy(registryClient, privilegedUsername)
 	store := &registry.Store{
 		NewFunc:                  func() runtime.Object { return &registryapi.Repository{} },
 		NewListFunc:              func() runtime.Object { return &registryapi.RepositoryList{} },
@@ -74,6 +75,7 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter, registryClient *re
 	return &Storage{
 		Repository: &REST{store, privilegedUsername, harborClient, registryClient},
 		Status:     &StatusREST{&statusStore},
+		Scan:       &ScanREST{&statusStore},
 	}
 }
 
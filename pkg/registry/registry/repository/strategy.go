@@ -33,6 +33,7 @@ import (
 	registryinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/registry/internalversion"
 	"tkestack.io/tke/api/registry"
 	"tkestack.io/tke/pkg/apiserver/authentication"
+	registryutil "tkestack.io/tke/pkg/registry/util"
 	"tkestack.io/tke/pkg/util/log"
 	namesutil "tkestack.io/tke/pkg/util/names"
 )
@@ -42,13 +43,14 @@ type Strategy struct {
 	runtime.ObjectTyper
 	names.NameGenerator
 
-	registryClient *registryinternalclient.RegistryClient
+	registryClient     *registryinternalclient.RegistryClient
+	privilegedUsername string
 }
 
 // NewStrategy creates a strategy that is the default logic that applies when
 // creating and updating repository objects.
-func NewStrategy(registryClient *registryinternalclient.RegistryClient) *Strategy {
-	return &Strategy{registry.Scheme, namesutil.Generator, registryClient}
+func NewStrategy(registryClient *registryinternalclient.RegistryClient, privilegedUsername string) *Strategy {
+	return &Strategy{registry.Scheme, namesutil.Generator, registryClient, privilegedUsername}
 }
 
 // DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
@@ -68,6 +70,7 @@ func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 		}
 		repository.Spec.TenantID = tenantID
 	}
+	repository.Status = oldRepository.Status
 }
 
 // NamespaceScoped is false for repositories.
@@ -179,10 +182,35 @@ func NewStatusStrategy(strategy *Strategy) *StatusStrategy {
 // the object.  For example: remove fields that are not to be persisted,
 // sort order-insensitive list fields, etc.  This should not remove fields
 // whose presence would be considered a validation error.
-func (StatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+func (s *StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 	newRepository := obj.(*registry.Repository)
 	oldRepository := old.(*registry.Repository)
 	newRepository.Spec = oldRepository.Spec
+	// only the controllers record the scans and signatures of the tags
+	if !registryutil.IsPrivileged(ctx, s.privilegedUsername) {
+		preserveVerdicts(newRepository, oldRepository)
+	}
+}
+
+// preserveVerdicts keeps the scans and signatures of the tags recorded by the
+// controllers, the users may only request the tags to be scanned again.
+func preserveVerdicts(repository, old *registry.Repository) {
+	oldTags := make(map[string]registry.RepositoryTag, len(old.Status.Tags))
+	for _, tag := range old.Status.Tags {
+		oldTags[tag.Name] = tag
+	}
+	for i, tag := range repository.Status.Tags {
+		oldTag := oldTags[tag.Name]
+		rescan := tag.Scan != nil && tag.Scan.Phase == registry.TagScanPending
+		repository.Status.Tags[i].Signature = oldTag.Signature.DeepCopy()
+		repository.Status.Tags[i].Scan = oldTag.Scan.DeepCopy()
+		if rescan {
+			if repository.Status.Tags[i].Scan == nil {
+				repository.Status.Tags[i].Scan = &registry.TagScan{}
+			}
+			repository.Status.Tags[i].Scan.Phase = registry.TagScanPending
+		}
+	}
 }
 
 // ValidateUpdate is invoked after default fields in the object have been
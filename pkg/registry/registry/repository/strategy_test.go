@@ -0,0 +1,81 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package repository
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"tkestack.io/tke/api/registry"
+)
+
+func repositoryWithScan(summary map[string]int32, signed bool) *registry.Repository {
+	return &registry.Repository{
+		ObjectMeta: metav1.ObjectMeta{Name: "repo-1", Namespace: "ns-1"},
+		Status: registry.RepositoryStatus{
+			Tags: []registry.RepositoryTag{{
+				Name:      "latest",
+				Digest:    "sha256:1",
+				Scan:      &registry.TagScan{Phase: registry.TagScanFinished, Digest: "sha256:1", Summary: summary},
+				Signature: &registry.TagSignature{Digest: "sha256:1", Signed: signed},
+			}},
+		},
+	}
+}
+
+func TestStatusStrategyPreservesVerdicts(t *testing.T) {
+	strategy := NewStatusStrategy(NewStrategy(nil, "admin"))
+	vulnerable := map[string]int32{string(registry.VulnerabilityCritical): 1}
+	clean := map[string]int32{}
+
+	ctx := request.WithUser(context.Background(), &user.DefaultInfo{Name: "user"})
+	forged := repositoryWithScan(clean, true)
+	strategy.PrepareForUpdate(ctx, forged, repositoryWithScan(vulnerable, false))
+	tag := forged.Status.Tags[0]
+	if len(tag.Scan.Summary) != 1 || tag.Signature.Signed {
+		t.Errorf("PrepareForUpdate() by user kept forged scan %v and signature %v", tag.Scan, tag.Signature)
+	}
+
+	rescan := repositoryWithScan(clean, false)
+	rescan.Status.Tags[0].Scan.Phase = registry.TagScanPending
+	strategy.PrepareForUpdate(ctx, rescan, repositoryWithScan(vulnerable, false))
+	if tag := rescan.Status.Tags[0]; tag.Scan.Phase != registry.TagScanPending || len(tag.Scan.Summary) != 1 {
+		t.Errorf("PrepareForUpdate() by user requesting a scan = %v, want pending with the old summary", tag.Scan)
+	}
+
+	ctx = request.WithUser(context.Background(), &user.DefaultInfo{Name: "admin"})
+	scanned := repositoryWithScan(clean, true)
+	strategy.PrepareForUpdate(ctx, scanned, repositoryWithScan(vulnerable, false))
+	if tag := scanned.Status.Tags[0]; len(tag.Scan.Summary) != 0 || !tag.Signature.Signed {
+		t.Errorf("PrepareForUpdate() by administrator = %v, %v, want the new scan and signature", tag.Scan, tag.Signature)
+	}
+}
+
+func TestStrategyPreservesStatus(t *testing.T) {
+	strategy := NewStrategy(nil, "admin")
+	ctx := request.WithUser(context.Background(), &user.DefaultInfo{Name: "user"})
+	forged := repositoryWithScan(map[string]int32{}, true)
+	strategy.PrepareForUpdate(ctx, forged, repositoryWithScan(map[string]int32{string(registry.VulnerabilityCritical): 1}, false))
+	if tag := forged.Status.Tags[0]; len(tag.Scan.Summary) != 1 || tag.Signature.Signed {
+		t.Errorf("PrepareForUpdate() changed the status to %v, %v", tag.Scan, tag.Signature)
+	}
+}
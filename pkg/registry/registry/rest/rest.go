@@ -43,7 +43,9 @@ import (
 	chartgroupstorage "tkestack.io/tke/pkg/registry/registry/chartgroup/storage"
 	configmapstorage "tkestack.io/tke/pkg/registry/registry/configmap/storage"
 	namespacestorage "tkestack.io/tke/pkg/registry/registry/namespace/storage"
+	replicationpolicystorage "tkestack.io/tke/pkg/registry/registry/replicationpolicy/storage"
 	repositorystorage "tkestack.io/tke/pkg/registry/registry/repository/storage"
+	trustedkeystorage "tkestack.io/tke/pkg/registry/registry/trustedkey/storage"
 	"tkestack.io/tke/pkg/util/transport"
 )
 
@@ -127,6 +129,14 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		repositoryREST := repositorystorage.NewStorage(restOptionsGetter, registryClient, s.PrivilegedUsername, harborClient)
 		storageMap["repositories"] = repositoryREST.Repository
 		storageMap["repositories/status"] = repositoryREST.Status
+		storageMap["repositories/scan"] = repositoryREST.Scan
+
+		replicationPolicyREST := replicationpolicystorage.NewStorage(restOptionsGetter, registryClient, s.PrivilegedUsername)
+		storageMap["replicationpolicies"] = replicationPolicyREST.ReplicationPolicy
+		storageMap["replicationpolicies/status"] = replicationPolicyREST.Status
+
+		trustedKeyREST := trustedkeystorage.NewStorage(restOptionsGetter)
+		storageMap["trustedkeys"] = trustedKeyREST.TrustedKey
 
 		chartGroupRESTStorage := chartgroupstorage.NewStorage(restOptionsGetter, registryClient, s.AuthClient, s.BusinessClient, s.PrivilegedUsername)
 		chartGroupREST := chartgroupstorage.NewREST(chartGroupRESTStorage.ChartGroup, registryClient, s.AuthClient, harborClient, helmClient)
@@ -0,0 +1,158 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package trustedkey
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"tkestack.io/tke/api/registry"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/util/log"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for trusted key.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating trusted key objects.
+func NewStrategy() *Strategy {
+	return &Strategy{registry.Scheme, namesutil.Generator}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object.
+func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	oldKey := old.(*registry.TrustedKey)
+	key, _ := obj.(*registry.TrustedKey)
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if len(tenantID) != 0 {
+		if oldKey.Spec.TenantID != tenantID {
+			log.Panic("Unauthorized update trusted key information", log.String("oldTenantID", oldKey.Spec.TenantID), log.String("newTenantID", key.Spec.TenantID), log.String("userTenantID", tenantID))
+		}
+		key.Spec.TenantID = tenantID
+	}
+}
+
+// NamespaceScoped is false for trusted key.
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(context.Context, runtime.Object, bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	key, _ := obj.(*registry.TrustedKey)
+	if len(tenantID) != 0 {
+		key.Spec.TenantID = tenantID
+	}
+	key.ObjectMeta.GenerateName = "tk-"
+	key.ObjectMeta.Name = ""
+}
+
+// Validate validates a new trusted key.
+func (Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ValidateTrustedKey(obj.(*registry.TrustedKey))
+}
+
+// AllowCreateOnUpdate is false for trusted keys.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings for the creation of the given object.
+func (Strategy) WarningsOnCreate(ctx context.Context, obj runtime.Object) []string {
+	return nil
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end trusted key.
+func (Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateTrustedKeyUpdate(obj.(*registry.TrustedKey), old.(*registry.TrustedKey))
+}
+
+// WarningsOnUpdate returns warnings for the given update.
+func (Strategy) WarningsOnUpdate(ctx context.Context, obj, old runtime.Object) []string {
+	return nil
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	key, ok := obj.(*registry.TrustedKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a trusted key")
+	}
+	return key.ObjectMeta.Labels, ToSelectableFields(key), nil
+}
+
+// MatchTrustedKey returns a generic matcher for a given label and field
+// selector.
+func MatchTrustedKey(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"spec.tenantID",
+			"metadata.name",
+		},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(key *registry.TrustedKey) fields.Set {
+	objectMetaFieldsSet := genericregistry.ObjectMetaFieldsSet(&key.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID": key.Spec.TenantID,
+	}
+	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
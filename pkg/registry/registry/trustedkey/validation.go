@@ -0,0 +1,60 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package trustedkey
+
+import (
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/registry"
+	"tkestack.io/tke/pkg/registry/util"
+)
+
+// ValidateTrustedKeyName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateTrustedKeyName = apimachineryvalidation.NameIsDNSLabel
+
+// ValidateTrustedKey tests if required fields in the trusted key are set.
+func ValidateTrustedKey(key *registry.TrustedKey) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&key.ObjectMeta, false, ValidateTrustedKeyName, field.NewPath("metadata"))
+	allErrs = append(allErrs, validateTrustedKeySpec(&key.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateTrustedKeyUpdate tests if required fields in the trusted key are
+// set during an update.
+func ValidateTrustedKeyUpdate(key *registry.TrustedKey, old *registry.TrustedKey) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&key.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+
+	if key.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "tenantID"), key.Spec.TenantID, "disallowed change the tenant"))
+	}
+	allErrs = append(allErrs, validateTrustedKeySpec(&key.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateTrustedKeySpec(spec *registry.TrustedKeySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.PublicKey == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("publicKey"), "must specify public key"))
+	} else if _, err := util.ParsePublicKey(spec.PublicKey); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("publicKey"), "", err.Error()))
+	}
+	return allErrs
+}
@@ -22,11 +22,26 @@ import (
 	"context"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/authentication/user"
 	"tkestack.io/tke/api/registry"
 	v1 "tkestack.io/tke/api/registry/v1"
 	"tkestack.io/tke/pkg/apiserver/authentication"
 )
 
+// IsPrivileged tells whether the request is made by the administrator or by
+// the apiserver itself.
+func IsPrivileged(ctx context.Context, privilegedUsername string) bool {
+	if authentication.IsAdministrator(ctx, privilegedUsername) {
+		return true
+	}
+	for _, group := range authentication.Groups(ctx) {
+		if group == user.SystemPrivilegedGroup {
+			return true
+		}
+	}
+	return false
+}
+
 // FilterNamespace is used to filter namespaces that do not belong to the tenant.
 func FilterNamespace(ctx context.Context, namespace *registry.Namespace) error {
 	_, tenantID := authentication.UsernameAndTenantID(ctx)
@@ -74,3 +89,29 @@ func FilterChart(ctx context.Context, chart *registry.Chart) error {
 	}
 	return nil
 }
+
+// FilterReplicationPolicy is used to filter replication policies that do not
+// belong to the tenant.
+func FilterReplicationPolicy(ctx context.Context, policy *registry.ReplicationPolicy) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if policy.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("replicationpolicy"), policy.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterTrustedKey is used to filter trusted keys that do not belong to the
+// tenant.
+func FilterTrustedKey(ctx context.Context, key *registry.TrustedKey) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if key.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("trustedkey"), key.ObjectMeta.Name)
+	}
+	return nil
+}
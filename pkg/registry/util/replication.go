@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	registryinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/registry/internalversion"
+	"tkestack.io/tke/api/registry"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// MatchReplication returns true if the tag of the repository is selected by
+// the filters of a replication policy, the empty filters match all.
+func MatchReplication(repositories, includeTags, excludeTags, repoName, tag string) bool {
+	for _, f := range []struct {
+		pattern string
+		value   string
+		want    bool
+	}{
+		{repositories, repoName, true},
+		{includeTags, tag, true},
+		{excludeTags, tag, false},
+	} {
+		if f.pattern == "" {
+			continue
+		}
+		matched, err := regexp.MatchString(f.pattern, f.value)
+		if err != nil || matched != f.want {
+			return false
+		}
+	}
+	return true
+}
+
+// TriggerReplication adds the pushed tag to the pending images of the
+// replication policies triggered by pushing to the namespace.
+func TriggerReplication(ctx context.Context, registryClient registryinternalclient.RegistryInterface, tenantID, namespace, repoName, tag, digest string) error {
+	// the images pushed by digest are copied with the tags referencing them
+	if tag == "" {
+		return nil
+	}
+	policyList, err := registryClient.ReplicationPolicies().List(ctx, metav1.ListOptions{
+		FieldSelector: fmt.Sprintf("spec.tenantID=%s", tenantID),
+	})
+	if err != nil {
+		return err
+	}
+	for i := range policyList.Items {
+		policy := &policyList.Items[i]
+		if policy.Spec.Trigger.Type != registry.ReplicationTriggerPush ||
+			policy.Spec.Source.Type != registry.ReplicationEndpointTKE ||
+			policy.Spec.Source.Namespace != namespace ||
+			!MatchReplication(policy.Spec.Repositories, policy.Spec.IncludeTags, policy.Spec.ExcludeTags, repoName, tag) {
+			continue
+		}
+		pending := false
+		for k, artifact := range policy.Status.Pending {
+			if artifact.Repository == repoName && artifact.Tag == tag {
+				policy.Status.Pending[k].Digest = digest
+				pending = true
+				break
+			}
+		}
+		if !pending {
+			policy.Status.Pending = append(policy.Status.Pending, registry.ReplicationArtifact{
+				Repository: repoName,
+				Tag:        tag,
+				Digest:     digest,
+			})
+		}
+		if _, err := registryClient.ReplicationPolicies().UpdateStatus(ctx, policy, metav1.UpdateOptions{}); err != nil {
+			log.Error("Failed to trigger replication while received notification",
+				log.String("tenantID", tenantID),
+				log.String("namespace", namespace),
+				log.String("repo", repoName),
+				log.String("tag", tag),
+				log.String("policy", policy.ObjectMeta.Name),
+				log.Err(err))
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import "testing"
+
+func TestMatchReplication(t *testing.T) {
+	tests := []struct {
+		name         string
+		repositories string
+		includeTags  string
+		excludeTags  string
+		repoName     string
+		tag          string
+		want         bool
+	}{
+		{name: "no filters", repoName: "app", tag: "v1", want: true},
+		{name: "repository matched", repositories: "^(app|web)$", repoName: "web", tag: "v1", want: true},
+		{name: "repository not matched", repositories: "^(app|web)$", repoName: "db", tag: "v1", want: false},
+		{name: "tag included", includeTags: `^v\d+$`, repoName: "app", tag: "v2", want: true},
+		{name: "tag not included", includeTags: `^v\d+$`, repoName: "app", tag: "latest", want: false},
+		{name: "tag excluded", includeTags: `^v`, excludeTags: "-rc$", repoName: "app", tag: "v2-rc", want: false},
+		{name: "tag not excluded", includeTags: `^v`, excludeTags: "-rc$", repoName: "app", tag: "v2", want: true},
+		{name: "invalid pattern", repositories: "(", repoName: "app", tag: "v1", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchReplication(tt.repositories, tt.includeTags, tt.excludeTags, tt.repoName, tt.tag); got != tt.want {
+				t.Errorf("MatchReplication() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -28,7 +28,7 @@ import (
 	"tkestack.io/tke/pkg/util/log"
 )
 
-func PushRepository(ctx context.Context, registryClient *registryinternalclient.RegistryClient, namespace *registry.Namespace, repository *registry.Repository, repoName, tag, digest string) error {
+func PushRepository(ctx context.Context, registryClient registryinternalclient.RegistryInterface, namespace *registry.Namespace, repository *registry.Repository, repoName, tag, digest string) error {
 	needIncreaseRepoCount := false
 	if repository == nil {
 		needIncreaseRepoCount = true
@@ -122,11 +122,18 @@ func PushRepository(ctx context.Context, registryClient *registryinternalclient.
 	return nil
 }
 
-func PullRepository(ctx context.Context, registryClient *registryinternalclient.RegistryClient, namespace *registry.Namespace, repository *registry.Repository, repoName, tag string) error {
+func PullRepository(ctx context.Context, registryClient registryinternalclient.RegistryInterface, namespace *registry.Namespace, repository *registry.Repository, repoName, tag, digest string) error {
 	if repository == nil {
 		return fmt.Errorf("repository %s not exist", repoName)
 	}
 	repository.Status.PullCount = repository.Status.PullCount + 1
+	now := metav1.Now()
+	for k, v := range repository.Status.Tags {
+		// the images pulled by digest have no tag in the notification
+		if (tag != "" && v.Name == tag) || (tag == "" && digest != "" && v.Digest == digest) {
+			repository.Status.Tags[k].LastPullTime = now
+		}
+	}
 	if _, err := registryClient.Repositories(namespace.ObjectMeta.Name).UpdateStatus(ctx, repository, metav1.UpdateOptions{}); err != nil {
 		log.Error("Failed to update repository pull count while received notification",
 			log.String("tenantID", namespace.Spec.TenantID),
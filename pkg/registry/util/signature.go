@@ -0,0 +1,94 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"tkestack.io/tke/api/registry"
+)
+
+// SimpleSigningMediaType is the media type of the layers cosign stores the
+// signed payloads as.
+const SimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+
+// signatureTagSuffixes are the suffixes of the tags cosign stores the
+// signatures, attestations and sboms of the images with.
+var signatureTagSuffixes = []string{".sig", ".att", ".sbom"}
+
+// SignatureTag returns the tag cosign stores the signatures of the image with
+// the given digest with.
+func SignatureTag(digest string) string {
+	return strings.Replace(digest, ":", "-", 1) + ".sig"
+}
+
+// IsSignatureTag returns true if the tag is a cosign artifact of an image
+// rather than an image.
+func IsSignatureTag(tag string) bool {
+	if !strings.HasPrefix(tag, "sha256-") {
+		return false
+	}
+	for _, suffix := range signatureTagSuffixes {
+		if strings.HasSuffix(tag, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParsePublicKey parses a PEM encoded ecdsa, rsa or ed25519 public key.
+func ParsePublicKey(publicKey string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(publicKey))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded public key found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch key.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+// SignedBy returns true if the image of the tag is signed by one of the given
+// keys.
+func SignedBy(tag *registry.RepositoryTag, keys []string) bool {
+	if tag.Signature == nil || tag.Signature.Digest != tag.Digest {
+		return false
+	}
+	for _, signedBy := range tag.Signature.Keys {
+		for _, key := range keys {
+			if signedBy == key {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"tkestack.io/tke/api/registry"
+)
+
+var severityRanks = map[registry.VulnerabilitySeverity]int{
+	registry.VulnerabilityUnknown:  0,
+	registry.VulnerabilityLow:      1,
+	registry.VulnerabilityMedium:   2,
+	registry.VulnerabilityHigh:     3,
+	registry.VulnerabilityCritical: 4,
+}
+
+// IsValidSeverity returns true if the severity of vulnerabilities is known.
+func IsValidSeverity(severity registry.VulnerabilitySeverity) bool {
+	_, ok := severityRanks[severity]
+	return ok
+}
+
+// CountVulnerabilities returns the number of the vulnerabilities in the
+// summary of a scan with the severity or higher.
+func CountVulnerabilities(summary map[string]int32, severity registry.VulnerabilitySeverity) int32 {
+	var count int32
+	for s, n := range summary {
+		if rank, ok := severityRanks[registry.VulnerabilitySeverity(s)]; ok && rank >= severityRanks[severity] {
+			count += n
+		}
+	}
+	return count
+}
+
+// ScannedTag returns the scan of the image of the tag, nil if the image has
+// not been scanned.
+func ScannedTag(tag *registry.RepositoryTag) *registry.TagScan {
+	if tag.Scan == nil || tag.Scan.Digest != tag.Digest || tag.Scan.LastScanTime.IsZero() || tag.Scan.Summary == nil {
+		return nil
+	}
+	return tag.Scan
+}
@@ -0,0 +1,109 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package net
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+var (
+	trustedProxiesLock sync.RWMutex
+	trustedProxies     []*net.IPNet
+)
+
+// SetTrustedProxies sets the networks of the proxies whose X-Forwarded-For
+// and X-Real-Ip headers are trusted, the headers are ignored if none is set.
+func SetTrustedProxies(cidrs []string) error {
+	var networks []*net.IPNet
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		if !strings.Contains(cidr, "/") {
+			if ip := net.ParseIP(cidr); ip != nil && ip.To4() != nil {
+				cidr += "/32"
+			} else {
+				cidr += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy %s: %v", cidr, err)
+		}
+		networks = append(networks, network)
+	}
+	trustedProxiesLock.Lock()
+	defer trustedProxiesLock.Unlock()
+	trustedProxies = networks
+	return nil
+}
+
+func isTrustedProxy(ip net.IP) bool {
+	trustedProxiesLock.RLock()
+	defer trustedProxiesLock.RUnlock()
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the source address of the request. The forwarded headers
+// are only honoured when the request is sent by a trusted proxy, and then the
+// nearest address that is not a trusted proxy is returned, so that a client
+// cannot claim an arbitrary address by setting the headers itself.
+func ClientIP(req *http.Request) net.IP {
+	remote := RemoteIP(req)
+	if remote == nil || !isTrustedProxy(remote) {
+		return remote
+	}
+	if forwarded := req.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		hops := strings.Split(strings.Join(forwarded, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				break
+			}
+			remote = ip
+			if !isTrustedProxy(ip) {
+				break
+			}
+		}
+		return remote
+	}
+	if ip := net.ParseIP(strings.TrimSpace(req.Header.Get("X-Real-Ip"))); ip != nil {
+		return ip
+	}
+	return remote
+}
+
+// RemoteIP returns the address of the peer of the connection of the request.
+func RemoteIP(req *http.Request) net.IP {
+	host, _, err := net.SplitHostPort(strings.TrimSpace(req.RemoteAddr))
+	if err != nil {
+		host = strings.TrimSpace(req.RemoteAddr)
+	}
+	return net.ParseIP(host)
+}
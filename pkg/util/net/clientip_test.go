@@ -0,0 +1,64 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package net
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestClientIP(t *testing.T) {
+	if err := SetTrustedProxies([]string{"10.0.0.0/8", "192.168.1.1"}); err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = SetTrustedProxies(nil) }()
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  []string
+		realIP     string
+		want       string
+	}{
+		{"direct", "1.2.3.4:5678", nil, "", "1.2.3.4"},
+		{"untrusted peer forging header", "1.2.3.4:5678", []string{"10.1.1.1"}, "", "1.2.3.4"},
+		{"untrusted peer forging real ip", "1.2.3.4:5678", nil, "10.1.1.1", "1.2.3.4"},
+		{"trusted proxy", "10.0.0.1:80", []string{"5.6.7.8"}, "", "5.6.7.8"},
+		{"trusted proxy chain", "10.0.0.1:80", []string{"5.6.7.8, 192.168.1.1"}, "", "5.6.7.8"},
+		{"client prepending forged hops", "10.0.0.1:80", []string{"9.9.9.9, 5.6.7.8"}, "", "5.6.7.8"},
+		{"multiple headers", "10.0.0.1:80", []string{"9.9.9.9", "5.6.7.8"}, "", "5.6.7.8"},
+		{"malformed hop", "10.0.0.1:80", []string{"bad, 10.0.0.2"}, "", "10.0.0.2"},
+		{"trusted proxy real ip", "10.0.0.1:80", nil, "5.6.7.8", "5.6.7.8"},
+		{"trusted proxy without header", "10.0.0.1:80", nil, "", "10.0.0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &http.Request{RemoteAddr: tt.remoteAddr, Header: http.Header{}}
+			for _, f := range tt.forwarded {
+				req.Header.Add("X-Forwarded-For", f)
+			}
+			if tt.realIP != "" {
+				req.Header.Set("X-Real-Ip", tt.realIP)
+			}
+			if got := ClientIP(req); got.String() != tt.want {
+				t.Errorf("ClientIP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
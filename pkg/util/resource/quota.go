@@ -76,15 +76,7 @@ func ValidateAllocatableResources(wantAllocate business.ResourceList, hasAllocat
 		used = business.ResourceList{}
 	}
 
-	allErrs := field.ErrorList{}
-
-	for capacityResourceName := range capacity {
-		_, wantAllocateExist := wantAllocate[capacityResourceName]
-		if !wantAllocateExist {
-			allErrs = append(allErrs, field.Invalid(fldPath.Key(capacityResourceName), 0,
-				fmt.Sprintf("%s '%s', for the parent project has set this kind of quota", QuotaLimitErrorInfo, capacityResourceName)))
-		}
-	}
+	allErrs := ValidateAllocatableResourceNames(wantAllocate, capacity, fldPath)
 
 	for wantResourceName, wantResourceQuantity := range wantAllocate {
 		capacityResourceQuantity, capacityResourceExist := capacity[wantResourceName]
@@ -108,3 +100,17 @@ func ValidateAllocatableResources(wantAllocate business.ResourceList, hasAllocat
 
 	return allErrs
 }
+
+// ValidateAllocatableResourceNames is used to verify whether the resource
+// quota to be allocated sets every kind of quota of the total resource quota.
+func ValidateAllocatableResourceNames(wantAllocate business.ResourceList, capacity business.ResourceList, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for capacityResourceName := range capacity {
+		_, wantAllocateExist := wantAllocate[capacityResourceName]
+		if !wantAllocateExist {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(capacityResourceName), 0,
+				fmt.Sprintf("%s '%s', for the parent project has set this kind of quota", QuotaLimitErrorInfo, capacityResourceName)))
+		}
+	}
+	return allErrs
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"strings"
 
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 )
@@ -96,6 +97,14 @@ const (
 	RequestsHugePagesPrefix = "requests.hugepages-"
 	// Default resource requests prefix
 	DefaultResourceRequestsPrefix = "requests."
+	// Default resource limits prefix
+	DefaultResourceLimitsPrefix = "limits."
+	// ObjectCountQuotaResourceNamePrefix is the prefix of the generic object
+	// count quota, such as count/deployments.apps.
+	ObjectCountQuotaResourceNamePrefix = "count/"
+	// StorageClassQuotaResourceNameSuffix is the infix of the storage quota of
+	// a storage class, such as gold.storageclass.storage.k8s.io/requests.storage.
+	StorageClassQuotaResourceNameSuffix = ".storageclass.storage.k8s.io/"
 )
 
 var standardQuotaResources = sets.NewString(
@@ -174,7 +183,51 @@ var integerResources = sets.NewString(
 
 // IsIntegerResourceName returns true if the resource is measured in integer values
 func IsIntegerResourceName(str string) bool {
-	return integerResources.Has(str) || IsExtendedResourceName(Name(str))
+	if IsObjectCountResourceName(Name(str)) {
+		return true
+	}
+	if IsStorageClassResourceName(Name(str)) {
+		return integerResources.Has(strings.SplitN(str, "/", 2)[1])
+	}
+	return integerResources.Has(str) ||
+		IsExtendedResourceName(Name(str)) ||
+		IsExtendedResourceName(Name(strings.TrimPrefix(str, DefaultResourceRequestsPrefix)))
+}
+
+// IsObjectCountResourceName returns true if the resource name is an object
+// count quota of the form count/<resource>.<group>.
+func IsObjectCountResourceName(name Name) bool {
+	return strings.HasPrefix(string(name), ObjectCountQuotaResourceNamePrefix)
+}
+
+// IsStorageClassResourceName returns true if the resource name is a quota of
+// a storage class of the form <class>.storageclass.storage.k8s.io/<resource>.
+func IsStorageClassResourceName(name Name) bool {
+	return strings.Contains(string(name), StorageClassQuotaResourceNameSuffix)
+}
+
+// QuotaResourceName returns the name the resource is tracked by in the
+// resource quota, the extended resources can only be limited by requests.
+func QuotaResourceName(str string) string {
+	if IsExtendedResourceName(Name(str)) {
+		return DefaultResourceRequestsPrefix + str
+	}
+	return str
+}
+
+// NormalizeQuotaResourceNames replaces the extended resources in the resource
+// list with the names they are tracked by in the resource quota.
+func NormalizeQuotaResourceNames(resourceList map[string]apiresource.Quantity) {
+	for k, v := range resourceList {
+		name := QuotaResourceName(k)
+		if name == k {
+			continue
+		}
+		delete(resourceList, k)
+		if _, ok := resourceList[name]; !ok {
+			resourceList[name] = v
+		}
+	}
 }
 
 // IsExtendedResourceName returns true if:
@@ -183,7 +236,9 @@ func IsIntegerResourceName(str string) bool {
 // to avoid confusion with the convention in quota
 // 3. it satisfies the rules in IsQualifiedName() after converted into quota resource name
 func IsExtendedResourceName(name Name) bool {
-	if IsNativeResource(name) || strings.HasPrefix(string(name), DefaultResourceRequestsPrefix) {
+	if IsNativeResource(name) || strings.HasPrefix(string(name), DefaultResourceRequestsPrefix) ||
+		strings.HasPrefix(string(name), DefaultResourceLimitsPrefix) ||
+		IsObjectCountResourceName(name) || IsStorageClassResourceName(name) {
 		return false
 	}
 	// Ensure it satisfies the rules in IsQualifiedName() after converted into quota resource name
@@ -23,6 +23,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/resource"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -30,6 +31,13 @@ import (
 const isNegativeErrorMsg = apimachineryvalidation.IsNegativeErrorMsg
 const isInvalidQuotaResource string = `must be a standard resource for quota`
 const isNotIntegerErrorMsg string = `must be an integer`
+const isInvalidStorageClassQuotaResource string = `must be requests.storage or persistentvolumeclaims of a storage class`
+const isInvalidExtendedQuotaResource string = `extended resources can only be limited by requests`
+
+var storageClassQuotaResources = sets.NewString(
+	string(RequestsStorage),
+	string(PersistentVolumeClaims),
+)
 
 // Validate compute resource typename.
 // Refer to docs/design/resources.md for more details.
@@ -60,6 +68,18 @@ func ValidateResourceQuotaResourceName(value string, fldPath *field.Path) field.
 		if !IsStandardQuotaResourceName(value) {
 			return append(allErrs, field.Invalid(fldPath, value, isInvalidQuotaResource))
 		}
+		return allErrs
+	}
+
+	switch {
+	case IsStorageClassResourceName(Name(value)):
+		if !storageClassQuotaResources.Has(strings.SplitN(value, "/", 2)[1]) {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, isInvalidStorageClassQuotaResource))
+		}
+	case strings.HasPrefix(value, DefaultResourceLimitsPrefix):
+		if IsExtendedResourceName(Name(strings.TrimPrefix(value, DefaultResourceLimitsPrefix))) {
+			allErrs = append(allErrs, field.Invalid(fldPath, value, isInvalidExtendedQuotaResource))
+		}
 	}
 	return allErrs
 }
@@ -753,11 +753,15 @@ export async function fetchNamespaceKubectlConfig(query: QueryState<NamespaceFil
     extraResource: `namespaces/${np}/certificate`
   });
 
-  /** 构建参数 */
-  let method = 'GET';
+  /** 构建参数，私钥只在签发证书时返回 */
+  let method = 'POST';
   let params: RequestParams = {
     method,
-    url
+    url,
+    data: {
+      kind: 'NamespaceCertOptions',
+      apiVersion: 'business.tkestack.io/v1'
+    }
   };
   let result = {
     certPem: '',